@@ -0,0 +1,100 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"github.com/spf13/pflag"
+
+	configv1 "github.com/openshift/api/config/v1"
+
+	ingresscontroller "github.com/openshift/cluster-ingress-operator/pkg/operator/controller/ingress"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+// ClusterConfigOptions are the paths of the cluster config objects and
+// configmaps with which to render or lint an ingresscontroller offline.
+type ClusterConfigOptions struct {
+	// InfrastructureFile, IngressFile, DNSFile, APIServerFile, and
+	// NetworkFile are the paths of the cluster config objects.
+	InfrastructureFile string
+	IngressFile        string
+	DNSFile            string
+	APIServerFile      string
+	NetworkFile        string
+
+	// ClientCAConfigMapFile is the path of the configmap that the
+	// ingresscontroller's spec.clientTLS.clientCA references.
+	ClientCAConfigMapFile string
+	// ErrorPagesConfigMapFile is the path of the configmap that the
+	// ingresscontroller's spec.httpErrorCodePages references.
+	ErrorPagesConfigMapFile string
+}
+
+// AddFlags adds flags for the options to the given flag set.
+func (o *ClusterConfigOptions) AddFlags(flags *pflag.FlagSet) {
+	flags.StringVarP(&o.InfrastructureFile, "infrastructure", "", "", "path of the cluster infrastructure config, which must have the platform status.")
+	flags.StringVarP(&o.IngressFile, "ingress", "", "", "path of the cluster ingress config (optional).")
+	flags.StringVarP(&o.DNSFile, "dns", "", "", "path of the cluster DNS config (optional).")
+	flags.StringVarP(&o.APIServerFile, "apiserver", "", "", "path of the cluster apiserver config (optional).")
+	flags.StringVarP(&o.NetworkFile, "network", "", "", "path of the cluster network config (optional).")
+	flags.StringVarP(&o.ClientCAConfigMapFile, "client-ca-configmap", "", "", "path of the configmap that the ingresscontroller's spec.clientTLS.clientCA references.")
+	flags.StringVarP(&o.ErrorPagesConfigMapFile, "error-pages-configmap", "", "", "path of the configmap that the ingresscontroller's spec.httpErrorCodePages references.")
+}
+
+// Load reads the cluster config objects and configmaps into the given config.
+// Cluster config objects whose paths are empty are set to empty objects.
+func (o *ClusterConfigOptions) Load(config *ingresscontroller.RenderConfig) error {
+	config.APIConfig = &configv1.APIServer{}
+	config.DNSConfig = &configv1.DNS{}
+	config.InfraConfig = &configv1.Infrastructure{}
+	config.IngressConfig = &configv1.Ingress{}
+	config.NetworkConfig = &configv1.Network{}
+	for _, f := range []struct {
+		path string
+		obj  client.Object
+	}{
+		{o.APIServerFile, config.APIConfig},
+		{o.DNSFile, config.DNSConfig},
+		{o.InfrastructureFile, config.InfraConfig},
+		{o.IngressFile, config.IngressConfig},
+		{o.NetworkFile, config.NetworkConfig},
+	} {
+		if err := readObjectFile(f.path, f.obj); err != nil {
+			return err
+		}
+	}
+	if len(o.ClientCAConfigMapFile) != 0 {
+		config.ClientCAConfigMap = &corev1.ConfigMap{}
+		if err := readObjectFile(o.ClientCAConfigMapFile, config.ClientCAConfigMap); err != nil {
+			return err
+		}
+	}
+	if len(o.ErrorPagesConfigMapFile) != 0 {
+		config.ErrorPagesConfigMap = &corev1.ConfigMap{}
+		if err := readObjectFile(o.ErrorPagesConfigMapFile, config.ErrorPagesConfigMap); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readObjectFile reads the object in the YAML or JSON file at the given path
+// into the given object.  If the path is empty, the object is left unchanged.
+func readObjectFile(path string, obj client.Object) error {
+	if len(path) == 0 {
+		return nil
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read %q: %w", path, err)
+	}
+	if err := yaml.Unmarshal(data, obj); err != nil {
+		return fmt.Errorf("failed to decode %q: %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	operatorclient "github.com/openshift/cluster-ingress-operator/pkg/operator/client"
+	operatorcontroller "github.com/openshift/cluster-ingress-operator/pkg/operator/controller"
+	"github.com/openshift/cluster-ingress-operator/pkg/operator/diagnose"
+
+	"k8s.io/client-go/kubernetes"
+
+	"sigs.k8s.io/controller-runtime/pkg/client/config"
+)
+
+type DiagnoseOptions struct {
+	// OperatorNamespace is the namespace in which the operator watches
+	// ingresscontrollers.
+	OperatorNamespace string
+	// OperandNamespace is the namespace of the router deployments.
+	OperandNamespace string
+	// CanaryNamespace is the namespace of the canary resources.
+	CanaryNamespace string
+	// IngressControllerName is the name of the ingresscontroller to
+	// diagnose.  If empty, all ingresscontrollers are diagnosed.
+	IngressControllerName string
+	// OutputFile is the path of the support bundle to write.
+	OutputFile string
+	// LogLines is the number of lines of each router log to collect.
+	LogLines int64
+}
+
+func NewDiagnoseCommand() *cobra.Command {
+	var options DiagnoseOptions
+
+	var command = &cobra.Command{
+		Use:   "diagnose",
+		Short: "Collect a support bundle for ingresscontrollers",
+		Long: `diagnose collects the objects that the operator manages for one or all
+ingresscontrollers, computes the ingresscontrollers' status from them the same
+way that the operator does, and writes a redacted support bundle with a summary
+of likely root causes of problems.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := runDiagnose(&options); err != nil {
+				log.Error(err, "error diagnosing")
+				os.Exit(1)
+			}
+		},
+	}
+
+	command.Flags().StringVarP(&options.OperatorNamespace, "namespace", "n", operatorcontroller.DefaultOperatorNamespace, "namespace in which the operator watches ingresscontrollers")
+	command.Flags().StringVarP(&options.OperandNamespace, "operand-namespace", "", operatorcontroller.DefaultOperandNamespace, "namespace of the ingresscontrollers' router deployments")
+	command.Flags().StringVarP(&options.CanaryNamespace, "canary-namespace", "", operatorcontroller.DefaultCanaryNamespace, "namespace of the ingress canary check resources")
+	command.Flags().StringVarP(&options.IngressControllerName, "ingresscontroller", "", "", "name of the ingresscontroller to diagnose (default all)")
+	command.Flags().StringVarP(&options.OutputFile, "output", "o", "", "path of the support bundle to write (default ingress-diagnose-<timestamp>.tar.gz)")
+	command.Flags().Int64VarP(&options.LogLines, "log-lines", "", 1000, "number of lines of each router pod's log to collect, or 0 to skip logs")
+
+	return command
+}
+
+func runDiagnose(opts *DiagnoseOptions) error {
+	kubeConfig, err := config.GetConfig()
+	if err != nil {
+		return fmt.Errorf("failed to get kube config: %v", err)
+	}
+	cl, err := operatorclient.NewClient(kubeConfig)
+	if err != nil {
+		return err
+	}
+	kubeClient, err := kubernetes.NewForConfig(kubeConfig)
+	if err != nil {
+		return fmt.Errorf("failed to create kube client: %w", err)
+	}
+
+	snapshot, err := diagnose.Collect(context.TODO(), cl, kubeClient, diagnose.Options{
+		OperatorNamespace:     opts.OperatorNamespace,
+		OperandNamespace:      opts.OperandNamespace,
+		CanaryNamespace:       opts.CanaryNamespace,
+		ConfigNamespace:       operatorcontroller.GlobalUserSpecifiedConfigNamespace,
+		IngressControllerName: opts.IngressControllerName,
+		LogLines:              opts.LogLines,
+	})
+	if err != nil {
+		return err
+	}
+	analysis := diagnose.Analyze(snapshot)
+
+	dir := "ingress-diagnose-" + snapshot.CollectedAt.UTC().Format("20060102-150405")
+	outputFile := opts.OutputFile
+	if len(outputFile) == 0 {
+		outputFile = dir + ".tar.gz"
+	}
+	if base := filepath.Base(outputFile); strings.HasSuffix(base, ".tar.gz") {
+		dir = strings.TrimSuffix(base, ".tar.gz")
+	}
+
+	f, err := os.OpenFile(outputFile, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to create %q: %w", outputFile, err)
+	}
+	if err := diagnose.WriteArchive(f, dir, snapshot, analysis); err != nil {
+		f.Close()
+		return fmt.Errorf("failed to write %q: %w", outputFile, err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("failed to write %q: %w", outputFile, err)
+	}
+
+	if err := diagnose.WriteSummary(os.Stdout, snapshot, analysis); err != nil {
+		return err
+	}
+	fmt.Printf("\nwrote %s\n", outputFile)
+	return nil
+}
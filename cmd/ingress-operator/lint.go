@@ -0,0 +1,172 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	operatorcontroller "github.com/openshift/cluster-ingress-operator/pkg/operator/controller"
+	ingresscontroller "github.com/openshift/cluster-ingress-operator/pkg/operator/controller/ingress"
+
+	"sigs.k8s.io/yaml"
+)
+
+type LintOptions struct {
+	// ExistingFiles are the paths of existing ingresscontrollers, or lists
+	// of ingresscontrollers, against which to check the uniqueness of the
+	// linted ingresscontrollers' domains.
+	ExistingFiles []string
+	// OperatorNamespace is the namespace in which the operator watches
+	// ingresscontrollers.
+	OperatorNamespace string
+	// OTelCollectorImage is the OpenTelemetry collector image with which
+	// the operator is configured.
+	OTelCollectorImage string
+	// OperatorImage is the ingress operator image with which the operator
+	// is configured.
+	OperatorImage string
+	// ClusterConfig is the cluster config against which to lint.
+	ClusterConfig ClusterConfigOptions
+	// Strict causes warnings to be treated as errors.
+	Strict bool
+	// Quiet suppresses printing the defaulted ingresscontrollers.
+	Quiet bool
+}
+
+func NewLintCommand() *cobra.Command {
+	var options LintOptions
+
+	var command = &cobra.Command{
+		Use:   "lint FILE...",
+		Short: "Validate ingresscontrollers offline",
+		Long: `lint runs the operator's admission and defaulting logic against the
+ingresscontrollers in the given files using the given cluster config, and prints
+the reasons for which the operator would reject each ingresscontroller, settings
+that the operator would ignore or change, and each ingresscontroller with the
+defaults that admission sets.  lint exits with a non-zero status if any
+ingresscontroller would be rejected, or with --strict, if there are warnings.`,
+		Args: cobra.MinimumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ok, err := lint(os.Stdout, args, &options)
+			if err != nil {
+				log.Error(err, "error linting")
+				os.Exit(1)
+			}
+			if !ok {
+				os.Exit(1)
+			}
+		},
+	}
+
+	command.Flags().StringSliceVarP(&options.ExistingFiles, "existing", "e", nil, "paths of existing ingresscontrollers or ingresscontroller lists, for checking domain uniqueness.")
+	command.Flags().StringVarP(&options.OperatorNamespace, "namespace", "n", operatorcontroller.DefaultOperatorNamespace, "namespace in which the operator watches ingresscontrollers.")
+	command.Flags().StringVarP(&options.OTelCollectorImage, "otel-collector-image", "", "", "image of the OpenTelemetry collector container with which the operator is configured (optional).")
+	command.Flags().StringVarP(&options.OperatorImage, "operator-image", "", "", "image of the ingress operator with which the operator is configured (optional).")
+	options.ClusterConfig.AddFlags(command.Flags())
+	command.Flags().BoolVarP(&options.Strict, "strict", "", false, "treat warnings as errors.")
+	command.Flags().BoolVarP(&options.Quiet, "quiet", "q", false, "do not print the defaulted ingresscontrollers.")
+	if err := command.MarkFlagRequired("infrastructure"); err != nil {
+		panic(err)
+	}
+
+	return command
+}
+
+// lint lints the ingresscontrollers in the given files and writes the results
+// to the given writer.  Returns a Boolean value indicating whether all the
+// ingresscontrollers passed.
+func lint(w io.Writer, files []string, opts *LintOptions) (bool, error) {
+	config := ingresscontroller.RenderConfig{
+		OperatorNamespace:  opts.OperatorNamespace,
+		OTelCollectorImage: opts.OTelCollectorImage,
+		OperatorImage:      opts.OperatorImage,
+	}
+	if err := opts.ClusterConfig.Load(&config); err != nil {
+		return false, err
+	}
+
+	var linted, existing []operatorv1.IngressController
+	for _, file := range files {
+		ics, err := readIngressControllersFile(file)
+		if err != nil {
+			return false, err
+		}
+		linted = append(linted, ics...)
+	}
+	for _, file := range opts.ExistingFiles {
+		ics, err := readIngressControllersFile(file)
+		if err != nil {
+			return false, err
+		}
+		existing = append(existing, ics...)
+	}
+
+	ok := true
+	for i := range linted {
+		ic := &linted[i]
+		// The other linted ingresscontrollers count as existing ones.
+		others := append([]operatorv1.IngressController{}, existing...)
+		others = append(others, linted[:i]...)
+		others = append(others, linted[i+1:]...)
+
+		result := ingresscontroller.LintIngressController(ic, others, config)
+
+		fmt.Fprintf(w, "ingresscontroller %s:\n", ic.Name)
+		for _, e := range result.Errors {
+			fmt.Fprintf(w, "  error: %s\n", e)
+		}
+		for _, warning := range result.Warnings {
+			fmt.Fprintf(w, "  warning: %s\n", warning)
+		}
+		if len(result.Errors) == 0 && len(result.Warnings) == 0 {
+			fmt.Fprintf(w, "  ok\n")
+		}
+		if len(result.Errors) != 0 || (opts.Strict && len(result.Warnings) != 0) {
+			ok = false
+		}
+
+		if !opts.Quiet {
+			result.IngressController.APIVersion = operatorv1.GroupVersion.String()
+			result.IngressController.Kind = "IngressController"
+			data, err := yaml.Marshal(result.IngressController)
+			if err != nil {
+				return false, fmt.Errorf("failed to marshal ingresscontroller %s: %w", ic.Name, err)
+			}
+			fmt.Fprintf(w, "---\n%s", data)
+		}
+	}
+	return ok, nil
+}
+
+// readIngressControllersFile reads the ingresscontroller, or the list of
+// ingresscontrollers, in the YAML or JSON file at the given path.
+func readIngressControllersFile(path string) ([]operatorv1.IngressController, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %q: %w", path, err)
+	}
+	var typeMeta struct {
+		Kind string `json:"kind"`
+	}
+	if err := yaml.Unmarshal(data, &typeMeta); err != nil {
+		return nil, fmt.Errorf("failed to decode %q: %w", path, err)
+	}
+	if strings.HasSuffix(typeMeta.Kind, "List") {
+		list := &operatorv1.IngressControllerList{}
+		if err := yaml.Unmarshal(data, list); err != nil {
+			return nil, fmt.Errorf("failed to decode %q: %w", path, err)
+		}
+		return list.Items, nil
+	}
+	ic := operatorv1.IngressController{}
+	if err := yaml.Unmarshal(data, &ic); err != nil {
+		return nil, fmt.Errorf("failed to decode %q: %w", path, err)
+	}
+	return []operatorv1.IngressController{ic}, nil
+}
@@ -18,6 +18,9 @@ func main() {
 	var rootCmd = &cobra.Command{Use: "ingress-operator"}
 	rootCmd.AddCommand(NewStartCommand())
 	rootCmd.AddCommand(NewRenderCommand())
+	rootCmd.AddCommand(NewLintCommand())
+	rootCmd.AddCommand(NewDiagnoseCommand())
+	rootCmd.AddCommand(NewServeRouterTracingCommand())
 	rootCmd.AddCommand(httphealthcheck.NewServeHealthCheckCommand())
 	rootCmd.AddCommand(&cobra.Command{
 		Use:   "serve-grpc-test-server",
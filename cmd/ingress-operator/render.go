@@ -3,26 +3,70 @@ package main
 import (
 	"fmt"
 	"io/ioutil"
+	"net"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/spf13/cobra"
 
+	operatorv1 "github.com/openshift/api/operator/v1"
+
 	"github.com/openshift/cluster-ingress-operator/pkg/manifests"
+	operatorclient "github.com/openshift/cluster-ingress-operator/pkg/operator/client"
+	operatorcontroller "github.com/openshift/cluster-ingress-operator/pkg/operator/controller"
+	ingresscontroller "github.com/openshift/cluster-ingress-operator/pkg/operator/controller/ingress"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/yaml"
 )
 
+type RenderOptions struct {
+	// OutputDir is the directory to which to write manifests.
+	OutputDir string
+	// Prefix is an optional prefix for the names of the manifest files.
+	Prefix string
+
+	// IngressControllerFile is the path of an ingresscontroller whose
+	// operand manifests to render.  If empty, only the base manifests are
+	// rendered.
+	IngressControllerFile string
+	// OperatorNamespace is the namespace in which the operator watches
+	// ingresscontrollers.
+	OperatorNamespace string
+	// OperandNamespace is the namespace for the router deployments.
+	OperandNamespace string
+	// IngressControllerImage is the router image.
+	IngressControllerImage string
+	// OTelCollectorImage is the OpenTelemetry collector image.
+	OTelCollectorImage string
+	// OperatorImage is the ingress operator image.
+	OperatorImage string
+
+	// ClusterConfig is the cluster config with which to render the
+	// ingresscontroller.
+	ClusterConfig ClusterConfigOptions
+
+	// LoadBalancerAddress is the hostname or IP address of the
+	// ingresscontroller's load balancer, for the wildcard DNS record.
+	LoadBalancerAddress string
+}
+
 func NewRenderCommand() *cobra.Command {
-	var options struct {
-		OutputDir string
-		Prefix    string
-	}
+	var options RenderOptions
 
 	var command = &cobra.Command{
 		Use:   "render",
 		Short: "Render base manifests",
-		Long:  `render emits the base manifest files necessary to support the creation of an ingresscontroller resource.`,
+		Long: `render emits the base manifest files necessary to support the creation of an ingresscontroller resource.
+
+If an ingresscontroller is given with --ingresscontroller, render also admits it
+using the given cluster config and emits the manifests of the resources that the
+operator would create for it.`,
 		Run: func(cmd *cobra.Command, args []string) {
-			if err := render(options.OutputDir, options.Prefix); err != nil {
+			if err := render(&options); err != nil {
 				log.Error(err, "error rendering")
 				os.Exit(1)
 			}
@@ -31,6 +75,14 @@ func NewRenderCommand() *cobra.Command {
 
 	command.Flags().StringVarP(&options.OutputDir, "output-dir", "o", "", "manifest output directory.")
 	command.Flags().StringVarP(&options.Prefix, "prefix", "p", "", "optional prefix for rendered filenames.")
+	command.Flags().StringVarP(&options.IngressControllerFile, "ingresscontroller", "", "", "optional path of an ingresscontroller for which to render operand manifests.")
+	command.Flags().StringVarP(&options.OperatorNamespace, "namespace", "n", operatorcontroller.DefaultOperatorNamespace, "namespace in which the operator watches ingresscontrollers.")
+	command.Flags().StringVarP(&options.OperandNamespace, "operand-namespace", "", operatorcontroller.DefaultOperandNamespace, "namespace for ingresscontrollers' router deployments and related resources.")
+	command.Flags().StringVarP(&options.IngressControllerImage, "image", "i", "", "image of the ingress controller (required with --ingresscontroller).")
+	command.Flags().StringVarP(&options.OTelCollectorImage, "otel-collector-image", "", "", "image of the OpenTelemetry collector container (optional).")
+	command.Flags().StringVarP(&options.OperatorImage, "operator-image", "", "", "image of the ingress operator, which runs the router tracing sidecar (optional).")
+	options.ClusterConfig.AddFlags(command.Flags())
+	command.Flags().StringVarP(&options.LoadBalancerAddress, "load-balancer-address", "", "", "hostname or IP address of the ingresscontroller's load balancer, for rendering the wildcard DNS record (optional).")
 	if err := command.MarkFlagRequired("output-dir"); err != nil {
 		panic(err)
 	}
@@ -38,22 +90,83 @@ func NewRenderCommand() *cobra.Command {
 	return command
 }
 
-func render(dir string, prefix string) error {
+func render(opts *RenderOptions) error {
 	files := []string{
 		manifests.CustomResourceDefinitionManifest,
 		manifests.NamespaceManifest,
 	}
 
-	if err := os.MkdirAll(dir, 0750); err != nil {
-		return fmt.Errorf("failed to create output directory %q: %v", dir, err)
+	if err := os.MkdirAll(opts.OutputDir, 0750); err != nil {
+		return fmt.Errorf("failed to create output directory %q: %v", opts.OutputDir, err)
 	}
 
 	for _, file := range files {
-		outputFile := filepath.Join(dir, prefix+filepath.Base(file))
+		outputFile := filepath.Join(opts.OutputDir, opts.Prefix+filepath.Base(file))
 		if err := ioutil.WriteFile(outputFile, manifests.MustAsset(file), 0640); err != nil {
 			return fmt.Errorf("failed to write %q: %v", outputFile, err)
 		}
 		fmt.Printf("wrote %s\n", outputFile)
 	}
+
+	if len(opts.IngressControllerFile) == 0 {
+		return nil
+	}
+	return renderIngressController(opts)
+}
+
+// renderIngressController writes the manifests of the resources that the
+// operator would create for the ingresscontroller in the given options.
+func renderIngressController(opts *RenderOptions) error {
+	if len(opts.IngressControllerImage) == 0 {
+		return fmt.Errorf("--image is required with --ingresscontroller")
+	}
+	if len(opts.ClusterConfig.InfrastructureFile) == 0 {
+		return fmt.Errorf("--infrastructure is required with --ingresscontroller")
+	}
+
+	ic := &operatorv1.IngressController{}
+	if err := readObjectFile(opts.IngressControllerFile, ic); err != nil {
+		return err
+	}
+	config := ingresscontroller.RenderConfig{
+		OperatorNamespace:      opts.OperatorNamespace,
+		OperandNamespace:       opts.OperandNamespace,
+		IngressControllerImage: opts.IngressControllerImage,
+		OTelCollectorImage:     opts.OTelCollectorImage,
+		OperatorImage:          opts.OperatorImage,
+	}
+	if err := opts.ClusterConfig.Load(&config); err != nil {
+		return err
+	}
+	if len(opts.LoadBalancerAddress) != 0 {
+		if net.ParseIP(opts.LoadBalancerAddress) != nil {
+			config.LoadBalancerIngress = []corev1.LoadBalancerIngress{{IP: opts.LoadBalancerAddress}}
+		} else {
+			config.LoadBalancerIngress = []corev1.LoadBalancerIngress{{Hostname: opts.LoadBalancerAddress}}
+		}
+	}
+
+	_, objects, err := ingresscontroller.RenderIngressController(ic, config)
+	if err != nil {
+		return err
+	}
+
+	for i, obj := range objects {
+		gvk, err := apiutil.GVKForObject(obj, operatorclient.GetScheme())
+		if err != nil {
+			return fmt.Errorf("failed to determine kind of %s: %w", obj.GetName(), err)
+		}
+		obj.GetObjectKind().SetGroupVersionKind(gvk)
+		data, err := yaml.Marshal(obj)
+		if err != nil {
+			return fmt.Errorf("failed to marshal %s %s: %w", gvk.Kind, obj.GetName(), err)
+		}
+		name := fmt.Sprintf("%singresscontroller-%s-%02d-%s-%s.yaml", opts.Prefix, ic.Name, i, strings.ToLower(gvk.Kind), obj.GetName())
+		outputFile := filepath.Join(opts.OutputDir, name)
+		if err := ioutil.WriteFile(outputFile, data, 0640); err != nil {
+			return fmt.Errorf("failed to write %q: %v", outputFile, err)
+		}
+		fmt.Printf("wrote %s\n", outputFile)
+	}
 	return nil
 }
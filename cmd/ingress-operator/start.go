@@ -6,18 +6,22 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"time"
 
 	"github.com/spf13/cobra"
 	"gopkg.in/fsnotify.v1"
 
 	"github.com/openshift/cluster-ingress-operator/pkg/operator"
 
+	operatorclient "github.com/openshift/cluster-ingress-operator/pkg/operator/client"
 	operatorconfig "github.com/openshift/cluster-ingress-operator/pkg/operator/config"
 	operatorcontroller "github.com/openshift/cluster-ingress-operator/pkg/operator/controller"
 	canarycontroller "github.com/openshift/cluster-ingress-operator/pkg/operator/controller/canary"
+	dnscontroller "github.com/openshift/cluster-ingress-operator/pkg/operator/controller/dns"
 	ingresscontroller "github.com/openshift/cluster-ingress-operator/pkg/operator/controller/ingress"
 	routemetricscontroller "github.com/openshift/cluster-ingress-operator/pkg/operator/controller/route-metrics"
 	statuscontroller "github.com/openshift/cluster-ingress-operator/pkg/operator/controller/status"
+	"github.com/openshift/cluster-ingress-operator/pkg/util/trustedca"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/config"
@@ -30,27 +34,71 @@ import (
 )
 
 const (
-	// defaultTrustedCABundle is the fully qualified path of the trusted CA bundle
-	// that is mounted from configmap openshift-ingress-operator/trusted-ca.
-	defaultTrustedCABundle = "/etc/pki/ca-trust/extracted/pem/tls-ca-bundle.pem"
+	// defaultLeaseDuration, defaultRenewDeadline, and defaultRetryPeriod
+	// are the default leader election parameters.  These are the values
+	// that OpenShift operators use so that the leader keeps its lease
+	// through a 60-second API server disruption.
+	defaultLeaseDuration = 137 * time.Second
+	defaultRenewDeadline = 107 * time.Second
+	defaultRetryPeriod   = 26 * time.Second
 )
 
 type StartOptions struct {
 	// When this file changes, the operator will shut down. This is useful for simple
 	// reloading when things like a certificate changes.
 	ShutdownFile string
+	// TrustedCABundle is the path of the trusted CA bundle that the
+	// operator's HTTP clients and DNS providers use.  When this file
+	// changes, the operator reloads the bundle without restarting.  If
+	// empty, the operator uses the system certificate pool.  If the same
+	// file is also the ShutdownFile, the operator still shuts down when
+	// the file changes.
+	TrustedCABundle string
 	// MetricsListenAddr is the address on which to expose the metrics endpoint.
 	MetricsListenAddr string
+	// HealthListenAddr is the address on which to serve the /healthz and
+	// /readyz endpoints.  If empty, the operator does not serve them.
+	HealthListenAddr string
+	// WebhookListenAddr is the address on which to serve the admission
+	// webhook.  If empty, the operator does not serve the webhook.
+	WebhookListenAddr string
+	// EnableProfiling enables the /debug/pprof endpoints on the metrics
+	// listener.
+	EnableProfiling bool
 	// OperatorNamespace is the namespace the operator should watch for
 	// ingresscontroller resources.
 	OperatorNamespace string
+	// OperandNamespace is the namespace in which the operator manages
+	// ingresscontrollers' router deployments and related resources.
+	OperandNamespace string
+	// CanaryNamespace is the namespace in which the operator manages the
+	// ingress canary check resources.
+	CanaryNamespace string
+	// ConfigNamespace is the namespace from which the operator reads
+	// user-specified configuration.
+	ConfigNamespace string
 	// IngressControllerImage is the pullspec of the ingress controller image to
 	// be managed.
 	IngressControllerImage string
 	// CanaryImage is the pullspec of the ingress operator image
 	CanaryImage string
+	// OTelCollectorImage is the pullspec of the OpenTelemetry collector
+	// image that is used to export access logs using OTLP.
+	OTelCollectorImage string
 	// ReleaseVersion is the cluster version which the operator will converge to.
 	ReleaseVersion string
+	// LeaderElect enables leader election so that multiple replicas of the
+	// operator can run, with only the leader running controllers.
+	LeaderElect bool
+	// LeaderElectLeaseDuration is the duration that non-leader replicas
+	// wait before they try to acquire leadership.
+	LeaderElectLeaseDuration time.Duration
+	// LeaderElectRenewDeadline is the duration that the leader retries
+	// renewing leadership before it gives up.
+	LeaderElectRenewDeadline time.Duration
+	// LeaderElectRetryPeriod is the duration that replicas wait between
+	// attempts to acquire or renew leadership.
+	LeaderElectRetryPeriod time.Duration
 }
 
 func NewStartCommand() *cobra.Command {
@@ -69,11 +117,23 @@ func NewStartCommand() *cobra.Command {
 	}
 
 	cmd.Flags().StringVarP(&options.OperatorNamespace, "namespace", "n", operatorcontroller.DefaultOperatorNamespace, "namespace the operator is deployed to (required)")
+	cmd.Flags().StringVarP(&options.OperandNamespace, "operand-namespace", "", operatorcontroller.DefaultOperandNamespace, "namespace for ingresscontrollers' router deployments and related resources")
+	cmd.Flags().StringVarP(&options.CanaryNamespace, "canary-namespace", "", operatorcontroller.DefaultCanaryNamespace, "namespace for the ingress canary check resources")
+	cmd.Flags().StringVarP(&options.ConfigNamespace, "config-namespace", "", operatorcontroller.GlobalUserSpecifiedConfigNamespace, "namespace from which to read user-specified configuration")
 	cmd.Flags().StringVarP(&options.IngressControllerImage, "image", "i", "", "image of the ingress controller the operator will manage (required)")
-	cmd.Flags().StringVarP(&options.CanaryImage, "canary-image", "c", "", "image of the canary container that the operator will manage (optional)")
+	cmd.Flags().StringVarP(&options.CanaryImage, "canary-image", "c", "", "image of the ingress operator, which runs the canary and router tracing containers that the operator will manage (optional)")
+	cmd.Flags().StringVarP(&options.OTelCollectorImage, "otel-collector-image", "", "", "image of the OpenTelemetry collector container that exports access logs using OTLP (optional)")
 	cmd.Flags().StringVarP(&options.ReleaseVersion, "release-version", "", statuscontroller.UnknownVersionValue, "the release version the operator should converge to (required)")
 	cmd.Flags().StringVarP(&options.MetricsListenAddr, "metrics-listen-addr", "", "127.0.0.1:60000", "metrics endpoint listen address (required)")
-	cmd.Flags().StringVarP(&options.ShutdownFile, "shutdown-file", "s", defaultTrustedCABundle, "if provided, shut down the operator when this file changes")
+	cmd.Flags().StringVarP(&options.HealthListenAddr, "health-listen-addr", "", ":9440", "health and readiness endpoint listen address; if empty, the endpoints are not served (optional)")
+	cmd.Flags().StringVarP(&options.WebhookListenAddr, "webhook-listen-addr", "", "", "admission webhook listen address; if empty, the webhook is not served (optional)")
+	cmd.Flags().BoolVarP(&options.EnableProfiling, "enable-profiling", "", false, "serve /debug/pprof on the metrics listener")
+	cmd.Flags().StringVarP(&options.ShutdownFile, "shutdown-file", "s", "", "if provided, shut down the operator when this file changes (optional)")
+	cmd.Flags().StringVarP(&options.TrustedCABundle, "trusted-ca-bundle", "", "", "if provided, the trusted CA bundle, which the operator reloads without restarting when it changes (optional)")
+	cmd.Flags().BoolVarP(&options.LeaderElect, "leader-elect", "", false, "use leader election so that only one replica of the operator is active at a time")
+	cmd.Flags().DurationVarP(&options.LeaderElectLeaseDuration, "leader-elect-lease-duration", "", defaultLeaseDuration, "duration that non-leader replicas wait before trying to acquire leadership")
+	cmd.Flags().DurationVarP(&options.LeaderElectRenewDeadline, "leader-elect-renew-deadline", "", defaultRenewDeadline, "duration that the leader retries renewing leadership before giving it up")
+	cmd.Flags().DurationVarP(&options.LeaderElectRetryPeriod, "leader-elect-retry-period", "", defaultRetryPeriod, "duration between attempts to acquire or renew leadership")
 
 	if err := cmd.MarkFlagRequired("namespace"); err != nil {
 		panic(err)
@@ -93,6 +153,7 @@ func start(opts *StartOptions) error {
 	}
 
 	log.Info("using operator namespace", "namespace", opts.OperatorNamespace)
+	log.Info("using managed namespaces", "operand", opts.OperandNamespace, "canary", opts.CanaryNamespace, "config", opts.ConfigNamespace)
 
 	if opts.ReleaseVersion == statuscontroller.UnknownVersionValue {
 		log.Info("Warning: no release version is specified", "release version", statuscontroller.UnknownVersionValue)
@@ -120,20 +181,45 @@ func start(opts *StartOptions) error {
 	operatorConfig := operatorconfig.Config{
 		OperatorReleaseVersion: opts.ReleaseVersion,
 		Namespace:              opts.OperatorNamespace,
+		OperandNamespace:       opts.OperandNamespace,
+		CanaryNamespace:        opts.CanaryNamespace,
+		ConfigNamespace:        opts.ConfigNamespace,
 		IngressControllerImage: opts.IngressControllerImage,
 		CanaryImage:            opts.CanaryImage,
+		OTelCollectorImage:     opts.OTelCollectorImage,
+		WebhookListenAddr:      opts.WebhookListenAddr,
+		LeaderElection: operatorconfig.LeaderElectionConfig{
+			Enabled:       opts.LeaderElect,
+			LeaseDuration: opts.LeaderElectLeaseDuration,
+			RenewDeadline: opts.LeaderElectRenewDeadline,
+			RetryPeriod:   opts.LeaderElectRetryPeriod,
+		},
 	}
 
-	// Start operator metrics.
-	go operator.StartMetricsListener(opts.MetricsListenAddr, signal)
+	log.Info("registering Prometheus metrics for operator")
+	if err := operator.RegisterMetrics(); err != nil {
+		log.Error(err, "unable to register metrics for operator")
+	}
+	log.Info("registering Prometheus metrics for operator client")
+	if err := operatorclient.RegisterMetrics(); err != nil {
+		log.Error(err, "unable to register metrics for operator client")
+	}
 	log.Info("registering Prometheus metrics for canary_controller")
 	if err := canarycontroller.RegisterMetrics(); err != nil {
 		log.Error(err, "unable to register metrics for canary_controller")
 	}
+	log.Info("registering Prometheus metrics for dns_controller")
+	if err := dnscontroller.RegisterMetrics(); err != nil {
+		log.Error(err, "unable to register metrics for dns_controller")
+	}
 	log.Info("registering Prometheus metrics for ingress_controller")
 	if err := ingresscontroller.RegisterMetrics(); err != nil {
 		log.Error(err, "unable to register metrics for ingress_controller")
 	}
+	log.Info("registering Prometheus metrics for status_controller")
+	if err := statuscontroller.RegisterMetrics(); err != nil {
+		log.Error(err, "unable to register metrics for status_controller")
+	}
 	log.Info("registering Prometheus metrics for route_metrics_controller")
 	if err := routemetricscontroller.RegisterMetrics(); err != nil {
 		log.Error(err, "unable to register metrics for route_metrics_controller")
@@ -194,11 +280,46 @@ func start(opts *StartOptions) error {
 		}
 	}()
 
-	// Set up and start the operator.
+	if len(opts.TrustedCABundle) > 0 {
+		operatorConfig.TrustedCABundle, err = trustedca.Load(opts.TrustedCABundle)
+		if err != nil {
+			return fmt.Errorf("failed to load trusted CA bundle %q: %v", opts.TrustedCABundle, err)
+		}
+	}
+
+	// Set up the operator.
 	op, err := operator.New(operatorConfig, kubeConfig)
 	if err != nil {
 		return fmt.Errorf("failed to create operator: %v", err)
 	}
+
+	if len(opts.TrustedCABundle) > 0 {
+		caWatcher, err := fsnotify.NewWatcher()
+		if err != nil {
+			return fmt.Errorf("failed to create watcher: %v", err)
+		}
+		defer func() {
+			if err := caWatcher.Close(); err != nil {
+				log.V(1).Info("warning: watcher close returned an error: %v", err)
+			}
+		}()
+		if err := caWatcher.Add(opts.TrustedCABundle); err != nil {
+			return fmt.Errorf("failed to add file %q to watcher: %v", opts.TrustedCABundle, err)
+		}
+		log.Info("watching trusted CA bundle", "filename", opts.TrustedCABundle)
+		go reloadTrustedCABundleOnChange(signal, cancel, caWatcher, opts.TrustedCABundle, op)
+	}
+
+	// Start operator metrics, and profiling if it is enabled.
+	go operator.StartMetricsListener(opts.MetricsListenAddr, signal, operator.ListenerConfig{EnableProfiling: opts.EnableProfiling})
+
+	// Start the health checks, which the kubelet probes.
+	if len(opts.HealthListenAddr) != 0 {
+		healthzChecks, readyzChecks := op.HealthChecks()
+		go operator.StartHealthListener(opts.HealthListenAddr, signal, healthzChecks, readyzChecks)
+	}
+
+	// Start the operator.
 	return op.Start(signal)
 }
 
@@ -252,3 +373,45 @@ func ensureServicesHaveIdleAnnotation(cl client.Client) error {
 
 	return nil
 }
+
+// reloadTrustedCABundleOnChange reloads the operator's trusted CA bundle each
+// time that the given watcher reports a change to the bundle's file.  If the
+// operator cannot reload the bundle, or cannot watch the file again after the
+// kubelet replaces it, reloadTrustedCABundleOnChange stops the operator so that
+// it restarts and loads the new bundle on startup.
+func reloadTrustedCABundleOnChange(ctx context.Context, cancel context.CancelFunc, watcher *fsnotify.Watcher, filename string, op *operator.Operator) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-watcher.Events:
+			if !ok {
+				log.Info("trusted CA bundle watch events channel closed")
+				cancel()
+				return
+			}
+			// The kubelet updates a configmap volume by replacing a
+			// symlink, which removes the watch on the file, so watch
+			// the file again.
+			if event.Op&(fsnotify.Remove|fsnotify.Rename) != 0 {
+				if err := watcher.Add(filename); err != nil {
+					log.Error(err, "failed to watch trusted CA bundle again, stopping operator", "filename", filename)
+					cancel()
+					return
+				}
+			}
+			if err := op.ReloadTrustedCABundle(); err != nil {
+				log.Error(err, "failed to reload trusted CA bundle, stopping operator", "filename", filename)
+				cancel()
+				return
+			}
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				log.Info("trusted CA bundle watch error channel closed")
+				cancel()
+				return
+			}
+			log.Error(err, "trusted CA bundle watch error")
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/cluster-ingress-operator/pkg/tracing"
+
+	"sigs.k8s.io/controller-runtime/pkg/manager/signals"
+)
+
+type ServeRouterTracingOptions struct {
+	// ListenAddress is the UDP address on which to receive the router's
+	// log records using syslog.
+	ListenAddress string
+	// ConfigFile is the path of the sidecar's configuration file.
+	ConfigFile string
+}
+
+func NewServeRouterTracingCommand() *cobra.Command {
+	var options ServeRouterTracingOptions
+
+	var command = &cobra.Command{
+		Use:   tracing.ServeCommand,
+		Short: "Export router spans using OTLP",
+		Long: tracing.ServeCommand + ` runs the router tracing sidecar, which receives the
+router's log records using syslog and exports a span for each request in a
+sampled trace to the OTLP/HTTP endpoint that the configuration file specifies.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := tracing.Serve(signals.SetupSignalHandler(), options.ListenAddress, options.ConfigFile); err != nil {
+				log.Error(err, "error serving router tracing")
+				os.Exit(1)
+			}
+		},
+	}
+
+	command.Flags().StringVarP(&options.ListenAddress, "listen-address", "", "127.0.0.1:1515", "UDP address on which to receive the router's log records.")
+	command.Flags().StringVarP(&options.ConfigFile, "config", "", "", "path of the configuration file.")
+	if err := command.MarkFlagRequired("config"); err != nil {
+		panic(err)
+	}
+
+	return command
+}
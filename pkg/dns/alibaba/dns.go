@@ -7,6 +7,7 @@ import (
 	iov1 "github.com/openshift/api/operatoringress/v1"
 	"github.com/openshift/cluster-ingress-operator/pkg/dns"
 	logf "github.com/openshift/cluster-ingress-operator/pkg/log"
+	"net/http"
 	"strings"
 )
 
@@ -33,6 +34,10 @@ type Config struct {
 	Region       string
 	AccessKeyID  string
 	AccessSecret string
+	// Transport, if not nil, is the HTTP transport that the provider uses
+	// to communicate with the Alibaba Cloud API.  If nil, the provider
+	// uses the SDK's default transport.
+	Transport http.RoundTripper
 }
 
 type ZoneInfo struct {
@@ -55,6 +60,9 @@ func NewProvider(config Config) (dns.Provider, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to create alibabacloud api service: %w", err)
 	}
+	if config.Transport != nil {
+		sdkClient.SetTransport(config.Transport)
+	}
 
 	client := NewClient(sdkClient, config.Region)
 	return &provider{
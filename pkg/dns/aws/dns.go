@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"errors"
 	"fmt"
 	"reflect"
 	"regexp"
@@ -46,6 +47,7 @@ const (
 
 var (
 	_   dns.Provider = &Provider{}
+	_   dns.Lister   = &Provider{}
 	log              = logf.Logger.WithName("dns")
 
 	hostedZoneIDRegex = regexp.MustCompile("^/?hostedzone/([^/]+)$")
@@ -325,10 +327,10 @@ func (m *Provider) lookupZoneID(zoneConfig configv1.DNSZone) (string, error) {
 		TagFilters:          tagFilters,
 	}, f)
 	if err := kerrors.NewAggregate([]error{innerError, outerError}); err != nil {
-		return id, fmt.Errorf("failed to get tagged resources: %v", err)
+		return id, fmt.Errorf("failed to get tagged resources: %w", err)
 	}
 	if len(id) == 0 {
-		return id, fmt.Errorf("no matching hosted zone found")
+		return id, dns.NewError(dns.ErrorReasonZoneNotFound, fmt.Errorf("no matching hosted zone found"))
 	}
 	return id, nil
 }
@@ -368,10 +370,10 @@ func (m *Provider) lookupZoneIDWithoutResourceTagging(zoneConfig configv1.DNSZon
 		searchZones,
 	)
 	if err := kerrors.NewAggregate([]error{innerError, outerError}); err != nil {
-		return id, fmt.Errorf("failed to get tagged resources: %v", err)
+		return id, fmt.Errorf("failed to get tagged resources: %w", err)
 	}
 	if len(id) == 0 {
-		return id, fmt.Errorf("no matching hosted zone found")
+		return id, dns.NewError(dns.ErrorReasonZoneNotFound, fmt.Errorf("no matching hosted zone found"))
 	}
 	return id, nil
 }
@@ -433,7 +435,7 @@ func (m *Provider) getLBHostedZone(name string) (string, error) {
 	}
 	err := m.elb.DescribeLoadBalancersPages(&elb.DescribeLoadBalancersInput{}, elbFn)
 	if err != nil {
-		return "", fmt.Errorf("failed to describe classic load balancers: %v", err)
+		return "", fmt.Errorf("failed to describe classic load balancers: %w", err)
 	}
 	if len(id) == 0 {
 		elbv2Fn := func(resp *elbv2.DescribeLoadBalancersOutput, lastPage bool) (shouldContinue bool) {
@@ -450,11 +452,11 @@ func (m *Provider) getLBHostedZone(name string) (string, error) {
 		}
 		err := m.elbv2.DescribeLoadBalancersPages(&elbv2.DescribeLoadBalancersInput{}, elbv2Fn)
 		if err != nil {
-			return "", fmt.Errorf("failed to describe network load balancers: %v", err)
+			return "", fmt.Errorf("failed to describe network load balancers: %w", err)
 		}
 	}
 	if len(id) == 0 {
-		return "", fmt.Errorf("couldn't find hosted zone ID of ELB %s", name)
+		return "", dns.NewError(dns.ErrorReasonInvalidTarget, fmt.Errorf("couldn't find hosted zone ID of ELB %s", name))
 	}
 	log.V(2).Info("associating load balancer with hosted zone", "dns name", name, "zone", id)
 	m.lbZones[name] = id
@@ -480,6 +482,55 @@ func (m *Provider) Replace(record *iov1.DNSRecord, zone configv1.DNSZone) error
 	return m.change(record, zone, upsertAction)
 }
 
+// List returns the A and CNAME records in the given zone.  Route 53 does not
+// support tagging individual records, so the returned records never have an
+// owner ID.
+func (m *Provider) List(zone configv1.DNSZone) ([]dns.Record, error) {
+	zoneID, err := m.getZoneID(zone)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find hosted zone: %v", err)
+	}
+
+	var records []dns.Record
+	f := func(resp *route53.ListResourceRecordSetsOutput, lastPage bool) (shouldContinue bool) {
+		for _, rrs := range resp.ResourceRecordSets {
+			var recordType iov1.DNSRecordType
+			switch aws.StringValue(rrs.Type) {
+			case route53.RRTypeA:
+				// The operator publishes CNAME DNSRecords as alias A
+				// records (see updateRecord).
+				recordType = iov1.ARecordType
+				if rrs.AliasTarget != nil {
+					recordType = iov1.CNAMERecordType
+				}
+			case route53.RRTypeCname:
+				recordType = iov1.CNAMERecordType
+			default:
+				continue
+			}
+			record := dns.Record{
+				// Route 53 escapes "*" as "\052" in record names.
+				DNSName:    strings.ReplaceAll(aws.StringValue(rrs.Name), "\\052", "*"),
+				RecordType: recordType,
+			}
+			if rrs.AliasTarget != nil {
+				record.Targets = append(record.Targets, strings.TrimSuffix(aws.StringValue(rrs.AliasTarget.DNSName), "."))
+			}
+			for _, rr := range rrs.ResourceRecords {
+				record.Targets = append(record.Targets, aws.StringValue(rr.Value))
+			}
+			records = append(records, record)
+		}
+		return true
+	}
+	if err := m.route53.ListResourceRecordSetsPages(&route53.ListResourceRecordSetsInput{
+		HostedZoneId: aws.String(zoneID),
+	}, f); err != nil {
+		return nil, fmt.Errorf("failed to list records in zone %s: %v", zoneID, err)
+	}
+	return records, nil
+}
+
 // change will perform an action on a record. The target must correspond to the
 // hostname of an ELB which will be automatically discovered.
 func (m *Provider) change(record *iov1.DNSRecord, zone configv1.DNSZone, action action) error {
@@ -497,19 +548,19 @@ func (m *Provider) change(record *iov1.DNSRecord, zone configv1.DNSZone, action
 
 	zoneID, err := m.getZoneID(zone)
 	if err != nil {
-		return fmt.Errorf("failed to find hosted zone for record: %v", err)
+		return classifyError(fmt.Errorf("failed to find hosted zone for record: %w", err))
 	}
 
 	// Find the target hosted zone of the load balancer attached to the service.
 	targetHostedZoneID, err := m.getLBHostedZone(target)
 	if err != nil {
-		return fmt.Errorf("failed to get hosted zone for load balancer target %q: %v", target, err)
+		return classifyError(fmt.Errorf("failed to get hosted zone for load balancer target %q: %w", target, err))
 	}
 
 	// Configure records.
 	err = m.updateRecord(domain, zoneID, target, targetHostedZoneID, string(action), record.Spec.RecordTTL)
 	if err != nil {
-		return fmt.Errorf("failed to update alias in zone %s: %v", zoneID, err)
+		return classifyError(fmt.Errorf("failed to update alias in zone %s: %w", zoneID, err))
 	}
 	switch action {
 	case upsertAction:
@@ -570,7 +621,7 @@ func (m *Provider) updateRecord(domain, zoneID, target, targetHostedZoneID, acti
 				}
 			}
 		}
-		return fmt.Errorf("couldn't update DNS record in zone %s: %v", zoneID, err)
+		return fmt.Errorf("couldn't update DNS record in zone %s: %w", zoneID, err)
 	}
 	log.Info("updated DNS record", "zone id", zoneID, "domain", domain, "target", target, "response", resp)
 	return nil
@@ -581,3 +632,39 @@ func (m *Provider) updateRecord(domain, zoneID, target, targetHostedZoneID, acti
 func clientEndpointIsGovCloud(clientInfo *metadata.ClientInfo) bool {
 	return strings.Contains(clientInfo.Endpoint, govCloudRoute53Region)
 }
+
+// classifyError classifies the given error, which may wrap an error returned by
+// the AWS SDK.  Errors that the provider has already classified are returned
+// unchanged.
+func classifyError(err error) error {
+	var dnsErr *dns.Error
+	if err == nil || errors.As(err, &dnsErr) {
+		return err
+	}
+	var aerr awserr.Error
+	if !errors.As(err, &aerr) {
+		return err
+	}
+	switch aerr.Code() {
+	case "AccessDenied", "AccessDeniedException", "UnrecognizedClientException", "InvalidClientTokenId",
+		"SignatureDoesNotMatch", "IncompleteSignature", "MissingAuthenticationToken", "ExpiredToken",
+		"ExpiredTokenException":
+		return dns.NewError(dns.ErrorReasonAuthFailure, err)
+	case route53.ErrCodeNoSuchHostedZone:
+		return dns.NewError(dns.ErrorReasonZoneNotFound, err)
+	case route53.ErrCodeThrottlingException, route53.ErrCodePriorRequestNotComplete:
+		return dns.NewError(dns.ErrorReasonThrottled, err)
+	case route53.ErrCodeInvalidChangeBatch, route53.ErrCodeInvalidInput:
+		return dns.NewError(dns.ErrorReasonInvalidTarget, err)
+	}
+	var reqErr awserr.RequestFailure
+	switch {
+	case request.IsErrorThrottle(aerr):
+		return dns.NewError(dns.ErrorReasonThrottled, err)
+	case request.IsErrorRetryable(aerr):
+		return dns.NewError(dns.ErrorReasonTransient, err)
+	case errors.As(err, &reqErr):
+		return dns.NewError(dns.ReasonForHTTPStatus(reqErr.StatusCode()), err)
+	}
+	return err
+}
@@ -1,13 +1,16 @@
 package aws
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/aws/aws-sdk-go/service/route53"
 	configv1 "github.com/openshift/api/config/v1"
+	"github.com/openshift/cluster-ingress-operator/pkg/dns"
 )
 
 func TestZoneMatchesTags(t *testing.T) {
@@ -133,3 +136,58 @@ func TestZoneIDFromResource(t *testing.T) {
 		})
 	}
 }
+
+func TestClassifyError(t *testing.T) {
+	cases := []struct {
+		name     string
+		err      error
+		expected dns.ErrorReason
+	}{
+		{
+			name:     "access denied",
+			err:      awserr.New("AccessDenied", "User is not authorized to perform route53:ChangeResourceRecordSets", nil),
+			expected: dns.ErrorReasonAuthFailure,
+		},
+		{
+			name:     "no such hosted zone",
+			err:      awserr.New(route53.ErrCodeNoSuchHostedZone, "No hosted zone found with ID: Z123", nil),
+			expected: dns.ErrorReasonZoneNotFound,
+		},
+		{
+			name:     "throttling",
+			err:      awserr.New("Throttling", "Rate exceeded", nil),
+			expected: dns.ErrorReasonThrottled,
+		},
+		{
+			name:     "invalid change batch",
+			err:      awserr.New(route53.ErrCodeInvalidChangeBatch, "Tried to create an alias that targets a nonexistent load balancer", nil),
+			expected: dns.ErrorReasonInvalidTarget,
+		},
+		{
+			name:     "service unavailable",
+			err:      awserr.NewRequestFailure(awserr.New("ServiceUnavailable", "Service is unavailable", nil), 503, "request-id"),
+			expected: dns.ErrorReasonTransient,
+		},
+		{
+			name:     "wrapped error",
+			err:      fmt.Errorf("failed to update alias in zone Z123: %w", awserr.New(route53.ErrCodeNoSuchHostedZone, "No hosted zone found", nil)),
+			expected: dns.ErrorReasonZoneNotFound,
+		},
+		{
+			name:     "already classified",
+			err:      dns.NewError(dns.ErrorReasonInvalidTarget, fmt.Errorf("couldn't find hosted zone ID of ELB")),
+			expected: dns.ErrorReasonInvalidTarget,
+		},
+		{
+			name:     "unknown error",
+			err:      fmt.Errorf("something went wrong"),
+			expected: dns.ErrorReasonUnknown,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, dns.ReasonForError(classifyError(tc.err)))
+		})
+	}
+}
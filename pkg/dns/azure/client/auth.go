@@ -1,6 +1,8 @@
 package client
 
 import (
+	"net/http"
+
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/adal"
 )
@@ -17,5 +19,8 @@ func getAuthorizerForResource(config Config) (autorest.Authorizer, error) {
 	if err != nil {
 		return nil, err
 	}
+	if config.Transport != nil {
+		token.SetSender(&http.Client{Transport: config.Transport})
+	}
 	return autorest.NewBearerAuthorizer(token), err
 }
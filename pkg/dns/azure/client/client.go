@@ -2,6 +2,8 @@ package client
 
 import (
 	"context"
+	"net/http"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/profiles/2018-03-01/dns/mgmt/dns"
 	privatedns "github.com/Azure/azure-sdk-for-go/services/privatedns/mgmt/2018-09-01/privatedns"
@@ -12,6 +14,7 @@ import (
 type DNSClient interface {
 	Put(ctx context.Context, zone Zone, arec ARecord) error
 	Delete(ctx context.Context, zone Zone, arec ARecord) error
+	List(ctx context.Context, zone Zone) ([]ARecord, error)
 }
 
 type Config struct {
@@ -20,6 +23,10 @@ type Config struct {
 	ClientID       string
 	ClientSecret   string
 	TenantID       string
+	// Transport, if not nil, is the HTTP transport that the client uses
+	// to communicate with the Azure API and to get tokens.  If nil, the
+	// client uses the SDK's default transport.
+	Transport http.RoundTripper
 }
 
 // ARecord is a DNS A record.
@@ -78,6 +85,28 @@ func (c *dnsClient) Delete(ctx context.Context, zone Zone, arec ARecord) error {
 	}
 }
 
+func (c *dnsClient) List(ctx context.Context, zone Zone) ([]ARecord, error) {
+	switch zone.Provider {
+	case "Microsoft.Network/privateDnsZones":
+		return c.privateRecordSetClient.List(ctx, zone)
+	case "Microsoft.Network/dnszones":
+		return c.recordSetClient.List(ctx, zone)
+	default:
+		return nil, errors.Errorf("unsupported Zone provider %s", zone.Provider)
+	}
+}
+
+// labelFromMetadata returns the first metadata key that marks a record as
+// owned by a cluster, or the empty string if there is none.
+func labelFromMetadata(metadata map[string]*string) string {
+	for k, v := range metadata {
+		if v != nil && *v == "owned" && strings.HasPrefix(k, "kubernetes.io_cluster.") {
+			return k
+		}
+	}
+	return ""
+}
+
 type recordSetClient struct {
 	client dns.RecordSetsClient
 }
@@ -91,6 +120,9 @@ func newRecordSetClient(config Config, userAgentExtension string) (*recordSetCli
 	rc := dns.NewRecordSetsClientWithBaseURI(config.Environment.ResourceManagerEndpoint, config.SubscriptionID)
 	rc.AddToUserAgent(userAgentExtension)
 	rc.Authorizer = authorizer
+	if config.Transport != nil {
+		rc.Sender = &http.Client{Transport: config.Transport}
+	}
 	return &recordSetClient{client: rc}, nil
 }
 
@@ -127,6 +159,32 @@ func (c *recordSetClient) Delete(ctx context.Context, zone Zone, arec ARecord) e
 	return nil
 }
 
+func (c *recordSetClient) List(ctx context.Context, zone Zone) ([]ARecord, error) {
+	var arecs []ARecord
+	iter, err := c.client.ListByTypeComplete(ctx, zone.ResourceGroup, zone.Name, dns.A, nil, "")
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list dns a records in zone %s", zone.Name)
+	}
+	for ; iter.NotDone(); err = iter.NextWithContext(ctx) {
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to list dns a records in zone %s", zone.Name)
+		}
+		rs := iter.Value()
+		if rs.RecordSetProperties == nil || rs.Name == nil {
+			continue
+		}
+		arec := ARecord{Name: *rs.Name, Label: labelFromMetadata(rs.Metadata)}
+		if rs.TTL != nil {
+			arec.TTL = *rs.TTL
+		}
+		if rs.ARecords != nil && len(*rs.ARecords) > 0 && (*rs.ARecords)[0].Ipv4Address != nil {
+			arec.Address = *(*rs.ARecords)[0].Ipv4Address
+		}
+		arecs = append(arecs, arec)
+	}
+	return arecs, nil
+}
+
 type privateRecordSetClient struct {
 	client privatedns.RecordSetsClient
 }
@@ -140,6 +198,9 @@ func newPrivateRecordSetClient(config Config, userAgentExtension string) (*priva
 	prc := privatedns.NewRecordSetsClientWithBaseURI(config.Environment.ResourceManagerEndpoint, config.SubscriptionID)
 	prc.AddToUserAgent(userAgentExtension)
 	prc.Authorizer = authorizer
+	if config.Transport != nil {
+		prc.Sender = &http.Client{Transport: config.Transport}
+	}
 	return &privateRecordSetClient{client: prc}, nil
 }
 
@@ -171,3 +232,29 @@ func (c *privateRecordSetClient) Delete(ctx context.Context, zone Zone, arec ARe
 	}
 	return nil
 }
+
+func (c *privateRecordSetClient) List(ctx context.Context, zone Zone) ([]ARecord, error) {
+	var arecs []ARecord
+	iter, err := c.client.ListByTypeComplete(ctx, zone.ResourceGroup, zone.Name, privatedns.A, nil, "")
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list dns a records in zone %s", zone.Name)
+	}
+	for ; iter.NotDone(); err = iter.NextWithContext(ctx) {
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to list dns a records in zone %s", zone.Name)
+		}
+		rs := iter.Value()
+		if rs.RecordSetProperties == nil || rs.Name == nil {
+			continue
+		}
+		arec := ARecord{Name: *rs.Name, Label: labelFromMetadata(rs.Metadata)}
+		if rs.TTL != nil {
+			arec.TTL = *rs.TTL
+		}
+		if rs.ARecords != nil && len(*rs.ARecords) > 0 && (*rs.ARecords)[0].Ipv4Address != nil {
+			arec.Address = *(*rs.ARecords)[0].Ipv4Address
+		}
+		arecs = append(arecs, arec)
+	}
+	return arecs, nil
+}
@@ -6,22 +6,36 @@ import (
 
 type FakeDNSClient struct {
 	fakeARM map[string]string
+	records map[string]map[string]ARecord
 }
 
 func NewFake(config Config) (*FakeDNSClient, error) {
-	return &FakeDNSClient{fakeARM: map[string]string{}}, nil
+	return &FakeDNSClient{fakeARM: map[string]string{}, records: map[string]map[string]ARecord{}}, nil
 }
 
 func (c *FakeDNSClient) Put(ctx context.Context, zone Zone, arec ARecord) error {
 	c.fakeARM[zone.ResourceGroup+zone.Name+arec.Name] = "PUT"
+	if c.records[zone.ResourceGroup+zone.Name] == nil {
+		c.records[zone.ResourceGroup+zone.Name] = map[string]ARecord{}
+	}
+	c.records[zone.ResourceGroup+zone.Name][arec.Name] = arec
 	return nil
 }
 
 func (c *FakeDNSClient) Delete(ctx context.Context, zone Zone, arec ARecord) error {
 	c.fakeARM[zone.ResourceGroup+zone.Name+arec.Name] = "DELETE"
+	delete(c.records[zone.ResourceGroup+zone.Name], arec.Name)
 	return nil
 }
 
+func (c *FakeDNSClient) List(ctx context.Context, zone Zone) ([]ARecord, error) {
+	var arecs []ARecord
+	for _, arec := range c.records[zone.ResourceGroup+zone.Name] {
+		arecs = append(arecs, arec)
+	}
+	return arecs, nil
+}
+
 func (c *FakeDNSClient) RecordedCall(rg, zone, rel string) (string, bool) {
 	call, ok := c.fakeARM[rg+zone+rel]
 	return call, ok
@@ -3,8 +3,10 @@ package azure
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"strings"
 
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/pkg/errors"
 
@@ -18,6 +20,7 @@ import (
 
 var (
 	_   dns.Provider = &provider{}
+	_   dns.Lister   = &provider{}
 	log              = logf.Logger.WithName("dns")
 )
 
@@ -38,6 +41,10 @@ type Config struct {
 	ARMEndpoint string
 	// InfraID is the generated ID that is used to identify cloud resources created by the installer.
 	InfraID string
+	// Transport, if not nil, is the HTTP transport that the provider uses
+	// to communicate with the Azure API.  If nil, the provider uses the
+	// SDK's default transport.
+	Transport http.RoundTripper
 }
 
 type provider struct {
@@ -66,6 +73,7 @@ func NewProvider(config Config, operatorReleaseVersion string) (dns.Provider, er
 		ClientID:       config.ClientID,
 		ClientSecret:   config.ClientSecret,
 		TenantID:       config.TenantID,
+		Transport:      config.Transport,
 	}, userAgent(operatorReleaseVersion))
 	if err != nil {
 		return nil, err
@@ -108,7 +116,7 @@ func (m *provider) Ensure(record *iov1.DNSRecord, zone configv1.DNSZone) error {
 		log.Info("upserted DNS record", "record", record.Spec, "zone", zone)
 	}
 
-	return err
+	return classifyError(err)
 }
 
 func (m *provider) Delete(record *iov1.DNSRecord, zone configv1.DNSZone) error {
@@ -136,13 +144,44 @@ func (m *provider) Delete(record *iov1.DNSRecord, zone configv1.DNSZone) error {
 		log.Info("deleted DNS record", "record", record.Spec, "zone", zone)
 	}
 
-	return err
+	return classifyError(err)
 }
 
 func (m *provider) Replace(record *iov1.DNSRecord, zone configv1.DNSZone) error {
 	return m.Ensure(record, zone)
 }
 
+// List returns the A records in the given zone.  Records that the operator
+// has tagged with a cluster's infrastructure ID have that ID as their owner ID.
+func (m *provider) List(zone configv1.DNSZone) ([]dns.Record, error) {
+	targetZone, err := client.ParseZone(zone.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse zoneID")
+	}
+
+	arecs, err := m.client.List(context.TODO(), *targetZone)
+	if err != nil {
+		return nil, err
+	}
+
+	var records []dns.Record
+	for _, arec := range arecs {
+		record := dns.Record{
+			DNSName:    fmt.Sprintf("%s.%s.", arec.Name, targetZone.Name),
+			RecordType: iov1.ARecordType,
+			OwnerID:    strings.TrimPrefix(arec.Label, "kubernetes.io_cluster."),
+		}
+		if arec.Name == "@" {
+			record.DNSName = targetZone.Name + "."
+		}
+		if arec.Address != "" {
+			record.Targets = []string{arec.Address}
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
 // getARecordName extracts the ARecord subdomain name from the full domain string.
 // Azure defines the ARecord Name as the subdomain name only.
 // This function logs a message if recordDomain is not a subdomain of zoneName.
@@ -154,3 +193,16 @@ func getARecordName(recordDomain string, zoneName string) (string, error) {
 	}
 	return strings.TrimSuffix(trimmedDomain, "."+zoneName), nil
 }
+
+// classifyError classifies the given error, which may wrap an error returned by
+// the Azure SDK, using the HTTP status code of the failed request.
+func classifyError(err error) error {
+	var derr autorest.DetailedError
+	if err == nil || !errors.As(err, &derr) {
+		return err
+	}
+	if code, ok := derr.StatusCode.(int); ok {
+		return dns.NewError(dns.ReasonForHTTPStatus(code), err)
+	}
+	return err
+}
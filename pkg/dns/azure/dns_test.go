@@ -1,6 +1,7 @@
 package azure_test
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/pkg/errors"
@@ -96,3 +97,42 @@ func TestDeleteDNS(t *testing.T) {
 		t.Fatalf("expected the dns client 'Delete' func to be called, but found %s instead", recordedCall)
 	}
 }
+
+func TestListDNS(t *testing.T) {
+	fc, err := client.NewFake(client.Config{})
+	if err != nil {
+		t.Fatalf("failed to create fake client: %v", err)
+	}
+	mgr, err := azure.NewFakeProvider(azure.Config{InfraID: "mycluster-xyz12"}, fc)
+	if err != nil {
+		t.Fatalf("failed to create manager: %v", err)
+	}
+	record := iov1.DNSRecord{
+		Spec: iov1.DNSRecordSpec{
+			DNSName:    "*.apps.dnszone.io.",
+			RecordType: iov1.ARecordType,
+			Targets:    []string{"55.11.22.33"},
+			RecordTTL:  120,
+		},
+	}
+	dnsZone := configv1.DNSZone{
+		ID: "/subscriptions/E540B02D-5CCE-4D47-A13B-EB05A19D696E/resourceGroups/test-rg/providers/Microsoft.Network/dnszones/dnszone.io",
+	}
+	if err := mgr.Ensure(&record, dnsZone); err != nil {
+		t.Fatalf("failed to ensure dns: %v", err)
+	}
+
+	records, err := mgr.(dns.Lister).List(dnsZone)
+	if err != nil {
+		t.Fatalf("failed to list dns: %v", err)
+	}
+	expected := []dns.Record{{
+		DNSName:    "*.apps.dnszone.io.",
+		RecordType: iov1.ARecordType,
+		Targets:    []string{"55.11.22.33"},
+		OwnerID:    "mycluster-xyz12",
+	}}
+	if !reflect.DeepEqual(records, expected) {
+		t.Fatalf("expected records %+v, got %+v", expected, records)
+	}
+}
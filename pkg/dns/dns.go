@@ -18,10 +18,37 @@ type Provider interface {
 	Replace(record *iov1.DNSRecord, zone configv1.DNSZone) error
 }
 
-var _ Provider = &FakeProvider{}
+// Lister is implemented by providers that can enumerate the records in a
+// zone.  Providers that do not implement Lister are skipped when zones are
+// audited for records that the cluster owns but no longer manages.
+type Lister interface {
+	// List returns the A and CNAME records in the given zone.
+	List(zone configv1.DNSZone) ([]Record, error)
+}
+
+// Record describes a record as it exists in a DNS zone.
+type Record struct {
+	// DNSName is the fully qualified domain name of the record, with a
+	// trailing dot.
+	DNSName string
+	// RecordType is the type of the record.
+	RecordType iov1.DNSRecordType
+	// Targets are the values of the record.
+	Targets []string
+	// OwnerID is the infrastructure ID of the cluster with which the
+	// provider has tagged the record.  OwnerID is empty if the provider
+	// does not support tagging records or if the record is untagged.
+	OwnerID string
+}
+
+var (
+	_ Provider = &FakeProvider{}
+	_ Lister   = &FakeProvider{}
+)
 
 type FakeProvider struct{}
 
 func (_ *FakeProvider) Ensure(record *iov1.DNSRecord, zone configv1.DNSZone) error  { return nil }
 func (_ *FakeProvider) Delete(record *iov1.DNSRecord, zone configv1.DNSZone) error  { return nil }
 func (_ *FakeProvider) Replace(record *iov1.DNSRecord, zone configv1.DNSZone) error { return nil }
+func (_ *FakeProvider) List(zone configv1.DNSZone) ([]Record, error)                { return nil, nil }
@@ -0,0 +1,89 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+)
+
+// ErrorReason is a classification of an error returned by a DNS provider.  The
+// reason is used as the reason of a DNSRecord's zone condition when publishing
+// the record to the zone fails.
+type ErrorReason string
+
+const (
+	// ErrorReasonAuthFailure means the provider rejected the operator's
+	// credentials or the credentials lack the required permissions.
+	ErrorReasonAuthFailure ErrorReason = "AuthFailure"
+	// ErrorReasonZoneNotFound means the provider could not find the zone.
+	ErrorReasonZoneNotFound ErrorReason = "ZoneNotFound"
+	// ErrorReasonThrottled means the provider is rate-limiting requests.
+	ErrorReasonThrottled ErrorReason = "Throttled"
+	// ErrorReasonInvalidTarget means the provider rejected the record,
+	// typically because its target does not exist or is malformed.
+	ErrorReasonInvalidTarget ErrorReason = "InvalidTarget"
+	// ErrorReasonTransient means the request failed for a reason that is
+	// expected to resolve itself, such as a network error or a server
+	// error.
+	ErrorReasonTransient ErrorReason = "TransientError"
+	// ErrorReasonUnknown means the error could not be classified.
+	ErrorReasonUnknown ErrorReason = "ProviderError"
+)
+
+// Error is an error returned by a DNS provider that the provider has
+// classified.
+type Error struct {
+	// Reason is the classification of the error.
+	Reason ErrorReason
+	// Err is the underlying error.
+	Err error
+}
+
+func (e *Error) Error() string { return e.Err.Error() }
+
+func (e *Error) Unwrap() error { return e.Err }
+
+// NewError returns an error with the given classification wrapping the given
+// error, or nil if err is nil.
+func NewError(reason ErrorReason, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &Error{Reason: reason, Err: err}
+}
+
+// ReasonForError returns the classification of the given error.  If a provider
+// has classified the error, that classification is used; otherwise, network
+// errors and timeouts are classified as transient, and anything else is
+// unknown.
+func ReasonForError(err error) ErrorReason {
+	var dnsErr *Error
+	if errors.As(err, &dnsErr) {
+		return dnsErr.Reason
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) || errors.Is(err, context.DeadlineExceeded) {
+		return ErrorReasonTransient
+	}
+	return ErrorReasonUnknown
+}
+
+// ReasonForHTTPStatus returns the classification of an error for a provider
+// API request that failed with the given HTTP status code.
+func ReasonForHTTPStatus(code int) ErrorReason {
+	switch {
+	case code == http.StatusUnauthorized, code == http.StatusForbidden:
+		return ErrorReasonAuthFailure
+	case code == http.StatusNotFound:
+		return ErrorReasonZoneNotFound
+	case code == http.StatusTooManyRequests:
+		return ErrorReasonThrottled
+	case code == http.StatusBadRequest, code == http.StatusUnprocessableEntity:
+		return ErrorReasonInvalidTarget
+	case code >= http.StatusInternalServerError:
+		return ErrorReasonTransient
+	default:
+		return ErrorReasonUnknown
+	}
+}
@@ -0,0 +1,63 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestReasonForError(t *testing.T) {
+	cases := []struct {
+		name     string
+		err      error
+		expected ErrorReason
+	}{
+		{
+			name:     "classified error",
+			err:      NewError(ErrorReasonAuthFailure, fmt.Errorf("unauthorized")),
+			expected: ErrorReasonAuthFailure,
+		},
+		{
+			name:     "wrapped classified error",
+			err:      fmt.Errorf("failed to publish: %w", NewError(ErrorReasonThrottled, fmt.Errorf("rate exceeded"))),
+			expected: ErrorReasonThrottled,
+		},
+		{
+			name:     "deadline exceeded",
+			err:      fmt.Errorf("request failed: %w", context.DeadlineExceeded),
+			expected: ErrorReasonTransient,
+		},
+		{
+			name:     "unclassified error",
+			err:      fmt.Errorf("something went wrong"),
+			expected: ErrorReasonUnknown,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if actual := ReasonForError(tc.err); actual != tc.expected {
+				t.Errorf("expected %q, got %q", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestReasonForHTTPStatus(t *testing.T) {
+	cases := map[int]ErrorReason{
+		http.StatusUnauthorized:        ErrorReasonAuthFailure,
+		http.StatusForbidden:           ErrorReasonAuthFailure,
+		http.StatusNotFound:            ErrorReasonZoneNotFound,
+		http.StatusTooManyRequests:     ErrorReasonThrottled,
+		http.StatusBadRequest:          ErrorReasonInvalidTarget,
+		http.StatusServiceUnavailable:  ErrorReasonTransient,
+		http.StatusInternalServerError: ErrorReasonTransient,
+		http.StatusConflict:            ErrorReasonUnknown,
+	}
+	for code, expected := range cases {
+		if actual := ReasonForHTTPStatus(code); actual != expected {
+			t.Errorf("status %d: expected %q, got %q", code, expected, actual)
+		}
+	}
+}
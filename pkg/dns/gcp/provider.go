@@ -4,6 +4,7 @@ import (
 	"context"
 	"net/http"
 
+	"golang.org/x/oauth2"
 	"google.golang.org/api/googleapi"
 
 	configv1 "github.com/openshift/api/config/v1"
@@ -14,6 +15,7 @@ import (
 
 	gdnsv1 "google.golang.org/api/dns/v1"
 	"google.golang.org/api/option"
+	htransport "google.golang.org/api/transport/http"
 )
 
 var (
@@ -32,10 +34,26 @@ type Config struct {
 	Project         string
 	UserAgent       string
 	CredentialsJSON []byte
+	// Transport, if not nil, is the HTTP transport that the provider uses
+	// to communicate with the GCP API and to get tokens.  If nil, the
+	// provider uses the SDK's default transport.
+	Transport http.RoundTripper
 }
 
 func New(config Config) (*Provider, error) {
-	dnsService, err := gdnsv1.NewService(context.TODO(), option.WithCredentialsJSON(config.CredentialsJSON), option.WithUserAgent(config.UserAgent))
+	ctx := context.TODO()
+	opts := []option.ClientOption{option.WithCredentialsJSON(config.CredentialsJSON), option.WithUserAgent(config.UserAgent)}
+	if config.Transport != nil {
+		// Use the transport for token requests as well as for API
+		// requests.
+		ctx = context.WithValue(ctx, oauth2.HTTPClient, &http.Client{Transport: config.Transport})
+		transport, err := htransport.NewTransport(ctx, config.Transport, opts...)
+		if err != nil {
+			return nil, err
+		}
+		opts = []option.ClientOption{option.WithHTTPClient(&http.Client{Transport: transport})}
+	}
+	dnsService, err := gdnsv1.NewService(ctx, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -56,7 +74,7 @@ func (p *Provider) Ensure(record *iov1.DNSRecord, zone configv1.DNSZone) error {
 	if ae, ok := err.(*googleapi.Error); ok && ae.Code == http.StatusConflict {
 		return nil
 	}
-	return err
+	return classifyError(err)
 }
 
 func (p *Provider) Replace(record *iov1.DNSRecord, zone configv1.DNSZone) error {
@@ -75,7 +93,7 @@ func (p *Provider) Replace(record *iov1.DNSRecord, zone configv1.DNSZone) error
 		}
 		return nil
 	}); err != nil {
-		return err
+		return classifyError(err)
 	}
 	if err := p.Ensure(record, zone); err != nil {
 		return err
@@ -90,6 +108,15 @@ func (p *Provider) Delete(record *iov1.DNSRecord, zone configv1.DNSZone) error {
 	if ae, ok := err.(*googleapi.Error); ok && ae.Code == http.StatusNotFound {
 		return nil
 	}
+	return classifyError(err)
+}
+
+// classifyError classifies the given error, which may be an error returned by
+// the Google Cloud DNS API, using the HTTP status code of the failed request.
+func classifyError(err error) error {
+	if ae, ok := err.(*googleapi.Error); ok {
+		return dns.NewError(dns.ReasonForHTTPStatus(ae.Code), err)
+	}
 	return err
 }
 
@@ -2,7 +2,11 @@ package common
 
 import (
 	"fmt"
+	"net/http"
 	"regexp"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
 
 	configv1 "github.com/openshift/api/config/v1"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
@@ -27,6 +31,21 @@ type Config struct {
 	UserAgent string
 	// Zones is a list of DNS zones in which the DNS provider manages DNS records.
 	Zones []string
+	// Transport, if not nil, is the HTTP transport that the provider uses
+	// to communicate with the IBM Cloud API and to get tokens.  If nil,
+	// the provider uses the SDK's default transport.
+	Transport http.RoundTripper
+}
+
+// RetryableHTTPClient returns an HTTP client that retries failed requests the
+// same way as the client that the SDK's EnableRetries method configures, and
+// that uses the given transport.
+func RetryableHTTPClient(transport http.RoundTripper, maxRetries int, maxRetryInterval time.Duration) *http.Client {
+	client := core.NewRetryableHTTPClient()
+	client.RetryMax = maxRetries
+	client.RetryWaitMax = maxRetryInterval
+	client.HTTPClient.Transport = transport
+	return client.StandardClient()
 }
 
 // ValidateInputDNSData validates the given record and zone.
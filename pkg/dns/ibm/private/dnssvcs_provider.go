@@ -46,6 +46,9 @@ func NewProvider(config common.Config) (*Provider, error) {
 	authenticator := &core.IamAuthenticator{
 		ApiKey: config.APIKey,
 	}
+	if config.Transport != nil {
+		authenticator.Client = &http.Client{Transport: config.Transport}
+	}
 
 	options := &dnssvcsv1.DnsSvcsV1Options{
 		Authenticator: authenticator,
@@ -57,6 +60,9 @@ func NewProvider(config common.Config) (*Provider, error) {
 		return nil, fmt.Errorf("failed to create a new IBM Cloud DNS Services instance: %w", err)
 	}
 	dnsService.EnableRetries(3, 5*time.Second)
+	if config.Transport != nil {
+		dnsService.Service.SetHTTPClient(common.RetryableHTTPClient(config.Transport, 3, 5*time.Second))
+	}
 	dnsService.Service.SetUserAgent(config.UserAgent)
 
 	provider.dnsService = dnsService
@@ -39,6 +39,9 @@ func NewProvider(config common.Config) (*Provider, error) {
 	authenticator := &core.IamAuthenticator{
 		ApiKey: config.APIKey,
 	}
+	if config.Transport != nil {
+		authenticator.Client = &http.Client{Transport: config.Transport}
+	}
 	provider := &Provider{}
 
 	provider.dnsServices = make(map[string]dnsclient.DnsClient)
@@ -56,6 +59,9 @@ func NewProvider(config common.Config) (*Provider, error) {
 			return nil, fmt.Errorf("failed to create a new IBM CIS DNS instance: %w", err)
 		}
 		dnsService.EnableRetries(3, 5*time.Second)
+		if config.Transport != nil {
+			dnsService.Service.SetHTTPClient(common.RetryableHTTPClient(config.Transport, 3, 5*time.Second))
+		}
 		dnsService.Service.SetUserAgent(config.UserAgent)
 
 		provider.dnsServices[zone] = dnsService
@@ -15,7 +15,7 @@
 // assets/router/service-account.yaml (213B)
 // assets/router/service-cloud.yaml (631B)
 // assets/router/service-internal.yaml (429B)
-// manifests/00-cluster-role.yaml (3.181kB)
+// manifests/00-cluster-role.yaml (3.468kB)
 // manifests/00-custom-resource-definition-internal.yaml (7.756kB)
 // manifests/00-custom-resource-definition.yaml (121.33kB)
 // manifests/00-ingress-credentials-request.yaml (4.824kB)
@@ -26,14 +26,15 @@
 // manifests/0000_90_ingress-operator_03_prometheusrules.yaml (4.051kB)
 // manifests/01-cluster-role-binding.yaml (578B)
 // manifests/01-role-binding.yaml (1.196kB)
-// manifests/01-role.yaml (1.219kB)
+// manifests/01-role.yaml (1.298kB)
 // manifests/01-service-account.yaml (405B)
 // manifests/01-service.yaml (538B)
 // manifests/01-trusted-ca-configmap.yaml (517B)
-// manifests/02-deployment-ibm-cloud-managed.yaml (3.856kB)
-// manifests/02-deployment.yaml (4.288kB)
+// manifests/01-webhook-service.yaml (469B)
+// manifests/02-deployment-ibm-cloud-managed.yaml (4.983kB)
+// manifests/02-deployment.yaml (6.08kB)
 // manifests/03-cluster-operator.yaml (1.047kB)
-// manifests/image-references (435B)
+// manifests/image-references (565B)
 
 package manifests
 
@@ -102,7 +103,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _assetsCanaryDaemonsetYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x54\xc1\x6e\x1b\x47\x0c\xbd\xeb\x2b\x08\x19\x45\x5a\xa0\x52\x94\x1e\x0a\x63\x6e\x81\xed\xa2\x06\x1c\x45\x88\x9c\x5e\x8a\x1e\xe8\xd9\xb7\xd2\x40\xb3\xc3\x29\x87\x23\x5b\x28\xfa\xef\xc5\xae\xa4\x48\x72\xd3\x22\xa7\x9d\x25\xf9\x1e\x39\x8f\xe4\x5c\xd1\xaf\x88\x51\xe8\x63\x46\x2a\xeb\xd0\x1a\xdd\xa7\x95\xa2\x14\xba\xe1\xc4\xba\xa3\x86\xd1\x49\x2a\xb0\xd1\x15\x2d\x33\x7c\x68\x83\xa7\x2d\xc7\x8a\x42\xac\xa0\x02\x23\x36\xd2\x9a\x2c\x74\x18\x6d\x42\x6a\x1c\xdd\x0e\xa0\x25\x6c\xc4\x39\xfc\x06\x2d\x41\x92\x23\xce\xb9\xbc\xdd\xbe\x1b\x5d\x51\xe2\x0e\xc4\xa9\x19\x0e\x25\xb3\xc7\x57\xb8\xa6\xa3\x92\xe1\xdd\x88\x28\xab\x0c\x35\xdd\x82\x9b\x18\x12\x96\xf0\x92\x9a\xe2\xe8\xe7\xd9\x6c\x44\x64\xe8\x72\x64\x43\x1f\x4a\xd4\xc1\xb8\x61\xe3\xfd\x1f\x11\xa7\x24\xc6\x16\x24\x95\xa3\x89\xc8\x58\x57\xb0\xe9\xb3\xe8\x26\x0a\x37\x53\x39\x5e\x7f\x1a\xe4\x6d\xc7\x89\x57\xe8\x90\xcc\xd1\x9b\xbf\xc6\x68\x5b\x78\x1b\x3b\x1a\x2f\x14\x2d\x54\xd1\xdc\x56\x0d\x69\xb5\xf4\x6b\x34\x35\x86\xb4\x1a\xff\xfd\x66\xa0\x3e\x16\x3c\x9c\xe1\xab\x06\xdb\xdd\x48\x32\xbc\xd8\x29\xb7\xd6\xf4\xbe\xcc\x25\x7d\x12\x31\x47\xa6\x15\x5f\x5c\x05\xde\x4b\x97\x17\x2a\x6d\x88\x38\x41\x88\x6c\x97\xe1\xe8\xd3\x5e\x99\x5b\xb4\x5c\xa3\x1d\xdc\x59\x83\x0c\x89\x22\x97\x32\xe7\x0e\x8e\xca\xae\x18\xba\x89\x8f\xb5\x18\x74\xe2\x35\x58\xf0\x1c\x0f\x00\x2f\xc9\x38\x24\xe8\x99\x20\x93\xa1\x15\x8e\x0a\x74\x8b\xc9\x1a\x1c\x6d\xed\xd7\xf0\x9b\x89\x1f\xe6\xe0\xac\x94\xff\xbc\xd8\xa0\x76\x8c\xf2\xbc\xd0\xb0\x0d\x11\x2b\xdc\x15\xcf\x71\xd0\xde\x51\xcb\xb1\xe0\x22\xd6\x73\xe6\xa7\x10\x83\x05\x94\x4b\x16\xa2\x46\x25\x3b\xfa\x7d\xfc\xfe\xe1\x61\xfc\xc7\x99\xef\x8a\xee\x3b\x5e\xed\x87\xc7\x4b\xd7\xf5\xdf\xaf\x8c\xe1\x09\x10\xfa\xf0\x45\x8d\x71\x21\x31\xf8\x9d\xa3\xfb\x76\x2e\xb6\x50\x14\x24\x3b\x17\x18\xda\x85\x34\xd4\xfa\x01\xa5\xf4\xa0\x03\xe0\x17\x8e\xf1\x89\xfd\xe6\x51\x1e\x64\x55\x3e\xa6\x3b\x55\xd1\x33\x64\x16\xb5\x8b\xfa\x27\x27\x85\x17\xa2\xe6\xe8\x7a\x76\x3d\xbb\xb8\x5f\x56\x31\xf1\x12\x1d\x3d\xde\x2c\xfe\x17\x79\x7d\x7d\xfd\x4d\x48\x45\x91\xaa\xfe\xb5\x90\x8a\x3f\x2b\x8a\xfd\x4b\x5e\x9f\xab\xa3\x77\xb3\xee\x95\xb9\x43\x27\xba\x73\xf4\xd3\xec\x43\x38\xb8\x92\x34\x58\x22\xc2\x9b\xe8\x89\x65\x53\x9f\xa0\x09\x86\xd2\x2f\x8c\x14\x47\x31\xa4\xfa\x72\xf0\x9b\x44\xe8\xeb\x95\x9b\xd0\x7e\x91\x1c\xcd\xe5\xb0\x39\xe7\x7d\xda\x60\xe7\x86\x64\x13\x95\x88\xe9\x65\x82\x90\x5a\xe5\xb3\x60\xc9\x3d\xbf\xa8\xa3\xbb\x97\x50\xac\x8c\x88\x6a\x6e\xd8\xb0\x34\x65\xc3\x6a\xb7\x4f\x7b\x58\x1a\x89\xfd\x8e\x7e\x1e\x02\x06\xbb\x9e\x5b\x8e\x15\x5e\xd1\x5c\x0c\x8e\x1e\xd7\x38\x3c\x7a\x43\x33\xfa\x58\x28\xa9\xd4\xd4\x14\xb2\x35\x28\x43\x3d\x92\xf5\x53\x58\xf3\x17\xf0\xf7\x35\xc5\xb0\xc1\x10\xd1\x20\x47\xd9\xf5\x0f\xc8\x19\xc5\x8f\xf4\xbc\x0e\x7e\x7d\x64\x6a\xe4\x39\xfd\x30\x3d\xc0\x3b\x7e\xf9\x9c\x78\xcb\x21\xf2\x53\x44\xdf\x99\xef\x46\xff\x04\x00\x00\xff\xff\x13\xdc\x4e\x79\x9a\x05\x00\x00")
+var _assetsCanaryDaemonsetYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x54\x51\x6b\x23\x47\x0c\x7e\xf7\xaf\x10\x0e\xe5\x5a\xa8\x7d\xbe\x3e\x94\x30\x6f\x47\x92\xd2\x40\xce\x67\xce\xb9\xbe\x94\x3e\x28\xb3\xdf\xda\x83\x67\x47\x53\x8d\xc6\x89\x29\xfd\xef\x65\xd7\xeb\xc4\x09\xc7\x51\x66\xc1\x5e\xe9\xfb\xa4\x4f\x1a\x69\x2f\xe8\x77\xc4\x28\xf4\x39\x23\x95\x6d\x68\x8d\x6e\xd3\x46\x51\x0a\x5d\x71\x62\x3d\x50\xc3\xe8\x24\x15\xd8\xe4\x82\xd6\x19\x3e\xb4\xc1\xd3\x9e\x63\x45\x21\x56\x50\x81\x11\x1b\x69\x4d\x16\x3a\x4c\x76\x21\x35\x8e\xae\x07\xd2\x1a\x36\xe1\x1c\xfe\x80\x96\x20\xc9\x11\xe7\x5c\xde\xef\x3f\x4c\x2e\x28\x71\x07\xe2\xd4\x0c\x7f\x4a\x66\x8f\x6f\xc4\x9a\x4f\x4a\x86\x77\x13\xa2\xac\x32\x68\xba\x06\x37\x31\x24\xac\xe1\x25\x35\xc5\xd1\xaf\x8b\xc5\x84\xc8\xd0\xe5\xc8\x86\x1e\x4a\xd4\xc1\xb8\x61\xe3\xe3\x1b\x11\xa7\x24\xc6\x16\x24\x95\x93\x89\xc8\x58\x37\xb0\xf9\xa3\xe8\x2e\x0a\x37\x73\x39\x95\x3f\x0f\xf2\xbe\xe3\xc4\x1b\x74\x48\xe6\xe8\xdd\x3f\x53\xb4\x2d\xbc\x4d\x1d\x4d\x57\x8a\x16\xaa\x68\xae\xab\x86\xb4\x59\xfb\x2d\x9a\x1a\x43\xda\x4c\xff\x7d\x37\x84\x3e\x09\xee\x4f\x81\xaf\x1a\xec\x70\x25\xc9\xf0\x64\x2f\xb9\xb5\xa6\x8f\x65\x29\xe9\x8b\x88\x39\x32\xad\x78\x76\x15\x78\x2f\x5d\x5e\xa9\xb4\x21\x8e\xf5\x8c\x82\x0f\x19\x8e\xbe\x1c\xbb\x7c\x8d\x96\x6b\xb4\xd1\x9d\x35\xc8\x90\x28\x72\x29\x4b\xee\xe0\xa8\x1c\x8a\xa1\x9b\xf9\x58\x8b\x41\x67\x5e\x83\x05\xcf\x71\x24\x78\x49\xc6\x21\x41\xcf\x1a\x32\x1b\xae\xc2\x51\x81\xee\x31\xdb\x82\xa3\x6d\xfd\x16\x7e\x37\xf3\xc3\x1c\x3c\x03\xbf\x53\x58\xff\x70\x8c\xf2\xb8\xd2\xb0\x0f\x11\x1b\xdc\x14\xcf\x71\xe8\xbd\xa3\x96\x63\x79\xa9\xb4\x3f\x9e\x33\x3f\x84\x18\x2c\xe0\x4c\xc9\xf1\x69\x54\xb2\xa3\x3f\xa7\x1f\xef\xee\xa6\x7f\x9d\xf9\x2e\xe8\xb6\xe3\xcd\x71\x78\xbc\x74\x5d\xff\xfb\x8d\x31\x3c\xc1\x89\x42\x0f\x5f\xd5\x18\x57\x12\x83\x3f\x38\xba\x6d\x97\x62\x2b\x45\x41\x3a\x75\xb0\x3f\x06\xed\x42\x1a\xb4\x7e\x42\x29\x3d\x69\x24\xfc\xc6\x31\x3e\xb0\xdf\xdd\xcb\x9d\x6c\xca\xe7\x74\xa3\x2a\x7a\xc6\xcc\xa2\xf6\x4a\xff\xec\xa5\xc3\x2b\x51\x73\x74\xb9\xb8\x5c\x9c\xf9\x87\x81\x36\xf1\x12\x1d\xdd\x5f\xad\xbe\xcb\xbc\xbc\xbc\xfc\x5f\x4c\x45\x91\xaa\xfe\x6d\x23\x15\x7f\x57\x94\xd7\xf2\xfa\xe3\x73\x75\xf4\x61\xd1\xbd\x31\x77\xe8\x44\x0f\x8e\x7e\x59\x7c\x0a\xa3\x2b\x49\x83\x35\x22\xbc\x89\xbe\x44\xd9\xd5\x07\x68\x82\xa1\xf4\x0b\x23\xc5\x51\x0c\xa9\x3e\x8d\x7e\x93\x08\x7d\xbb\x72\x33\x3a\x2e\x92\xa3\xa5\x8c\x9b\x73\x7e\x4f\x3b\x1c\xdc\x90\x6c\xa6\x12\x31\x7f\x9d\x20\xa4\x56\xf9\x0c\x2c\xb9\x8f\x2f\xea\xe8\xe6\x29\x14\x2b\x13\xa2\x9a\x1b\x36\xac\x4d\xd9\xb0\x39\x1c\xd3\x8e\x4b\x23\xb1\xdf\xd1\xaf\x03\x60\xb0\xeb\xb9\xe5\xa4\xf0\x82\x96\x62\x70\x74\xbf\xc5\xf8\xd1\x1b\x2e\xa3\xc7\x42\x49\xa5\xa6\xa6\x90\x6d\x41\x19\xea\x91\xac\x9f\xc2\x9a\x9f\xc9\x3f\xd6\x14\xc3\x0e\x03\xa2\x41\x8e\x72\xe8\x3f\x20\x67\x21\x7e\xa6\xc7\x6d\xf0\xdb\x53\xa4\x46\x1e\xd3\x4f\xf3\x91\xde\xf1\xd3\xd7\xc4\x7b\x0e\x91\x1f\x22\x1c\x7d\x58\xfc\x30\xf9\x6f\x00\x13\xdc\x4e\x79\x9a\x05\x00\x00")
 
 func assetsCanaryDaemonsetYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -122,7 +123,7 @@ func assetsCanaryDaemonsetYaml() (*asset, error) {
 	return a, nil
 }
 
-var _assetsCanaryNamespaceYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\xcc\x31\x4e\x03\x31\x10\x05\xd0\xde\xa7\xf8\x5a\xea\x0d\xa2\xf5\x21\xa0\xa3\xff\xac\x7f\x16\x2b\xf6\xcc\xca\x1e\x12\x71\x7b\x14\x24\x84\xd2\x3f\xbd\x4b\xb5\x92\xf1\xca\xae\x79\x70\x53\xe2\x51\xdf\x35\x66\x75\xcb\xb8\xbe\xa4\xae\x60\x61\x30\x27\xc0\xd8\x95\xe1\x87\x6c\x7e\xd6\x73\xac\xd5\xf6\xa1\x39\xd7\x8d\xc6\xf1\x9d\x00\x9a\x79\x30\xaa\xdb\xbc\x7b\xfc\xdb\x53\xf5\x67\xf3\xa2\x75\xaa\x69\x0b\x1f\x19\xcb\x82\x27\xbc\x5d\x35\x46\x2d\xc2\xde\xfc\x83\x0d\x45\x67\x7e\xb5\xc0\xdd\xe2\xcf\xfe\x56\x37\x1f\x97\xe6\x2c\xa7\x87\x93\xad\xf9\x4d\x25\x63\xe9\x34\xee\xea\xb2\x58\xd2\x4f\x00\x00\x00\xff\xff\xfc\xc4\xd0\x2a\xd4\x00\x00\x00")
+var _assetsCanaryNamespaceYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\xcc\x31\x4e\x03\x31\x10\x05\xd0\xde\xa7\xf8\x5a\xea\x0d\xa2\xf5\x21\xa0\xa3\xff\xac\x7f\x16\x2b\xf6\xcc\xca\x1e\x12\x71\x7b\x14\x24\x84\xd2\x3f\xbd\x4b\xb5\x92\xf1\xca\xae\x79\x70\x53\xe2\x51\xdf\x35\x66\x75\xcb\xb8\xbe\xa4\xae\x60\x61\x30\x27\xc0\xd8\x95\xe1\x87\x6c\x7e\xd6\x73\xac\xd5\xf6\xa1\x39\xd7\x8d\xc6\xf1\x9d\x00\x9a\x79\x30\xaa\xdb\xbc\x7b\xfc\xdb\x53\xf5\x67\xf3\xa2\x75\xaa\x69\x0b\x1f\x19\xcb\x82\x27\xbc\x5d\x35\x46\x2d\xc2\xde\xfc\x83\x0d\x45\x67\x7e\xb5\xc0\xdd\xe2\xcf\xfe\x56\x37\x1f\x97\xe6\x2c\xa7\x87\x93\xad\xf9\x4d\x25\x63\xe9\x34\xee\xea\xb2\x58\xd2\xcf\x00\xfc\xc4\xd0\x2a\xd4\x00\x00\x00")
 
 func assetsCanaryNamespaceYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -142,7 +143,7 @@ func assetsCanaryNamespaceYaml() (*asset, error) {
 	return a, nil
 }
 
-var _assetsCanaryRouteYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\x90\x41\x4f\xc3\x30\x0c\x85\xef\xf9\x15\xd6\x7a\xa6\x74\xb7\xa9\x57\x84\x04\x17\x98\x36\xc4\xdd\x4b\xbc\xce\x22\x75\x22\xc7\xdd\xd8\xbf\x47\x4d\x99\x06\xb7\xbe\xfa\xf9\x7b\x7e\x69\xe0\x85\x62\x4c\xf0\x9e\x49\xca\x89\x8f\x06\xaf\x32\x28\x95\x02\x4f\x28\xa8\x57\xd0\x34\x19\xb9\x06\xf6\x99\x3c\x1f\xd9\xc3\x19\xe3\x44\x05\x50\x09\x30\xe7\xc8\x14\x00\x0d\x74\x12\xe3\x91\xdc\x17\x4b\xe8\x61\x57\x97\x30\xf3\x27\x69\xe1\x24\xfd\x82\x69\xd3\x2d\xa5\xe5\xf4\x78\x5e\xbb\x06\x04\x47\x02\x94\x50\x3f\x4a\x46\x4f\x95\x5c\xc8\xfe\x50\x5b\x37\x92\x61\x40\xc3\xde\x01\xa0\x48\x32\x34\x4e\x52\x66\x09\x70\xc2\xac\xe9\xfb\xda\xd6\x0c\xfd\x1f\x72\xc0\x88\xe2\xa9\x87\x95\xa6\x49\x82\xa6\x03\xcb\xca\x95\x4c\x7e\xde\xcd\x49\x6d\x61\x18\xea\x40\xb6\x9d\x35\x6c\xba\x4d\xf7\x60\x3e\x3b\x00\x4b\xcb\x78\xa9\xb5\x27\x3d\xb3\xa7\xfa\xa7\xb9\xa9\xa5\xc2\xfd\x60\xa8\xef\x30\x5b\x2e\xc4\xc3\xc9\x7a\x58\x77\xdd\x8c\x8a\xbf\xe7\x1a\xe9\xc8\x52\x0b\xf4\x40\x61\x58\xcc\x2c\x85\xfc\xa4\xf4\x1c\x06\xfa\xb8\x3b\xb6\x29\xb2\xbf\xf6\xb0\xa3\xc0\x4a\xde\x1c\xc0\x85\x63\xf0\xa8\xe1\x36\x7a\x4b\x42\xee\x27\x00\x00\xff\xff\xa2\x4f\x56\xb1\xc8\x01\x00\x00")
+var _assetsCanaryRouteYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\x90\x41\x4f\xc3\x30\x0c\x85\xef\xf9\x15\xd6\x7a\xa6\x74\xb7\xa9\x57\x84\x04\x17\x98\x36\xc4\xdd\x4b\xbc\xce\x22\x75\x22\xc7\xdd\xd8\xbf\x47\x4d\x99\x18\xa7\xe4\xc5\xf6\xf7\x9e\xd3\xc0\x0b\xc5\x98\xe0\x3d\x93\x94\x13\x1f\x0d\x5e\x65\x50\x2a\x05\x9e\x50\x50\xaf\xa0\x69\x32\x72\x0d\xec\x33\x79\x3e\xb2\x87\x33\xc6\x89\x0a\xa0\x12\x60\xce\x91\x29\x00\x1a\xe8\x24\xc6\x23\xb9\x2f\x96\xd0\xc3\xae\x0e\x61\xe6\x4f\xd2\xc2\x49\xfa\x05\xd3\xa6\x9b\x4b\xcb\xe9\xf1\xbc\x76\x0d\x08\x8e\x04\x28\xa1\x5e\x4a\x46\x4f\x95\x5c\xc8\xee\xa8\xad\x1b\xc9\x30\xa0\x61\xef\x00\x50\x24\x19\x1a\x27\x29\xb3\x04\x38\x61\xd6\xf4\x7d\x6d\xab\x87\xfe\x37\x39\x60\x44\xf1\xd4\xc3\x4a\xd3\x24\x41\xd3\x81\x65\xe5\x4a\x26\x3f\xcf\xe6\xa4\x36\x9f\x00\x86\x3a\x90\x6d\x67\x0d\x9b\x6e\xd3\x3d\x98\xcf\x0e\xc0\xd2\x52\x5e\xd6\xda\x93\x9e\xd9\x53\x7d\x69\x6e\xaa\x26\xbf\x0b\x0c\xf5\x1f\xe6\x96\x0b\xf1\x70\xb2\x1e\xd6\x5d\x37\xa3\xe2\x6f\x5c\x23\x1d\x59\xea\x02\x3d\x50\x18\x16\x1e\x4b\x21\x3f\x29\x3d\x87\x81\x3e\xfe\x3a\xb6\x29\xb2\xbf\xf6\xb0\xa3\xc0\x4a\xde\x1c\xc0\x85\x63\xf0\xa8\xe1\x56\x7a\x4b\x42\xee\x67\x00\xa2\x4f\x56\xb1\xc8\x01\x00\x00")
 
 func assetsCanaryRouteYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -162,7 +163,7 @@ func assetsCanaryRouteYaml() (*asset, error) {
 	return a, nil
 }
 
-var _assetsCanaryServiceYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\xcc\xb1\x6a\x33\x31\x10\x04\xe0\x5e\x4f\x31\x70\xb5\x7f\xfc\x77\x42\xed\x35\x71\x95\x03\x87\xf4\x8b\x6e\xed\x88\xc8\xd2\xb2\xbb\x77\xe0\xb7\x0f\xbe\x0b\xc1\xa4\x4a\x27\xcd\xcc\x7e\x03\x5e\xb8\xd6\x8e\x57\xe1\x66\x1f\xe5\xe2\x38\xb5\xab\xb2\x19\x46\x6a\xa4\x77\x18\xeb\x5a\x32\x87\x01\x67\xe1\x5c\x2e\x25\x63\xa5\xba\xb0\x81\x94\x41\x22\xb5\xf0\x0c\x72\xe8\xd2\xbc\xdc\x38\x7c\x96\x36\x27\x9c\xbf\xcf\x48\xca\x3b\xab\x95\xde\x12\xd6\xff\x61\x40\xa3\x1b\x83\xda\xbc\x3d\x4c\x28\xf3\x06\x19\xfb\x13\xf2\x2f\x98\x70\x4e\x01\xf0\xbb\x70\xc2\x58\x17\x73\xd6\xd3\x14\x00\xe9\xea\xf6\xa8\x0e\x1b\x91\x10\x8f\xf1\x78\xf0\x2c\x01\xd8\xdb\x3d\xda\xbf\xda\xbd\xe7\x5e\x13\xde\xc6\x69\x4b\x9c\xf4\xca\x3e\x3d\xcf\x7e\xa0\x18\xe3\x6f\x28\xc6\xf8\x17\xe8\x31\xfb\x0a\x00\x00\xff\xff\x41\xbe\x5b\x7c\x4b\x01\x00\x00")
+var _assetsCanaryServiceYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\xcc\x31\x6b\x03\x31\x0c\x05\xe0\xdd\xbf\xe2\xc1\xcd\x29\xe9\x26\xbc\xde\xd2\x4c\x0d\xa4\x74\x17\x3e\x25\x35\x75\x6c\x23\xe9\x0e\xf2\xef\x4b\xee\x4a\x09\x9d\xb2\x49\x7a\x7a\xdf\x80\x37\x29\xa5\xe1\xbd\x4b\xb5\xaf\x7c\x76\x1c\xea\x45\xc5\x0c\x23\x57\xd6\x1b\x4c\x74\xc9\x49\xc2\x80\x53\x97\x94\xcf\x39\x61\xe1\x32\x8b\x81\x55\xc0\xbd\x97\x2c\x13\xd8\xa1\x73\xf5\x7c\x95\xf0\x9d\xeb\x14\x71\xfa\xad\x71\xcf\x9f\xa2\x96\x5b\x8d\x58\x5e\xc3\x80\xca\x57\x01\xd7\x69\x1d\xac\x73\x92\x15\x32\xf1\x07\xe4\x25\x58\x97\x14\x03\xe0\xb7\x2e\x11\x63\x99\xcd\x45\x0f\xc7\x00\xf4\xa6\x6e\xf7\x68\xb7\x12\x11\xb4\xa7\xfd\xce\x53\x0f\xc0\x96\x6e\xa7\x6d\xd5\xe6\x2d\xb5\x12\xf1\x31\xde\xcb\x80\xb3\x5e\xc4\x8f\x8f\x6f\x7f\x10\x11\xfd\x87\x88\xe8\x19\x88\x88\xc2\xcf\x00\x41\xbe\x5b\x7c\x4b\x01\x00\x00")
 
 func assetsCanaryServiceYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -182,7 +183,7 @@ func assetsCanaryServiceYaml() (*asset, error) {
 	return a, nil
 }
 
-var _assetsRouterClusterRoleBindingYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x8f\x31\x4e\xc4\x40\x0c\x45\xfb\x39\x85\x25\xea\x0c\xa2\x43\xd3\x01\x37\x58\x24\x7a\xef\xc4\xbb\x31\x49\xec\xc8\xf6\xa4\xe0\xf4\x28\x4a\x44\xc3\x4a\x29\x2d\xf9\xbf\xff\xfe\x13\xbc\xb3\xf4\x0e\x31\x10\x98\xb6\x20\x03\xd3\x89\x20\x14\x38\x1c\x3e\xc9\x56\xae\x04\x6f\xb5\x6a\x93\xc8\x69\x64\xe9\x0b\x7c\x4c\xcd\x83\xec\xa2\x13\x6d\x71\x96\x7b\xc2\x85\xbf\xc8\x9c\x55\x0a\xd8\x15\x6b\xc6\x16\x83\x1a\xff\x60\xb0\x4a\x1e\x5f\x3d\xb3\x3e\xaf\x2f\x69\xa6\xc0\x1e\x03\x4b\x02\x10\x9c\xa9\x80\x2e\x24\x3e\xf0\x2d\x3a\x96\xbb\x91\x7b\xb7\x9b\x24\x6f\xd7\x6f\xaa\xe1\x25\x75\xb0\x17\x1f\x3e\x87\xce\x1f\xe1\xf8\xdf\x4f\x5f\xb0\x3e\xa2\xa6\x6d\xd8\x85\x6e\x5b\xf1\xbf\x19\xe7\x32\x27\xf0\xdf\x00\x00\x00\xff\xff\x83\x13\xa9\xa6\x49\x01\x00\x00")
+var _assetsRouterClusterRoleBindingYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x8f\x31\x4e\xc4\x40\x0c\x45\xfb\x39\x85\x25\xea\x0c\xa2\x43\xd3\x01\x37\x58\x24\x7a\xef\xc4\xbb\x31\x49\xec\xc8\xf6\xa4\xe0\xf4\x28\x4a\x44\xc3\x4a\x29\x2d\xf9\xbf\xff\xfe\x13\xbc\xb3\xf4\x0e\x31\x10\x98\xb6\x20\x03\xd3\x89\x20\x14\x38\x1c\x3e\xc9\x56\xae\x04\x6f\xb5\x6a\x93\xc8\x69\x64\xe9\x0b\x7c\x4c\xcd\x83\xec\xa2\x13\x6d\x71\x96\x7b\xc2\x85\xbf\xc8\x9c\x55\x0a\xd8\x15\x6b\xc6\x16\x83\x1a\xff\x60\xb0\x4a\x1e\x5f\x3d\xb3\x3e\xaf\x2f\x69\xa6\xc0\x1e\x03\x4b\x02\x10\x9c\xa9\x80\x2e\x24\x3e\xf0\x2d\x3a\x96\xbb\x91\x7b\xb7\x9b\x24\x6f\xd7\x6f\xaa\xe1\x25\x75\xb0\x17\x1f\x3e\x87\xce\x1f\xe1\xf8\xdf\x4f\x5f\xb0\x3e\xa2\xa6\x6d\xd8\x85\x6e\x5b\xf1\xbf\x19\xe7\x32\x27\xf0\xdf\x01\x00\x83\x13\xa9\xa6\x49\x01\x00\x00")
 
 func assetsRouterClusterRoleBindingYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -202,7 +203,7 @@ func assetsRouterClusterRoleBindingYaml() (*asset, error) {
 	return a, nil
 }
 
-var _assetsRouterClusterRoleYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x92\x31\x6f\xdb\x40\x0c\x85\x77\xfd\x0a\x22\x9d\xad\xa0\x5b\xa1\xb5\x43\xb7\x0e\x45\xd1\x9d\x3a\xbd\x54\xac\x2f\xc7\x03\xc9\x93\x9b\xfe\xfa\x42\xb2\x13\x18\xb6\x83\x24\x9b\xa8\x3b\x7e\xef\xdd\x23\x3f\xd1\xd7\xdc\x3c\x60\xe4\x49\x2b\x26\x32\xcd\xa0\x07\x35\x32\x6d\x01\xf3\x9e\x7e\xce\xe2\xe4\xb3\xb6\x3c\xd1\x08\x62\x27\x83\x87\x49\x0a\x59\xb6\xb2\xaa\xbb\x8c\x19\x7d\xb7\x97\x32\x0d\xcf\xc4\x1f\x9a\xd1\x71\x95\x5f\x30\x17\x2d\x03\xd9\xc8\xa9\xe7\x16\xb3\x9a\xfc\xe3\x10\x2d\xfd\xfe\x8b\xf7\xa2\xf7\xcb\xe7\xee\x11\xc1\x13\x07\x0f\x1d\x51\xe1\x47\x0c\xa4\x15\xc5\x67\x79\x88\x9d\x94\xdf\x06\xf7\xdd\xd1\x52\x67\x2d\xc3\x87\x6e\x47\x5c\xe5\x9b\x69\xab\xbe\x36\xed\xe8\xee\xae\xa3\xd5\x9b\x36\x4b\x38\xfd\x43\x99\xaa\x4a\x09\xdf\xaa\x15\xec\x95\x13\x8e\xa5\xc3\x16\x39\x16\x0b\x6c\x3c\xb5\x64\xf1\xd8\x3e\x0e\x1c\x69\xee\xae\x75\xd6\x27\xa0\x84\xa4\xf3\x37\x5c\x4b\x87\xee\x51\x0c\x8b\xe0\x70\xa1\x90\x0c\x1c\x78\x85\x7c\x19\xce\x35\xd8\xdb\xf8\x07\x29\x38\x25\xb8\x7f\x4c\x60\x4b\xb0\x7f\x49\xf6\x26\x7e\xbb\xf3\xd1\x4c\xde\x0f\xbe\xf7\xe0\x68\x17\xfc\x56\xa7\xdb\x86\x1d\xa9\x99\xc4\xd3\x1b\xe8\xe7\x6b\x49\x4b\xe0\x6f\x24\x2d\x1e\xc6\xa7\xb9\x9f\xeb\x38\xce\x9a\xbf\xaf\xeb\x70\x3c\x98\xd5\xa3\x20\x0e\x6a\xfb\x1b\x2e\x26\xf1\xa4\x0b\xec\xe9\xd5\x99\xbc\xec\x59\x7e\x73\xa1\xfe\x07\x00\x00\xff\xff\x45\xf5\x79\x0d\x73\x03\x00\x00")
+var _assetsRouterClusterRoleYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x92\x31\x6f\xdb\x40\x0c\x85\x77\xfd\x0a\x22\x9d\xad\xa0\x5b\xa1\xb5\x43\xb7\x0e\x45\xd1\x9d\x3a\xbd\x54\xac\x2f\xc7\x03\xc9\x93\x9b\xfe\xfa\x42\xb2\x13\x18\xb6\x83\x24\x9b\x28\x90\xdf\x7b\xf7\xc8\x4f\xf4\x35\x37\x0f\x18\x79\xd2\x8a\x89\x4c\x33\xe8\x41\x8d\x4c\x5b\xc0\xbc\xa7\x9f\xb3\x38\xf9\xac\x2d\x4f\x34\x82\xd8\xc9\xe0\x61\x92\x42\x96\xad\xac\xea\x2e\x63\x46\xdf\xed\xa5\x4c\xc3\x33\xf1\x87\x66\x74\x5c\xe5\x17\xcc\x45\xcb\x40\x36\x72\xea\xb9\xc5\xac\x26\xff\x38\x44\x4b\xbf\xff\xe2\xbd\xe8\xfd\xf2\xb9\x7b\x44\xf0\xc4\xc1\x43\x47\x54\xf8\x11\x03\x69\x45\xf1\x59\x1e\x62\x27\xe5\xb7\xc1\x7d\x77\xb4\xd4\x59\xcb\xf0\xa1\xdb\x11\x57\xf9\x66\xda\xaa\xaf\x43\x3b\xba\xbb\xeb\x68\xf5\xa6\xcd\x12\x4e\xff\x50\xa6\xaa\x52\xc2\xb7\x8e\x15\xec\x95\x13\x8e\xa5\xc3\x16\x39\x16\x0b\x6c\x3c\x8d\x64\xf1\xd8\x3e\x0e\x1c\x69\xee\xae\x75\xd6\x27\xa0\x84\xa4\xf3\x37\x5c\x4b\x87\xee\x51\x0c\x8b\xe0\x70\xa1\x90\x0c\x1c\x78\x85\x7c\x19\xce\x35\xd8\xdb\xf8\x07\x29\x38\x25\xb8\x7f\x4c\x60\x4b\xb0\x7f\x49\xf6\x26\x7e\xeb\xf9\x68\x26\xef\x07\xdf\x7b\x70\xb4\x0b\x7e\xab\xd3\x6d\xc3\x8e\xd4\x4c\xe2\xe9\x0d\xf4\x73\x5b\xd2\x12\xf8\x1b\x49\x8b\x87\xf1\x69\xef\xe7\x3a\x8e\xb3\xe1\xef\xeb\x39\x1c\x75\x66\xf5\x28\x88\x83\xda\xfe\x86\x8b\x49\x3c\xe9\x02\x7b\x7a\x75\x27\x2f\x77\x96\xdf\x3c\xa8\xff\x03\x00\x45\xf5\x79\x0d\x73\x03\x00\x00")
 
 func assetsRouterClusterRoleYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -222,7 +223,7 @@ func assetsRouterClusterRoleYaml() (*asset, error) {
 	return a, nil
 }
 
-var _assetsRouterDeploymentYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x55\x4f\x6f\xe2\xc8\x13\xbd\xf3\x29\x4a\xe4\x30\x27\x63\xc8\x6f\x7e\x59\xad\x6f\x08\x9c\x5d\xa4\x21\x41\x81\x99\x2b\xaa\xb4\x0b\xd3\x4a\xbb\xbb\xb7\xba\x9a\x2c\xb3\xda\xef\xbe\xb2\x4d\x12\x9b\x64\x32\x91\x56\xcb\xa9\xe9\xaa\x7e\xaf\xfe\xbc\x2a\x5f\xc0\x9c\xbc\x71\xc7\x8a\xac\xc0\xa3\x96\x3d\x14\xb4\xc3\x68\x04\x0e\x68\x22\x85\xc1\x05\x2c\x6c\xc9\x14\x02\xcc\x9c\x15\x76\xc6\x10\x43\xf0\xa4\xf4\x4e\xab\x93\x13\x20\x13\xa0\xf7\x46\x53\x01\x28\xc0\xd1\x8a\xae\x68\x34\x78\xd0\xb6\xc8\x3a\x0c\x03\xf4\xfa\x1b\x71\xd0\xce\x66\xf5\x83\x90\x1e\x26\x83\x0b\xb0\x58\x11\xa0\x2d\x9a\x43\xf0\xa8\xa8\x41\x0c\x24\x3d\xb4\x9a\x35\x1b\x00\x78\x76\x4d\x44\x73\xc2\xc2\x68\x4b\x6b\x52\xce\x16\x21\x83\xab\xf1\x78\x00\x20\x54\x79\x83\x42\xb5\x2b\x40\x45\x82\x05\x0a\xb6\xff\x00\xd0\x5a\x27\x28\xda\xd9\xf0\x74\x05\x30\x8c\x36\x44\xef\x1d\x0b\x15\xa3\xc2\x25\xd6\x49\x12\x03\x8d\x9c\x27\x1b\xf6\x7a\x27\x23\xed\x52\x77\x20\x66\x5d\x50\x62\xf4\x81\x2c\x85\x90\x94\x8c\x8a\x12\x4f\xac\x5d\x91\x84\x36\x8a\x61\x06\xc3\xc9\x78\xf8\x0c\x2d\xc8\x25\xc9\xe8\xd1\xf1\x83\x71\x58\xf4\x21\x2b\xb4\x58\x52\x5d\x99\x0c\x3e\xfd\x35\xa4\xdd\x8e\x94\xd4\x08\x2b\xa6\x1d\x31\x53\x31\x8f\xac\x6d\xb9\x56\x7b\x2a\xa2\xd1\xb6\x1c\xfe\xfd\xa9\x81\x7e\xaa\x45\x73\x26\x3e\x68\x45\x53\xa5\x5c\xb4\x72\x83\x15\x65\xc0\x2e\x0a\xf1\xc9\xe1\x02\xac\x2b\x68\x4d\x86\x94\x38\x06\x1d\x5e\x95\xb6\xf5\xf3\xac\x1d\x6b\x39\xce\x0c\x86\xd0\xe2\x84\x63\x10\xaa\x12\x65\x62\x10\xe2\x44\xb1\x16\xad\xd0\x9c\x1e\x28\x67\x05\xb5\x25\xee\xd4\x32\x69\xba\x78\x16\x41\x1b\x85\xae\xb0\xa4\x1f\xd3\xd7\xbf\xc6\x65\x15\x8d\x59\x39\xa3\xd5\x31\x83\xc5\xee\xc6\xc9\x8a\x29\xd4\xf2\x79\xf1\x0b\xa4\x62\x13\xaa\xb3\x42\x7f\x4a\xd6\x31\xd5\x4c\x6b\x22\xd8\x8b\xf8\x90\xa5\xe9\x7d\x2c\xbf\x6b\x63\x70\xc4\x54\xec\x51\x46\xca\x55\xe9\xe5\x78\xfc\xcb\xe5\xe7\xab\xde\x2b\x34\xc6\x3d\xae\x58\x1f\xb4\xa1\x92\xf2\xa0\xd0\x34\x3a\xc9\x40\x38\x52\xc7\x55\x88\x2b\x6d\x1b\xdb\x92\x42\xa8\x03\x3e\x05\x7b\x8d\xc6\xdc\xa3\x7a\xd8\xb8\x2f\xae\x0c\xb7\x36\x67\x76\xfd\x12\x2c\x89\xcb\xb3\x21\x79\x31\x93\x3d\x74\x13\x79\xaa\xe4\xdd\xed\xd7\x4d\x7e\xb7\x5d\xe7\x77\xdf\x16\xb3\x7c\x7b\x33\x5d\xe6\xeb\xd5\x74\x96\xf7\xa2\x6f\x26\x31\x83\x67\x79\x25\xba\x1d\xdb\x37\xf0\xe6\xf9\xf5\xf4\xeb\x97\xcd\x76\x96\xdf\x6d\x16\xd7\x8b\xd9\x74\x93\x6f\xe7\x8b\xbb\xb7\xe0\x52\x12\x95\xfa\x07\x9d\x8a\x09\xa9\x67\x7d\x40\xa1\x77\x10\xe7\xf9\x7a\xb3\xb8\x99\x6e\x16\xb7\x37\xdb\xd9\x74\xbb\x9a\x6e\x7e\x7f\x13\xf5\x80\x9c\x72\xb4\xa9\x72\x76\xa7\xcb\x0a\x7d\x48\x4f\x22\x4e\x14\x76\x8e\x23\xc5\xdd\xa6\x3f\x0d\xde\x8a\xdd\x3d\xf5\x5b\x5e\x37\xfb\x37\x3a\xd3\x01\x80\x47\xd9\x67\x90\xee\x09\x8d\xec\xbf\x9f\x1b\x1d\x4b\x06\x93\x5f\xff\xd7\xd5\x01\x13\x16\xfa\xdf\x90\xa4\x35\xc2\xf1\x23\x54\x41\x90\x25\xfa\x37\x88\x76\xa8\x4d\x64\xda\xec\x99\xc2\xde\x99\x22\x83\xc9\xe5\xf8\xbf\x0c\x05\xa0\xdd\x62\xcf\xab\x74\xd2\x2b\x49\x70\x91\x15\x85\x3e\x1b\xd3\x1f\x91\x82\x84\xf3\x18\x94\x8f\x19\x4c\xc6\xe3\xea\xec\xbe\xa2\xca\xf1\x31\x83\xcb\xff\x5f\x2d\x75\xc7\x76\x70\x26\x56\xb4\xac\x77\x57\xe8\xcb\xbf\xaa\xef\x56\x6d\x4a\xef\x0b\x11\x4e\x42\x3c\x7d\xbc\x12\x45\x2c\xf5\x07\xea\xdc\xab\x2e\xc8\xad\x35\xc7\x57\x33\xdd\x27\x7b\x57\x9f\x6f\xd0\xbe\x18\x93\xfb\x68\x0b\xf3\x01\xd2\x36\xeb\xe7\x84\x93\x0f\x24\x10\x48\x71\xbf\xe5\x27\xef\xa5\x2b\x28\x83\xcf\x3d\x89\xd4\x3b\xb0\x76\xaf\xd7\x78\x7f\xe5\x26\xdd\xb5\x93\xfc\x34\x83\xb6\x06\x4b\xf4\x5d\x62\x2d\x54\x9d\x35\xeb\x81\x8e\x5d\x98\xb3\xd1\x7d\x92\xe6\x0f\x1d\x7e\x5e\x48\xe7\xeb\x8d\x8b\x26\x83\x1d\x9a\xf0\x62\x79\x55\x84\x7f\x02\x00\x00\xff\xff\x49\x0a\x8b\xa5\xd4\x08\x00\x00")
+var _assetsRouterDeploymentYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x55\x4f\x6f\x22\xb9\x13\xbd\xf3\x29\x4a\x70\x98\x53\xd3\x90\xdf\xfc\xb2\xda\xbe\x21\xe8\xec\x22\x0d\x09\x0a\xcc\x5c\x51\xc5\x5d\x34\x56\xdc\xb6\xb7\x5c\x26\xcb\xac\xf6\xbb\xaf\xcc\x9f\x4c\x43\xfe\x4c\xa4\xd5\xca\x1c\x1a\xfb\xf5\x7b\x55\xe5\x57\xd5\x3d\x98\x90\x37\x6e\xd7\x90\x15\x78\xd2\xb2\x81\x8a\xd6\x18\x8d\xc0\x16\x4d\xa4\xd0\xe9\xc1\xd4\xd6\x4c\x21\xc0\xd8\x59\x61\x67\x0c\x31\x04\x4f\x4a\xaf\xb5\x3a\x82\x00\x99\x00\xbd\x37\x9a\x2a\x40\x01\x8e\x56\x74\x43\xfd\xce\xa3\xb6\x55\xd1\x52\xe8\xa0\xd7\xdf\x88\x83\x76\xb6\x48\x2f\x84\x7c\x3b\xec\xf4\xc0\x62\x43\x80\xb6\xda\x3f\x04\x8f\x8a\xf6\x8c\x81\xe4\x8c\x2d\xa9\x16\x1d\x00\xcf\x6e\x1f\xd1\x84\xb0\x32\xda\xd2\x82\x94\xb3\x55\x28\xe0\x7a\x30\xe8\x00\x08\x35\xde\xa0\x50\x82\x02\x34\x24\x58\xa1\xe0\xe1\x1f\x00\x5a\xeb\x04\x45\x3b\x1b\x4e\x5b\x00\xdd\x68\x43\xf4\xde\xb1\x50\xd5\xaf\x5c\x66\x9d\x64\x31\x50\xdf\x79\xb2\x61\xa3\xd7\xd2\xd7\x2e\x77\x5b\x62\xd6\x15\x65\x46\x6f\xc9\x52\x08\x59\xcd\xa8\x28\xf3\xc4\xda\x55\x59\x38\x44\xd1\x2d\xa0\x3b\x1c\x74\x9f\xa9\x05\xb9\x26\xe9\x3f\x39\x7e\x34\x0e\xab\x73\xca\x06\x2d\xd6\x94\x6a\x5f\xc0\xa7\xbf\xba\xb4\x5e\x93\x92\xc4\x30\x67\x5a\x13\x33\x55\x93\xc8\xda\xd6\x0b\xb5\xa1\x2a\x1a\x6d\xeb\xee\xdf\x9f\xf6\xd4\xa7\x5a\xa4\x15\x88\xb7\x5a\xd1\x48\x29\x17\xad\xdc\x62\x43\x05\xb0\x8b\x42\x7c\x04\xf4\xc0\xba\x8a\x16\x64\x48\x89\x63\xd0\xe1\x45\x69\xf7\x30\xf0\xac\x1d\x6b\xd9\x8d\x0d\x86\x70\xe0\x09\xbb\x20\xd4\x64\xca\xc4\x20\xc4\x99\x62\x2d\x5a\xa1\x39\xbe\xa0\x9c\x15\xd4\x96\xb8\x55\xcb\x0c\xec\xcb\x08\xd2\xea\x81\x6e\xb0\xa6\xb7\xe5\xd3\xda\x43\xe6\xd1\x98\xb9\x33\x5a\xed\x0a\x98\xae\x6f\x9d\xcc\x99\x42\xb2\xcf\x09\x95\x72\x56\x71\x1f\xaa\xb3\x42\x7f\xca\x0f\xf9\xb4\x7a\xb0\x20\x82\x8d\x88\x0f\x45\x9e\x3f\xc4\xfa\xbb\x36\x06\xfb\x4c\xd5\x06\xa5\xaf\x5c\x93\x5f\x0d\x06\xbf\x5c\x7d\xbe\x3e\x7b\x0b\x8d\x71\x4f\x73\xd6\x5b\x6d\xa8\xa6\x32\x28\x34\x7b\x9f\x14\x20\x1c\xa9\x05\x15\xe2\x46\xdb\xfd\xd9\x8c\x42\x48\x01\x1f\x83\xbd\x41\x63\x1e\x50\x3d\x2e\xdd\x17\x57\x87\x3b\x5b\x32\xbb\xf3\x12\xcc\x88\xeb\x8b\x26\x39\x1d\x02\x90\xdd\xb6\x13\x39\x55\xf2\xfe\xee\xeb\xb2\xbc\x5f\x2d\xca\xfb\x6f\xd3\x71\xb9\xba\x1d\xcd\xca\xc5\x7c\x34\x2e\x5b\x50\x38\x74\x62\x01\xcf\xf6\xca\xf4\xa1\x6d\x5f\xe1\x9b\x94\x37\xa3\xaf\x5f\x96\xab\x71\x79\xbf\x9c\xde\x4c\xc7\xa3\x65\xb9\x9a\x4c\xef\x5f\xa3\xcb\x49\x54\xee\x1f\x75\x2e\x26\xe4\x9e\xf5\x16\x85\xde\x61\x9c\x94\x8b\xe5\xf4\x76\xb4\x9c\xde\xdd\xae\xc6\xa3\xd5\x7c\xb4\xfc\xfd\x55\xd6\x2d\x72\xce\xd1\xe6\xca\xd9\xb5\xae\x1b\xf4\x21\x3f\x9a\x38\x53\xd8\x7a\xec\x2b\x6e\x5f\xfa\xa9\xf1\xe6\xec\x1e\x8e\xed\x7d\x5a\xe9\xb2\x7f\xa3\x0b\x1f\x00\x78\x94\x4d\x01\xf9\x86\xd0\xc8\xe6\xfb\xe5\xa1\x63\x29\x60\xf8\xeb\xff\xda\x3e\x60\xc2\x4a\xff\x1b\x91\x3c\x31\xec\x3e\x22\x15\x04\x59\xa2\x7f\x45\x68\x8d\xda\x44\xa6\xe5\x86\x29\x6c\x9c\xa9\x0a\x18\x5e\x0d\xfe\xcb\x50\x00\x0e\x53\xec\x79\x94\x0e\x5b\x87\x4c\xc1\x45\x56\xd4\x6a\xf2\xf4\x63\xfa\x23\x52\x90\x8b\x5d\x00\xe5\x63\x01\xc3\xc1\xa0\xb9\xd8\x6f\xa8\x71\xbc\x2b\xe0\xea\xff\xd7\x33\xdd\x3a\xdb\x3a\x13\x1b\x9a\xa5\xd9\x75\xc6\x95\x41\x93\xf6\xe6\x87\x94\xde\x37\x22\x1c\x8d\x78\xfc\x78\x65\x8a\x58\xd2\x07\xea\x12\x95\x0a\x72\x67\xcd\xee\x45\x4f\x9f\x8b\xbd\xeb\xcf\x33\xc2\x83\xec\x8f\xc3\xec\x21\xda\xca\x7c\x40\xf4\x90\xf5\x73\xc2\xd9\x07\x12\x08\xa4\xf8\xfc\xca\x8f\xe8\x99\xab\xa8\x80\xcf\x67\x16\x49\x33\x30\xc1\xd3\x18\x3f\x1f\xb9\x59\x7b\xec\x64\x3f\xcd\xe0\x50\x83\x19\xfa\xb6\xb0\x16\x6a\x2e\x2e\xeb\x91\x76\x05\xbc\xd9\xba\x27\x6b\xbe\x09\xf8\x79\x21\x9d\x4f\x13\x17\x4d\x01\x6b\x34\x81\x3a\x6f\x16\xe1\x9f\x01\x00\x49\x0a\x8b\xa5\xd4\x08\x00\x00")
 
 func assetsRouterDeploymentYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -242,7 +243,7 @@ func assetsRouterDeploymentYaml() (*asset, error) {
 	return a, nil
 }
 
-var _assetsRouterMetricsClusterRoleBindingYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x8f\xc1\x4a\xc4\x40\x0c\x86\xef\xf3\x14\x79\x81\x56\xbc\x2d\x73\x53\x0f\xde\x57\xf0\x9e\x9d\xa6\x36\xb6\x93\x0c\x49\xa6\x07\x9f\x5e\x8a\x22\xc2\x42\xaf\x81\x7c\xdf\xff\xad\x2c\x53\x86\x97\xad\x7b\x90\x5d\x75\xa3\x67\x96\x89\xe5\x23\x61\xe3\x77\x32\x67\x95\x0c\x76\xc3\x32\x62\x8f\x45\x8d\xbf\x30\x58\x65\x5c\x2f\x3e\xb2\x3e\xec\x8f\xa9\x52\xe0\x84\x81\x39\x01\x08\x56\xca\x60\xda\x83\x6c\xa8\x2a\x1c\x6a\x07\xcc\xfb\xed\x93\x4a\x78\x4e\x03\xfc\x18\xdf\xc8\x76\x2e\xf4\x54\x8a\x76\x89\xbf\xd7\x66\x5a\x29\x16\xea\x3e\xac\x17\xff\x3d\x7b\xc3\x42\x19\xb4\x91\xf8\xc2\x73\xfc\x27\x9b\x6e\x74\xa5\xf9\x90\xdf\xa5\x9c\x0c\x02\xc0\xc6\xaf\xa6\xbd\x9d\xd4\xa5\xef\x00\x00\x00\xff\xff\x7f\xc0\x4a\x40\x1d\x01\x00\x00")
+var _assetsRouterMetricsClusterRoleBindingYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x8f\xc1\x4a\xc4\x40\x0c\x86\xef\xf3\x14\x79\x81\x56\xbc\x2d\x73\x53\x0f\xde\x57\xf0\x9e\x9d\xa6\x36\xb6\x93\x0c\x49\xa6\x07\x9f\x5e\x8a\x22\xc2\x42\xaf\x81\x7c\xdf\xff\xad\x2c\x53\x86\x97\xad\x7b\x90\x5d\x75\xa3\x67\x96\x89\xe5\x23\x61\xe3\x77\x32\x67\x95\x0c\x76\xc3\x32\x62\x8f\x45\x8d\xbf\x30\x58\x65\x5c\x2f\x3e\xb2\x3e\xec\x8f\xa9\x52\xe0\x84\x81\x39\x01\x08\x56\xca\x60\xda\x83\x6c\xa8\x2a\x1c\x6a\x07\xcc\xfb\xed\x93\x4a\x78\x4e\x03\xfc\x18\xdf\xc8\x76\x2e\xf4\x54\x8a\x76\x89\xbf\xd7\x66\x5a\x29\x16\xea\x3e\xac\x17\xff\x3d\x7b\xc3\x42\x19\xb4\x91\xf8\xc2\x73\xfc\x27\x9b\x6e\x74\xa5\xf9\x90\xdf\xa5\x9c\x0c\x02\xc0\xc6\xaf\xa6\xbd\x9d\xd4\xa5\xef\x01\x00\x7f\xc0\x4a\x40\x1d\x01\x00\x00")
 
 func assetsRouterMetricsClusterRoleBindingYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -262,7 +263,7 @@ func assetsRouterMetricsClusterRoleBindingYaml() (*asset, error) {
 	return a, nil
 }
 
-var _assetsRouterMetricsClusterRoleYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x4c\xce\xb1\x4e\xc4\x40\x0c\x84\xe1\x7e\x9f\xc2\x12\x75\x72\xa2\x43\x69\x29\xe8\x29\xe8\x9d\xec\x70\xb1\x2e\x6b\xaf\x6c\xef\x49\xf0\xf4\xe8\x44\x90\xa8\xe7\x93\xfe\x79\xa2\xd7\x63\x44\xc2\xc9\xed\x40\x90\x02\x15\x95\xd6\x2f\xea\x6e\x0d\xb9\x63\x04\xa5\x51\x6c\xce\x1d\xe4\x36\x1e\xb6\x21\x5d\xb6\x20\x68\xed\x26\x9a\x85\xbb\x7c\xc0\x43\x4c\x17\xf2\x95\xb7\x99\x47\xee\xe6\xf2\xcd\x29\xa6\xf3\xed\x25\x66\xb1\xcb\xfd\xb9\xdc\x44\xeb\xf2\xd7\x7c\xb7\x03\xa5\x21\xb9\x72\xf2\x52\x88\x94\x1b\x96\x33\x32\x35\x53\x49\x73\xd1\x6b\xf1\x71\x20\x96\x32\x11\x77\x79\x73\x1b\x3d\x1e\x7a\xfa\x95\xb3\x75\x68\xec\xf2\x99\xb3\x58\x21\x72\x84\x0d\xdf\xf0\xdf\x78\x5c\xce\xcf\x85\xe8\x0e\x5f\xcf\xf1\x8a\x2c\x3f\x01\x00\x00\xff\xff\x4f\xd5\xdf\xe0\x03\x01\x00\x00")
+var _assetsRouterMetricsClusterRoleYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x4c\xce\x31\x4b\x03\x41\x10\x86\xe1\x7e\x7f\xc5\x07\xd6\x77\xc1\x4e\xb6\xb5\xb0\xb7\xb0\xdf\xdc\x7d\xe6\x86\xdc\xcd\x2c\x33\xb3\x01\xfd\xf5\x12\x8c\x60\xff\xc0\xfb\x3e\xe1\x75\x1f\x91\x74\xb8\xed\x0c\x28\xb9\x72\xc5\xf9\x0b\xdd\xed\x60\x6e\x1c\x81\x34\xc4\xe2\xad\x13\x6e\xe3\x6e\x0f\xa6\xcb\x12\xa0\xae\xdd\x44\xb3\xb4\x2e\x1f\xf4\x10\xd3\x0a\x3f\xb7\x65\x6e\x23\x37\x73\xf9\x6e\x29\xa6\xf3\xf5\x25\x66\xb1\xd3\xed\xb9\x5c\x45\xd7\xfa\xd7\x7c\xb7\x9d\xe5\x60\xb6\xb5\x65\xab\x05\xd0\x76\xb0\x3e\x22\xd3\x61\x2a\x69\x2e\x7a\x29\x3e\x76\x46\x2d\x13\x5a\x97\x37\xb7\xd1\xe3\xae\xa7\x5f\x39\x5b\xa7\xc6\x26\x9f\x39\x8b\x15\xc0\x19\x36\x7c\xe1\x7f\xe3\x71\x7a\x3c\x17\xe0\x46\x3f\x47\x2d\xc0\x84\x0b\xb3\xfc\x0c\x00\x4f\xd5\xdf\xe0\x03\x01\x00\x00")
 
 func assetsRouterMetricsClusterRoleYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -282,7 +283,7 @@ func assetsRouterMetricsClusterRoleYaml() (*asset, error) {
 	return a, nil
 }
 
-var _assetsRouterMetricsRoleBindingYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\xce\x31\x4e\xc5\x40\x0c\x04\xd0\x7e\x4f\xe1\x0b\x24\x88\xee\x6b\x3b\x68\xe8\x3f\x12\xbd\xb3\x71\x12\x93\xac\xbd\xb2\xbd\x29\x38\x3d\x42\x8a\x44\x05\xd2\x6f\x47\x33\x9a\x87\x8d\x3f\xc8\x9c\x55\x32\xd8\x84\x65\xc4\x1e\x9b\x1a\x7f\x61\xb0\xca\xb8\xdf\x7c\x64\x7d\x3a\x9f\xd3\xce\x32\x67\xb8\xeb\x41\xaf\x2c\x33\xcb\x9a\x2a\x05\xce\x18\x98\x13\x80\x60\xa5\x0c\xcd\xb4\x52\x6c\xd4\x7d\xd8\x6f\x7e\xc5\xde\xb0\x50\x06\x6d\x24\xbe\xf1\x12\x03\xcb\x6a\xe4\x9e\x4c\x0f\xba\xd3\xf2\x33\xc7\xc6\x6f\xa6\xbd\xfd\x63\x48\x00\xbf\x84\xbf\x1e\xbd\x4f\x9f\x54\xc2\x73\x1a\xae\xf6\x3b\xd9\xc9\x85\x5e\x4a\xd1\x2e\xf1\xa0\xb4\xaa\x70\xa8\xb1\xac\x90\xbe\x03\x00\x00\xff\xff\x15\x9f\x30\x56\x29\x01\x00\x00")
+var _assetsRouterMetricsRoleBindingYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\xce\x31\x4e\xc5\x40\x0c\x04\xd0\x7e\x4f\xe1\x0b\x24\x88\xee\x6b\x3b\x68\xe8\x3f\x12\xbd\xb3\x71\x12\x93\xac\xbd\xb2\xbd\x29\x38\x3d\x42\x8a\x44\x05\xd2\x6f\x47\x33\x9a\x87\x8d\x3f\xc8\x9c\x55\x32\xd8\x84\x65\xc4\x1e\x9b\x1a\x7f\x61\xb0\xca\xb8\xdf\x7c\x64\x7d\x3a\x9f\xd3\xce\x32\x67\xb8\xeb\x41\xaf\x2c\x33\xcb\x9a\x2a\x05\xce\x18\x98\x13\x80\x60\xa5\x0c\xcd\xb4\x52\x6c\xd4\x7d\xd8\x6f\x7e\xc5\xde\xb0\x50\x06\x6d\x24\xbe\xf1\x12\x03\xcb\x6a\xe4\x9e\x4c\x0f\xba\xd3\xf2\x33\xc7\xc6\x6f\xa6\xbd\xfd\x63\x48\x00\xbf\x84\xbf\x1e\xbd\x4f\x9f\x54\xc2\x73\x1a\xae\xf6\x3b\xd9\xc9\x85\x5e\x4a\xd1\x2e\xf1\xa0\xb4\xaa\x70\xa8\xb1\xac\x90\xbe\x07\x00\x15\x9f\x30\x56\x29\x01\x00\x00")
 
 func assetsRouterMetricsRoleBindingYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -302,7 +303,7 @@ func assetsRouterMetricsRoleBindingYaml() (*asset, error) {
 	return a, nil
 }
 
-var _assetsRouterMetricsRoleYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x4c\x8e\xb1\x6e\xeb\x30\x0c\x45\x77\x7d\x05\x91\x37\x3b\x0f\xdd\x02\xfd\x40\xf7\x0e\xdd\x19\xe9\x36\x26\x62\x8b\x02\x49\xb9\x68\xbf\xbe\x88\xe3\x02\x9d\x78\xef\x01\xc1\xc3\x7f\xf4\xa6\x0b\x9c\x1a\x50\x51\xe9\xfa\x45\xdd\x74\x45\xcc\x18\x4e\xa1\xe4\xc5\xb8\x83\x4c\x47\xc0\x68\x45\x98\x14\x27\xb4\xda\x55\x5a\x24\xee\xf2\x0e\x73\xd1\x96\xc9\xae\x5c\xce\x3c\x62\x56\x93\x6f\x0e\xd1\x76\xbe\x5f\xfc\x2c\xfa\x7f\x7b\x49\x77\x69\x35\xef\xae\xb4\x22\xb8\x72\x70\x4e\x44\x8d\x57\xe4\x3f\xca\xe9\x7e\xf1\x03\x7b\xe7\x82\x4c\xda\xd1\x7c\x96\x8f\x98\xa4\xdd\x0c\xee\xc9\xc6\x02\xcf\x69\x22\xee\xf2\x6a\x3a\xba\x3f\x2e\x4d\x74\x3a\x25\x22\x83\xeb\xb0\x82\x83\x39\x6c\x93\x02\xdf\xcb\xef\xd7\xcf\xd6\xb5\x3e\xc2\x06\xbb\x1e\xcb\x37\xc4\x3e\x17\xf1\x67\xf8\xe4\x28\x73\xfa\x09\x00\x00\xff\xff\x67\x78\x6f\x08\x23\x01\x00\x00")
+var _assetsRouterMetricsRoleYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x4c\x8e\xb1\x6e\xeb\x30\x0c\x45\x77\x7d\x05\x91\x37\x3b\x0f\xdd\x02\xfd\x40\xf7\x0e\xdd\x19\xe9\x36\x26\x62\x8b\x02\x49\xb9\x68\xbf\xbe\x70\x62\x14\x9d\x78\x79\x41\x9c\xc3\x7f\xf4\xa6\x0b\x9c\x1a\x50\x51\xe9\xfa\x45\xdd\x74\x45\xcc\x18\x4e\xa1\xe4\xc5\xb8\x83\x4c\x47\xc0\x68\x45\x98\x14\x27\xb4\xda\x55\x5a\x24\xee\xf2\x0e\x73\xd1\x96\xc9\xae\x5c\xce\x3c\x62\x56\x93\x6f\x0e\xd1\x76\xbe\x5f\xfc\x2c\xfa\x7f\x7b\x49\x77\x69\x35\x3f\x5c\x69\x45\x70\xe5\xe0\x9c\x88\x1a\xaf\xc8\x7f\x94\xd3\xfd\xe2\x47\xed\x9d\x0b\x32\x69\x47\xf3\x59\x3e\x62\x92\x76\x33\xb8\x27\x1b\x0b\x3c\xa7\x89\xb8\xcb\xab\xe9\xe8\xbe\x93\x26\x3a\x9d\x12\x91\xc1\x75\x58\xc1\xd1\x39\x6c\x93\x82\x9d\x39\xfd\x7e\xfd\xdc\xba\xd6\x3d\x6c\xb0\xeb\x71\x7c\x43\x3c\xe6\x22\xfe\x0c\x9f\x1c\x65\x4e\x3f\x03\x00\x67\x78\x6f\x08\x23\x01\x00\x00")
 
 func assetsRouterMetricsRoleYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -322,7 +323,7 @@ func assetsRouterMetricsRoleYaml() (*asset, error) {
 	return a, nil
 }
 
-var _assetsRouterNamespaceYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x91\x41\x8f\xd3\x40\x0c\x85\xef\xfd\x15\x56\xf6\xba\x0d\xe2\x9a\x5f\xc0\x85\x15\x02\x89\xbb\x3b\xe3\x24\xa6\x13\x7b\x34\x76\x1a\xf5\xdf\xa3\x99\x44\x6c\x2b\x40\x88\x53\xa4\xf1\xf3\xf3\x7b\x5f\xae\x2c\x71\x80\x37\x5c\xc8\x32\x06\x3a\x61\xe6\xef\x54\x8c\x55\x06\xb8\x7d\x3c\x2d\xe4\x18\xd1\x71\x38\x01\x08\x2e\x34\x80\x66\x12\x9b\x79\xf4\x33\xcb\x54\xc8\xec\x04\x80\x22\xea\xe8\xac\x62\x55\x08\xef\xa2\x9e\xf5\x83\x68\xa4\xb3\x51\xa2\xe0\x5a\x06\xe8\xba\x26\xd9\xb4\x5c\x93\x62\xec\x9f\xb4\x98\x92\x6e\x14\x07\xe8\x16\x14\x9c\x68\x21\xf1\xaa\x4f\x78\xa1\x74\x98\xbf\x40\x53\x3d\x24\x59\x54\xd8\xb5\xb0\x4c\xe0\x0a\x49\xf5\x0a\xa3\x16\xf8\x46\xe5\xc6\x81\x3e\xef\x53\xd0\xcb\x0f\x0a\x6e\xc0\x02\x3e\xb3\xb5\x3e\x7b\xe9\xdf\x22\x87\xb4\x9a\x53\x79\x30\x1e\xa0\xf3\xb2\xd2\x9e\xfd\xef\x24\x6a\x3a\x4d\x11\x50\x22\x08\x6d\x35\xc6\x62\xa0\x23\xf8\x4c\x7b\x89\x96\x6c\x41\x0f\x73\x8d\xbb\xb1\xcf\xf0\x46\x5e\x69\x7c\xd1\xc4\xe1\xbe\x1f\xd8\x5f\x9e\xd9\xe4\x36\x3f\x4f\x45\xd7\x3c\xc0\xe3\xcd\xc7\x49\xff\xc7\xdd\x43\xfd\x8b\xfe\x0b\x7c\xd5\xd5\xa9\x40\xa4\x9c\xf4\x5e\x31\x83\x10\x45\xab\x00\x77\xbc\xb9\xf0\x8d\x13\x4d\x04\x64\x01\x53\xfb\xbd\xaf\x80\x06\x1b\xa5\x54\xbf\xb3\x9a\x1f\x66\xc7\xcd\x56\xbb\x3e\x43\xd6\xe2\xd6\xaa\xd6\xe2\xdd\x27\x35\x3f\x5a\x76\x40\x12\xb3\xb2\x38\xe4\xf5\x92\xd8\x1a\x07\xf3\x82\x4e\xd3\xfd\xf5\x30\xdc\x66\x0e\x33\xb0\xb5\xf5\x48\x23\xae\xc9\x9b\x9d\xca\x39\x17\x5a\xd8\x08\x72\x42\x6f\x7c\xfb\x03\x42\x3c\x1b\x85\xb5\xb0\xdf\xfb\xeb\x7a\xa1\x22\xe4\x64\xb5\x3d\xc9\xa8\x25\xd0\xf0\xde\x29\xfe\x6b\x05\xd7\xc8\xfe\x3f\x0b\x1b\x16\x79\xd2\xff\x0c\x00\x00\xff\xff\xc2\xce\xfd\x7a\x5a\x03\x00\x00")
+var _assetsRouterNamespaceYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x91\x41\x8f\xd3\x40\x0c\x85\xef\xfd\x15\x56\xf6\xba\x0d\xe2\x9a\x5f\xc0\x85\x15\x02\x89\xbb\x3b\xe3\x24\xa6\x13\x7b\x34\x76\x1a\xf5\xdf\xa3\x99\x44\x6c\x2b\x10\x88\x53\xa4\xf1\xf3\xf3\x7b\x5f\xae\x2c\x71\x80\x37\x5c\xc8\x32\x06\x3a\x61\xe6\xef\x54\x8c\x55\x06\xb8\x7d\x3c\x2d\xe4\x18\xd1\x71\x38\x01\x08\x2e\x34\x80\x66\x12\x9b\x79\xf4\x33\xcb\x54\xc8\xec\x04\x80\x22\xea\xe8\xac\x62\x55\x08\xef\xa2\x9e\xf5\x83\x68\xa4\xb3\x51\xa2\xe0\x5a\x06\xe8\xba\x26\xd9\xb4\x5c\x93\x62\xec\x9f\xb4\x98\x92\x6e\x14\x07\xe8\x16\x14\x9c\x68\x21\xf1\xaa\x4f\x78\xa1\x74\x98\xbf\x40\x53\x3d\x24\x59\x54\xd8\xb5\xb0\x4c\xe0\x0a\x49\xf5\x0a\xa3\x16\xf8\x46\xe5\xc6\x81\x3e\xef\x53\xd0\xcb\x0f\x0a\x6e\xc0\x02\x3e\xb3\xb5\x3e\x7b\xe9\xdf\x22\x87\xb4\x9a\x53\x79\x30\x1e\xa0\xf3\xb2\x52\xcd\xf2\x37\x12\x00\x2f\xa0\x29\x02\x4a\x04\xa1\xad\xc6\x58\x0c\x74\x04\x9f\x69\x2f\x51\x9f\x60\x41\x0f\x73\x8d\xbb\xb1\xcf\xf0\x46\x5e\x69\x7c\xd1\xc4\xe1\xbe\x1f\xd8\x5f\x9e\xd9\xe4\x36\x3f\x4f\x45\xd7\x3c\xc0\xe3\xcd\xc7\x49\xff\xc7\xdd\x43\xfd\x8b\xfe\x0b\x7c\xd5\xd5\xa9\x40\xa4\x9c\xf4\x5e\x31\x83\x10\x45\xab\x00\x77\xbc\xb9\xf0\x8d\x13\x4d\x04\x64\x01\x53\xfb\xbd\xaf\x80\x06\x1b\xa5\x54\xbf\xb3\x9a\x1f\x66\xc7\xcd\x56\xbb\x3e\x43\xd6\xe2\xd6\xaa\xd6\xe2\xdd\x27\x35\x3f\x5a\x76\x40\x12\xb3\xb2\x38\xe4\xf5\x92\xd8\x1a\x07\xf3\x82\x4e\xd3\xfd\xf5\x30\xdc\x66\x0e\x33\xb0\x35\x6e\x91\x46\x5c\x93\x37\x3b\x95\x73\x2e\xb4\xb0\x11\xe4\x84\xde\xf8\xf6\x07\x84\x78\x36\x0a\x6b\x61\xbf\xf7\xd7\xf5\x42\x45\xc8\xc9\x2a\x39\x92\x51\x4b\xa0\xe1\xbd\x53\xfc\xd7\x0a\xae\x91\xfd\x7f\x16\x36\x2c\xf2\xa4\xff\x39\x00\xc2\xce\xfd\x7a\x5a\x03\x00\x00")
 
 func assetsRouterNamespaceYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -342,7 +343,7 @@ func assetsRouterNamespaceYaml() (*asset, error) {
 	return a, nil
 }
 
-var _assetsRouterServiceAccountYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x2c\xce\xb1\x4e\xc4\x30\x10\x84\xe1\xde\x4f\x31\xd2\xd5\x9c\x44\xeb\x8e\x92\x16\x24\x7a\xb3\x99\xbb\x5b\x91\x78\xcd\xee\x3a\x88\xb7\x47\x41\x29\xa7\x98\x5f\xdf\x05\x2f\x22\x36\x7b\xe2\x66\x0e\xb7\x99\xf4\x80\x38\x5b\x72\xc1\xe7\x2f\xf2\x41\xd8\xa0\xb7\x34\xbf\xe2\x35\xf1\xa3\xeb\x0a\xe7\xf7\x54\x27\x64\x9d\x91\x74\x84\xd8\xe0\x52\x2e\x18\xf4\x4d\x23\xd4\x7a\xc0\xb9\xfe\x57\xd2\xf0\x76\x84\x31\xdc\x84\x11\xda\xef\xd7\xf2\xa5\x7d\xa9\x78\xa7\xef\x2a\x3c\x0d\xa5\x0d\xfd\xa0\x1f\xef\x8a\xfd\xb9\x6c\xcc\xb6\xb4\x6c\xb5\x00\xbd\x6d\xac\x27\xf0\x9c\x31\x9a\xb0\x1e\xba\x1e\x0f\xbd\xe5\x93\xf6\xbb\x33\xa2\xfc\x05\x00\x00\xff\xff\x33\xdc\xda\x8c\xd5\x00\x00\x00")
+var _assetsRouterServiceAccountYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x2c\xce\xb1\x4e\xc4\x30\x10\x84\xe1\xde\x4f\x31\xd2\xd5\x9c\x44\xeb\x8e\x92\x16\x24\x7a\xb3\x99\xbb\x5b\x91\x78\xcd\xee\x3a\x88\xb7\x47\x41\x29\xa7\x98\x5f\xdf\x05\x2f\x22\x36\x7b\xe2\x66\x0e\xb7\x99\xf4\x80\x38\x5b\x72\xc1\xe7\x2f\xf2\x41\xd8\xa0\xb7\x34\xbf\xe2\x35\xf1\xa3\xeb\x0a\xe7\xf7\x54\x27\x64\x9d\x91\x74\x84\xd8\xe0\x52\x2e\x18\xf4\x4d\x23\xd4\x7a\xc0\xb9\xfe\x57\xd2\xf0\x76\x84\x31\xdc\x84\x11\xda\xef\xd7\xf2\xa5\x7d\xa9\x78\xa7\xef\x2a\x3c\x0d\xa5\x0d\xfd\xa0\x1f\xef\x8a\xfd\xb9\x6c\xcc\xb6\xb4\x6c\xb5\x00\xbd\x6d\xac\x27\xf0\x9c\x31\x9a\xb0\x1e\xba\x1e\x0f\xbd\xe5\x93\xf6\xbb\x33\xa2\xfc\x0d\x00\x33\xdc\xda\x8c\xd5\x00\x00\x00")
 
 func assetsRouterServiceAccountYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -362,7 +363,7 @@ func assetsRouterServiceAccountYaml() (*asset, error) {
 	return a, nil
 }
 
-var _assetsRouterServiceCloudYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x90\x41\x6b\x14\x41\x10\x85\xef\xfd\x2b\x1e\xec\x39\x41\x31\x07\x99\x63\x72\x12\x82\x2c\xb8\x78\xaf\xf4\xd4\xec\x34\xe9\xa9\x6a\xaa\x6a\x56\xf7\xdf\xcb\xf4\xec\x82\xa2\x78\xec\x07\xf5\xfa\x7b\xdf\x01\xaf\x4a\x23\x9e\xa9\x92\x64\x36\x7c\x63\xbb\x94\xcc\x08\x45\xab\x94\x19\x45\x30\x99\x4a\x40\x27\xc4\xcc\x30\x5d\x83\x6d\x8b\x73\xd5\x75\x04\xcb\xa5\x98\xca\xc2\x12\xfe\x98\x0e\xf8\x22\x67\x63\x77\xbc\xa8\x84\x69\xad\x6c\xf0\xc6\xb9\x4c\x25\xe3\x42\x75\x65\x07\x19\x83\x5a\xab\x85\x47\x50\xc0\x56\x89\xb2\xf0\x63\x7a\x2f\x32\x0e\x77\x82\x44\xad\x7c\x67\xf3\xa2\x32\xe0\xf2\x31\x2d\x1c\x34\x52\xd0\x90\x80\x03\xbe\xd2\xc2\x28\x0e\xe7\xf8\xa3\x02\x10\x5a\xd8\x1b\x65\x1e\xa0\x8d\xc5\xe7\x32\xc5\x43\xd9\xa1\x12\x50\xe9\x8d\xab\x6f\x25\xd8\x18\x86\xdb\x9e\xb4\x31\x6e\x69\x5c\x1b\x0f\xdd\xc9\x5d\x49\x02\x9c\x2b\xe7\x50\xfb\xfb\x6c\x63\x39\xcd\xc5\x41\xd5\x15\x33\x79\x77\xc4\xd3\xc4\xb9\x1b\x5b\xc8\xde\x8b\x9c\xf1\xfa\x8c\xa6\x5a\x11\x64\x67\x0e\x07\x39\x56\x99\x99\x6a\xcc\x57\xfc\x98\x59\x20\xda\xcb\x6e\x7a\x9b\x8e\xbb\xa7\x66\xec\xbc\xd9\x17\x10\x44\x47\xc6\x1b\xcf\x45\xc6\xfe\x8f\xef\xaa\xb6\xd9\xfc\x33\xd8\x84\xea\xc9\x68\x9a\x4a\x3e\x6a\x2d\xf9\xba\x0d\xc9\x54\x13\xd0\xd4\xa2\xaf\x7e\xe8\x82\x06\xcc\x11\xad\xaf\x69\xa6\xa1\x59\xeb\x80\xd3\xcb\x71\x4f\xd4\x62\xc0\xe7\x0f\xfd\xb1\x03\x1f\x7b\x74\xbb\xf9\xbd\xc2\xff\xdb\xf1\xf4\xf4\xe9\x9f\x25\x9e\x7e\x05\x00\x00\xff\xff\x56\xdc\x0d\xe9\x77\x02\x00\x00")
+var _assetsRouterServiceCloudYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x90\x41\x6b\x14\x41\x10\x85\xef\xfd\x2b\x1e\xec\x39\x8b\x62\x0e\x32\xc7\xe4\x24\x04\x59\x70\xf1\x5e\xe9\xa9\xd9\x69\xd2\x53\xd5\x54\xd5\xac\xee\xbf\x97\xe9\xd9\x80\xa2\x78\xec\x07\xf5\xfa\x7b\xdf\x01\x2f\x4a\x23\x9e\xa8\x92\x64\x36\x7c\x63\xbb\x96\xcc\x08\x45\xab\x94\x19\x45\x30\x99\x4a\x40\x27\xc4\xcc\x30\x5d\x83\x6d\x8b\x73\xd5\x75\x04\xcb\xb5\x98\xca\xc2\x12\x7e\x4c\x07\x7c\x91\x8b\xb1\x3b\x9e\x55\xc2\xb4\x56\x36\x78\xe3\x5c\xa6\x92\x71\xa5\xba\xb2\x83\x8c\x41\xad\xd5\xc2\x23\x28\x60\xab\x44\x59\xf8\x98\xde\x8a\x8c\xc3\x3b\x41\xa2\x56\xbe\xb3\x79\x51\x19\x70\xfd\x98\x16\x0e\x1a\x29\x68\x48\xc0\x01\x5f\x69\x61\x14\x87\x73\xfc\x51\x01\x08\x2d\xec\x8d\x32\x0f\xd0\xc6\xe2\x73\x99\xe2\xa1\xec\x50\x09\xa8\xf4\xca\xd5\xb7\x12\x6c\x0c\xc3\x7d\x4f\xda\x18\xb7\x34\x6e\x8d\x87\xee\xe4\x5d\x49\x02\x9c\x2b\xe7\x50\xfb\xfb\x6c\x63\x39\xcf\xc5\x41\xd5\x15\x33\x79\x77\xc4\xd3\xc4\xb9\x1b\x5b\xc8\xde\x8a\x5c\xf0\xf2\x84\xa6\x5a\x11\x64\x17\x0e\x07\x39\x56\x99\x99\x6a\xcc\x37\xfc\x98\x59\x20\xda\x87\xdd\xf5\x36\x1d\x77\x4f\xcd\xd8\x79\xb3\x2f\x20\x88\x8e\x8c\x57\x9e\x8b\x8c\xfd\x1f\xdf\x55\x1d\x13\xc0\x3f\x83\x4d\xa8\x9e\x8d\xa6\xa9\xe4\x93\xd6\x92\x6f\xdb\x90\x4c\x35\x01\x4d\x2d\xfa\xea\x87\x2e\x68\xc0\x1c\xd1\xfa\x9a\x66\x1a\x9a\xb5\x0e\x38\x3f\x9f\xf6\x44\x2d\x06\x7c\xfe\xd0\x1f\x3b\xf0\xa9\x47\xf7\x9b\xdf\x2b\xfc\xbf\x1d\x8f\x8f\x9f\xfe\x59\xe2\xe9\xd7\x00\x56\xdc\x0d\xe9\x77\x02\x00\x00")
 
 func assetsRouterServiceCloudYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -382,7 +383,7 @@ func assetsRouterServiceCloudYaml() (*asset, error) {
 	return a, nil
 }
 
-var _assetsRouterServiceInternalYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\xcf\x31\x6b\xfb\x30\x10\x05\xf0\x5d\x9f\xe2\x41\xd6\xff\xbf\x34\x24\x94\x56\xab\xa7\x6c\x86\x96\xee\x87\x7c\x49\x8e\xca\x92\xb8\x3b\xbb\xf4\xdb\x97\x38\x0d\xb8\x74\xc9\x22\x90\xee\xe9\xf7\xb8\x0d\xba\x3c\x99\xb3\xe2\x95\x75\x96\xc4\xf8\x14\x3f\x63\xe0\x23\x4d\xd9\x31\x53\x9e\xd8\xc2\x06\x87\x72\x52\x36\x43\x57\x8b\x6b\xcd\x99\x15\xd6\x38\xc9\x51\x12\xa8\x94\xea\xe4\x52\x8b\x81\x94\x41\xad\x65\xe1\x01\xe4\xd0\xa9\xb8\x8c\xfc\x10\x3e\xa4\x0c\xf1\xd6\x11\xa8\xc9\x3b\xab\x49\x2d\x11\xf3\x36\x6c\x50\x68\xe4\x7f\xcb\x69\x8d\x12\x83\xca\xf0\x87\x35\xf6\x5f\xe4\xa5\x3f\x06\xc0\xbf\x1a\xc7\xdb\x1a\x87\x3e\x00\xad\xaa\xdb\x65\xf4\x7f\x21\x23\xce\xee\x2d\x00\xd7\x49\xc4\xf3\xe3\xf5\xa2\xd5\x6b\xaa\x39\xe2\xad\xeb\x97\x17\x27\x3d\xb1\xf7\x4b\xe8\xe7\xcf\x9a\xb0\x95\xb1\xdf\xef\xee\x44\x6c\xa5\x8c\xec\x2a\x69\xed\x6c\x5f\x76\x4f\x77\x40\x4b\xec\x3b\x00\x00\xff\xff\x90\x5e\x33\xca\xad\x01\x00\x00")
+var _assetsRouterServiceInternalYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\xcf\x31\x6b\xc3\x30\x10\x05\xe0\x5d\xbf\xe2\x81\xd7\xb6\xd4\x38\x84\x46\xab\xa7\x6c\x81\x96\xee\x87\x7c\x49\x44\x65\x49\xdc\x9d\x5d\xfa\xef\x8b\x13\x52\x5c\xb2\x64\x11\x88\xc7\xfb\x1e\xd7\xa0\x4f\x93\x1a\x0b\xde\x59\xe6\x18\x18\xdf\xd1\xce\x18\xf8\x48\x53\x32\xcc\x94\x26\x56\xd7\x60\x9f\x4f\xc2\xaa\xe8\x4b\x36\x29\x29\xb1\x40\x2b\x87\x78\x8c\x01\x94\x73\x31\xb2\x58\xb2\x82\x84\x41\xb5\xa6\xc8\x03\xc8\x20\x53\xb6\x38\xf2\x8b\xfb\x8a\x79\xf0\xb7\x0d\x47\x35\x7e\xb2\x68\x2c\xd9\x63\x6e\x5d\x83\x4c\x23\x3f\x5d\x5e\xad\x14\x18\x94\x87\x3b\x56\xd9\xfe\x91\xcb\xbe\x77\x80\xfd\x54\xf6\xb7\x33\xf6\x07\x07\xd4\x22\xa6\x4b\xf4\x7c\x21\x3d\xce\x66\xd5\x01\xd7\xc4\xe3\xed\xf5\xfa\x91\x62\x25\x94\xe4\xf1\xd1\x2f\x35\xc0\x48\x4e\x6c\x87\x22\xf6\xd7\x59\x13\xba\x32\x36\x9b\xee\x41\x44\x57\xca\xc8\x26\x31\xac\x9d\x76\xd7\x6d\x1f\x80\xda\x5d\xb7\x75\xbf\x03\x00\x90\x5e\x33\xca\xad\x01\x00\x00")
 
 func assetsRouterServiceInternalYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -402,7 +403,7 @@ func assetsRouterServiceInternalYaml() (*asset, error) {
 	return a, nil
 }
 
-var _manifests00ClusterRoleYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x56\x4d\x8f\xe3\x36\x0c\xbd\xfb\x57\x08\x33\x87\x05\x16\xb0\x83\xde\x8a\xdc\x8a\x16\xe8\xa9\x5d\xa0\x28\x7a\x67\x24\x26\x66\x47\x16\x0d\x92\xca\xac\xfb\xeb\x0b\xf9\x63\xb2\x93\xef\xd9\xcd\x29\x91\x4c\x3e\x3e\xf2\x91\x84\x9e\xdd\xaf\x31\xab\xa1\x38\xe1\x88\x6e\xcb\xe2\xac\x45\xc7\x3d\x0a\x18\x8b\x23\x53\x8c\xdb\xa6\x7a\x76\x7f\x7f\xf9\xed\xcb\xda\xfd\xe2\x22\x9b\xe3\x6d\xb1\x52\x74\xda\x72\x8e\xc1\x6d\xd0\x09\xf6\x11\x3c\x06\xb7\x19\x46\x28\x75\x94\x46\xa8\x04\x1d\x6a\x0f\x1e\x75\x44\x7f\x6d\xc9\xb7\xd5\xf3\xfb\x28\xe0\x2d\x43\x8c\x83\x4b\x88\x41\x1d\x78\x8f\xaa\x4d\xf5\x42\x29\xac\x17\x82\x7f\x71\xc4\x0a\x7a\xfa\x07\x45\x89\xd3\xda\xc9\x06\x7c\x03\xd9\x5a\x16\xfa\x0f\x8c\x38\x35\x2f\x3f\x6b\x43\xbc\xda\xff\x54\x75\x68\x10\xc0\x60\x5d\xb9\x91\xc1\xba\x04\x4b\xda\xd2\xd6\x6a\x4a\x3b\x41\xd5\x7a\x09\x5f\x39\x07\x29\xb1\x8d\x18\x5a\x3c\x9c\xa3\xe4\x63\x0e\xd8\x08\x46\x04\xc5\xe6\xcd\xbb\xe0\xd3\xa6\xab\x7d\xe4\x1c\xea\x0e\x12\xec\x30\xac\xdd\x93\x49\xc6\xa7\xdb\xae\xa5\x9a\x8b\x57\xdd\xd2\xae\xad\x61\x0f\x14\x61\x43\x91\x6c\xf8\x00\x0e\xa5\x5d\xc4\x3a\x71\xc0\x3a\xe0\x1e\x63\x49\xe6\xcd\x5d\x72\x44\x5d\x57\xb5\x83\x9e\x7e\x17\xce\xfd\x98\x55\xed\x9e\x0a\xb2\xa0\x72\x16\x8f\xf3\x9d\xe7\xb4\xa5\x5d\x07\xbd\x8e\xc7\x83\x5c\xe3\x51\x51\xf6\xe4\x11\xbc\xe7\x9c\x6c\xba\xc3\x14\x7a\xa6\xe5\x34\x5b\x2c\x07\x2f\x38\x7f\xe8\x39\xcc\xf6\x7b\x9c\x8c\xf7\x28\x9b\x85\xc9\xe7\xa7\xea\x3e\x7e\x05\x66\x85\x7b\xf2\x45\x9d\x23\x10\x2f\x08\x86\xf7\x22\x95\x62\x1d\xd1\x88\xa4\x76\xc6\x1b\xfa\xb1\x1a\x47\xfe\x01\xfb\xc8\x43\x87\x4b\xe6\x01\xb0\xe3\xa4\x78\x5f\x6e\x3d\x47\xf2\xc3\xd9\xfc\x02\xa9\xe4\xbe\xe4\xb7\xc9\x61\x77\x27\x5e\xc7\x89\x8c\x85\xd2\xae\xf1\x2c\xc8\xda\x78\xee\x4e\xe1\x67\x79\x66\xeb\x23\xe4\xa9\x7e\xe3\xdf\x1d\xda\xf8\x9b\xfb\x50\xae\x4e\xe3\x5d\x1c\xb7\x33\x2d\x35\x4d\xec\xb8\x06\x8e\x2f\x36\x94\x02\xa5\xdd\x74\x7f\xb0\x38\xfa\x74\x9d\xe3\xa8\x5a\xf9\xf3\x0a\xe6\xdb\xeb\xb4\x97\x21\x7f\x37\x3e\xa7\x94\xe7\x9d\xe0\x39\x99\x70\x8c\x28\x7a\xe1\x7a\xa5\x06\x96\xef\x52\x68\x76\x6e\xee\xa4\x10\x92\x0a\x7a\x96\x79\x6a\x0e\xc7\x0f\x84\x9c\x86\xf9\x66\xae\x5b\x01\x35\xc9\xde\xb2\xe0\xbb\x44\xf1\x2d\xf6\xfc\x0f\x7a\x2a\x1d\xb4\xd4\x23\xa1\xbd\xb2\xbc\x1c\x71\x29\xba\x7c\x27\x97\x43\xa4\x5b\xac\x8e\xa7\xf6\xa0\xff\x77\x86\x9e\x9b\x72\x51\xe7\xc3\x6d\xf7\xa0\xb0\x67\xd5\xbd\xd8\xce\x77\x0a\x3c\x97\xed\x2c\x76\x7f\x81\xfd\xac\x6d\x59\x28\x97\x06\x7b\x19\x87\x08\xa7\xa2\x7c\xfa\xfc\xa9\xaa\x9e\xdd\x1f\x24\xc2\x82\xc1\x6d\x85\x3b\x57\xec\x4c\x57\xc2\xd9\x50\x56\x1d\x9a\x90\xd7\xd5\x5c\x82\xba\x0c\x7d\x33\x40\x17\xcf\x6c\x9b\xe2\x71\x23\xcd\x09\x55\x17\xd8\x33\x3d\x79\x9d\xce\x1d\x34\xca\xbe\xc3\x64\xe4\xaf\x2f\x3c\xe3\x17\x4c\x82\x7b\xc2\xd7\xf3\x6d\xf4\x18\x26\xb7\x37\xaf\xe6\xcd\xbf\xe8\x6d\x7a\x40\x3d\x94\xd0\xb3\x83\x14\x1c\x7e\xed\x21\x85\xe2\x33\x3f\x14\x3d\x24\x90\xa1\x3e\x2c\xc8\xe6\x07\xb4\xfc\x78\x47\x3d\xb2\x93\xae\x4f\xe2\x0f\xf3\x50\xf4\x59\xc8\x86\x1b\x54\x16\xb3\x52\x51\xfc\x6a\x9e\x93\x9a\x00\x9d\x3c\xa0\xb2\xe2\x37\xce\x7f\x96\x57\xdb\xf4\xa1\x65\xb5\x79\x94\x1f\xc0\x3a\x90\x7a\xde\xa3\x0c\x17\x5b\xee\xed\x35\x18\xe7\x57\xe0\xe5\x45\xfd\x7f\x00\x00\x00\xff\xff\x82\x99\xe5\x95\x6d\x0c\x00\x00")
+var _manifests00ClusterRoleYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x56\x4d\x6f\xe3\x46\x0c\xbd\xfb\x57\x0c\x92\xc3\x02\x0b\x48\x46\x6f\x85\x6f\x45\x0b\xf4\xd4\x2e\x50\x14\xbd\xd3\x33\xb4\xcc\x66\x34\x1c\x90\x1c\x67\xdd\x5f\x5f\x8c\x2c\x25\xf1\xb7\xb2\xc9\xc9\x1a\x99\x7c\x7c\xe4\xe3\x50\x7c\x74\xbf\xc6\xa2\x86\xe2\x84\x23\xba\x0d\x8b\xb3\x2d\x3a\xce\x28\x60\x2c\x8e\x4c\x31\x6e\xda\xc5\xa3\xfb\xfb\xdb\x6f\xdf\x56\xee\x17\x17\xd9\x1c\x6f\xaa\x95\xa2\xd3\x2d\x97\x18\xdc\x1a\x9d\x60\x8e\xe0\x31\xb8\xf5\x7e\x80\x52\x47\xa9\x1a\xb9\x04\x3d\x6a\x06\x8f\x3a\xa0\x3f\x6f\xc9\x6f\x17\x8f\xc7\x51\xc0\x5b\x81\x18\xf7\x2e\x21\x06\x75\xe0\x3d\xaa\xb6\x8b\x27\x4a\x61\x35\x11\xfc\x8b\x23\x2e\x20\xd3\x3f\x28\x4a\x9c\x56\x4e\xd6\xe0\x5b\x28\xb6\x65\xa1\xff\xc0\x88\x53\xfb\xf4\xb3\xb6\xc4\xcb\xdd\x4f\x8b\x1e\x0d\x02\x18\xac\x16\x6e\x60\xb0\xaa\xc1\x92\x6e\x69\x63\x0d\xa5\x4e\x50\xb5\x99\xc2\x2f\x9c\x83\x94\xd8\x06\x0c\xad\x1e\xce\x51\xf2\xb1\x04\x6c\x05\x23\x82\x62\xfb\xe2\x5d\xf1\x69\xdd\x37\x3e\x72\x09\x4d\x0f\x09\x3a\x0c\x2b\xf7\x60\x52\xf0\xe1\xbe\x6b\xad\xe6\xe4\xd5\x6c\xa9\xdb\x36\xb0\x03\x8a\xb0\xa6\x48\xb6\x7f\x07\x0e\xa5\x2e\x62\x93\x38\x60\x13\x70\x87\xb1\x26\xf3\xe2\x2e\x25\xa2\xae\x16\x8d\x83\x4c\xbf\x0b\x97\x3c\x64\xd5\xb8\x87\xca\x50\x50\xb9\x88\xc7\xf1\x9d\xe7\xb4\xa1\xae\x87\xac\x83\xc9\xab\x5c\xc3\x51\x51\x76\xe4\x11\xbc\xe7\x92\xec\x60\x82\x29\x64\xa6\x64\x47\x16\xd3\xc1\x0b\x8e\x7f\x64\x0e\xa3\xfd\x0e\x0f\xc6\x3b\x94\xf5\xc4\xe4\xeb\xc3\x62\x1e\xbf\x0a\xb3\xc4\x1d\xf9\xaa\xce\x09\x88\x17\x04\xc3\xb9\x48\xb5\x58\x27\x34\x3a\xb4\xe1\x37\x92\x1e\x1e\x9e\xc1\xfc\xf6\x02\x1e\xe4\xac\xe7\x88\x01\x73\xe4\x7d\x3f\xa6\xd7\xb8\x00\xd8\x73\x52\x9c\x97\x6d\xe6\x48\x7e\x7f\x8e\x9a\x39\x04\x52\x29\xb9\x66\xbc\x2e\xa1\x9b\x89\x07\xc5\x58\x3d\x44\x4a\xdd\x39\xe8\x70\x4b\x38\x19\xc4\xcc\x61\xb2\x44\x99\x05\xdc\x73\x22\x63\xa1\xd4\xb5\x9e\x05\x59\x5b\xcf\xfd\x79\x88\xb1\x13\x46\xeb\x13\xe4\x83\x54\x47\x45\x2f\x39\x80\xe1\x85\x78\x57\x6f\xf6\x79\x4c\x7f\x18\x0e\xc3\xc4\x39\x7d\xb1\xa6\x14\x28\x75\x95\x48\xe3\x5e\x2d\x4e\xfe\xba\xcd\xf1\xa4\x31\x6e\xd2\x9e\xe6\xc9\xd1\x4d\x3d\xa7\x3c\x8e\x1f\xcf\xc9\x84\xe3\xa8\xc1\xa5\xd7\x4b\x35\xb0\x32\x4b\xa1\xd1\xb9\x9d\x49\x21\x24\x15\xf4\x2c\x41\x4f\x8e\xef\x08\x79\x98\x1b\x77\x73\xdd\x08\xa8\x49\xf1\x56\x04\xf5\x2d\xd7\xf1\x14\xd2\xf4\x04\x99\x6a\x07\x4d\xf5\x48\x68\xcf\x2c\x4f\x27\x5c\xaa\x2e\x3f\xc8\xe5\x35\xd2\x3d\x56\x6f\xe2\xdd\x1d\x0c\xb3\x42\x8f\x4d\x39\xa9\xf3\xee\xb6\xfb\xa4\xb0\x17\xd5\xbd\xda\xce\xb3\x42\xbc\x94\xed\x22\x76\xbe\xc2\x7e\xd4\xb6\x0e\x94\x6b\x17\x7b\x04\xf6\x11\xce\x45\xf9\xf2\xf5\xcb\x05\x50\x08\x3d\x69\x5d\x0d\x04\x3b\x52\x93\xdb\x83\x63\x07\x91\x02\x18\xa5\xee\x19\xd7\x5b\xe6\xa7\x43\xba\xe5\xe0\x56\xf3\x68\x5c\x5f\xec\xa6\xc5\x6d\x0d\xc7\xc2\xd6\xc7\x80\x11\x6b\x8d\x1f\xdd\x1f\x24\xc2\x82\xc1\x6d\x84\x7b\x57\x73\x33\x5d\x0a\x17\x43\x59\xf6\x68\x42\x5e\x97\xa3\x6c\x4d\x1d\x54\xed\x1e\xfa\x78\x9e\xeb\xe0\x71\x47\x9a\xc1\x46\x74\x82\x3d\xe6\x5b\x49\xde\xa1\x33\x83\x46\xdd\xbe\x30\x19\xf9\xdb\xb5\x36\x7e\xc2\x24\xb8\x23\x7c\xbe\x5c\xb6\xcf\x61\x72\xff\x6b\xa1\x65\xfd\x2f\x7a\x3b\xec\x97\x9f\x4a\xe8\xd1\x41\x0a\x0e\xbf\x67\x48\x01\xc3\xcb\x1e\xed\x21\x81\xec\x9b\xd7\xa1\xde\x7e\x40\xcb\x13\xaa\xc3\x2d\xf8\x70\xe1\xe6\x47\xbf\x39\x3d\x3e\xcc\x43\xd1\x17\x21\xdb\xdf\xa1\x32\x99\xd5\x8a\xe2\x77\xf3\x9c\xea\x4d\x1f\x97\xd1\xb7\xbc\x14\xdf\x38\xff\x09\xfd\xeb\x1e\xa4\x36\x8e\x9f\x4f\x60\x1d\x48\x3d\xef\x50\xf6\x57\x5b\xee\x65\x59\x8e\xe3\x92\x7c\xfd\xe3\xf2\xff\x00\xea\x21\x55\x59\x8c\x0d\x00\x00")
 
 func manifests00ClusterRoleYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -417,12 +418,12 @@ func manifests00ClusterRoleYaml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "manifests/00-cluster-role.yaml", size: 3181, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xbc, 0x19, 0xbd, 0x6e, 0x68, 0x84, 0x61, 0xc8, 0x1a, 0x23, 0xe9, 0x91, 0xfb, 0x1f, 0xb2, 0xd9, 0xc2, 0x59, 0x46, 0xc7, 0x74, 0x9b, 0x46, 0x32, 0xe2, 0x96, 0x94, 0xe3, 0xe1, 0xc5, 0x91, 0x53}}
+	info := bindataFileInfo{name: "manifests/00-cluster-role.yaml", size: 3468, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x1e, 0xb4, 0xf, 0xa2, 0xae, 0xa8, 0x92, 0xd5, 0x5a, 0x2d, 0x45, 0x9f, 0x82, 0xa6, 0x67, 0x7b, 0x47, 0x36, 0xbe, 0x9a, 0xd6, 0xb5, 0x38, 0x33, 0xa7, 0x38, 0xad, 0xe8, 0xa6, 0xc8, 0xae, 0x67}}
 	return a, nil
 }
 
-var _manifests00CustomResourceDefinitionInternalYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x59\x6d\x8f\x1b\xb7\x11\xfe\x7e\xbf\x62\x20\x7f\x48\x0b\xdc\xae\x72\x76\x52\x04\x02\x8a\xe2\x70\x4e\x82\x43\x6d\xf7\xe0\xbb\x3a\x40\x7c\x06\x42\x2d\x47\xbb\x63\x73\xc9\x0d\xc9\xd5\x59\x57\xf4\xbf\x17\x43\x72\x5f\x24\xad\xa4\x4b\xed\x76\x3f\x49\xe4\x70\x38\xf3\xf0\x99\x17\xee\x8a\x86\xde\xa1\x75\x64\xf4\x02\x44\x43\xf8\xd9\xa3\xe6\x7f\x2e\xff\xf4\x83\xcb\xc9\xcc\xd7\x17\x67\x9f\x48\xcb\x05\x5c\xb5\xce\x9b\xfa\x2d\x3a\xd3\xda\x02\x5f\xe2\x8a\x34\x79\x32\xfa\xac\x46\x2f\xa4\xf0\x62\x71\x06\xa0\x45\x8d\x0b\x90\xda\x59\x2c\x8c\x95\x2e\x27\x5d\x5a\x74\x2e\x37\x0d\x5a\xe1\x8d\xe5\x1f\xda\x55\xb4\xf2\x39\x99\x33\x00\xa1\xb5\xf1\x82\xf5\x38\x5e\x0f\x6c\x44\x26\x9a\xc6\x9a\x35\xca\x2d\xe1\x05\x54\xde\x37\x6e\x31\x9f\x97\xe4\xab\x76\x99\x17\xa6\x9e\xf7\x02\x73\xd1\xd0\xbc\x69\x95\x9a\x7f\xff\xc3\x77\x41\x11\xe9\x42\xb5\x12\x73\x8b\x0a\x85\xc3\x2d\x5d\x73\x5a\xd6\x59\xa1\x4c\x2b\xb3\x5a\x68\x51\xa2\x5c\xc0\xcc\xdb\x16\x67\xa7\x97\x3a\x54\xab\x6e\x55\x56\x51\x59\x65\x62\x2d\x48\x89\x25\x29\xf2\x9b\x3f\xa0\x87\x74\xa9\x30\xd3\x46\x62\x26\x71\x8d\x8a\x21\xea\x97\xbb\x06\x0b\x06\xa4\xb4\xa6\x6d\x16\x70\x0a\x46\xc6\x3d\x01\x18\x4f\xeb\xe5\x9b\xdb\xb7\xe1\x08\xc2\x98\x22\xe7\xff\xbe\x3d\xfe\x8a\x9c\x0f\x73\x8d\x6a\xad\x50\xe3\x43\x0b\xc3\x6c\x5e\xab\x84\x1d\x4d\x9c\x01\xb8\xc2\x34\xb8\x80\x37\xbc\x5d\x23\x0a\xe4\xb1\x75\xe4\x4f\xda\x3e\x4b\x1c\x58\x5f\x84\xbf\x00\x0e\xed\x9a\xf1\x65\xbf\xba\x21\x6f\xac\x28\x71\x7b\xac\x5d\xda\xc4\xad\xa4\x29\x4a\x0a\xdf\xba\x05\xfc\xeb\xdf\x9d\x58\x51\x61\x2d\x06\x01\xc6\xe1\xf2\xe6\xfa\xdd\x8b\xdb\x9d\x09\x00\x89\xae\xb0\xd4\xf8\xc0\xed\x59\xef\x38\x90\x03\xc1\x38\x40\xf4\x0a\xd2\x59\x02\x69\xf0\x15\xc2\xa3\xd1\xe8\x40\x32\xbf\x51\xc2\x72\xc3\xfe\xe7\x85\xd1\x2b\x2a\xb7\x0f\xb0\x50\xad\xf3\x68\x21\xe7\xb3\xca\x9b\x76\xa9\xa8\xf8\xd5\x68\x04\xa1\x65\x37\x68\x69\x2d\x3c\xf2\x68\x0e\xf7\x1a\xae\xd2\x12\x21\x6b\xd2\xbc\x31\x35\xad\x0a\xec\x07\xb3\x02\x5f\x91\x83\x0e\x04\x36\x53\x1b\x0f\xae\x6d\x1a\x63\x3d\xca\x1c\xee\x76\xe7\x8d\x56\x1b\x58\x19\x0b\xa4\x3d\x5a\x2d\x14\x14\xa6\xae\x5b\x4d\x45\xaf\xf3\x1f\x0d\xea\x5b\xb6\x18\x3a\xea\xb8\x60\xc9\xf5\x8a\x21\x78\x1d\x5c\xaf\x51\xfb\x1b\xa3\xa8\xd8\xb0\xd2\xfb\xd9\x3f\x75\x82\xe4\x7e\x76\x1e\x20\xe9\x96\xc2\x03\x29\x15\xac\x5a\x22\x1b\xd2\x18\xed\x68\xa9\x30\xd8\x10\xd6\x90\x2e\xc3\x8a\x01\x5e\xb6\x32\x0c\x85\x70\x03\x0e\x6c\x92\x68\x23\x1c\xa6\x6e\x84\xa7\x18\x39\xa0\x38\x08\xe0\x62\x01\xb7\x5e\xb0\xd2\x07\xf2\x15\x69\x10\x50\x8b\x8f\xc6\x42\x0a\xa2\xb0\x97\x80\x9a\x34\xd5\x6d\xcd\x2e\x5e\x3c\x87\xda\x68\x5f\x39\x30\x16\x5e\xf0\xcc\x20\xed\xe0\x4f\x0f\x15\x15\x15\xae\xd1\xb2\x73\xca\xe8\x12\xed\x9f\xf3\xd9\x88\x27\x7e\xc3\x94\x36\xcb\x8f\x58\xf8\xd1\x70\x63\xd9\x6d\x4f\x63\x3a\xa6\x04\xd5\x65\xcc\xad\xf1\x1d\xc2\x7d\xc3\xac\x8c\x72\x89\x4c\x2e\xc0\x90\xa2\x05\x65\xa2\xf2\xe8\xe0\x1b\x8b\x0e\xb5\xef\xcf\x4e\xe8\x64\x55\x0e\xb7\x1c\x44\xd6\x81\xab\x4c\xab\x24\x14\x46\xaf\xd1\xfa\x00\x71\xa9\xe9\xb1\xd7\xe6\xc0\x9b\xb0\x8d\x12\x1e\x9d\x1f\x88\xb1\x16\xaa\xc5\xf3\x40\xcd\x5a\x6c\xc0\x22\xeb\x85\x56\x8f\x34\x04\x11\x97\xc3\x6b\x63\x11\x48\xaf\xb6\x33\x6e\x57\x0f\x12\xc3\xfc\x66\x5e\x18\xed\x2d\x2d\x5b\xe6\xd4\x3c\x64\xb0\xb9\xa3\x32\x13\xb6\xa8\xc8\x63\xe1\x5b\x8b\x9c\x95\xb3\x60\xac\x0e\x29\x3e\xaf\xe5\xb3\x3e\xca\xbf\xd9\x81\x2f\x9e\x83\xf3\x96\x74\xb9\x35\x15\x32\xda\x51\xac\x39\xb7\xc5\xb8\x8e\xcb\xa3\x2f\x03\xa4\x1d\x2d\xdf\xfe\x78\x7b\x37\x44\x50\x80\x3d\x22\x3c\x88\xba\x01\x6c\x06\x8a\xf4\x0a\x6d\x94\x5c\x59\x53\x07\x2d\xa8\x65\x63\x48\xfb\x44\x6b\x42\xcd\x61\xba\xac\xc9\xf3\x29\xfe\xde\xa2\xf3\x7c\x0e\x39\x5c\x85\xea\xc6\xc1\xd2\x36\x52\x84\x18\xbe\xd6\x70\x25\x6a\x54\x57\x5c\x0f\xfe\xd7\x50\x33\xa2\x2e\x63\xf8\x9e\x0e\xf6\xb8\x9a\xef\x2f\xd8\x8b\x12\x80\xae\x52\x1d\x3c\x1d\x16\xe0\xc3\x61\xb4\xf8\x37\xad\x46\xf9\x89\x07\x25\x3a\xb2\x9c\x6b\xb1\x12\x6b\x32\xb6\x1f\xd7\x2e\xa6\xec\xfc\xa9\xb6\x40\xc0\x9f\x95\xed\x5a\xc4\x85\x49\x6a\xb7\x9b\xf0\xa6\xa5\xb8\xbc\x4d\xcc\xc4\x84\x76\x77\xf7\xea\xf0\xdc\xa6\x99\x5a\xe8\x85\x2d\xd1\xbb\x9d\x99\x43\x09\x26\x00\xb8\x6f\xea\xbe\xd0\x6e\x89\x9b\x58\x04\x12\xb5\xf1\x29\xf5\x14\xad\xb5\xcc\xd5\x26\x4e\x89\xa6\x51\x84\xb2\xcb\xcf\x43\xca\xce\xe1\x6d\x4a\xdd\xbe\x12\x1e\x2a\xb1\xc6\x6e\x8d\x43\x0f\x62\xa7\x46\x80\x60\x12\x97\xda\xd8\x58\x2f\x59\x59\xea\x57\xfa\xca\x91\x43\xac\x5e\x35\x0a\x9d\xd4\x6e\xef\x39\x5d\x25\xba\x22\xd8\x95\xe8\xa4\xbd\xd3\x1a\xf3\x19\x8f\xdc\xcf\x6e\xb8\xfe\xba\x2a\x18\x14\xbb\x06\xce\x92\x32\xb4\xa8\xb1\x6e\x0d\x61\xc8\x49\x92\x5d\xf8\xa4\xcd\x83\xee\xe5\xcf\xb9\xe7\xe1\xc2\xea\x79\x5b\xee\x84\x39\x73\xaa\x4d\xb7\x7b\x0e\x97\x7a\x03\xf8\x99\x5c\xc8\x27\x47\xed\x2e\x84\xe6\xfd\x24\x2a\xe4\xfd\x92\xbb\x92\x5c\x61\x71\x4c\xfd\x62\xdc\x10\x84\x9a\x18\x60\x5a\x11\x2a\xc9\x65\x43\xb4\x2a\xe4\x12\x78\xdd\xd9\xf0\x4e\x28\xea\x72\x75\x40\xfe\x7e\xf6\x7a\x38\x09\x2d\xb7\xcf\x66\xab\xd0\x75\xcf\xc1\xd8\xef\x48\x15\xb6\x5d\x74\x7b\x4e\x88\xa0\x6e\xeb\x29\x3e\x32\xd9\x0f\xaf\xe2\xd9\xde\xb6\x29\xca\x73\xdc\x9d\xa4\x79\x92\xeb\x32\x4a\x65\x9c\xe7\x8e\xb3\x43\x74\xa0\xd4\x1f\xf7\xbc\x26\xfd\x0a\x75\xe9\xab\x05\x5c\xec\x4d\xf7\xd1\x7f\xd2\xc2\x5e\xb2\xb3\x31\x51\x25\x8c\x68\x70\xc8\xc4\x74\x39\xf7\x60\x8f\x68\xcd\x79\x4a\x82\x01\x75\x5e\xf2\xe2\xdb\x2e\x02\x79\xc5\xb8\xe7\x6a\x5d\xec\x53\x2f\x7f\x61\x27\x4b\xce\xf3\x70\xa9\x88\xcb\x7e\x4c\x31\xe7\xb0\x6c\xfd\x40\xf7\x24\x7e\xf5\xe6\xf2\xf5\x8f\x83\x48\x83\x36\x68\xb8\xbc\xb9\x86\x50\x59\x44\xe1\x77\x53\xec\x80\x16\xb7\x10\x25\xda\x89\xf9\x95\xb1\xb5\xf0\x41\xe2\x2f\xdf\x4d\xc3\xc9\x3d\xda\x02\xbe\x3d\x04\x26\x6f\xf0\x44\x34\x37\x4d\x7f\xe4\xa3\xac\xc1\x26\xe6\xf0\x93\xb1\x80\x9f\x45\xdd\x28\x3c\x87\xd9\xe5\x8c\x1b\xc1\x59\x70\x7a\x76\xd8\xaf\x83\x2c\x38\x46\xee\xa0\xf4\xc0\xdc\xe5\xde\x78\x42\xfc\xa4\x8b\x49\x2e\x84\x73\xe7\x58\x1c\x3a\x6c\xbe\xb0\x56\xec\x97\xaf\x00\xfa\xb5\xc7\xda\x4d\x51\x18\x80\xc2\xd4\xa4\x03\x07\x51\x49\x77\xb0\xa3\x25\x3e\x26\xdc\x74\x3e\xb5\x71\xa1\x35\x45\xed\xd5\x06\xcc\x32\xde\x00\x3b\xa1\x2f\x28\xee\xc7\x2a\x66\xb7\xcd\xcf\xa8\xb9\x38\x4c\xb4\xe7\x7b\x56\xef\x2f\x39\xe1\x41\x39\x08\x0e\xd9\x26\x79\x01\xf0\x4b\x85\x7a\x7b\x90\xd5\xa5\x92\x13\x83\x3c\x44\x9b\x51\x0a\x6d\x1a\x4f\x85\xd9\xd8\x78\x9b\x92\xa3\xc2\x42\x1a\x50\x14\x55\x5f\xfb\x1e\xc3\x25\x92\x93\x86\xd0\x69\x75\xba\x0b\x35\xc2\x7a\x2a\xf8\xb2\x1e\x84\x60\x25\x48\xb9\xf3\x58\x65\xf9\x77\x6b\x43\xe8\x44\xbd\xc3\x45\x77\xaf\x4a\xb2\xb6\xee\x06\x0c\xce\x0c\x65\x7a\x64\x36\x17\x36\x89\x1e\x6d\x4d\x1a\xa3\x04\x79\xd0\x88\x32\x94\x29\x8b\xde\xc6\x1a\x3d\xb2\x30\x48\x75\x9d\x5f\xf4\xe3\xab\x67\x9b\x70\x6b\x3f\x79\xe2\xf1\x6e\xcf\x61\x36\x02\x20\x1d\xe5\x0e\xec\xc7\xcd\x3c\x14\x7d\x47\xe2\x6b\xcb\x90\x97\x6f\x6e\x7f\x35\x1a\x6f\xb7\xe2\x66\xb0\x47\x74\xd6\xf4\x77\xe0\x93\xf0\x1d\x0d\x9c\xf8\x1c\x0b\x9f\xf8\xf4\x5c\x38\x28\xb1\xdb\x73\x0e\x2b\x02\xac\x42\x6f\x60\x3c\xe4\x9c\x29\x28\xb4\x5c\xec\xc9\x0e\xce\x1d\xd7\xba\x17\x12\x4d\x6c\xe1\xba\xcb\x5a\x12\x74\x6d\x51\x30\xbd\xce\x27\x1a\xbd\xfd\x0e\x8f\x1b\xd4\xb0\x55\x02\xf3\x7e\x76\x67\x5b\x4c\xad\x51\xdb\x30\xcb\xbb\x88\xf0\x5b\x8b\x42\x4b\xf8\x93\x50\x2e\x08\x2b\xa3\xcb\xb1\xc9\xc2\x19\x1d\x2f\xcf\xe8\x9c\x28\x31\xa1\xb0\xec\x6c\x2d\x44\xeb\xfa\x16\x24\xed\x30\xd9\x7b\x3d\x85\x44\xf1\x39\x42\xa5\x89\x83\x48\x84\xba\xea\x01\x21\x07\x1f\x5b\xe7\x3b\x62\x69\x29\xac\x1c\xc7\x3a\x77\x98\x93\xe5\x65\xdb\xc6\x23\x74\x8a\xcf\xe1\x0b\xd7\xf8\xc9\xd2\x79\x9c\x10\xf2\x53\xf7\xa7\xe1\x39\x4d\xe0\xf8\x28\xe1\xfc\x9d\x15\xda\x05\x5f\xef\x68\xba\xab\x1c\x3f\x27\x5a\x82\xf1\xd3\xe5\x21\x4e\x6f\x99\xa7\x89\x9b\xe2\xf8\x49\x7c\xf9\x6a\xfb\x47\x2a\x7e\x35\x75\xd3\xb5\xfd\xbf\x56\x77\xa2\x85\xde\xd3\xf9\x7f\xdd\x57\x6a\xc7\x21\xf2\xc4\xcc\x96\xa4\xbb\xdc\x1c\x6a\xe2\x43\x85\xa9\x74\xd8\xbe\xbe\x37\x5d\x3a\x3a\x1c\x4b\x4f\x8a\xa4\xa7\x71\x9b\x4e\xc4\xd9\x76\x72\x26\xd9\x99\x4f\x12\xb5\xa7\x15\x61\xaa\xc6\xe9\x7e\x1a\x2e\x08\xde\xc0\x8a\xd2\x3d\x9a\x5b\x6b\xbe\x51\xf5\xfd\xc6\xbd\xe6\x1b\x2e\xdf\x16\x02\x02\x69\xdd\x0a\x7d\x51\xa1\x84\xd6\x71\x02\xfc\xed\xfa\xe5\x6f\x20\x1c\x50\xc8\xea\xef\x2f\x3e\x84\x25\x8f\x9c\x64\x4f\x2f\x12\xd0\x58\xcc\xfa\x96\x42\x86\xcf\x07\x41\xcf\xf3\x0f\xe7\xac\xe8\xe7\xab\x9b\x2f\x52\xf3\xe2\x43\xf0\xe2\xfd\xc5\x87\xe1\x25\x9b\x34\x85\xcb\xc5\x83\xcb\x45\x2d\x1e\x8d\x0e\x9f\x92\x0a\x45\xf3\xf8\xd6\x74\x6e\x71\x85\x16\x75\x81\x73\x6b\x5a\x8f\xdf\xbf\x98\x97\xe8\xb3\x88\x4b\x16\x70\xa9\x7c\xad\x9e\x99\x26\x56\xb9\xf7\xcf\x77\x55\xd7\x54\x58\xe3\xcc\xca\x07\xcd\xa8\xb3\xd6\x05\xfd\x82\x41\x99\x6b\xf4\x0f\xc6\x7e\x9a\x4b\xed\xe6\xac\xed\x6f\x6b\xc2\x87\xbf\x86\xb9\xac\x50\x94\x45\x2b\x9e\x89\xc7\x2c\x49\x66\x52\xbb\xb0\x6f\xe6\x2a\xf3\xc0\x2e\x0d\xfb\x85\x17\x0f\x79\x69\x4c\xa9\x30\xec\xc6\x5a\xd9\x88\x91\x17\xeb\x8b\x79\xea\x22\x99\xd2\x8e\xbd\x39\x5c\x9b\xe0\xe9\x81\xe7\x45\x79\x22\x77\x6c\xf3\x91\xe5\x77\xa9\xf7\x7b\x8b\xa9\x63\x3c\xcc\xbd\xf3\xfe\x9d\x6d\xf8\x1c\xe6\xbc\x28\x4b\xd2\xa5\x68\x28\xb0\x6d\x97\xcc\x4c\x10\x10\x91\x34\x89\x25\x77\xa2\x74\x81\x27\x5e\x94\xd9\x8a\x94\x47\xeb\xce\xbf\x80\x16\x07\xcc\x09\x3c\xe9\x5f\x70\x6f\xb1\xe4\x29\x80\x9f\x2c\xb6\x00\x42\xc6\x02\x2e\xd4\xcd\x13\x8b\xe1\xce\x69\x0e\x19\x5f\x14\x05\x36\x1e\xe5\x9b\xdd\x6f\x87\xb3\xd9\xd6\x87\xc1\xf0\x77\xd4\x19\xc2\xfb\x0f\x67\xf1\x1b\x1e\xca\x77\xdd\xb7\x3f\x1e\xfc\x4f\x00\x00\x00\xff\xff\xf6\xc8\x1e\x71\x4c\x1e\x00\x00")
+var _manifests00CustomResourceDefinitionInternalYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x59\x5f\x6f\xe3\x36\x12\x7f\xcf\xa7\x18\x78\x1f\x7a\x07\x44\x72\xb3\x69\x0f\x85\x81\xc3\x21\xc8\xb6\x45\x70\xbb\x7b\xc1\x26\xb7\x05\x2e\x09\x50\x5a\x1c\x4b\xb3\x4b\x91\x2a\x87\x72\xd6\x39\xdc\x77\x3f\x0c\x45\x59\xb2\x63\xc7\x49\xbb\x8d\xf3\x60\x93\xc3\xe1\xcc\x8f\xbf\xf9\x43\x49\x35\xf4\x11\x3d\x93\xb3\x33\x50\x0d\xe1\x97\x80\x56\x7e\x71\xfe\xf9\x07\xce\xc9\x4d\x97\x27\x47\x9f\xc9\xea\x19\x9c\xb7\x1c\x5c\xfd\x01\xd9\xb5\xbe\xc0\x37\xb8\x20\x4b\x81\x9c\x3d\xaa\x31\x28\xad\x82\x9a\x1d\x01\x58\x55\xe3\x0c\xb4\x65\x8f\x85\xf3\x9a\x73\xb2\xa5\x47\xe6\xdc\x35\xe8\x55\x70\x5e\xbe\x58\xae\x68\x11\x72\x72\x47\x00\xca\x5a\x17\x94\xe8\x61\x59\x0f\x62\x44\xa6\x9a\xc6\xbb\x25\xea\x0d\xe1\x19\x54\x21\x34\x3c\x9b\x4e\x4b\x0a\x55\x3b\xcf\x0b\x57\x4f\xd7\x02\x53\xd5\xd0\xb4\x69\x8d\x99\x7e\xff\xc3\x77\x51\x11\xd9\xc2\xb4\x1a\x73\x8f\x06\x15\xe3\x86\xae\x29\xcd\xeb\xac\x30\xae\xd5\x59\xad\xac\x2a\x51\xcf\x60\x12\x7c\x8b\x93\xc3\x4b\x19\xcd\xa2\x5f\x95\x55\x54\x56\x99\x5a\x2a\x32\x6a\x4e\x86\xc2\xea\x05\x7a\xc8\x96\x06\x33\xeb\x34\x66\x1a\x97\x68\x04\xa2\xf5\x72\x6e\xb0\x10\x40\x4a\xef\xda\x66\x06\x87\x60\x14\xdc\x13\x80\xdd\x69\xbd\x79\x7f\xf5\x21\x1e\x41\x1c\x33\xc4\xe1\x9f\x9b\xe3\x6f\x89\x43\x9c\x6b\x4c\xeb\x95\x19\x1f\x5a\x1c\x66\xb2\x65\x6b\x94\x1f\x4d\x1c\x01\x70\xe1\x1a\x9c\xc1\x7b\xd9\xae\x51\x05\xea\x23\x80\x65\xc7\x9f\xb4\x7d\x96\x38\xb0\x3c\x89\x3f\x01\x18\xfd\x52\xf0\x15\x78\xfb\xa1\xe0\xbc\x2a\x71\x73\xac\x9d\xfb\xc4\xad\xa4\x49\xfe\x39\xa8\xd0\xf2\x0c\xfe\xfb\xbf\x5e\xac\xa8\xb0\x56\x83\x80\x9c\xea\xd9\xe5\xc5\xc7\xd3\xab\xad\x09\x00\x8d\x5c\x78\x6a\x84\x5b\x33\x98\xac\x1d\x07\x62\x50\x82\x03\x74\x1c\x85\x74\x96\x40\x16\x42\x85\xf0\xe0\x2c\x32\x68\xe1\x37\x6a\x98\xaf\xc4\xff\xbc\x70\x76\x41\xe5\x06\xea\xd3\xc2\xb4\x1c\xd0\x43\x2e\x67\x95\x37\xed\xdc\x50\xf1\x1f\x67\x11\x94\xd5\xfd\xa0\xa7\xa5\x0a\x28\xa3\x39\xdc\x5a\x38\x4f\x4b\x94\xae\xc9\xca\xc6\xd4\xb4\x26\xb2\x1f\xdc\x02\x42\x45\x0c\x3d\x08\x62\xa6\x75\x01\xb8\x6d\x1a\xe7\x03\xea\x1c\xae\xb7\xe7\x9d\x35\x2b\x58\x38\x0f\x64\x03\x7a\xab\x0c\x14\xae\xae\x5b\x4b\xc5\x5a\xe7\xbf\x1a\xb4\x57\x62\x31\xf4\xd4\xe1\x68\xc9\xc5\x42\x20\x78\x17\x5d\xaf\xd1\x86\x4b\x67\xa8\x58\x89\xd2\xdb\xc9\xbf\x6d\x82\xe4\x76\x72\x1c\x21\xe9\x97\xc2\x3d\x19\x13\xad\x9a\xa3\x18\xda\x38\xcb\x34\x37\x18\x6d\x88\x6b\xc8\x96\x71\xc5\x00\xaf\x58\x19\x87\x62\xb8\x81\x04\x36\x69\xf4\xd1\x88\x73\x57\x37\x2a\x50\x17\x39\x60\x24\x08\xe0\x64\x06\x57\x41\x89\xd2\x7b\x0a\x15\x59\x50\x50\xab\x4f\xce\x43\x0a\xa2\xb8\x97\x82\x9a\x2c\xd5\x6d\x2d\xb0\x9d\xbc\x86\xda\xd9\x50\x31\x38\x0f\xa7\x32\x33\x48\x33\xfc\xe5\xbe\xa2\xa2\xc2\x25\x7a\x71\xce\x38\x5b\xa2\xff\x6b\x3e\x19\xf1\x24\xac\x84\xd2\x6e\xfe\x09\x8b\x30\x1a\x6e\xbc\xb8\x1d\xa8\x8f\xab\xfe\x6f\x94\x31\x37\xc6\xb7\x08\xf7\x8d\xb0\xb2\x93\x4b\x64\xe2\x08\x43\x8a\x16\xd4\x89\xca\xa3\x83\x6f\x3c\x32\xda\xb0\x3e\x3b\x65\x93\x55\x39\x5c\x49\x10\x79\x06\xae\x5c\x6b\x34\x14\xce\x2e\xd1\x87\xc8\xe0\xd2\xd2\xc3\x5a\x1b\x43\x70\x71\x1b\xa3\x02\x72\x18\x88\xb1\x54\xa6\xc5\xe3\x48\xcd\x5a\xad\xc0\xa3\x78\x0b\xad\x1d\x69\x88\x22\x9c\xc3\x3b\xe7\x11\xc8\x2e\x36\x33\x6e\x5f\x0f\x12\xc3\xc2\x6a\x5a\x38\x1b\x3c\xcd\xdb\xe0\x3c\x4f\x63\x06\x9b\x32\x95\x99\xf2\x45\x45\x01\x8b\xd0\x7a\x94\xac\x9c\x45\x63\xad\x38\xc5\x79\xad\x5f\xf5\x04\xe6\x6f\xb6\xe0\xeb\xce\x81\x83\x27\x5b\x6e\x4c\xc5\x8c\xf6\x24\xd6\x92\xdb\xe4\x78\x55\x5a\xde\xf9\x32\x40\xda\xd3\xf2\xc3\x8f\x57\xd7\x43\x04\x45\xd8\x3b\x84\x07\x51\x1e\xc0\x16\xa0\xc8\x2e\xd0\x77\x91\xb9\xf0\xae\x8e\xd8\xa2\xd5\x8d\x23\x1b\x12\xad\x09\xad\x84\xe9\xbc\xa6\x20\xe1\xf9\x5b\x8b\x1c\xe4\x1c\x72\x38\x8f\xd5\x0d\xe6\x08\x6d\xa3\x55\x8c\xe1\x0b\x0b\xe7\xaa\x46\x73\x2e\x25\xe9\xcf\x86\x5a\x10\xe5\x4c\xe0\x7b\x3e\xd8\xe3\x6a\x0e\x70\x30\x4a\x00\xfa\x4a\xb5\xf7\x74\x44\x40\x0e\x47\xd0\x92\xef\xb4\x18\xe5\x27\x19\xd4\xc8\xe4\x25\xd7\x62\xa5\x96\xe4\xfc\x7a\xdc\x72\x57\xab\xf2\xe7\xda\x02\x11\x7f\x51\xb6\x6d\x11\x40\x26\x89\x7c\x3b\xe1\xed\x96\x92\xf2\xb6\x63\x46\x62\xc5\xeb\xeb\xeb\xb7\xfb\xe7\x56\xcd\xae\x85\x41\xf9\x12\x03\x6f\xcd\xec\x4b\x30\xf2\xd9\x61\xea\x63\xa1\x2d\x9c\x27\x3b\x16\x81\x46\xeb\x02\x76\xe0\x17\xad\xf7\xc2\xd5\xa6\x9b\x52\x4d\x63\x08\x75\x9f\x9f\x87\x94\x9d\xc3\x87\x94\xba\x43\xa5\x02\x54\x6a\x89\xfd\x1a\xc6\x00\x6a\xab\x46\x80\x92\x7c\x51\x5a\x17\xcf\x70\x15\xb7\x4a\xfd\xca\xba\xe8\xe4\xd0\x55\xaf\x1a\x95\x4d\x6a\x37\xf7\xdc\x5d\x25\xfa\x22\x98\xf6\xea\xb5\xf7\x5a\xbb\x7c\x26\x23\xb7\x93\x4b\xa9\xbf\x5c\x45\x83\xba\xae\x41\xb2\xa4\x8e\x2d\x6a\x57\xb7\x86\x30\x94\x24\x29\x2e\x7c\xb6\xee\xde\xae\xe5\x8f\x81\xc9\x4a\x61\x0d\xb2\xad\x74\xc2\x52\x52\xcd\xaa\xdf\x3d\x87\x33\xbb\x02\xfc\x42\x1c\xf3\xc9\x93\x76\x17\xca\x4a\xd8\x6b\x34\x18\x50\x43\x72\x57\x13\x17\x1e\xc7\xd4\xef\x7b\x88\xd8\x10\xc4\x9a\x18\x61\x5a\x10\x1a\x2d\x65\x43\xb5\x26\xe6\x12\x78\xd7\xdb\xf0\x51\x19\xea\x73\x75\x44\xfe\x76\x92\xe6\x6e\x27\x11\x8e\x8d\xb3\xc9\x27\x3b\x58\xb3\x37\xf6\x7b\x52\xc5\x6d\x67\xfd\x9e\x3b\x44\xd0\xb6\xf5\x2e\x3e\x0a\xd9\xf7\xaf\x92\xd9\xb5\x6d\x8f\xe6\x53\xdc\x1d\xa4\x79\x92\xeb\x33\x4a\xe5\x38\x48\xc7\xd9\x23\x3a\x50\xea\xe5\x9e\xd7\x64\xdf\xa2\x2d\x43\x35\x83\x93\xa3\x8d\x19\x80\xa4\xf4\xfa\xfa\xed\x41\x0b\xd7\x92\xbd\x8d\x89\x2a\x71\xc4\x02\xa3\x10\x93\x73\xb8\x58\xc0\x03\x7a\xd7\xf5\x58\x09\x75\x59\x72\xfa\x6d\x1f\x81\xb2\x62\xdc\x73\xb5\xdc\xf5\xa9\x67\xbf\x88\x93\xa5\xe4\x79\x38\x33\xa4\xb8\x4f\x31\xc7\x30\x6f\xc3\x40\xf7\x24\x7e\xfe\xfe\xec\xdd\x8f\x83\x48\x83\x3e\x6a\x38\xbb\xbc\x90\x18\x09\x5e\x15\x21\xdf\x8b\x96\xb4\x10\x25\xfa\x1d\xf3\x0b\xe7\x6b\x15\xa2\xc4\xdf\xbe\xdb\x31\x9f\x7a\xb4\x19\x7c\xbb\x0f\x4c\x39\x8e\x67\xa2\xb9\x6a\xb0\x87\x73\x94\x35\xc4\xc4\x1c\x7e\x72\x1e\xf0\x8b\xaa\x1b\x83\xc7\x30\x39\x9b\x48\x23\x38\x89\x4e\x4f\xf2\x97\xb3\xe0\x29\x72\x47\xa5\x7b\xe6\xce\x1e\x8d\x27\xc4\x0f\xba\x98\xe4\x62\x38\xf7\x8e\x75\x43\xfb\xcd\x57\xde\xab\xc7\xe5\x2b\x82\x7e\x11\xb0\xe6\x5d\x14\x06\xa0\x38\xb5\x63\xe2\x09\x54\xd2\x1d\xec\xe8\x09\x07\x52\xc2\x4d\xe7\x53\x3b\x8e\xad\x29\xda\x60\x56\xe0\xe6\xdd\x0d\xb0\x17\x4a\x71\xfa\x7b\x8a\xfb\x53\x15\xb3\xdf\xe6\x67\xb4\x52\x1c\x76\xb4\xe7\x8f\xac\x7e\xbc\xe4\x80\x07\xe5\x20\x38\x64\x9b\xe4\x05\xc0\x2f\x15\xae\x2b\xe9\x70\xd5\x4c\x25\xa7\x0b\xf2\x18\x6d\xce\x18\xf4\x69\x3c\x15\x66\xe7\xbb\xdb\x94\x1e\x15\x16\xb2\x80\xaa\xa8\xd6\xb5\x4f\xee\xa5\x39\x48\xd2\x50\x36\xad\x4e\x77\xa1\x46\xf9\x40\x85\x5c\xd6\xe3\xe5\x15\x16\x8a\x0c\xcb\x86\x2a\xc4\xef\xad\xd4\x67\x4e\x7a\x87\x8b\xee\xa3\x2a\x29\xda\xfa\x1b\x30\xb0\x1b\xca\xf4\xc8\x6c\x29\x6c\x1a\x03\xfa\x9a\xac\x74\xd0\x2a\x48\xbd\xb4\x88\x3a\x96\x29\x8f\xc1\x77\x35\x7a\x64\x61\x94\xea\x3b\xbf\x68\xe2\xd7\xcf\x36\xa2\x95\x0f\x9e\x78\x94\x8a\x61\x36\x02\x20\x1d\xe5\x16\xec\x4f\x9b\xb9\x2f\xfa\x9e\x88\xaf\x0d\x43\xde\xbc\xbf\x92\x87\x02\x57\x1b\x71\x33\xd8\xa3\x7a\x12\xac\xef\xc0\x07\xe1\x7b\x32\x70\x0e\x87\x4f\xf7\x59\x73\x61\xaf\xc4\x96\x1f\x93\x61\x45\x84\x55\xd9\xd5\x48\x09\x28\x66\x57\x50\x6c\xb9\xc4\x93\x2d\x9c\x7b\xae\xf5\x0f\x24\xe2\x23\x14\xae\xfa\xcb\x5a\x12\xe4\xb6\x28\x84\x5e\xc7\x3b\x1a\xbd\xc7\x1d\x9e\x34\xa8\x71\xab\x04\xe6\xed\xe4\xda\xb7\x98\x5a\xa3\xb6\x71\x76\x88\x88\xa1\x4e\xca\xa2\xd8\x12\xfe\xa4\x0c\x47\x61\x79\x4e\x30\x36\x59\xb1\xb3\x51\x45\x8d\xcc\xaa\xc4\x84\xc2\xbc\xb7\xb5\x50\x2d\xaf\x5b\x90\xb4\xc3\xce\xde\xeb\x39\x24\x3a\x48\xa5\xfd\x84\x3a\x5f\x03\x42\x0c\x9f\x5a\x0e\x3d\xb1\xac\x56\x5e\x8f\xf0\x8a\x1d\x26\xe7\x4f\xa8\x3f\x48\xa7\x43\x17\xae\xf1\x5f\x96\x82\xed\x80\x50\xd8\x75\x7f\x7a\x09\x81\xbb\x8f\x51\x1c\xae\xbd\xb2\x1c\x7d\xbd\xa6\xdd\x5d\xe5\xb3\x8a\xdf\xfe\x3c\x24\xe9\x2d\x0b\xb4\xe3\xa6\x38\xfe\x24\xbe\x7c\xb5\xfd\x3b\x2a\x7e\x35\x75\xbb\x6b\xfb\xef\x56\x77\xa0\x85\x1e\x7f\xc2\x9e\xde\xef\xcf\xdb\x57\x5b\x96\x10\x99\x1d\x3d\x2b\xa0\x92\x74\x9f\x9b\x25\x4f\xc1\x7d\x85\x1e\xc7\xb9\x89\xb8\x4f\x5a\xf8\xa8\x8f\x79\x61\x24\x3d\x8f\xdb\x74\x20\xce\x36\x5c\x98\x90\xee\xcd\x27\x8d\x36\xd0\x82\x30\x55\xe3\x74\x3f\x8d\xf7\x89\xe0\x60\x41\xe9\x1e\x2d\xad\xb5\xdc\xa8\xd6\xfd\xc6\xad\x95\x1b\xae\xdc\x16\x22\x02\x69\xdd\x02\x43\x51\xa1\x86\x56\xde\x12\xc0\xaf\x17\x6f\x7e\x95\xa7\x02\xb2\x9d\x85\x9b\x93\xbb\xb8\xe4\x41\xda\x8e\xc3\x8b\x14\x34\x1e\xb3\x75\x4b\xa1\xe3\xeb\x83\xa8\xe7\xf5\xdd\xb1\x28\xfa\xf9\xfc\xf2\x0f\xa9\x39\xbd\x8b\xf5\xe5\xe6\xe4\x6e\x78\xc8\xa6\x5d\xc1\xb9\xba\xe7\x5c\xd5\xea\xc1\xd9\xf8\x2a\xa9\x30\x34\xed\x9e\x9a\x4e\x3d\x2e\xd0\xa3\x2d\x70\xea\x5d\x1b\xf0\xfb\xd3\x69\x89\x21\xeb\x70\xc9\xc4\x96\xbc\x0a\xb5\x79\xe5\x22\xce\x0c\x37\xaf\xb7\x55\xd7\x54\x78\xc7\x6e\x11\xa2\x66\xb4\x59\xcb\x51\xbf\x12\x50\xa6\x16\xc3\xbd\xf3\x9f\xa7\xda\xf2\x54\xb4\xfd\x63\x49\x78\xff\xf7\x38\x97\x15\x86\xb2\xce\x8a\x57\xea\x21\x4b\x92\x99\xb6\x1c\xf7\xcd\xb8\x72\xf7\x70\x73\x3a\xda\x2f\x3e\x78\xc8\x4b\xe7\x4a\x83\x71\x37\xd1\x2a\xfe\x8d\xbc\x58\x9e\x4c\x53\x17\x29\x01\xc0\xe2\xcd\xe4\xe8\x2b\x04\x5e\x50\x25\xbf\x84\x8f\x22\xbf\x4d\xbd\xdf\x5a\xf4\xab\x03\xdc\x3b\x5e\x3f\xb3\x8d\xaf\xc3\x38\xa8\xb2\x24\x5b\xaa\x86\x22\xdb\xb6\x34\x46\x9e\x81\xea\x48\x93\x58\x72\xad\x4a\x8e\x3c\x09\xaa\xcc\x16\x64\x02\x7a\x3e\xfe\x03\xb4\xd8\x63\x8e\x20\x9b\xf5\xb6\xf2\x06\x4b\x9e\x03\xf8\xc1\x62\x0b\xa0\x74\x57\xdf\x95\xb9\x7c\x66\x31\xdc\x3a\xcd\x21\xe3\xab\xa2\xc0\x26\xa0\x7e\xbf\xfd\xee\x70\x32\xd9\x78\x31\x18\x7f\xae\x3b\x07\x9e\xc1\xcd\x9d\xbc\x09\x0c\xf2\xbc\x2f\xbd\xe1\xe0\x19\xdc\xdc\x1d\xfd\x7f\x00\xf6\xc8\x1e\x71\x4c\x1e\x00\x00")
 
 func manifests00CustomResourceDefinitionInternalYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -442,7 +443,7 @@ func manifests00CustomResourceDefinitionInternalYaml() (*asset, error) {
 	return a, nil
 }
 
-var _manifests00CustomResourceDefinitionYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\xfd\x6d\x7b\xdb\x36\xd6\x28\x0a\x7f\x9f\x5f\x81\x47\x9d\x3e\xb1\x5b\x49\x7e\x89\x9d\xa6\xee\xcc\xf4\x38\x4e\xda\xfa\xba\xd3\xd4\x27\xf6\xb4\x33\x13\xa5\x39\x10\x09\x49\x18\x53\x80\x86\x00\x6d\xab\x4d\xf7\xbf\xda\xdf\xf6\xa7\xfd\x03\xf6\x6f\x3a\x17\xd6\x02\x48\x90\x22\x40\xca\x4d\xee\x7d\xcf\x3e\xe6\x5c\xd7\xd4\x11\xc1\x05\x60\x01\x58\x6f\x58\x2f\x74\xc5\x7f\x64\xb9\xe2\x52\x9c\x10\xba\xe2\xec\x4e\x33\x61\xfe\xa5\xc6\xd7\x4f\xd5\x98\xcb\xbd\x9b\x83\x3f\x5c\x73\x91\x9e\x90\xb3\x42\x69\xb9\x7c\xcd\x94\x2c\xf2\x84\x3d\x67\x33\x2e\xb8\xe6\x52\xfc\x61\xc9\x34\x4d\xa9\xa6\x27\x7f\x20\x84\x0a\x21\x35\x35\x3f\x2b\xf3\x4f\x62\x60\x8e\xe8\x6a\x95\xcb\x1b\x96\x8e\xe5\x8a\x09\xb5\xe0\x33\x3d\xe6\xf2\x84\x2c\xb4\x5e\xa9\x93\xbd\xbd\x39\xd7\x8b\x62\x3a\x4e\xe4\x72\xaf\x6c\xb0\x47\x57\x7c\x6f\x55\x64\xd9\xde\x93\x83\x27\x00\x88\x8b\x24\x2b\x52\x36\xce\x59\xc6\xa8\x62\x35\x58\x7b\x7c\xba\x1c\x25\x99\x2c\xd2\xd1\x92\x0a\x3a\x67\xe9\x09\x19\xe8\xbc\x60\x83\xee\x4f\x15\xcb\x66\xee\xab\xd1\x82\xcf\x17\x23\x7a\x43\x79\x46\xa7\x3c\xe3\x7a\xbd\x05\x1c\x2e\xe6\x19\x1b\x09\x99\xb2\x51\xca\x6e\x58\x26\x57\x2c\xf7\x3e\x17\x74\xc9\x4e\x08\x17\xf3\x9c\x29\x95\x48\xa1\x73\x99\x65\x2c\x57\x06\x4a\x4e\xb5\xcc\x6b\xe0\xfe\xa0\x56\x2c\x31\x18\x9c\xe7\xb2\x58\x9d\x90\xf6\x46\x08\xd5\x62\x1a\x57\xe9\x1c\x3b\x38\x2b\x3b\x80\x77\x19\x57\xfa\x3f\xda\xdf\xbf\xe4\x4a\x43\x9b\x55\x56\xe4\x34\x6b\x1b\x22\xbc\x36\xf3\x2b\x32\x9a\xb7\x34\xf8\x03\x21\x2a\x91\x2b\x76\x42\x5e\x99\xe1\xac\x68\xc2\xd2\x3f\x10\x72\x83\xfb\x0a\x86\x37\xb2\xf3\xbf\x39\x40\x60\xc9\x82\x2d\x29\x8e\x9b\x98\xc9\x89\xd3\x8b\xf3\x1f\x1f\x5f\xd6\x7e\x26\x24\x65\x2a\xc9\xf9\x4a\xc3\xee\x1c\x6c\x0c\xdd\xbe\x9f\x32\x45\x28\xb1\x4b\xe8\x46\x47\xaa\xe1\x91\x99\xcc\x4b\x90\x84\xe8\x05\x23\x49\x56\x28\xcd\xf2\x31\xb9\x32\xff\xa8\x5a\x26\x54\x10\xc5\xf2\x1b\x9e\x30\xf2\xc3\x8a\x89\x4b\x83\x69\xf2\x5a\x16\x9a\x11\x2a\x52\xf2\x1f\xc5\x94\xe5\x82\x69\xa6\x1c\x22\x3d\xc0\xb9\x3d\x1a\x6a\x4c\x26\x82\xfc\xb4\x60\x82\x50\xb1\x89\x70\xc2\x15\x49\x72\x46\x35\x4b\x87\x84\x12\xc1\x6e\x5b\xc6\xec\x81\x4d\xd9\x2a\x93\xeb\x25\x13\xda\xfb\x92\x68\x49\x68\x96\xc9\x5b\x62\x4e\x6c\x2e\x68\x46\x74\x4e\x67\x33\x9e\x98\x37\x39\xa3\xc9\x02\x26\x6a\x27\xa3\x88\x5e\x50\xed\x01\x65\x77\x2b\xa9\x98\x1b\x1c\x91\xb9\x9d\xa4\x37\x87\xbf\xae\x52\xaa\xb9\x98\x13\xbd\xe0\xaa\x7c\x41\x96\x74\x4d\x32\x46\x61\x04\x29\x57\x79\x01\xcb\xe3\x81\x9e\xc9\x9c\xac\x8a\x69\xc6\x13\x32\xa3\x89\x01\x20\x98\xbe\x95\xf9\xb5\x99\x9e\x60\x09\x10\x07\x42\x55\x70\xee\x24\x67\x37\x5c\xd5\x81\x9a\x5e\xa7\x8c\x40\x83\x94\xc8\x42\x03\x8e\x1d\x05\xb9\x2e\x97\xc5\x9c\xc4\x54\x26\x6a\x2f\x91\x22\x61\x2b\xad\xf6\x1c\x06\x46\x76\x14\x5c\xcc\xf7\x6c\x9f\xa3\xe6\x1e\xc7\xc7\x2e\x1e\xbb\x61\x39\x59\x49\xa5\xf8\x34\x63\x43\xa2\x0c\x5d\x9c\x66\x8c\xa4\x6c\x46\x8b\x4c\x2b\x98\xa8\xc1\xf1\x2a\xa3\x7a\x26\xf3\x25\xa1\x39\x23\x85\x62\xe9\x98\x5c\x32\x46\xcc\x1a\xf8\x68\xe1\x2c\x4b\xe1\x9b\xa5\xcc\x0d\x14\x4d\x79\x86\x5b\xe5\x4c\x2e\x57\x54\x73\xa4\x38\x24\x33\xc4\x83\x1c\x9c\x90\x4b\x4d\x4d\x7f\xb7\x5c\x2f\xb8\x80\x1d\xfe\xcf\xda\x46\xb6\x64\x08\x60\x52\xb2\xe4\x82\x2f\x8b\x25\x91\x33\x72\x70\x48\x96\x52\xe8\x05\xac\xeb\x63\xf3\x46\xe6\xae\xb5\x22\x3b\xb7\x0b\x9e\x2c\x60\x76\x5c\x91\x4c\x8a\x39\xcb\x77\xc7\x83\x12\xf0\x2a\x37\xc4\x46\x73\x47\x57\xf0\xf1\xf8\x84\xf7\x6b\xe3\x88\x3e\x32\xa7\x18\x5b\x19\x34\x71\x01\x1b\x8f\x39\x4a\xc0\x52\x7b\xf0\xcd\x20\xed\xae\x5a\xe5\x4c\x31\x81\x2c\xa3\x06\x98\x98\x46\x54\x10\x39\xfd\x27\x4b\xb4\x41\x69\x6e\xc0\x10\xb5\x90\x45\x96\x9a\xed\x72\xc3\x72\x4d\x72\x96\xc8\xb9\xe0\xbf\x94\xb0\x95\xd9\x98\xa6\xd3\x8c\x6a\xa6\x74\x03\x26\x17\xf6\xb4\xdc\xd0\xac\x60\x43\x38\xd4\x66\x6f\xe5\xcc\xf4\x42\x0a\xe1\xc1\x83\x26\x6a\x4c\xbe\x37\xeb\xc5\xc5\xac\xce\xb2\x1c\x7f\x4c\xe4\x72\x59\x08\xae\xd7\x7b\xb0\x9b\xf8\xb4\xd0\x32\x57\x7b\xc0\x02\xf6\x14\x9f\x8f\x68\x9e\x2c\xb8\x66\x89\x2e\x72\x66\xd8\xda\x08\x86\x2e\xe0\x18\x8c\x97\xe9\x27\xe5\x91\x7b\x54\x1b\xab\x5e\x1b\x82\xaa\x74\xce\xc5\xdc\x7b\x01\x54\x3e\xb2\x02\x86\xca\x9b\x75\xa5\xf6\x53\x9c\x45\x85\x68\x3c\xd0\x8c\xbc\x7e\x71\x79\x55\x1d\x6a\xb3\x18\x4d\xec\x03\xde\xab\x0f\x55\xb5\x04\x06\x61\x5c\xcc\x58\x8e\x8b\x38\xcb\xe5\x12\x60\x32\x91\xae\x24\x17\xda\x92\x58\xce\x44\x13\xfd\xaa\x98\x2e\xb9\x36\xeb\xfe\xaf\x82\x29\x6d\xd6\x6a\x4c\xce\x40\x68\x30\xc7\xbb\x30\x24\xc7\x9c\x9f\x73\x41\xce\xe8\x92\x65\x67\x86\xcd\x7e\xec\x05\x30\x98\x56\x23\x83\xd8\x7e\x4b\xe0\xcb\x3b\xcd\xc6\x88\x35\xef\x85\xe3\xe3\x81\xf5\x32\xaf\xcd\x72\x01\xad\x5e\xb1\x84\xcf\x78\x02\x67\x01\x8f\x88\xa1\x13\x8a\xe7\x2c\x25\x53\xb6\xa0\x37\x5c\xe6\xf6\xf7\x06\x5e\x37\x98\xcc\xb8\xd6\xa0\xfd\x48\x9b\x07\x57\xe9\xea\xe5\x65\xf3\x45\x63\x9c\x65\x3b\x37\x4a\xa6\x88\x62\xda\x6c\x27\x24\x84\x76\x45\xcd\xf6\x32\x67\xea\x86\xe5\x7c\xb6\xae\xe3\xad\xde\x27\x49\xcc\x88\x60\xba\x4c\x0d\x09\x10\x25\xe0\xbf\x53\x24\xa2\xe6\x18\x33\x01\x14\x70\x59\xe8\x82\x66\xc4\xf4\x5e\x67\xe5\xee\x61\xe9\x9c\x8d\x34\xcb\x97\x5c\x00\x73\x34\x23\xc8\x19\x13\x49\xbe\x5e\x69\x92\x1b\xce\xa6\xc6\x1b\xdf\x85\xb1\x62\x1e\xe0\xae\x2c\xbd\x2c\x60\x45\x2f\xa8\x36\x84\xa3\xb5\x65\x03\x55\xed\x1f\x7a\x78\xa3\x20\x8e\x99\x85\xcc\x19\x88\x54\xad\x30\x81\x47\x9b\x45\x05\x76\x69\xd8\xb7\x23\x7d\x53\xc3\x84\x75\xb2\x30\x13\x9d\x53\x2e\x14\x1e\xb8\x94\x03\xfa\x0b\xae\x16\x20\x7e\xb5\x3d\x46\x08\x23\xd2\x30\x93\x1b\x9a\xf1\xb4\x65\x29\x0c\xda\x67\x3c\xd3\xac\x5c\x52\x35\x26\x46\x4c\x0a\x40\xb4\x53\xa8\x0d\x76\x59\x28\x6d\xd6\x90\x5c\x9c\xbd\x7e\x41\xd4\x5a\x68\x7a\x37\x26\xe4\xdc\x12\x7d\x98\x3d\x57\x84\x2d\x57\x7a\x3d\x0c\x8d\xd4\x0d\xc3\xec\x28\xae\xc8\x8a\xe5\x86\xc7\x1a\xe2\x80\x80\x58\x09\x47\x48\x81\xa0\xcc\xaf\x82\xd0\x26\xd1\x71\x8f\xe1\x7f\x9a\x48\xc1\xc8\x0a\x17\x05\x07\x0a\xb8\x24\xb4\x05\x17\x8f\xd4\x16\x48\xcd\x09\xcb\x14\x83\x81\xb5\x8a\x34\x66\x33\xe0\x39\xf7\x7a\x08\x40\x34\xfb\x37\x65\x82\x5b\xf6\x59\xc9\x4d\x9b\x9b\xd8\x3c\x5c\xb3\x65\x60\x67\x06\x89\x58\xb3\x01\xcd\x73\xba\x6e\x7d\x7f\x37\xaa\xc4\xab\x91\xc1\xf9\xc8\x7e\xa1\xe5\x92\x27\xc1\xf3\x7d\x76\xda\xe3\xac\xb8\xa6\xb5\xd3\x91\x48\x31\xe3\xf3\x25\x5d\x01\x02\x29\x17\x8e\x67\x5d\xbc\xf8\x7e\xc4\x44\x22\xd3\xe0\x52\x9c\x9d\xd6\xf6\xf2\xb4\x10\x69\xc6\x9a\x87\xc7\x51\x17\xa4\x51\xe5\xca\x3f\x6a\x72\xc0\x72\x3e\x15\x44\x3c\x0a\x84\xa6\x46\xda\x52\x1a\x54\x32\xdc\x45\x28\x97\xe3\x06\xaf\x26\xc0\x9b\x32\x4d\x89\xf4\x05\x23\xa5\x2a\x37\xc2\x0f\x50\x99\x33\xda\x53\xfb\x32\xc7\xe9\x15\xb1\x3b\x31\xf4\xae\x81\x79\xd8\xb4\x96\xf1\x38\x86\x36\xc6\x9d\x8c\xa7\x2b\x67\x33\x96\x33\x91\xb0\xd4\xce\x27\x08\xd7\x48\xe7\xab\xe0\xdb\xce\xfd\x67\xc8\x8c\x61\x71\xed\x03\x47\xc5\x31\xb2\x71\x37\x38\xae\x7b\xec\xde\xaa\x16\xef\x42\x66\x3c\x59\xf7\xd8\x95\x83\xc0\xa7\xde\x2e\xbd\x5d\x30\xbd\x60\xb9\x7f\xdc\x43\xbb\xc7\x27\x02\x30\x53\x65\x87\x06\x92\xea\x2a\x97\x37\x3c\xad\x11\x05\x24\xb8\x46\xb0\x32\x2a\x43\x88\x44\x24\xa0\xde\xa0\x78\x0d\x82\x2a\x99\x0c\x5e\x5b\x54\x4e\x06\x86\x1e\x4d\x06\x3f\xc0\x84\x68\x36\x19\x80\x9e\xf1\x4a\x6a\xd6\x54\x04\x6b\x08\x6d\xa7\x5d\x52\x64\x6b\x92\x2c\x58\x72\xad\xda\x78\x37\xb0\xff\x06\x07\x8e\xd0\xb5\x8a\x2f\x1b\x7e\x8e\xbc\xf9\x2b\xc2\xb5\xe1\xfd\x46\x0c\x84\x8e\x36\x3b\x48\x32\x46\x73\xcd\xee\x42\x63\xff\xee\xea\xea\xc2\x4c\x7a\x45\x95\xd2\x8b\x5c\x16\xf3\x85\xd7\x81\xa7\xde\xf8\x0f\x13\xc5\x32\xb4\xed\x06\xed\x9f\x8c\x88\x43\x72\xe0\xb5\xc3\x79\x64\xcb\x06\x0e\x43\xf8\x20\x8c\x4a\x42\x19\x7e\xd5\xdc\xac\x1b\x2d\x23\xc7\xc5\xaa\xb4\x1e\x8c\x0e\x71\x70\xb0\xf9\x05\x2a\x1d\x25\xd5\x00\x03\x05\x51\x2c\xc9\x99\xf6\xe8\x78\x0b\x56\x50\xca\x05\x70\x35\xe2\x6d\x14\x77\x23\xf8\xae\x03\xfb\x72\x8c\x26\x16\xb0\x5b\xb4\x1d\xbe\x54\x8a\x47\xda\x1e\x59\x80\xc1\x73\x22\x6f\x85\xdf\xc7\xb0\x65\xe6\x66\x1e\xa0\xc4\xb7\x80\x9c\x08\x20\xff\x76\x56\x48\xf7\x71\x6a\x30\xc6\x99\x34\xa2\x9f\xe1\x56\xd7\x6c\xad\x90\x8b\x1b\x3d\xc1\x7c\xa7\x33\x35\x4e\x72\xdd\xb6\xdb\xfc\x49\xcf\x78\x86\x06\x29\xa4\x0f\x99\x1a\x5f\xb3\xf5\x89\x81\xd7\x78\x35\x11\x46\x0c\x2a\x84\x62\xba\x4d\x84\xa2\xe4\x96\x67\x69\x42\xf3\xb4\x06\xde\xac\x51\xa1\xe5\x92\x6a\x9e\xd0\x2c\x5b\x93\x39\x13\x2c\x2f\x65\x66\x34\x5e\xb4\x0b\x7b\x0d\x30\x28\x3f\x3a\x13\x48\x0b\xd5\x48\xe5\xd2\xe0\x65\xc7\xc0\x55\xc5\x14\xff\xa9\x76\xdb\xc6\x2a\x52\x00\x52\x8d\xa5\x2e\x83\x9d\x9d\x9a\xc9\x64\x86\x75\xd7\x07\x6f\x94\xf9\x79\x1e\xa0\x37\xb7\x5c\x2f\x7c\x5b\xdf\x23\x45\x74\x6e\x56\x4c\x69\x99\xb3\xb1\x45\x60\x10\x4f\x2d\x10\x41\x6e\x80\xe9\x2c\x68\x8e\x3b\x73\x59\x64\x9a\xaf\x32\x06\xe4\x67\xef\xd0\x2a\x2c\xa9\xa5\x3a\xd6\xd4\x42\xf8\x72\x95\xf1\xd6\x3d\x7a\xfa\xf2\xe2\xd5\x2e\x8a\xad\x8e\x27\xec\xf0\x31\x1b\x0f\x89\x90\x9a\x4e\xb3\x35\x99\xe6\xf2\x56\xb1\x5c\xed\x82\x75\x89\x6a\x92\xf1\x29\xcb\xf5\x1a\xcd\x7c\x85\x6a\x5b\x2a\x23\x58\xf8\xc6\xb6\x31\xb2\x93\x25\xa3\x42\x79\x82\x2e\x15\x08\x01\x45\x2e\xdb\x98\x68\xd9\xba\x44\x12\xd8\x5d\x5e\x5a\x42\x41\xa6\x02\x61\xfe\x9a\x65\x30\x9a\x19\xe5\x99\xed\xc9\x2a\xab\x05\x58\x98\x70\x59\xb3\xac\x4d\xba\xbc\x16\xe6\x44\x52\xe5\x0f\x20\x91\x34\x63\x2a\xe1\x62\x3e\x76\x07\x8e\x8b\x91\x19\xa7\xbf\x42\x3b\x96\x03\xb7\x00\xad\xf6\x91\xcc\xcd\xa2\xe5\x23\xc7\xb7\xd3\xdd\xce\x9d\x04\xfb\xa6\x05\x68\x69\x0d\x7e\xa4\xc8\xb4\xe0\x99\x1e\x71\x41\x7e\x38\x2d\xf4\x02\x09\x55\xde\xc2\x62\xe2\x02\x5b\x58\x58\xab\x5b\x74\x5e\x6d\x0a\x65\xba\xd5\x26\x12\xb3\x82\xca\x1b\x96\xdf\x70\x76\xbb\x67\x4d\xa0\x23\x33\xcd\x11\x32\x03\xb5\x07\x82\xe7\xde\x27\xf0\x9f\x00\x7f\xbd\xfa\xe1\xf9\x0f\x27\xe4\x34\x4d\x09\xee\x84\x42\xb1\x59\x91\xa1\x88\xa2\xc6\x9e\x79\x70\x08\x26\xaa\x21\x29\x78\xfa\xf5\xa3\xed\x99\x60\x54\xa8\xab\xa9\x22\x4b\xba\x8a\x69\x22\x48\x70\x3a\xf9\x18\x52\x29\xe0\x5d\xcf\x5f\x5d\xa2\x58\x6c\x4d\xc6\x11\xde\x13\xa0\x62\x96\x75\x98\xd3\x80\x32\x73\x91\xb3\x8a\x46\xcc\x18\xd5\x45\xce\x14\xf0\x83\xcf\xc8\x37\x96\x76\xbe\x94\x34\x7d\x46\x33\x2a\x12\x96\x5f\x62\xcf\x6d\x26\x0e\x67\x5b\x03\xd3\xba\x5a\x18\x2e\x03\x0a\x08\x9b\xaf\x87\xa4\x9a\xc6\x66\xff\x66\x5a\x39\x4b\x64\x9e\xb6\xd8\x40\x08\x9a\xaa\x2d\xf0\x8b\x12\xf6\xa5\x05\x3d\xc6\xb1\x02\xa7\x2d\x14\x58\x77\xbc\xf3\x65\xb9\x67\x9c\x5b\x0c\x9b\x4a\x6f\x79\x06\x2b\x1e\x62\xc7\x0f\x38\xd4\xca\xe3\x17\xa1\x11\x6f\xf2\x6d\x3b\xd2\x2b\x27\x0f\x83\xd1\x00\xe7\x83\x18\xe1\x22\xe5\x37\x3c\x2d\x5a\x45\x33\xbc\xfd\x50\x9a\xea\x42\x31\x45\x94\x44\x02\xc7\x44\x6a\x28\x4f\xae\x80\x52\x19\xa9\x3f\x07\xc9\x46\xd3\x7c\xce\x74\x75\xf7\xf2\xfc\xd5\x65\x0b\x50\x87\x75\x33\x32\x3b\x43\x90\x19\x8c\x0e\x2a\xf8\xbf\x0a\x46\xe8\x52\x1a\x94\x66\xd9\xa6\x05\x4f\xb5\xb2\x75\x91\x3a\x39\xd9\x37\x97\x22\x2f\xb3\x46\x90\xf2\x7e\x02\xe6\x0c\x50\xc7\xb8\x1b\xea\x17\x96\x96\x2f\xb6\xf4\x32\x36\xf4\x72\x8c\x23\x6e\x21\x6b\x91\xf3\x1b\xde\x48\x5d\x07\x31\xfc\xa5\xbf\xab\xed\x82\x76\xa8\x5d\x9e\x14\xe2\xc0\x02\x36\x90\x72\xd9\x7b\x20\x35\x74\xe6\xc5\x4c\xd2\x94\x4c\xed\x09\x2c\x59\x81\x61\x9c\x6d\x4b\xc0\x74\x32\xae\x49\x5f\x35\x01\xd6\x30\x3f\x6a\x06\x2b\x85\xa1\xce\x39\x55\x3a\x2f\xc0\xf2\xbc\xf5\x1a\xc0\x5e\x1c\xbb\xcb\x25\x20\x1a\xa7\x3f\x5d\x9e\x54\x4d\x5a\x08\x07\xd9\x01\xb1\xe7\x85\xdd\x97\x2d\x70\xe1\xa2\x76\x97\x9c\xfe\x52\xe4\xec\xa4\x3f\x24\xf7\xdd\xb7\x67\x17\x6d\x3c\x6b\xb2\xc5\xa0\x1c\xa8\xf3\x67\xdf\x9f\x65\xb2\x48\x4f\x42\xdf\xb5\xf4\xd3\x0e\xe9\x34\xe3\x53\x3a\xa5\x16\xda\xef\x41\xcb\x4b\x3e\xbd\xe1\xb9\xb6\x88\xf9\x4e\x2a\xfd\xca\xde\x5d\x1a\xec\x8b\xb5\xdd\x42\xe5\x8d\x1f\x5c\x1d\x90\x1d\x74\x0c\x68\x57\x6f\x5e\x49\xc1\x76\xcb\xfd\xa1\xa5\x0f\x15\x36\x52\x64\xe7\xe3\x51\x6f\x01\xea\x1d\xfe\x6d\xc5\x8e\x45\xd5\x7d\x0f\xe9\xc3\x6b\x4d\x16\x32\x4b\x15\x59\xd1\x9c\x2e\x99\x36\x34\xd1\x49\xff\xde\x8c\x42\xa6\xec\x08\xf7\x1a\x93\x0b\xbc\x61\x42\x43\x03\x9f\x01\x56\xcd\x49\xf2\x31\x75\x4f\x8b\x98\x91\x8d\x2e\x64\xbb\xde\xe5\xa6\x0b\x2b\x73\x42\x9e\xee\xf7\xb3\x9c\x39\x90\xce\x7a\xb6\x32\x7f\x4b\xd4\x00\x0d\xba\xec\x55\x06\xda\x1b\x23\x46\x33\xcf\x12\x99\x71\xa5\x99\x00\x7c\x82\x1d\xa3\xb2\xbc\x57\x76\xa0\xca\x80\x19\x81\xa9\x98\xe9\x9e\x09\x1c\xd4\xd3\x7d\x10\x6c\xb2\x9c\xd1\xd4\xc8\xb8\xa6\xbf\xb1\xc7\x21\x2d\xc4\xf6\x2d\xe6\x9e\x44\x72\x91\xf0\x94\x55\x0a\xd5\x2b\x99\x32\x40\x40\x4e\xc5\xbc\x14\x4d\x4b\x97\x0a\x90\x15\x36\xaf\xa8\xfc\xa7\x64\xd0\xfb\x46\x44\x07\x13\xbe\x2e\x8d\x6b\x46\x04\xa8\x31\xb1\xa7\xfb\xed\xab\x4f\xd0\xd9\x60\x49\xf5\x89\xa1\xda\x8f\x0f\x83\xad\x96\xf4\x8e\x2f\x8b\xe5\x09\x79\x72\x7c\xfc\xf8\x38\xdc\x0c\xaf\xd0\x4f\x48\x78\x27\x20\xef\x03\x1e\xd1\x4a\xb6\x89\xdd\x73\xaa\xe7\xa6\x3b\x3a\x7a\xdc\x7f\xd7\xa9\x8f\xbb\xed\x2e\x63\xfb\x2e\x0e\xb1\xbe\xef\x8e\x8e\x1e\xf7\xd9\x78\x11\x90\x60\x08\xbc\xcf\xc6\x8b\xc0\xd4\xbe\x64\xd8\x6b\xe3\x1d\x1d\x3d\xfe\xf7\xda\x79\xab\x5c\x6a\x99\xc8\xac\xe7\x1d\xc0\xc0\xb5\x0f\x18\xb6\xdb\x7d\xc9\xda\x1f\x76\xb7\x82\x0b\x2e\x2e\x12\xb9\x34\xe4\xdd\x77\xf6\xd1\x12\xae\x03\x57\x99\x11\x81\xaf\xce\xc0\x50\x1b\xd6\xdf\xcb\x19\xb7\x0d\xa1\xec\xe8\xe2\xf5\x0f\x7f\xfb\x7b\x39\x63\xe0\xa4\xf5\x9f\x62\x34\xcd\xbb\x66\x86\xdd\x55\x13\x01\xdd\x6d\x6b\xb1\x82\xdd\xcc\x35\xea\x53\xe0\x65\x10\xb9\xb4\x73\x43\xb6\x2e\x15\x34\x4d\xcd\xc8\x99\x32\xfb\xd4\x99\x5c\x3c\x9c\xc0\x71\x99\xc9\xfc\x96\xe6\x01\xc9\xa1\x02\x2a\x15\x6b\xe2\xb3\x15\x39\x63\x42\xfe\x0a\x4a\x1a\x60\x22\x02\xb2\x5c\x78\x14\x81\x55\x00\xd7\x60\x63\x02\x24\xe0\x18\x70\x6a\x11\xb8\xd5\xa4\xb9\x50\x9a\xd1\x14\x6f\xb9\x0d\x08\x77\x89\x57\x43\xf5\x23\xe5\x3e\x89\x00\xe5\x02\xb9\xe2\x82\xd1\xd4\xac\x8e\x51\x82\x32\x39\x57\x63\x52\xdd\x68\xe0\x44\xca\xa9\xf7\xd9\x04\x96\x76\x6e\xce\x1a\xd4\xd3\x84\x16\x9b\x48\x9f\x51\x1e\x83\xc8\x67\x64\x2d\x0b\x30\xd4\x19\xba\xe2\xf4\xe5\xba\x7e\x01\xa3\x05\x25\xb3\xf7\x48\x4d\xcf\xb8\x51\xfa\xee\x02\xa3\x22\x1b\x8e\x71\xb2\xb7\x77\x7b\x7b\x3b\x5e\xd0\x55\x2e\xef\xd6\x63\x99\xcf\xf7\x52\x79\x2b\xcc\x88\xf6\x0e\xc7\x87\x7b\xa9\x4c\xf6\xe0\xd5\xa8\x3c\x48\x3a\x78\xc5\x42\xac\x73\x1f\x17\x48\xfb\xb8\x14\x84\x4e\x65\xa1\xdb\xce\xe2\x55\xcd\x1e\x8e\x37\x54\xb1\x8d\x93\xb3\x9a\x49\xd9\x71\x9f\x93\x4a\xab\x07\xf5\xd6\xaa\x9d\x63\xf2\x19\x99\x0c\xae\xce\x2e\x26\x83\x30\x85\x26\xd0\x08\xc6\x66\x2f\xc0\x9a\x60\x3c\xba\xe7\x29\x71\x21\x97\x04\xf3\x58\x83\xae\x02\x2a\x66\x48\x47\xdb\xf4\xbd\x6d\xe9\x81\x8d\x6d\x1c\x30\x79\x80\xbf\x95\x21\x35\x0b\xc3\xde\x02\x57\x56\x24\x7a\x6d\x45\x62\x57\x57\xf8\xf2\xea\xec\x22\xf2\x36\x4e\x38\x3a\xef\x72\x09\x98\x50\xfa\x0a\x3f\x07\x5f\x3e\x7e\xd2\x8f\x53\x95\x50\x23\xd2\x0f\x98\x66\x16\x68\xc4\x89\x6d\xb5\xd2\x5d\x0d\x8c\xd9\x39\x6c\xbd\xd2\x50\xd4\x2e\x1e\x97\xb2\x48\x6c\x67\xf4\x94\x52\xce\xc1\xab\xb1\xb4\x1c\x99\xa3\x18\x93\xad\x4a\xb3\x84\xaa\x4c\x7a\x69\x94\x22\x70\xb5\x49\x12\x62\x5b\x7a\x83\x28\xbb\x89\x17\xca\xfa\x33\x00\xb2\xcd\xb9\x5c\x30\x9a\xe9\x85\xbd\x0b\x8e\x1d\xbc\xab\x0d\x98\xe8\x59\x2d\x52\x24\xe5\xab\x5c\x4e\x0d\x67\x14\x5e\x07\x86\x9a\x44\x40\xce\xf9\x0d\x13\x44\xc8\x94\x0d\x2b\x5c\xaf\xa8\x5e\x90\x3d\x1c\xd6\x2f\x7b\x28\x6e\x6a\x49\x52\x86\x57\xd1\x8c\xf0\x59\xc7\xcc\x5b\x6e\xae\xc0\x2f\xd5\x42\xca\x59\xc2\xf8\x0d\x2b\x9d\xab\x65\x97\x6a\x63\x06\x38\x06\xdb\x2e\x2a\xa7\xd6\x73\x9f\xdb\xbd\x5a\x47\x0a\x58\x03\xcd\xfe\xb2\x8b\x19\x1b\x6b\xe5\xdd\x4d\xa1\x13\x52\x08\xcd\x33\x3c\x00\xde\xb2\x58\x6e\x6b\xa7\xb0\xdd\x1a\xd9\x75\xa7\x99\x92\x44\x69\xb9\xf2\xc4\x93\xca\x63\x13\xdd\x91\x63\xa4\xdc\x09\xbd\x66\xeb\x1f\x1d\x13\xc5\x12\x29\x52\x45\xe8\xcc\x9c\xb6\xc6\x62\x29\x4d\x71\xb0\x56\x44\x88\xeb\x04\x23\x9c\x14\xb9\xc8\xe5\xd4\x0c\x8a\xdd\xb0\x7c\x4d\x8e\x0d\x52\x0e\xf6\x5d\x47\x76\x7b\x50\x72\x3c\xc2\x5f\x62\x58\xe5\x4b\x66\x28\xb8\x11\x29\xec\x57\x7a\x91\x33\xb5\x90\x19\x88\x2e\xfa\x56\x12\x55\x24\x09\x53\x6a\x56\x64\x46\x6c\x35\x22\x40\xd0\xbf\x81\xd8\x5b\x7c\xeb\xda\x4a\xa6\x2c\x91\x4b\xb7\x3e\x6b\xb8\x8e\x12\xee\x1f\x39\x33\xcc\x47\xf3\x1b\x96\xad\x87\x86\x08\xc5\x68\x0b\xcb\xb2\x91\x66\x4a\x7b\x5e\xc9\x4e\xcd\xee\xd0\x69\x62\x5c\xa7\xae\xed\x18\x6a\xfc\xef\xa4\xee\x74\xb8\xff\x64\x9e\x19\xb0\x87\xa1\xcb\x6f\x1e\xb6\x74\xd5\xce\x4b\x08\x59\x21\x73\x56\x8b\x61\xf2\xbe\x66\x2d\xe7\x63\x0a\xf2\xf8\x6b\xc3\x67\x82\x4d\x9b\x3a\x5f\xcb\xa7\xbe\xf7\x9d\x40\xd8\xd6\x43\x35\xb2\x7b\xce\x2f\x9c\xe8\x8e\x8c\x0e\xf6\x10\x8a\x47\x14\x0e\x4c\xe9\x87\xdf\x42\x63\xe2\xd6\x84\x9c\x19\x11\x23\x81\xab\x0d\xf2\x82\x26\x0b\xcb\x4a\xdd\xe5\x49\xa5\xb0\xa3\x88\x7d\x76\xfe\xfc\x75\x9c\x70\x20\x01\xde\x61\xe3\xf9\x98\x4c\x06\x07\xfb\x63\xf8\xdf\xde\x53\xe7\x28\x35\x4b\xf7\xf7\x4f\x4e\xcc\xbf\x77\x81\x43\x0b\x89\x5d\x76\xc8\x6c\x6e\x1c\x43\x32\x19\x38\x98\xfb\x93\x01\x6c\x97\xf3\x8b\x9b\x23\xa0\x2b\x93\xc1\xc9\x89\xff\x6b\x58\xe4\x21\x65\x04\x09\x99\xae\x4b\x69\xd4\x21\xd5\x2c\x8b\x82\xbb\xa2\xa6\x86\x19\xa3\xf2\x46\xea\x95\x10\x88\x93\x71\x4d\x35\x23\x4b\x6e\x6f\x37\x50\x10\x62\x34\xcf\x38\xcb\xcb\x60\x2d\x43\xf5\xca\xbb\xee\x28\xef\xa4\x9a\xa4\x1c\xc5\xa3\x05\xbd\x41\xd9\xab\x6d\x73\x81\x1c\x3f\x04\xcd\x68\x19\x70\x31\xc5\x47\x31\xed\xc7\x2c\x8d\xa7\x4c\xd3\x71\xfd\x52\xdb\xec\xa5\x91\xdb\x4b\x23\xc4\xc3\x08\xb7\x5f\x0c\xab\x65\x50\xa2\x13\x19\x27\x03\x14\xfe\x46\x7f\xda\x08\x66\x83\x2b\xe0\xbf\x4c\x06\x6e\x18\x71\xc5\x14\x81\x55\x9e\x9c\x16\xdc\x64\x50\x39\x73\x0e\xad\xbf\x04\x57\xde\x40\xa2\x82\x64\x96\x11\x4d\xaf\x19\x61\xb3\x99\x51\x09\xf8\xac\x15\xaf\xce\x83\xda\xcc\xa9\xcf\x8a\x1d\x8d\x0f\x0e\x23\x4a\x45\xd4\x89\x98\x34\xc9\x88\x39\x72\x60\xe3\x13\x1b\x84\xc0\xa0\x05\x5e\xf7\x98\x2b\x21\x3b\xe0\x3d\x78\x47\x97\xab\x8c\x0d\x89\x77\x34\xe1\x64\x96\x07\x73\xb0\x1b\xdb\xe4\xd6\x9f\xfb\x84\xec\xfc\xbc\xb3\xf3\x66\x7f\xf4\xe5\xdb\xf7\xf0\xff\xf8\xe7\x81\xf7\xf7\xe1\x9b\xfd\xd1\x91\xfb\xfb\xf8\xcd\xfe\xe8\xf8\xed\xee\x64\xbc\xfb\xeb\xe3\xdf\xb6\xff\x6e\xcf\x7d\x72\x70\x68\xdf\x3c\x7e\xb3\x3f\x3a\x7c\xbb\xfb\xc7\xdd\xf7\x3b\x3f\xab\xcf\x76\x70\x2c\xa7\xa3\x6f\xe8\x68\xf6\xf6\xd7\x83\xe1\xd1\x6f\x27\xbb\xbf\x7e\xf1\xdb\xc6\xaf\xef\x4f\x76\x77\xdf\xb7\x36\x7e\xf2\xdb\xce\xc9\x46\xeb\x9d\x1d\x3b\x02\x3b\xaa\xf4\xfd\x41\x9a\xbe\x7f\x73\x30\xfa\xf2\xed\xd7\xe9\xee\xce\x38\xfa\xda\x4c\x75\x37\xdc\xe1\xf1\x6f\x3b\x3b\x9b\x5d\xee\xfe\x7a\x30\x3c\x34\x9f\x7d\xcc\xae\x8f\x82\x5d\x9b\xcf\xda\x5e\x7d\xfd\x01\xc6\x13\x19\xd0\xe3\xe0\x80\x8e\x02\x03\xfa\x75\x7f\x78\xf8\xdb\xc7\x1d\xd4\x61\x70\x50\xc7\xe1\x41\x3d\xfe\xc8\x83\x3a\x08\x0e\xea\x49\x78\x50\x47\x1f\x70\x50\x27\xa1\xfe\xbf\x08\xf7\x7f\xfc\xc1\xfa\xdf\xdd\xf9\x74\xfc\xf9\xee\xd7\xea\xb3\x9d\xc9\xde\xce\x81\x69\xfb\x14\xa9\xc7\xc1\x5b\x47\x21\x4a\x42\x02\xff\xbf\xbb\xfb\xc7\x36\x47\x48\xf7\xf4\x30\xb3\x10\x22\x8a\x2c\xa3\xd3\x8c\x9d\x10\x9d\x17\x61\x2e\xd5\x15\xdd\x41\x48\x2a\xd4\xf7\x10\x3b\xbd\x64\x42\xc7\x7c\xe4\x89\x6f\xbb\xc1\x4f\xc2\x82\x5c\xdd\x87\xad\xa5\x0f\xf0\xc7\x41\xff\x6e\xee\xe2\x79\x66\x2c\x59\x27\x59\x8c\xe5\x5a\x6b\x4a\xe9\xb4\x59\x39\x37\x11\xaa\x94\x4c\x78\xe9\xc3\xb7\x29\x7b\x46\x45\x0f\xf4\x13\x70\x9e\x49\x2e\x98\xbc\xe1\x00\x56\x46\xe1\x93\xf3\x4a\x77\x8a\x1b\x6e\x2d\x9e\xc6\xe4\x47\xb0\x6e\x5a\x57\x7d\x9a\xb3\x12\x85\x20\x1e\xfc\x55\xd8\x2e\xc7\xed\x3e\x73\xa4\x87\xd9\xaf\x6b\x49\x46\x55\x2f\xbf\xc7\xc2\x67\x23\x16\xf2\x8b\x52\x3d\xda\xe2\xba\xa9\xf1\xa5\xd5\xb4\x5c\x90\x63\xdf\xe5\xf2\x2d\xd0\x2e\x6a\xd2\xa9\x1b\x85\x48\x59\x9e\xad\x21\x6c\xac\xe6\x80\x53\x0e\xbc\x43\x5c\x6e\xf1\xa4\x2a\x9d\x46\x57\xab\x8c\xbb\xf8\xee\xb2\xe3\xcd\x69\xc5\xac\x8f\xe0\x30\x89\x4e\x6f\x18\x02\x6e\x6d\xe8\xe8\xa0\xee\xba\x8c\x08\x6a\xdd\xda\xa1\x79\xe8\x6d\xf4\xf5\x86\x56\x78\xab\xdc\x3c\x2a\x43\xa3\x45\xb0\x0b\xf3\x8c\x44\x8e\x94\xbd\xe6\xac\xb6\x20\xa7\x3f\x5d\x36\xee\xd6\x02\xce\x6a\x1d\x70\xe3\x0b\x60\x06\xbf\x89\xd7\xae\xa1\x6e\x87\x75\xd2\x1b\xf3\x04\x62\x8f\xa8\x52\x3c\x79\xd9\x69\x83\xf0\x9f\x46\x64\xdc\x06\x84\x8d\x63\x53\x5b\xa7\x4e\xf0\xa4\x69\xd0\xa0\xe0\x50\xe6\x7a\x6a\xd8\x36\x9c\x15\xa3\x07\xd8\xa6\x9d\xe3\x0c\x01\xc6\x8e\x99\x1d\x4f\x6f\x7c\x12\xf4\xea\xb3\x86\xee\xf3\x34\x63\x57\x68\xb9\xeb\xf3\x61\x13\xb3\x6d\x70\xea\x37\x41\xbd\x80\x96\xd6\x2e\xb0\x22\x92\x15\xcb\xb9\xb4\x7e\xf1\x35\x87\x7a\x4c\x5d\xd1\x13\x24\x4f\x33\x46\xa6\x6c\x26\xed\x65\x46\xc3\x88\x9e\x49\xb5\x19\x13\x1a\x0b\xcf\x6d\x3e\x68\x2c\x74\x86\x94\x15\xcd\x15\x03\x1f\x48\x48\xc7\x01\xf3\x48\xdd\xb9\x87\xa6\x5f\xf5\x84\xab\x18\x23\x7f\x72\x8e\xe8\xab\xeb\xf9\x78\x2e\xc7\x29\xbb\xd9\x33\x20\x3f\xb9\x30\xdd\x3c\xb7\x70\xff\x32\x26\x64\x33\x8c\xa9\xfd\x11\x1c\x6c\xae\xbf\xb0\x5c\xba\x91\x43\x0c\x83\x90\x44\xae\xb8\x00\x6f\x73\x2e\xd0\x40\xd2\x13\x64\x42\x21\xe0\xc1\xf9\xe3\x95\xc6\x53\x0c\x7d\x01\xa3\x78\xed\x5d\x4f\xb0\xf5\x5b\x4b\x03\xf0\xc9\x7e\x19\xc7\xe7\x39\x87\xda\x6b\xbe\x9e\x50\xab\xcb\xc0\x5e\x1f\x38\x1b\x6d\xda\x9f\x24\xf4\x14\x36\xeb\x8d\x83\x36\x57\xff\xb1\x6e\xb6\xbf\x83\x04\xb6\x40\xf8\x48\x24\xd0\x25\xa8\xf9\x60\x24\xf0\xd5\xcb\x67\xdd\x4b\xb6\x05\x32\xa1\xe9\x76\xd8\x1b\xb8\xb1\x18\x6a\x01\x7f\xcb\xd9\x26\x3f\xee\x31\x37\x70\x23\x57\x9a\x0a\xcd\x21\x42\x0d\xd1\xb6\x61\x37\x03\xc6\x0e\x42\x6e\x0f\x98\xbe\x18\x0c\x17\xfc\x93\x81\xe5\x1a\x93\xc1\x09\x39\x75\x2c\x04\x4c\xe6\xe4\xd9\x16\x63\x35\xd4\x77\x49\xaf\x99\x82\xdb\x5d\x23\x04\xa6\x2c\xe1\x68\xd9\xa4\x9a\x30\x5e\x3a\x37\xe9\x9c\x0a\xb5\x92\x79\x9f\xe5\xcd\xe8\x9a\xe5\x64\xe7\xea\xec\x62\xef\xf2\xf2\xe5\x2e\xb1\xb7\x01\x20\x8d\xd8\x74\x1d\xb6\x09\xc4\x81\x81\x33\x5d\xd4\x60\xe5\x1e\x23\xc6\xd4\x23\x07\x01\xbd\x69\xca\x31\x88\xd4\xc9\x32\x27\x7e\xb6\xa3\x54\x26\x6a\x4c\x6f\xd5\x98\x2e\xe9\x2f\x52\x40\xe2\xb4\x53\xf8\xf3\xc5\xd9\xe5\x1e\xa6\xbd\xd9\x2b\x53\x90\xcd\x0b\x9e\xb2\x86\xe1\x14\xdc\x96\xc7\x0b\xbd\xcc\x3e\x49\xb2\x69\x8f\x71\xda\x35\x7a\xf5\xf2\x19\xae\x8f\x73\x09\xae\xad\x8f\x87\xfd\x1e\x20\x5b\xd7\xa7\xb6\x30\x4d\xb4\xff\x7b\x20\x54\x64\xd3\xb8\x14\x49\x3a\x55\x39\xf7\x8c\xdc\x39\xe8\xd1\xf2\xd5\xcb\x67\x3d\x09\x4e\x0f\x52\x1f\x0f\xc9\xaf\x3a\x35\x00\xa3\x4d\x7a\x92\xb8\x79\xb2\xda\x46\x59\x99\x27\xab\x8f\xa1\xac\x7c\x7b\x76\xf1\x9f\xa0\xac\x98\xc1\xff\x57\x53\x56\x38\x13\xfa\x14\x2e\xed\xb6\x65\x32\xfe\xb7\x5e\x3a\xbe\x85\xbc\x75\x3e\x8f\x78\x19\xd8\xe3\xe8\x62\x92\x39\x7b\xf1\x67\x1d\xcd\x3c\x27\x99\xc6\xaa\xdc\x87\xd3\x18\x12\xf6\x6d\x26\xa7\x34\x33\x54\xec\x12\x23\xc3\x31\x63\x51\xd9\x57\x1f\x6e\x50\x93\xc9\xc1\xd6\x84\x50\xeb\x53\x76\x37\x76\x36\xb0\xb7\x07\x60\xb8\x90\xa3\x62\x4d\x72\x36\x37\x9b\xd9\x66\x7e\x33\xf4\xec\xc7\x8b\xb3\x86\x17\x6a\x28\x50\xb5\xf9\x6c\x5e\x5b\xfb\xa4\x0f\x92\x66\x8e\xe7\x52\xce\x33\x06\x74\xcf\x0b\xb0\x64\x62\xce\x05\xc3\x40\xd2\x85\xbc\x1d\x69\xb9\xe7\xb0\x34\xf2\xc8\x1f\x17\xf3\x4f\xe6\x30\xff\x77\xbd\xd7\xda\xf2\x93\x97\x32\x89\xad\xc5\xd6\xa2\x0a\xac\x05\x00\x6d\x2c\x05\xaa\x0d\x98\xc8\xc2\xc6\x59\x77\xfa\xb1\xf8\x08\x54\x74\xc9\xdc\xaa\x40\x44\xfb\x8f\x17\x67\xbb\x46\x69\x32\x2f\x37\xe8\x46\x1f\x65\x96\x8a\x8d\xc5\x6c\x5b\xaa\xae\x94\x1d\xf5\x81\x72\xe5\x04\x3e\xa7\x72\xb8\xb4\x61\xf1\x45\xaf\xaf\x26\xae\xb8\x5b\x84\x4f\x10\x61\x76\x6d\x3f\x1c\x6b\xc3\x23\xd3\xa3\x21\xac\xe7\x87\xe3\x6d\x3d\x59\x52\xb7\xc4\x5d\x23\x84\xbe\xb0\xdd\x6d\x81\xec\x98\x4d\xbb\xb7\x09\x39\xc5\x4b\x60\x8f\xa4\x91\xc1\xe9\x4f\x97\x83\x21\x19\x40\x38\xdf\x20\xe6\xe5\x67\x9e\xc1\x33\x9a\xb3\xef\x99\xa6\x99\xf9\xe6\xdb\xb3\x0b\xf3\x9f\x57\x85\xa6\x82\xdf\x99\x3f\xe1\x16\x59\xd3\xe4\x7a\x80\x77\xd6\x83\x1f\x2f\x57\x0b\x96\xb3\xa8\x8f\x6d\x9f\x15\x1f\x19\xc5\xa3\xab\x85\x99\x42\x47\x9b\x72\xfc\x1d\xed\xbe\x8d\xf8\xb8\x62\x0b\x3b\xeb\x8e\x56\x25\x42\x3a\xda\x59\x3c\x75\xb4\x3a\x7f\xf6\x7d\x8f\x9d\xd9\xb1\x85\xbb\x45\xb3\x0e\xb1\xac\xc7\xfe\xc7\x64\xbe\xfd\x8c\xfa\xd0\xd6\xbb\xcb\x01\x6a\x09\xbf\x51\x17\x92\xb4\xcd\x45\x0c\x57\x36\x35\x6d\x3a\x26\x17\x36\xf7\x6a\x6d\xc3\xbb\x18\xce\x01\xee\xd0\x73\x4b\xa5\x22\x5b\xb4\xeb\xda\xe4\xbc\x8b\xf3\x8f\x62\x81\xa3\xf8\xfc\xee\x24\x57\x2d\x17\x64\x81\x96\x80\xdc\xd6\x77\x1d\x2b\x2b\xac\x5f\x72\x0f\x67\x38\xd7\x34\xec\x08\xe7\x9c\x9c\xc3\xa1\xba\xe4\xde\x61\x9f\x0d\xd8\xf7\xf5\x91\x7b\x08\x86\x7a\x08\x86\x7a\x08\x86\x7a\x08\x86\x7a\x08\x86\xc2\xe7\x21\x18\x6a\xf3\xe5\x47\x0d\x86\xea\xe0\xc7\xab\x9c\xdf\xb4\x66\xda\x23\x4d\xd6\x64\x5b\x86\xb9\xf1\x85\x6d\xe0\xf8\x6d\x88\x23\x6e\xc3\x85\x2d\xcc\x07\xee\x4b\x1e\xb8\xaf\x07\xf4\x81\xfb\x3e\x70\xdf\x07\xee\xfb\xc0\x7d\xed\xf3\x6f\xcb\x7d\xc3\xf6\xbd\xf0\x2d\x7a\x0b\xff\xb4\xfc\xa4\xee\xde\x19\x18\x74\x75\xdb\xdd\x96\xa2\xca\x30\x0d\x1b\x85\xac\xfa\x67\x1d\x24\x90\x97\x14\x0f\xb7\x57\x9c\xc8\xef\x80\x38\x3d\x1a\x2e\xb3\x84\x9f\xa2\xdc\xde\x9a\x85\x76\x59\x28\xb3\x6b\x55\x90\x08\x88\x87\x4d\x81\x5b\xa5\x56\x33\xa7\x22\x00\xf2\xb4\x75\x68\x8d\xc2\x46\x7e\x96\xb7\xaa\xb3\x10\xc8\x89\x30\x34\xa6\x5f\x1a\xcc\xb6\x62\x40\xf6\xb7\xbd\x4f\x0c\x29\xea\x30\x51\xe1\x75\x60\x95\x6d\x11\xf2\xbf\xd1\x56\x77\xe4\xa6\x2b\x71\x6b\x5e\x55\xf3\xa0\x85\xc4\x1e\xd0\x36\xec\x3c\x52\x55\xb8\xb8\xa0\x4b\x36\xf6\x53\x3a\x86\xf7\x5a\xd9\x33\x4a\x76\x02\xbe\xfa\x45\x0a\xa6\x6c\x6d\x1e\x8c\x32\x13\xdb\x66\x07\x24\x65\x86\x40\xac\xf2\xf4\x0f\x29\x30\x25\xac\xfd\x11\xe5\x46\xf3\x2b\xd6\xc1\xf2\x71\xb3\x2c\x6d\x5c\x01\xc0\x66\x23\x14\x79\xce\x84\xce\xd6\x4e\xec\x71\x53\xb0\x5c\xf2\xf4\xa7\xcb\x21\x5a\x8b\xd1\x4a\xfd\xed\xd9\x45\x99\x90\x2d\x18\x0a\x07\xc7\xae\x91\xcf\xad\xeb\xb8\x99\x0e\x85\x0c\x06\xfd\x63\x98\x75\x95\x77\xa0\x3d\x92\x1c\xf7\xaf\x4b\xd2\xf8\x11\x4e\x1f\x64\x3f\xa8\xb6\xf3\x90\x4c\x65\x11\x8c\x79\xd6\x12\x63\xc7\x71\xec\x4f\xf7\x01\x81\x47\x47\x8f\x31\xed\x41\xa1\x5c\xf4\xbb\x5a\x49\x5b\xed\x0a\x72\xaf\xdb\xe1\x86\x65\xc3\x66\x46\x03\x4f\xc6\x68\x4d\xda\xd8\x4d\xd4\x6e\x38\x85\x0f\xaa\xe1\xda\x04\x9f\x4e\xd7\x99\x08\xf2\x5c\x32\x8c\x76\xee\x97\x16\x92\xd4\x52\x43\x46\x16\x64\xbb\x91\xf6\x21\x87\x43\x97\x1e\xd6\x8c\x96\xdd\xad\x32\x9e\x70\xdd\x9a\x11\x99\x54\x7a\x9a\xcb\x46\x01\xcb\x62\x4b\x95\x88\x02\xf2\x15\xc7\x51\x1a\x3d\x04\x0d\xeb\x6a\xaf\x83\x00\x1c\x26\xb4\xf4\x3e\xdf\x29\x73\x5f\x74\xf3\x9c\x88\x9f\xed\x7d\x79\x0e\x39\x2d\x07\x10\x80\xbc\x15\xbf\xc1\x5c\xef\xce\x6f\xa3\x93\x12\x18\x92\x9b\xae\x05\x5d\xda\x9c\xd2\x34\xcb\x64\x02\x5d\x4c\xd7\x55\x80\xe6\x57\x64\x21\x6f\xd9\x0d\xcb\x87\xa6\x67\x4b\xe1\x42\xf7\x30\x9a\x6a\x9e\x60\x76\x0c\x0b\x0d\xb2\x92\xe2\x8e\x40\xd9\xac\x94\xbd\xcb\x83\x12\x2d\x1b\x61\xe3\x67\x1c\x6b\x68\xae\x17\x72\x2d\xa8\xf8\x95\xdf\xb4\x26\x97\x24\x1d\x65\x13\xfa\xe5\xf1\x34\x2d\xbb\xb2\x46\x8e\xdc\x51\x0f\xbc\xed\x73\x05\x71\xef\x7a\x0b\xad\x57\x68\x11\x91\xd2\x08\x1f\x67\x72\xe9\x6a\x20\x75\x64\xbb\x6d\xb4\x2e\x6b\xe5\x51\xb2\xc2\xd8\xa8\x32\x13\xa4\x4b\xfb\x91\x54\xad\xdb\x4e\xf7\x33\x2f\x5c\x5c\x03\x47\x02\x5a\x83\x30\xa2\xdf\xc6\x2d\x39\x4b\xbe\x64\x57\xeb\x55\xc8\xcc\x53\x17\x95\xcb\xc6\x98\x4e\xdb\x15\xb9\xfa\xfe\xfc\xfb\x17\x36\x51\xaa\x5f\x88\x67\x41\x6f\x42\xe7\xc9\x1b\x70\xe5\x44\x75\x55\x96\x8e\xb2\x26\x13\xeb\x94\xe5\xfc\xbf\xd1\xb3\x7b\x3b\xa2\xe2\xf8\x87\xe9\x64\x5d\xc3\x93\x2b\x97\x72\x62\xfe\x3f\x44\xf9\xb2\xcc\x9f\xdc\x94\x09\x36\xe3\x1a\xbd\x78\x3c\x58\x43\x32\x2d\x34\xf9\xee\xf4\xc2\x28\xb7\x78\xc0\x94\xe6\x59\x48\xcb\x36\xba\x7e\x59\x12\x10\x0e\x77\xbe\xb6\x56\x1f\x80\x68\x54\x7b\x2e\xd0\xa7\x00\xc8\xd7\x98\x90\x6f\x23\x29\xf6\x09\x16\xa1\xa3\xc8\x86\x34\xbb\xd3\x64\x67\xa1\x97\xd9\x90\x24\x4a\x0d\xc9\x3f\xd5\x10\x52\x1a\xef\x5a\xef\xf1\xc8\x3c\x02\xc0\xcd\xec\xdc\xb7\x18\xfd\x90\xb3\x32\xf7\xa3\xfb\x9e\xa5\x64\x87\x2f\xe9\xdc\xc8\x6c\x45\xca\xe5\x90\xdc\xf0\x94\xc9\x10\x4c\x1c\x91\x1b\x49\xc6\xb5\xce\x20\xae\x9c\xdd\x21\xd5\x2b\x2d\x9f\x10\xb2\x00\x59\xb1\x57\x85\x99\x66\x50\xb0\x84\xc2\x02\x76\x2e\x46\x5b\x9a\x53\x2e\xc6\xa5\x71\xc2\xe8\x0d\xff\x94\x6c\x21\x85\x96\x62\xbc\x64\x29\x2f\x96\xe0\x19\xa3\x17\x6c\x34\xff\x85\xaf\x46\x2b\x26\x68\xa6\xd7\xa3\xf4\xf1\xc1\x34\x7d\xf2\xe5\x17\xb3\x03\x7a\xd8\x4e\x1d\xa3\x81\xf4\xf5\x13\xe3\xd1\x00\xb3\x91\xcc\xe1\xa9\xd5\xcd\x44\xb4\x42\x21\x4c\x82\xf5\x85\x83\xfa\x70\xb9\x11\xc7\xe4\x05\x66\xb9\x30\x4b\xbd\x97\x28\xf5\x95\xe1\x14\xb9\x62\xfa\xcf\x85\x9e\x8d\x9e\x4e\x06\x43\xf7\xd2\x6c\x83\x49\xc4\x6b\xc4\x36\xfb\x0c\x3f\x81\xe5\xdb\x53\x37\xf3\xcf\xef\xe0\x33\x32\x19\x78\x0e\xd0\x7b\x32\xd1\x4c\x8f\x94\xce\x19\x5d\xc6\x81\xfe\x6d\x94\x40\x29\xe9\x3d\xfc\x8f\x2a\xa6\x06\x9a\xcb\xab\x7d\x55\xcd\xdb\x52\x0a\xb4\xdd\x44\xe3\x72\xce\xb0\x2c\xca\xa8\xc2\x20\xf8\xfb\x9a\x2d\xf3\xfa\x9b\x33\x72\xf0\xf8\xe8\xe0\xa4\xde\xe8\xe4\xcf\x71\x37\x89\xc9\x60\x6f\x32\x20\xaa\x98\x82\x01\xe0\xb3\x37\x93\xc1\x57\x93\x41\x65\x7b\x7f\x4b\x46\x30\x52\x34\x0f\x88\x1a\xec\x20\x4c\x4b\xb8\x8c\xbe\x24\x67\x27\xbe\xfb\x78\x79\x2a\xec\x19\x59\x32\xa5\xf0\x0f\x28\x1e\x40\xf3\x88\x25\xc8\xac\x91\x3b\x4e\x04\x4a\xc1\x22\x5e\x71\x6c\xab\x9c\x25\x2c\x45\x21\xc4\xa0\x7e\x82\x8e\x13\x88\xd3\x48\x20\xe8\x74\x4d\x28\xd1\xf2\x9a\x41\x79\x8e\x52\x61\x64\x99\xbc\x1d\xbb\xb9\xc3\x5b\xbf\xd6\xa8\xd9\xa8\x61\xe3\x52\x55\x7f\xcf\x6c\x48\x9a\x68\x23\x04\x99\xc1\x60\x86\xb5\xb2\xd4\xdb\xed\x82\x6b\x23\x78\x41\xee\x0c\x4b\xae\xc3\x38\x75\xa0\x14\x4e\x5e\xac\xcb\x8c\x6b\xe5\x1b\x97\xa8\x43\x83\x30\x47\x33\xd0\xdb\xc3\xc6\x3a\x3b\x3b\xbf\xb1\x1b\x9e\x6a\x42\xde\xd9\xfd\xd3\x5f\xfe\xaf\xe1\x57\x27\x93\xc9\x64\xb0\xf7\xe6\xed\xd7\xe3\x3f\x77\x80\x75\x3b\xaa\xb1\x67\x30\x5f\xae\x92\x0e\xe9\x0e\xc7\x32\x56\x4d\x8a\xf8\x01\x2d\x7b\xca\x33\x72\x6a\xaf\x27\x90\x4b\xed\xfa\x51\x75\x62\x97\x6d\x32\xf8\xf3\x24\x6c\x9b\xdb\xc1\x46\x7b\xe4\x5f\x85\xd4\x2c\x1d\xe1\xfa\xee\xda\x41\xd5\x7e\x1c\xfa\xfb\x23\xe2\x50\x69\xce\xe1\xd3\xc3\xc3\x21\x5a\x18\xf3\xdc\x68\xa9\xd6\x04\x21\x0b\xa3\x69\x02\x54\xe5\x4a\x1c\x94\x85\x95\x60\x37\x04\xa1\xc2\x2e\x21\xab\xac\x50\xb0\xf4\xe5\xca\x90\x17\x7f\x3b\x7b\x71\x71\x45\x26\x13\x43\xa7\x70\x9b\x9d\xbd\x86\xb8\xed\x04\x72\x1c\xa9\x90\x39\x86\x94\x3d\x1b\x80\x48\x75\xc9\xe9\xe5\xd9\xf9\xb9\x07\x9d\x62\xe1\x64\xf3\x5f\x8e\xb5\x2c\x55\x42\x57\xf1\xe3\x34\x99\x04\xed\xa8\x65\xfe\x92\x9f\x77\xbe\xe6\xbb\x3b\x77\xa3\x37\x3f\xbf\x7d\x43\x76\x76\x27\x13\xdc\x5d\x83\xbd\xaf\xc7\x7f\x9e\xdc\xed\xef\x8f\x26\x77\x07\xdf\x4c\xee\xbe\xf8\xe6\xed\xe7\xef\x3d\xf2\xf1\x1e\xa8\xc7\x7b\x20\x1e\xef\x2d\xed\x78\x5f\x92\x8e\xf7\x86\x42\xbc\x07\x02\xb1\xbb\x67\x20\x87\x97\x3d\xda\xe3\xce\x57\xe4\xb3\x1e\x03\xfb\xf3\xce\xef\xe8\xe3\xfd\x60\x67\x32\x79\x63\x7f\xfc\xe2\x9b\xb7\xef\xdf\xfc\x3c\xb9\xdb\x7f\x3e\x98\x4c\xde\xee\x7e\x36\xd8\xdd\xfd\xec\x8f\x21\xcb\xc6\xc7\x2a\x73\xa9\xd8\x26\x45\xeb\x50\x04\x5e\x18\xc9\xf4\xb5\xcd\x03\x17\xca\xa8\x50\x66\x52\x78\x0d\xd6\x96\xcd\xad\x53\x17\x15\x02\x70\x1b\x3e\xf7\x56\xde\x2f\x2f\x66\x5a\x66\x5a\xd5\xc1\x5c\x50\x91\x66\xe6\xd0\xce\x1a\x11\xa5\x20\x55\x29\x22\x0b\xed\x42\x51\xa9\x4b\x6b\xd7\x5e\x19\xcb\x66\x49\x4c\x37\x7c\x54\x1b\x61\x91\x86\x14\x4d\x06\x76\xc2\x96\x13\x4d\x06\xe7\x73\x21\x73\xd6\x7e\x7d\x32\x71\xc5\x5e\xcb\xb8\x4a\xc8\x12\x25\x7d\x30\x21\x7b\x0d\xe4\x9b\x6c\x1b\x2e\xb5\xb7\x78\x47\xfb\x90\x32\xef\x68\xff\xa9\x33\x79\xb1\x21\x5c\xe7\x35\xf1\xb1\xc3\x67\xce\x93\x3c\x93\xf3\x79\xfb\x16\x33\x54\x00\xcb\x7f\xed\x22\xbd\x49\x64\x21\xf4\x06\x2c\xcb\x8b\xe8\xca\x68\x5e\x39\x84\xd4\x2d\x99\xce\x79\xd2\x6a\xbb\x8c\xcc\xdf\xa1\x2d\x38\x7d\x8c\x16\x6e\xbb\xfb\xa8\x8f\xc8\xdd\x1d\x19\x74\xe1\x4d\x42\x0d\x1b\x73\xc7\x50\xaa\x4f\xda\x84\x11\xb8\x8d\x4b\x72\x30\xee\x9a\x2f\xdc\xa4\x5a\x62\x6a\xcd\x34\xbc\xf5\x43\xb1\x6f\xbd\x42\xbb\x49\x1b\x6c\xbd\x60\x8d\x1b\x50\xc8\xab\x08\x1a\x4a\xfd\xbe\xfc\x91\x4b\x86\xe9\x32\x8c\xe6\xe4\x27\xd6\x16\xe1\xe6\xea\xaa\x3d\x02\x0b\x4f\x91\x51\xcd\x6f\xea\x5d\xec\x4c\x06\x46\x78\xc2\x5f\x26\x83\xdd\x92\x33\x4d\x19\x51\x74\xc6\x5a\xb5\x2d\x0e\x8b\x92\x8e\x09\xf9\xae\x34\xf3\xc0\xe0\xcb\xb4\x90\x4b\xba\x46\x4e\x3f\x65\x78\xb3\x0b\x3c\x50\x04\x0d\x21\x2c\xcf\xa5\x11\x6e\xa0\xf0\x9c\x74\x01\x4e\xfe\xa9\xaa\xed\x06\x2c\xce\xbe\x5c\xb1\x94\x41\x1a\xd4\x24\x10\x18\x0b\xc5\x09\x39\x9d\x0b\xa9\x38\xf8\x05\x18\x84\x65\x6c\x69\x16\xec\x5c\x94\x11\x73\xad\xc3\x2f\x47\xde\x86\xd7\x35\x1a\xa1\x54\x42\x85\x6a\x6a\xe7\x6e\x37\xe1\xc5\x67\x1d\x25\xdc\x48\x0f\x6e\xc8\xed\x07\x4c\xe8\xbc\x40\xa3\x80\xd6\x06\x44\x5b\x08\x54\xbb\x6d\x6a\x14\xa4\xb2\x23\x82\x98\x0b\xd0\xf8\x56\x86\x02\xb4\xd8\x2c\xcb\x99\x4c\xd9\x05\x6d\x4d\xbe\xb8\x61\xef\xa9\x7f\x10\x28\x78\x0c\x1e\x20\x28\xbe\x87\xb6\x02\x59\x99\xef\xc7\xdb\xd6\x21\x0e\xa8\x4f\x91\x1a\xc4\x68\x71\xa9\x60\x78\x76\x1b\xac\x6f\x19\x04\x6a\xd5\xb7\x01\x8c\x77\x64\xc6\x3b\xfa\x13\x8e\x3d\x91\x29\xfb\xcb\xd8\xa0\x63\x30\xb4\x57\x27\xfe\x1b\xcc\x28\xd7\x02\x11\xc8\x75\xd5\x10\x13\xec\x56\x59\xe3\xbc\x8e\x8e\xf7\x1f\x23\xfc\xca\xa8\x26\xf0\xcb\x16\xb0\xe6\x8b\xca\xd6\x76\xbc\xff\xb8\xa4\x7c\x6a\x4c\xce\xca\x7b\x28\xb8\x7d\xf2\x90\x0f\x9f\x1c\xef\xb7\x55\xee\x80\xfb\x94\xfd\xa3\x0a\x8e\xa3\x18\xb8\xa8\xfc\x17\x43\x1b\x20\xa5\xa5\xa5\x84\xc2\x91\x56\xc4\x72\x94\x61\x03\x1f\x28\xb2\xcc\xa3\xcf\x65\xe5\xa1\x9a\x5f\xca\x98\xbc\x98\x8f\x4a\xc3\x46\x4e\x6f\xc7\x73\xae\x17\xc5\xb4\x50\x2c\xb7\x15\x44\xc1\xba\x51\xae\xfe\x1e\xa6\x43\xdc\x9b\xd1\x94\x4e\x8f\x8e\xbf\x38\xfa\x82\x7e\x39\x7d\xbc\x9f\x24\x8f\x67\xfb\xf4\x68\x7a\x74\x40\xd3\xc3\xa7\x5f\x1c\xcc\xbe\x3c\x7e\x7a\xf8\x05\xfd\xf2\xf1\x1e\x88\x9a\xca\x7d\x66\x9d\x3a\xf6\xcc\x34\xf6\x5a\x16\xa3\x65\x56\xae\x18\x7c\xc9\xd7\xaa\x12\xee\xed\x37\x10\x75\xc7\x88\x8e\xd3\xb1\xa5\x99\xb3\x67\x29\xc6\x0f\x56\x33\x3b\x54\x2f\xfb\xde\xe6\xea\xd6\x1a\xd9\x1d\x52\xea\x77\xb8\x57\xba\x0a\xb3\x79\x4d\xcb\x23\xd5\xb4\x52\x97\xdb\x0e\xef\xc7\xcd\xc2\xb6\x91\x85\x96\xa5\xae\xc9\x05\xaa\xcc\x7f\xba\x75\x55\x2b\xeb\x94\xc4\x52\x1c\x6a\xff\x52\xdf\xad\x1f\xd6\x9d\x10\xb1\x2e\xe1\x22\x6a\x82\xda\x74\xdd\x52\xcc\xca\x7e\xdf\xb8\x1e\x86\xe4\x6f\xa3\xf2\x1f\xe6\xaf\xfa\x0f\xdf\x49\x15\xb4\xf2\xf8\xed\x2e\x64\xae\xeb\x5f\x5e\xe4\x52\x4b\x94\x10\x36\x7e\x1e\xd9\xb2\x9c\x01\xc0\xf5\xe5\xf3\x92\xdf\x5b\x3e\x8f\xb6\xaa\x7a\x48\x7d\x99\xb2\xe1\x74\xb5\x62\x20\x80\x07\x80\xdb\x8a\x44\x9e\x63\x93\xf5\x72\xd8\xc4\x16\x05\x50\xca\xa5\x33\x4f\x03\xf5\x0f\x71\x23\xc0\xed\x14\xc8\x11\x77\x5c\x81\x34\xe4\x6f\xc0\xcf\x40\xb6\x5c\x65\x34\x01\xe1\xb8\x31\x88\xe0\x65\x7b\x70\x68\xe5\x42\xba\x71\x91\x1c\xa0\x63\xb0\xea\xba\x1c\x44\x00\x72\xb9\x1e\x46\x16\xf5\x97\xe7\xb3\x8d\x51\x9f\xcf\x5e\x49\xf1\x71\x06\x6d\x95\xbc\xb5\xf3\x08\x0c\x5e\x69\xd8\xb4\xec\x4c\x97\xa3\x7a\x65\xc4\xd8\x96\x41\x45\xba\x0f\x40\x17\x06\x52\x0b\x3e\xbd\x15\xf5\x11\xea\xe3\x27\x00\xb1\x71\xef\xe5\x08\x13\xe8\x17\x6e\x77\xde\xe3\x12\x13\x3f\x0d\xbc\xb4\x7b\x2b\xf0\x16\xd7\x30\x74\x65\x69\x10\xb0\x3d\xe5\x27\x16\x13\xaf\xe8\x92\x9d\x51\xc5\x4e\xd3\x7f\x16\x4a\x1b\x45\xab\xd7\xbd\x5c\xf0\x63\x6f\x2d\x31\x2d\x52\xf5\x2a\xb6\xe3\xac\x44\x63\x6f\xe6\xa0\xa4\x61\x45\x45\x50\x80\x74\x79\xbb\x2b\x88\xed\x86\x03\x82\x97\x53\x36\x9b\x37\x55\xa5\x6a\xee\x01\xab\x62\xa3\x5d\x9a\xc0\x84\xae\xb8\xa6\x19\xff\x05\x8c\x5f\x41\x6f\x87\xba\x90\xa8\xaa\x10\xf3\xc9\xa0\x41\x88\x27\x83\x5a\x04\x63\xd0\x86\x8d\xd9\x9e\xef\x46\x25\xd3\x30\x7f\x4d\x06\xb5\x21\x57\xe2\x1a\x4e\x1e\x31\x14\xb9\xed\xd4\x0b\x3f\xa3\x79\x63\x6e\xf6\x46\xa4\xbe\x38\x70\x88\x8d\x50\x1a\x3a\xc5\xd5\xca\x24\x19\xa3\x39\xde\x10\xb0\x74\xce\x46\x58\x17\x83\x6a\xc3\x8d\x0c\xbf\xc8\xd9\x88\x89\x24\x5f\xaf\xb4\xad\x59\x1e\xa2\xba\xa6\x31\xc8\xc1\xb7\x55\x29\x60\x48\x76\x73\x00\x63\x34\xa8\x76\x26\xa2\xf2\x5c\xeb\xe6\xc0\x43\xb0\xf3\x6a\x37\x41\x1f\x46\xb0\xb0\x25\xd4\x61\xc7\x95\xf9\xc5\x9d\xf7\x30\xca\x9d\x75\x8f\xb5\xc5\xc1\x08\xbb\x1a\x99\xcd\xfc\xe7\x48\x56\xd4\x2a\x0d\xf7\x98\xd8\x91\xa3\x3c\x1d\x19\xba\x3f\xc8\xd0\x42\x4a\xb8\x12\xb6\x15\x1c\x4b\x3d\xa2\x92\x89\x36\x24\x20\x21\x1d\xd2\x02\x20\x4b\x3f\xf3\xbc\xda\x4e\x01\x7a\xd6\xff\xda\x71\x83\x5c\x9b\x11\x7f\x17\x20\x12\xe1\x73\x8b\x3b\xd7\x89\xbf\x8d\x83\xdb\x48\xeb\xdd\x38\x6f\x83\x70\xf6\x5b\xab\x10\x05\x8e\x7a\x4d\x42\xb1\x49\xec\x28\x7a\x6b\x07\x21\x6e\xd0\x93\xda\x7d\x05\xdc\x4b\x1c\x3e\x39\x78\x42\x94\x35\x87\x1d\x8d\x0f\x43\x24\x65\x49\xef\x5e\x32\x31\xd7\x8b\x13\x72\xb0\x7f\x78\x14\x6a\xc5\x85\x6b\x15\xaa\x6f\x51\x19\xfc\xff\xf8\xfe\xe7\x37\xa3\xff\xdf\x27\x7f\xfc\xf4\xff\xff\xe8\xb3\xcf\xc7\x90\xa6\xf8\x1f\x3f\xbf\xfb\x7f\xe8\xe8\x97\xf7\xff\xed\xed\xe7\xf7\xb6\x76\xf7\xc8\x0e\x1c\x37\x88\x63\x81\xea\xf3\x40\xc0\x72\x9d\xc1\xb8\xb6\x9e\x31\xba\x9e\x95\x67\xe6\x5f\x4b\x9a\xf5\x88\x6e\xf7\x4a\xb0\x68\xb3\xe9\x22\x79\xe5\x02\x5c\xd4\xb9\x80\xec\x64\x18\xe7\x13\x13\x71\xbd\xca\x96\xa5\xa1\x11\xcf\xa4\xed\x73\xa3\xbe\x52\x84\x62\x53\xbb\x03\x61\x9c\x5c\xb9\x62\xde\xd6\x11\xcb\xef\xcf\x6e\x58\xdb\x47\xd2\x6a\xdb\x20\x5e\x51\xce\xe9\xda\xbf\x15\x56\xd6\xa8\x9a\x15\x29\xee\xd5\xca\x06\x6d\x63\x40\x5c\x55\x87\xd0\x36\xc9\x51\x4c\xad\x4a\x9f\x37\xb1\x11\x26\x4e\x21\x19\x0e\x0a\xe2\x2c\x3c\xb4\xe1\x52\xc0\x00\xb5\xac\x40\xb7\x53\xa9\xee\xc0\x31\x9b\xc4\x30\x78\x9c\xeb\x89\xa5\x9d\xa7\x40\x2d\x78\xc2\xfe\xe8\x7c\x44\xdc\x00\x83\x20\x6b\x34\xe2\xd1\x23\x85\xcb\x5b\x2b\x82\xba\xa0\xe0\x4f\x65\x2b\x32\x14\x22\x33\xeb\xd0\xaa\x75\x57\x8f\x5f\x21\xc4\x32\x1a\xd8\x1f\xe8\x09\x34\xc2\x3a\xe1\xbd\xdd\x4c\x01\xa2\x21\xa7\x86\x2a\x97\x2e\x94\x5c\xb9\xd9\x96\x16\x12\xc8\x55\xa3\xd6\x42\xd3\x3b\x9b\xa1\xa6\x6b\xe2\x99\x9c\x5b\x28\x63\xb2\x71\xdf\x10\x52\xd6\xe3\x13\x1f\x7c\xfa\xeb\xe7\x7f\xfb\x4d\x4e\x26\xe4\xd3\x84\x9f\x7c\x9a\xac\xde\x7d\x3a\xe3\x27\x9f\xce\x56\xef\x3e\xbd\x52\xef\x3e\xcd\xf5\xc9\xa7\x2b\x9e\x0e\xbe\x82\xc4\xa1\x68\x01\xcb\x91\x67\x76\x44\xa0\x39\x3f\xaa\x54\x26\x45\x89\x89\x13\x17\x61\x94\x4c\xa5\xd0\xcc\x5a\xbb\x40\x28\x40\x81\x61\x94\x26\x52\xdc\xec\x1d\x8e\xf7\xf7\x6a\x94\x09\x53\xb6\x3d\x1d\x1f\x8e\x1f\x87\xf3\x6e\xf7\xa3\xfa\xbd\xe8\x7e\xed\xaa\xf7\xd3\x9d\x4f\xdf\xef\x4c\x7e\x7d\x33\xfa\xfc\xed\xd7\x6f\xfe\xef\xbf\xbd\x78\xbb\x33\xac\xfe\xde\xfd\x6c\xf2\xdb\xee\xd7\x3b\x6f\x4e\x47\xff\xa0\xa3\x5f\xde\x7e\xfe\x7e\xf2\xe6\x8d\x65\x0f\xef\xe0\x87\x9d\xc9\xce\x9b\x9f\x77\xdf\x7e\x3e\xd9\xdd\xfd\x7a\x67\x18\x7e\xb7\xfb\xd9\xe4\xed\xee\xee\xfb\x37\x3f\x7f\xfa\xe6\x24\x11\x3a\xcf\x4e\xde\xbe\x0d\x5f\xa3\xf6\xca\x42\x12\x36\x97\x91\x56\x93\x59\xfd\x74\xfa\x06\xb3\xa6\xc8\x10\x59\xf8\x4a\x98\x40\x72\x3b\xe2\xe9\x60\xb7\x07\xab\x88\xed\x53\x8f\x89\x34\xe8\x22\xba\x21\x99\x33\xe0\xc8\x41\x25\x6f\x44\x20\x62\xdc\xd8\x7d\x24\x0e\x73\xf0\x22\x80\xdb\x8e\xa4\x90\x2d\x54\x38\xec\xd6\xf2\x91\xb6\xf1\xfd\x04\x98\xdf\x1b\xc0\x15\x79\x69\x2f\x99\x3a\xec\x9a\xee\x2a\xaa\x34\x69\xd6\xa3\xaa\x6f\x3d\x4f\xd5\x29\x5e\x5c\xb5\x32\x10\xb8\xc3\x18\x87\xcd\xd9\x65\xf5\x3f\x08\x8c\x98\xa3\x24\x6f\xaf\x89\xc9\xb4\x68\x93\xfb\x7d\x26\x0f\xbe\x3b\x5c\x41\xf3\x6d\x8d\xda\x34\x92\xd0\xaf\x91\xfc\xbd\x2d\x7d\x1f\x10\x65\x8c\x15\x2e\x2f\xea\xa2\x27\xaa\x44\x53\x54\xe5\xa9\x5f\xa3\x43\x84\x8c\x9b\xdf\x7d\x25\x86\x94\x29\x6d\x74\xda\x56\xbf\xeb\xd6\x19\x7b\x5f\x98\x11\xe0\x45\x94\x8f\xf7\xb9\x0c\x9f\xa3\x7e\x89\x15\xcb\x00\x85\x2d\x92\x88\x55\x41\x0d\xc1\x48\xff\x33\xd7\x24\x0a\x95\x78\xdb\xbb\x9c\x69\x38\xd4\xbf\x04\x1a\xcf\xf3\xd5\x33\x73\x9a\x5a\xab\x4c\xb6\x1c\xbf\xe0\xb4\xf1\x83\xf6\x39\x53\xf7\xd6\xa5\x36\x18\x93\x9e\x69\x99\x9b\x93\xbc\x04\x38\xf1\x19\x4a\xc1\x7e\x98\x75\xa5\x32\xdb\x26\x6b\xbd\x8d\x28\xef\x93\xa5\xbe\x2c\xb0\xb8\xba\x09\xd3\xe6\xff\xac\x11\xc4\x6a\xd4\x6d\x93\x5a\xb4\x77\xef\xb5\x0d\xe1\xca\x89\xd9\xab\x36\xaf\xc0\x98\x15\x1b\x70\x4f\xf4\x98\x52\x99\x80\x0a\xe4\x04\xeb\xa6\x04\x67\xdc\xb9\xd8\x46\x8b\xe7\xe1\xb3\x45\x86\x74\xab\x91\x45\x0a\xe8\xb4\x4e\x78\xe0\xbe\x6b\x88\x4a\x76\xef\xbb\xb7\x3d\x26\x2c\x67\xf5\xc9\xc5\x48\x71\xbf\x42\x07\xe5\xd0\xc0\xa8\x9e\xc9\x84\x66\x07\x41\xa3\xba\xff\xf4\x4d\x04\x79\xcd\xf2\xee\x34\x9c\x23\x88\x79\xea\xd1\x6c\x19\xcf\x8b\xe0\x9a\xa5\x94\x2d\x7b\x24\x8c\x1f\x11\x5a\xf4\xc8\xb2\x3a\xea\xbb\x23\x47\x24\x5b\xf5\x99\x85\x60\xb7\xdd\x69\x54\x47\xa4\x28\x92\xf6\x8b\xe4\x7a\xb3\x24\xef\x3d\xd5\x70\x69\xd7\xaa\xdd\xac\xf5\x42\xbf\xd9\x4a\xf4\x6a\x45\x8b\x94\x77\xe7\x36\x1d\x11\x9a\xb1\x1e\x99\xda\x71\xaa\x7d\xe6\x00\xfb\x38\x2c\xde\x36\x1a\x1e\xf4\x6d\xd8\xbb\xeb\x36\xdf\x91\xd6\x86\x71\x5e\xe0\x35\x0c\x57\xdb\x6d\x34\x8c\xd3\x76\xaf\xe1\x17\x1f\x92\x32\x56\xda\x47\x0f\xd2\xe8\xea\xc3\xc7\xd4\x94\xaa\x75\x2d\x06\xcd\x75\x53\xfa\x69\xd8\x1a\x2d\x19\xfe\x8a\x0c\xa4\x07\xd9\xb3\xa4\xd7\x12\xd2\x0e\x32\x5a\xcd\xae\x07\x64\xdf\x87\xd3\xcc\xb0\x17\x31\xed\x57\x7e\xd9\x3d\x65\x19\xe6\xa3\xfd\x2f\xbb\x97\xbb\xac\xc6\x7c\xf4\xb4\xfb\x44\x74\xd7\x65\xae\x9e\x55\x30\xdb\xa4\xff\xd4\x53\x5d\x79\x35\xfe\xff\xfa\xfc\x02\xff\x2d\x8a\xe5\x94\xe5\xff\x7b\x98\xff\x3d\x11\x1f\xaf\x7f\x5d\x36\x77\x98\xef\x26\x30\xfd\xf1\xde\x37\x79\x7f\x77\xb6\x23\x90\x35\xbb\x2a\x64\x7c\x9c\x7c\xca\xad\xd5\x4b\x7c\xb5\xcd\x28\x07\x90\x79\xa9\x03\x73\x13\x72\xae\x4b\xd3\x4d\xc4\x9b\xa5\xd4\x81\xc2\xce\x06\xee\xb9\x6a\xa9\x08\x58\x5d\x1e\x58\xc9\x8d\xa7\x2c\xa1\x79\xa5\xd2\x75\xc0\x14\x74\xc9\x52\x90\xaf\xe6\x6a\x32\x70\xa9\x2e\x5a\x8c\x5a\x2b\x99\x5a\xe7\x72\xd7\x5f\x07\xe4\x00\x1c\x2d\xc9\x6d\xce\x35\x2b\x2f\x13\xbc\x51\x77\xe3\xf4\x6a\xc1\x2a\x03\x85\x06\x17\xa9\x1b\xca\x33\x57\x4a\xaa\x52\x64\x6d\x72\xac\xee\x02\x55\x98\x4b\xad\x54\xc9\x4b\xbb\x5e\xdd\xc5\xd5\xc3\x32\x0d\xfb\xc9\xfa\x8f\x53\x84\x95\xcc\x0a\x8c\x6d\x40\x62\x40\x53\xab\xea\x83\x13\x39\x10\xf6\xde\xb3\xaf\x72\x2a\xe1\x3d\x78\x7d\xba\x36\xd9\x3c\xbc\xc7\x7f\x47\xab\x5e\x13\x34\x9d\xa4\xb9\x5c\xad\xaa\x60\x8c\x9c\x6a\x66\x85\x79\x45\xd8\x5d\xc2\x58\x5a\x46\x12\xf4\xda\x50\x79\x21\x34\x5f\xb2\x47\xca\x15\xab\xb1\xb7\x6f\x4d\x7c\x3c\x52\x24\xa1\x2b\x9a\x70\xbd\xee\x9c\xb8\x39\x27\xa8\x46\x9b\xbf\x5f\xba\xf5\x07\x9b\x82\x96\xad\xba\x7a\xf7\xc2\xb7\xf8\x30\x5b\x57\x0d\xf0\xdc\xad\xd1\xf0\x84\x0a\x47\xc7\x3b\xa0\xd6\xd9\xb7\x33\xe8\xf6\xda\x64\x1d\x90\x17\xb4\x76\x53\x58\x5e\x6b\xda\x1e\xb1\x54\x52\x12\xcb\x99\x45\x7a\xe6\x4c\xef\x67\xeb\x19\xd9\x25\xe9\x41\xa4\xff\x0b\xa4\x16\x87\xcc\x07\x74\xa5\x8b\x9c\x9d\x49\x79\x1d\x35\x21\x6c\xa6\x4c\xa8\x7d\xe8\xe9\xca\x36\xec\x09\x7f\xee\xa8\x96\x50\x19\x75\x13\x04\xd7\xbc\x59\x0d\x5b\x73\x23\x50\x85\x2c\xfb\x37\x47\xc2\x81\x0e\x1f\xa9\xed\xca\xad\x6f\xb8\x6c\x58\x54\x98\x89\x23\x3a\x2a\xeb\x6d\x74\x23\x38\x37\x0d\x1c\x6c\x2d\x21\xc3\xb4\xcf\xb0\xfb\x1b\x7f\x96\x54\x27\x8b\xab\x1e\x75\xcb\x6a\x13\x2d\xbf\x6a\x58\x42\x9c\x08\x00\xef\xc3\xb9\xb4\xaa\x67\x0a\x15\x19\x8d\xf4\x06\x4e\x45\x96\x76\xc2\xa4\x31\x87\x56\x4b\x39\x85\x4e\xa0\x83\x17\x77\x34\xd1\x03\x57\x03\x8d\x99\x7f\xb9\xd8\x67\x1c\x19\x24\xa5\xbf\xc8\xd9\x8c\xdf\x41\xb3\x6e\xfb\x9c\xfb\x7e\x05\x1f\x21\x18\xdc\x82\xae\x37\xff\x1e\x79\x18\xbd\x7e\xc2\x07\xae\x9c\x9c\xc8\x53\x79\x96\x59\xcf\x1a\x78\x0b\x1b\xdb\xf6\x62\x47\xdb\x09\x96\x97\x15\x62\xcd\x28\xca\xf1\xc6\xfa\x41\xd0\xdd\x32\xb6\x1d\x4d\xc8\x5f\xaf\xda\x14\xbd\x07\x0b\x61\xe5\xe5\x08\xc8\x60\x26\xe5\xc0\x65\xd3\x84\x3d\x0e\x61\x37\xe5\x76\x48\xb1\x45\x27\x58\x99\x43\xc3\x29\xcd\x07\x90\xf7\x42\x48\x0c\x68\xb7\x30\xcc\xef\xe5\xdd\x61\x0e\x09\x9b\x74\xb2\xe8\x53\x58\xd1\x8e\x85\xab\x5e\x67\x90\xf4\x34\xaf\x8d\x08\x6c\xa1\xce\x56\xbd\xd6\xa9\xb7\xb2\xdf\x5b\xd5\x6f\x9c\x7c\xa7\xb9\xfb\xa1\x51\x1b\xea\x7b\xb7\xa2\x64\xe4\x58\x3c\x52\x40\xdd\x5c\x1e\x3e\xbc\x9b\x72\x5e\xcc\xd6\xab\xa6\x5f\xb5\x57\x6f\xa3\x0c\xdd\x3f\x80\x6a\x0c\xad\x5b\x24\x1b\x55\xa1\xe1\x29\xcb\xf8\x92\x6b\xc8\x7d\x1a\xbd\xd2\xad\x86\x8b\x32\x93\xce\xd7\x9e\xa4\xc7\x95\x9d\x33\x1a\x18\xf0\x0a\xfa\x36\x9c\x36\xa6\x7a\xa6\x8c\xe8\xbc\x10\x98\x7a\xca\x1e\x46\x4f\x16\xda\xc8\x09\x1a\x4f\x98\xe6\x63\xa1\x54\x1e\x6c\x8c\xa1\x84\x52\xaa\x8a\xad\x28\x88\xab\x90\x78\xce\xd1\x5a\x2d\xbb\xca\xb4\x10\x48\x09\xe1\xac\x32\xb5\xb4\xba\x86\x21\x97\x2e\x54\xdd\x86\x25\xab\x43\x77\x5b\x8b\x7a\xeb\xdb\xfd\xb5\xed\xb8\x2f\x04\x3e\x31\x8f\x08\xda\xe0\x4a\xe7\x5a\xf5\x2c\x3c\xdb\xf0\x7f\xac\xf1\xf5\x76\xaf\x83\x4e\x90\x4f\x0e\x9f\x1c\x7b\x5e\x09\x07\x3d\x70\xdf\xcb\xa5\xc0\xb6\xee\xe3\x58\x80\x4f\xe5\x5e\x10\xf6\x2d\x88\xf8\xb0\xe0\xd3\x9b\x60\x55\x8c\x62\xeb\x95\xb4\xfc\x25\xb0\x9e\x96\x49\xe2\xb2\x76\x62\xbf\xd5\xad\x35\xb6\xac\xdd\x2c\x1b\x9d\x4d\xfe\x3f\xb9\xac\x7d\xcc\x60\xa3\x4a\xb4\xe8\x68\xd5\x6d\xde\xed\x65\x07\x5b\xd2\xbb\x73\x10\xfc\x23\x14\xa8\x87\xeb\x2e\xe9\x91\xcf\xa2\xa6\x6b\x05\x63\xfd\xdc\xb3\x19\xf3\x57\xff\xb2\xf4\x93\xa9\xd1\xe9\xff\x5d\xaa\x96\xef\x16\x5f\xca\x4b\x2e\x65\x9b\xe3\x6c\x5c\xd9\xec\x39\xe8\x0a\x80\xbe\xfb\xb1\x53\xe8\x87\x4b\x6c\x64\xd2\x00\x4e\xaf\xa5\x39\x46\x46\x7a\xec\x91\x68\xc3\x3d\xe8\x4e\xe4\x0a\x61\xb5\x99\xe2\x5c\x4c\x86\x22\x36\x16\x03\x72\x4e\x74\x20\x97\xab\x8d\x90\x0e\x02\xa1\x0c\x2e\x9e\xc3\x1b\xdd\xee\x98\x10\xbb\x90\x11\x88\x09\xc4\x45\xd4\x96\xcb\x8c\xfd\xea\xe5\x25\x59\x51\xa5\xf4\x22\x97\xc5\x7c\xe1\xc3\x8d\x18\x39\xfa\xa9\x89\x96\xc7\x6f\x63\x18\x76\x51\x26\x7e\x88\xa6\x41\xae\xef\xc0\xe7\x36\x48\x17\x25\x91\x6e\xa6\x3d\xa3\x34\x7a\xc2\xad\x6d\xcb\xb8\x52\xd1\x69\x06\xd8\x40\x40\xcc\x14\x80\x6b\xdc\xd3\x14\x40\x3c\x73\x80\xef\x78\xbf\x9d\x39\x80\x6c\xe5\x0f\xb2\xd5\x3d\xe0\x3d\x14\x84\x1e\x30\xab\xf2\x8a\x76\xd2\xd6\xd9\xdb\x2c\x3f\xad\xfd\xe6\xa4\xf1\x5e\x40\x23\x12\x7b\x9b\x44\xde\x0b\x66\x0f\xa9\xbd\x9f\xdf\x78\xf5\xf4\x95\xdc\xb7\xc1\xe7\xef\x91\xde\xc9\x76\xb7\x60\xdb\xdd\x3f\xf6\x93\xce\x49\xa7\x84\xee\xb9\xf2\xf6\x14\xe5\x48\x5c\x9c\x6b\xf7\x0d\xee\x05\x75\xcb\x88\x25\xff\xe9\x23\x7d\x45\x1c\x76\xab\x67\x8b\x6b\xff\x7e\x97\x91\x7d\x65\x2c\x12\x4e\x76\xd0\x36\xc2\xce\x4b\xc9\xde\xc2\x16\xe9\x25\x70\x11\x98\x30\x06\xfe\x6d\xc7\xd2\x6c\xf8\x61\x80\xa7\xd5\x83\x13\x3f\x20\x53\xdb\x0a\xf0\x03\x57\x0b\x3f\x0f\x5c\xed\x81\xab\x35\xc7\xf4\xc0\xd5\x1e\xb8\xda\xff\x19\x5c\xad\xe7\xbd\xee\x4b\x39\xff\x66\x9b\xe0\xcd\x41\xed\xa3\xf6\x18\x4e\xeb\xad\x13\xbf\xdf\x76\x4e\x7a\xf6\x5a\xae\x1e\x80\xbb\x75\x84\x2b\xa9\xd3\x28\x05\x19\xd9\x81\x38\xd5\xa2\x2f\x1f\xa9\x32\x1e\xb2\x19\x44\x19\x01\x8c\x49\x29\x6c\x10\x63\x18\xc4\xb0\x8c\x8b\xb4\x4d\x63\x17\xc5\x1f\x3a\x12\x32\x36\xfc\x0d\x13\x8b\x5b\x27\xcf\xc4\xb2\x69\x48\x89\x19\x1d\x7a\x9b\x58\xea\x86\x94\x98\x65\x64\x2b\x13\x4b\x9b\x21\x25\x02\x3c\x66\x62\x39\xd7\x5e\x49\x01\x8c\x14\x76\x97\x2f\x88\xa6\x0e\x2b\xd1\xfd\x2c\x2d\x3d\xe8\x56\x26\xe7\xb5\xdc\xb1\xb1\x03\x6a\x7d\x6f\x5f\x46\x4e\x5c\x33\x90\xae\x06\xdb\x3b\xc7\x0b\x79\x5b\x5b\xc0\xa8\x17\x3a\xae\xac\x67\x6b\x81\x42\x44\x98\x57\x76\x23\x12\x6f\x4c\xe2\x09\x43\x4b\xdc\x40\x1a\x8f\x46\xba\xc9\x9e\xb9\x43\xe3\x9b\xe0\x27\x36\xed\x93\x43\xd4\x4b\x21\x3a\xd8\x8d\x8d\xb4\x3d\x51\x66\x7b\xf2\xcd\x42\x40\x7a\x0c\x43\xe1\xbd\x44\xa3\x9d\x68\xe8\x97\x82\xb4\x4c\x38\x1a\xc9\xe5\x4d\x7a\x26\xf5\xc4\xb4\x06\xb3\x22\x83\xed\xed\xd2\x8d\x76\x54\x18\x65\x76\x04\xdb\x24\x22\x25\xd3\x18\x85\xbc\x6f\x42\xd2\xf8\x72\x95\xa9\x4a\xdb\x12\x93\x6e\xb8\x97\xb8\x4c\xc8\x31\x12\x50\xe6\x48\x1e\xbc\x94\x73\xcc\x8e\x6c\xf3\xba\x0e\x42\x69\x74\xa1\x65\x98\xe1\x74\x95\x67\x8c\x9d\xf2\x60\x66\x54\xf7\x74\x12\x9e\xb8\x98\x34\xf2\xfd\x79\x5b\x5b\xdc\x37\x02\xb8\xcc\x61\x7a\xc9\x32\x96\x68\xd9\x12\x83\x59\x17\x44\x36\x3e\x80\xac\x1e\x0a\x13\x81\xcc\x78\xa6\x6d\x15\x62\xc5\x40\x22\x29\x9b\xb7\xe6\xd7\xc6\x72\x44\x70\xa4\x02\xe5\xb9\x30\xb1\x04\x76\xe1\x0e\x47\x29\x5c\xb4\x23\x53\x2d\x68\x9e\x96\xe1\x5c\x85\x80\xc2\x83\x7e\x4e\x06\xac\xf7\x83\x63\xe5\x62\xbe\x75\x02\x45\xb8\x08\x7b\x71\xe7\xca\x91\xf4\x89\x1b\x6e\x7e\x52\x2f\xfb\x93\xd1\x29\xcb\x88\x72\x08\xb5\x7b\x01\x72\x29\x85\xf6\xeb\xd5\x82\xd5\xda\xc1\x59\x38\x7d\xf5\x3c\xa4\xab\xf7\x4f\x79\x74\x1a\x19\x8e\xad\x60\xe1\xde\x44\xaf\x5d\xca\x32\x10\x78\xaa\x87\x84\x92\x6b\xb6\x46\x37\x10\x2a\x2a\x6f\x70\xeb\x64\x9c\xd9\x84\x62\x90\x30\x37\x08\xd4\x7c\x8c\xf0\x42\x88\xe9\x63\x8a\xb8\x66\xd1\xa8\xc3\x1a\x3a\xae\xd9\xda\xf9\xd6\x23\x5e\xcc\x0f\xa5\x12\x5e\xa2\xa2\xfb\xca\x8c\x10\x1d\x89\x94\xee\xa9\x53\x39\xac\xf5\x1e\x7e\x89\xe6\x9c\xad\x30\x16\x58\xe1\x42\x3c\x52\x88\x74\xb3\x1b\x17\x7c\xd5\xe5\x27\x48\xdd\x81\xb6\xd8\xb7\x45\x5c\x1d\x78\xdc\x7f\xe7\x62\x68\x64\x5e\xf3\x9f\x17\x77\x5c\x75\xa8\xc9\x66\x2d\x9f\x4b\xa6\x5e\x49\x0d\xad\x7f\x37\x72\x70\x68\xbd\x51\x63\xb9\x0d\xe4\x51\x46\xad\xce\xcc\xcf\xfa\x43\xb9\x69\x9e\x77\x07\x41\x95\x28\xe6\xca\x70\x61\x99\x3b\x1c\x94\x46\x20\x65\xc1\x3b\x53\x80\x90\x62\x04\x6c\x34\xae\xb4\xdb\xf4\x32\x3e\x7c\x44\xab\xe9\xc3\xc7\x9c\xdf\x55\x1c\xe5\xb5\x61\xe0\x10\x90\xc2\xe2\x1b\x90\x23\x21\x65\x63\x4a\x52\x28\x62\x89\x4e\x97\x54\xb3\x39\x4f\xa2\xa0\x97\x2c\x9f\x33\xb2\x02\x97\xcc\x98\x30\xd0\x6d\xee\xec\x69\x5c\xe8\xd6\xc6\xbb\x4c\x0f\xa3\x08\xa9\x19\x95\x68\x0f\x34\xe8\x54\xf4\xe3\xe3\x03\x86\xf0\xd2\x10\x94\x00\x36\x9c\x30\x47\xb3\x8b\x4e\x8a\xd6\x89\xb1\x4d\x5e\x84\x5d\x23\x3d\x5f\xd2\x95\xd9\xf9\xbf\x1a\xf2\x0c\x9b\xe8\x37\xb2\xa2\xdc\x48\x95\xa7\xf1\x52\x5a\xfe\x17\xd6\x7b\xce\x07\x0e\xd9\xd4\x15\x31\xab\x70\x43\x33\x17\xf9\x20\x08\xcb\x62\x65\x6d\x9d\xe7\xb2\xc7\x2d\x87\xe4\x16\x0a\xd9\x1b\xd2\x5b\x1a\x26\x06\xd7\x6c\x6d\x0b\x3a\x74\x2c\x95\x69\x7c\x2e\x6c\x65\x9b\x8d\x43\x59\xf2\x29\xd0\xcb\x07\xf0\x6e\x30\xde\x60\xb0\x01\xd8\x1d\x6c\xf7\xbe\x62\x59\xa3\xc6\xca\x92\xae\x6c\x89\x15\xaa\xe5\x72\xe3\x24\x0a\x99\xb2\x0b\x73\x6a\xcd\x48\x3b\x05\x38\xbf\xb1\xcd\xaf\xa2\xca\x42\xab\x4e\xf6\x22\xf2\xc6\x09\x72\xc9\x82\xa5\x45\xd6\xbe\xb5\xac\xcd\xa9\x4d\x70\xab\x89\x5f\x56\xf4\xf2\xb2\x56\x43\x3d\xb9\x57\xfe\x58\x5a\xc0\x1b\x69\x6f\x29\xa1\x28\x93\xa6\x3c\x6b\x53\xed\x3b\xb2\x95\xcb\x34\x22\xd9\xb6\x23\xc7\x17\x6c\xcb\x6a\xa1\x0d\x46\x0f\xd2\x6e\xdf\xf2\xb9\x5e\xa1\xd4\x52\x2c\x2d\x85\xd2\xca\x19\x5c\x55\xfd\x06\x40\x82\x98\x8d\xa9\x48\x81\x48\xd7\xd2\xbc\x47\x04\xde\x94\xad\x02\xe5\x5d\x08\x96\xfb\xab\xae\x2b\xec\x82\xda\x2f\xab\x7d\x62\x8f\x9d\x0d\x0e\xc0\x82\xd7\xa4\xad\x18\xf6\xcd\xc1\x9e\xc5\x4b\x90\x17\x29\x4d\x75\x81\x98\xf8\x69\xc1\xc4\x66\x67\x5c\x91\x9f\x64\x7e\xed\xb2\x8b\x7a\x82\x7b\x88\x02\x4e\x04\xa9\xd7\x32\x97\xea\x84\x64\x5c\x14\x77\xb0\x7c\xa3\x5c\x66\x6c\x5c\x6f\x71\x0b\x3d\x9c\x90\x47\xa1\x6c\x66\xb1\xe1\xd9\xcb\xb7\x8b\x8c\x0a\xb6\x31\xc6\x70\x58\xe4\xb6\x63\x5c\x52\x83\x67\x33\xc6\x30\x4c\x4c\x79\x5b\x3b\x60\xaa\x00\x9a\x02\xc6\x45\xa8\x01\x79\xef\x7c\x41\xfd\x54\x1d\xf2\x7b\xd4\x9d\xe8\x05\xb1\xa7\x08\x6d\xad\xf2\x90\xad\x43\x87\xfa\xab\x3e\x71\x01\x06\xe2\xef\xee\xa1\xfe\x74\x40\x45\x06\xd8\xad\x02\x91\x2d\x6e\x64\x3b\x54\xa1\x0d\x14\xf5\x53\x87\x3a\xaf\x90\x2a\xf3\x77\xd7\xbd\x55\xef\xab\xa6\x3e\xaa\xd1\xc6\x74\xfa\xaa\x47\x9d\x13\xc2\x98\xce\x6d\x54\xa4\x4e\x90\x56\xd6\xdf\x46\x4d\x22\xdb\x20\xac\x5b\x5d\x22\xf7\x54\x99\xba\xb1\xd5\x50\x6b\xba\xd5\xa6\x4e\x90\x35\xb5\x6a\x0b\xd5\xa9\xd7\x58\x5b\xb5\xb8\xa8\xfa\xd4\x09\x76\x53\xbd\xea\xab\x42\x91\xbe\x5e\x23\x5b\xdd\xd3\xf6\x75\xd9\xe9\xe3\x29\x1e\xb3\xe0\xf4\x50\xad\x48\xdf\xfb\xdb\xee\x31\x77\xaa\x59\x64\x4b\x55\x8b\xf4\xc5\xea\xbd\x55\xae\x48\xc7\xb6\xc2\xe6\xb6\x6a\x57\x6c\x2a\xbe\x42\xb6\xa5\xea\xd5\x71\x33\xe1\x9f\xbd\xbe\xea\x57\x04\x64\x50\x31\xeb\x23\x06\xf4\x54\xd6\x23\x0d\xb6\x51\xc7\x10\xb1\x99\xcd\x66\xd9\x2b\xad\xa4\xd7\xbc\x2e\x2b\xf9\x2f\x2a\xd5\x23\xa4\xe8\xb6\xd4\x6d\x6f\xa8\x1e\x57\x75\x3b\xb8\x59\x7c\xb8\xcf\xc9\x22\x9c\x65\x22\x6a\xc5\xbf\xeb\x22\x6a\x2a\x13\xb5\x97\x48\x91\xb0\x95\x56\xf5\x7b\xf2\x3d\xb3\xb0\x7a\x44\x45\x3a\xaa\xa6\xb1\xf7\x7b\x53\xf2\x5f\x41\xed\x92\x14\xaf\xd4\xaf\x4a\xb8\x30\x1b\xad\xa9\xd1\x56\x21\x47\x07\xbe\x89\x08\x55\x54\xac\x09\x8c\x10\xe5\x17\xd8\xf9\x2e\x84\x3a\xe7\xab\x8c\x91\x3f\x95\x07\x6d\x88\xd9\xac\xff\x82\x59\x2c\xc2\xfb\xcc\x1d\x45\xa8\xf0\xec\x0e\xc0\x9f\xdc\x5f\x7f\xf9\x3d\x46\x6b\x1c\x41\x6f\x59\xf6\x05\x5e\xaa\xfb\xb5\x3a\x98\x9d\xae\xcd\xcc\xad\xa5\x8d\xa0\x8e\x72\x06\xb8\xb0\xb6\x79\x3a\x6c\xdc\x76\x96\xd5\x00\xa9\x31\xea\x49\x7e\xe0\x75\x47\xc5\x6c\x52\x8a\x1f\x70\x7a\x5f\xc9\x4b\x34\x32\xb0\x21\xc4\xd7\xb2\xbc\xfa\x05\xa8\xc6\x2b\xf9\xe2\x8e\x25\x85\x66\xbf\xdb\x46\xbc\x8d\xe9\xff\x3f\x2a\x59\x17\xe7\x5b\x93\x75\xab\x2d\xdd\xd7\xf8\x1f\xc5\xe5\x35\x5b\x97\xe6\x66\x27\x65\x77\xfa\xde\x78\xc6\x67\x27\x95\xa0\x98\xf3\x95\xab\x6a\xb8\x9c\xba\x2c\x40\x36\xd5\x8a\x5d\xf4\xb8\x99\x38\xcb\xca\xe5\x31\xea\x4a\x96\xe1\xf0\xfe\xd3\x6f\x2f\x7e\xf8\x40\xb7\x17\x25\xd7\x69\x17\xca\x3d\x49\xfb\xc5\xbf\x0a\x9a\x8d\xc9\x73\xab\x4e\x77\x2c\xa9\x6b\x6e\x01\x6c\x18\x3b\x6f\x79\x96\x26\x34\xc7\xe0\x20\x24\x24\x51\x88\x4a\xe2\xfe\xa2\x40\xe1\x12\x2a\x4a\x32\x56\xed\x14\xc8\xec\x49\xc9\x8a\xe6\x9a\x27\x45\x46\xe3\xca\x96\x39\xfb\x73\x99\x47\x6f\x1a\x7a\xad\x5d\xb5\xdd\x2f\x59\x22\x45\xda\x5f\xab\xbe\x6a\x7e\xe9\xaf\x26\x54\xa2\x62\x39\x97\x69\x57\xc0\xba\xe6\x4b\xd6\x3c\x78\x3b\xe8\x9f\x50\xe6\xac\x9a\x39\xda\x56\x12\x8c\x8e\xd3\xa3\x17\x2c\xbf\xe5\x8a\x35\x9c\xde\x6c\x69\xda\xdd\x8a\x8b\x54\x54\x20\x4e\x2e\xfd\x2a\x5b\xdc\x5e\x35\x6b\xb4\xf6\xd9\xb1\xda\x63\x68\x97\xb5\x04\x1b\x85\x3a\x93\x39\xd4\x03\xdb\x49\x25\xc0\x63\x37\x3c\xd1\xbb\x63\xf2\x0f\x96\x4b\xcc\xdf\xc0\xe6\xe8\x51\x63\x8f\x6d\x67\xf4\x3b\x78\x24\x33\xf0\x47\xa6\x8a\xec\x93\x1d\x00\x49\xf8\x72\xc9\x52\x4e\x35\xcb\xd6\xbb\xee\x56\x5e\xad\x95\x66\xcb\xd8\xb4\xbd\xc4\x6f\x4f\x62\x21\xb0\xfd\x5c\x77\x61\x0a\xbd\x77\xd7\x8f\xce\xbd\xa3\x22\xd3\xae\xe2\x48\x6d\xab\x58\xf6\xde\x49\xa3\x83\x9a\xa3\xef\xd4\x5d\xf9\x58\xf5\x21\xd1\xe5\x26\xfb\xa7\xd9\xa7\x94\xe4\x6c\x6e\xce\xad\x3d\x71\xbf\xf3\x64\xfe\xae\x7b\xa8\xc8\xc7\x46\x91\xe5\x09\xed\xac\x79\xe9\xda\xb9\x25\x70\xd5\x88\xaa\x44\x84\xbd\x1c\xd5\x1d\x98\x71\xdc\x84\xdd\xcb\x58\xdd\x76\x91\x70\x1f\xf3\xb5\x67\xa8\x6e\x01\x79\xe5\x0f\xc3\xc7\x82\x62\x9a\x60\xc1\x92\xe6\x60\x29\x49\x8c\x32\x25\x70\x38\x2d\x30\xed\x00\xcf\xc5\x2c\xa7\x4a\xe7\x45\x02\xe9\x57\xce\x5e\x03\x56\xda\xec\xd0\x36\x3b\x66\xcd\x1c\xdd\xa6\x8d\x98\x59\x7b\x5d\x97\x51\x09\x89\xf7\xe1\x95\x5c\xc9\x4c\xce\xd1\x7a\xc2\x7d\xf0\xd6\x18\xbf\x0d\x60\x5e\x9b\x42\x05\xfa\xb5\x43\x14\x34\xd4\x0b\xd6\x86\xda\x29\x73\x3d\x1f\x10\x99\x93\x43\x8b\xce\xdb\x05\x33\x47\x69\xa3\xd3\xb2\xec\x04\x57\xe4\x12\x54\x64\xdb\x4b\xdb\x85\x55\x4e\xbe\xe3\xf3\x45\xb6\x3e\x75\x49\xf8\x86\x10\xdc\xc3\x12\x43\x3e\xb3\xb5\x57\x45\xae\xcd\xa4\xde\xaa\xe9\x85\x8d\xec\xf1\x8c\x98\x31\x72\x08\x55\xdb\x4e\xd3\x25\x07\x35\xbc\xf3\x10\xd6\x5a\x57\xe5\x9c\xfd\xea\xb3\x0b\x2a\xd2\xcc\xe8\x25\x82\xdd\x22\xf8\x96\xc9\x24\x19\xe5\x4b\xd5\x28\x49\x86\xc5\xda\xe4\xad\x41\x5e\xca\xc4\xda\xb5\xa2\x49\x2e\x6d\x69\x1f\x74\xf1\xda\x6d\x2d\xcb\x0f\xb7\x52\x36\x94\xaa\xc4\xa9\xdb\x27\x56\xa7\xc5\xeb\x40\xab\x41\x24\x8d\xd9\x87\xce\x33\x7a\x0a\xda\xab\x41\x42\xa7\xb2\x00\xc9\x9c\xe7\x65\x37\xdb\xdf\x17\xba\xb9\xfc\x70\x2b\x58\x6e\x44\xcb\x5e\xb7\x86\x1b\x5f\x35\x0a\x44\x2c\xa4\xd2\x18\xec\x81\xa8\x0b\x5d\x26\x37\x11\xea\x31\x1a\x58\x3e\x1b\xba\xff\x63\x2d\xb0\x04\x33\x75\x46\x6e\xc6\x46\xe4\x52\xe7\x3c\xd1\x27\xe4\x39\x8a\x0f\xb8\x9a\xb6\x6a\x20\x17\x24\xe5\x33\x28\xc6\xac\xfd\xae\x83\x22\x35\x4c\xa1\x2a\x9f\x64\xe6\x36\xc6\x6e\xce\x85\xc6\xba\x78\x00\xc2\x7a\x75\x9e\xa0\x7b\xa7\xed\x2e\xc4\xc5\xa4\x05\x5b\x0d\x65\x45\xf5\xa2\xca\x62\xef\x7a\xb2\x21\x33\x4d\x44\x45\x4c\x17\xd5\xe6\x6b\xf8\x00\x22\x52\xee\x51\xf2\xb4\x75\x9a\x81\xb6\xd8\x49\x84\x31\x07\x99\xbb\xd3\x1d\xfa\xd7\x67\xae\x7f\xd1\xd8\x82\x76\xb5\xa1\x40\xa8\x6b\x18\xb2\x46\x98\xef\x79\xdb\xee\xf3\xea\x84\x6d\x5e\xf8\xff\x54\xeb\x3e\x7e\x2b\x8e\x91\x22\x86\x71\xe2\xb0\xde\x1c\xbc\x25\xec\x6e\x25\x55\xcc\x19\x34\x00\xb2\xc6\x6b\x01\xde\x23\x55\x69\x5e\x48\x01\x61\x83\x9a\x4e\x9c\x11\xcb\x06\x7b\xd4\x2b\xbb\xd3\x15\xdf\x9b\x66\x72\x6a\xef\x5d\xb1\x64\xbb\x11\x09\xcc\x52\xa9\xf1\x3c\x74\x22\x6c\xa8\xc7\x09\xf9\xeb\x2a\xa5\xe0\xe6\x5c\xc7\x05\x7a\xd0\xbb\xdf\x94\x73\x77\x36\x9c\xd5\xfd\xf6\x9c\xab\xb8\xd1\xc4\x26\x64\x2b\x14\xa6\xe0\xd4\x46\x72\xf7\xd7\x94\x36\xa7\x6c\xb0\x7b\x59\x4c\x53\xb9\xa4\x41\xf7\x74\x2d\x89\xd2\x72\x45\x6e\x65\x7e\x6d\x44\x51\xcb\xf9\x2c\x5c\x47\x60\x0a\x33\x2b\x1c\x2f\xed\xb5\x77\xa0\xef\x57\x86\x2e\x69\x70\x9c\xcf\x59\x39\xe4\x88\xa7\x6f\xf0\x86\xbb\x81\x4c\x55\xac\x56\x32\xd7\x6a\x13\xa1\x46\x13\xea\x8f\x51\x77\x5f\xd5\xce\xa0\x20\xe7\x78\x0b\xb0\x7b\x15\x49\x6e\x8e\xb4\xab\x59\xc7\xd8\xa3\x94\x23\x26\xd3\x9b\x75\xed\xeb\xe8\x5d\x6b\x1c\x77\xf2\x7e\x8d\xfb\xc5\xb9\x73\xb7\x49\x74\xf7\x76\xf0\x76\xee\xdc\x41\xc9\xe2\xc1\xc1\x1b\x9e\x07\x07\x6f\xef\x79\x70\xf0\x7e\x70\xf0\x7e\x70\xf0\x7e\x70\xf0\x7e\x70\xf0\x7e\x70\xf0\xfe\x3f\xc1\xc1\x5b\x67\xea\x92\x25\x45\xce\xf5\xfa\x22\x97\x33\x9e\xb5\xd8\xc7\x1b\x0e\x05\x1b\x5f\x78\xc1\xc6\x8a\x69\x23\x5a\xa9\x32\x92\x3a\x9e\x9d\x0f\x44\x32\x94\xdc\x2a\xc1\xad\x23\xc0\xce\x57\xca\x5a\x40\xd2\x15\x37\xd2\xa2\x01\xd3\x66\x85\x75\x16\xda\x9c\x29\x59\xe4\x09\x6b\x24\x2c\xbc\x6d\x37\x1b\x62\x85\x09\x33\x90\x1f\xb2\x74\x88\x6a\xba\xbd\xce\xc0\xad\xf1\xbd\x4c\x59\x2e\x8c\x04\x01\x08\x01\xad\x0e\x47\x8e\xd7\x46\xed\xb5\x12\x5c\xf3\x7a\x9d\x76\xae\x36\xdd\x6c\xc9\x94\xe9\x5b\xc6\x84\x61\xbc\x8c\x2a\xc3\x5e\xbe\x69\xdd\xb4\xa5\x69\x6d\xce\x6f\x98\x30\x24\xd8\x5a\xbe\x10\xb6\x96\x65\x22\x05\x7f\x12\xe5\x48\xd0\xa9\xa3\x55\xd0\x96\x65\xe7\xe4\x6f\xe3\xbf\x8f\xff\x61\x26\x4e\x8a\xd5\x3c\xa7\x29\x68\x63\xb5\x97\x9f\x1f\x40\xf8\xae\xd5\x2a\x89\x60\xb7\xbd\xa7\x8f\x8a\x9d\xe7\x0c\xdf\x2e\xde\x83\x71\xb5\xc8\x02\x91\x9a\x98\x27\x46\x66\x99\x2c\xda\xec\x30\x71\x49\x0f\x6b\x49\xf4\xb1\x8b\xd8\xaa\x13\x40\x0a\x0b\xc5\xf2\x91\x4b\xf5\x6b\xb6\xbe\xb2\xa7\xc4\xcd\x73\x4c\x9e\x85\xa8\x21\xbb\xd3\x86\x56\x64\x06\x63\x39\x28\x2b\xb8\xe3\xca\xba\x16\x0e\x55\x54\x11\x2e\x30\xdb\x4b\x0d\x6b\xc1\x50\x01\x2a\x30\x3e\x5b\x53\xa5\x73\xb9\x5a\xf0\x64\x4c\x4e\x85\xdb\x26\x4d\xf0\x99\x94\xd7\x8a\x64\xfc\x1a\xaf\x2d\x23\x96\xbf\x84\xaf\x16\x2c\x57\x27\x64\x44\x5e\x9c\x3d\xff\xee\xc5\xe8\xc5\xd9\xf3\xcb\xd3\xd1\xd9\x77\xa7\x67\xdf\x9d\x1e\xee\x8f\x2e\x7e\x78\xf9\xf7\x83\xc7\xfb\xc7\xe5\xfb\xd7\x6d\x6f\x83\x1c\xb4\xfa\xe6\xf4\xc5\xe5\xc1\xe1\xd3\xd1\xb7\x67\xdf\x8f\x2e\xbf\x3b\x3d\x3c\x7e\xd2\xe8\x71\xe3\x7d\x00\xe6\x92\x8b\xab\x97\x97\x3f\xb2\x5c\xe1\xd5\xf1\xcb\xcb\x9b\x83\xf1\x41\xbb\xc2\xdd\x23\x73\x4b\xb7\xae\xe0\x30\x14\x94\x43\x1a\x5b\x09\x9b\xfb\xea\xb0\xab\xc0\x02\xb7\x12\xf0\x9a\xd0\x6c\x2e\x73\xae\x17\x41\x43\x2f\x71\x5e\xe2\x86\xbb\x08\x36\x97\x9a\x83\x85\xc5\x8a\x64\x06\x94\xd9\x9b\x0b\x2a\x52\xb5\xa0\xd7\x6c\x4c\x4a\x57\x88\x18\x48\x73\x98\x73\xb6\x94\x37\x0c\x72\xa4\xdb\xfc\x30\x3c\x47\xfe\x29\x52\x45\xec\xfd\xb1\x35\xa1\x34\xea\x08\xc4\x06\x8b\x14\xe9\xf9\x8b\xcb\xd1\xd9\xb3\xb3\xc7\x66\x11\x09\xd9\x59\xd3\x65\xb6\x7b\xd2\xd8\x68\x7e\x9b\x70\x26\x8e\x4e\x31\xb1\x9f\x53\x42\xb7\x03\x68\x6d\x43\xf5\x5c\xe5\xda\x47\xa1\xb5\x86\xa4\x55\xd1\xbc\xed\x37\x16\x80\xb5\x61\x43\xca\x92\x5c\x6a\x99\xc8\x8c\xd8\x74\x2a\xed\x8b\x1f\x81\xd9\xd8\x16\xdf\x34\xee\x69\xcc\x22\x99\x26\xb6\x6b\x45\x0e\xc6\x07\x43\x72\x30\x8e\x95\x63\x33\x9c\xf1\x60\xfc\xd8\x5f\xce\xf6\x63\x08\x4c\xf8\x87\xab\x17\x27\x24\x29\xf2\x9c\x09\x1d\x75\xd8\x84\x04\x6b\x7c\xbe\x60\x4a\xd7\xe1\x39\xef\x30\x33\x7d\xfb\xd3\xd5\xcb\xcb\x83\xc3\xf0\x66\xe9\x4a\xcd\xe0\x41\x09\xa7\xd5\xae\x35\x0b\x27\x1a\xab\x35\x0b\xa3\xad\xd6\x2c\x9c\x06\xe8\xf7\x55\x6e\x27\x70\x39\xe8\xd8\x7f\x1f\x56\xe7\xb7\x47\x86\xd7\xc6\xe3\x4a\xe9\x2c\xc2\x3a\x9c\xa1\xfc\x96\x5f\xf3\xf1\x52\xfe\xc2\xb3\x8c\x8e\x65\x3e\xdf\x73\x62\xe5\xde\x25\x08\x70\xef\x2e\x79\xca\xde\x5d\xbd\xbc\xfc\xc4\x17\x55\xde\x25\x72\xb9\xa2\x9a\x4f\xa1\x1e\xee\xbb\xf1\xe1\xd3\x9c\x25\x72\xb9\x64\x22\x65\xe9\xf8\xf0\xcb\x70\xbf\x66\x37\x36\x18\x5c\x80\xd0\x5c\xbd\xbc\x7c\x77\xfa\xe2\xf2\xdd\xc1\xe1\xd3\x77\xdf\x9e\x7d\xff\x2e\xca\x58\xaa\xe6\x87\xc7\x4f\x5c\xf3\xc7\x4f\x8f\xec\x0b\xc7\xf3\xde\x39\x9e\xf7\xee\x77\xb1\xb1\x2d\x59\xe3\xe1\xf1\x13\xf7\xfe\xf1\xd3\x90\x03\x4d\x03\x66\xfd\x9b\x2e\x06\xdf\x03\x66\x9b\x50\x10\x99\x43\x00\x62\x78\x84\xad\x34\x25\x70\xe8\x7b\xb0\xf6\x8e\x83\xb3\x04\x51\xbf\xcf\x91\xc1\x96\x7d\x0e\xcb\xfd\x8e\x05\x2a\x1d\xf5\x03\xf1\x6f\xb3\xfd\x7b\xb1\x4f\x5c\xca\xc7\x15\x7b\x38\x73\xec\xc1\x28\x87\x28\x6a\x04\xf3\xa0\xfe\xfe\xa5\x96\x59\xc0\x00\x54\x5f\x67\x89\x56\x85\x8f\xb3\xc8\x3f\x64\xe9\xbb\x29\x4d\xae\x6f\x69\x9e\xfe\x9b\x2e\xf5\x03\xa5\xfb\x50\x94\xae\x82\x16\x56\xb4\x6a\x58\xde\x16\xc3\xb1\x15\x33\x92\x79\xfb\x27\x1d\xf0\x6b\x6b\xd4\xc0\xf5\xeb\xe0\x9b\xe6\x57\xfd\x97\x14\x07\x1a\x9a\xd9\x66\xe3\x18\x86\xda\x76\x5e\xdb\xba\x34\x7b\xd9\x0a\x3a\x0e\xb8\x3e\x7a\x5f\x1f\x6a\x65\x70\xfb\x1f\x8b\xea\x85\x8b\x04\xb7\x17\x07\xb6\xf5\x7c\x5b\x2c\x63\xd6\x2a\x26\x73\x72\x06\x76\x86\x90\x75\xff\xac\xb4\x42\xdc\x70\x5b\x0e\x8c\x50\x24\x72\xbe\x6e\xc4\x45\xca\x6f\x78\x5a\xd0\xac\x46\x69\xc3\xb7\x79\x40\x7f\x57\x34\xa7\x4b\xa6\xc1\xae\xb8\x31\x46\xdf\x78\x67\x34\xe6\xfe\x70\xd5\xef\x25\xec\xaf\x2b\xb1\xf5\x5d\x2f\x5b\x8e\x8d\xb1\x2a\xfb\x37\xe3\x35\x52\xb9\xb0\x4e\xa1\x43\x74\xf2\x67\x6b\x34\xbd\xa1\xdd\x10\x93\x6d\xf0\x60\x56\x5b\xaa\xc0\x6b\xcf\x59\x1f\x28\x64\xc4\xb8\x61\x99\x5c\x59\xaf\x07\x76\xc7\x15\xdc\x96\xfb\x4d\x66\xb2\x10\x21\xe7\x07\xb4\xdf\x71\x81\x09\x44\xc7\x84\x3c\x07\x07\x5b\x88\x5b\x12\x64\x95\xb3\x84\x2b\x96\xad\x5d\x02\x40\x0f\x6a\x59\xbd\x38\x0c\x99\x9a\xe9\x27\x4c\x59\xe3\x2a\xdc\x82\xdb\x1c\x84\x39\x4b\x8b\xa4\xa5\x02\x0d\xb3\xeb\xdb\xb1\x4d\xb8\xaa\x74\x4f\xdf\x92\xc1\x52\x32\x65\x68\xc7\xac\xbc\xee\xd7\x4c\x93\x5b\x16\x74\x84\xa7\xa9\x5c\x59\x97\x14\xb3\xc6\x52\x10\x25\x67\xfa\xd6\xcc\x31\xe3\xd3\x9c\xe6\x9c\x85\x72\x89\xc6\x7c\x3d\x7e\x68\xf5\xec\x2d\xfd\xc6\xec\x96\x0e\x34\x89\x20\x61\x64\x0f\x60\x84\x58\x6c\xef\x11\xa2\x0b\xc1\xc5\xfc\x87\x55\x20\x50\x91\x8a\xf5\x0f\x2d\x9e\x85\xa3\x4e\xc3\xda\x92\xde\x9d\x55\x77\x0a\x21\xc1\x31\xa6\xd7\x8f\xc8\x28\xa4\xa4\x8f\x5a\x8a\x67\x7d\xa8\x21\xf5\xa9\x61\x5f\x96\xd1\x3b\xdc\x87\x27\x6c\x7a\xaa\x9a\x75\x5d\xd7\xf8\xeb\x50\xfa\xef\x56\x44\x11\xf3\x35\xa7\xff\x2c\xf0\x94\xdb\xa8\x19\x18\xac\x48\xda\x36\x53\xab\xdf\x3d\x59\xc9\x54\x8d\xc9\x69\x96\x39\x07\x5a\xb3\xd9\xb1\xf2\x13\xcd\x80\x90\x80\x9b\x59\xa1\xda\x40\xa2\x29\xb1\x72\x96\xae\x7c\xa5\xf8\xcc\x45\xb9\x34\xfc\x78\x6b\xd3\x6a\xbb\x59\xaa\xdc\x78\x6b\x69\x7e\x30\xe4\x14\x2e\xa9\x5c\x9b\x5b\xae\x17\x5c\x74\x42\xe4\x8a\xcc\x99\x60\x39\xcd\x2c\x81\xf0\xed\x0e\x9b\x79\x41\x9d\x73\x37\xd7\x50\x8f\xbd\xbd\x0a\xed\x52\x2a\xdd\x30\xe4\x6f\x7f\x5b\x91\x71\x26\xf4\x37\x5c\x5c\xf1\x25\x93\x45\x20\x8e\xb3\x61\x6c\x6e\x7c\x53\xee\x8b\x85\xbc\x25\x99\xc4\x9b\x87\x72\x4b\xc7\x62\x7e\xa6\x8c\x2c\x58\x06\xde\x1c\x90\xd3\x35\x63\xe4\x96\x72\x44\xaf\xab\x79\x0e\x9d\x55\x85\x2e\x5c\x99\x7b\xe0\x87\x7b\x46\xb7\x61\x41\x66\x92\x64\xb2\xbc\x7a\xab\x06\x14\xb9\x20\xd4\x76\x42\xc1\x4c\x47\x07\xaa\x9d\xf0\xba\xe3\x99\xda\x85\xd8\x9e\x18\xba\xa5\xd8\x7a\x1d\x3e\xf6\x22\xd0\xe6\x12\xd4\xf1\x17\xe2\xb6\xad\x58\x25\x8f\xf7\x3f\x22\x02\xb1\x78\xc1\xb3\x62\x36\x63\xf9\xb3\xb5\x0e\x11\xdc\x46\x02\xfb\xe6\x47\x0d\xff\xe4\x65\x01\xc1\x71\x4b\x99\xaf\xad\xdf\x71\x18\x8d\x39\x83\x6d\x99\x92\x1d\x2e\xc8\xd4\x00\xdb\x05\x14\x6e\x54\x46\xf1\x57\x46\x59\x5f\x83\x90\x78\xdb\x48\xd6\xde\x28\xde\xa0\x49\xc6\xa8\xd2\xe4\xe0\x89\xd1\x24\x38\x16\xbc\xd8\x3b\x04\xf7\x07\x48\xa1\x16\x1a\xae\x3b\x5f\x9b\x63\xdb\x71\xd2\xa8\x96\x32\x53\x63\xce\xf4\x0c\x64\xd1\x85\x5e\x66\x7b\xf9\x2c\xf9\xe2\xf8\x68\xbf\x35\xa2\x82\x90\x60\x2d\xc0\x40\x57\x8e\xaa\x13\xea\x36\x4b\x00\x6c\x19\xaa\xf4\xf8\xf0\x8b\x27\x4f\x11\xb7\x35\x6a\x5c\xef\xb4\x46\x6a\x03\x20\x3d\x02\x6c\xe4\xd8\xcd\x7d\x60\x09\x71\x79\x1f\xa6\xa5\x24\x6a\x49\xb3\xcc\xc8\x8c\xa1\x4d\x90\x33\x7a\x1d\x98\xac\x61\x63\xd1\x5e\x42\xf2\xa0\xed\x3b\xa3\xf9\xdc\xd0\xb1\x22\x4b\xed\xd5\x78\x7b\x3f\xf6\xba\x45\xf1\xb9\x80\xab\xfb\xc8\x7d\x08\x30\x37\xbb\xb9\xf5\x82\x0a\x22\x98\x11\x8f\x69\xbe\x0e\x08\x98\xdd\x62\x48\x55\x33\xe5\x49\xc8\x92\xd2\x15\x6e\xe9\x23\xe9\x7b\x7a\xf7\x9a\xdd\xe6\x5c\xb3\x7b\x9d\xe8\xc6\xe7\xe1\xb3\x1d\x40\x50\x15\x69\xd0\x7a\xb6\x73\xb9\x6c\x59\x52\x73\xb4\x22\x25\x13\x6c\x8d\x95\x1c\xc6\x05\xf7\xf4\x22\x25\x74\xe5\xd4\x9c\x2e\x82\x11\x1a\xa9\x23\x23\x1e\xc1\xe0\x22\x91\x4b\x03\xd3\x2f\xa7\x51\xc5\x38\x61\x91\xeb\x48\xde\x8e\x9d\xcd\xb9\x8d\x62\xab\xb3\x8b\x88\x19\x42\x30\x71\xf8\x7e\x74\x13\xaa\x23\x67\x73\x08\xfd\xcd\x71\x2f\x46\x3a\xfa\xdf\x45\x7d\x9e\x1e\x7c\x79\xf8\xb1\x89\x4f\x73\xcb\xb6\x90\xa1\xd0\x16\x70\xc4\x69\x2b\x32\xd4\xe8\x2f\x86\x82\x06\x31\xec\x49\x90\xc2\xfa\xf8\x06\x99\xfa\xa8\x04\xe9\x68\xff\xcb\x76\x5b\x5a\x0f\x7a\x94\xe9\xc5\xd9\x82\x25\xd7\xa0\x2a\xdf\xd0\xac\x27\x1d\x6a\x7e\xb6\x29\xa4\x95\xb1\x41\x21\x34\x19\x51\x4c\x95\x0e\x5c\xfa\x56\x1a\x52\xa0\x58\x52\x80\xaa\x63\xab\x5d\x24\xa6\x13\x08\x42\xe6\x50\x1c\x03\x55\x82\x20\xef\xb7\x52\xb3\x82\x8a\x04\xdc\x8b\x52\x75\x7e\x53\xf3\x4c\x4e\x61\x07\x53\x55\x1d\x0e\x94\x06\x83\x62\x24\x06\xe7\x0c\xc9\xb4\x28\x8d\x2a\xf2\x86\xe5\x39\x4f\x53\x26\x8c\x56\x38\x82\x16\x2e\xe4\x02\xff\x01\xe5\x6a\xc3\x02\x1f\x21\x13\x8c\xf7\xc8\xeb\x7e\x78\xb6\x10\xce\x18\xa7\x3f\x86\xe9\x8f\xb9\x45\xf2\x64\x00\x87\xf3\xc5\x9d\xd1\x07\x83\xee\x93\xc2\xc8\xaf\x7c\x2e\xd0\xc1\x01\x9d\xc7\xac\x0b\xb6\x9c\x91\x94\x25\x7c\x49\x33\x1b\x2d\xae\x86\x84\xd1\x64\x81\x01\x4d\x4e\x2d\x0d\xc9\x55\x39\x45\xb9\x0e\xa8\x3a\x29\x04\xd7\x44\x15\xb3\x19\xbf\x1b\x12\x36\x27\x93\xc1\xe3\xfd\xfd\xa5\x9a\x0c\x86\x64\x32\x38\x18\x1f\x2f\x26\x03\x22\x73\x32\x19\x1c\x2e\x8e\x8e\x97\x93\x60\x1d\x08\xf4\x7a\x87\x5c\x13\x06\x24\xea\x87\x93\x81\xb0\x90\x0a\x35\x19\x90\x1d\x00\xf4\x3f\xff\xbb\xf9\xfb\xaf\x9f\xef\xef\x3f\x3b\x46\xd0\xff\xeb\x7f\xa8\x49\xc8\x89\xe1\xaf\x9f\xef\x3f\x7e\x76\xb6\x6b\x60\xb8\x51\xd9\xff\x2c\xf1\x3f\x0b\x8b\xce\x1a\xad\xd3\x92\x64\xe1\x0c\xb3\x70\x70\x8f\x15\xb8\x8f\x21\x5d\x60\x77\x50\x2f\x5a\xe7\x74\x66\xd4\xef\xb4\x40\x3d\x4e\x4a\x32\x03\xae\x24\x34\xb9\x3a\xbb\xb0\xbb\x39\xa4\xcc\xe1\x1e\x07\xb4\x26\x70\x95\x25\xd6\x66\x40\x97\x7f\x7f\x45\x56\x66\x43\x6b\xa2\xb4\xcc\x97\x58\xb8\x43\xb3\x5c\x50\x0c\x9d\x1e\x3a\xbf\xd2\xe0\x70\x61\x46\x12\xbc\x42\x60\xd0\xe8\x1b\x48\xb8\x30\x1c\x34\x67\x60\xa9\xcd\xa8\x66\x22\x59\x0f\xdd\xe0\xe3\x9a\xa7\x75\x22\xf5\x9c\xa9\x24\x9c\x77\x43\x7f\xab\x00\x6f\x73\x54\x16\xf4\x86\x89\x47\x68\x14\x9c\x32\xe6\x6a\x92\x04\xcf\x2e\x55\x44\x15\xc9\x02\xd6\xe4\xd4\x65\xc9\x92\x39\xf9\x85\xe5\xb2\x8d\x52\xd9\xc4\x1e\x42\x12\xb9\xe2\x22\x7c\xd0\x0c\x5e\x5b\xc4\x8e\x85\x94\x8a\x79\x44\xc0\xa5\x0b\xa9\xb9\x99\x06\x57\xac\x6e\x44\x1e\x7b\x17\xb0\xbe\x7e\xd8\x32\xea\x28\x23\xe6\x8a\x1c\x23\x03\xae\xc3\xb3\x94\xbe\xf4\xe3\x29\x5b\x1f\xc0\xb6\x89\x68\xaa\xae\x56\xe3\xc6\x97\x87\x07\x47\x5f\x1c\x3d\x7d\xfc\xe4\xe8\x8b\xa5\x22\x3b\x87\x47\xe3\xa7\xc7\x24\xa5\x6b\x28\x15\xf5\x4c\x06\xf7\x6a\x6b\xb2\x5b\x0f\x11\x81\xb4\xb7\x65\x19\xbc\x9d\xfd\xf7\x3b\x6f\xf6\x47\x5f\xbe\xfd\x7c\x67\x32\xc6\x3f\x76\xbf\xde\x11\xea\x7d\xa1\xde\xff\xcf\xff\xae\xde\xff\xaf\xff\xa1\xde\x2f\xd5\x7b\xf5\x7e\xf9\x7e\xb1\xbb\xfb\xf9\x6e\x7b\x6d\xbc\x0e\x75\xb9\x8f\xe1\xb1\xe1\x92\x50\xfb\xa2\x64\x66\x3e\x06\xab\xf4\x1a\x8a\x2f\x8b\x4c\x53\xc1\x64\x11\x49\x45\x5d\x02\xc3\xe4\xb4\xe8\x70\xca\x94\xa6\xd3\x8c\xab\x05\x4b\x0d\xe3\x70\x05\xd4\x9c\xf5\x7e\x4c\xce\xf1\x58\x76\x9c\x69\x5c\x45\x58\x53\x85\x04\xbc\xdd\xf4\x68\x96\x08\x03\x89\x41\xfe\xe8\x31\x58\x73\x70\xed\xf5\x40\x22\x31\x94\xae\x0a\x9b\xb0\x89\x69\x4a\x27\x71\xc3\xbd\xc3\x23\x35\xdc\xbc\x58\xda\x9b\x87\x0b\x96\xdb\x78\xd4\xca\x0a\x78\xe2\x84\xd8\xfd\x21\x19\x1d\x54\xb1\x04\xb9\xd9\x53\x01\xa0\x87\xfb\xfb\xfb\x23\x6b\x05\x8e\x94\xcf\x33\x74\x63\x3f\x20\x1d\x87\xc4\x11\x27\x33\xeb\x8d\x52\x46\x72\x46\x8e\x4d\x87\x48\xd7\x1a\x7e\xf7\xe1\x3c\x15\xc4\xcf\x55\x61\x68\xee\xac\x80\xec\x22\x95\xaf\xba\x1b\x3f\xab\xce\xe5\xe8\x00\x72\x74\x74\x94\xd6\x83\xc1\xa6\x6b\x41\x97\x58\x66\x8c\x18\xb6\x01\x62\x47\xb9\x5f\x11\x62\x2d\x54\xbb\xeb\x22\xa9\x00\xbd\x49\xb9\xc0\x93\xbc\x10\x02\x6e\xb8\x30\x9c\x83\xe5\x63\x82\xd1\xa9\xe6\xc7\xd1\x01\xd9\xe1\x63\x36\x1e\x12\x5a\x68\xb9\x1b\x1b\x67\xc5\x74\xa8\x95\xac\xed\xd8\x18\x42\x87\x91\xa7\x64\xe7\xbf\x1d\xc3\xc2\x9a\xd1\xfe\xb0\x62\xe2\xd2\xc8\x44\x01\xb8\x7f\xf9\xf3\xd1\xf8\x60\xdf\xe5\x95\x51\xbb\x6e\xef\xe4\x6c\x66\xe4\x6c\x38\x12\x8d\xb3\x85\x63\xe1\x22\x29\x42\x3b\xc0\x13\xd8\x9d\xa4\x5e\x40\xe9\x46\xe0\xc9\x79\xe5\x75\x6f\x2f\xc4\xca\x5d\x10\xcc\xa3\x75\x5c\x6e\x53\x27\x64\xd0\x32\x57\x12\x7a\xa3\xd6\xf4\x42\x1f\x36\x06\xe0\x84\x4f\x97\x3d\x8a\xb0\x62\x38\xb5\x55\xce\x6e\x20\x22\xa8\x2a\xcc\x58\xce\x1d\xf4\x79\xa5\x69\xae\x23\xf9\x8f\x70\x37\xae\x65\x61\x99\xa3\xe1\x8d\x5c\x25\x39\xd3\x6e\xc5\x76\xd8\x78\x3e\x1e\x92\x2f\x60\x5e\xbb\xd5\x81\x8d\x89\xf9\x86\x0c\x71\x45\x96\x7c\x9e\x57\x31\xed\x82\xdd\x42\x0a\xf6\x21\x2e\xda\x23\x60\xe3\xf3\x82\xe6\x54\x68\x56\x1a\x05\x83\x86\x23\xf7\x39\x59\x50\x45\x78\xca\x84\x36\xc7\xa0\xdc\xbf\x95\x9a\x60\x08\x2a\x48\x15\x84\x12\x95\x30\x41\x73\x1e\xe2\xe9\xda\x66\xb9\xbc\x05\xad\x6f\x46\x79\x86\xc1\xfa\x34\xd7\x63\x87\x17\x23\xd2\x40\xc7\x36\x05\x40\x99\x3b\xa3\xe3\x40\x55\x03\x72\x28\xac\xb2\x6e\xe8\xc2\x48\xea\xee\xf6\x1a\x91\xba\x96\x45\x50\xf2\x68\x5f\x19\xa0\x19\x66\x0a\xf3\x82\xa7\x54\x24\x98\xa0\x0b\x15\xd1\xd1\x01\x7a\x3e\xb1\x20\x91\x5a\x54\x47\xb2\x3a\x8f\x3e\x79\xa1\xda\x50\x03\x14\x75\x26\x82\xfc\xdd\xec\x11\x2a\xc8\x52\x0a\x1e\x0e\x12\xab\x9d\x21\xa3\x62\xe1\x3e\xa9\xe8\x89\xc5\xa2\x86\x8a\xaa\x86\x99\x99\x13\xb2\x64\x3a\xe7\x49\xe8\xb6\xf0\x51\xf9\xf1\x3b\x04\xff\xce\x66\x52\x78\xa7\x98\x7e\x07\x06\x8c\x5f\xcb\x26\x7f\x76\x3a\xd6\x64\x30\x2c\x33\x97\xfc\x79\x32\x28\x35\xae\x91\x65\x9b\x93\xc1\x6f\x8f\x22\xa7\xa2\x31\xdd\xfa\xc4\xe0\xde\xaf\x74\xc0\x68\x1c\xbc\xa0\xd5\x81\x8b\xad\xb0\xf1\xf1\xe6\xbd\x57\x01\x2e\xc7\x7c\x5f\x0c\xde\xd7\x90\xd1\x65\xa6\xc8\x59\x26\x69\xba\x8d\x85\xa2\xfe\x45\x5d\x9e\xb3\xb2\xb4\x53\xaa\x09\x84\xb7\xf1\x60\x82\xce\x8a\xc0\x81\x1d\xa1\xca\x24\x82\x7d\x00\x3d\x4b\x12\xb6\xd2\xe8\x30\x02\xcc\x7e\x4b\x41\xce\xec\x2d\x9f\x7a\xd3\x24\x29\x96\x05\x46\x92\x43\xa9\xef\xe6\xa6\x0a\xdd\x4d\xa5\xbe\x4b\x09\x96\x92\x41\x9a\xe7\x8f\x07\x7b\x2e\xa7\x6f\x3a\xa7\x99\x0a\x91\x45\xa3\x0d\x33\x81\xc5\x48\xf9\xd2\x96\x23\x35\xe0\xa9\x53\x14\x1f\xa9\x52\x29\xbc\x35\x54\xa8\x0c\x0a\x0c\x9b\x53\x44\x9a\xcb\x29\x17\xb6\xba\x29\xf0\x45\x17\xc0\x33\x6e\xaa\xb8\x29\xab\x0d\x3c\xaa\x3f\x2d\xe9\xda\xb5\x67\x4e\xaf\x25\x8a\x9b\x11\x63\x4a\x15\xa0\x89\x55\x81\xe3\xda\x15\x73\x88\xe2\x1a\xc6\x4c\xaf\x5d\xc4\x22\x1a\xf2\xfe\x55\xf0\xe4\xba\xca\x5d\xb6\x51\x78\x1c\xcc\x19\xd1\x1b\x3f\x3b\xe4\xaf\xa0\x96\xf2\x9f\xdc\xc5\xd4\xea\x7a\x3e\x9e\xcb\x71\xca\x6e\xf6\x0c\x88\x4f\x2e\x68\xae\xd8\x73\x0b\xe7\x2f\x9e\x9a\x19\xbb\x9d\x74\x3b\xdc\xd3\x12\x30\xf6\xe3\x40\x55\x42\x76\xab\x62\x18\x22\x56\x8a\x1c\x1c\xee\xab\x31\xf9\xde\xe9\xa1\x22\x6d\x07\xa0\x7c\x1f\xab\x52\xe2\x0d\x21\xc0\x85\xcc\xc8\x59\x25\xf0\xd5\xac\xfc\x33\xc3\xe8\x9c\x0d\x4b\x16\x5a\xf1\xd4\xe5\x20\x6c\x75\x98\x30\x0f\x14\xd6\x87\x78\x31\xeb\x37\x97\x0e\xeb\x39\x01\x1b\xb4\xa1\x4c\xcc\x47\x57\x2b\x96\xee\xcd\x32\x29\xc3\xf6\x45\xc8\xfc\x09\x3e\x0e\xff\x04\x53\x06\xb2\xf3\xda\x28\x67\xa8\x0d\x1b\x84\x35\x60\x87\x55\x04\xd3\xf8\xab\x98\x2d\xbf\xea\xd3\xaf\x46\x14\x3b\x10\x69\x99\xd0\xc6\xce\xbc\x64\x2e\x56\x6a\xdd\x45\x43\x0b\x9a\x57\xb0\x09\xf0\xe8\x1a\x76\x42\x43\x66\x59\xa6\xc2\x97\x74\x56\x48\xf1\x3a\xab\x8c\x2b\xa1\x53\x56\xda\x3b\x8e\xd1\x12\xb6\x69\x64\x30\x13\x90\x85\x36\xb8\xe0\x36\x40\xf9\xaa\xd4\xfc\x42\xfe\x4e\x68\x2a\xbd\x97\x51\x34\xa8\xd2\x78\xa6\xd2\xb8\x51\xd4\x6c\x8d\x16\xb3\x68\x00\x70\xcd\x58\xba\xad\x59\x34\x6c\x04\xad\x1b\x4b\xfb\x9b\x45\x21\xbf\x56\x00\x6c\x53\x9b\xa9\x5f\x75\x80\x8e\xe7\xa9\x34\x95\x9a\x44\x8e\x63\x01\xb9\x68\x51\x75\x64\x9b\x0b\x22\xa7\x90\xe0\x48\xcc\x7d\xea\x6d\x53\x65\x59\x3a\xc6\x73\xc2\x44\xba\x92\x3c\x92\xd8\x27\x40\xef\xcb\xd3\x89\x5b\x9e\xa5\xc0\xeb\x4a\xbb\x6d\x86\x89\x75\xc2\x1a\x88\xd1\xba\x6d\x42\xdf\x32\x2d\x98\x3d\xaa\x53\xeb\xc6\x93\x12\x23\x34\x67\xc8\x8a\x8b\xa9\x42\xc8\xc1\x9b\x33\x33\x8c\xff\x1a\x16\x34\x64\xe8\xdb\x39\x4f\x35\xbf\xf9\xa8\xce\x53\x75\x2f\x29\xdf\x89\x2a\xc2\x18\xad\xbb\xcf\xb6\xce\x53\x21\x88\x95\xf3\xcf\xc7\x74\x9e\xc2\x99\x6e\xbd\x0e\x1f\xdf\x79\x2a\xb0\x04\x91\xb4\x72\x5d\xae\x69\x1f\xd7\x89\x4a\x2f\x72\x46\xd3\x33\x59\xb4\x55\x6c\xdc\x44\xa3\xd7\xbc\xa6\x3f\x54\x76\x60\x6c\xa1\x1c\x15\x08\xd9\x3f\xda\xcc\xbc\x67\xe6\x13\xd0\xef\x8c\x34\xe9\x00\xb5\x18\x74\xc3\xb6\xd5\xb0\x99\xd7\x37\xe8\x0e\x9b\xd6\xdc\x88\x1d\xb8\xdd\xba\x6b\x8b\x47\xba\x29\x54\x8c\xba\xcc\x0a\x58\x44\x52\x5d\x3d\x39\x72\xb3\x1b\xdf\xc3\x87\x21\x64\x56\x69\xb3\xd2\xda\x22\xf4\x4d\xdb\x1c\x57\xa4\x1c\x02\xd8\x6f\x63\xea\x58\x9b\xf0\x5a\x37\xd7\xb6\x3a\x46\x04\x40\x46\xdc\x62\x6b\xda\x98\xbf\xa7\xe2\x66\xdf\xc6\x46\x09\xf8\x1b\x83\xdd\x47\xf3\x8c\xff\x62\xf7\xc3\xd9\xc5\x5f\x63\xb1\x0e\x85\x48\x59\x0e\x1a\xde\x90\xac\xa4\x66\x42\x73\x18\xb5\xd2\x14\xb9\x2f\xe4\x53\x47\xd0\x7c\x66\x13\x45\xe3\xa5\x62\x88\xe5\xbe\x66\x69\x91\x6c\xce\xce\x8d\xbf\xca\x81\xb2\x55\xde\x51\x2d\x9d\x0f\x36\x59\x49\x99\x67\xbf\xc3\x75\xc2\x39\x94\x07\xb2\xe7\x57\xbe\x5e\xf7\x32\x58\xe8\x4c\x9d\x0b\xf0\xda\x7e\xce\x32\xda\x2b\x95\x6c\xe3\x93\x98\x3f\x85\x11\x96\x42\x0e\x48\x32\x4b\x49\x4a\x35\xc5\xb4\x91\x20\x93\x96\xe5\x71\xe0\xeb\xb6\x2b\x6f\x49\xd4\x42\xe6\xa1\x93\x51\x89\x66\xde\x10\x0c\x78\x6a\xc4\x27\x9a\x5c\x3b\xb3\xb8\x3b\x72\x09\xcb\x35\xa6\xd6\x09\xed\x38\x48\x76\x9d\xce\xd9\x48\xb3\x7c\xc9\x05\xc8\x50\xa0\x7b\x30\x91\xe4\xeb\x95\x76\x02\x15\xbb\x01\xde\x03\x09\x7b\xa6\xcc\x48\x40\xe5\x6c\x42\x83\xad\xfa\xb6\xfe\x3b\x53\x57\xfe\x36\x9c\x3c\x09\xf1\x1e\xb4\xa9\x64\x98\xc6\xed\xf8\x63\xf2\xa6\x42\x08\x96\x6d\xc1\xe4\x6b\x1f\xb4\x31\x79\x6c\xd0\xed\x5d\xb7\xc3\x45\x92\x15\x60\x33\xba\x65\x53\x25\x93\x6b\xa6\xd5\x6e\x29\x1b\x37\x45\x00\xa8\xf1\x80\xa0\x83\x74\x8f\xa7\x19\xeb\xe5\x8a\x4e\x0e\x16\x1f\x0b\xa5\x91\x08\x1c\x2f\x42\xfa\x0c\xf4\x81\x1f\xd0\x99\xa7\xcd\x07\xb3\x86\xf4\xf0\x87\x8e\x20\xdb\x88\x3c\xe0\x9a\x55\xe3\x96\xd1\xd7\x15\x11\xd4\x26\xd5\x98\x90\x73\x8d\x79\x9a\xb9\xaa\x05\x72\x6f\x40\xe8\x88\x66\xec\x9f\x06\x6d\x65\xbd\x3f\x47\x85\xb8\x16\xf2\x56\x8c\x30\xf8\xa3\x05\x6e\x00\x26\x56\x6c\xa8\x63\xae\x86\x35\x6c\xe0\x12\x7e\x42\x64\x47\xce\x12\x14\x21\x50\xc1\x63\xa9\x6b\x64\xf3\xb6\x6c\x08\x03\x75\x04\x84\xe3\x3e\xca\x0b\xce\xd7\xfd\x6a\x69\x6c\xb4\x87\x40\xb6\x92\x5d\x95\xc3\x2b\xdb\x95\x65\x27\x5a\xd6\x94\x26\x89\xcc\xe1\x20\x05\x93\x73\x79\xc5\xe1\x36\xd7\xf4\xfe\xa5\x0b\x12\x29\xf0\x8a\xbe\xb3\x76\x48\xd5\xb2\x9e\x2e\xd7\xfb\xbd\x52\xac\x6d\x31\x8e\x96\xa9\x4e\x04\x29\xab\x39\xb8\xfa\x3a\x5d\x13\x86\x0e\x23\x17\xd0\x60\xf4\x81\x7c\xc6\x25\xaf\x82\xdf\x1c\xd0\x4a\x2c\xdd\xe1\x63\x36\x24\x63\x3b\xbc\x8d\x35\x6c\x81\xcd\xfe\x55\xd0\x4c\x91\xb1\x39\x9f\x63\xb7\x84\xbb\xd6\x78\x9b\x33\x8c\xb2\xac\xdc\x1c\x3c\x6c\x84\x6e\x06\x5c\x95\x37\x54\x46\xcb\xdd\x8b\x92\x52\x0b\x1e\x66\x8c\x1a\x61\x12\xd1\x9b\xd0\x15\x86\xf1\xf2\x76\x87\xdf\x89\x20\x9f\x91\x97\x92\xa6\xcf\xd0\xdc\x9e\x7f\x4f\x05\x9d\xb3\x94\x8c\xc8\x55\x5e\x30\x23\x84\xd5\x6f\x89\xfc\xe5\x6b\x15\xef\x97\x4c\x9f\x90\xcf\x40\x40\x76\xe6\x12\xb2\x2a\xc0\x13\x05\x32\x3e\x63\x3e\xd2\xb5\x4b\xcf\xa8\xac\x7a\xc7\x13\x06\x72\x61\x0b\x44\x7b\x13\x90\x8f\xc9\x88\x7c\x43\x33\x05\xa3\xa2\x62\x8d\x67\x58\x2a\xd6\x18\x94\xa1\x67\x54\x73\x35\xe3\xc8\x8b\x5b\x40\xd6\xe7\xfc\x9a\xd1\x74\xdd\x73\xc6\x30\xbf\x56\x88\xa7\x78\x73\xe1\x46\x0b\x37\xd1\x88\xcc\xb1\xc5\xc7\xc6\x7b\x23\xa2\xae\xcb\x69\xb5\xad\xfd\x56\x13\x25\x9f\x91\xe7\xaf\x2e\xeb\x2b\xd8\x0e\xb4\x73\x86\x3d\x56\x30\x70\xb0\x56\x19\xd5\x20\x37\x5b\x9e\x62\x86\xe4\x10\xd0\x76\x6a\x21\x85\xbd\xad\x47\xd3\xb6\x43\x3f\x23\xa9\x88\x27\x92\x2c\xaf\xbc\x95\xe9\x8b\xfc\x22\x85\xd1\xa0\x3e\x2c\x52\xfd\x2d\x72\x7f\x94\x9a\xe1\xd5\xb7\x85\xf9\xc5\xa8\x6b\x79\x6b\x05\x7f\x70\x02\x00\x87\x46\x55\x24\x46\x9d\x9f\x15\x59\x65\x0b\xec\x73\x1e\xda\x16\xa9\x31\xc7\x4d\xb9\x28\x98\xbf\xad\xb5\x7e\xdf\x99\xeb\xcc\x4c\x0d\xaa\x60\x59\x42\x25\x52\x9a\xa7\xd5\x58\x6c\xb0\x67\xdb\x1a\x77\x85\xf7\x66\x54\xe9\xab\x9c\x0a\x05\x80\x8c\x28\xda\x15\xe2\x9b\x52\xcd\x46\x11\x45\xb4\x33\x77\xd7\x92\x29\x45\xe7\xc1\x7e\x3a\xbf\x37\x3a\x77\x38\xab\x4e\xe7\xe7\x6d\xb2\xce\x16\x9f\x87\x13\x48\x74\xeb\x07\xdd\x49\x6f\xdb\x72\xf2\xe1\x39\xee\x10\x0a\xaa\xc3\x8e\x77\xcf\xba\xa0\x59\xf9\xa3\x30\xe2\xe8\xe6\xee\x88\x0c\xd7\x51\xa7\x8b\x92\x38\x5d\x5a\xda\xd4\x31\x8e\xf0\x87\x8d\xb1\x95\xdc\xaa\xb5\xca\x46\xeb\x78\xe3\x7b\x79\x21\x95\x7e\xc5\xf4\xad\xcc\xaf\x7b\xe8\x5e\x5e\x6b\xd0\xb7\x37\xa2\xc6\xc1\x01\xab\x6a\x14\xba\x9a\x8a\x50\xf1\x31\xb9\xc0\xcc\xf2\x98\x41\xd9\x10\x32\x9b\x54\xc4\x83\xdb\x6e\x7b\xe9\x4e\xc0\xb9\xd0\x7a\x75\x21\xf3\x48\x61\x5b\xab\xa9\x9d\x90\xa7\xe1\xe4\x7e\x75\x94\x58\x90\x6e\xa1\x80\xc5\x58\x17\x04\xa8\x21\x84\xb7\x7f\xd1\x28\x53\x82\x36\x67\x97\xf8\xd1\x48\xa6\x4c\x94\x11\x67\x65\x8c\xd7\xd8\xbb\xf4\xab\x82\xd8\x22\x30\x15\xc3\xf4\xc5\x38\xa8\xa7\xfb\xe8\xc5\x01\x4c\xde\x6d\x6f\xef\x0a\xdf\x42\x0c\xc7\x37\x11\x90\xb7\xb9\x48\x78\xea\xdd\xa9\xbe\x92\x29\x03\x04\xe4\xe8\x0f\x3d\x23\x5e\xd9\x3b\x5b\xad\x37\x9e\x66\xb2\x74\x00\xdd\x27\x98\x97\x1e\xe2\xfb\x5d\x81\x5f\xc2\x75\xad\x50\xca\xd3\xfd\x70\x46\xf8\x3e\x09\x15\x7c\x0b\xd8\xf1\xf1\xe3\x50\x0e\x27\xcf\x10\x16\xde\x09\x7d\x6a\x4c\x82\x7f\x43\xcf\x4d\x77\x74\x14\xce\xee\xb8\xb1\xeb\xd4\xc7\xdd\x76\x97\xb1\x7d\x17\x87\x58\xdf\x77\x47\x47\x8f\xfb\x6c\xbc\x08\x48\xb3\x1f\xee\xb5\xf1\x22\x30\x6b\x9e\xc7\xbd\x36\xde\xd1\xd1\xe3\x7f\xaf\x9d\xe7\xf2\xbf\xf6\xcd\x44\x5b\xe6\x8b\xad\x92\xb7\xfb\x55\x10\xfb\x7a\x93\x13\xcf\xf9\xa0\x0c\x56\xad\x45\x05\xa0\x6f\xe6\x2a\x33\x6c\xf6\xea\xec\xc2\x20\xdf\x76\xd4\xb1\x60\x9b\x57\x25\xae\xa3\x8b\xd7\x3f\xfc\xed\xef\xe5\x8c\xd1\xef\xbe\xf6\x53\x8c\xa6\x61\x80\x02\x9c\x03\xd8\x5d\x35\xbd\xc8\x46\x31\x38\xed\x81\xa3\x5f\x86\x5c\x2e\x0b\x11\xb3\xf3\xba\x21\xa3\xe6\x6e\x54\x6c\x5b\xe7\x53\xce\xca\xcb\x58\x0f\x27\x70\x5c\x66\x32\xbf\xa5\x60\x47\x89\x02\xb5\x12\xb5\x8f\xcf\x76\xd3\x11\x21\x7f\x85\x7b\x16\xc0\x44\x04\x64\xb9\xf0\x98\x5d\x20\xe2\xde\x92\x33\x40\x02\x8e\x01\xa7\x16\x81\x5b\x4d\x9a\x0b\xa5\x19\x4d\x6d\xf5\x52\x99\x97\x89\x65\x6a\xa8\x7e\xa4\xdc\x27\x11\xa0\x5c\x20\x57\xc4\x70\x57\x65\x33\x1b\xce\xd5\xd8\xcf\xaa\x00\x13\x29\xa7\xde\x67\x13\x48\x11\x73\x42\xc2\x4b\x80\x06\xd2\x67\x94\xc7\x20\x72\x74\x9c\xc6\x40\x31\x5d\xa6\x6f\xaf\x2b\xdd\x30\xda\xc2\xa0\xa8\xf7\x48\x4d\xcf\xb8\x51\xfa\xee\x82\x4b\xc6\x80\x63\x9c\xec\xed\xdd\xde\xde\x8e\x5d\xa0\xa5\xcc\xe7\x7b\xa9\xbc\x15\x66\x44\x7b\x87\xe3\xc3\xbd\x54\x26\x7b\xf0\x6a\x54\x1e\x24\x7d\x17\x93\x06\xb0\x58\x03\xd2\x3e\xf0\x04\x82\xb2\x98\x2d\x67\xf1\xaa\xa6\x8c\x76\xd6\x96\x31\x38\xc3\x94\xf6\x28\x54\x3a\xee\x73\x82\xfa\x2f\x58\x02\x20\xd6\xc5\x56\x35\x26\x9f\x91\xc9\xe0\xea\xec\x22\x1c\x6a\x49\x40\x73\x9f\x0c\x60\x6c\xd6\xcd\xa7\x09\xc6\xa3\x7b\xbe\xff\x56\x04\x62\x19\x36\x67\xa8\x98\x73\xd2\x6a\x4e\xbf\x91\x7a\x2c\xee\x59\x41\xd0\xe7\x70\xc3\x05\x2c\x70\xdd\x47\x7a\x24\xac\x1e\x84\x3f\x1d\x99\x81\x47\xde\xc6\x09\x47\x8f\xac\xe9\x46\x6d\xec\x2b\xfc\x1c\x7c\xf9\x38\x94\x16\xb1\xc5\xae\xde\x25\xfd\xb0\xbc\x32\x50\xc6\xb6\x1a\x84\x87\x78\xb7\x7b\x66\xeb\x59\xb5\xc4\x25\x71\x6a\x8a\xc7\xa5\x2c\x12\xdb\x19\x3d\xa5\x94\xf3\x19\xd4\xb0\xb9\x03\xbf\xdf\x2c\x64\x71\x74\x8f\x6f\xab\xf3\x02\x2b\x62\x14\x01\xca\x48\x35\x48\x42\x34\xf9\xfe\x86\x5d\xd0\x4e\xbc\x70\x85\xeb\x01\xd9\x50\xe4\xd7\x8f\x53\x8f\x1d\xbc\x4d\x5b\xa3\x61\xb9\x8a\x89\x14\x49\xf9\x2a\x97\x53\x66\xcb\x6d\xbb\x0e\x0c\x35\x89\x80\xc4\x5a\x26\x18\x47\x53\xe2\x7a\x45\xf5\x82\xec\xe1\xb0\x7e\xd9\x43\x71\x53\x4b\x08\xbe\xcd\x97\x5c\x30\xc2\x63\x55\xff\x03\x66\x7c\x67\x16\x45\xf6\x97\x30\x7e\xc3\xca\x98\xe7\x60\xc9\x19\xf7\x98\x01\x62\xe2\x7c\x54\x4e\x5d\x6c\x93\xdd\xab\x75\xa4\x80\x0f\xb5\xd9\x5f\x76\x31\x63\x63\xb5\x03\xc0\x88\x22\x99\x32\xcf\xdb\xce\x5f\x16\xcb\x6d\x9d\x65\x77\xab\x35\xb2\xeb\x4e\x33\x65\x0b\x77\x56\xe2\x89\x9f\x76\x44\x2f\x82\x15\x3f\x09\x94\x7a\x73\xde\xd2\x72\x46\x8e\x8e\x89\x62\x89\x14\xa9\x22\x74\x66\x4e\x5b\x63\xb1\x20\xe4\x48\x55\x22\x42\x5c\x27\x18\x59\x73\xf5\x05\x78\xd4\xcf\x09\xbb\x61\xf9\x9a\x1c\x83\x57\xf1\xbe\xeb\x68\xe8\x2a\x96\x1e\x8f\xf0\x97\x18\x56\xed\xf5\x2c\xe6\xa6\x83\xaf\xf4\x22\x67\x0a\x5c\x0b\xcc\xc9\xbd\x95\x9e\xe1\xd3\x88\xad\x46\x04\x08\xba\x3f\x11\x5b\x35\x0c\x10\x05\x39\x30\x13\xb9\x74\xeb\x03\x71\x9a\x85\x70\xff\xf0\xcb\x82\x0f\x03\x37\x19\xee\xb9\x65\x59\x36\xd2\x4c\x55\x21\xa5\x95\x9a\xdd\xa1\xd3\xc4\xb8\x4e\x5d\xdb\x31\xd4\xf8\xdf\x49\xdd\xe9\xc8\x60\x9b\x79\xd7\x2b\x3d\x0c\x5d\x7e\xf3\xb0\xa5\xab\x76\x5e\x42\xc8\x0a\x99\xb3\xfc\x0b\x9f\x4b\xbc\x70\xba\xaf\x59\xcb\x7a\xbb\x5f\x82\x3c\xfe\x1a\xa2\x70\xfa\xea\x7c\x2d\x9f\x7a\x62\x10\xb5\xf5\x33\xec\x5d\x69\x64\xf7\x9c\x5f\x38\xd1\x1d\x19\x1d\xec\x21\x14\x8f\x68\x82\x79\x22\x64\x90\xb7\xc4\xad\x09\x39\x53\x50\xd7\xda\xb0\x63\xf2\x82\x26\x0b\xcb\x4a\x5d\xc8\x49\xa5\xb0\xa3\x88\x7d\x76\xfe\xfc\x75\x9c\x70\x20\x01\xde\xb1\x4e\xea\x07\xfb\x63\xf8\xdf\xde\x53\x97\xb6\x63\x96\xee\xef\x9f\x9c\x98\x7f\xef\x02\x87\x16\x32\x1a\x98\x4d\xac\xcc\xe6\xc6\x31\x24\x93\x81\x83\xb9\x3f\x19\x60\xf2\xa7\x8b\x9b\x23\xa0\x2b\x93\xc1\xc9\x89\xff\x6b\x58\xe4\xb1\x37\x23\xb6\x4a\x75\x23\x9a\xc0\x7a\x5d\xd0\x2c\xdb\xd0\x30\x63\x54\xde\x48\xbd\x46\x71\x49\x78\xc6\x35\xd5\xcc\xc6\xa6\xc2\x6d\x88\x11\x84\x18\xcd\x33\xce\xf2\xf6\xf8\x94\x28\xef\xa4\x9a\xa4\x1c\xc5\x23\xb8\x25\x02\xa3\x75\xcb\xe6\x02\x39\x7e\x08\x9a\x51\x38\xe3\x1a\xb1\xc6\x4b\xe7\xee\x6c\xce\xc6\x94\x69\x3a\xae\xdc\x37\xc6\x5c\xee\x99\xbd\x34\x72\x7b\x69\x84\x78\x18\xe1\xf6\x8b\x61\xb5\x4c\x4f\xe3\x44\x46\x17\xe9\x37\xfa\xd3\x46\xe5\x39\x28\xfb\xfe\x97\xc9\xc0\x0d\x23\xae\x98\x22\xb0\x96\x08\xc1\xaa\x7a\xbc\x0b\x43\x82\x42\xa1\x1d\x79\x72\x88\x0b\x23\xf7\x83\xb0\xf8\xac\x15\xaf\x55\xf8\x7f\x77\x08\xb9\x79\x8e\xc6\x07\x87\x11\xa5\xa2\xb3\x64\x52\x8d\x8c\x98\x23\x67\x4b\x9f\x36\x09\x81\x41\x0b\xbc\xee\x31\x57\x42\x76\x66\x7e\x8d\x21\xef\x68\xc2\xc9\x2c\x0f\xe6\x20\x98\xaa\x90\xf8\x01\x03\x3b\x3f\xef\x60\xbc\xc0\x7b\xf8\x7f\xfc\xf3\xc0\xfb\xfb\xf0\xcd\xfe\xe8\xc8\xfd\x7d\xfc\x66\x7f\x74\xfc\x76\x77\x32\xde\xfd\xf5\xf1\x6f\xdb\x7f\xb7\xe7\x3e\x39\x38\xb4\x6f\x1e\xbf\xd9\x1f\x1d\xbe\xdd\xfd\xe3\xee\xfb\x9d\x9f\xd5\x67\x3b\x38\x96\xd3\xd1\x37\x74\x34\x7b\xfb\xeb\xc1\xf0\xe8\xb7\x93\xdd\x5f\xbf\xf8\x6d\xe3\xd7\xf7\x27\xbb\xbb\xef\x5b\x1b\x3f\xf9\x6d\xe7\x64\xa3\xf5\xce\x8e\x1d\x81\x1d\x55\xfa\xfe\x20\x4d\xdf\xbf\x39\x18\x7d\xf9\xf6\xeb\x74\x77\x67\x1c\x7d\x6d\xa6\xba\x1b\xee\xf0\xf8\xb7\x9d\x9d\xcd\x2e\x77\x7f\x3d\x18\x1e\x9a\xcf\x3e\x66\xd7\x47\xc1\xae\xcd\x67\x6d\xaf\xbe\xfe\x00\xe3\x89\x0c\xe8\x71\x70\x40\x47\x81\x01\xfd\xba\x3f\x3c\xfc\xed\xe3\x0e\xea\x30\x38\xa8\xe3\xf0\xa0\x1e\x7f\xe4\x41\x1d\x04\x07\xf5\x24\x3c\xa8\xa3\x0f\x38\xa8\x93\x50\xff\x5f\x84\xfb\x3f\xfe\x60\xfd\xef\xee\x7c\x3a\xfe\x7c\xf7\x6b\xf5\xd9\xce\x64\x6f\xe7\xc0\xb4\x7d\x8a\xd4\xe3\xe0\xad\xa3\x10\x25\x21\x81\xff\xdf\xdd\xfd\x63\x28\x89\x08\xe9\x5b\x9c\xae\x47\x8d\x07\xd2\xab\x86\x5d\x2a\x14\x3a\xec\x2c\x99\xd0\x17\x32\xe3\x49\xa4\xba\x79\x69\xbb\xb1\x3e\x3e\xfd\x84\xce\x47\x2d\x7d\x94\x5e\x65\xca\xf9\xae\x64\x7c\xc6\x92\x75\x12\xcc\xd8\x42\x30\xf1\xb8\x69\x7a\xcb\xb3\x34\xa1\x79\xea\x79\xaf\x10\xaa\x94\x4c\xb0\xd6\x5d\x69\x1f\xa8\xc9\x9e\x51\xd1\x03\xfd\xbf\x9c\x27\xb0\xf5\x8f\x71\x59\xee\x9c\x91\xc0\x15\x0a\x1e\x93\xf3\x4a\x77\x8a\x1b\x6e\xbf\x77\x9e\x36\x18\xe3\xe8\x27\x04\x72\x6e\x52\x46\x3c\xf8\xab\x70\x2e\x39\x8f\xee\x6d\xf6\xeb\x5a\x92\x51\xd5\xcb\xef\xb1\xf0\xd9\x48\xe3\xfc\xa2\x54\x8f\xb6\xb8\x6e\x6a\x7c\x69\x35\xad\x94\x29\x9e\xb3\xb4\xf7\x72\xf9\x16\xe8\x2a\x1b\x3c\xaa\x1b\x10\xe0\x91\x81\x5b\x32\x17\xb3\x9c\x2a\x9d\x17\x09\xc4\xb6\xb8\xee\x3b\xc4\xe5\xf3\x99\x8b\xd5\x29\xb5\x63\xb7\x2d\x6c\x62\xc5\x46\x1a\xfa\xcd\x69\xc5\xac\x8f\x55\x4e\x7a\x9b\x8e\xde\xda\xd0\xd1\x0d\xd5\x75\x19\x11\xd4\xba\xb5\x43\xf3\xd0\xdb\x8e\x32\xe9\x0d\xad\xf0\x56\x55\xa5\x57\xea\x0e\xdb\x65\x39\xe7\x48\x8e\x9a\xb2\xd7\x9c\xd5\x16\xe4\xf4\xa7\xcb\xc6\xdd\xda\xb8\x1d\xc5\x1d\x70\xe3\x0b\x60\x06\xbf\x89\xd7\xae\xa1\x6e\x87\x75\xd2\x1b\xf3\x04\x92\xc0\x53\xa5\x78\xf2\xb2\xd3\x06\xe1\x3f\xb5\x15\x69\x81\xb0\x71\x6c\xfa\x64\x74\xa8\x8d\xbf\x51\xf0\x41\xc0\xfa\xd8\x9e\x1a\xb6\x0d\x67\xc5\xe8\x01\xb6\x69\xe7\x38\x43\x80\xb1\x63\x66\xc7\xd3\x1b\x9f\xa4\x96\xb9\xed\x3c\xcd\x58\x34\x7c\xa4\xf9\xd4\x31\xdb\x06\xa7\x7e\x13\xd4\x0b\x68\x69\xed\xc2\xc0\xf5\x15\xcb\xb9\x4c\x6d\xaa\x48\x3f\xec\x14\xb3\xa8\xf6\x04\xc9\xd3\x8c\x91\x29\x26\xf6\xda\x34\x9e\x24\x19\xa4\x71\x6c\xc6\xf2\x92\xab\xde\x43\xae\xe7\xee\x58\xd1\x5c\x31\xf0\x6b\x87\x0c\xb1\xb5\x44\x1e\x36\x65\x47\x4f\xb8\x5b\x25\xf6\x20\xa1\x3a\x5e\xcd\x47\xf0\xac\x4c\x8a\x69\x47\xde\x48\x83\x39\x34\xda\x66\x2c\xa3\x4d\xf3\x49\xa8\x9f\x29\xba\x32\x9e\x62\xd8\xd4\x66\x09\x8f\x9e\x60\xeb\xb7\x96\x06\xe0\x93\xfd\x32\x31\x6f\xef\x04\x7a\xcd\xa7\xba\x0c\xec\xf5\x41\xaf\x48\xa2\x8d\x3e\xfa\x08\x9b\xf5\xc6\x41\x9b\xab\xff\x08\x74\xdc\xfb\x1d\x24\xb0\x05\xc2\x47\x22\x81\xb6\xa7\x0f\x47\x02\x5f\xbd\x7c\xd6\xbd\x64\x5b\x20\x33\xe6\x4d\x5b\x3d\xed\xa5\xd9\x20\x8a\xcd\xfc\x2d\x67\x9b\xfc\xb8\xc7\xdc\xb4\x04\x67\x0e\x2a\xa0\x90\xb4\x43\xdb\x86\xdd\x0c\x18\x3b\x08\xb9\x3d\x60\xfa\x62\x30\x5c\xf0\x4f\x06\x96\x6b\x4c\x06\x27\xe4\xd4\xb1\x10\x30\x99\x93\x67\x5b\x8c\xd5\x50\xdf\x25\xbd\x66\x0a\x6e\x77\x8d\x10\x98\xb2\x84\xa3\x65\x93\x6a\xc2\x78\xe9\xdc\xa4\x73\x2a\xd4\x2a\x1c\x09\xea\x3f\x19\x5d\xb3\x9c\xec\x5c\x9d\x5d\xec\x5d\x5e\xbe\xdc\x25\xf6\x36\x00\xa4\x91\x04\xa9\xa5\x6d\x02\x75\x3b\xc0\x99\x2e\x6a\xb0\x72\xcf\x25\x63\x0d\xb7\x7d\xac\xc4\x54\x46\xe7\x58\x59\xa6\x56\x5e\x2e\x95\x89\x1a\xd3\x5b\x35\xa6\x4b\xfa\x8b\x14\xe3\x44\x2e\xf7\x4e\xe1\xcf\x17\x67\x97\x7b\x99\x51\xa9\xf4\x9e\x2b\xe0\x96\xcf\x0b\x9e\xb2\x86\xe1\xd4\x6c\x07\x35\x5e\xe8\x65\xf6\x49\x92\x4d\x7b\x8c\xd3\xae\xd1\xab\x97\xcf\x70\x7d\x9c\x4b\x70\x6d\x7d\x3c\xec\xf7\x00\xd9\xba\x3e\xb5\x85\x69\xa2\xfd\xdf\x03\xa1\x22\x9b\xc6\xa5\x48\xd2\xa9\xca\xb9\x67\xe4\xce\x41\x8f\x96\xaf\x5e\x3e\xeb\x49\x70\x7a\x90\x7a\x1b\x19\x15\x28\x7c\x5b\x75\x6a\x00\x46\x9b\xf4\x24\x71\xf3\x64\xb5\x8d\xb2\x32\x4f\x56\x1f\x43\x59\xf9\xf6\xec\xe2\x3f\x41\x59\x31\x83\xff\xaf\xa6\xac\x70\x26\xf4\x29\x5c\xda\x6d\xcb\x64\xfc\x6f\x1b\x65\x59\xac\xcf\x23\x5e\x06\xf6\x38\xba\xe0\x76\xe1\x2e\xfe\xac\xa3\x99\xe7\x24\xd3\x58\x95\xfb\x70\x1a\x43\xc2\xbe\x85\x9a\x08\x86\x8a\x5d\x56\xf1\xcb\xc0\xcf\xb0\xaf\x3e\xdc\xa0\x26\x93\x83\xad\x09\xa1\xd6\xa7\xec\x6e\xec\xf0\xc7\x3e\x18\x80\x0b\x39\x2a\xd6\x24\x67\x73\x4c\x58\x83\x45\xe9\x16\x8c\xfc\x78\x71\xd6\xf0\x42\x85\xb7\xbd\x58\x62\xf3\xda\xda\x27\x7d\x49\x26\x8b\x74\x3c\x97\x72\x9e\x31\xa0\x7b\x5e\xb8\x34\x13\x73\x2e\x18\x50\xc7\xbd\x85\xbc\x1d\x69\xb9\xe7\xb0\x34\xf2\xc8\x1f\x17\xf3\x4f\xb0\xd2\xc4\xbb\xde\x6b\x6d\xf9\xc9\x4b\x99\xc4\xd6\x62\x6b\x51\x05\xd6\x02\x80\x36\x96\x02\xd5\x06\x90\xd2\xec\x72\x74\xfb\xb1\xf8\x08\x54\x74\xc9\xdc\xaa\xec\x50\x91\x9a\xf5\xd8\x35\x4a\x93\x79\xb9\x41\x37\xfa\x28\xb3\x54\x6c\x2c\x66\xdb\x52\x95\x9e\x84\xbd\x06\xca\xcb\xd0\x73\xa7\x72\x4c\xd9\x82\xde\x70\xd9\xb1\xe8\xf5\xd5\xc4\x15\x77\x8b\xf0\x09\x22\xcc\xae\xed\x87\x63\x6d\x78\x64\x7a\x34\x84\xf5\xfc\x70\xbc\xad\x27\x4b\xea\x96\xb8\x6b\x84\xd0\x17\xb6\xbb\x2d\x90\x1d\xb3\x69\xf7\x36\x21\xa7\xf5\xe4\x93\x86\x7d\x0d\x4e\x7f\xba\x1c\x0c\xc9\xe0\xf4\x97\x22\x67\x83\x98\x97\x9f\x79\x06\xcf\x68\xce\xbe\x67\x9a\x66\xe6\x9b\x6f\xcf\x2e\xcc\x7f\x5e\x15\x9a\x0a\x7e\x67\xfe\x84\x5b\x64\x4d\x93\xeb\x01\xde\x59\x0f\x7e\xbc\x5c\x2d\x58\xce\xa2\x3e\xb6\x7d\x56\x7c\x64\x14\x8f\xae\x16\xbf\x84\x93\x67\xba\x36\xe5\xf8\x3b\xda\x7d\x1b\xf1\x71\xc5\x16\x76\xd6\x1d\xad\x4a\x84\x74\xb4\xb3\x78\xea\x68\x75\xfe\xec\xfb\x1e\x3b\xb3\x63\x0b\x77\x8b\x66\x1d\x62\x59\x8f\xfd\xaf\x12\x19\xdb\xfc\x75\xcf\x5c\xd3\xd6\xbb\xcb\xc1\x44\xbc\xe6\x37\x97\x6a\x78\xab\x8b\x18\xae\x08\xbb\x5b\x49\x30\xc6\x5c\x48\xa5\xf8\x34\x63\xb5\x0d\xff\xc2\x3a\xce\x0e\x70\x87\x9e\x5b\x2a\x15\xd9\xa2\x5d\xd7\x26\xe7\x5d\x9c\x7f\x44\x5c\xa7\xf7\xbf\x36\x89\x2f\xdb\xa8\xed\x12\x2e\xd0\x12\x90\xdb\xfa\xae\x63\x65\x85\xf5\x4b\xee\xe1\x0c\xe7\x9a\x86\x1d\xe1\x9c\x93\xf3\x65\xd4\x49\xe6\x7e\x61\x9f\x0d\xd8\xf7\xf5\x91\x7b\x08\x86\x7a\x08\x86\x7a\x08\x86\x7a\x08\x86\x7a\x08\x86\xc2\xe7\x21\x18\x6a\xf3\xe5\x47\x0d\x86\xea\xe0\xc7\xab\x9c\xdf\xd0\x50\xda\xe9\x1a\x6b\xb2\x2d\xc3\xdc\xf8\xc2\x36\x70\xfc\x36\xc4\x11\xb7\xe1\xc2\x16\xe6\x03\xf7\x25\x0f\xdc\xd7\x03\xfa\xc0\x7d\x1f\xb8\xef\x03\xf7\x7d\xe0\xbe\xf6\xf9\xb7\xe5\xbe\x61\xfb\x5e\xf8\x16\xbd\x2d\x89\x20\xf2\x93\xba\x7b\x67\x60\xd0\xd5\x6d\x77\x4b\x24\x18\x30\x0d\x1b\x85\x1c\x4a\x30\x19\x80\xeb\x0e\xf7\x7f\x94\xa6\xfb\x5a\x07\xc4\xe9\xd1\x70\x99\x65\x23\x6e\x6b\xb7\x66\xb1\xfa\x3a\xf1\x34\x97\x40\x3c\xca\x02\x5a\xce\x8f\x22\x52\x76\xef\xb4\x75\x68\x10\xdd\x6c\xcb\x4b\x68\xe9\xf0\x6c\x77\x6c\x38\x89\x28\x3e\x90\xe2\x99\x9d\x94\xd6\xed\x7a\xc4\x10\xd8\xb2\x13\x29\x12\xb6\xd2\x6a\xcf\xfa\xf9\xaa\x51\x35\x52\xf7\xdb\xde\x27\x86\x14\x75\x98\xa8\xf0\x3a\xb0\x96\x31\x70\x48\x68\xab\x3b\x72\xd3\x95\x38\x98\x35\x1e\x2d\x24\xf6\x80\xb6\x61\xe7\x91\xaa\xc2\xc5\x05\x5d\xb2\x71\x47\xce\x3e\x62\x89\x98\xeb\x19\x25\x3b\x51\xa5\x26\xb4\x29\x94\x31\xca\x2c\x9e\xda\x30\x00\x1e\x93\x8b\xc2\x3a\x25\xff\x90\x02\x93\x97\xda\x1f\x51\x6e\x34\xbf\xc2\x8e\xfb\xc9\xc7\xcd\xb2\xb4\x71\x05\x00\x9b\x8d\xd0\x4c\xba\xef\xa6\x60\xb9\xe4\xe9\x4f\x97\x43\xb4\x16\xa3\x95\xfa\xdb\xb3\x8b\x32\xe5\x63\x30\x14\x0e\x8e\x9d\x97\x4f\xac\xcf\x71\x33\x1d\x0a\x19\x0c\xfa\xc7\x30\xeb\x2a\xef\x40\x7b\x24\x39\xee\x5f\x97\x92\xfb\x23\x9c\x3e\xc8\x7e\x50\x6d\xe7\x21\xd6\x84\x0a\xc1\x94\x18\x3b\x8e\x63\x7f\xba\x0f\x08\x3c\x3a\x7a\x8c\x69\x0f\x0a\xe5\xa2\xdf\xa1\xba\x05\xd8\x5e\x0d\x0f\x74\xc3\x0d\xcb\x86\xcd\x8c\x06\x9e\x8c\x51\x3f\xce\xbd\x89\xda\x0d\xa7\x98\x4f\xbf\x1c\xae\x4d\x85\xe9\x74\x9d\x89\x20\xcf\x25\xc3\x68\xe7\x96\x1e\x02\x60\x1b\xfe\x4a\xed\x0b\xb2\xdd\x48\xfb\x90\x43\xdc\xa9\x36\x36\x9b\xdd\xad\x32\x9e\x70\x9d\x85\x22\x32\x1a\xd9\x28\x60\x59\xc0\x65\x73\x49\x45\x01\xd5\x0a\xe2\x28\x8d\x1e\x82\x86\x75\xb5\xd7\x41\x28\x22\xa5\xfd\x6a\x7c\xa7\xcc\x7d\xd1\xcd\x73\x22\x7e\xb6\xf7\xe5\x39\xe4\xb4\x1c\x40\x00\xf2\x56\xfc\x06\x90\x5f\xfa\x6d\x74\x52\x02\x43\x72\x6b\x65\x44\xb3\x4c\x26\xd0\xc5\x74\x5d\x05\x68\x7e\x45\x16\xf2\x96\xdd\xb0\x7c\x08\x15\x57\x91\xc2\x85\xee\x61\x34\xd5\x3c\xc1\xec\x18\x16\x1a\x96\x39\x81\x1d\x61\xab\x2f\x3a\xd9\xbb\x3c\x28\xd1\x1a\x61\x36\x7e\xc6\xb1\x86\xe6\x7a\x95\xc5\x75\x31\x17\x78\x40\xf0\x0b\x0b\x7d\xa3\x36\xd1\x26\xd0\xb2\x2b\x6b\xe4\xc8\x1d\xf5\xc0\xdb\x3e\x57\x10\x51\x29\x31\x7c\x27\x13\xb8\x42\x8b\x88\x94\x65\xb4\x2f\x16\x8d\x96\x2d\xbe\xae\xf5\x1b\x96\x66\xfb\x46\xda\xcf\x96\xf7\x1f\x28\xff\x27\xd4\x94\x78\x71\x67\xd6\x58\xf5\xac\x18\xdf\xfc\xa4\x9e\x4e\x3d\xa3\x53\x96\x11\xe5\xc6\x69\xb1\x6a\x4e\x50\x90\x1b\x9b\x83\xe5\xb7\x83\xb3\x73\xfa\xea\x79\x5b\xf6\x7f\xd2\x15\x9b\x5c\x1b\xea\x69\x64\x38\x38\xec\xf2\x4d\xd4\xab\xc2\xd2\x17\x5b\xb4\x54\x19\x21\xef\x9a\x61\x91\x34\xc3\xec\x5c\x20\x18\x6a\x56\x39\xcb\xca\xbb\xcf\x6b\x16\x0e\x71\x37\x1f\xdb\xf4\x1d\x61\xab\x59\xa7\xd3\xd6\x35\x8b\xc4\xe7\x35\xd0\x71\xcd\xca\x7c\xb2\x88\x17\xf3\x43\xa9\x0d\x96\xa8\x40\x4f\xb5\xb8\xe3\x8e\x96\x31\xf5\xb6\xd7\xdd\xb5\xc3\x5a\xef\xe1\x97\x68\xce\xd9\x0a\xcd\x94\x0a\x17\xe2\x91\x42\xa4\x9b\xdd\xb8\xe0\x91\x92\x4c\xf8\x50\xc8\x2d\x20\x67\x65\xf2\x14\xd4\xd6\x1c\x78\xdc\x7f\xe7\x62\x68\xb4\x65\xf3\x9f\x17\x77\x5c\x75\x78\x6c\x99\xb5\x34\xf2\xc7\x2b\xa9\xa1\xf5\xef\x46\x0e\x0e\xad\x37\x6a\xec\x9d\x38\x86\xe0\x43\x78\xa7\x99\x9f\xb5\x25\xb8\x69\x62\xbd\xfd\xe8\x3c\x4a\x14\x43\xda\x28\x22\x73\x87\x83\x32\xb5\x8c\xb2\xe0\x5d\xd0\x88\x90\x62\x04\x96\x8b\xb8\x57\x88\xad\xf5\xef\xc3\x47\xb4\x9a\x3e\x7c\xcc\xf9\x5d\xc5\x51\x5e\x1b\x06\x0e\x01\x03\x2c\xf0\x0d\x48\xae\x50\x2b\x14\xea\x5e\xa2\x52\x6c\x15\xf5\x0e\x1f\xda\x25\xcb\xe7\x90\x4d\x2a\x09\x56\x79\x22\x7d\x72\x24\xf4\xf6\x43\xea\x0e\xcb\xed\x72\xf4\x18\x45\x48\xcd\xa8\x44\x7b\x48\x0f\xe8\x72\x02\x89\x8f\x0f\x18\xc2\x4b\x43\x50\x02\xd8\xa8\x1c\xae\x2f\x3a\x29\x5a\x27\xc6\x36\x79\x11\x76\x8d\xf4\x7c\x49\x57\x66\xe7\xff\x6a\xc8\x33\x6c\xa2\xdf\xc8\x8a\xf2\x5c\x19\x69\xd0\x88\xad\xc1\x70\x62\xff\x0b\xeb\x65\xe9\x03\x37\x70\xb9\x22\x66\x15\x6e\x68\x06\xd5\xaa\x25\xe8\x39\x59\x4c\x7f\x95\xb3\x0d\x6e\x39\x24\xb7\x60\xb1\x36\xa4\xb7\x8c\x0e\x1a\x5c\xb3\xf5\x60\x58\x3b\x21\x61\x8d\x78\x70\x2e\x06\x55\x31\xe3\xda\xa1\x2c\xf9\x14\x68\xc8\x03\x78\x37\x18\x6f\x30\xd8\x88\x95\x20\xc2\x76\x7b\x24\x62\xef\x53\x76\x67\x49\x57\x23\xbb\x9f\xb4\x5c\x6e\x9c\x44\x57\x78\xe6\x5b\xa8\x20\x07\xab\x1c\x97\x9d\x36\x3f\x68\x29\xb8\x63\x0b\xd2\x61\xd2\x17\xfb\x41\xb4\x0c\x4d\x4b\x71\xb4\x58\x0e\x2a\xc8\x1f\xd3\x53\xd8\xab\xb5\x6d\x08\x7a\x8d\x77\x81\x14\xf4\x0f\x22\xdd\x83\x48\xf7\x20\xd2\xf9\xcf\x83\x48\x17\x7c\x1e\x44\x3a\xf2\x20\xd2\x6d\x3b\xbe\x07\x91\xee\x41\xa4\xfb\x60\x22\x9d\xea\x27\x17\x29\x4f\x24\xa2\x0d\x2e\x0f\x81\xfd\x96\xf6\xa1\x94\x36\xb4\x37\xfe\x21\xf3\x7e\xb3\x30\x70\x22\x73\xbc\xc8\xf0\xcb\x14\xb6\x78\x28\x5c\x95\x55\x7b\x5b\x80\xba\xc5\x37\x20\x00\xaa\xcd\x91\x0b\x45\xf6\xbc\xf4\xab\x72\xb6\x59\x5f\x71\xab\x4a\x42\x3a\x53\x17\xb9\x9c\xf1\xac\xe5\x56\xba\x1e\x69\x52\x36\x74\x62\xc0\xd5\xcb\x4b\x3f\xdf\x44\x3d\x20\x12\x24\x83\xd6\xea\xa5\x5c\xd8\x04\x83\xdb\x8a\x9b\x09\x5f\x2d\x82\x59\x7f\x1a\xe1\x81\xd8\xd4\x25\x58\x00\x93\x37\x86\x7a\x61\x12\x0b\x78\x4d\x68\x36\x97\x39\xd7\x8b\x65\x68\x53\x63\x52\x8d\x9c\x11\xc1\xe6\x52\x63\x8e\x27\xcb\x0e\x1c\x02\x16\x54\xa4\x6a\x41\xaf\xd9\x98\x94\x75\xc1\x54\x24\xe1\x64\xce\x96\xf2\x86\x11\x26\x74\x6e\x7d\x2e\xb8\xcd\xd5\x2c\x52\x45\x52\x89\x59\x74\xd1\x3c\x3f\x26\x90\xd0\xd9\x65\x29\x0c\x0d\x12\x3d\xcb\x9e\xbf\xb8\x1c\x9d\x3d\x3b\x7b\x3c\xba\xfc\xee\x94\x90\x9d\x35\x5d\x66\xbb\x70\xed\xef\xd0\x46\x46\xb5\x36\xed\x26\xf6\x28\x5b\xea\xe9\xfb\x10\x24\xf5\x5c\x5c\xbd\xbc\xfc\x11\x13\x7e\xf6\x59\xc5\xda\x07\xa1\xb5\x84\x9c\xbe\x34\x73\x89\x44\xe3\xf7\x0f\x66\xc5\x4a\xc7\x2f\xbb\x45\x7b\x2d\x6e\x00\xac\xbf\x3c\x6e\x21\xcc\x87\x65\x56\xd3\x83\xf1\xc1\x90\x1c\x8c\x0f\x81\xe4\x1e\x8c\x1f\x7b\x0b\x13\x00\x59\xc7\x92\x81\x76\x73\x30\x3e\x30\x0b\xf9\xea\x87\xab\x17\x27\xde\x35\x35\xa4\xe0\xe6\xf3\x05\x53\xba\xfe\x55\x88\x44\xdb\x38\x2e\xae\x88\x6d\x78\xf5\xf2\xf2\xe0\x70\xfb\xbb\x16\xef\xeb\xf6\x44\xca\xb5\x26\xed\xd5\xbd\x6b\x4d\xda\x13\x3b\xd7\x9a\xb4\x97\x2b\xba\x5f\x79\xe2\xd6\x17\x1b\x3f\xa2\xee\xee\xa5\xb9\x53\x5a\xe6\x74\xee\x27\xbe\x53\xc5\xb4\xac\x98\xea\x90\xa5\x12\xea\xd3\x52\xe0\x2e\x4e\xcf\xbe\xa0\x7a\x71\x52\x16\x55\x75\x2c\xa7\x6c\x6b\xb6\xb5\x23\xe2\xae\xa9\x5f\x4c\xb5\x6a\x08\x00\x9a\x4d\xe3\xa5\x5a\x6d\x5d\x3d\xf2\xeb\x6f\x7f\xf8\x7f\x03\x00\x00\xff\xff\x8b\x9c\x74\x17\xf2\xd9\x01\x00")
+var _manifests00CustomResourceDefinitionYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7d\xfb\x7b\xdb\xb6\xb2\xe0\xef\xfa\x2b\xb0\xea\xc9\xda\x4a\x45\xca\x76\x9c\x34\x55\x5f\xeb\xba\x69\xeb\xef\xa6\xa9\x37\xf6\x69\xbb\x27\x4a\xbb\x10\x09\x49\x38\xa1\x00\x1e\x02\xb4\xad\x36\xdd\xbf\x7d\xbf\xc1\x83\x04\x25\x02\xa4\x9c\xf4\xde\xbd\x7b\x23\x9d\xef\xd4\x11\xc1\xc1\x60\x30\x98\x17\x06\x03\x9c\xd3\x9f\x48\x21\x28\x67\x53\x84\x73\x4a\xee\x24\x61\xf0\x2f\x11\xbf\x79\x2a\x62\xca\x27\x37\xc7\x83\x37\x94\xa5\x53\x74\x5e\x0a\xc9\xd7\x2f\x89\xe0\x65\x91\x90\x6f\xc8\x82\x32\x2a\x29\x67\x83\x35\x91\x38\xc5\x12\x4f\x07\x08\x61\xc6\xb8\xc4\xf0\xb3\x80\x7f\x22\x80\x19\xe1\x3c\x2f\xf8\x0d\x49\x63\x9e\x13\x26\x56\x74\x21\x63\xca\xa7\x68\x25\x65\x2e\xa6\x93\xc9\x92\xca\x55\x39\x8f\x13\xbe\x9e\x54\x0d\x26\x38\xa7\x93\xbc\xcc\xb2\xc9\x93\xe3\x27\x0a\x10\x65\x49\x56\xa6\x24\x2e\x48\x46\xb0\x20\x0d\x58\x13\x3a\x5f\x47\x49\xc6\xcb\x34\x5a\x63\x86\x97\x24\x9d\xa2\xa1\x2c\x4a\x32\xec\x7e\x55\x90\x6c\x61\xdf\x8a\x56\x74\xb9\x8a\xf0\x0d\xa6\x19\x9e\xd3\x8c\xca\xcd\x1e\x70\x28\x5b\x66\x24\x62\x3c\x25\x51\x4a\x6e\x48\xc6\x73\x52\x38\xaf\x33\xbc\x26\x53\x44\xd9\xb2\x20\x42\x24\x9c\xc9\x82\x67\x19\x29\x04\x40\x29\xb0\xe4\x45\x03\xdc\x40\xe4\x24\x01\x0a\x2e\x0b\x5e\xe6\x53\xd4\xde\x48\x43\x35\x94\xd6\xb3\x74\xa1\x3b\x38\xaf\x3a\x50\xcf\x32\x2a\xe4\xbf\xb5\x3f\x7f\x4e\x85\x54\x6d\xf2\xac\x2c\x70\xd6\x86\xa2\x7a\x2c\x28\x5b\x96\x19\x2e\x5a\x1a\x0c\x10\x12\x09\xcf\xc9\x14\xbd\x00\x74\x72\x9c\x90\x74\x80\xd0\x8d\xe6\x2b\x85\x5e\xa4\x30\x9d\xa2\x9b\x63\x0d\x2c\x59\x91\xb5\x62\x18\xf8\x17\x0c\xfc\xec\xf2\xe2\xa7\x47\x57\x8d\x9f\x11\x4a\x89\x48\x0a\x9a\x03\x3b\x4d\xd1\x70\x07\x75\xf3\x7c\x4e\x04\xc2\xc8\x4c\xa1\xc5\x0e\xd5\xe8\xa1\x05\x2f\x2a\x90\x08\xc9\x15\x41\x49\x56\x0a\x49\x8a\x18\x5d\xaf\x88\xdb\x32\xc1\x0c\x09\x52\xdc\xd0\x84\xa0\x1f\x73\xc2\xae\x80\xd2\xe8\x25\x2f\x25\x41\x98\xa5\xe8\xdf\xca\x39\x29\x18\x91\x44\x58\x42\x3a\x80\x0b\xb3\x34\x44\x8c\x66\x0c\xfd\xbc\x22\x0c\x61\xb6\x4b\x70\x44\x05\x4a\x0a\x82\x25\x49\xc7\x08\x23\x46\x6e\x5b\x70\x76\xc0\xa6\x24\xcf\xf8\x66\x4d\x98\x74\xde\x44\x92\x23\x9c\x65\xfc\x16\xc1\x8a\x2d\x18\xce\x90\x2c\xf0\x62\x41\x13\x78\x52\x10\x9c\xac\xd4\x40\xcd\x60\x04\x92\x2b\x2c\x1d\xa0\xe4\x2e\xe7\x82\x58\xe4\x10\x2f\xcc\x20\x9d\x31\xfc\x3d\x4f\xb1\xa4\x6c\x89\xe4\x8a\x8a\xea\x01\x5a\xe3\x0d\xca\x08\x4e\xa1\x9f\x94\x8a\xa2\x54\xd3\xe3\x80\x5e\xf0\x02\xe5\xe5\x3c\xa3\x09\x5a\xe0\x04\x00\x30\x22\x6f\x79\xf1\x06\x86\xc7\x48\x02\xcd\x05\xc2\xc2\x3b\x76\x54\x90\x1b\x2a\x9a\x40\xa1\xd7\x39\x41\xaa\x41\x8a\x78\x29\x15\x8d\xad\x04\x79\x53\x4d\x0b\xac\xc4\x94\x27\x62\x92\x70\x96\x90\x5c\x8a\x89\xa5\x40\x64\xb0\xa0\x6c\x39\x31\x7d\x46\x75\x9f\xee\x3c\x9a\xc9\x23\x37\xa4\x40\x39\x17\x82\xce\x33\x32\x46\x02\xe4\xe2\x3c\x23\x28\x25\x0b\x5c\x66\x52\x00\x5b\x29\x1a\xe7\x19\x96\x0b\x5e\xac\x11\x2e\x08\x2a\x05\x49\x63\x74\x45\x08\x82\x39\x70\xa0\x2e\x28\xc9\x52\xf5\xce\x9a\x17\x00\x45\x62\x9a\x69\x56\x39\xe7\xeb\x1c\x4b\xaa\x25\x0e\xca\x40\x78\xa0\xe3\x29\xba\x92\x18\xfa\xbb\xa5\x72\x45\x99\xe2\xf0\x7f\x36\x18\xd9\x88\x21\x05\x13\xa3\x35\x65\x74\x5d\xae\x11\x5f\xa0\xe3\x13\xb4\xe6\x4c\xae\xd4\xbc\x3e\x82\x27\xbc\xb0\xad\x05\x3a\xbc\x5d\xd1\x64\xa5\x46\x47\x05\xca\x38\x5b\x92\x62\x14\x0f\x2b\xc0\x79\x01\xc2\x46\x52\x2b\x57\xf4\xd7\xd1\x13\xce\xaf\x5b\x4b\xf4\x00\x56\xb1\x6e\x05\x64\xa2\x4c\x31\x1e\xb1\x92\x80\xa4\x66\xe1\x03\x92\x86\xab\xf2\x82\x08\xc2\xb4\xca\x68\x00\x46\xd0\x08\x33\xc4\xe7\xff\x24\x89\x04\x92\x16\x00\x06\x89\x15\x2f\xb3\x14\x78\xe9\x86\x14\x12\x15\x24\xe1\x4b\x46\x7f\xaf\x60\x0b\x60\x4c\xe8\x34\xc3\x92\x18\xd1\x56\x7f\x29\x33\xab\xe5\x06\x67\x25\x19\xab\x45\x0d\xbc\x55\x10\xe8\x05\x95\xcc\x81\xa7\x9a\x88\x18\xfd\x00\xf3\x45\xd9\xa2\xa9\xb2\xac\x7e\x4c\xf8\x7a\x5d\x32\x2a\x37\xc0\x72\xb2\xa0\xf3\x52\xf2\x42\x4c\x94\x0a\x98\x08\xba\x8c\x70\x91\xac\xa8\x24\x89\x2c\x0b\x02\x6a\x0d\xb8\xee\x86\x30\x18\xb0\x88\xd7\xe9\x47\xd5\x92\x3b\x68\xe0\x2a\x37\x20\x50\x85\x2c\x28\x5b\x3a\x0f\x94\x94\x0f\xcc\x00\x48\x79\x90\x13\xd8\xbc\xaa\x47\x51\x13\x1a\x7e\x02\xea\xbc\x7c\x76\x75\x5d\x2f\x6a\x98\x8c\x06\x50\x64\xe8\x5e\xbf\x28\xea\x29\x00\x82\x51\xb6\x20\x85\x16\x0d\x8b\x82\xaf\xd5\x34\x13\x96\xe6\x9c\x32\x69\x44\x2c\x25\x6c\x9b\xfc\xa2\x9c\xaf\xa9\x04\x69\xf2\xaf\x92\x08\x09\x73\x15\xa3\x73\x65\x34\xa0\x39\x41\x25\x88\x1c\x58\x3f\x17\x0c\x9d\xe3\x35\xc9\xce\x41\xd3\xff\xd5\x13\x00\x94\x16\x11\x10\xb6\xdf\x14\xb8\xf6\x4e\xfd\xd1\x8d\x35\xd5\x9c\x07\x56\x8f\x7b\xe6\x0b\x1e\xc3\x74\x01\xc5\xe0\x6f\xba\xa0\x89\x5a\x0b\xc0\xfd\xf0\x63\x4a\x04\x2d\x48\x8a\xe6\x64\x85\x6f\x28\x2f\xcc\xef\x0d\x88\x68\x57\xc9\xc4\x8d\x06\xed\x4b\x1a\xbe\x7a\x96\xae\x9f\x5f\x6d\x3f\xd8\xc2\xb3\x6a\x67\xb1\x24\x02\x09\x22\x41\x3f\x68\x41\x68\x66\x14\xd8\x0b\xd6\xd4\x0d\x29\xe8\x62\xd3\xa4\x9b\xfd\x68\x58\x28\x01\x8c\xd4\x70\x89\x18\x23\x25\x94\x94\xfe\x05\x3e\x10\x44\xe9\x17\xc2\x94\x04\x5c\x97\xb2\xc4\x19\x82\xde\x9b\xaa\xdc\x7e\x48\xba\x24\x91\x24\xc5\x9a\x32\xe0\x1f\xb5\xaa\x0b\x42\x58\x52\x6c\x72\x89\x0a\xd0\x6c\xa2\x49\x91\x30\x55\xe0\xab\xb4\x2b\x49\xaf\x4a\x35\xa3\x97\x58\x82\xe0\x68\x6d\xb9\x45\xaa\xf6\x17\x1d\xba\x61\x65\x8e\xc1\x44\x16\x44\x99\x54\xad\x30\x95\x8e\x86\x49\x05\x36\x55\xea\xdb\x8a\xbe\x39\x41\x6b\x2c\x93\x15\x0c\x74\x89\x29\x13\x7a\xc1\xa5\x54\xc0\x74\x94\x54\xac\x94\xf9\xd5\xf6\x05\x23\x0c\x71\x50\x26\x37\x38\xa3\x69\xcb\x54\x00\xd9\x17\x34\x93\xa4\x9a\x52\x11\x23\x30\x93\x3c\x10\xcd\x10\x1a\xc8\xae\x4b\x21\x61\x0e\xd1\xe5\xf9\xcb\x67\x48\x6c\x98\xc4\x77\x31\x42\x17\x46\xe8\xab\xd1\x53\x81\xc8\x3a\x97\x9b\xb1\x0f\x53\x8b\x06\x70\x14\x15\x28\x27\x05\xe8\x58\x10\x0e\x1a\x10\xa9\xe0\x30\xce\x34\x28\x20\x03\x43\x0d\x3b\xc7\xfd\x82\xfe\x93\x88\x33\x82\x72\x3d\x9b\x1a\x51\x45\x4b\x84\x5b\x68\x71\x20\xf6\x20\x6a\x81\x48\x26\x40\x92\x92\x76\x93\x06\x98\x41\xaf\x73\xa7\x07\x0f\x44\xe0\xdf\x94\x30\x6a\xd4\x67\x6d\x37\xed\x32\x31\x7c\xa9\x24\x6b\x0f\x67\x7a\x85\x58\xfd\xd5\x0d\x70\x51\xe0\x4d\xeb\xf3\xbb\xa8\x36\xaf\x22\xa0\x79\x64\xde\x90\x7c\x4d\x93\x96\x57\x34\x21\xcf\xcf\x7a\xac\x15\xdb\xb4\xb1\x3a\x12\xce\x16\x74\xb9\xc6\x39\x28\x79\x89\x29\xb3\x3a\xeb\xf2\xd9\x0f\x11\x61\x09\x4f\xbd\x53\x71\x7e\xe6\x52\x17\xcd\x4b\x96\x66\x64\x7b\xf1\x58\xe9\xa2\x65\x54\x35\xf3\x07\x62\xd0\x0a\xd3\x85\xa8\x97\x02\xc2\x29\x58\x5b\x42\x2a\xbf\x4d\x73\x91\xb6\xcb\x35\x83\xd7\x03\xa0\x6c\xd0\x0a\x52\x4d\x6b\xe5\xca\x81\x42\x5a\xd0\xa5\x62\x24\xe5\x3d\xb5\x4f\x73\x58\x5e\x59\x4e\xf4\x3d\xdb\xa2\x3c\x34\xb5\x8a\xc7\x2a\xb4\x58\xfd\x68\x14\x4f\x41\x16\xa4\x20\x2c\x21\xa9\x19\x8f\x17\x2e\x42\x6b\x9c\x7b\x9f\x76\xf2\x1f\x68\x0e\x50\x71\xed\x88\x6b\xc7\xb1\xf5\x91\x47\xe3\x6e\xb1\x61\x3d\x79\x97\x3c\xa3\xc9\x66\x3a\xe8\xa4\xcd\xd0\xf3\xaa\xc3\xa5\xb7\x2b\x22\x57\xa4\x70\x97\x7b\x2b\x5c\xd4\x14\x02\x6a\xa4\xc2\xa0\x06\xd6\x0f\x82\xc8\x08\x4d\x1b\x42\x41\x0b\x5c\x2a\xb4\xcb\xe0\x01\x8b\x13\xe5\xde\x28\x04\x94\x89\x27\xd0\x6c\xf8\xd2\x90\x72\x36\x04\xcb\x7f\x36\xfc\x51\x0d\x08\x67\xb3\xa1\xf2\x33\x5e\x70\x49\xb6\x1d\x41\xf7\xe3\x91\x5d\x9c\x65\x1b\x94\xac\x48\xf2\xc6\x62\xde\xc0\x56\xa9\xff\x2d\x0d\xec\x43\xba\xa1\x97\x41\x9f\x6b\xdd\xfc\x19\xa2\x12\x74\x3f\x98\x81\xaa\xa3\xdd\x0e\x92\x8c\xe0\x42\x92\x3b\x1f\xee\xdf\x5f\x5f\x5f\xc2\xa0\x73\x2c\x84\x5c\x15\xbc\x5c\xae\x9c\x0e\x1c\xf7\xc6\xfd\x12\x56\xae\x7d\x6c\x37\x6c\x7f\x25\x42\x96\xc8\x9e\xc7\x96\xe6\x83\xbd\x17\x83\x7f\x21\x44\x86\xee\xe7\x67\xfe\x47\x35\xc1\x34\x9f\x0f\xf6\x58\x2e\xc6\xa5\x75\x60\x4c\x07\xe1\x15\xb2\xfb\x06\x08\x13\x5c\x4b\x0d\xe0\x6d\x8c\x04\x49\x0a\x22\x1d\x39\xbe\x03\x16\x19\x2b\x57\x81\x73\xa7\x5d\xc5\x61\xc0\xf0\xdd\x78\xf8\x32\x56\x5e\xba\x8e\x5b\xb4\x2d\xbe\x94\xb3\x03\x69\x96\xac\x82\x41\x0b\xc4\x6f\x99\xdb\xc7\xb8\x65\xe4\x30\x0e\xd0\x10\x6d\x32\x78\xc6\x94\xf8\x37\xa3\xd2\x72\x5f\x0f\x0d\xe0\xa3\x05\x07\xd3\x0f\xb4\xd5\x1b\xb2\x11\x8a\xdb\x41\xac\x4e\x61\xed\xc9\x4c\xc4\x49\x21\x77\xe9\x8a\x1a\x83\x5e\xd0\x4c\x07\xa4\xb4\x7c\xc8\x44\xfc\x86\x6c\xa6\xe8\x0d\xd9\x6c\x3d\x9a\x31\x30\x83\x4a\x26\x88\x6c\x33\xa1\x30\xba\xa5\x59\x9a\xe0\x22\x6d\x80\x87\x39\x2a\x25\x5f\x63\x49\x13\x9c\x65\x1b\xb4\x24\x8c\x14\x95\xcd\xac\xc6\xed\x31\xf6\xb6\xc0\x68\xfb\xd1\x86\x40\x5a\xa4\x46\xca\xd7\x98\x32\x74\x08\x54\x10\xe5\x5c\xff\x53\x8c\xda\x70\x65\xa9\x22\x5f\x8d\x8b\xd3\xd7\x81\x40\xe7\x67\x30\x98\x0c\x1c\xc4\x26\xf2\xe0\xcc\x2f\x0b\x8f\xbc\x81\xb0\x89\x1b\xeb\x3b\x10\x48\x16\x30\x63\x42\xf2\x82\x28\x79\x78\xb1\xf0\xd3\xa9\x05\x22\x50\x47\x21\x2b\x56\x58\xb9\x64\x1b\xb4\x2e\x33\x49\xf3\x8c\x28\xf1\x33\x39\x31\x0e\x4b\x6a\xa4\x8e\x09\xb5\x20\xba\xce\x33\xda\xca\xa3\x67\xcf\x2f\x5f\x8c\x00\x4b\x66\x96\xb1\x40\x87\x34\x26\xf1\x18\x41\x18\x7d\x9e\x6d\xd0\xbc\xe0\xb7\x82\x14\x62\xa4\xa2\x4b\x58\xa2\x8c\xce\x49\x21\x37\xb0\xbe\x0a\x52\x8a\xb6\xa9\x02\xc3\xc2\x0d\xb6\x41\x98\x93\x0a\xb4\x26\x98\x09\xc7\xd0\xc5\x4c\x43\x40\xd8\x69\x8c\x24\x6f\x81\x88\x19\x57\xea\xae\xa8\x22\xa1\xca\xa6\x52\xc6\xfc\x1b\x92\x29\x6c\x16\x98\x66\xa6\x27\xe3\xac\x96\x05\x2c\x26\x3d\xad\x59\xd6\x66\x5d\xbe\x61\xb0\x22\xb1\x70\x11\x48\x38\xce\x88\x80\xc0\x61\x6c\x17\x1c\x65\x11\xe0\xe9\xce\xd0\xa1\xd1\xc0\x2d\x40\x6b\x3e\xe2\x05\xb8\x21\x45\x64\xf5\x76\x3a\xea\xe4\x24\xc5\x37\x2d\x40\xab\x68\xf0\x81\x40\xf3\x92\x66\x32\xa2\x0c\xfd\x78\x56\xca\x95\x16\x54\x45\x3c\xdc\xd3\xc1\xf4\x1b\x6b\x0d\x51\x7b\xf0\x62\xd7\x28\x93\xad\x31\x91\x50\x14\x94\xdf\x40\x18\x94\xdc\x4e\x4c\x08\x34\x82\x61\x46\xda\x76\x12\x13\x40\x45\x4c\x3e\x52\xff\x69\xc5\x08\xa1\xeb\x1f\xbf\xf9\x71\x8a\xce\xd2\x14\x69\x4e\x28\x05\x59\x94\x99\x36\x51\x44\xec\x84\x07\xc7\x08\x22\x29\x63\x54\xd2\xf4\xab\x83\x41\x0b\xa4\xb0\x12\x0c\x1a\x75\x0d\x57\x64\x8d\xf3\x90\x27\xa2\x05\xce\x74\x10\x24\xee\xd0\x48\x29\x90\x8b\xe8\x9b\x17\x57\xca\xd8\xb4\x3b\x00\x01\xdd\xb3\x03\x15\x96\x48\x6a\x55\x07\xac\x06\x6d\x33\x97\x05\xa9\x65\xc4\x82\x60\x08\x41\x09\xa5\x0f\x1e\xa2\x6f\x8d\xec\x7c\xce\x71\xfa\x35\xce\x30\x4b\x48\x01\x11\x36\x9a\xb4\xad\xe9\x2a\xb6\xa6\x42\xeb\x62\x05\x5a\x46\x39\x20\x64\xb9\x19\x9b\xc1\xb6\xf7\x0f\xc3\x82\xa8\x66\x91\xb6\xc4\x40\x90\x0e\x55\x1b\xe0\x97\x15\xec\x2b\x03\x5a\x2d\xc1\x87\x5a\xd3\x96\xb0\x05\x84\xb0\xa3\x33\x8c\xf6\x6c\x81\xea\x2c\xd5\xf1\xb6\xd3\x5b\xad\xc1\x5a\x87\x18\xfc\x15\x0d\xa5\x70\xf4\x85\x0f\xe3\x5d\xbd\x6d\x30\x05\xfd\xac\xec\x61\x20\x86\xa1\x95\xa6\x08\x65\x29\xbd\xa1\x69\xd9\x6a\x9a\x29\x2b\x02\x09\x89\x65\x29\x20\xb4\xc5\x95\x9d\x0c\x84\x01\xc9\x53\x08\x25\xa9\xd0\xed\x8a\x14\x04\x60\x49\x5c\x2c\x89\xac\xf7\x5e\xbe\x79\x71\xd5\x02\xd4\x52\x1d\x30\x33\x23\x5c\x83\x06\x02\x1f\x94\xd1\x7f\x95\x04\xe1\x35\x07\x92\x66\xd9\x6e\x04\x4f\xb4\xaa\x75\x96\xa2\x64\x37\x5c\xaa\x75\x99\x09\x82\x18\xd2\x28\xef\xc2\x70\x6e\xac\xb9\xa1\xb1\x79\x38\x31\x7a\xb1\xa5\x97\x18\xe4\x65\xac\x31\x6e\x11\x6b\x81\xf5\xeb\x67\xa4\xae\x85\xe8\x7f\xd3\xe5\x6a\x33\xa1\xee\xaa\x1c\x04\x5d\x2e\x0b\x56\x51\x43\x4b\x2e\xb3\x0f\x24\xc6\x46\x5b\xa3\x8c\xe3\x14\xcd\xcd\x0a\xac\x54\x01\x28\xce\xb6\x29\x20\x32\xb1\x04\xd7\xd6\x57\xc3\x80\x05\xe5\x87\xc1\x4e\xe0\x0c\xa4\x73\x81\x85\x2c\x4a\x15\xfa\xdf\x7b\x0e\x14\x2f\xc6\x76\x73\x49\x09\x8d\xb3\x9f\xaf\xa6\xb6\x01\x6a\x13\x1c\xe8\x10\xe4\x3a\x7a\x66\xf8\xb2\x05\xae\xda\xa8\x1d\xa1\xb3\xdf\xcb\x82\x4c\xfb\x43\xb2\xef\x7d\x77\x7e\xb9\x3b\x93\x08\xcd\xd0\xfe\xa0\x2e\xbe\xfe\xe1\x1c\xb6\xec\xa7\xbe\xf7\x5a\xfa\x69\x87\x74\x96\xd1\x39\x9e\x63\x03\xad\x1b\x83\x16\xc0\x06\xd2\x73\x3a\xbf\xa1\x85\x34\x84\xf9\x9e\x0b\xf9\xc2\xec\x5d\x02\xf5\xd9\xc6\x28\xbf\x6a\xc7\x0f\x96\x02\x98\x6c\x2a\x31\xa0\x4d\x9d\x21\xf4\x82\x33\x32\xaa\xf8\x43\x72\x17\xaa\x62\xa4\x00\xe7\xeb\xa5\xde\x02\xd4\x59\xfc\xfb\x9a\x1d\xab\xba\xfb\xb6\xc7\x5b\xeb\xd2\x69\x8d\x56\x3c\x4b\x05\xca\x71\x81\xd7\x44\x92\xa2\xde\x00\x75\x46\xd4\x0a\x31\xac\xbd\x62\x74\xa9\x77\x98\x74\xa0\x81\x2e\x94\x81\x00\xab\xde\xa5\x54\x2b\xe0\xf0\x48\xe1\x0b\xfb\x45\x97\xbc\xdd\xef\xb2\xc3\x55\xe2\x72\x8a\x9e\x1e\x05\xda\xb8\x24\x31\x20\x6d\xf4\x2c\x87\xbf\x39\x53\x62\x00\xc8\x65\xb6\x32\x74\xb0\xde\x0b\x12\xb9\x91\x48\x88\xaa\x12\xa6\x02\x29\xe0\x48\x38\x91\xf7\x3a\x0e\x54\x07\x30\x03\x30\x05\x91\xa0\xa4\x98\x46\xea\xe9\x11\xe0\x88\xb3\x82\xe0\x14\xbc\x25\x30\x86\x63\x47\x43\x1a\x88\xed\x2c\x66\x3f\x09\xa7\x2c\x81\x08\x55\xe5\x50\xbd\xe0\x29\x01\x9a\xa2\x02\xb3\x65\x65\x9a\x1a\x51\x66\xbc\xf2\xdd\x2d\x2a\xf7\x53\x29\xe8\x23\x08\xd9\xa8\x10\xbe\xf5\xd4\x29\x01\x13\xc0\xce\x8a\x12\xdb\x4f\x8f\xda\x67\xdf\x24\x1b\xac\xb1\x84\x9c\x14\xf9\xe8\xc4\xdb\x6a\x8d\xef\x60\x6f\x7c\x8a\x9e\x3c\x7e\xfc\xe8\xb1\xbf\x99\xde\x42\x9f\x22\x3f\x27\x00\x6b\xaa\xde\xc8\x92\x14\x01\x9e\x13\x3d\x99\xee\xf4\xf4\x51\x7f\xae\x13\x7f\x2d\xdb\x5d\x85\xf8\x2e\x0c\xb1\xc9\x77\xa7\xa7\x8f\xfa\x30\x5e\x00\x24\x18\x38\xf7\x62\xbc\x00\xcc\x2a\x52\xda\x9b\xf1\x4e\x4f\x1f\xfd\xe7\xe2\xbc\xbc\xe0\x92\x27\x3c\x9b\xf6\xe3\xa9\xa1\x6d\xef\x09\x6c\xef\xd8\xa4\x5e\xb0\x6a\xb3\x52\x6d\x70\x51\x96\xf0\x35\x88\xf7\xda\xa3\x57\xb4\x04\xff\x3d\xcf\xc0\x49\xb9\x3e\x57\x81\x5a\xbf\xff\x6e\x3f\xad\x28\x54\x1d\x5d\xbe\xfc\xf1\x97\xff\x55\x8d\x58\x69\xd2\xe6\x4f\x01\xc8\xee\x36\xb3\x12\x6b\x0d\x13\xd0\xee\xb6\x96\xb9\x92\xa2\x54\x02\xfe\x26\xcb\x20\xb0\x69\x67\x51\x36\x29\x15\x38\x4d\xc1\x3c\x25\x02\xf8\xd4\x86\x5c\x1c\x9a\xa8\xe5\xb2\xe0\xc5\x2d\x2e\x3c\x96\x83\xfd\xc8\x15\xa4\x6a\x6d\xd1\xb3\x95\x38\x31\x42\x7f\x57\x4e\x9a\xa2\x44\x00\xa4\xa5\x91\x31\x81\x85\x87\xd6\x2a\xc6\xa4\x88\xa0\x71\xd0\x43\x0b\xc0\xad\x07\x0d\xdb\xd2\x90\x25\xa6\x76\xb9\x01\x84\xdd\xc4\x6b\x90\xfa\x40\xd8\x57\x02\x40\x29\x53\xe2\x09\xad\x08\x4e\xc1\xec\x00\x2f\x31\xe3\x4b\xd8\x26\xa9\x76\x34\xf4\x40\xaa\xa1\xf7\x61\x02\xce\x3c\xa3\x56\xee\x69\x82\xcb\x5d\xa2\x43\x88\x2b\x84\xe8\x02\x6d\x78\xa9\x02\x75\x20\x57\xac\xbf\xdc\x18\xb1\xc6\x56\x39\x99\xbd\x31\x85\x9e\x35\xa3\xf4\xe5\x02\x70\x91\x41\x63\x4c\x27\x93\xdb\xdb\xdb\x78\x85\xf3\x82\xdf\x6d\x62\x5e\x2c\x27\x29\xbf\x65\x80\xd1\xe4\x24\x3e\x99\xa4\x3c\x99\xa8\x47\x91\x45\x23\x96\xde\x2d\x16\x23\xf5\xc0\xaf\x51\xb2\x0f\xa2\x85\x78\xce\x4b\xb9\x45\xf3\x2a\x6c\x57\xc7\xc3\x95\xdc\x15\x03\x2f\x58\x45\x33\x37\xa4\x6c\xb5\x8f\x89\x95\x80\xea\x50\xee\xad\x71\x3b\x63\xf4\x10\xcd\x86\xd7\xe7\x97\xb3\xa1\x5f\x42\x23\xd5\x48\xe1\x66\x36\xc0\xb6\xc1\x38\x72\xcf\x71\xe2\xc6\x03\x2f\x40\xa3\x64\xa9\x50\x52\x0c\x44\x47\xdb\xf0\x1d\xb6\x74\xc0\x06\xa0\x52\x15\xf2\x80\x90\x17\x4c\x6a\xb2\x02\xf5\xd6\xe2\x78\x77\x6f\x5b\x05\xb7\xae\xe0\x7f\x11\x20\x1e\x78\x1a\x16\x1c\xc1\xc8\x9d\xb1\x40\x25\x96\x7d\x8d\x9f\xe3\x4f\x1f\x3d\xe9\xa7\xa9\x2a\xa8\x01\xeb\x47\x85\x66\x60\x77\x06\xda\x7a\xc1\xa2\x3a\x5d\x4d\x05\xb3\x0b\xc5\x7a\xc6\x2d\x21\xe9\xae\x95\xd2\xb0\x45\x02\x60\xfb\x5a\x29\xb0\xe9\xc0\xea\xc8\x11\x2c\xc5\x00\xd4\x4a\x6c\x54\x69\x05\x25\x6c\x3d\x84\x24\x02\x15\xbb\x22\x21\xc4\xd2\x3b\x42\xd9\x0e\x1c\x04\xa0\x02\xa7\x88\x0d\xeb\x72\x45\x70\x26\x57\x66\x2f\x38\xb4\xf0\xae\x77\x60\x82\xca\x15\x84\xa5\x5a\x94\xe7\x05\x87\xac\x6d\xce\x9c\x0e\x40\x9a\x04\x40\x2e\xe9\x0d\x61\x08\xd2\xeb\xc7\xb5\x45\x98\x63\xb9\x42\x13\x8d\xd6\xef\x13\x6d\x6e\x42\x5e\x32\xf8\xa7\x6b\xca\x08\xa2\x8b\x00\xc8\xf6\xd8\x2e\xb0\x58\x05\xa9\x20\x09\xa1\x37\xa4\x4a\xae\xe6\x5d\xae\x0d\x20\x18\xab\xd8\xae\x76\x4e\x4d\xe6\x3e\xe5\xac\x85\xd0\x2a\x1a\x08\xfc\x65\x26\x33\x84\x6b\x9d\xdd\x8d\x15\x15\x50\xc9\x24\xcd\x00\x9b\xc6\xb4\x18\x6d\x6b\x86\xb0\xdf\x1c\x99\x79\xc7\x99\xe0\x48\x48\x9e\x5b\xb4\x40\x82\x5b\xd7\xc0\xa4\x23\x07\xe0\x62\x6b\xf4\x82\xe1\x73\xfa\x18\xf6\x80\x39\x4b\x05\xc2\x0b\x48\x2a\xdb\x9a\x2c\x21\x71\x21\x45\x6d\x22\x04\xe0\x32\x2e\x23\x3d\x28\x74\x59\xf0\x39\x20\x05\x49\xcc\x1b\xf4\x18\x88\x72\x7c\x64\x3b\x32\xec\x81\xd1\xe3\x48\xff\x12\x80\x29\xe9\x9a\x80\x04\xc7\xcc\x18\x82\x18\xc9\x55\x41\x04\x84\x3a\x00\x7d\x79\xcb\x91\x28\x93\x84\x08\x01\xbb\x1d\xbc\x50\x26\x80\x67\xef\xbf\xde\xc5\x37\xa9\xad\x68\x4e\x12\xbe\xb6\xf3\xb3\x01\xab\xb7\x64\xf6\x1f\x05\x01\xe5\x23\xe9\x0d\xc9\x36\x63\x10\x42\x01\x98\xb7\x24\xcb\x22\xc8\x66\x76\xb2\x92\xad\x9b\xdd\xe1\xd3\x04\xa0\x6e\x79\x3b\x20\x8d\xff\x33\xb9\x3b\xc1\x9d\x22\x84\x32\x27\x0c\x38\x1d\x74\x6a\x18\xb7\xb9\x3f\xd2\xd5\x58\x2f\x3e\x62\xf9\xc2\x59\x2d\x81\xc9\xfb\x86\xb5\x6c\x8e\xa9\xb2\xc7\x5f\x82\xb9\x20\xa6\xfd\x34\xe9\xb0\xe5\x55\xc7\x0c\xc2\x0c\x76\x23\xf8\xad\xc9\x50\xf5\xc2\x44\xe8\xe2\xd2\x9a\xee\xda\x1d\x57\x3c\xa4\xcd\x23\xc8\x4d\x12\x46\x1b\xb5\xcb\x98\x00\x5c\x38\xe5\x41\xc0\xc4\x48\x24\xa8\x63\xf4\x0c\x8e\xb2\xa8\x1e\xaa\xcd\x93\x8a\xb9\x8d\x89\x7d\x7e\xf1\xcd\xcb\x00\x44\x7b\x22\x0d\x1d\x92\x78\x19\xa3\xd9\xf0\xf8\x28\x56\xdf\xc9\x53\x9b\x28\xb5\x48\x8f\x8e\xa6\x53\xf8\xf7\x48\x69\x68\xc6\xf5\xa0\x02\x50\x69\x9d\xd2\x9b\x8e\xd1\x6c\x68\x61\x1e\xcd\x86\x20\x37\xd1\xc5\xe5\xcd\xa9\xda\x14\x9c\x0d\xa7\x53\xf7\x57\xbf\xc9\x83\xaa\x13\x24\xb0\xf3\x68\xd6\xa6\x4d\x8d\x56\xd3\x02\x41\x95\x6c\xc7\xc3\xf4\x31\xa2\x4d\x59\xe1\xea\x20\x4e\x46\x25\x6c\x9c\xaf\xa9\xd9\xdd\xd0\x86\x10\xc1\x45\x46\x49\x61\x8f\x68\x28\x6f\xb5\xda\xeb\x0e\xc0\x55\xd6\x6d\x4a\xb5\x79\xb4\xc2\xa0\x22\x57\xa4\x8d\x2f\xb5\x1d\x3f\x56\x9e\xd1\x1a\x6f\x3a\x82\x97\xce\x99\xa5\x78\x4e\x24\x8e\x9b\x9b\xda\xc0\x4b\x91\xe5\xa5\x48\xd3\x21\x52\x33\x15\x74\x2e\xaa\x43\x89\xd6\x64\x9c\x0d\xb5\xf1\x17\x7d\x6e\xf4\xbf\xa3\xfe\x61\x0b\xf8\xcb\xd9\xd0\xa2\x11\x80\x6b\xd2\x7e\x66\xc3\x6a\x4f\x27\x32\xe0\x66\xc3\x3a\x99\x73\x6c\xf2\x25\xa8\x70\x10\x09\x40\x55\xee\xa7\xc4\x6f\x08\x22\x8b\x05\xb8\x04\x74\xd1\x4a\x57\x9b\x41\x0d\x63\xea\x33\x63\xa7\xf1\xf1\x49\xc0\xa9\x08\x26\x11\xef\x88\x11\x58\x72\x20\xd3\x30\xdb\x11\x04\x10\x68\x56\x8f\x7b\x8c\x15\xa1\x43\x58\x2b\xe4\x0e\xaf\x73\x38\x64\xe5\x2c\x4d\xb5\x32\xab\x85\x39\x1c\x85\x98\xdc\xe4\x73\x4f\xd1\xe1\xaf\x87\x87\xaf\x8e\xa2\x4f\x5f\xbf\x55\xff\xaf\xfe\xef\xed\xb1\xf3\xf7\xc9\xab\xa3\xe8\xd4\xfe\xfd\xf8\xd5\x51\xf4\xf8\xf5\x68\x16\x8f\xfe\x78\xf4\xe7\xfe\xef\x4d\xec\x2b\xc7\x27\xe6\xc9\xa3\x57\x47\xd1\xc9\xeb\xd1\xdf\x46\x6f\x0f\x7f\x15\x0f\x0f\x35\x2e\x67\xd1\xb7\x38\x5a\xbc\xfe\xe3\x78\x7c\xfa\xe7\x74\xf4\xc7\x27\x7f\xee\xfc\xfa\x76\x3a\x1a\xbd\x6d\x6d\xfc\xe4\xcf\xc3\xe9\x4e\xeb\xc3\x43\x83\x81\xc1\x2a\x7d\x7b\x9c\xa6\x6f\x5f\x1d\x47\x9f\xbe\xfe\x2a\x1d\x1d\xc6\xc1\xc7\x30\xd4\x91\xbf\xc3\xc7\x7f\x1e\x1e\xee\x76\x39\xfa\xe3\x78\x7c\xf2\xe7\xe8\xed\xf4\xaf\xec\xfa\xd4\xdb\x35\x60\xdc\xf6\xe8\xab\xf7\x80\x4f\x00\xa1\x47\x5e\x84\x4e\x3d\x08\xfd\x71\x34\x3e\xf9\xf3\xaf\x45\xea\xc4\x8b\xd4\x63\x3f\x52\x8f\xfe\x62\xa4\x8e\xbd\x48\x3d\xf1\x23\x75\xfa\x1e\x91\x9a\xfa\xfa\xff\xc4\xdf\xff\xe3\xf7\xd6\xff\xe8\xf0\x41\xfc\xf1\xe8\x2b\xf1\xf0\x70\x36\x39\x3c\x06\x50\x4f\xb5\xf4\x38\x36\x72\x41\x41\x34\x7f\xc2\xff\x8f\x46\x7f\x1b\x0d\xbc\xd2\xac\x4f\x98\x05\x21\x56\x66\x19\x24\x1b\x4e\x21\xad\xd1\xaf\xa5\xba\x4e\x77\x20\x94\x32\xf1\x83\x3a\x3b\x0d\x47\x8c\x43\x39\xf2\x8d\xd8\x8d\x7e\x25\x1d\xf4\xd2\x16\x07\x2d\x7d\xa8\x7c\x1c\x08\xa7\x0b\x65\x23\x43\xc0\x80\x2e\x48\xb2\x49\xb2\x90\xca\x35\xd1\x94\x2a\x69\xb3\x4e\x6e\x42\x58\x08\x9e\xd0\x2a\x87\x6f\xd7\xf6\x0c\x80\x35\xba\xbe\xca\x4c\xb2\x87\xc9\xb7\x12\xc0\xaa\x53\xf8\xe8\xa2\xf6\x9d\x02\x60\x25\xb7\x74\x8a\xd1\x4f\x2a\xba\x69\x52\xf5\x71\x41\x2a\x12\x2a\xf3\xe0\xef\xcc\x74\x19\x1f\xdc\x3b\xec\x67\xe0\x05\x5a\x54\xbd\x0c\xde\x81\xf5\xcc\x89\x85\xe2\xb2\x72\x8f\xa6\xfd\xb8\x60\xb8\xfb\xa6\xf1\xb4\xec\x21\xc7\xbe\xd3\xe5\x46\xa0\x8d\x11\x9e\x58\x77\xa3\x64\x29\x29\x32\x38\x7a\xb8\x95\x80\x53\x21\xde\x61\x2e\xb7\x64\x52\x59\xb6\xc0\x39\x24\xf2\x9a\xf3\xdd\x55\xc7\xbb\xc3\x0a\x74\xa0\xac\x61\xed\x50\x9a\x23\xe0\x26\x86\xae\x13\xd4\x6d\x97\x01\x43\xad\xdb\x3b\x84\x2f\xbe\x0d\x3e\xde\x9e\x1a\x7c\x2b\x2c\x7d\xea\x40\xa3\x21\xb0\x3d\xe6\x19\x38\x39\x62\xbf\xb8\x70\xe8\x22\x39\x3a\xfb\xf9\xaa\x39\xa9\xc2\x93\xac\xd6\x01\x37\x3c\x01\x80\xfc\x2e\x5d\xbb\x50\xdd\x8f\xea\xfd\x29\x0f\xdf\x24\xc3\x42\xd0\xc4\xf5\xf8\xbb\x5e\xd9\x9a\x91\x16\x08\x3b\xcb\xa6\x31\x4f\x9d\xe0\xd1\x76\x40\x03\x33\x35\x3f\xa6\xa7\xe6\x3c\x55\x49\x39\x3d\xc0\x6e\xc7\x39\xce\x35\xc0\x78\xd0\x89\x4f\x6f\x7a\x9a\xac\x3e\x13\xe8\xbe\x48\x33\x72\xad\x23\x77\x7d\x5e\xdc\xa6\x6c\x1b\x1c\xcb\x4c\x7a\x27\xa8\x17\xd0\x2a\xda\xa5\xa2\x88\x70\x40\x95\x72\x93\x17\xdf\x48\xa8\x87\xd3\xf2\xc1\xa4\x1d\xf7\x4b\xe1\xbc\xe2\x9c\x2c\xb8\xd9\xcc\x68\x4c\x0a\x4a\x32\x2e\x76\xcf\x84\x86\x8e\xe7\x6e\x7f\x94\xfe\xa9\x02\x29\x39\x2e\x04\x01\x23\x42\x97\xe3\x50\xe3\x48\xed\xba\x57\x4d\x3f\xeb\x09\x57\x10\x82\x3e\xb7\x89\xe8\xf9\x9b\x65\xbc\xe4\x71\x4a\x6e\x26\x00\xf2\xa3\x4b\xe8\xe6\x1b\x03\xf7\xcb\x18\xa1\xb3\x41\x10\x58\xf5\x65\x54\xc5\x5c\x7f\x27\x05\xb7\x98\xab\x33\x0c\x8c\x23\x9e\x53\x46\x39\x1b\x83\xb7\xa9\x02\x24\x3d\x41\x26\x50\x31\x03\xdb\x15\x6f\xa0\x9a\x0c\x53\x4d\xc9\xe6\xb3\x9e\x60\x9b\xbb\x96\xb0\x0a\x9e\x1c\x55\xe7\xf8\x2c\xc0\x7a\x9b\xaf\x27\xd4\x7a\x33\xb0\xd7\x0b\x36\x46\x6b\xa7\xb0\xd7\x4b\xbd\x8c\xcd\x9e\x31\x57\xf7\x6b\xd2\x6c\xdf\x41\x04\xb6\x40\xf8\x8b\x44\xa0\xe9\xe9\xfd\x89\xc0\x17\xcf\xbf\x8e\xdf\x27\x31\x55\xd3\xfd\xa8\x37\xb4\xb8\x80\xb4\x50\x7f\xf3\xc5\xae\x3e\xee\x84\x89\xc0\xae\x82\x64\x0e\xcc\x24\x18\xd7\x96\x6c\x3b\x71\x33\xa5\xd8\x95\x91\xdb\x03\xa6\x6b\x06\xab\x0d\xfe\xd9\xd0\x68\x8d\xd9\x70\x8a\xce\xac\x0a\x51\xd9\xc4\xe8\xeb\x3d\x70\x85\xa8\xe4\x1a\xbf\x21\x42\x1d\xc1\x02\x23\x30\x25\x89\x2a\x26\x24\x10\x96\x88\xd0\x2a\xb9\x49\x16\x98\x09\xd8\x78\xec\x01\x36\xc3\x1b\x52\xa0\xc3\xeb\xf3\xcb\xc9\xd5\xd5\xf3\x11\x32\xbb\x01\xca\x1a\x31\xe5\x3a\x4c\x13\x75\x0e\x0c\xfe\xef\x6a\x14\xf7\x00\x0c\x79\x1a\xcd\x93\x83\x8a\xbc\x69\xaa\x2a\xab\xe1\xcc\xda\x32\x53\xb7\xda\x11\x9c\xec\x89\xf1\xad\x88\xf1\x1a\xff\xce\x99\x2a\x9c\x76\xa6\xfe\x7c\x76\x7e\x35\xd1\x65\x6f\x26\x55\x09\xb2\x65\x49\x53\xb2\x15\x38\x05\x76\x10\xf1\x4a\xae\xb3\x8f\x92\x6c\xde\x03\x4f\x33\x47\x2f\x9e\x7f\xad\xe7\xc7\x64\xe5\x36\xe7\xc7\xa1\x7e\x0f\x90\xad\xf3\xd3\x98\x98\x6d\xb2\xff\xe7\x20\x28\xcb\xe6\x61\x2b\xb2\xdb\x95\xb3\x9f\xc8\xae\x83\x1e\x2d\x5f\x3c\xff\x7a\xf0\xde\x44\xbd\xff\x24\xb2\xfb\x89\x14\xc0\xc1\x7b\x10\x71\xcb\x24\x9f\x0e\xfa\x4b\xb6\x65\x92\xff\x15\xce\xca\x77\xe7\x97\xff\x0e\xce\x0a\x20\xff\xff\x9a\xb3\x02\xc9\x89\x67\x6a\xd3\x6e\x5f\x25\xe3\xbe\x6b\x1e\x41\x62\xc7\x8a\xdf\xda\x9c\x47\xbd\x19\xd8\x09\x16\x81\xaa\xaa\x37\xfe\x4c\xa2\x99\x93\x24\xb3\x35\x2b\xf7\xd1\x34\x90\x0e\xf6\x5d\xc6\xe7\x50\x10\x61\x8a\xae\x94\xc5\xaf\x02\x05\x98\x55\x7d\xf5\x00\xd9\xc0\x46\xc7\x9a\x34\xd4\xe6\x90\xed\x8e\x9d\x39\xd8\xdb\x03\xb0\xda\x90\xc3\x0c\x72\x03\x96\xc0\xcc\xa6\xf2\x1b\xc8\xb3\x9f\x2e\xcf\xb7\xb2\x50\x7d\x07\x55\xb7\x3f\x3b\x5e\x44\xa3\x72\x9e\x2a\x9a\x19\x2f\x39\x5f\x66\x44\xc9\x3d\xe7\x80\x25\x61\x4b\xca\x88\x92\x8e\x93\x15\xbf\x8d\x24\x9f\x58\x2a\x45\x8e\xf8\xa3\x6c\xf9\xd1\x52\x8d\xff\xb7\xde\x73\x6d\xf4\xc9\x73\x9e\x84\xe6\xa2\x89\x77\x0f\xb0\x40\x12\xa4\x80\x6e\x4d\x85\x3e\xfa\xac\xac\x34\x7b\xce\xba\x33\x8f\xc5\x7e\x81\x80\x02\x8e\x85\x9a\x59\x51\x27\xda\x7f\xba\x3c\x1f\x81\xd3\x04\x0f\x77\xe4\x46\x0f\xa0\x90\x0e\xb5\x3d\x99\x6d\x53\x55\x65\x12\xf6\x42\x94\x0a\x6b\xf0\x19\x21\x51\x95\x0d\x0b\x4f\x7a\x73\x36\xf5\x8c\xdb\x49\xf8\x48\x13\xcc\xcc\xed\xfb\x53\x6d\x7a\xc9\xf4\x68\xa8\xe6\xf3\xfd\xe9\xb6\x9e\x2a\xa9\xdb\xe2\x6e\x08\x42\xd7\xd8\xee\x8e\x40\x0e\xfa\x38\x93\x3b\xbc\x70\x06\xf2\xa4\xca\x00\x02\x91\x86\x86\x67\x3f\x5f\x0d\xc7\x68\xa8\x8e\xf3\x0d\x43\x59\x7e\xf0\x1d\x7e\x8d\x0b\xf2\x03\x91\x38\x83\x77\xbe\x3b\xbf\x84\xff\xbc\x28\x25\x66\xf4\x0e\xfe\x54\xbb\xc8\x12\x27\x6f\x86\x7a\xcf\x7a\xf8\xd3\x55\x0e\x27\x5e\x83\x39\xb6\x7d\x66\x3c\x02\xc7\xa3\xab\x05\x0c\xa1\xa3\x4d\x85\x7f\x47\xbb\xef\x02\x39\xae\xc6\x5c\xd2\xa3\xee\x68\x55\x11\xa4\xa3\x9d\xa1\x53\x47\xab\x8b\xaf\x7f\x18\xbc\x33\x0b\x77\x9b\x66\x1d\x66\x59\x0f\xfe\x57\x87\x21\xa7\x83\x5e\x8c\xaf\xda\x3a\x7b\x39\xb0\x00\xf4\x6f\xd8\x1e\x49\xda\x61\x65\x2f\x64\x65\x04\xe8\xd2\xb4\x69\x8c\x2e\x4d\xed\xd5\x06\xc3\xdb\x33\x9c\x43\xcd\xa1\x17\x46\x4a\x05\x58\x34\xcc\x9e\x11\xb2\x20\x02\x4d\x02\x07\x47\x7b\x4e\x5d\x78\xda\xa2\xb6\x4d\x38\x4f\x4b\x45\xdc\xc1\x3d\x66\x96\x99\xbc\xe4\xe9\xa0\x73\x52\x6d\x53\x13\x64\xd9\x0a\x9a\xb8\x49\xce\x26\x8f\xed\xbd\x1e\xfb\xdc\x82\x1d\x0f\xee\x67\xde\xda\x7c\xfc\x9e\x8c\xfc\xe1\x30\xd4\x87\xc3\x50\x1f\x0e\x43\x7d\x38\x0c\xf5\xe1\x30\xd4\x87\xc3\x50\xef\xe7\x30\x54\x87\x3e\xce\x0b\x7a\xd3\x5a\x69\x6f\x47\x35\x99\x96\x7e\x6d\x7c\x69\x1a\x58\x7d\xdb\x0a\x12\xed\xa7\x85\x0d\xcc\x0f\xda\xf7\x83\xf6\xfd\xa0\x7d\x3f\x68\xdf\x0f\xda\xf7\x83\xf6\xfd\xff\x49\xfb\xfa\xe3\x7b\x4d\xbd\x64\xd5\x21\x50\xaf\x45\x7f\x1a\x7d\xd2\x4c\xef\x6c\x05\x8b\x9c\xdd\x6e\x37\xa5\xc1\x78\xb9\xf0\xc0\x54\x80\x22\xa2\x91\x74\x5a\x6f\xb1\x7b\xe0\xda\xc5\xed\x5c\x4e\xe4\x76\x80\xac\x1f\x0d\x5d\x5c\x98\x13\xb7\x8d\x5d\x33\x1f\x97\xb5\x63\xe1\x5e\x48\xa4\x4c\x77\x53\x02\xb7\x2e\xad\x06\xab\xc2\x03\xf2\xac\x15\xb5\xad\x8b\x8d\x0c\x9d\x0d\xc7\xda\xce\x7c\x20\x67\x0c\x64\x4c\xbf\x32\x98\x6d\x97\x01\x99\xdf\x26\x1f\x81\x28\xb2\xb2\xd1\xdf\xd7\xc5\xc2\xd6\xf2\x83\xa5\x42\xe4\x18\xe1\xd6\x74\x64\xbb\x0b\x68\x32\x6e\xdb\xeb\xaa\xc2\x57\x47\x48\xcc\x02\x6d\xa3\xce\x81\xa8\x8f\x8b\xc3\xa9\xa2\xd8\x2d\xe9\xe8\x01\x8a\x8b\xba\x67\x6d\xd9\x31\xf5\xd6\xef\x1c\xee\xe4\xd1\x77\xf3\xa8\xf4\xe6\x94\x89\x3d\x2b\xd3\xd5\x15\x02\xd5\x3c\x25\xff\x80\x1b\x15\x40\x11\x9a\x1f\xb5\xdd\x08\xbf\x2a\xe9\xfb\xb3\x4b\x9b\x75\x15\xe3\xf2\x00\x06\x46\x28\x0b\x28\x71\x9a\x6d\xac\xd9\x63\x87\x60\xb4\xe4\xd9\xcf\x57\x63\x1d\x2d\xd6\x51\x6a\xd8\x7e\xb1\x05\xd9\x44\xec\x9f\xb8\x87\x6e\x3d\xb1\x3e\xcb\x0d\xd4\x32\x44\xc2\x3c\x20\x01\x35\xe1\xd4\x1d\x68\x01\xa0\x72\xbf\x80\x7f\x49\xfa\x97\xad\x3e\x55\xfd\xa0\x66\xe7\x31\x9a\xf3\xd2\x7b\xe6\x59\x72\x7d\x76\x5c\xe3\xfe\xf4\x48\x11\x10\xca\x1c\x29\xb5\x06\x95\x2e\xcd\x36\x6c\xce\xcd\x6d\x57\xa0\x03\x2d\xba\x7e\xdb\x70\xbb\xa2\x81\x63\x63\xb4\x16\x6d\x74\x06\xe4\x81\x78\x43\xe1\x28\x36\x71\xd0\x35\x05\x3e\xad\xaf\x33\x63\xe8\x1b\x4e\xf4\x69\xe7\x96\x1e\x3c\x60\xeb\x7e\x03\x13\xb2\x1f\xa6\x7d\xc4\xe1\xd8\x96\x87\x05\x6c\xc9\x1d\xa4\x0d\x51\x99\x6d\xc2\x7e\x9a\xad\x46\xa1\xa6\xc5\x5c\x55\xc2\x4a\x55\xaf\x38\x4c\xd2\xe0\x22\xd8\x8a\xae\xf6\x5a\x08\x4a\xc3\x78\x80\x36\xf4\x8e\x05\x6e\x45\x57\x07\x91\x3d\x20\x7b\x70\x7d\xab\xce\x41\x67\x55\x5c\xda\x03\x79\x2f\x7d\xa3\xd6\x84\x31\xcb\x92\x6e\x49\x00\x22\x37\xdd\x30\xbc\x36\x35\xa5\x61\xfb\x1f\x7c\x34\x25\x66\xab\x03\x9a\x9f\xa1\x15\xbf\x85\xba\x05\x63\xe8\xd9\x48\x38\x0f\x5c\xa8\x7a\x02\xe7\x0b\xc0\xeb\x31\xd0\xc0\xfc\x1f\x03\x9f\x15\xc6\x36\xab\x6c\xef\x6a\xa1\x04\xaf\x8d\x30\xe7\x67\xac\x6a\xd8\x9e\x2f\x1d\xee\x53\x77\xb2\x15\x37\xad\xc5\x25\xc3\x46\x5f\xd4\x50\xef\x06\xa8\xa7\xa5\x23\x8d\x3d\x2d\xcc\x52\xf7\x3c\xb5\xb8\x87\x7a\x09\x5a\x89\xfe\x3d\x19\xcf\x16\x5a\xc0\xa4\x04\xe3\x03\xae\xf1\x33\x77\x20\x4d\x07\x41\xab\x72\xab\x75\x75\x57\x1e\x46\xb9\x3e\x1b\x65\x4b\xf2\x55\x65\x3f\x92\xba\x75\xdb\xea\xfe\xda\x39\x2e\x0e\x31\x13\xc5\xe1\x8c\x6b\x67\x35\xf8\x6e\x38\x92\xb3\xa6\x6b\x72\xbd\xc9\xdb\x1f\x6e\x9b\xca\x55\x63\x44\xdd\x4b\xae\x7e\xb8\xf8\xe1\x99\xa2\xa7\x0d\x66\xe8\x2a\x23\x70\x66\xbc\x15\x28\x72\x11\xae\x93\xa8\xae\xab\xab\xa3\x4c\xc8\xc4\x24\x65\xd9\xfc\x6f\x9d\xd9\xbd\x9f\x50\xb1\xfa\x03\x3a\xd9\x34\xe8\x64\xaf\x4b\x99\x82\x5f\xef\x81\x08\x47\xf1\x9d\xc1\xcd\x09\x23\x0b\x2a\xf5\xb1\x7a\x07\xd6\x18\xcd\x4b\x89\xbe\x3f\xbb\x04\xe7\x56\x2f\x30\x21\x69\xe6\xf3\xb2\x21\xd2\x5e\x5d\x09\xa8\x16\x77\xb1\x31\x51\x1f\x85\x1d\xb8\xf6\xb0\x9f\x00\x39\x05\x4a\x7c\xc5\x08\x7d\x17\x28\xb1\x6f\x2e\xa1\xc3\x20\x21\xc7\x08\x6e\x50\x41\x87\x90\xf9\x39\x46\x89\x10\x63\xf4\x4f\xa8\x83\x2c\x93\x78\x64\x2a\x7c\x04\xc6\xe1\x01\x0e\xa3\xb3\xef\xaa\x09\x06\x41\x68\x6b\x3f\xda\xf7\x49\x8a\x0e\xe9\x1a\x2f\xe1\xca\xc5\x32\xa5\x7c\x8c\x20\x7b\x8f\xfb\x6c\x1f\x28\xb2\x3c\xaa\x30\xc9\xa8\x94\x19\x28\x26\x44\xee\xb4\xd4\xab\x22\x9f\x70\xbe\x40\xe9\xd6\x24\x2f\xc1\x85\xf6\x1a\x96\x9c\xd5\x63\x81\x8d\x08\xb8\x38\x2d\xae\x82\x13\xe0\x37\xfc\x93\x93\x15\x67\x92\xb3\x78\x4d\x52\x5a\xae\x55\x3a\x94\x5c\x91\x68\xf9\x3b\xcd\xa3\x9c\x30\x9c\xc9\x4d\x94\x3e\x3a\x9e\xa7\x4f\x3e\xfd\x64\x71\x8c\x4f\x86\x83\xbd\x0f\xd2\x37\x57\x8c\x23\x03\x80\x91\x60\xf1\x54\xb2\x00\x94\x91\x26\x2b\x2c\x22\x8c\x00\xed\xc0\xd1\xc8\x8a\x11\x63\xf4\x4c\x57\xb9\x80\xa9\x9e\x24\x42\x7c\x06\x9a\xa2\x10\x44\x7e\x51\xca\x45\xf4\x74\x36\x1c\xdb\x87\xc0\x06\xb3\x40\xd6\x88\x69\xf6\x50\xbf\xa2\xa6\x6f\x22\x6e\x96\x1f\xdf\xa9\xd7\xd0\x6c\xe8\x24\x40\x4f\x78\x22\x89\x8c\x84\x2c\x08\x5e\x87\x81\xfe\x12\x25\xea\x2a\xe9\x89\xfe\x8f\x28\xe7\x00\xcd\xd6\xd5\xbe\xae\xc7\x6d\x24\x85\x8e\xdd\x04\xec\x05\x84\x20\xe2\x44\x98\x8c\x6a\x0a\xaa\x04\x6a\x60\x99\x97\xdf\x9e\xa3\xe3\x47\xa7\xc7\xd3\x66\xa3\xe9\x17\xed\x32\xde\x7e\x66\xc3\xc9\x6c\x08\xa1\x10\x68\x85\x1e\xbe\x9a\x0d\x3f\x9b\x0d\xeb\xd8\xfb\x6b\xd8\x3c\xb0\x89\xf5\x94\x35\x60\x0f\x3a\x62\xbd\xe0\x2f\xf1\xc5\xd4\x4d\x1f\xaf\x56\x85\x59\x23\x6b\x22\x84\xfe\x43\x5d\x1e\x80\x8b\x40\x24\x08\xe6\xc8\x2e\x27\x88\x66\x63\xa4\xe9\xaa\x71\xcb\xa1\x82\x55\xaa\x6f\x2b\x01\xd2\xcf\x74\xe2\x84\xa6\x69\xe0\x20\xe8\x7c\x83\x30\x92\xfc\x0d\x5c\x62\xec\x38\x8c\x24\xe3\xb7\xb1\x1d\xbb\x7a\xea\xde\x35\x0a\x8c\xea\x5b\x80\xee\xfd\x7b\xc0\x90\x38\x91\x60\x04\x01\x32\x20\x82\x8d\x41\x07\x50\x6e\xe1\xba\x54\x64\x6a\x67\x18\x71\xed\x05\x5a\x81\x12\x7a\xf0\x6c\x63\x6d\x9c\xfa\x09\x32\x49\x94\x52\x19\x73\x38\x53\x7e\x7b\x9b\x1a\xb5\xda\xff\x7a\xbb\xb1\x45\x4f\x6c\x43\x3e\x1c\x7d\xfe\xe5\xff\x18\x7f\x36\x9d\xcd\x66\xc3\xc9\xab\xd7\x5f\xc5\x5f\x74\x80\xb5\x1c\xb5\xc5\x33\x4a\x65\x42\xdd\x2d\x43\x74\x4b\x63\x1e\xba\x4d\xaa\x71\xa0\x65\x22\x9c\x20\xa7\x74\x7a\x02\x71\x6c\xe7\x0f\x8b\xa9\x99\xb6\xd9\xf0\x8b\x59\xbb\xfc\x82\xef\xa1\x6e\x34\x41\xff\x2a\xb9\x24\x29\x2c\x6b\xca\x96\x23\x83\x54\xe3\xc7\xb1\xcb\x1f\x81\x84\x4a\x58\x87\x4f\x4f\x4e\xc6\x30\x52\x51\x16\x05\x78\xa9\x26\x04\xc1\x4b\xc8\xf2\x51\x50\x85\xbd\xe2\xc0\x52\x1c\x94\x7b\x60\x9f\x43\x71\x09\xca\xb3\x12\x5e\xdc\xd4\x33\x83\x9e\xfd\x72\xfe\xec\xf2\x1a\xcd\x66\x20\xba\x34\x9b\x9d\xbf\x54\xe7\xb6\x01\x38\x94\x38\xf3\xc2\xb4\x3d\x03\x40\x2d\x75\xd1\xd9\xd5\xf9\xc5\x85\x03\x1d\xeb\x8b\x93\x61\xe8\x54\x9d\xeb\x22\x22\xc1\x79\x78\x39\xcd\x66\xde\x38\x6a\x55\xbf\xe4\xd7\xc3\xaf\xe8\xe8\xf0\x2e\x7a\xf5\xeb\xeb\x57\xe8\x70\x34\x9b\x69\xee\x1a\x4e\xbe\x8a\xbf\x98\xdd\x1d\x1d\x45\xb3\xbb\xe3\x6f\x67\x77\x9f\x7c\xfb\xfa\xe3\xb7\x8e\xf8\x78\xab\xa4\xc7\x5b\x25\x3c\xde\x1a\xd9\xf1\xb6\x12\x1d\x6f\x41\x42\xbc\x55\x02\x62\x34\x01\xc8\x5e\x2c\xc3\x3d\x1e\x7e\x86\x1e\xf6\x40\xec\x8b\xc3\x77\xe8\xe3\xed\xf0\x70\x36\x7b\x65\x7e\xfc\xe4\xdb\xd7\x6f\x5f\xfd\x3a\xbb\x3b\xfa\x66\x38\x9b\xbd\x1e\x3d\x1c\x8e\x46\x0f\xff\x36\x78\xa7\x78\xf0\xfe\xd7\x5c\x0a\x22\xf7\x75\x04\x9e\x81\x65\xfa\xd2\xd4\x81\xf3\x55\x54\x30\xf6\xfa\x14\xbd\x24\x22\x6f\xab\x52\xd7\x34\x15\x3c\x70\xb7\x72\xee\x8d\xbd\x5f\x6d\xcc\xec\x00\x45\xd6\xfc\x9e\x13\xb4\xc2\x70\x3b\x66\x6a\xcb\x2f\xd4\xaf\xa9\x03\xad\x02\x6e\x59\xb7\x47\x51\xb1\x2d\x6b\xd7\x7e\x33\x96\xa9\x92\x98\xee\xe4\xa8\x36\x77\x50\x20\x02\xae\x6e\x29\x54\x03\x36\x9a\x68\x36\xbc\x58\x32\x5e\x90\xf6\xed\x93\x99\xbd\xec\xb5\x3a\x57\x09\xd2\x58\x72\x17\xcc\xd8\x17\xb6\x80\x7a\x93\x6d\xe8\x62\xb3\x8b\x77\x7a\xa4\x4a\xe6\x9d\x1e\x3d\xb5\x21\x2f\x32\x56\xc9\x34\xdb\xf4\x38\x84\x12\x4c\x2a\xff\x19\x1e\x2f\xdb\x59\x0c\xa4\x00\xec\xfc\x91\x74\xa4\xe5\x4d\xc2\x4b\x26\x77\x60\x19\x5d\x84\x73\xf0\xbc\x0a\x75\xa4\x6e\x4d\xa0\xdc\x99\xd8\x73\xfc\x96\x6c\xde\xe1\xeb\xd3\xc2\x2d\x40\xb7\x30\xb2\x7b\x47\x40\x2e\x50\x1e\xb8\x49\x8d\xa5\x55\x28\xf5\x2b\x6d\xc6\x88\xca\x85\x49\x0a\x55\xe1\x03\xde\xb0\x83\x6a\x39\x53\x0b\x02\xd3\x99\x3f\x15\x24\xba\xde\xe4\x3a\x6e\xd2\x06\x5b\xae\xc8\xd6\x0e\xa8\xaa\xab\xa8\x3c\x94\x46\xb8\x51\x1c\x98\x62\x8b\x55\x85\xd1\x02\xfd\x4c\xda\x4e\xb8\xd9\x7b\xd5\x0e\xc0\x6b\x48\xca\x0c\x43\x25\xc6\x46\x17\x87\xb3\x21\x18\x4f\xfa\x97\xd9\x70\x54\x69\x26\xa8\x40\x87\x17\xa4\xd5\xdb\xa2\x6a\x52\xa0\x6e\xdd\xf7\x55\x98\x47\x21\x5f\x95\x85\x84\xe3\xe0\xa0\x7e\xc0\x12\x54\x79\x55\x4a\x07\x9a\x28\x56\x0b\x44\x52\x14\xbc\x10\x9a\xa3\xa0\x2e\xa7\xae\xc6\xe0\xae\xaa\x06\x37\x28\xf8\x74\x9d\x93\x14\x88\x0e\xd7\xce\xb7\x1f\x8c\x05\x70\x29\xc5\x4b\xc6\x05\x15\x60\x32\x01\xc1\x32\xb2\x86\x09\xbb\x60\xd5\x89\xb9\x56\xf4\x2b\xcc\x5b\xe0\xce\x37\x3a\x08\x25\x12\xcc\xc4\xb6\x77\x6e\xb9\x49\xb9\xee\x4d\x98\x98\x82\xf5\x60\x51\x6e\x5f\x60\x0c\x2e\xf0\x83\xf5\x08\xea\x72\x9d\xb7\x1e\x81\x6a\x8f\x4d\x45\x5e\x29\x1b\x21\xbd\x8e\x06\x7b\x28\x14\x25\x8b\x61\x5a\xce\x21\x04\x85\x5b\x8b\x2f\x36\xa4\xf7\xee\x0b\x9e\x0b\x8f\x61\x29\x1a\xf3\xdd\xc7\x0a\x28\x87\xf7\xe3\x7d\xef\x21\xf6\xb8\x4f\x75\xe5\xba\x9d\x3b\x88\xd1\x75\x13\x86\x51\x1c\x10\xb7\x81\xfb\x28\x85\x1f\xa8\x71\xdf\x86\x8a\x75\x23\xc0\x37\xfa\x5c\xfd\x8d\xe0\xee\xe6\x2f\x63\x20\xc7\x70\x6c\xb6\x4e\xdc\x27\x20\x18\x70\x1b\xb7\x2a\xbd\x56\x37\xd4\x05\x76\xeb\xaa\x71\x4e\x47\x8f\x8f\x1e\x69\xf8\xc6\x26\x05\x80\x9a\x70\x2d\x60\xe1\x8d\x3a\xd6\xf6\xf8\xe8\x51\x25\xf9\x44\x8c\xce\xab\x7d\x28\xb5\x81\xe6\x10\x5f\xbd\xf2\xf8\xa8\xed\xe6\x0e\x58\x56\xa7\x47\xa7\x35\x1c\x2b\x31\xf4\xa4\xd2\xdf\x21\x94\xa5\x4a\x5a\x1a\x49\xc8\xac\x68\xd5\x33\x15\x54\xd8\xd0\x72\x51\x66\x59\x05\x7d\x8c\xaa\x9b\x87\x1a\x79\x29\x31\x7a\xb6\x8c\xaa\xc0\x46\x81\x6f\xe3\x25\x95\xab\x72\x0e\x71\x63\x73\x83\xa8\x8a\x6e\x54\xb3\x3f\x81\xd3\xb8\xa4\x98\x2c\x70\x8a\xe7\xa7\x8f\x3f\x39\xfd\x04\x7f\x3a\x7f\x74\x94\x24\x8f\x16\x47\xf8\x74\x7e\x7a\x8c\xd3\x93\xa7\x9f\x1c\x2f\x3e\x7d\xfc\xf4\xe4\x13\xfc\xe9\xa3\x89\x32\x35\x85\x7d\xcd\x24\x75\xc0\x3e\xeb\x62\xd2\x32\x19\x2d\xa3\xb2\x97\xc1\x57\x7a\xcd\x84\xf3\x3c\xba\xac\xb4\x75\x2f\x8c\x22\xe9\x58\x1d\x7b\x86\x39\x7b\x5e\xc5\xf8\xde\xee\xcc\xf6\xdd\x97\x7d\xef\x70\x75\xeb\x1d\xd9\x1d\x56\xea\xf7\x3a\x83\x78\x3a\x08\x8e\x79\xe8\x34\xad\x96\xd4\x76\x94\xba\x62\x3b\xd8\xed\xd1\x13\x3b\xf0\x94\x3e\xda\x9a\xea\x86\x5d\x20\xaa\xfa\xa7\xf1\x70\xcf\x19\x34\x49\x49\x24\xd5\xa8\xfa\x8c\xee\xdd\xe1\xb5\xbe\xe8\x48\x67\x95\x1b\x07\xab\x7a\x15\x0c\x41\xed\xa6\x6e\x09\x62\x6c\xbf\x6f\x6d\x0f\x63\xf4\x4b\x54\xfd\x03\xfe\x6a\xfe\x00\xfb\x21\x6d\xc6\x0f\x7c\xdd\x76\xb0\xe7\xd1\x7c\xf3\x12\xd2\x7d\xb4\x85\xb0\xf3\x73\x64\xae\xe5\xf4\x00\x6e\x4e\x9f\x53\xfc\xde\xe8\x79\xce\x2a\xe6\xae\xa2\x0b\x26\x89\x65\x36\x3c\xcb\x73\xa2\x0c\x70\x0f\x70\x9d\xa1\x54\xd3\xb2\x4a\x43\xda\xa5\x16\x56\xa0\x84\x2d\x67\x9e\x7a\xee\x3f\x84\xaf\xd9\x9d\x02\x99\x47\xee\xa8\x00\x63\xa1\x1e\x81\xc1\xec\x25\xc9\x33\x9c\x28\xe3\x78\x0b\x09\x0f\xd0\x00\x6a\xd5\x44\x5a\xbc\x20\x11\x31\xc3\x70\x52\x52\xc5\x1c\x2c\x12\x1e\xc8\xd5\x7c\x80\xab\xe1\x4e\xcf\xc3\x1d\xac\x2f\x16\x70\x59\xdc\x5f\x82\xb4\x71\xf2\x36\x36\x23\xd0\x03\xd1\x86\xed\x21\x3e\x66\xb1\x7a\x01\x66\x6c\x0b\x52\x81\xee\x3d\xd0\x19\xb9\x69\x41\x6d\xec\xce\xa8\x4b\x50\xdb\x00\xe8\xe3\x81\xb8\xb5\xef\x65\xb7\xcf\xa8\x70\xb8\xf3\x1e\x9b\x98\x9a\xb1\x3d\x0f\x0d\x6f\x79\x9e\xea\x39\xf4\x3c\x54\xa4\xdc\x5f\xf2\x23\x43\x09\xb8\x09\xf8\x1c\x0b\x72\x96\xfe\xb3\x14\x12\x1c\x2d\xd1\x47\xc4\x79\x5f\x76\xe6\x52\x6d\x9e\xe1\xfa\x51\x88\xe3\x8c\x45\x63\x76\xe6\xc0\x19\x75\xa4\x88\x36\x20\x6d\xdd\xee\x1a\x62\x7b\xe0\xc0\x6c\x4e\x99\x6a\xde\x58\x54\xae\xb9\x03\xac\x3e\x1b\x6d\xcb\x04\x26\x38\xa7\x12\x67\xf4\x77\x15\xfc\x6a\x73\x9b\xe1\x3b\x6b\x1a\x89\xe6\x22\x78\x60\xb2\xd9\xd0\x5d\x87\xdf\xf2\x62\x36\x6c\x9c\x60\xf4\xc6\xb0\x01\x89\xd9\xf0\x2e\xaa\x94\x06\xfc\x35\x1b\x36\x50\xae\xcd\x35\x3d\x78\xb5\x47\x17\xda\xed\x94\x75\x96\xc1\xee\xd8\xcc\x8e\x48\x73\x72\xd4\x22\x06\xa3\xd4\x03\xd1\x99\x99\x24\x23\xb8\x80\xf8\xdf\x18\x91\x74\x49\x22\x7d\x2f\x06\x64\x25\x68\x7d\x51\x90\x88\xb0\xa4\xd8\xe4\xd2\xdc\x59\xee\x93\xba\xd0\x18\xba\x44\xb7\xf5\x55\xc0\x30\xec\xc9\x31\x2c\x4f\x45\x6a\xe3\xcf\x19\x42\x08\xeb\x3a\x3a\x88\xfb\x60\x17\x35\x37\xa9\x3e\xc0\xbe\x36\x57\xa8\x2b\x21\x53\xd5\x17\x37\x86\x66\xac\x1e\x16\xcd\x8c\xb5\xd5\x71\xa4\xbb\x8a\x80\x99\xbf\x08\x54\x45\xad\xcb\x70\xc7\xc8\x60\x0e\xce\xa1\x20\x01\xd4\x5d\x24\x3d\x60\x25\x87\x92\x0f\xf6\x06\xc7\xca\x8f\x98\x31\xaf\xb1\x0b\x65\xe6\x35\xd1\x3c\x20\x0d\x3e\xba\x73\xc3\x4e\xf1\xbb\x6e\x3b\xee\x88\x6b\xc0\xf8\x7b\x8f\x90\xf0\xaf\x5b\xcd\xb9\xd6\xfc\xdd\x5a\xb8\x5b\x65\xbd\xb7\xd6\xdb\xd0\x5f\xfd\x96\xb2\xd0\x52\x6f\x58\x28\xa6\x88\x1d\x06\x8b\x85\xfa\x26\xa5\x61\xe2\x68\x79\xd2\xd8\xaf\x50\xfb\x12\x27\x4f\x8e\x9f\xc0\xf5\x21\xc0\x12\xe8\x34\x3e\xf1\x89\x94\x35\xbe\x7b\x4e\xd8\x52\xae\xa6\xe8\xf8\xe8\xe4\xd4\xd7\x8a\x32\xdb\xea\xa8\x33\xe0\xff\xb7\xb7\xbf\xbe\x8a\xfe\xdb\x47\x7f\x7b\xf0\xdf\x0f\x1e\x7e\x1c\xab\x32\xc5\xff\xf8\xf5\xb7\xff\x8d\xa3\xdf\xdf\xfe\x9f\xd7\x1f\xdf\x3b\xda\xdd\xa3\x3a\x70\x38\x20\xae\x2f\xa8\xbe\x48\xfb\x28\x18\xdb\xd6\xfc\x3c\x27\x5b\xb9\x5e\x68\xe1\x6e\x4b\xc2\x7c\x04\xd9\xbd\x36\x2c\x5a\x1c\x41\x23\x5e\x29\x03\xaf\x06\x42\x42\xbc\x3a\xe7\xe3\x01\xba\x7d\xa3\x6a\x15\x68\x54\x7e\x8a\xed\x73\xe7\x7e\xa5\x80\xc4\xc6\x86\x03\x15\x9e\x50\x3b\x50\x8d\xdf\x26\x62\xb9\xfd\x19\x86\x35\x7d\x24\xad\xb1\x0d\x73\xe9\x85\x8d\x04\x3a\xdb\x3a\xc2\x04\x55\xb3\x12\xcc\x47\xb8\x1f\xbd\x8a\x41\x9b\x33\x20\xf6\x56\x07\x0f\x58\x59\x68\x33\xb5\xbe\xfa\xbc\x81\x5d\x50\x38\x79\x40\x32\x48\x5a\x4b\x56\x0e\xd9\xf4\x54\x28\x04\x65\x25\xcf\x7c\xd5\x89\xc2\x5e\x5c\x1d\x3c\xf2\x3d\xdd\x62\xbd\x03\x13\x6a\xb2\xfa\xb3\x91\x36\x61\x73\x44\x2c\x82\x5e\x90\x0d\x19\x71\x70\x20\xf4\xf4\x36\x2e\x41\x5d\x61\xc8\x14\xb2\x37\x32\x94\x2c\x83\x79\x68\xf5\xba\xdb\x6f\x08\x31\x8a\x06\xd0\x31\x99\x40\xd1\x1c\xc3\x8c\xef\xb2\x78\x08\x22\x88\x53\x90\xca\x55\x0a\x25\x20\xa8\x49\x50\x45\x48\x54\xad\x1a\xb1\x61\x12\xdf\x99\x0a\x35\x01\x88\x6a\xe0\x19\x5f\x1a\x28\x31\xda\xd9\x6f\xf0\x39\xeb\x21\x34\x05\x1a\x3e\xf8\xe3\xe3\x5f\xfe\xe4\xb3\x19\x7a\x90\xd0\xe9\x83\x24\xff\xed\xc1\x82\x4e\x1f\x2c\xf2\xdf\x1e\x5c\x8b\xdf\x1e\x14\x72\xfa\x20\xa7\xe9\xf0\x33\x24\x4c\xc1\xb6\x84\x17\x5a\x67\x76\x9c\x40\x33\xd4\x43\x29\x4f\xca\x8a\x12\x53\x7b\xc2\x28\x99\x43\x74\xcb\x44\xbb\x94\x51\xa0\x0d\x86\x28\x4d\x38\xbb\x99\x9c\xc4\x47\x93\x86\x64\xd2\x25\xdb\x9e\xc6\x27\xf1\x23\x7f\xdd\xed\x7e\x52\xbf\x97\xdc\x6f\x6c\xf5\x3e\x38\x7c\xf0\xf6\x70\xf6\xc7\xab\xe8\xe3\xd7\x5f\xbd\xfa\x9f\xbf\x3c\x7b\x7d\x38\xae\xff\x1e\x3d\x9c\xfd\x39\xfa\xea\xf0\xd5\x59\xf4\x0f\x1c\xfd\xfe\xfa\xe3\xb7\xb3\x57\xaf\x8c\x7a\xf8\x4d\xfd\x70\x38\x3b\x7c\xf5\xeb\xe8\xf5\xc7\xb3\xd1\xe8\xab\xc3\xb1\xff\xd9\xe8\xe1\xec\xf5\x68\xf4\xf6\xd5\xaf\x0f\x5e\x4d\x13\x26\x8b\x6c\xfa\xfa\xb5\x7f\x1b\xb5\x87\x6a\x09\x85\xcb\x76\x16\x28\x34\xad\x56\x81\x68\x58\x0c\x95\xac\x34\xa2\x04\x4c\x06\x2f\x50\xe4\x18\x13\x5a\xdd\x44\x34\x1d\x8e\x7a\xa8\x8a\x00\x48\x57\x89\x6c\xc9\x45\x25\xb6\xd5\x1a\x38\x10\xdb\xf6\x46\x00\xa2\xb2\x44\xee\x65\x71\xc0\xc2\x0b\x00\x6e\x5b\x92\x8c\xb7\x48\xe1\xf8\xdf\x9b\x8d\xef\x67\xc0\xf4\xe0\xb3\x40\xf8\x32\xf8\xd0\x6c\x32\x4d\x07\x41\xc6\xb4\x5b\x51\x55\x48\xd3\xe6\xd1\x28\x81\x8a\x6e\x9d\x4c\xd5\xb9\xde\xb8\x6a\x55\x20\x6a\x0f\x23\x46\x3e\x25\x5a\xdf\xfe\xa7\xaa\x99\x2d\x75\x84\xd3\x6c\x13\xa3\x79\xb9\x3b\x30\xd4\x50\xf2\xd0\x3a\xa5\x42\x35\x8f\x07\xfb\x29\x53\x1c\x28\xe8\xd7\x20\xc5\xd0\xf4\xd8\x4c\x25\x00\x8e\x33\x67\x85\xed\xb2\x08\xaf\xa8\x8a\x4c\x21\xab\xc2\x19\x9d\xda\x57\xa6\xa2\x1e\xdf\x7d\x2d\x86\x94\x40\x10\x50\x2b\x02\x4f\x93\xad\x11\x3b\x6f\x00\x6a\x6a\x12\x1d\xcc\x04\x5a\x72\xff\x3a\xea\x46\xc8\x49\x22\xea\x2a\x7a\xdc\x40\xab\x7a\xc7\x7f\xd2\xff\xdc\x36\x09\x42\xad\x16\x81\x4b\x1b\xff\x51\xff\x0a\xa8\x7f\xd0\x9d\x0b\xb2\xfe\x8a\x8d\xc8\xf8\x72\x8f\x61\xeb\x17\xda\xc7\x8c\x0d\xb8\xaa\x94\x50\x5c\xdd\xaf\x37\xd8\xaf\x24\xf3\x95\x82\x13\x1e\x21\x67\xe4\xc7\x45\x18\xf3\xa8\xe7\xfc\x9b\x35\xa8\x0f\xa1\x77\x37\xac\xec\x5e\x44\xf3\x9b\xd3\xff\x70\x0c\x42\x77\xd4\xf5\x5d\x01\x7b\xf5\xde\x60\x08\xf3\x96\xdd\x6a\x73\x2e\x18\x33\x66\x83\xe6\x89\x4e\xa0\x4e\x01\x2a\x65\x27\x98\x34\x25\xb5\xc6\x91\x49\x93\x6b\xcd\xba\xd9\x53\x53\xd5\x5f\xe3\x91\x79\x76\x9e\xbc\x03\x1e\xda\xf7\xb6\x4c\x25\xc3\xfb\xf6\x69\x27\x50\x04\x76\x55\x63\x70\x21\x51\x1c\xf6\x0c\xec\xc7\x76\x0e\xb3\x31\x1b\xc2\x21\xa4\xec\xd8\x1b\x54\xef\x17\x60\x77\x3f\x11\x7a\x43\x0a\x36\x08\xb6\x51\xb1\x73\xd8\xbb\xee\xd1\x6c\x1d\xae\x8b\x60\x9b\xa5\x98\xac\xbd\x9b\x62\xf5\x37\x42\xb8\xec\x51\x65\x35\x32\x52\xaa\x47\xc3\x2c\xef\x33\x0a\x46\x6e\xc5\xa0\xa3\x15\xd0\xa4\x4c\xf2\x41\xa0\x85\x69\x96\x14\xbd\x87\x7a\x32\xe8\x68\x86\x22\xb4\x68\xdd\xd0\x6f\x7e\x23\xc4\x7a\xb5\x82\xc4\x55\xd9\xa7\x5d\x46\xbc\xc7\xe4\xea\xaf\x1e\x6a\x9f\x31\x28\x3e\x3e\x1a\x74\xb4\xb3\x0d\x8f\xfb\x36\xec\xdd\xf5\xa3\x41\x47\x3b\xdb\xf0\xb4\x6f\xc3\xc7\x83\x8e\x76\xb6\xe1\x93\xbe\x0d\x3f\x79\x9f\x92\xb1\xf6\x3e\x3a\xa1\x9a\x30\x43\x87\x9b\xd2\x2e\x48\xab\x6e\xaa\x3c\x0d\x73\x47\x4b\xa6\x7f\xd5\x0a\xa4\x13\xa8\xb5\x62\xac\x20\xed\x10\xa3\xf5\xe8\x7a\x40\x76\x73\x38\x61\x84\xbd\x84\x69\xbf\xeb\x97\xb7\x2e\xa6\x99\xa2\xd3\xa3\x4f\xbb\xa7\xbb\xba\x8d\xf9\xf4\x69\xf7\x8a\xe8\xbe\x97\xb9\xfe\xe4\xde\x6a\x93\xde\x09\xcc\x9d\x3b\xfe\xff\xfe\xcd\xa5\xce\x25\x64\xe5\x7a\x4e\x8a\xff\x18\xe5\x7f\x4f\xc2\x87\xef\xbf\xde\xa1\xfc\xf1\x7b\xa4\xbb\x3f\x4f\xc8\xfd\x44\xd6\xa6\xea\x68\xd5\x79\x43\xc6\x5f\x53\x4f\xb9\xf5\xf6\x12\xd7\x6d\x03\xe7\x00\x5c\xb5\xae\x19\x9c\xc1\x91\x0f\x1b\xba\x09\x64\xb3\x54\x3e\x90\x3f\xd9\xc0\x7e\xae\x5b\x6e\x04\xac\x37\x0f\x8c\xe5\x46\x53\x92\xe0\xa2\x76\x03\x3b\x60\x42\x50\x2c\x55\xf6\xd5\x52\xc0\xa5\xd4\xcc\x17\xd4\xca\x79\x6a\x92\xcb\x6d\x7f\x1d\x90\x3d\x70\xe0\xb2\xee\x02\xce\x59\xd9\xcd\x04\x07\xeb\x6e\x9a\x02\x09\xaa\x00\x85\x84\x1d\x61\x7c\x83\x69\x66\xaf\x92\xaa\x1d\x59\x53\x1c\xab\xfb\x82\x2a\x5d\x4b\xad\x72\xc9\xab\xb8\x5e\x33\xc5\xd5\xa1\xb2\xdd\x4f\xea\xe9\x08\x0b\x9e\x95\x0a\xb8\x11\x06\x38\x35\xae\xbe\x4a\x22\x57\xa1\x8a\xde\xa3\xaf\x6b\x2a\xe9\x7d\xf0\xe6\x70\xcd\x1d\x55\xaa\x1f\xfd\xef\xd6\x0d\x36\xf7\x3b\x27\x28\x2d\x78\x9e\xd7\x87\x31\xa0\x3a\x9f\x31\xe6\xa1\xfa\x4b\x42\x48\x5a\x9d\x24\xe8\xc5\x50\x45\xc9\xe0\x14\xc7\x81\xb0\x97\xd5\x98\xdd\xb7\x6d\x7a\x1c\x08\xd8\x63\xc5\x09\x95\x9b\xce\x81\x43\x3e\x90\x76\xa3\x41\x1f\x3e\xb7\xf3\xaf\x8a\xf8\xc2\xe6\xb7\x55\x9a\x56\xf0\xf6\x9a\xf8\x96\x1c\x66\x93\xaa\x01\xc9\x20\x4d\x19\x0e\x99\x27\x46\x8e\x0f\xf6\x51\xdf\x36\xa0\xdb\x8b\xc9\x3a\x20\xc3\x4e\x50\xc5\x86\x69\xbd\xad\x69\x7a\x84\xa3\xd9\x70\x14\xa1\x43\xa7\x77\x3b\x47\x51\xcf\x58\x4f\x64\xa6\x64\xf0\xce\x86\xda\xbf\x47\x69\x71\xd8\xa7\x39\xc7\xb9\x2c\x0b\x72\xce\xf9\x9b\x60\x08\xa1\xa1\x10\x76\x5f\x74\x7c\x65\x73\xec\x49\xc1\x0b\xe5\xef\x34\xd3\xa9\x13\x8d\xc7\xf6\xce\xaa\x3f\x9a\x1b\x80\xca\x78\xd5\x3f\x2e\x6a\xd0\xfe\x25\x15\x4c\xd7\xe8\x4e\xd9\x30\xa4\x80\x81\x6b\x3a\x9a\xf6\xf3\x0e\x7d\x60\xd3\x34\x34\xb2\x8d\x82\x0c\xf3\x3e\x68\xf7\x0f\xfe\xac\xb1\x4c\x56\xd7\x9d\x5a\x7f\x6b\xa0\xd5\x5b\x5b\x91\x10\x6b\x02\xa8\xe7\xfe\x5a\x5a\xf5\x07\x6e\x37\x24\x05\x58\x6f\x2a\xa9\xc8\xc8\x4e\x35\x68\xd0\xb5\x31\xda\x3e\xaa\x86\x5b\xce\x80\x6c\x7f\x87\xcf\xee\x70\x22\x87\xf6\x0e\x34\x02\xff\x32\xab\xca\x60\x06\xaa\x79\x78\x59\x90\x05\xbd\x53\xcd\x3a\x41\x56\x67\xa7\x73\xf5\x92\x06\xa3\x59\xd0\xf6\xe6\xee\x23\x8f\x83\xdb\x4f\xb5\x2d\x51\x99\x3c\xd5\x9b\x36\xb3\x46\x3d\x55\x3e\x8c\xe9\xc5\x60\xdb\x09\x96\x56\x37\xc4\xa6\x50\xf3\xcc\xe2\x1b\xea\x47\x83\xee\x84\x6c\xb1\xf1\xe5\xeb\xd5\x4c\xd1\x1b\x59\x98\x87\x1a\x03\x48\xf3\xe6\x43\x5b\x4d\x53\xad\x1c\x10\xdc\x35\x3b\xa4\xba\x45\x27\x58\x5e\xa8\x86\x73\x5c\x0c\x61\xf7\x06\x32\x69\x11\x67\x15\x0c\xf8\xbd\xda\x3b\x2c\x84\xd4\xb3\xd9\xed\x1c\x23\x8b\x0b\x15\xbd\xd6\x60\xdf\xf0\x5a\x84\x14\x0b\x75\xb6\xea\x35\x4f\xbd\x9d\xfd\xca\x19\xde\x73\xe5\x9b\xb7\x2a\x56\x82\xc2\x02\xc6\xa1\xaa\xdd\xf7\x0e\x90\x26\x9d\x52\x2f\x49\x25\xdd\x6c\x1d\x3e\x30\x7c\x60\xfd\x98\xb2\x99\x3a\xab\xa6\x2b\x3d\xa2\x31\x39\x30\xc9\x63\xfb\x0f\x95\x22\x32\x36\x69\x91\x24\xaa\x8f\x86\xa7\x24\xa3\x6b\x2a\x49\xd1\xb5\xa5\x5b\xa3\x0b\x96\x0a\x61\xb2\xd8\x38\x96\x1e\x14\xbb\x51\x63\xd6\x01\x06\xd5\x9d\x62\xe0\x4e\x88\x73\xb8\x42\xaf\x64\xba\xf4\x94\x59\x8c\x8e\x2d\xb4\x53\x13\xd4\x38\x07\x9d\x70\x1d\xe7\xc1\x9c\x31\xe4\x90\x22\x89\x04\x81\x1a\x04\x92\xe8\xc2\x73\x56\xd6\x4a\xde\x75\x4d\x0b\x7c\xeb\xa8\x8c\xb3\x67\xae\x4e\x96\x55\x27\x8e\xe3\x41\x4f\x97\xbb\x47\xb4\xa8\xb7\xbf\xdd\xdf\xdb\x0e\xe7\x42\xb4\xf0\xf9\x56\x46\x44\x43\x0c\x01\xcb\x48\x93\x6f\xd0\x01\x13\x6d\xe7\x3f\x36\xf4\x7a\x7b\xd6\x41\x27\xc8\x27\x27\x4f\x1e\x3b\x59\x09\xc7\x71\xef\xa0\x5e\x9f\x58\x5d\xaf\xc4\x82\xed\xf4\x02\x7f\x6e\xc1\xc3\xbf\xf5\x9a\xc4\x1e\x02\xab\x56\x14\x7b\xcf\xa4\xd1\x2f\x9e\xf9\x34\x4a\x52\x4f\xeb\xa0\xd7\x55\x75\xfb\x4c\x6b\x27\x44\x93\x6c\xf2\x5f\x72\x5a\xfb\x84\xc1\xa2\xda\xb4\xe8\x68\x65\x08\x32\x78\xc7\x38\xd8\x1a\xdf\x5d\x28\xc3\x3f\x20\x81\x7a\xa4\xee\x76\xa7\xef\x6e\xf9\x5a\xde\xb3\x7e\xad\x8c\x3d\xdc\x7d\xd3\xb0\x9c\x68\xca\xe9\xff\x28\x57\xcb\xf6\xdf\x70\xb5\x6c\xc9\x36\xab\xd9\xe0\x58\xbb\x1a\x90\x4e\x05\x50\x79\xb5\x41\xef\xc8\x3d\x2e\x61\x97\x5d\x5d\x0e\x00\x34\x3d\x54\x6c\x24\x09\x58\x8f\xdb\x8f\x03\x60\xc1\x59\xa8\x2f\xc2\x6a\x0b\xc5\xd9\x33\x19\x50\x40\x42\x9d\xc5\x00\xa4\x0f\x3b\x88\x4b\xc5\xce\x91\x0e\x08\xf5\x14\xc4\x9e\xe7\x70\xb0\x1b\x41\x3d\x02\x4d\xb3\x00\xc4\x44\x9d\x8b\x68\x4c\x17\xe0\x7e\xfd\xfc\x0a\xe5\x58\x08\xb9\x2a\x78\xb9\x5c\xb9\x70\x03\x41\x8e\x7e\x6e\xa2\xd1\xf1\xd3\x41\x6f\xa1\x3b\x34\xaf\x38\x2a\x54\x13\xd7\x4d\xe0\xb3\x0c\x12\x04\xab\xae\x92\x31\x23\xed\x79\x4a\xa3\x27\xdc\x06\x5b\x86\x9d\x8a\xce\x30\xc0\x5e\xa1\x00\xbd\x58\x7b\x86\x02\xdc\x70\x80\xc9\x23\xbc\x47\x38\x60\x9f\x90\x40\x53\xb7\x74\x36\xdd\x1a\x78\xf5\x66\xc0\x41\x18\xf4\xbf\xab\xbf\xca\xca\x37\xc9\xde\x30\xfd\xb8\xf1\x9b\xb5\xc6\x7b\x01\x0d\x58\xec\x6d\x16\x79\x2f\x98\x3d\xac\x76\x47\x8e\xf4\x02\xd9\xd7\x72\xdf\x87\x9e\xef\x62\xbd\xef\x65\x95\xef\x67\x99\xf7\xb5\xce\xbb\x2d\x74\xc3\x14\xb5\x85\xde\x03\x62\xd0\x9c\x73\x00\xee\x63\xce\x39\x26\x5d\xdf\x13\x4b\xfb\x5a\x5f\x81\x84\xdd\xbd\x2d\xb0\xbe\x56\x58\x7f\x1b\xcb\x64\x96\x84\x8f\x5d\xf4\x36\xc6\xf6\x30\xb6\xfa\x19\x5c\xf0\xb5\x07\xff\xa6\x83\xde\xfc\x36\xb4\xef\xf8\x74\x9a\x79\xfa\xfe\x95\xda\x5e\x80\x3f\x68\xb5\x0f\x5a\xed\x83\x56\xfb\xa0\xd5\x3e\x68\xb5\xff\x6a\x5a\xad\x47\xbf\x10\x31\x78\xce\x97\xdf\xee\x73\x78\x73\xd8\x78\xa9\xfd\x0c\xa7\xc9\xd6\x09\xef\x6f\x1b\x69\x62\xb7\xe5\x5c\x4f\x30\xa4\x03\x07\xbd\x64\x94\x80\xdb\x16\x14\x12\xcd\xd3\x97\x07\xa2\x3a\x0f\xb9\x7d\x88\x32\x00\x58\x17\xa5\x30\x87\x18\xfd\x20\xc6\xd5\xb9\x48\xd3\x34\x00\xf2\xbd\x9f\x84\x0c\xa1\xbf\x13\x62\xb1\xf3\xe4\x84\x58\x1a\x75\x27\x42\xc7\xbd\xcd\xae\x42\xcf\x10\x4b\x33\x90\x12\x00\xb9\x5f\x88\xa5\x2d\x90\x12\x00\x1e\x0a\xb1\x5c\x48\xe7\x4a\x01\x7d\x52\xd8\x6e\xbe\x68\x32\x05\xe0\xde\x3f\xd2\xd2\x43\x6e\x65\x7c\xd9\xa8\x1d\x3b\x1d\x74\xe6\xde\x3e\xe7\xcb\x7e\xab\x78\x1b\xb6\xb3\x8e\xe1\x18\x99\x33\x06\x14\xcc\x42\xd7\x51\x1c\x27\xd6\xa2\x2e\x22\x52\xb9\x3e\xa9\x63\xbd\xd9\x23\x66\xce\x39\xfe\x00\x50\x5d\x81\x64\xab\xdc\x64\xcf\xda\xa1\x01\xb0\xba\xaa\x68\x9f\x1a\xa2\x4e\x09\xd1\xe1\x28\x24\x71\xda\x0b\x65\xb6\x17\xdf\x84\xca\xd9\x82\x16\xe0\xb7\x38\x85\x46\x03\xc0\xf7\x29\x41\x5a\x15\x1c\xa5\xdd\xb3\xd5\x55\xd4\x53\x97\x35\x58\x94\x99\x5a\xbb\xb6\xdc\x68\x50\xbd\xc2\x82\xd1\x18\xec\x53\x88\x14\xcd\x43\x3e\xd9\x7d\x0b\x92\x06\x40\xba\xa5\x4a\xdb\x0a\x93\x22\x5f\x25\xe4\x00\xcc\xba\x46\xf2\xf0\x39\x5f\x0e\x95\x38\x34\x75\x5d\x6d\x56\xc1\x4e\x19\x5d\xd5\x32\x1e\xdc\x2f\x49\x20\x0a\xae\x72\x6f\x65\xd4\xde\x82\x27\x6c\x26\x45\xee\xe1\xc4\xc1\xde\x76\x47\xe0\x61\x55\xc3\xf4\x8a\x64\x24\x91\xbc\xe5\x0c\x66\xd3\x10\xd9\x79\x01\x8c\x04\xc5\x57\x50\x65\x83\x66\xd2\xdc\x42\x0c\xb5\xa7\xf9\xa2\xae\x91\xda\x66\x32\x9b\xeb\x04\xd5\x92\x6a\x77\x69\x4c\x61\x09\x2a\xdc\xc5\x51\x19\x17\xed\xc4\x14\x2b\x5c\xa4\xd5\x71\xae\x92\x09\x62\x2a\x9e\x59\x8e\xd0\xf7\xfd\x68\x5c\xe1\xfe\xc5\xe1\x60\x3f\x7f\x56\x6d\x84\x3d\xbb\xb3\xd7\x91\x78\x7c\xde\x06\xd9\xb6\x5f\x69\x5e\xfb\x93\xe1\x39\xc9\x90\xb0\x04\x35\xbc\x00\x86\x8a\x37\x67\x1b\x38\xdc\x6d\xa7\xb2\x8b\xcf\x5e\x7c\xe3\xf3\xd5\x83\x61\x86\x06\xaa\x67\x01\x74\x34\xda\xd5\x93\xe0\xb6\x8b\xc9\xbe\x35\x5b\xf4\x50\x95\x19\x4a\xe1\xea\x34\x10\xcc\xea\x6c\x70\x93\x64\x9c\x99\x82\x62\x04\x5a\x79\x81\xc2\xcb\x1a\x5e\x3c\xb8\x7f\x28\xe2\x0d\x09\x9e\x3a\x6c\x90\xe3\x0d\xd9\xd8\xdc\x7a\x4d\x17\xf8\xa1\x72\xc2\x2b\x52\x74\x6f\x99\x41\xb8\xc9\x87\x74\x2f\x11\x61\x6a\x10\x2b\xaa\xf5\x46\xbf\x22\x73\x41\x80\x5f\x35\xab\x00\x89\x0f\xc0\x64\x00\x35\xcc\xa1\x2c\x58\xde\x95\x27\x88\xed\x82\x36\xd4\x37\x97\xb8\x5a\xf0\x7a\x7f\xf1\x82\x8d\xc1\xe6\x85\xff\x3c\x83\x4a\x8f\x61\x72\xc0\x5c\xc2\xb5\x84\x2f\xb8\x54\xad\xdf\x99\x38\x1a\xb5\xde\xa4\x31\xda\x06\x58\x9a\x69\xaf\x0e\xd6\xa2\x49\x31\xb4\xc3\xbc\xe8\x3e\x04\x55\x91\x98\x0a\xd0\xc2\xbc\xb0\x34\xa8\x82\x40\xc2\x80\xb7\xa1\x00\xc6\x59\x04\xba\xaf\x23\x8d\xdc\x94\x97\x71\xe1\x2b\x42\xa9\x3c\x75\x97\x72\x6e\x57\x41\x88\x4d\x34\x34\x0a\x5a\xc2\xea\x27\xca\x8e\x54\x25\x1b\x53\x94\xaa\x4b\x2c\x61\xeb\x43\xdf\xdf\x4b\x93\x20\xe8\x35\x29\x96\x04\x22\x0c\xc9\x2a\x1e\xbc\x53\xb8\xb3\xd7\x5c\xf7\xf1\xc6\xc3\x3a\x15\xb4\xaa\x5f\xd4\x44\xd5\x52\xf3\x34\x08\x2a\xdc\x6e\xfc\x94\x42\x78\x0e\x02\xc5\x43\x0d\x6b\xcc\xe1\xec\xb2\x53\xa2\x75\x52\xac\xc1\xf7\x4e\xd7\xc0\x52\x10\x41\xcd\x81\xf3\xff\x00\xf1\xac\x98\xe8\x4f\x94\x63\x0a\x56\xe5\x59\xf8\x2a\x2d\xf7\x0d\x93\x3d\xe7\x02\x07\xb8\x70\xf6\xef\x5f\x25\xbd\xc1\x99\x3d\xf9\xc0\x10\xd1\x85\x99\x3c\x40\xf9\x62\x47\x5b\x42\x26\x22\x04\x2c\x41\xf4\x56\x81\x89\xe1\x1b\xb2\x19\x8e\x1b\x2b\xc4\x03\x11\x1a\x5f\x30\x73\xb3\xcd\xce\xa2\xac\xf4\x94\x4a\x7d\x18\xaa\x67\xc3\x78\x47\xc1\x7a\x60\x77\xa8\xdd\x20\x97\x04\x1f\x36\xee\x58\x59\xe3\xdc\x5c\xb1\x82\x25\x5f\xef\xac\x44\xb8\xcf\xf2\x12\x56\x2d\x90\x75\x3a\x08\xce\xfe\xb0\xd1\xd8\xd4\x57\x11\xd5\x45\xab\xd6\xf6\x42\xfc\xc6\x1a\x72\xc9\x8a\xa4\x65\xd6\xce\x5a\x7c\xe1\x35\xdc\x1a\xe6\x97\x31\xbd\x9c\xaa\xd5\xea\x3e\xb9\x17\x2e\x2e\x2d\xe0\xc1\xda\x5b\x73\x28\xec\x42\x24\xa6\x99\xd8\xdb\x54\x83\xc1\xfa\x2d\xdb\x76\xe2\xb8\x86\x6d\x75\x5b\xe8\x96\xa2\x57\xf9\x2d\x66\xd4\x83\xce\x1c\xd0\xfa\xa2\xd4\xca\x2c\xad\x8c\x52\x1b\x02\x48\x6b\xb3\xca\xeb\xf9\x00\xe1\x4c\x29\x52\x25\xa4\x1b\x65\xde\x03\x06\x6f\x4a\x72\xcf\xf5\x2e\xe6\xba\xbf\x6a\x51\xd8\x09\x35\x6f\xd6\x7c\x62\x96\x9d\x29\xc1\xaf\x2f\xbc\x46\x6d\x97\x61\xdf\x1c\x4f\x0c\x5d\xbc\xba\x08\xee\x6a\x2d\x35\x25\x7e\x86\xf3\x70\x3b\x9d\x51\x81\x7e\xe6\xc5\x1b\x5b\x5d\xd4\x36\x40\xd4\x2b\x01\x67\x0c\xd5\xcb\x05\x8a\x99\x71\x31\x45\x19\x65\xe5\x9d\xba\x8c\x36\x2a\x78\x46\xe2\x66\x0b\x70\xe0\x49\x31\x45\x07\xbe\x6a\x66\x21\xf4\xcc\xe6\xdb\x65\x86\x19\xd9\xc1\xd1\x7f\x2c\x72\x5f\x1c\xd7\x18\xe8\x0c\x38\xfa\x61\xea\x92\xb7\xa6\x7b\x73\xca\xac\x54\x7e\x20\xf0\xa8\xbe\x03\xb2\x65\xdd\xf4\xb3\x95\xb7\x25\xb1\xaf\xdd\xd6\x3a\xda\xc7\xdd\xf1\x42\xac\x14\xb8\x59\x38\xfb\xba\x3c\xbd\xcc\x8d\x7b\xba\x3e\x01\x88\xb6\xf2\xf3\x3d\xdc\x9f\x0e\xa8\x5a\x01\x76\xbb\x40\xfd\xa6\xb6\x97\x2b\x74\x4f\x77\xa8\x03\xa2\x2d\xbb\x2c\x3a\x5c\xa2\x5e\xb6\x8d\xfd\x5a\x8a\xee\x35\x9c\xbe\xee\x51\x07\x4c\xf0\xed\xf6\x75\x91\x3a\x41\x1a\x5b\x7f\x1f\x37\x69\x2f\x82\x75\xbb\x4b\x3b\xe4\xea\xe9\x32\x75\x80\x44\x3b\x6e\x4d\xb7\xdb\xd4\x09\xb2\xe1\x56\xd9\xca\x9c\x3d\x5c\xa7\x5e\xb8\xee\xa0\xd3\xe9\x3e\x75\x82\xdd\x75\xaf\xfa\xba\x50\x3d\xdd\xa8\xbd\x78\xa1\xcb\x5d\xb1\x9f\x2e\x97\xaa\xcb\xad\xea\xe5\x5a\x75\xda\xc6\xfd\x71\x76\xdc\x11\x3f\xca\xfb\xb8\x5a\xbd\xa9\xda\x58\x37\xfb\xb8\x5c\x81\x8e\xcd\x0d\x9b\xfb\xba\x5d\x01\x88\x0d\x87\x6c\x4f\xd7\x6b\xd0\x7f\x7d\xf7\x75\xbf\x02\x20\xbd\x8e\x59\x1f\x33\xa0\x93\x9b\x3a\x1b\xec\xe3\x8e\xc1\x57\xf2\xcc\x54\xb3\xf4\xb0\x52\x83\x3f\x86\x4e\xf3\xa6\xad\xe4\x3e\xa8\x5d\x8f\x41\xef\xdd\xdc\x6d\xd7\xc3\xdd\x19\x81\x8e\x98\xd9\x8b\xca\x02\x9a\x65\xc6\x1a\x97\x7f\x37\x4d\xd4\x94\x27\x02\xb6\xc7\x13\x92\x4b\xd1\xdc\x27\x9f\xc0\xc4\xca\x08\xb3\x34\xaa\x87\x31\x19\x0e\xf6\x16\x68\x0d\x5a\xc1\x08\xa0\xb2\x87\xda\x52\xbf\xae\xe0\x2a\xb2\x49\x89\xc1\x5b\x05\x6d\x6c\x7a\x0c\x18\x55\x70\x7f\x8a\xc2\x50\xdb\x60\x8a\xf3\x4d\x08\x5a\x16\x34\xcf\x08\xfa\xbc\x5a\x68\x63\x5d\xcd\xfa\x4b\x54\x06\xf7\xe4\xaa\xa5\x08\x91\xb3\x6a\x01\x7c\x6e\xff\xfa\x32\x1e\xdc\xdf\x5a\xd3\x18\xf8\x9f\x6f\x91\xe9\x99\x6a\xde\xb8\xab\x83\x98\xe1\x9a\xca\xdc\x92\x9b\x13\xd4\x01\x90\x08\xa9\xcd\x70\x53\xa7\xc3\x9c\xdb\xce\xb2\x06\x20\x11\x6b\x3f\xa9\xf2\x64\xc7\x08\x77\xdc\x98\x5d\x9b\x1f\x6a\xf5\xbe\xe0\x57\x30\x6d\x25\xdc\x7f\x00\xa7\xc4\x48\x51\xff\xa2\x0c\xe6\x17\xfc\xd9\x1d\x49\x4a\x49\x7c\x04\xec\x2d\x96\xf7\x09\xfd\xff\x5b\x6d\xeb\xea\xf1\x36\x6c\xdd\x9a\xa5\xad\x35\x1b\x80\x0b\x02\x21\x0e\xd2\x12\xae\x09\xb4\xe1\x66\x6b\x65\x77\xe6\xde\x38\xc1\x67\x6b\x95\x68\x33\xe7\x33\x7b\xab\xe1\x7a\x6e\xab\x00\x99\x52\x2b\x66\xd2\x83\x40\xe1\x6a\x0e\x3b\x3d\xe0\xae\x64\xca\xcc\x17\xef\x4c\x7c\x8b\x6c\xef\x19\xf8\xb1\xa7\x79\xde\xb5\x7b\x51\x69\x9d\x76\xa3\xdc\xb1\xb4\x9f\xfd\xab\xc4\x59\x8c\xbe\x31\xee\x74\x18\x2a\xb7\xcd\x0d\x80\x9d\x60\xe7\x2d\xcd\xd2\x04\x17\x70\x87\xbc\x49\x29\x0d\xcf\xa6\xe0\x5a\x16\x61\x25\xe1\xa0\x84\x8b\x15\x63\x35\xa7\xa8\x1b\x48\x31\x14\x7f\x95\x14\x12\x29\x42\x56\x14\x42\x70\x90\x62\xc9\x8b\xcd\x3b\xcf\x5d\xcd\xee\x57\x24\xe1\x2c\x15\xbd\x27\xf1\x7a\xfb\x4d\x77\x36\x61\x66\x72\x52\x50\x9e\x76\x1d\x58\x87\x12\x3d\xdb\x0b\xef\x50\x67\xc2\x58\xde\xe7\x0b\x23\x92\x6a\x81\xd1\xb1\x7a\xe4\x8a\x14\xb7\x54\x98\x4b\x40\x2b\x03\xc7\x5c\x4d\x3b\xb2\x7d\xb9\xe2\x33\x44\xc9\xe6\x2d\x5b\xd4\x6c\x35\xc3\x75\xc1\xd2\x9e\xa6\xb7\xcb\xd0\x4c\x6b\x05\x36\x08\x15\x2e\x96\x86\x78\xec\x61\xca\x55\x1d\x05\x72\x43\x13\x39\x8a\xd1\x3f\x48\x01\x06\x5c\x8a\x18\x59\xea\x5b\x79\xcd\xb2\xed\x3c\xfd\xae\x32\x92\x89\xca\x47\xc6\x02\x1d\xa1\x43\x05\x12\xd1\xf5\x9a\xa4\x70\xd1\x72\xb6\x19\xd9\x5d\x79\xb1\x11\x92\xac\xe3\x41\xaf\xc2\x6f\x4f\x4e\x07\xef\x9a\xba\xab\x86\xd0\x9b\xbb\x7e\xb2\xe9\x1d\x15\x25\x4d\x24\x73\x8b\x55\x8c\x7a\x0f\x80\x05\x1e\xaf\x24\xf0\xae\xe7\xe8\x38\xa6\x4e\x8e\x55\x1f\x11\x5d\x31\x19\x5c\x14\xa4\xce\xfb\x2c\x61\xdd\x9a\x15\xf7\x8e\x2b\xb3\xa7\x69\xdb\xee\x24\x05\x5e\x86\x8b\xfb\x68\x82\x5b\x96\x79\x83\xfc\x43\xdb\xce\x4e\x81\xbd\x8d\xa8\x2e\x44\xb8\x6b\x95\xee\x80\xac\xbb\x8b\xc3\x21\xec\x5e\xc1\xea\x16\xf0\xf7\x0a\x5f\x3b\x81\xea\x01\x42\xe8\xff\xb2\xf7\xad\xcb\x71\xdb\x5a\xba\xff\xfb\x29\x50\x9d\x7d\xca\x52\xa2\xa6\xd4\xba\x38\x4e\xef\xd9\x3b\x25\xcb\x4e\xe2\x3a\x8e\xad\xb2\x94\x64\x32\x51\xc6\x05\x91\xe8\x6e\x96\xd9\x44\x0f\xc1\x96\xac\xc4\x3e\x6f\x35\xff\xe6\xd7\x3c\xc0\x3c\xd3\xa9\x0f\x37\x82\x17\x80\x94\x62\xcd\xec\x4c\x49\x4a\x25\x4a\x37\xb8\x00\x2e\x2c\xac\x1b\xd6\xc5\xc0\xd2\x3f\x55\x77\x25\x3e\xb7\xcb\x37\x5d\xc7\x55\xc3\x92\xe6\x62\x29\x89\x97\x5c\xb0\x5c\x45\xd7\x76\x2c\x53\x2f\xf0\x45\x3e\x2f\xa8\x28\x8b\x4d\x8c\xdc\x14\x72\xf2\x46\x62\xa5\xf9\x9a\x66\xae\x92\xd7\xdd\xd1\x1d\x70\x81\x32\x77\x6a\x9b\x95\xa0\xf7\x44\x3e\x78\xce\xd7\x3c\xe3\x8b\x1b\x39\x59\xea\x82\xd7\xce\xf8\xdb\x00\x4e\x6b\xaf\x50\x81\x7e\x63\x10\x25\x07\xa2\x1c\x5e\x07\x54\xd4\xc8\x51\x65\x3f\xa7\xf0\xd7\xec\x6b\x74\x5e\x2f\x19\x8e\x52\x6b\x52\xdb\x76\x22\x15\xe4\x4c\x9a\xc8\x7a\x96\x0e\xd0\x68\xc3\x9a\x2e\x96\xd9\xcd\xb1\x29\xc2\xb7\x23\x5b\x9a\x21\x3c\xed\x8a\x65\x37\x4e\x17\xb9\x2e\x97\x7a\x07\xc4\x90\x93\x3d\x5c\x11\x33\xc4\x0e\x65\xd7\xb6\xe3\x64\x95\x4a\x33\xbc\xf7\x10\xd6\x46\xeb\x74\x02\x98\x91\x4e\xf7\xd9\x25\xcd\x13\xdc\xe2\x91\x9c\x5d\xab\x06\x76\x2d\xa0\x84\xc4\x19\x4d\x57\xa2\xd1\x92\x0c\x4e\x02\x28\xf4\xd8\x8b\x84\xe5\x37\x66\x14\x8d\x0b\xae\x5b\xfb\xc8\x88\x30\xb1\xdd\xc5\xcb\xd4\xad\x94\x4e\xa5\xb2\x38\x35\x74\xa2\x6d\xda\x48\x5a\x98\xda\x82\x88\x1b\x6f\xdf\x01\x54\xee\xbb\x2a\x7b\xaf\xaf\x06\x09\xbd\x44\x63\xfe\x72\xc9\xd2\xc2\x70\x84\x3b\xdc\x17\x9a\x77\x79\x7d\x9d\xb3\x02\x9e\xdf\xae\x51\x4d\xf4\xb7\x9f\x6a\x34\x88\x58\x72\x51\x4a\x3c\x69\xd4\x75\xc2\x24\x6d\x84\x3a\x82\x46\x6e\x9f\x4e\xdd\xff\xb1\x96\x58\x82\xa2\x4a\xdc\x5b\x8a\xff\x22\x27\x13\x72\x56\x16\x69\x5c\xce\xc8\x33\xa5\x3e\x48\xf3\xcc\x74\x0d\x44\x78\x66\x3a\x97\xcd\x98\x4b\x77\x6a\xaf\x4a\x2d\x5f\xa1\x6a\x9f\x84\x77\x93\xab\x9a\x90\x17\x79\xa9\xfa\xe2\x49\x10\x3a\xaa\x73\x46\x8e\x9d\xe9\x3c\x30\x71\x88\x24\xd8\x6a\x29\x6b\x5a\x2e\xab\x2a\xf6\x66\x26\x9d\x32\xd3\x44\x94\x4f\x88\xd6\x88\xcf\x95\x27\x60\x15\x12\x29\x1d\x24\x12\x8e\x06\xf5\xbc\xa6\x67\xac\xc2\xfc\xe8\x0e\xc2\xdd\xd8\x0e\xc3\xfb\x33\xd7\x9f\x68\x90\xa0\xde\x6d\xd9\x20\xd4\x0c\xec\x84\x89\x18\xe0\x2c\x8d\xd3\x2e\xea\xb3\x99\x73\x6d\x99\x1e\x91\x9f\x6a\xd3\x7b\x60\xeb\x07\x64\x3c\x27\x36\x57\x2f\xeb\x97\xe9\xaf\x08\x41\xe0\x3a\xbe\xba\x7b\x0a\x0f\xc8\x9a\xac\x95\xf0\x1e\x09\x8b\x3d\xcd\x01\x25\x81\x62\x12\xe3\xc4\xd2\xc9\x1e\xf5\xce\xee\x74\x9d\xee\x5e\x66\xfc\x52\xdf\xbb\xaa\x96\xed\x50\x09\xc0\xa9\x45\xb4\xe0\xa3\x60\xaa\xc7\x8c\xfc\xb0\x4e\xa8\x0c\x73\xae\xe3\x42\x45\xd0\x9b\xcf\x84\xa6\x18\xc8\x37\xfb\xd9\xb3\x54\x84\x9d\x26\xba\x20\x1b\xd0\x86\x12\x9c\x25\x34\x77\x77\x4f\x69\xf3\x95\x81\xdd\xb3\xcd\x65\xc2\x57\xd4\x1b\x9e\x8e\x22\x1d\x25\x5f\x13\xdc\x85\x43\x15\xd5\x92\x4f\xc3\x35\x0c\x66\x83\xb7\x52\x5e\xb5\x6a\x16\x0f\xc4\x6a\x6e\xf4\xf6\xc5\x23\x97\xb8\xb5\xb5\x4b\xf6\x6e\x6e\xa0\x73\x71\x03\x99\x62\xb3\x46\x94\xba\x68\x23\x14\x96\xd0\x70\x8c\x9a\xfb\xaa\x6e\x01\x55\x72\x72\x31\xee\x00\x76\x31\xbe\x03\xc7\x68\xae\xb4\x6f\x58\xcf\xda\x83\x9c\x23\xa4\xd3\x63\x5f\xfd\xe1\x30\x75\x6e\x52\x1b\x1c\x0e\xf2\x7e\x83\xa1\xc2\x86\x73\xb7\x00\x13\xff\x99\xee\x0d\xf0\x36\xe1\xdc\x1d\x40\x1f\x02\xbc\x1f\x02\xbc\x1f\x02\xbc\x1f\x02\xbc\x1f\x02\xbc\x1f\x02\xbc\x1f\x02\xbc\x1f\x02\xbc\xff\xf7\x06\x78\x97\x99\x38\x43\x31\xbd\xb4\xbc\x39\x2d\xf8\x3c\xcd\x3a\x6a\x5a\xd4\x48\x60\xdc\x7e\xc2\x49\x36\x16\xac\x84\x95\xa2\xfc\x27\xc8\xa4\x76\xf2\x70\x5b\x70\xa5\x17\xcb\x68\x6e\x95\xe2\x26\xa2\xa0\xfe\xe5\x1a\x65\x1d\x20\xe9\x3a\x85\xb6\x08\x30\x5d\x5e\x58\xe3\xa1\x2d\x98\xe0\x9b\x22\x66\x51\x3d\x9b\xfe\xba\xdb\x6d\x28\xef\xe6\x31\x21\x79\x9d\x25\x3b\xca\x4c\xd7\xd7\x19\x8a\x34\xbe\xe7\x09\x2b\x72\x28\x7f\x12\x21\xd8\x3b\xed\xd6\x57\xd7\x46\xdd\xbd\x12\xcc\xf0\x5a\x6c\x03\x68\xae\x15\x66\x4b\x2e\x59\x79\xcd\x18\x1a\x2f\x64\x8c\x0a\x48\xd1\x6f\x3a\x89\xd6\xba\xd6\x16\xe9\x15\x1a\x82\x98\xbd\x89\x15\xec\x92\xdb\x42\x0a\xee\x4b\xd8\x95\xa8\xa0\x8e\x4e\x45\x9b\xdb\xc9\xc9\x3f\x47\x3f\x47\xff\x82\x17\x27\x9b\xf5\xa2\xa0\x09\xae\x43\xea\x5f\x7e\x31\x95\xe9\xbb\xda\xaa\x24\x39\xbb\x1e\xfc\xfa\x25\x77\xbc\x77\xa6\x27\x4a\x5b\xbd\x97\xce\xd5\x4d\x56\x76\xf3\x2d\x55\xfd\x8c\x67\x19\xdf\x94\xb7\x56\xd2\x55\x93\x8e\x59\x3f\x3b\x1c\xeb\xae\x13\x92\x15\xa2\x35\xde\x44\x97\x5b\x91\xa4\x2f\xf4\x29\x31\xef\x19\x91\xa7\x5d\x44\x80\x5f\xf6\xbe\x84\x32\x9e\xdd\x90\x98\x16\xd2\x58\x51\x14\x67\xfb\x5a\x18\x54\xe1\x36\x24\x57\xd5\x5e\x6a\x58\x13\x23\x6f\x35\x49\x60\x33\xa6\x25\xbc\xfe\x7c\xbd\x4c\xe3\x88\x1c\xe7\xc6\x03\xdb\x04\x9f\x71\xfe\x4e\x90\x2c\x7d\x87\xe3\x10\x8c\x89\x8f\xd3\xf5\x12\xb5\x4c\xc9\x84\x3c\x3f\x79\xf6\xdd\xf3\xc9\xf3\x93\x67\x67\xc7\x93\x93\xef\x8e\x4f\xbe\x3b\xde\xdf\x9b\x9c\xbe\x7e\xf9\xf3\xf4\x60\xef\xc8\x7e\xff\xa6\xeb\x5b\x0f\x7c\xf7\x99\xe3\xe7\x67\xd3\xfd\x27\x93\x6f\x4f\xbe\x9f\x9c\x7d\x77\xbc\x7f\xf4\xb8\x31\x63\xeb\x7b\x0f\xcc\x55\x9a\x9f\xbf\x3c\xfb\x91\x15\xb0\xbb\x66\xd8\xa0\xab\x69\x34\x1d\x8f\xba\xd4\xc1\x01\x95\x5b\xfa\x6d\x05\x83\x21\xcf\xd7\x2d\x52\x52\xc3\x5d\x73\xd8\x74\x60\xc1\x01\x50\xd0\x08\xcd\x16\xbc\x48\xcb\xa5\xd7\xd1\x6b\xa3\xc4\xa1\x54\xe7\x6c\xc1\x4b\x9c\x6f\xab\x92\x01\x14\x68\x13\x5e\x37\xb1\xa4\xef\x50\x74\xc8\x84\x42\x84\x40\xe2\x30\x17\x6c\xc5\xaf\xd0\x37\xb9\x2c\x74\x7d\x98\xb4\x50\xaa\x0e\xae\xcf\xf4\xfd\xb1\x76\xa1\x34\xfa\x08\x84\x16\xab\x38\xd2\xb3\xe7\x67\x93\x93\xa7\x27\x07\xd8\x44\x42\xb6\x6e\xe8\x2a\xdb\x9e\x35\x08\xcd\x1d\x33\x1e\xdd\x59\x4d\x1c\xa4\x24\x86\x55\xb0\x36\x41\x0d\xdc\xe5\xda\x43\xbe\xbd\x96\xa5\x18\x83\x75\xdb\xaf\x34\x00\xed\xc3\xc6\x8e\xae\x0b\x5e\xf2\x98\x67\x44\x97\x53\xe9\xde\xfc\x00\xcc\x06\x59\xd4\xda\x40\xe8\x4d\xc2\x10\x3d\xb5\x20\xd3\x68\xba\x43\xa6\x51\xa8\x1d\x1b\xcd\x13\x32\x8d\x0e\xdc\xed\xec\x3e\x86\xf8\xe6\xd5\xeb\xf3\xe7\x33\x12\x6f\x0a\x5c\x17\x04\x03\x36\x81\xa3\x65\xba\x58\xa2\x16\x6c\x1d\xa1\xda\xb5\x05\xc4\xea\x8f\xce\x5f\x9e\x4d\xf7\xfd\xc4\xe2\x77\xad\xe1\x77\xe2\x42\xd9\x1b\x36\x6c\x3a\x6c\xd8\xfe\xb0\x61\x07\xa3\x3b\x53\x71\x50\x45\x44\xa9\x93\x4a\xfc\xcf\x46\xfd\x94\xeb\x8e\x07\x7e\x69\xa7\x8c\xb3\xd7\xd3\x3e\xa4\x5e\xe4\xd6\x51\x7e\x9d\xbe\x4b\xa3\x15\xff\x2d\xcd\x32\x1a\xf1\x62\xb1\x6b\xd4\xca\xdd\x33\xa9\xc0\xbd\x3d\x4b\x13\xf6\xf6\xfc\xe5\xd9\x67\xae\xaa\xf2\x36\xe6\xab\x35\x2d\xd3\x4b\xd9\x0f\xf7\x6d\xb4\xff\x04\xb5\x5d\x56\x2b\x96\x27\x2c\x89\xf6\xbf\xf2\xcf\x0b\x6a\x6c\x08\x38\x0f\xa3\x39\x7f\x79\xf6\xf6\xf8\xf9\xd9\xdb\xe9\xfe\x93\xb7\xdf\x9e\x7c\xff\x36\x28\x58\xaa\xe1\xfb\x47\x8f\xcd\xf0\x83\x27\x87\x1a\x8e\x91\x79\x6f\x8d\xcc\x7b\xfb\x87\xc4\xd8\x2d\x45\xe3\xfe\xd1\x63\xf3\xfd\xc1\x93\xc3\x61\x30\xeb\xcf\x34\x60\xde\x45\x84\xb7\x9e\x21\x13\x12\x78\x07\x0f\x44\xff\x0a\x3b\x79\xca\xfe\x9d\x45\x7b\xcf\xc1\x59\x49\x55\x7f\xc8\x91\x51\x23\x87\x1c\x96\xbb\x1d\x0b\x65\x74\xd4\x0f\xc4\x9f\x86\xfc\xeb\x9b\xe6\x01\xae\xb4\xb4\x83\x4a\x3c\x9c\x18\xf1\x00\xe3\x50\xa9\x1a\xde\x3a\xa8\x7f\x7c\xab\x79\x96\x0c\xd9\x67\x9e\x25\xf7\xb7\xc9\xaf\xb3\xe4\xed\x25\x8d\xdf\x5d\xd3\x22\xf9\x93\x6e\x75\x9d\x83\xb4\x4e\xfc\x00\x0e\xd2\xc1\x25\x9a\x30\xeb\x3c\x61\x18\xcc\x06\x1f\xf9\xc7\xe7\x74\x13\x12\x9a\xa9\x81\x11\x98\x4d\xb7\xc5\xb0\x0f\xbb\xfa\x3b\xcf\x23\x3d\xf0\x2d\x14\xe0\xbb\x81\xeb\x37\xde\x6f\x9a\x4f\x0d\x7b\x09\x3d\xb8\x8d\x77\xfb\x66\xed\xc1\x21\x0c\x75\xec\x1a\xe9\xda\x97\xe6\x2c\xb7\x82\xae\x16\x5c\x3d\xd2\xb0\x75\xba\x05\xdc\xde\x7d\x71\x3d\x7f\x93\xe0\xee\xe6\xc0\xba\x9f\x6f\x87\x67\x4c\x7b\xc5\x78\x41\x4e\xa4\x9f\xc1\xe7\xdd\x3f\xb1\x5e\x88\xab\x54\xb7\x03\x23\x54\x31\x39\xd7\x36\x42\xc6\xc9\x55\x9a\x6c\x68\x56\xe3\xb4\x1e\xa0\x86\xff\xa2\x0e\xf1\x8a\x95\x70\x08\xb6\xbd\x77\xae\xf3\x0e\x16\xf3\x70\xb8\xe2\x8f\x32\xf6\x37\x95\xda\xfa\x76\x90\x2f\x47\xe7\x58\xd9\xf9\xb1\x5e\x68\xe5\xb9\x0e\x0a\xdd\x51\x41\xfe\xec\x46\xb9\xde\x94\xdf\x50\x15\xdb\x48\xbd\x55\x6d\xa9\x80\x73\xce\x88\x04\xe9\x93\x4e\xd8\x15\xcb\xf8\x5a\x47\x3d\x30\xdc\x12\xc1\x17\xe5\x0e\x99\xa3\xa8\xb3\x07\xa2\xf2\xdf\xa5\xb9\x44\x23\xfc\x0b\xcf\x64\x8d\x08\x80\xe0\xf0\x90\xb2\x38\x15\x2c\xbb\xd1\x41\xeb\x2e\x54\xdb\xbd\xd8\x0f\x99\x02\xfd\x31\x13\xda\xb9\x2a\x6f\xc1\x75\x0d\xc2\x82\x25\x9b\xb8\xa3\x03\x0d\xd3\xfb\x1b\xde\x4e\x10\xb2\xb5\x3d\x5d\x4f\x06\x22\x4b\x98\xf2\x63\x56\x51\xf7\x37\xac\x24\xd7\xcc\x1b\x08\x4f\x13\xbe\xd6\x21\x29\xd8\x63\x9e\x13\xc1\xe7\xe5\x35\xde\x31\x4b\x2f\x0b\x0a\xff\xc9\x1d\x62\x3d\x5e\x67\x49\x28\x6e\x4c\x93\xb4\x67\x48\x00\x09\x13\x7d\x00\x47\xb7\xb6\x33\x03\x9c\xa4\xdc\xe4\x69\xbe\x78\xbd\xf6\x24\x2a\xd2\xfc\xe6\x75\x47\x64\xe1\xa4\xf7\x12\x7e\x45\xdf\x9f\x54\x77\x0a\xb3\xd1\xed\xed\xfa\x09\x99\xf8\x8c\xf4\x09\xd9\xbb\xb7\x25\x85\x23\x76\x2d\x24\x55\xae\x7c\x7f\x4f\xfe\x8c\x7a\xaa\x9a\xef\x77\x8d\x69\x70\x69\x77\x1f\x6c\xfc\x6e\xc5\x14\xe5\x1d\x0c\x4d\x90\x40\x60\x1c\x84\xba\x65\x2b\xea\xb1\x76\x2c\xa0\x33\xee\x1e\x59\x45\xb8\xf4\xcb\x32\x13\x40\x0b\x62\xe7\x72\x56\x9a\x49\x2e\x2b\xc3\xcc\x36\xa2\x0b\xa4\x72\x25\x56\xc1\xd2\xe6\xba\x47\xa0\x11\xb7\xce\x72\x69\xc4\xf1\xd6\xc8\x2b\x1c\xc6\x5b\x2b\xf3\x03\xf5\xf8\x4c\x5d\x52\x99\x31\x08\x5c\x4c\xf3\x5e\x88\xa9\x20\x0b\x96\xb3\x02\xd5\x6e\x25\x83\x70\xfd\x0e\xed\xba\xa0\x26\xb8\x3b\x2d\x21\x8d\x3d\x5d\x68\x57\x88\xde\xad\x33\xff\x0e\xbe\x10\xa6\xbf\x38\x4b\x59\x5e\x7e\x93\xe6\xe7\xe9\x8a\xf1\x4d\x39\x44\x74\x37\x9f\xb1\x74\x81\x62\xc1\x19\x97\xd5\x03\xaa\x9b\xbb\x50\xc7\x4b\xc4\x0f\xe3\x56\x15\xa1\x8e\x60\xe9\x19\x23\xd7\x34\x55\xe8\xd5\x11\x9d\x6a\xb2\xaa\xd1\x85\x69\x73\x2f\xe5\xe1\x2e\x6c\x1b\xe6\x15\x26\x71\xc6\xed\xd5\x5b\xb5\xa0\xc0\x05\x61\xa9\x5f\xc8\x5b\xe9\x68\x2a\xc6\xa3\xd0\xf1\x4c\xf4\x46\xdc\x9e\x19\x9a\xad\xb8\xf5\x3e\xdc\xf7\x26\xd0\xe6\x16\xd4\xf1\xe7\x01\xda\x8d\x55\x72\xb0\x77\x8f\x08\x54\xdd\x10\x9e\x6e\x10\xa9\xfd\xf4\xa6\x64\x62\x08\x12\x5b\x0f\x35\xe2\x93\x57\x1b\x99\x1c\xb7\xe2\xc5\x8d\x8e\x3b\xee\x04\x2a\x69\x19\xc9\x83\xc5\x15\x4b\xc8\x56\x9a\x93\x4b\xac\x60\x1b\x2f\xd6\xee\x8c\xe2\xee\x8c\xd0\xb1\x06\x3e\xf5\xd6\xd5\x46\x52\x93\x51\x62\x22\x69\x68\x49\x70\xa9\x5a\x92\xe9\x63\x58\x12\xa9\x6a\x78\xb1\xbb\x0f\x55\x43\x95\x50\xf3\x9d\x0d\x73\xbe\xda\x6b\xdb\x32\xda\x68\xc9\x79\x26\xa2\x94\x95\x73\xe9\x64\x58\x96\xab\x6c\xb7\x98\xc7\x5f\x1e\x1d\xee\x75\x66\x54\xd8\xd0\x21\x37\x65\xd1\x89\x71\x6f\x4f\x65\xb8\x3a\xa1\x86\x58\x3c\x60\x6d\xaa\xd2\xc1\xfe\x97\x8f\x9f\x28\xdc\xd6\xb8\x71\x7d\xd2\x1a\xab\xf5\x80\x74\x18\x30\x5a\x5d\xb4\xe9\x40\x33\x62\x7b\x1f\x56\x72\x4e\xc4\x0a\xf9\xae\x2b\xef\xb5\xce\x65\xc1\xe8\x3b\xcf\xcb\x42\x8c\x05\x67\xf1\xc0\x34\x73\x67\x14\x81\x4e\xb1\x0c\x7d\x57\x2a\x65\xf7\x3c\xfa\xba\x45\xa4\x8b\x1c\xc2\x8e\x06\xee\x43\xa4\x70\xd3\xc4\x5d\x2e\x69\x4e\x72\x06\xf5\x98\x16\x37\x51\xf8\x98\xfa\xd5\x90\xaa\x67\xca\x63\x9f\x27\xa5\x2f\xdd\xd2\x45\xd2\xf7\xf4\xfd\x1b\x76\x5d\xa4\x25\xbb\xd3\x89\x6e\x3c\xee\x3f\xdb\xa3\xbe\xf6\x97\x9d\x67\xbb\xe0\xab\x8e\x2d\xc5\xd1\x0a\xb4\x4c\xd0\x3d\x56\x0a\xb9\x2e\x70\x59\x10\x06\x5d\x1b\x33\xa7\x8f\x61\xf8\x56\x6a\xd8\x88\xc3\x30\xd2\x3c\xe6\x2b\xc0\x74\xdb\x69\x54\x39\x4e\xaa\xc9\x75\xa0\x6e\xc7\x56\xfb\xdd\x26\xa1\xdd\x41\x6a\x6e\x89\x88\x5b\x24\x13\x77\xf3\xe8\xe6\xfe\x2a\xa8\x86\x9d\x2d\x64\xea\x2f\x18\x13\xcd\x43\x13\xfd\x4f\x71\x9f\x27\xd3\xaf\xf6\xef\x9b\xf9\x34\x5e\xb5\x8b\x0d\x79\x80\x5a\xe6\x74\x2b\x36\xd4\x98\xcf\x03\xbb\x63\x15\x43\x19\x92\x07\x62\x17\x9b\xba\x57\x86\x74\xb8\xf7\xd5\xe3\xbb\xf2\xa3\xac\x5c\x9e\x2c\x59\xfc\x4e\x7a\x7f\xae\x68\x36\x90\x0f\x35\x1f\x6b\x2b\x69\x36\x37\xc8\x87\x26\xa8\x62\xc2\x06\x70\x95\xd7\x1c\xba\x03\xbc\x23\xd2\xd4\xd1\xdd\x2e\x62\x4c\x02\x5f\x1a\xc1\x60\x63\x12\x78\x65\xbf\xd6\x9a\xd1\xe2\xe0\xbc\xd2\x29\xd2\xaa\x92\xcf\x22\xe3\x97\x92\x82\xa9\xa8\x0e\x07\x70\x8d\x84\x4b\x0f\x50\xf9\x16\x62\x87\x5c\x6e\xac\x53\x05\xee\xa3\x22\x4d\x12\x96\x93\x35\x2b\x26\x72\x84\x49\xb9\x50\xff\x23\xdb\xd5\xfa\x15\x3e\x42\x2e\xc6\x72\x60\x51\x8f\xc3\xd3\x8d\x70\x22\xf5\xfa\x91\x7c\xfd\x28\xd5\x48\xbe\x18\xcb\xc3\xf9\xfc\xfd\x1a\x45\x60\x3c\x70\x11\x7d\x96\x43\x44\xaa\x80\x63\xb9\x04\xad\x59\xe2\xa0\x27\x2c\x4e\x57\x34\xd3\xd9\xe2\xe8\x2e\x43\xe3\xa5\x4a\x68\x32\x66\xa9\x07\xf0\xbc\xa0\x4a\xaf\xc3\x39\xa3\x64\x93\xa7\x25\x11\x9b\xf9\x3c\x7d\xbf\x43\xd8\x82\x5c\x8c\x0f\xf6\xf6\x56\xe2\x62\xbc\x43\x2e\xc6\xd3\xe8\x68\x79\x31\x46\x50\xf5\xc5\x78\x7f\x79\x78\xb4\xba\x18\xfb\x38\x9b\x8a\x7a\x87\x26\x2d\x41\x2a\xfb\xf0\x62\x9c\x6b\x48\x1b\x71\x31\x26\x5b\x12\xd0\x7f\xfe\x3b\xfe\xfe\xe1\x8b\xbd\xbd\xa7\x47\x0a\xf4\x7f\xfd\x87\xb8\xe8\x3e\x3d\x84\xfc\xf0\xc5\xde\xc1\xd3\x93\x6d\xac\xc6\xac\x4a\xff\x67\xa5\xfe\xb3\xbc\x18\xb7\x79\x5d\xc9\x49\xe6\xaf\x30\x2b\x0f\xee\x91\x90\xe1\x63\x4a\x51\x41\xbf\x3a\x21\x48\x59\xd0\xf9\x3c\x8d\x49\x82\x32\x08\x28\x85\xc4\xc9\x1c\xa1\xae\xb0\x2e\xce\x4f\x4e\xf5\x41\xf3\x40\xd5\x34\x2e\xd1\x1a\xcb\xab\xac\xfc\x06\xbb\x75\xf6\xf3\x2b\xb2\x06\x41\x97\xc8\x19\x2b\x56\xa0\xea\x63\x64\x02\xa1\xbe\x0c\x52\xa7\xd1\x88\xa9\xf4\x44\xfa\xd9\x06\x79\x58\x0b\xa2\x42\xe4\xa2\x55\x6c\x20\xda\xc5\xa5\x79\x5c\x20\x2e\x31\x21\x48\x13\xc9\x63\x64\x62\xa9\xc5\x87\x2d\x4f\x1d\x44\x5a\xf1\xcb\x9c\xcb\xf3\xce\x8a\xca\x4f\xa9\x8e\xca\x92\x5e\xb1\xfc\x91\x72\x0a\x5e\x22\x46\x53\xf5\x24\xf1\x9e\x5d\x8a\xf8\xce\x78\x29\xf7\xe4\xd8\x54\xc9\xe2\x05\xf9\x0d\x25\x37\xba\x58\x0e\x64\x31\xfc\x8e\x84\xaf\xd3\xdc\x7f\xd0\x80\xd7\xb6\xb0\x88\x97\x9c\x0b\xe6\x30\x01\x53\x2e\xa4\x16\x66\xea\x01\xd9\x74\x22\x47\xce\x05\xac\x6b\x1f\x76\xac\xda\x03\xd1\x32\xaa\x23\x15\xe6\x5b\x87\xa7\x39\xbd\x8d\xe3\xb1\xa3\xa7\xc8\xb4\xf0\x21\x54\x3e\x49\xdf\x77\x3f\xb9\x3f\x3d\xfc\xf2\xf0\xc9\xc1\xe3\xc3\x2f\x57\x82\x6c\xed\x1f\x46\x4f\x8e\x48\x42\x6f\x64\xab\xa8\xa7\xdc\x4b\xab\x9d\xc5\x6e\x1d\x44\x8c\x47\xe1\x36\x78\x5b\x7b\x1f\xb6\x7e\xd9\x9b\x7c\xf5\xeb\x17\x5b\x17\x91\xfa\x63\xfb\xeb\xad\x5c\x7c\xd8\x88\x0f\xff\xf9\xef\xe2\xc3\x7f\xfd\x87\xf8\xb0\x12\x1f\xc4\x87\xd5\x87\xe5\xf6\xf6\x17\xdb\x7f\xb9\x8b\xb9\x3c\xc4\xf1\x58\x97\x68\xf5\x27\xac\x30\x73\x31\x58\x95\xd7\x10\xe9\x6a\x93\x95\x34\x67\x7c\xe3\x63\x14\x95\x52\xab\x35\x0b\x1d\x70\xca\x04\xdc\x5e\xa9\x40\xc9\xb4\x35\xb3\xbd\xd6\x8c\xf7\x3e\x22\x2f\xd4\xb1\xec\x39\xd3\x6a\xff\x25\x35\x08\xc5\xc0\xbb\x5d\x8f\x38\xce\x88\x5d\xcb\x98\xd4\x3f\x06\x2c\x16\x07\x57\x5f\x0f\xc4\x70\xc2\xf1\xb9\x93\x36\xa1\x0b\xd3\xd8\x20\x71\x48\x6f\xff\x4a\x21\xcd\x37\x2b\x7d\xf3\x70\x8a\xf6\x65\x32\x1f\x55\xeb\x5b\xb4\x60\x33\xa3\xc4\xee\xed\x90\xc9\xb4\xca\x25\x28\x70\xb8\x3c\x40\xe1\xd9\x9d\x68\x2f\xb0\x71\xd7\x74\x28\xc6\x10\x0f\x7b\x1e\xed\xd8\x03\xd9\xea\xcc\xee\x01\xb6\xfa\xf1\x11\x66\x54\x7c\xcd\xfd\xbe\x62\x16\x1e\xa8\xd5\x19\x49\x73\x32\xdf\xc8\xea\x22\x3a\x22\xdc\xc6\xf3\xdb\xfc\x09\x2c\x7f\x32\xc5\xaa\x73\x43\x19\xa1\xc5\x26\x37\x39\x5d\xa9\x36\x63\x04\x62\x43\xaa\x1d\x96\x5e\x15\x44\x73\x19\x07\xa0\xbd\x17\x49\x1b\x69\x37\x09\x93\x78\x52\x6c\x72\x38\x93\x4d\x3a\x07\x52\x47\x55\x2a\x2b\x3e\x9c\x4c\xc9\x56\x1a\xb1\x68\x87\xd0\x4d\xc9\xb7\x43\xeb\xac\x84\x0e\xd5\x9a\xb5\x5e\x1b\x03\x20\xbd\xf2\x84\x6c\xfd\xbf\x23\xb9\xb1\x50\xf6\x5e\xaf\x59\x7e\x06\x9d\xc8\x03\xf7\xef\x7f\x3b\x8c\xa6\x7b\xa6\xae\x8c\xd8\x36\xb4\x53\x30\x14\x6e\x52\x47\xa2\x71\xb6\xd4\x5a\xd2\x3c\xde\xf8\x14\x52\x47\x61\x37\x9a\xfa\x06\x4d\x16\x25\x72\x69\x51\x45\xdd\xeb\x0b\x31\x4b\x05\xde\x3a\x5a\x47\x96\x4c\x8d\x92\x41\xf5\x4e\x9b\x68\xd4\x9a\x5d\xe8\xc2\x86\x6b\x9b\x06\x64\xbb\x3e\x8a\x72\xc7\xd4\xab\xad\x51\xb1\x2a\x2f\xdd\xc6\x8c\xf6\xdd\x65\x3a\xbc\x28\x51\xc1\xcc\x5f\xff\x48\x9d\xa6\x1b\xbe\xd1\xc2\x11\x0a\x72\x2a\xe2\x82\x95\x66\xc7\xb6\x58\xb4\x88\x76\xc8\x97\xf2\xbd\x2c\xd2\xc3\x6a\x3e\xea\xaa\xa5\x82\xac\xd2\x45\x51\xe5\xb4\xe3\x6e\x15\x25\xd8\xe5\x11\x2d\xd8\x23\x29\xc6\x17\x1b\x5a\xd0\xbc\x64\x1a\x3b\x01\xc7\x91\x79\x9c\x2c\x11\xf8\x9f\xa0\x31\x55\x4c\x33\x4b\xbf\x95\x99\x00\x86\x2a\xb5\x0a\x42\x89\x88\x59\x4e\x8b\xd4\x67\x63\x96\xba\xca\xe5\xb5\xb4\xfa\xe6\x34\xcd\xc0\x3d\x25\xce\x22\x83\x17\xa8\x34\xb2\xbc\xbd\x2e\x01\x60\x6b\x67\xf4\x1c\xa8\x6a\x41\x06\x85\x55\xd5\x8d\x72\x83\xc4\x08\x73\x7b\xad\x90\x7a\xc3\x37\x1e\x88\xbe\x9d\x91\x3c\x03\xaf\xb0\xd8\xa4\x09\x6e\xa8\x40\x5c\xda\x10\x9d\x4c\x25\xd0\x8c\xf9\xd0\x59\x31\xa0\x4b\x56\x9d\x47\x97\xbd\xa0\x61\xd4\x26\x97\x12\x1e\xd4\xfc\x33\x68\x84\xe6\x64\xc5\xf3\xd4\x9f\x24\x56\x3b\x43\x30\xb1\x14\x9d\x54\xfc\x44\x63\x11\xd3\xcf\x91\x93\x72\x8d\x13\xb2\x62\x28\xe0\xd1\x2d\xb4\x09\x79\x64\x1f\x7e\xab\xc0\xbf\xd5\x95\x14\xde\x0a\x56\xbe\x95\x0e\x8c\xdf\xed\x90\xbf\x19\x1b\xeb\x62\xbc\x63\x2b\x97\xfc\xed\x62\x6c\x2d\xae\x89\x16\x9b\x17\xe3\x8f\x8f\x02\xa7\xa2\xf1\xba\xf5\x17\xe3\x73\x37\x00\xa3\x71\xf0\xbc\x5e\x87\x34\xbf\x15\x36\xee\xef\xbd\x77\x2b\xc0\x76\xcd\x77\xc5\xe0\x5d\x1d\x19\x7d\x6e\x8a\x82\xa1\x17\xa6\xd1\xa0\x87\xe8\x73\xf5\x27\xea\xfa\x9c\xd6\xa5\x8d\x51\x4d\x64\x7a\x5b\xea\x2d\xd0\x59\x31\x38\x1c\x29\xa3\x47\xab\xc4\x2e\x4e\xe5\x5f\x34\x46\x2d\x5e\xc9\x95\x94\x65\x70\x4b\x45\x0e\xb4\xe5\x72\x6f\x1a\xc7\x9b\xd5\x26\x53\xa6\x3b\x9f\xb7\x89\xca\x77\x37\x95\xb8\x21\x25\x80\x64\x78\x9e\xbb\x1e\x65\xe8\xda\xd7\xc7\xe4\x34\x13\x3e\xb6\x08\x6b\x98\xe5\x04\xe8\x24\xe9\x4a\xb7\x23\x85\x88\xa6\xc6\x50\x7c\x24\xac\x51\x88\x34\xc1\x2a\x29\xd0\x03\xb1\x40\x90\x4c\xc1\x2f\xe1\x6d\x96\xe0\xb0\x5e\x9b\xc0\x13\x35\x4d\xdc\x84\xd5\x16\x3e\x0a\x76\xfe\xa6\x37\x66\x3c\x33\x76\x2d\xf2\x6d\x63\x53\x52\x45\xf2\x44\x8d\xce\x47\x15\x5f\x0e\xf9\x69\x80\x9f\x92\xbe\x33\x19\x8b\x52\x91\x26\xff\xb6\x49\xe3\x77\x55\xed\xb2\xe6\xdd\x95\x8c\x2c\x0a\xdf\xf8\xe9\x25\xff\x55\xf6\x52\xfe\x27\x73\x31\xb5\x7e\xb7\x88\x16\x3c\x4a\xd8\xd5\x2e\x40\x7c\x76\x4a\x0b\xc1\x9e\x69\x38\x7f\x77\xcc\xcc\xd0\xed\xa4\xa1\x70\xc7\x4a\x60\x52\x06\x4f\x45\xa5\x64\x1b\x35\xd1\x7c\x2d\x07\x7b\x80\xe2\xd1\xfd\x3d\x11\x91\xef\x35\x64\x9a\x27\xdd\x00\x84\x1b\x63\x65\x35\xde\x51\x30\x5b\x47\x96\x9c\xb2\x0a\x5f\xcd\xcb\x8f\x02\x7e\xd6\x87\xc5\x37\xa5\x48\x13\x53\x83\xb0\x33\x60\x02\xff\x5c\x82\xbc\x64\x1a\xb6\x8e\x9b\x4b\x9c\x5c\x7e\x3c\xdc\xe0\x0d\xe6\xce\x20\xc6\x45\x45\xb2\x3b\xcf\x38\xf7\xfb\x17\xf1\xe2\x2a\xc6\x01\x3a\xbf\x11\xe7\xb5\x55\xce\x95\x35\x3c\xdd\xdf\xab\xee\x23\x14\x6c\x0f\xcc\x92\x4b\xec\xfe\xb5\xdb\x5a\x51\x30\xaa\x39\xdd\x6e\x44\xa1\x03\x91\xd8\x82\x36\xfa\xcd\xad\x70\xd1\x5a\xeb\xb6\x24\xde\x63\xe5\x5e\x51\x43\xa4\x8c\xae\x61\xc7\xb7\x64\x96\x65\xc2\xb3\x5e\x69\xf1\x48\x25\xc5\x99\xac\x72\xae\x78\x20\x6a\xb5\x37\xbb\x21\x47\xd2\xa5\xd1\xe1\x64\xc0\x0b\xe0\xb2\x3d\xe7\x65\xaa\x13\x94\xcf\xad\xe5\xe7\x01\xcb\x94\xab\xf4\x4e\x4e\x51\x0f\xc8\x9a\xab\x34\xec\x14\x8d\x16\x51\x97\x5b\xd4\x03\xb8\xe6\x2c\xbd\xad\x5b\xd4\x03\xb3\xe5\x2c\x1d\xee\x16\xc5\x31\xf4\x69\x61\x4d\x6b\xa6\x7e\xd5\x21\x6d\x3c\xc7\xa4\xd1\x34\x00\x44\x1f\x79\xf7\xdf\x7a\x54\x0d\xdb\x4e\x73\xc2\x2f\x21\x58\x30\x91\xc3\xbd\x75\xa9\x2c\xcd\xc7\xd2\x82\xb0\x3c\x59\xf3\x34\x50\xd8\xc7\xc3\xef\xed\xe9\x54\x0c\x81\x25\xd2\xf3\x6b\xfd\xb6\x70\xaf\xfa\x7d\x6b\x39\xbb\x86\xd5\x2d\xad\x37\xa7\x2c\x98\x3e\xaa\x97\x3a\x8c\x27\x21\x50\x9a\x91\x7b\x28\xbd\x66\x42\x79\x84\x3d\x20\xd5\x32\xfe\x31\x3c\x68\xc0\x3b\x2b\x6e\x17\x3c\xd5\x7c\xa6\x7d\x25\xf4\x09\x83\xa7\xea\x51\x52\x6e\x10\x95\x07\xa6\x13\x71\x75\xdb\xe0\x29\x1f\xc4\x2a\xf8\xe7\x3e\x83\xa7\xd4\x9b\xde\x7a\x1f\xee\x7b\x13\xa8\x6f\x0b\x02\x65\xe5\x3a\xb1\xfb\xdf\x16\x44\x55\x2e\x0b\x46\x93\x13\xbe\xc9\x07\xa1\xd1\x19\x6e\x91\x88\x65\x57\x7e\x60\x35\x42\x18\x2e\xd0\x09\x93\x74\xbb\x79\x4f\xf0\x08\x50\x29\xb5\x49\x03\xa8\xc3\xa1\xeb\x01\x1a\x74\xf3\x3a\x1b\x2c\x76\x9a\xde\xdc\x80\x1f\xb8\xdb\xbb\xab\x9b\x47\x9a\x57\xa8\x04\xb5\x0e\x04\x17\x64\x13\x28\x75\xf5\xf8\xd0\xa0\x29\xf2\xb8\x6a\x43\x31\x0c\x1e\xa0\x9d\x5e\x5a\xdd\x84\x5e\xaf\xcf\x7e\x97\x0a\x62\x97\x20\xfd\xb7\x1e\x98\x50\xa5\x3a\x95\x57\x53\xc0\x23\x10\x18\xe1\x01\x59\x0b\x97\x70\x03\x23\x1a\xd6\x98\x4b\x53\x1a\xcd\xde\x45\xd6\x08\xa5\xdb\xe9\x2f\xa5\xe4\xa6\x4c\xb3\xf4\x37\x4d\x0f\x27\xa7\x3f\x84\x72\x1d\x36\x79\xc2\x0a\x69\xe1\xed\x90\x35\x47\xe2\x44\x2a\x7d\xca\xf0\x7d\x5d\xe1\x65\x39\x9c\x74\x0a\x74\x3a\xd7\x85\xa2\xd5\xa5\xa2\x4f\xe4\xbe\x41\xd2\x41\xfb\xed\xcc\xfa\xab\x1a\x28\xdd\x45\x4c\x3c\x50\x4b\x6e\x62\xb0\xc9\x9a\xf3\x22\xfb\x03\xa1\x13\x26\xa0\xdc\x53\x3d\x5f\x9b\x50\x33\x32\xbd\x93\xc3\xa2\xcc\xc4\x8b\x1c\x31\xd9\xe5\x33\x96\xd1\x9b\x41\xec\xa6\xfe\x48\x9b\x6f\x3b\x7e\x88\x98\xfa\x38\xec\x12\x39\x97\x09\x2d\x29\x68\x60\x9e\x4a\x9d\xd4\xb6\xc7\x91\x4f\xb7\xa9\x18\x17\xc4\x62\xc9\x0b\x9f\x9c\xab\x2e\xbb\x9d\x25\x00\x3c\x62\x72\xe0\x03\x30\x6e\x71\x73\xe4\x62\x04\x65\x23\xf0\xc5\x93\x74\x21\x37\x88\xb0\x64\xc1\x26\xc8\x38\x4a\x73\x70\x4f\xe8\xa8\x05\x63\x79\x5c\xdc\xac\x4b\xa3\x50\xc1\x25\xa2\x9c\x0a\x14\x81\x22\x70\x97\x99\xb7\xf1\x2d\xb6\x9a\x5b\xc7\xef\x5c\x9a\xf6\xb7\x3e\xf1\x4e\x52\xb5\x55\x1e\x88\x89\xdc\x0e\x79\x33\x7b\x8f\xb2\x69\x93\xe7\x2c\xbb\x85\x90\xaf\x3d\xd0\x26\x16\xaa\x21\x3a\xa2\xa0\x13\x28\x41\xd0\x5f\x9c\x6d\x12\x9c\xd5\x6b\x76\x29\x38\xc2\x0d\xc4\xb6\xd5\x8d\x9b\x2a\x00\xf6\x59\x83\xf6\xf2\xbd\x34\xc9\x58\x00\xdd\xae\xde\xb4\xbc\x2f\x94\x06\x32\x70\x9c\x0c\xe9\x13\xe9\x97\x7f\xad\x82\x79\xba\x62\x30\x6b\x48\xf7\x3f\x68\x18\xb2\xce\xc8\x03\x32\x9d\xc1\x2d\xa8\xa4\x61\x88\xa8\xc0\x1b\x84\x77\xbc\x28\x05\xa8\x15\x3a\xa5\x03\x20\x1a\xdd\x32\x9b\x71\x78\x19\xb4\xb5\x8e\xfe\x9c\x6c\xf2\x77\x39\xbf\xce\x27\x52\x38\x8b\x0e\xb8\x1e\x98\xaa\x63\xc3\x6c\xe4\xc5\x9a\x1a\x60\x0a\x7e\xca\xcc\x8e\x82\xc5\x4a\x85\x50\x06\x1e\x4b\x74\xdf\x07\x53\x7b\xbc\xa5\x0c\x44\xa3\x61\x79\x1f\xf6\x82\xd3\x34\x3d\xe8\xd9\xd1\xd6\x78\x2c\xb3\x12\x57\x76\x79\x76\x9c\x6d\x3b\xd1\x82\x8b\x5a\xf2\x31\x2f\xe4\x41\x2a\xb9\x47\xae\x39\xcd\xe1\xa2\x91\x8f\xe8\xbb\xa5\x56\x48\xe4\xc4\x3c\x57\x95\x0d\xfb\xde\x77\x5c\x8d\xac\x97\xcb\x75\x3e\xaf\x0c\x6b\xdd\x8c\xa3\x05\x52\xde\x89\xd8\x6e\x0e\xa6\xbf\x4e\xdf\x0b\xcb\x09\xcd\x43\x1d\x30\x31\x2f\xd0\x9d\xc6\x56\x56\x49\xa7\x8a\x01\x5a\xa9\xa5\x5b\x69\xc4\x76\x48\xa4\x97\xd7\xda\xc3\x0e\xd8\x0c\x4d\xa3\x04\x89\x70\x3e\x23\xb3\x85\xdb\xda\x79\x5b\x30\x19\x72\xe6\x84\x39\x38\xd8\xf0\xdd\x0c\x98\x2e\x6f\xf2\xbd\x2b\xea\x55\x9a\x52\x07\x1e\xe6\x8c\xe2\xee\x5f\xf9\xb6\x62\xba\x56\x69\xbc\xc8\x77\xec\x00\x7e\x91\x93\xcf\xc9\x4b\x4e\x93\xa7\xd2\x3f\x8e\x48\xd6\x9c\x2e\x58\x82\x4a\x03\x05\xc2\x77\xe6\x8d\x5b\x22\x77\xfb\x3a\xd5\xfb\x15\x2b\x67\xe4\x73\xa9\x20\x1b\x77\x09\x59\x6f\x64\x24\x0a\x9e\xd7\xf5\x48\x6f\x4c\x79\x46\xa1\xcd\xbb\x34\x46\xed\x33\xda\x65\xda\xe8\x9b\x80\x22\x22\x13\xf2\x0d\xcd\xc0\xb3\xe6\xb2\xc1\x9f\x3c\xc3\x70\xff\xd5\x17\x05\xcb\x8f\x96\xa9\x98\xcb\xde\x13\x9d\x26\x62\xfd\x9d\xdf\x30\x9a\xdc\x0c\x7c\x63\xf9\x7e\x9d\x10\x8f\xe5\xfa\xed\x6a\x41\x84\x2b\x85\xcc\x48\xe3\xa3\xf5\x3d\x54\xd4\x1b\xfb\x5a\x1d\x50\x6f\xf7\xa2\xe4\x73\xf2\xec\xd5\x59\x7d\x07\xbb\x81\xf6\xbe\xe1\x80\x1d\xec\x80\x0c\x8a\x5b\x67\xb4\x04\x7f\x31\x46\x1b\x96\x64\x10\xd0\x41\xad\xaa\x84\xbd\xee\x47\xd3\x45\xa1\x9f\x93\x24\x0f\x17\x92\x34\x12\x8e\x09\xcc\x45\x7e\xe3\x39\x13\x9f\x1a\xa9\x2e\x89\xdc\x1d\xa5\x58\x5e\x9d\x2c\xf0\x09\xcc\xb5\xa2\xb3\x83\xbf\x0c\x02\x90\x01\x8d\x62\x13\xc3\x9c\x9f\x6f\xb2\xca\x17\x38\xe4\x3c\x74\x00\x6d\xbe\xe3\x78\x34\xb8\x7e\x5b\x67\xff\xbe\x13\x33\x19\x36\x11\x49\xac\x86\x51\xe5\x09\x1a\x16\xd8\xb5\xe8\x64\xcf\xae\x3d\x0e\xa7\x57\x12\x92\x51\x51\x9e\x17\x34\x17\x12\x10\x74\xd7\xee\x71\x8e\x3a\x47\x4b\x36\x09\x18\xa2\x3d\x4a\x32\x21\x2b\x44\xcc\x2f\xd8\xec\xae\xcf\xe3\x22\x91\xe7\x77\x7e\xbc\x4b\xd7\xb9\xc5\xe3\xfe\x02\x12\xbd\x0f\x07\x15\x3a\x7f\x4d\x3e\x75\x8e\x67\xa3\x20\xc9\x54\x87\x1d\x24\x42\xe3\x12\x71\x0c\xe6\xc3\x1c\xea\x68\x34\xba\xc5\x72\x0d\x77\x3a\xb5\xcc\xe9\x4c\xf3\xa6\x9e\x75\xf8\x1f\x6c\xac\xcd\x4a\xab\xce\x2e\x1b\x9d\xeb\x0d\xd3\x32\xda\xdc\xbc\x62\x25\x22\x49\xba\xbe\x6e\xac\xd3\x19\x2d\xed\xed\x56\xd6\x38\x96\xfa\x5d\x35\xa8\x13\x22\x09\x72\xf1\x88\x9c\x42\x2f\xcf\x4b\x55\x41\x19\xd2\x4f\x17\x15\x71\xe0\xb6\xdf\x72\xc8\xa9\x25\xb2\x24\xc7\x29\x2f\x3c\x66\x26\xfe\xd1\x96\xda\x8c\x3c\xd9\x1b\x79\x86\x34\x50\xa2\x41\x9a\x8d\x92\x22\x46\x87\x20\x00\x5d\xfa\xf6\x2f\x98\x65\xaa\x7d\xce\xa6\xf0\x23\x34\x53\x96\xdb\x8c\x33\x9b\xe3\xa5\x8b\xab\x4b\xb6\x55\x25\xb1\x05\x60\xc2\x5d\x25\x5d\x08\x72\x51\x4f\xf6\xb0\x46\x9a\x49\x21\x6f\xc8\xdb\xb9\xc2\xd7\x10\xfd\xf9\x4d\xf8\x8d\x79\x9a\xc7\x69\xe2\xdc\xa9\xbe\xe2\x09\x03\x4e\x55\xec\xaa\xb1\x63\xb4\x2c\xd4\xdd\x7a\xc3\x65\x26\x6d\x00\xe8\x1e\x3c\x21\xba\x9e\x86\x4e\xeb\xc7\xed\x7d\x69\x76\x05\x0e\x1b\xf2\x64\xaf\x7b\xf7\xfb\xed\x08\xf3\xa3\xaf\xef\x67\xe4\xf1\xd1\xd1\x81\xaf\x86\x93\xe3\x08\xf3\x53\x42\x9f\x33\xcc\xd0\x9c\x18\x48\x74\x87\x87\x07\xc3\xa9\x4e\xdc\x2f\xd9\x9d\x85\xe8\x2e\x0c\xb1\x4e\x77\x87\x87\x07\x43\x08\x2f\x00\x12\x3e\xe4\x3b\x11\x5e\x00\x66\x15\x17\x31\x94\xf0\x0e\x0f\x0f\xfe\x5c\x94\x67\xea\xbf\xce\x86\xd1\xd4\xd8\xd6\x8b\x35\x28\x10\xb5\x2e\x88\x2d\xd7\x84\x17\x6c\x15\x7c\x60\x93\x55\x2b\x67\x9c\x8d\xcd\x5c\x67\x34\xcd\x65\x8a\x10\x2f\xcc\x44\x01\x90\x9d\x4b\xb0\x13\x9d\xbe\x79\xfd\xcf\x3f\xdb\x37\x96\x7a\x72\xfd\xa3\x00\x64\x9d\xa0\x20\xcf\x81\x64\x6b\x35\xbb\x48\x67\x31\x18\xeb\x21\x55\xc9\x1f\x7c\xb5\xda\xe4\x21\x3f\xaf\x59\xb2\xb2\xdc\x91\x49\x00\xff\x00\x93\x11\x3f\xe6\x32\xd6\xc1\x89\x3c\x2e\x73\x5e\xa0\xa6\x5f\xb7\x1e\x64\x7e\xac\x46\xed\xe2\xb3\x13\x39\x11\x21\x3f\xc8\x7b\x16\x89\x89\x00\x48\x83\x23\x5d\x5d\x20\x10\xde\x52\x30\x29\x4a\xd4\x1a\xd4\xab\x05\xe0\x56\x2f\x9d\xe6\xa2\x64\x34\xd1\xdd\x4b\x79\xa1\x5d\xf0\x0d\x13\xf4\x91\x30\x78\x0a\x00\x4d\x73\xc9\x9e\x74\xba\xab\xd0\x95\x0d\x17\x70\x21\x56\xe1\x53\xf2\x45\xec\xab\x0f\x21\x02\x9e\x7b\xde\xda\xe9\x85\xd6\x40\x3a\x02\xa5\x43\x0b\x55\x01\xe5\x30\x6f\xc0\xc0\x4c\xf9\xf6\xda\x1b\xab\xd5\x6e\x80\xa2\xc1\x2b\xc5\xcc\x8a\x50\x86\x52\x01\xca\xe4\x40\x0c\xa1\x18\xd9\xf5\x75\x64\x12\x2d\x79\xb1\xd8\x4d\xf8\x75\x8e\x15\xed\xee\x47\xfb\xbb\x09\x8f\x77\xe5\x57\x13\xb3\x8c\xa8\x7c\x1f\xd2\x06\x70\x99\x91\xe6\x8a\xf7\xc1\xa2\x52\x6d\x31\xeb\x6f\x62\x03\x05\x2b\x63\xb4\xb7\xb7\x0c\x70\xa6\x4a\xda\x63\x86\xea\xc2\x51\xd6\x55\xd3\x9e\x00\x5c\xa0\x6a\x35\x1c\x86\xeb\xc5\xf8\xfc\xe4\xd4\x9f\x6a\x89\x5f\x0c\x92\x6b\xd3\x61\x3e\x4d\x30\x96\xf5\x9b\xe6\xc2\x92\xf7\xfb\xc2\x0d\xf1\x6b\x22\xbb\x24\x17\x33\x41\x5a\xcd\xd7\x77\xc8\xd2\x01\x1b\x80\x9a\x8a\x76\x08\x98\xe7\xba\xaf\xaf\xb0\x15\xea\x57\x8d\xfd\x8f\x4e\xb0\xf0\xc0\xb7\x61\xc6\xd1\x63\xb8\x19\xb3\x71\xa8\xf2\x33\xfd\xea\xe0\xf1\x30\x49\x65\xa1\x06\xb4\x1f\x56\x54\x0e\xca\x10\xa9\xc9\xf4\x10\xe7\x76\x0f\xa4\xa7\xcd\x12\x96\xb4\xb5\x94\x9a\x2e\x12\x00\x3b\x54\x4b\x79\x01\x87\x1a\x61\xef\x11\xe1\x44\x33\x9f\xc7\xd1\xfc\x58\xb6\x91\x56\x01\x5d\x2c\x09\x72\x84\x54\xb4\x59\x42\x88\xa4\x5b\x4c\xd9\xbc\xf8\xc6\x34\xae\x97\xc8\xc6\xb9\xac\xe5\xa9\x87\x0e\x5e\xdb\xd7\x08\x91\x2b\x58\x9e\x28\x56\xbe\x2e\xf8\x25\x24\x63\xee\x4c\x00\x6e\x12\x00\xa9\x7a\x99\x20\x1f\x65\xa7\xd2\x08\xd1\xc5\x95\xec\xaa\x65\xfd\xb6\xab\xd4\xcd\x92\xa3\xd0\x97\xbc\x6b\x85\x5f\x2a\x00\xd2\xe3\xc6\x37\x6e\x51\x60\x19\xb7\x37\xc8\xd0\x37\x39\xcf\xde\x96\x33\xe6\x17\x0b\x94\x7d\x59\xb4\x71\x6a\x72\x9b\x78\xde\x81\x68\x59\x30\x03\xf4\xa5\x37\x33\xb4\x56\xbd\x00\x44\xe0\xcb\xac\x1c\x27\xda\xce\xdd\x16\x2d\x6d\x8d\x67\xf7\x56\x7b\xa4\xf7\x1d\x01\xf2\x48\xc2\x5e\x9b\x65\x81\x83\x1b\xd3\x40\x57\x4a\x0b\xc0\xa5\x46\xe9\x85\xec\x3f\x3c\x42\x89\x4e\x8e\x20\x65\x3a\x87\xab\xb4\xb1\x59\x08\xbb\x28\x45\xa5\x22\x04\xe0\xe6\xbc\x9c\x68\x77\xf5\xa9\x8c\xa8\x5f\x10\x76\xc5\x8a\x1b\x72\x84\x8d\x9a\xee\x99\x89\x34\x79\x50\x72\x34\x51\x9f\x04\x60\x9a\xeb\x59\xa8\x14\xfa\x29\x84\x6d\x08\xb8\x3a\xb0\x7c\x14\x6a\xa8\x1c\x9f\xb0\x19\xa0\x02\x78\xc3\x9f\x4c\xd7\x30\x89\x28\x64\x97\x23\x0c\xc6\xec\xcf\x0d\x1e\xdf\xe4\xe6\x7f\xdc\xb6\xe0\x3b\x9e\x9b\x0c\xf3\x8b\x32\x93\x93\x92\x89\x2a\xa5\xb4\x32\xb3\x7b\x6c\x9a\x00\xd4\x86\xb5\x03\x6e\xfc\x67\x32\x77\x82\x6e\x42\x42\x32\xe7\x7a\x65\x36\xea\x95\x30\xee\x70\xbf\xa7\xab\x76\x5e\x7c\xc8\xf2\xb9\xb3\xdc\x0b\x1f\x54\xf8\x46\x60\xf7\x1d\xdd\x5a\x3a\xda\xfd\x4c\xea\xe3\x6f\xa0\x2e\x88\xd9\x30\x49\x3a\xee\x78\xd4\x51\x83\xa8\xee\x9f\xa1\xef\x4a\xbd\x30\x09\x79\x71\x6a\x54\x77\x65\x8e\x4b\x1a\x52\xea\x11\x52\x84\x84\x96\x46\xdd\x3c\x26\x00\x57\xc6\x28\x43\xc5\x40\xca\x41\x44\xc8\x73\x04\x08\xca\x19\x6c\xca\x89\x25\x6e\xad\x62\x9f\xbc\x78\xf6\x26\x00\xd1\xd4\x26\xd1\xf9\x0b\x17\xe3\xe9\x5e\x24\x7f\x77\x9f\x98\xb2\x1d\xf3\x64\x6f\x6f\x36\xc3\xff\x6f\x4b\x09\x9d\xf3\x60\x62\xb6\xd1\xd9\xcc\x3a\x10\x4a\x6e\x60\xee\x5d\x8c\xc1\x37\xc9\x8b\xd3\xab\x43\x69\xaa\x5c\x8c\x67\x33\xf7\x53\xbf\xca\xa3\x6f\x46\x74\x97\xea\x46\x36\x81\x8e\xba\x40\x1c\x52\xd3\xc2\xf4\x11\xa2\xbe\x5e\x3d\x47\xf8\x52\x8c\x2b\x58\x5a\x32\x9d\x9b\x0a\xdd\x5d\x2a\x42\x8c\x16\x59\xca\x8a\xee\xfc\x94\x00\x5c\xa9\xdd\x26\xa9\xca\x10\x91\xb7\x44\xd8\xeb\x2e\xe2\x92\xde\xcb\x1d\x69\x19\xf9\x2b\xae\x19\xe7\xa5\x09\x77\xc6\xd9\xb8\x64\x25\x8d\xaa\xf0\x0d\x5c\xb8\x81\x96\x26\x86\x96\x26\x0a\x0f\x13\xb9\x53\x41\xe3\xc2\x96\xa7\x31\x2a\xa3\xc9\xf4\x9b\xfc\x93\x96\xff\x8e\xf8\x47\xea\xdf\xdf\x2f\xc6\x66\x19\x01\xb8\x3a\x75\xbc\x33\x43\xb0\xea\x1e\x6f\xd2\x90\x52\xe1\x2c\x24\x00\x55\x9a\x9f\x6e\x12\x56\x3a\xef\xc4\x6b\x95\xfe\xdf\x9f\x42\x8e\xdf\xc3\x68\xba\x1f\x30\x2a\xbc\x57\x6e\x9d\x6c\x04\x47\x4e\xb7\x3e\x6d\x32\x02\x38\x9a\xe5\xd7\x03\xde\x95\x90\xad\xb9\xdb\x63\xc8\x39\x9a\xf2\x64\xda\x83\x39\xf6\x96\x2a\xac\x25\x0c\x6c\xfd\xeb\x96\xca\x17\xf8\x20\xff\x2d\xff\xf5\x61\xea\xfc\xbd\xff\xcb\xde\xe4\xd0\xfc\x7d\xf4\xcb\xde\xe4\xe8\xd7\xed\x8b\x68\xfb\xf7\x83\x8f\xb7\x7f\x6e\xd7\x3c\x32\xdd\xd7\xdf\x1c\xfc\xb2\x37\xd9\xff\x75\xfb\x2f\xdb\x1f\xb6\xfe\x55\x7c\xbe\xa5\xd6\x72\x3c\xf9\x86\x4e\xe6\xbf\xfe\x3e\xdd\x39\xfc\x38\xdb\xfe\xfd\xcb\x8f\xad\x4f\x3f\xcc\xb6\xb7\x3f\x74\x0e\x7e\xfc\x71\x6b\xd6\x1a\xbd\xb5\xa5\x57\xa0\x57\x95\x7c\x98\x26\xc9\x87\x5f\xa6\x93\xaf\x7e\xfd\x3a\xd9\xde\x8a\x82\x5f\xe3\x55\xb7\xfd\x13\x1e\x7d\xdc\xda\x6a\x4f\xb9\xfd\xfb\x74\x67\xff\xe3\xf6\x87\xd9\x7d\x4e\x7d\xe8\x9d\x1a\x2b\xee\xfa\xea\xeb\x4f\xb0\x9e\xc0\x82\x0e\xbc\x0b\x3a\xf4\x2c\xe8\xf7\xbd\x9d\xfd\x8f\xf7\xbb\xa8\x7d\xef\xa2\x8e\xfc\x8b\x3a\xb8\xe7\x45\x4d\xbd\x8b\x7a\xec\x5f\xd4\xe1\x27\x5c\xd4\xcc\x37\xff\x97\xfe\xf9\x8f\x3e\xd9\xfc\xdb\x5b\xff\x27\xfa\x62\xfb\x6b\xf1\xf9\xd6\xc5\xee\xd6\x14\xa0\x9e\x28\xee\x31\xd5\x7c\x41\x42\xd4\x7f\xe2\xdf\xdb\xdb\x7f\xf1\x15\x11\x19\xe8\x66\x19\xd4\xe3\x21\x7c\x5f\x6e\x7e\x92\x5c\xa8\x80\x1d\x04\xae\x9d\xf2\x2c\x8d\x6f\x06\xf8\x6e\x74\x8c\xcf\x68\x90\xb4\x78\xd4\x31\x87\x8d\x2a\x13\x26\x76\x25\x4b\xe7\x2c\xbe\x89\xbd\x15\x5b\x74\xe1\x71\xc8\xef\xeb\x34\x4b\x62\x78\x3f\xaa\xe8\x15\x42\x85\xe0\x31\xfa\x39\x68\x53\xae\xa5\x7b\x06\xc0\x6a\x59\x6f\x23\x81\x75\x7c\x8c\xa9\x72\xa7\xa5\xba\xb6\xe5\x79\x11\x91\x17\x95\xed\x14\x00\x5b\x72\x83\x27\xd3\xf0\xdc\x2d\x08\xa4\xbf\x92\xea\xc1\x0f\xb9\x09\xc9\x79\x74\x67\xb7\x9f\x86\x17\x18\x61\x67\x19\xfd\x01\xd2\xd3\x99\xc6\xc5\xa9\x35\x8f\x66\xc3\xa8\x60\xdc\x7e\x52\x5b\x5a\x09\x13\x29\x4a\xce\x0c\xdd\x2e\xd7\x03\xad\x95\xf0\xd8\x98\x1b\x32\xc1\x23\x93\x35\xe5\xd2\x7c\x5e\xa0\xe5\xe9\x26\x46\x38\xa2\x5d\x78\x8f\xba\xfc\x62\x6e\x72\x75\xcc\x0e\x5b\xb2\xd0\x85\x15\x1b\x65\xe8\xdb\xaf\x15\x98\xc0\xa9\x49\xaf\xcb\xd1\x6b\x1f\xba\x0a\x43\x35\x53\x06\x14\xb5\x7e\xeb\x10\xbf\xf4\x3a\xf8\x75\x73\x6b\xe8\xb5\x4d\x21\x6f\x66\x8e\xda\x76\xce\x81\x1a\x35\xe6\x97\x16\x0e\x5e\x4a\x4e\x8e\x7f\x3a\xab\x6f\xaa\x88\xba\x51\xdc\x03\x37\xbc\x01\x58\x7c\x1b\xaf\x7d\x4b\xbd\x1d\xd6\x87\x63\x1e\xbf\x71\x46\x85\x48\x63\xd7\xe2\xef\x7b\xa4\xb1\x23\x1d\x10\x5a\xc7\xa6\xb6\x4f\xbd\xe0\x49\xd3\xa1\x41\x73\xb9\x3f\x7a\xa6\xfa\x3e\xd9\xa0\x9c\x01\x60\x9b\x7e\x8e\x13\x05\x30\x1a\xf5\xae\x67\x30\x3e\x75\xe0\xb7\x76\x74\xbf\x48\x32\x16\x4c\x1f\x09\x63\xb6\x0b\x8e\x21\x26\x95\xf7\x38\x08\xa8\xf5\x76\xc9\x74\x33\x24\x6b\xa5\xc8\x4e\x84\x5d\x5c\x4b\x3b\x45\xfe\x57\x30\x68\xc7\xfd\x4d\x93\x0c\x85\x89\x64\x61\xaf\xb6\xf3\x04\xc9\xbc\x3a\x33\xb3\x9a\x20\x92\xee\xf6\x81\xf0\xeb\xb5\x3b\xd6\xa8\xb8\x01\x25\x02\xd5\x8b\x1b\x85\x3c\x74\xc9\x8e\x81\x70\x6f\x55\xd8\x83\x1c\x8f\x06\x80\x84\xe7\x26\xcd\x6c\x51\x4c\xbd\xf2\x46\x19\xcc\x1d\x58\x9b\xbe\xb8\xf5\xae\x9f\x98\xba\x95\xa2\x2b\xe7\x29\x62\x01\x74\x56\x65\xed\xbb\x81\x60\xeb\xb7\x96\x00\xf8\x78\xcf\x16\xe6\x1d\x5c\x40\xaf\xf9\x5b\x5d\x06\x0e\x7a\xc0\xf8\x68\xcd\x16\x0e\x7a\x68\x90\xb2\x39\xd0\xe7\xea\xfe\xe6\x2a\x20\xf0\x0f\xb0\xc0\x0e\x08\xf7\xc4\x02\xf5\x4c\x9f\x8e\x05\xbe\x7a\xf9\x34\xfa\x94\xc8\x0c\x45\xd3\x7a\xb0\x67\x5b\xb3\x81\x8d\xc8\xbf\xf9\xbc\x2d\x8f\x7b\x61\x4a\x22\x44\x30\x07\x45\xa6\x6c\xc9\x0c\xda\x5a\x7e\x33\x29\xd8\xa5\x92\x3b\x00\xa6\xab\x06\xcb\x0b\xfe\x8b\xb1\x96\x1a\x17\xe3\x19\x39\x36\x22\x44\xe6\x48\x90\xa7\xb7\x58\x2b\xb8\xef\x8a\xbe\x63\x42\x66\x55\x42\x09\x4c\xd0\x6b\x4c\xc6\x4b\xd0\x92\xb0\xd4\x06\x37\x95\x05\xcd\xc5\xda\x9f\x09\xea\xfe\x66\xf4\x86\x15\x64\xeb\xfc\xe4\x74\xf7\xec\xec\xe5\x36\xd1\xb7\x01\x52\x1b\x89\x15\xb7\xd4\x43\xbe\x3b\x3f\x3f\xdd\xc5\xbf\xce\xb6\xa3\x01\x80\xa1\x47\xd6\xc3\xf6\x25\x7a\xab\xec\x1c\xad\xcb\xd4\xda\xcb\x25\x3c\x16\x11\xbd\x16\x11\x5d\xd1\xdf\x78\x1e\xc5\x7c\xb5\x7b\x2c\xff\x7c\x7e\x72\xb6\x8b\x92\x22\xa2\xdc\x35\x0d\xdc\x0a\xd4\xc8\x63\x0d\xc7\x29\xc8\x41\x44\xe8\x04\xf2\x59\x9c\x5d\x0e\x58\xa7\xde\xa3\x57\x2f\x9f\xaa\xfd\xd1\x51\xb9\xf5\xfd\x71\xb0\x3f\x00\x64\xe7\xfe\xd4\x36\xa6\x89\xf6\x3f\x07\x42\xf3\xec\x32\xac\x45\xf6\x9b\x72\xe6\x67\x62\xce\xc1\x80\x91\xaf\x5e\x3e\x1d\x7d\x32\x56\xaf\x33\xa3\x92\x59\x70\xd4\x44\x02\x1c\x7d\x02\x16\xb7\x88\xd7\xb3\xd1\x70\xce\xb6\x88\xd7\xf7\x61\xac\x7c\x7b\x72\xfa\xdf\x60\xac\x60\xf1\xff\x68\xc6\x0a\x82\x13\x8f\xe5\xa5\xdd\x6d\x85\x8c\xfb\x6c\xa3\x2d\x8b\x8e\x79\x54\x97\x81\xbd\x60\xe5\x85\x5a\x75\xf1\xa7\x03\xcd\x9c\x20\x99\xc6\xae\xdc\x45\xd2\x20\x1c\xec\x5b\xd9\x13\x01\x5c\xec\xac\xca\x5f\x96\xf2\x4c\xcd\x35\x00\x64\x6d\x35\xca\xd7\xa4\xa0\x6a\x4c\x9a\xfb\x4f\x7d\x63\xa7\x3e\x1c\x82\x01\x79\x21\x87\xb4\xc2\x82\x2d\x40\xcc\xa6\x29\xdd\x92\x91\x1f\x4f\x4f\x1a\x51\xa8\x72\xe2\x01\x40\x5b\x56\x44\xe4\xb2\xbe\x38\xe3\x9b\x24\x5a\x70\xbe\xc8\x98\xe4\x7b\x4e\xba\x34\xcb\x17\x69\xce\x24\x77\xdc\x5d\xf2\xeb\x49\xc9\x77\x0d\x96\x26\x0e\xfb\x4b\xf3\xc5\x67\xaa\xd3\xc4\xdb\xc1\x7b\xad\xe5\xc9\x4b\x1e\x87\xf6\xa2\xbe\xee\x01\x60\x81\x12\x22\x81\x36\xb6\x42\xa5\xed\x4a\x2d\x4d\x6f\x47\x7f\x1c\x8b\xf9\x01\x02\x05\x5d\x31\xb3\x2b\x5b\xf0\xd7\xfd\x78\x7a\xb2\x0d\xa3\x09\x5f\xb6\xf8\xc6\x00\xa0\x08\x87\x6a\x6e\x66\x47\x84\x41\x15\x49\x38\x68\xa1\xa9\x4d\x3d\xd7\x4c\x82\x5c\xb2\x25\xbd\x4a\x79\xcf\xa6\xd7\x77\x53\xed\xb8\xd9\x84\xcf\x14\xc2\xf4\xde\x7e\x3a\xd1\xa6\x8e\xcc\x80\x81\x72\x3f\x3f\x9d\x6c\x1b\x28\x92\xfa\x35\xee\x1a\x23\x74\x95\xed\x7e\x0f\xe4\x68\x88\x31\xd9\xa2\x85\xe3\x7a\xf1\x49\x88\xaf\xf1\xf1\x4f\x67\xe3\x1d\x32\x3e\xfe\x6d\x53\xb0\x71\x28\xca\x0f\xbf\xe3\xa7\xb4\x60\xdf\xb3\x92\x66\x78\xe6\xdb\x93\x53\xfc\xe7\xd5\xa6\xa4\x79\xfa\x1e\x7f\xca\x5b\xe4\x92\xc6\xef\xc6\xea\xce\x7a\xfc\xe3\x19\x1a\xf3\xb2\x60\x8c\xed\x90\x1d\x9f\xc0\xf0\xe8\x1b\x81\x57\xe8\x19\x63\xd7\xdf\x33\xee\xdb\x40\x8c\xab\x56\x97\xd4\x5b\xf7\x8c\xb2\x08\xe9\x19\xa7\xf1\xd4\x33\xea\xc5\xd3\xef\x47\x7f\x98\x84\xfb\x55\xb3\x1e\xb5\x6c\x00\xfd\x8b\x98\x87\x88\xbf\x46\xf8\x72\xac\x73\x97\x83\x03\xa0\x3e\x33\xa5\x86\xdb\xa4\xec\x85\x2c\x95\x00\xf6\x7e\xcd\xa5\x33\xe6\x94\x0b\x91\xc2\x39\xe5\x12\xfc\x73\x1d\x38\x3b\x56\x14\xfa\x42\x73\xa9\x00\x89\x86\xc9\x73\x42\x0c\x88\xc0\x90\xe7\xef\x7b\x86\xf4\x6e\x5d\x78\xdb\x26\x5d\x97\x70\x9e\x91\x12\xb9\xa3\x3b\xec\x6c\xae\xe3\x92\x67\xa3\xde\x4d\x35\x43\xb5\x93\xa5\xe1\x34\x71\x83\x9c\x75\x1c\xdb\x27\x4d\xfb\x6c\xc0\x8e\x46\x77\x53\x6f\x4d\x3c\xfe\x40\x42\x7e\x48\x86\x7a\x48\x86\x7a\x48\x86\x7a\x48\x86\x7a\x48\x86\x7a\x48\x86\xfa\x34\xc9\x50\x3d\xf2\x78\x5d\xa4\x57\xb4\x64\x03\xc4\xb1\x1e\xe9\x97\xc6\xa7\x7a\x80\x91\xb7\x9d\x20\xc9\xed\xa4\xb0\x86\xf9\x20\x7d\x1f\xa4\xef\x83\xf4\x7d\x90\xbe\x0f\xd2\xf7\x41\xfa\xfe\x6f\x92\xbe\x7e\xff\x5e\x5d\x2e\x19\x71\x08\xec\x75\xc8\x4f\x2d\x4f\xea\xe1\x9d\x9d\x60\x89\x73\xdb\xed\x86\x34\x68\x2b\x17\x5f\xe8\x62\x50\x4c\xd4\x82\x4e\xab\x2b\x76\x0f\x5c\x73\xb8\xff\xaf\x75\xdd\xd7\x26\x20\xc6\x8e\xc6\x14\x2f\x74\xc6\x6d\xed\xd6\xcc\x47\x65\xfd\x65\x2e\xa5\xea\x6e\x1b\x68\x99\x98\x8f\x40\xdb\xbd\xe3\xce\xa5\x91\xd4\x16\x96\x07\x42\x35\x9e\x35\xc5\x9a\xc9\x7c\x20\x65\x89\x67\xa6\x2b\xe4\xcc\x76\x77\xeb\x19\x43\xd2\x97\x1d\xf3\x1c\x4d\xab\xc4\xae\x8e\xf3\x15\x93\x6a\xa5\xe6\xb3\xdd\xcf\xc0\x8a\x0c\x6f\xf4\xcf\xf5\x62\x6e\xeb\x85\xc9\x8a\x81\x3b\x84\x76\x86\x23\x9b\x5b\x40\x1d\x71\xeb\xaf\x1a\xaf\x3c\x24\xfa\x80\x76\x61\xe7\x91\xa8\xd2\xc5\x91\x55\x14\x39\xd3\x04\x68\xcd\xce\xac\x34\xbb\xbc\x2a\x4d\xa8\x4b\x28\xab\x2c\xb3\x70\x69\x43\x0f\x78\x55\x5c\x54\xee\x53\xfc\x2f\x3c\x67\xd2\x0c\xd5\x1f\x2a\xbd\x11\x9f\x4a\xee\xfb\x93\x8b\x9b\x95\xf5\x71\x79\x00\xa7\xa2\x5d\x74\xdf\xbc\x82\x96\x92\xc7\x3f\x9d\xed\x28\x6f\xb1\xf2\x52\xe3\xfa\xc5\x94\x7c\xf4\xa6\xc2\x49\xd6\xed\xd4\x13\x1b\x72\xdc\x20\x96\xe1\x09\xf3\x80\xc4\xd2\x84\x53\x77\xa0\x03\x40\x2a\x34\xfd\xb2\xe4\xde\x4e\x9f\xac\x7e\x50\x91\xf3\x8e\xea\x09\xe5\x83\xc9\x55\xee\xb8\x5a\xfb\x93\x3d\x89\x40\x94\x39\x92\x62\x6d\x23\x4c\xf6\xbb\xec\x6e\x21\x7d\xaf\x90\x81\x66\xb9\x7e\xdd\xb0\x59\xd1\xc0\xd1\x31\xea\xc7\xb9\xfd\x42\x1e\x88\x57\x29\x95\xbb\x5d\x2d\x57\x97\xc2\x34\xb6\xce\x45\x4e\x9e\x71\xa6\xb2\x9d\x3b\x66\xf0\x80\xad\xe6\x0d\x6c\xc8\xed\x56\x3a\x84\x1d\x2a\x4a\xd5\xb9\xd9\xec\x3d\xc2\x86\xd2\x32\xbb\x09\xdb\x69\xa6\x1a\x85\xdc\x16\x19\xb2\xb9\xa2\xf9\x46\x76\x2b\x08\xa3\x34\x78\x08\x1a\xde\xd5\x41\x07\x61\x13\x68\xed\x57\x93\x3b\x06\xb8\x61\x5d\x3d\x48\xf6\x80\x1c\x40\xf5\x9d\x32\x87\x1c\x5b\xbf\xb4\x07\xf2\xad\xe4\x8d\x3c\x13\x5a\x2d\x8b\xfb\x39\x01\x58\x6e\xad\x8d\x68\x96\x71\xd8\x68\x92\xcd\xda\x04\xcd\xbf\x22\x36\x02\x75\x0b\x76\x30\xb3\xe6\x70\x1e\xb8\xa8\x7a\x82\xfc\x02\x58\x3d\x08\x26\x50\xa1\x66\x62\x07\x74\x56\x68\xdd\xcc\xea\xde\xf6\xa0\x04\x7b\x84\xe9\xfc\x19\x23\x1a\x9a\xfb\x65\x9b\xeb\x42\x28\x32\x5f\x7f\x26\xbf\xd2\x37\xa9\x89\x77\x0d\xd4\x33\xd2\xe1\xc6\x9e\x11\xfa\xa8\x7b\xbe\x35\x6b\x0f\xcd\x12\xd4\x12\xfd\x77\x32\x9e\x2b\xb4\x80\x4a\x69\xb3\x7d\x55\xd3\x68\xde\x11\xeb\x5a\xd3\x2b\x5b\xe3\x1b\x65\x3f\x3b\xbe\xff\x44\xf5\x3f\x65\x4f\x89\xe7\xef\xb1\xc7\x62\x60\xc7\xf8\xe6\x23\xf5\x72\xea\x19\xbd\x64\x19\x11\x66\x9d\x1a\xab\x38\x41\x5e\x69\x8c\x83\xe5\x8e\x83\x6a\x4c\x8e\x5f\x3d\xeb\xaa\xfe\xdf\x9b\x9b\x5c\x5b\xea\x71\x60\x39\x6a\xd9\xf6\x9b\x60\x54\x85\xe6\x2f\xba\x69\x29\x3a\x0b\x91\x77\x4c\x35\x49\x43\x84\x8a\x49\x04\x53\x96\x55\xc1\x32\x7b\xf7\xf9\x8e\xf9\xb8\x3a\xa4\xa4\xb1\x10\x7c\x88\xe9\xf7\xab\x11\xac\xc3\xff\x65\x03\x1d\xef\x98\xad\x27\xab\xf0\x82\x0f\xac\x35\x68\x51\x21\x03\x59\x83\x46\x31\xfc\xee\xbe\x45\xf7\x1e\x34\xf3\x6b\xb0\x36\x78\xf9\x16\xcd\x05\x03\xbd\x2a\x52\x01\x02\x1e\x41\x49\xc9\x14\x3b\x5c\xa6\x81\x96\x4c\x1a\xf1\x50\xd4\x41\xab\x1a\xfb\xda\x5a\x33\xe0\x15\xef\x7e\x91\xef\xc0\x89\x83\xff\x3c\x7f\x9f\x8a\x9e\x88\x2d\xec\x25\xf4\x8f\x57\xbc\x94\xa3\xff\x30\x72\xd4\xd2\x06\xa3\x46\xdf\x89\x83\xa4\x73\x95\xde\x89\xf7\xd3\xbe\x04\xf3\x9a\xb2\x09\x55\x37\x67\x34\x3f\x16\xc5\xb2\x6c\x14\xbc\xa1\x1a\x07\xb6\xb4\x8c\xd0\xe0\x4d\xd2\x48\xce\xf3\x89\xf4\x5c\x84\x5e\x99\xe8\xb9\x6b\xf0\x25\xa2\x04\xe6\x70\x31\xe7\x4e\x15\x84\x58\x5f\x86\x5a\x82\x4a\xb0\x50\xdf\xc8\x00\x42\xd9\x2b\x34\x41\x16\x8b\x32\x8a\xb5\xa1\xde\x13\x43\xbb\x62\xc5\x02\xe9\x30\x65\xec\xed\xf2\x34\xa8\x46\xc2\xc0\xbd\x1e\x92\x96\xeb\x97\x4e\x46\x46\xf9\x59\xcd\xc4\xa2\xdd\x33\xa0\xc7\x39\xd2\xb7\x3e\x29\x10\x5e\x82\xa1\x78\xb0\x51\x05\x5c\x9f\xf6\x72\xb4\x5e\x8c\xd5\xe8\xde\x99\x1a\x9c\x0d\xc5\xa6\xd6\xa0\xfc\xdf\xc1\x9e\x25\x11\x7d\x24\x6b\x9a\x22\x4a\xf4\x18\xdd\x90\x17\xde\x74\x62\xf7\x09\x1d\x65\xe9\x02\x07\x5c\x44\xa3\xfc\xdb\x26\xbd\xa2\x19\xc4\x07\x4a\x6f\xe5\x84\x65\x21\xfb\x95\xcf\x5b\xd2\x12\xed\x60\xe1\xb1\x06\xeb\xb5\xd9\x41\xe3\x77\xec\x66\xbc\x53\x3b\x21\x1e\x88\x18\xfc\x22\xd7\x11\x59\xad\x43\x69\xe5\x94\x34\xf2\xc7\xf2\xbb\xb1\x32\x1b\x1c\xc9\xe7\x3b\x57\x3d\x62\x37\x48\x25\xc3\xdb\xee\xac\xe8\x7a\xa2\xe9\xa9\xe4\xab\xd6\x49\x34\x8d\x67\xbe\x95\x1d\xe4\x40\x35\xb3\x51\x90\x04\xda\x0f\x74\x34\xdc\x21\x8b\xea\x5b\xf3\x40\x34\xf2\x65\x4c\xa5\x79\xd9\xd1\x1c\x2d\x54\x83\x0a\xe9\x0b\x56\x39\xeb\x59\x70\x6d\x6c\x43\xd1\x6b\x7c\x97\x3f\xa8\x74\x0f\x2a\xdd\x83\x4a\xf7\xa0\xd2\x3d\xa8\x74\x0f\x2a\xdd\x83\x4a\xf7\xa0\xd2\xfd\x29\x55\x3a\x23\x85\x66\xa3\xe0\xc6\x5b\x61\x25\x77\xbd\x2e\xe5\x65\x62\xbf\xe6\x7d\x4a\x4b\xdb\xd1\x37\xfe\x3e\xf7\xbe\xe3\x2d\x96\xdd\x7b\x63\x5e\xa8\x8b\x0c\xb7\x4d\x61\x47\x84\xc2\xb9\xed\xda\x3b\xf2\x6f\x3e\x40\x48\xa8\xba\x46\xae\x6c\xb2\x57\xed\x1d\xc6\xb5\x7a\xf3\x45\xa3\x5b\x9c\x8b\x32\x13\xa7\x05\x9f\xa7\x19\xeb\x41\x5a\x35\xd0\xa8\x01\xe7\x2f\xcf\x9c\x60\x8c\xba\x9b\x5d\x69\x06\x9d\xdd\x4b\xd3\x5c\x17\x18\x8c\x46\xb7\xd3\x59\xe2\x14\xd9\x0e\x9e\xc3\x5f\x5b\xeb\x58\x0f\x35\x05\x16\xb0\x0b\xba\x6d\xa8\xc4\x9d\xfa\x9a\xd0\x6c\xc1\x8b\xb4\x5c\xae\xc4\x28\x90\xd6\x0d\x81\x9e\xb3\x05\x97\x69\xe8\x56\x1c\x18\x04\xa0\x25\xb8\x58\xd2\x77\x2c\x22\xb6\x2f\x18\x8c\x38\x1f\x3f\x2d\xd8\x8a\x5f\x31\xc2\xf2\xb2\xd0\x31\x17\x69\xa1\x58\x2c\x6a\x15\x27\xb8\x27\xb3\x71\x57\x11\x21\xdf\x38\x55\x0a\x7d\x8b\x94\x91\x00\xe4\xd9\xf3\xb3\xc9\xc9\xd3\x93\x83\xc9\xd9\x77\xc7\x84\x6c\xdd\xd0\x55\xb6\x2d\x43\x47\x0c\xda\xc8\xa4\x36\x66\x3c\xba\xb5\x58\xea\x65\xaf\x3d\xac\x3e\xcd\xcf\x5f\x9e\xfd\xa8\x0a\x7e\x0e\xd9\xc5\xda\x03\xbe\xbd\x94\xcd\x73\x68\x66\x0a\x89\x86\xef\x1f\xb0\x63\x26\x48\xc5\x90\xe8\xa0\xcd\xf5\x80\x75\xb7\xc7\x6c\x04\xe6\xb0\x55\x4d\xa7\xd1\x74\x87\x4c\xa3\x7d\xa9\xed\x4f\xa3\x03\x67\x63\x3c\x20\x6b\x2f\x3d\xc3\x32\xae\xa6\xd1\x14\x1b\xf9\xea\xf5\xf9\xf3\x99\x73\x4d\x8d\x17\x42\x7f\x70\x26\xca\xfa\x53\x1e\xc8\x54\xe7\x71\xa5\x82\xe8\x81\xe7\x2f\xcf\xa6\xfb\xb7\xbf\x6b\x71\x9e\xde\xeb\x1f\x32\xed\x1f\xb2\xdf\x3f\xe4\x60\x74\x6b\x8a\xf4\x8a\x95\xce\x2f\x5a\x1f\x2a\xdb\xdd\x29\x73\x27\x4a\x5e\xd0\x85\x5b\xf8\x4e\x6c\x2e\x6d\xc7\x54\x83\x2c\x11\x53\x97\x97\x4a\xe9\x62\xec\xec\x53\x5a\x2e\x67\xb6\xa9\xaa\x11\x39\x76\x2c\xc8\xda\x30\x71\x33\xd4\x6d\xa6\x5a\x0d\x94\x00\x9a\x43\xc3\xad\x5a\x45\x49\xcb\x8d\x98\x91\xdf\x3f\x8e\xfe\xff\x00\x8b\x9c\x74\x17\xf2\xd9\x01\x00")
 
 func manifests00CustomResourceDefinitionYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -462,7 +463,7 @@ func manifests00CustomResourceDefinitionYaml() (*asset, error) {
 	return a, nil
 }
 
-var _manifests00IngressCredentialsRequestYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x57\xc1\x8e\xdb\x36\x10\xbd\xfb\x2b\x08\x5d\x02\x04\xa0\xd3\x45\x51\xa0\xe0\xcd\xeb\x2d\xd2\x02\x49\x11\x78\xd1\x04\xe8\x6d\x44\x8e\xb5\x83\x50\x43\x95\x43\x29\x6d\xbe\xbe\xa0\x25\xcb\x76\xe4\xda\xdd\x5d\xa7\x58\xa4\x7b\xb3\x39\x6f\xa8\x99\xc7\xf7\x44\x0d\x34\xf4\x1e\xa3\x50\x60\xa3\xac\x0f\xad\xb3\x11\x1d\x72\x22\xf0\xf3\xd0\x20\xcb\x1d\xad\xd3\x9c\xc2\xab\xee\x6a\xf6\x91\xd8\x19\xb5\x1c\x01\xb2\xc2\x3f\x5a\x94\x34\xab\x31\x81\x83\x04\x66\xa6\x94\x87\x12\xbd\xe4\x5f\x4a\xd9\xc0\x29\x06\xef\x31\xea\x14\x82\x97\xf9\xc7\x1f\x65\x4e\xc1\xa8\xe2\x6a\xfe\x5d\x31\x53\x8a\xa1\x46\xa3\xc6\xe7\x68\xe2\x2a\xa2\xc8\x10\x91\x06\xec\x41\x78\x53\xa0\xde\x55\xa8\x43\x83\x11\x52\x88\x33\xa5\x80\x39\x24\x48\x14\x78\x78\x38\xb1\xf5\xad\xc3\x79\x44\x8f\x20\x78\xd8\x0d\x95\xf5\xb0\x5b\x0d\x0c\x15\x3a\xa3\x8a\x14\x5b\x2c\xce\xa7\x0a\xfa\xf5\x36\x4b\xdf\x51\x75\xa7\xa1\x03\xf2\x50\x92\xa7\xf4\xd7\x3d\xf6\x21\xae\x3c\x6a\x0e\x0e\xb5\xc3\x0e\x7d\x6e\x66\x4c\x97\x06\x6d\xee\x43\x30\x76\x64\x71\x61\x6d\x68\x39\xfd\x9a\x59\xc9\xcb\x5a\x0d\x54\xed\x53\x20\x68\x23\xa6\x15\xae\x7b\x02\x7a\x72\xbf\xe4\x4c\xc6\xd8\x84\xde\x23\x5b\x36\x31\x74\xe4\x30\xde\x0e\xe5\x28\x75\x1f\xc1\x64\x7c\x2f\x9a\xc5\x87\xdb\x77\x7b\x5b\x6d\x22\x92\x20\x61\x8d\x9c\x7e\xe2\x14\x09\x87\x63\xd3\x0a\xd7\x6b\xb4\xc9\xa8\x85\xf7\xe1\xd3\x66\x4d\x29\xb0\xf9\x64\xcd\xf0\x4f\x2b\xf4\x20\x89\xac\x0f\xe0\x4a\xf0\xc0\x96\xb8\x32\x37\x28\x36\x52\x89\x6f\x02\xb8\xeb\xcd\x2a\x46\x19\x53\x62\x68\x13\xfe\xf0\xbd\x79\x43\x92\x7e\x0e\x92\xd0\xfd\x1e\x18\xa7\xf1\xe5\x1d\x70\x85\x2b\x94\xd0\x46\x8b\x2b\xb4\x21\xba\x5b\x4c\x3b\x60\x82\xca\xbc\xce\x3c\xf7\x88\x6d\x20\x0e\xff\x8d\x2a\x5e\x16\x33\xad\xf5\xec\x49\x7a\x4b\xc3\xe7\x36\xe2\xb3\xc3\xf6\x1c\xf6\x74\x5d\x93\x8f\x6a\xe2\x9b\x18\x3c\x5e\x13\x3b\xe2\x6a\xf4\x4c\x5e\x33\x6a\x99\x35\x41\x65\x9b\xab\x78\xba\x02\xac\x6c\xf3\x2c\xbf\x6f\xed\x05\xff\x7a\xf9\x6e\x22\xd4\x26\x27\xae\x89\xd1\xad\x82\xc7\x03\xad\xca\x2b\xc7\x32\x07\x57\x13\x7f\x1d\xa5\xfe\x93\xf8\xa8\xac\x37\xfb\xdf\x5f\x81\xdb\x03\xbb\x14\x63\xbf\x5c\xbf\x5d\x66\xf0\x94\xb6\xe0\xc9\xd2\x17\x7c\xed\xae\xbe\xc2\x46\x36\xdd\x95\x29\x7d\x8b\x35\xfd\x69\x9a\xb6\xf4\x64\x0d\x41\x6d\x8c\x31\x83\x9c\x32\xe1\xe6\xed\x46\xc3\xb1\x78\x40\xea\x0a\xc1\x3d\x2c\xf3\x43\xa4\xb4\xcb\x84\xd4\xbf\x90\xf6\x1b\xe8\x8f\xa6\x18\x92\xb2\xe2\xb7\x68\xa5\x3a\xf0\x6d\x0e\x12\x27\x8c\x8c\x49\x4b\x67\xa5\xf8\x1f\x13\xe1\x58\x46\x0e\x2e\x6e\xff\xff\xd4\x79\x4d\xf8\x84\xb1\x7b\xc0\xb7\xfd\x57\x33\x5e\x2e\xe8\xfd\xf4\xbb\xf4\xd0\x7f\x13\xe1\x3d\x52\x7c\x8f\x13\xe0\xe3\x44\x78\x4c\x86\xe7\xa5\x78\xc6\x97\xcf\x04\x7d\x9b\x7e\x05\x4f\x25\x94\x70\xf2\xb6\x9c\x54\x78\x69\xaf\x2e\xfa\x22\x8e\x5f\x94\xc7\x07\xc8\x7c\x5a\x87\xe3\xe2\xb0\xe6\xc9\xb1\x98\x85\x73\x37\xa1\x06\xe2\x7e\xb2\x3b\x06\xf9\xad\x71\x90\xf0\x1c\xea\x06\x3d\xfe\x1b\x54\x3f\x93\xee\xe3\x64\x04\x1e\x1b\x75\xf7\x27\xc9\x17\x2f\x5f\x9c\xec\xa9\xe9\xd2\xe7\xdc\x51\x9e\x64\x8f\xd4\xb0\x09\xf7\xdd\x9c\x42\xf4\x9d\x9c\x46\xf4\x5d\xec\x30\x72\x1a\x24\x27\x9b\x99\x36\x7e\x71\xab\xfc\x1d\x00\x00\xff\xff\x33\x96\x38\x9d\xd8\x12\x00\x00")
+var _manifests00IngressCredentialsRequestYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x57\xc1\x8e\xdb\x36\x10\xbd\xfb\x2b\x08\x5d\x02\x04\xa0\xd3\x45\x51\xa0\xe0\xcd\xeb\x2d\xd2\x02\x49\x11\x78\xd1\x04\xe8\x6d\x44\x8e\xb5\x83\x50\x43\x95\x43\x29\x6d\xbe\xbe\xa0\x25\xdb\xda\xc8\xb5\xeb\xc4\x2d\x8c\x64\x6f\xb6\xf8\x86\x9a\x79\xf3\x1e\xa9\x81\x86\xde\x62\x14\x0a\x6c\x94\xf5\xa1\x75\x36\xa2\x43\x4e\x04\x7e\x1e\x1a\x64\x79\xa0\x75\x9a\x53\x78\xd1\xdd\xcc\xde\x13\x3b\xa3\x96\x3b\x80\xac\xf0\x8f\x16\x25\xcd\x6a\x4c\xe0\x20\x81\x99\x29\xe5\xa1\x44\x2f\xf9\x97\x52\x36\x70\x8a\xc1\x7b\x8c\x3a\x85\xe0\x65\xfe\xfe\x47\x99\x53\x30\xaa\xb8\x99\x7f\x57\xcc\x94\x62\xa8\xd1\xa8\xdd\x7b\x34\x71\x15\x51\x64\x58\x91\x06\xec\xa3\xe5\x4d\x82\x7a\x9f\xa1\x0e\x0d\x46\x48\x21\xce\x94\x02\xe6\x90\x20\x51\xe0\xe1\xe5\xc4\xd6\xb7\x0e\xe7\x11\x3d\x82\xe0\xe3\x6a\xa8\xac\x87\xdd\x6a\x60\xa8\xd0\x19\x55\xa4\xd8\x62\x71\x3a\x54\xd0\xaf\xb7\x51\xfa\x81\xaa\x07\x0d\x1d\x90\x87\x92\x3c\xa5\xbf\xce\xd8\x87\xb8\xf2\xa8\x39\x38\xd4\x0e\x3b\xf4\xb9\x98\x5d\xb8\x34\x68\x73\x1d\x82\xb1\x23\x8b\x0b\x6b\x43\xcb\xe9\xd7\xcc\x4a\x7e\xac\xd5\x40\xd5\x98\x02\x41\x1b\x31\xad\x70\x9d\x11\x5b\x72\x3f\xe5\x4c\x76\x6b\x13\x7a\x0f\x6c\xd9\xc4\xd0\x91\xc3\x78\x3f\xa4\xa3\xd4\x39\x82\xc9\x6f\xea\x45\xb3\x78\x77\xff\x66\xb4\xd5\x66\x27\x49\x90\xb0\x46\x4e\x3f\x71\x8a\x84\x43\xdb\xb4\xc2\xf5\x1a\x6d\x32\x6a\xe1\x7d\xf8\xb0\x41\x2a\x05\x36\x77\xd6\x0c\xff\xb4\x42\x0f\x92\xc8\xfa\x00\xae\x04\x0f\x6c\x89\x2b\x73\x87\x62\x23\x95\xf8\x2a\x80\xbb\xdd\x3c\xc5\x28\xbb\x90\x18\xda\x84\x3f\x7c\x6f\x5e\x91\xa4\x9f\x83\x24\x74\xbf\x07\xc6\xe9\xfa\xf2\x01\xb8\xc2\x15\x4a\x68\xa3\xc5\x15\xda\x10\xdd\x3d\xa6\x3d\x30\x41\x65\x5e\x66\x9e\x7b\xc4\x76\x21\x0e\xff\x8d\x2a\x9e\x17\x33\xad\xf5\xec\x2a\xbd\xa5\xe1\x63\x1b\xf1\xc9\x61\x23\x87\x5d\xaf\x6b\x72\xab\x26\xbe\x89\xc1\xe3\x2d\xb1\x23\xae\x86\x46\x64\xf1\x7a\x34\x6a\x99\x35\x41\x65\x9b\xfb\x75\xbd\x02\xac\x6c\xf3\x74\xc0\x7f\x6d\x07\xfc\xcb\xe5\x9b\x89\x50\x9b\x1c\xb8\x26\x46\xb7\x0a\x7e\x7f\xbe\x67\xad\xca\x0b\xc7\x32\x07\x57\x13\xff\x37\x4a\xfd\x27\xf1\x51\x59\x6f\x8a\x3a\x5f\x81\xdb\x86\x5d\x8a\xb1\x5f\x6e\x5f\x2f\x73\x26\x53\xda\x82\x27\x3b\xba\x0f\xe3\x9e\xbc\x4c\x5f\x61\x23\x9b\xee\xc6\x94\xbe\xc5\x9a\xfe\x34\x4d\x5b\x7a\xb2\x86\xa0\x36\xc6\x98\x41\x4e\x99\x70\xf3\x7a\xf3\x91\x12\x8b\xcf\x08\x5d\x21\xb8\xcf\x8b\x7c\x17\x29\xed\x23\x21\xf5\x07\xd2\xb8\x80\xbe\x35\xc5\x10\x94\x3f\x69\xb6\x68\xa5\x3a\xf0\x2d\x1a\x55\x10\x27\x8c\x8c\x49\x4b\x67\xa5\xf8\x86\x89\x70\x2c\x3b\x0e\x2e\x6e\xff\xff\xd5\x79\x4d\xf8\x80\xb1\x93\x2b\x32\x5e\x4e\xe8\xed\xfd\x09\xff\x4d\x84\x77\xae\x0e\x3e\x71\xe1\x97\x09\xf0\xcb\x44\x78\x48\x86\xa7\xa5\x78\xc2\x97\x4f\x04\x8d\x09\xfa\x7a\xfc\x0a\x9e\x4a\x28\xe1\xe8\x6d\x39\xc9\xf0\xd2\x5e\x5d\xf4\x49\x1c\xbe\x28\x0f\x0f\x90\xb9\x5b\x8f\xc7\xc5\xe1\x99\x27\xc7\x62\x16\xce\xdd\x85\x1a\x88\xfb\xc9\xee\x10\xe4\xb7\xc6\x41\xc2\x53\xa8\x3b\xf4\xf8\x6f\x50\xfd\x4c\x3a\xc6\x6d\x27\x46\x75\x70\xd4\x1d\x4f\x92\xcf\x9e\x3f\x3b\x5a\x53\xd3\xa5\x8f\xb9\xa2\x3c\xc9\x1e\xc8\x61\xb3\xdc\x57\x73\x0c\xd1\x57\x72\x1c\xd1\x57\xb1\xc7\xc8\x71\x90\x1c\x2d\x66\x5a\xf8\xc5\xad\xf2\xf7\x00\x33\x96\x38\x9d\xd8\x12\x00\x00")
 
 func manifests00IngressCredentialsRequestYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -482,7 +483,7 @@ func manifests00IngressCredentialsRequestYaml() (*asset, error) {
 	return a, nil
 }
 
-var _manifests00NamespaceYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x91\xbb\x6e\xe3\x40\x0c\x45\x7b\x7d\x05\xe1\xad\xc7\x8b\x6d\xf5\x0f\xbb\xcd\x02\xe9\xa9\x99\x6b\x89\x31\x45\x0a\x33\x94\x8c\xfc\x7d\x20\x3b\x0f\x1b\x29\x82\x94\x83\x39\xe7\xf2\x75\x16\x2b\x3d\xfd\xe3\x19\x6d\xe1\x8c\x8e\x17\x79\x42\x6d\xe2\xd6\xd3\xf6\xa7\x9b\x11\x5c\x38\xb8\xef\x88\xd8\xcc\x83\x43\xdc\xda\xfe\x24\x12\xcb\xba\x16\x1c\x2b\x14\xdc\x70\xf4\x05\xd6\x26\x39\xc5\x51\xfc\xb7\x0c\x73\xca\xea\x6b\x49\x33\x1b\x8f\x28\x3d\x1d\xa2\xae\x38\x7c\xaf\x36\xe8\xe9\xdd\x4a\x93\x8c\x53\xe2\x8d\x45\x79\x10\x95\x78\xf9\x41\x8e\xd8\xa8\x48\xe6\x05\xa9\x60\x83\xfa\x82\xfa\xa0\x3f\xe0\x57\xae\x41\x91\xc3\x77\xec\x86\x5c\xbc\x9e\xd5\xb9\x3c\x46\xb3\xaa\x5f\xae\x33\xdd\xfa\x9c\x61\xb1\xf3\xca\x03\xf4\x6d\x3d\xbf\xe8\x4a\x7d\x16\x49\xb3\x9b\x84\x57\xb1\x91\xc2\x49\xdd\xcf\x74\xf2\x4a\xff\x51\x37\xc9\xf8\x7b\xfb\x25\x1f\x9e\x91\xa3\x91\x18\xc5\x24\x8d\xec\xe3\x38\x5f\x5a\xce\xba\xb6\x40\xbd\x0b\xbe\x1b\x6f\xf7\xfa\xbb\xea\x62\x63\x45\x6b\x69\xdf\x02\x87\xd7\xee\x35\x00\x00\xff\xff\x90\xc9\xa6\xce\xfc\x01\x00\x00")
+var _manifests00NamespaceYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x91\x41\x6e\xe3\x30\x0c\x45\xf7\x3e\x05\x91\x59\x2b\x83\xd9\xfa\x0e\x33\x9b\x01\xba\xa7\xa5\x1f\x9b\x0d\x4d\x1a\x12\xed\xa0\xb7\x2f\x9c\xb4\x41\x82\x2e\x8a\x2e\x05\xbd\xf7\xf5\x29\x9e\xc5\x4a\x4f\xff\x78\x46\x5b\x38\xa3\xe3\x45\x5e\x50\x9b\xb8\xf5\xb4\xfd\xe9\x66\x04\x17\x0e\xee\x3b\x22\x36\xf3\xe0\x10\xb7\xb6\x1f\x89\xc4\xb2\xae\x05\xc7\x0a\x05\x37\x1c\x7d\x81\xb5\x49\x4e\x71\x14\xff\x2d\xc3\x9c\xb2\xfa\x5a\xd2\xcc\xc6\x23\x4a\x4f\x87\xa8\x2b\x0e\xdf\xab\x0d\x7a\xfa\xb4\xd2\x24\xe3\x94\x78\x63\x51\x1e\x44\x25\xde\x7e\x90\x23\x36\x2a\x92\x79\x41\x2a\xd8\xa0\xbe\xa0\x3e\xe9\x4f\xf8\x95\x6b\x50\xe4\xf0\x1d\xbb\x35\xbd\x78\x3d\xab\x73\x79\x8e\x66\x55\xbf\x5c\x67\xba\xf5\x9c\x61\xb1\xf3\xca\x03\xf4\xe3\x7b\x7e\xd1\x95\xa2\xbb\x98\x66\x37\x09\xaf\x62\x23\x85\x93\xba\x9f\xe9\xe4\x95\xfe\xa3\x6e\x92\xf1\xf7\x76\x4b\x3e\xbc\x22\x47\x23\x31\x8a\x49\x1a\xd9\x7d\x39\x5f\x2a\x67\x5d\x5b\xa0\x3e\x04\x3f\x8c\xb7\x7b\xfd\xc3\xeb\x62\x63\x45\x6b\xc9\x17\x54\x0e\xaf\xdd\xfb\x00\x90\xc9\xa6\xce\xfc\x01\x00\x00")
 
 func manifests00NamespaceYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -502,7 +503,7 @@ func manifests00NamespaceYaml() (*asset, error) {
 	return a, nil
 }
 
-var _manifests0000_90_ingressOperator_00_prometheusroleYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x8f\xb1\x6e\xe3\x30\x10\x44\x7b\x7d\x05\xe1\x9e\x3a\x5c\x67\xe8\x07\xae\xbf\x22\xfd\x8a\x1c\x4b\x0b\x53\xbb\xc4\x2e\xa9\x20\xf9\xfa\x40\xb2\xe3\x36\x48\xc5\x99\x01\xdf\x90\x43\x95\xdf\x60\xce\x2a\x53\xb0\x99\xd2\x48\xbd\xad\x6a\xfc\x49\x8d\x55\xc6\xfb\xd5\x47\xd6\x3f\xfb\xdf\xe1\xce\x92\xa7\xf0\x5f\x0b\x86\x0d\x8d\x32\x35\x9a\x86\x10\x84\x36\x4c\xa1\x9a\x6e\x68\x2b\xba\xc7\xfb\xd5\x9f\xb1\x57\x4a\x98\x82\x56\x88\xaf\x7c\x6b\x91\x65\x31\xb8\x47\xad\x30\x6a\x6a\x43\x08\x24\xa2\xed\x7c\xca\x8f\xb6\x10\x58\x52\xe9\x19\xa3\xa1\x80\x1c\xe3\x8b\x3e\xbe\xc1\xf3\x16\x53\xd1\x9e\xe3\x46\x42\x0b\xf2\x14\x2e\xcd\x3a\x2e\x3f\xa3\x8e\x72\xfb\xa6\xe2\xca\xcb\x1a\x69\x27\x2e\x34\x73\xe1\xf6\xf1\x8b\x1e\x96\xa5\x20\x8a\x66\xc4\x8c\x1d\xe5\x18\xf3\xc2\xad\x17\xf8\x34\xc4\x40\x95\xff\x99\xf6\x7a\xae\x8a\xe1\x72\x34\x1b\x5c\xbb\x25\x3c\x33\x87\xed\x9c\xe0\xa7\x81\xe4\xaa\x2c\xed\xe1\xaa\xe6\x43\xec\xb0\xf9\x79\x79\x41\x3b\xcf\xc2\xfe\x10\xef\xd4\xd2\x3a\x7c\x05\x00\x00\xff\xff\xd4\x51\x0b\x8b\xbe\x01\x00\x00")
+var _manifests0000_90_ingressOperator_00_prometheusroleYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x90\xb1\x6e\xc3\x30\x0c\x44\x77\x7f\x85\x90\x5d\x2e\xba\x05\xfe\x81\xee\x1d\xba\xd3\xd2\xc5\x26\x22\x93\x02\x29\xb9\x68\xbf\xbe\x70\x92\x66\x2d\x3a\x89\x3a\xe8\x9d\xee\x48\x95\x3f\x60\xce\x2a\x53\xb0\x99\xd2\x48\xbd\xad\x6a\xfc\x4d\x8d\x55\xc6\xeb\xd9\x47\xd6\x97\xfd\x75\xb8\xb2\xe4\x29\xbc\x6b\xc1\xb0\xa1\x51\xa6\x46\xd3\x10\x82\xd0\x86\x29\x54\xd3\x0d\x6d\x45\xf7\x78\x3d\xfb\x43\xf6\x4a\x09\x53\xd0\x0a\xf1\x95\x2f\x2d\xb2\x2c\x06\xf7\xa8\x15\x46\x4d\x6d\x08\x81\x44\xb4\xdd\xbe\xf2\xc3\x2d\x04\x96\x54\x7a\xc6\x68\x28\x20\xc7\xf8\xa4\x8f\x18\x3c\x6f\x31\x15\xed\x39\x6e\x24\xb4\x20\x4f\xe1\xd4\xac\xe3\xf4\x37\xea\x28\x97\x5f\x2a\xae\xbc\xac\x91\x76\xe2\x42\x33\x17\x6e\x5f\xff\xf0\x61\x59\x0a\xa2\x68\x46\xcc\xd8\x51\x8e\x32\x4f\xdc\x7a\x81\x4f\x43\x0c\x54\xf9\xcd\xb4\xd7\x5b\xab\x18\x4e\x47\x42\x83\x6b\xb7\x84\x87\xe6\xb0\x9d\x13\x8e\x6d\xc5\x00\xc9\x55\x59\xda\xfd\x56\x35\x1f\xc3\x0e\x9b\x1f\x8f\x17\xb4\xdb\x59\xd8\xef\xc3\x27\xb5\xb4\x0e\x3f\x03\x00\xd4\x51\x0b\x8b\xbe\x01\x00\x00")
 
 func manifests0000_90_ingressOperator_00_prometheusroleYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -522,7 +523,7 @@ func manifests0000_90_ingressOperator_00_prometheusroleYaml() (*asset, error) {
 	return a, nil
 }
 
-var _manifests0000_90_ingressOperator_01_prometheusrolebindingYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x91\xb1\x6e\xf3\x30\x0c\x84\x77\x3f\x85\x90\x5d\xfe\xf1\x6f\x81\xb6\x76\xe9\x9e\x02\xdd\x69\xe9\x62\xb3\x91\x49\x41\xa2\x0c\xb4\x4f\x5f\xb8\x48\xd3\xa9\x2d\xb2\x12\xfc\x8e\xbc\x3b\x2a\xfc\x82\xda\x58\x25\xb8\x3a\x51\x1c\xa9\xdb\xa2\x95\xdf\xc9\x58\x65\xbc\x1c\xdb\xc8\xfa\x6f\xfb\x3f\x5c\x58\x52\x70\x27\xcd\x78\x64\x49\x2c\xf3\xb0\xc2\x28\x91\x51\x18\x9c\x13\x5a\x11\x5c\xa9\xba\xc2\x16\xf4\xe6\x2f\xc7\x76\x1d\xb7\x42\x11\xc1\x69\x81\xb4\x85\xcf\xe6\x59\xe6\x8a\xd6\xbc\x16\x54\x32\xad\x83\x73\x24\xa2\xf6\x79\xb1\xed\x6a\xce\xb1\xc4\xdc\x13\xc6\x8a\x0c\x6a\x18\x6f\xf4\xfe\x0d\x4f\xab\x8f\x59\x7b\xf2\x2b\x09\xcd\x48\xc1\x1d\xac\x76\x1c\xfe\x46\x1b\xf2\xf9\x8b\xf2\x0b\xcf\x8b\xa7\x8d\x38\xd3\xc4\x99\xed\xed\x0e\x1d\x96\x39\xc3\x8b\x26\xf8\x84\x0d\x79\x37\x73\xc3\xab\x66\x9c\x70\xde\xad\x50\xe1\xa7\xaa\xbd\xfc\x92\xee\xe0\xdc\x77\xb8\x3f\x65\xd9\xfa\xf4\x8a\x68\x2d\x0c\xfe\xba\xfd\x8c\xba\x71\xc4\x43\x8c\xda\xc5\xee\xec\x60\x55\x61\xd3\xba\xd7\xf8\x11\x00\x00\xff\xff\x60\xe1\x61\x52\x02\x02\x00\x00")
+var _manifests0000_90_ingressOperator_01_prometheusrolebindingYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x91\xb1\x6e\xeb\x30\x0c\x45\x77\x7f\x85\x90\x5d\x7e\x78\x5b\xa0\xad\x5d\xba\xa7\x40\x77\x5a\xba\xb1\xd9\xc8\xa4\x20\x51\x06\xda\xaf\x2f\x5c\xa4\xe9\xd4\x16\x59\x05\x9d\x4b\xde\x43\x2a\xfc\x82\xda\x58\x25\xb8\x3a\x51\x1c\xa9\xdb\xa2\x95\xdf\xc9\x58\x65\xbc\x1c\xdb\xc8\xfa\x6f\xfb\x3f\x5c\x58\x52\x70\x27\xcd\x78\x64\x49\x2c\xf3\xb0\xc2\x28\x91\x51\x18\x9c\x13\x5a\x11\x5c\xa9\xba\xc2\x16\xf4\xe6\x2f\xc7\x76\x7d\x6e\x85\x22\x82\xd3\x02\x69\x0b\x9f\xcd\xb3\xcc\x15\xad\x79\x2d\xa8\x64\x5a\x07\xe7\x48\x44\xed\x73\x62\xdb\xd3\x9c\x63\x89\xb9\x27\x8c\x15\x19\xd4\x30\xde\xe8\x7d\x1b\x9e\x56\x1f\xb3\xf6\xe4\x57\x12\x9a\x91\x82\x3b\x58\xed\x38\xfc\x8d\x36\xe4\xf3\x17\xe5\x17\x9e\x17\x4f\x1b\x71\xa6\x89\x33\xdb\xdb\x1d\x39\x2c\x73\x86\x17\x4d\xf0\x09\x1b\xf2\x5e\xe6\x86\x57\xcd\x38\xe1\xbc\x57\xa1\xc2\x4f\x55\x7b\xf9\xc5\xee\xe0\xdc\xb7\xdc\x9f\x5c\xb6\x3e\xbd\x22\x5a\x0b\x83\xbf\xfe\x7e\x46\xdd\x38\xe2\x21\x46\xed\x62\x77\xde\x60\x55\x61\xd3\xca\x32\x0f\x1f\x03\x00\x60\xe1\x61\x52\x02\x02\x00\x00")
 
 func manifests0000_90_ingressOperator_01_prometheusrolebindingYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -542,7 +543,7 @@ func manifests0000_90_ingressOperator_01_prometheusrolebindingYaml() (*asset, er
 	return a, nil
 }
 
-var _manifests0000_90_ingressOperator_02_servicemonitorYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x92\xb1\xae\xd5\x4c\x0c\x84\xfb\xf3\x14\xab\xdb\x6f\xf2\xff\xa2\x4b\x8b\x44\x05\x34\x20\x7a\xc7\x99\x9b\x2c\x67\x63\xaf\x6c\x27\x12\x6f\x8f\x92\x9c\x73\x2b\xae\x10\xed\xec\xcc\xe8\xf3\xda\xd4\xca\x0f\x98\x17\x95\x21\xad\x2a\x25\xd4\x8a\xcc\x1d\xab\x41\xbd\x63\x5d\xfb\xfd\xff\xdb\xbd\xc8\x34\xa4\x6f\xb0\xbd\x30\xbe\x5c\xae\xdb\x8a\xa0\x89\x82\x86\x5b\x4a\x42\x2b\x86\x54\x64\x36\xb8\x67\x6d\x30\x3a\x2c\xd7\x83\x37\x62\x0c\x49\x1b\xc4\x97\xf2\x1a\xf9\x0f\x3e\x12\xd1\xa0\x28\x2a\x7e\xf4\xa5\x54\x84\xeb\x36\xa1\x33\x54\x90\xa3\x7b\x4b\x77\x45\xfb\x32\xae\x99\xab\x6e\x53\x5e\x49\x68\xc6\x34\xa4\x97\xb0\x0d\x2f\x7f\x8f\x3a\xea\xeb\x33\x95\x97\x32\x2f\x99\x76\x2a\x95\xc6\x52\x4b\xfc\xfa\x87\x9e\x22\x73\x45\x16\x9d\x90\x27\xec\xa8\xc7\x30\x6f\x71\x6f\xe0\x63\x0e\xc8\xd4\xb4\x48\x9c\x43\xe5\x34\x82\x0c\xf6\x5d\xef\x90\x4f\xa5\x62\x48\xfd\x4e\xd6\xdb\x26\xbd\x83\x0d\xe1\xfd\x7d\x1b\x61\x82\x80\x5f\xb0\xe7\x8f\x13\xb3\x6e\x12\x7d\x1c\xc1\x07\x5a\xc0\x76\xaa\x43\xfa\xf0\x9f\x9f\x4a\x53\x8b\x21\xad\x08\x2b\x7c\x29\xce\x0b\x8e\xad\x2c\x11\xed\x52\xa2\xfa\x47\x95\xd7\x32\x5f\x5f\x9c\x12\xd3\x03\x03\xc1\x7d\x33\x5d\x11\x0b\x36\xef\xf9\x74\xad\xd4\xfc\x42\x90\x39\x33\x2c\x3c\x33\xe5\x71\x93\xa9\xe2\x89\x96\x99\x3a\xb6\x78\xf4\x1d\x22\xec\xeb\x79\x0c\x0f\x94\xee\xfd\xb5\x77\xbe\xf3\x2d\xa5\x9f\x3a\x7e\xa6\x11\x75\x48\xac\x6b\x53\x81\x1c\x75\x8e\x0a\x0e\xb5\x0b\x75\xa5\xe0\xe5\x74\xf9\x93\xfd\x9d\x93\xfb\x1d\x00\x00\xff\xff\x68\x04\xae\x7e\xd0\x02\x00\x00")
+var _manifests0000_90_ingressOperator_02_servicemonitorYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x92\x3f\x8f\xd5\x40\x0c\xc4\xfb\xf7\x29\x56\xd7\x6f\x02\xa2\x4b\x8b\x44\x05\x34\x20\x7a\xc7\x99\x97\x2c\x6f\xd7\x5e\x79\x9d\x48\x7c\x7b\x94\x3f\x77\x15\x4f\xe8\x94\x6e\x32\x33\xf9\x39\x36\xd5\xf4\x0b\xd6\x92\xca\x10\x8a\x4a\x72\xb5\x24\x73\xc7\x6a\xd0\xd6\xb1\x96\x7e\xfb\x78\x7b\x24\x99\x86\xf0\x03\xb6\x25\xc6\xb7\xd3\x75\x2b\x70\x9a\xc8\x69\xb8\x85\x20\x54\x30\x84\x24\xb3\xa1\xb5\xa8\x15\x46\xae\x76\xbd\x68\x95\x18\x43\xd0\x0a\x69\x4b\xba\x7b\xfc\x87\x8f\x44\xd4\xc9\x93\x4a\xdb\xfb\x42\x48\xc2\x79\x9d\xd0\x19\x32\xa8\xa1\x7b\x4b\x77\x49\xfb\x34\x96\xc8\x59\xd7\x29\x16\x12\x9a\x31\x0d\xe1\xc5\x6d\xc5\xcb\xff\xa3\x0d\xf9\xfe\x9a\x8a\x4b\x9a\x97\x48\x1b\xa5\x4c\x63\xca\xc9\xff\xbc\xa3\x27\xc9\x9c\x11\x45\x27\xc4\x09\x1b\xf2\x3e\xcc\x5b\xbc\x55\xf0\x3e\x07\x64\xaa\x9a\xc4\x8f\xa1\x62\x18\x41\x06\xfb\xa9\x0f\xc8\x97\x94\x31\x84\x7e\x23\xeb\x6d\x95\xbe\x81\x0d\xde\xfa\xc7\x3a\xc2\x04\x8e\x76\x7c\xe4\xfc\xe3\xc4\xac\xab\x78\xef\x7b\xf0\x42\x73\xd8\x46\x79\x08\x9f\x3e\xb4\x43\xa9\x6a\x3e\x84\x02\xb7\xc4\xa7\xd2\x78\xc1\xbe\x95\xc5\xbd\x9e\x8a\xe7\xf6\x59\xe5\x9e\xe6\x9d\x66\x7f\x98\x2e\x0c\x38\xf7\xd5\xb4\xc0\x17\xac\xad\xe7\xc3\x55\xa8\xb6\x13\x41\xe6\xc8\x30\x6f\x91\x29\x8e\xab\x4c\x19\xaf\x68\x91\xa9\x63\xf3\xab\x6f\x17\x61\xdf\x8f\x63\xb8\x50\xba\xe7\x6b\xef\xda\xc6\xb7\x10\x7e\xeb\xf8\x95\x46\xe4\x21\xb0\x96\xaa\x02\xd9\xeb\x1a\x32\xd8\xd5\x4e\xd4\x42\xce\xcb\xe1\xba\xce\xe3\xe9\xc9\xfd\x1d\x00\x68\x04\xae\x7e\xd0\x02\x00\x00")
 
 func manifests0000_90_ingressOperator_02_servicemonitorYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -562,7 +563,7 @@ func manifests0000_90_ingressOperator_02_servicemonitorYaml() (*asset, error) {
 	return a, nil
 }
 
-var _manifests0000_90_ingressOperator_03_prometheusrulesYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x57\x4d\x6f\xdc\x36\x10\xbd\xfb\x57\x0c\xb6\x3d\x38\x40\xb4\x8e\x0f\xb9\x08\xc8\x21\x48\x50\xb4\x97\x22\x30\x92\xf6\x28\x8c\xc5\x59\x69\x10\x8a\x54\x67\xc8\x5d\x2f\x5c\xff\xf7\x82\xfa\x8a\xac\xdd\xb5\x1d\xa7\x75\xf7\x24\x89\x9c\xc7\x37\xf3\x38\x8f\x5c\x6c\xf9\x0f\x12\x65\xef\x72\x68\xbc\xe3\xe0\x85\x5d\xb5\x2e\xbd\x90\xd7\x75\xe9\x9b\x8b\xed\xe5\xd9\x57\x76\x26\x87\x4f\xe2\x1b\x0a\x35\x45\xbd\x8a\x96\xce\x1a\x0a\x68\x30\x60\x7e\x06\xe0\xb0\xa1\x1c\xd8\x55\x42\xaa\x99\x6f\x49\x30\x78\x19\x06\xb4\xc5\x92\x72\xf0\x2d\x39\xad\x79\x13\xb2\x23\xf3\x2c\x5e\x93\xd5\x04\x05\x20\xde\x52\x0e\x68\x49\x42\x26\xd1\x92\x9e\x01\xa0\x73\x3e\x60\x60\xef\x86\x49\xec\x4a\x1b\x0d\xad\x85\x2c\xa1\xd2\x7a\x42\x5f\xb3\xbf\xe0\xeb\x26\x2b\xad\x8f\x26\x6b\xd0\x61\x45\x26\x87\x55\x90\x48\xab\xc7\x43\x95\xec\x66\x8c\xca\x6a\xae\xea\x0c\xb7\xc8\x16\xaf\xd9\x72\xd8\x7f\x07\x0e\xbb\xca\x52\xe6\xbc\xa1\xcc\xd0\x96\x6c\x4a\x76\x0a\xd7\x96\xca\x94\x47\x25\x3e\xb6\x43\x46\xd9\x50\xc5\x83\x3a\xad\xc7\x22\x74\xc5\x49\xcf\xf9\xf0\x92\xf5\x55\xca\xe1\xd7\xf7\x9f\xc4\xdf\xec\xaf\xc8\x7a\x34\xbf\x20\xdb\x61\x02\x00\xdd\xb4\x92\x43\xa0\xa6\xb5\x18\xa8\x10\x1f\x03\x49\x21\xdd\xc4\x62\x83\x6c\xa3\x10\xbc\x7b\x07\x97\x53\xc4\xc6\x4b\x0e\x6f\x9b\xe9\x7d\x2e\x4d\xff\x53\xda\x92\x74\xd5\xd8\xa1\x38\x76\xd5\x34\x76\x20\xd3\x10\x10\x9b\x06\x65\x3f\xf1\x84\x7e\x7d\x18\xd6\x9f\xcd\x34\xa4\xa5\x70\x1b\xba\xfd\xb8\xfa\x5c\xb3\xf6\x29\xc2\x86\x85\x14\x76\x35\xb9\x09\x24\x45\x2b\x04\x3f\xa2\x71\x50\x28\xbd\xdb\x70\x15\xa5\xe3\xf0\x1a\x76\x35\x97\x35\xec\xd8\x5a\x10\xd2\x68\x03\xb0\x83\x50\x13\xf4\x75\x00\xe7\x03\xb4\x5c\x7e\x65\x57\x41\x6c\x41\xa8\x24\x17\xec\x1e\x4a\x21\x0c\x64\xc0\x0b\x34\xde\xf0\x86\xc9\xf4\x21\xba\x5e\xcd\xd8\x36\xa4\x8a\x15\xe5\xb0\xba\x9f\x98\x02\x0a\x75\xfc\x12\xb0\x77\x70\x7b\x0b\x3f\xf7\x75\x5c\xb7\xde\xc0\xdd\xdd\x1a\xae\x7a\x06\xac\x1d\x09\xa1\xb4\x25\x42\x9a\xfe\x04\x12\xab\x13\xfa\x7f\xf4\x3b\xb7\x50\xbe\xc6\x36\x8d\x14\xb1\x4d\x2a\xbf\x79\x96\xca\xa5\x70\xe0\x12\xed\x77\xcb\xcc\x0a\x66\x4e\xe9\x69\xfa\x36\x14\x84\x4b\x05\xa1\xd6\x4b\x80\x50\x63\x58\x22\x3e\xa2\xc2\x88\x90\x54\xe8\x51\x52\x61\x8f\x01\x25\x71\x92\x22\x33\x81\x26\xcb\x82\xbb\x3b\xb8\x38\x94\xee\xa0\xf8\xbf\xf5\x6d\xfa\xc1\xbb\x20\xde\x5a\x92\x8f\x54\x09\x1a\x32\x0b\x29\x86\x76\x2e\xca\x69\x62\x7a\x34\xdc\xd5\xf1\x76\x7a\x7c\xb7\x1a\xe3\x57\x77\x2f\xd7\x99\x07\x49\x74\x15\x5a\x26\xf2\x34\x01\x53\x83\x1d\xe2\x69\xc0\x10\x75\x0e\x7b\x5c\xc5\xbf\x67\x1f\x01\x3e\xd7\x74\x4a\x9c\x8b\xc5\xf7\xa4\xd7\x50\xe3\x72\x9e\xc6\x3d\xbc\x71\xed\x7c\x8e\x2a\x84\xea\x5d\x6a\xcb\x47\xb5\xfd\xe2\x86\xf3\xc0\xd2\x73\xe5\x7d\x3f\x02\xf4\xfa\x3e\xaf\x27\xff\x15\x7d\xe3\x91\x64\x7e\x44\xe2\xc1\xcc\x26\xd0\xff\x43\xe0\x59\x4e\x0f\x6b\xfc\x13\x5c\x51\xe9\xc5\x74\x9e\x9b\x4e\xd5\x64\xde\x9d\xe1\xa6\x23\x25\xf9\xec\xe4\x23\x1b\x2f\xa0\xe4\xba\xa9\x5b\x46\x08\x64\x29\x8d\xed\xa7\xed\xd2\x6f\x81\x86\xdd\x79\x17\x59\x0c\x91\xf3\xcd\xd0\x5b\x77\xd1\x92\x14\x5a\xa3\x98\x57\x13\x6d\xe9\x78\xe4\x50\xda\xa8\x81\x24\x7f\x32\x44\xde\xb0\x5b\x52\xc0\x9b\x17\xa6\x80\x37\x0b\x0a\xb8\xad\x5e\x96\x02\x6e\xab\x05\x85\xbf\x22\xba\xc0\x96\xce\xdf\xac\xdf\xbe\x86\x97\xad\x07\x19\xc6\xa5\x2a\x1a\x1b\x38\x9f\x2e\x76\x7d\x50\xc1\x6e\xe3\x5f\xc1\xf5\x1e\xce\x83\xd5\x22\x90\x34\xec\xba\x0e\x3e\x4d\xe3\x18\x44\xbe\x88\xce\x35\x36\x0f\x5f\x28\xb3\xe0\xb3\x2e\x3e\xfb\x96\xc8\xe9\x4b\xe6\xa1\x1b\xfe\xc9\xa1\xf6\x31\x7c\xb0\xa8\xfa\x3b\x36\x73\xef\xe8\xd3\xfd\x46\x73\x34\xc5\xe0\x07\xc6\xb3\xd2\x8d\x63\xbb\x1e\xae\x28\x13\x5e\xd1\x35\xfa\xbd\x63\x6f\x30\xc6\xcb\xf9\x31\x74\x68\x8d\x0f\x99\xe3\x49\x7b\x3c\x34\x48\x18\xd8\x4c\xee\x36\x26\xd9\x99\xc0\x25\x18\xdc\x2f\xce\x93\x27\x99\xa5\x50\x32\x47\x74\xf7\x96\x49\xef\xd1\x29\x9d\x58\xcc\x7a\x57\x91\xa4\x6b\x4b\xba\xa6\x50\x5a\xfa\x9e\xa1\xce\x6f\x3e\x23\xec\x31\xc7\x54\x68\x58\xb5\xbf\x01\xd1\x03\x79\xcd\xc0\x27\xc5\xbf\xb8\xe1\xbf\x50\x77\x5d\xd5\x67\x2a\xdd\x7f\x48\x29\x17\x71\x04\x2c\xfc\xce\x91\x1c\x57\xba\xfe\x4f\x95\xee\x52\x81\xb4\xbc\x49\xdd\x37\x13\xc5\xf9\xb1\xe8\x03\xc9\x1f\x90\xfa\xc8\x32\x53\xee\xe3\x82\x27\xe5\xec\x63\x8f\x8b\xf9\x18\xe2\xd9\x3f\x01\x00\x00\xff\xff\x4a\xb1\x52\x38\xd3\x0f\x00\x00")
+var _manifests0000_90_ingressOperator_03_prometheusrulesYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x57\x4d\x6f\xdc\x36\x10\xbd\xfb\x57\x0c\xb6\x3d\x38\x40\xb4\x8e\x0f\xb9\x08\xf0\x21\x48\x50\xb4\x97\x22\x30\x92\xf6\x28\x8c\xc5\x59\x69\x10\x8a\xa3\x0e\xc9\x5d\x2f\xdc\xfd\xef\x05\xf5\x65\x79\x3f\x6c\xc7\x69\x5d\xf8\x22\x2d\x39\x6f\xde\xcc\x13\xdf\xd0\xd8\xf2\x1f\xa4\x9e\xc5\xe5\xd0\x88\xe3\x20\xca\xae\x5a\x96\xa2\x24\x7e\x59\x4a\x73\xb1\xbe\x3c\xfb\xc6\xce\xe4\xf0\x59\xa5\xa1\x50\x53\xf4\xd7\xd1\xd2\x59\x43\x01\x0d\x06\xcc\xcf\x00\x1c\x36\x94\x03\xbb\x4a\xc9\xfb\x4c\x5a\x52\x0c\xa2\xc3\x82\x6f\xb1\xa4\x1c\xa4\x25\xe7\x6b\x5e\x85\xec\xc8\x3e\x8b\x37\x64\x7d\x82\x02\x50\xb1\x94\x03\x5a\xd2\x90\x69\xb4\xe4\xcf\x00\xd0\x39\x09\x18\x58\xdc\xb0\x89\x5d\x69\xa3\xa1\xa5\x92\x25\xf4\xb4\x9c\xd0\x97\x2c\x17\x7c\xd3\x64\xa5\x95\x68\xb2\x06\x1d\x56\x64\x72\x58\x04\x8d\xb4\x78\x3a\xd4\x93\x5d\x8d\x51\x59\xcd\x55\x9d\xe1\x1a\xd9\xe2\x0d\x5b\x0e\xdb\xef\xc0\x61\x57\x59\xca\x9c\x18\xca\x0c\xad\xc9\xa6\x62\xa7\x70\xdf\x52\x99\xea\xa8\x54\x62\x3b\x54\x94\x0d\x5d\x3c\xe8\xd3\x72\x6c\x42\xfa\xeb\x9e\xf3\xe1\x25\xeb\xbb\x94\xc3\xaf\x1f\x3e\xab\xdc\x6e\xaf\xc9\x0a\x9a\x5f\x90\xed\xb0\x01\x80\x6e\x5b\xcd\x21\x50\xd3\x5a\x0c\x54\xa8\xc4\x40\x5a\x68\xb7\xb1\x58\x21\xdb\xa8\x04\x57\x57\x70\x39\x45\xac\x44\x73\x78\xdf\x4c\xef\x73\x69\xfa\x3f\x4f\x6b\xd2\xae\x1b\x1b\x54\xc7\xae\x9a\xd6\x0e\x64\x1a\x02\x62\xd3\xa0\x6e\x27\x9e\xd0\xe7\x87\x21\xff\x6c\xa7\x21\x5f\x2a\xb7\x09\x21\x87\xc5\x97\x9a\x7d\x5f\x22\xac\x58\xc9\xc3\xa6\x26\x37\x81\xa4\x68\x0f\x41\x46\x34\x0e\x1e\x4a\x71\x2b\xae\xa2\x76\x9f\xca\x5b\xd8\xd4\x5c\xd6\xb0\x61\x6b\x41\xc9\x47\x1b\x80\x1d\x84\x9a\xa0\xef\x03\x38\x09\xd0\x72\xf9\x8d\x5d\x05\xb1\x05\xa5\x92\x5c\xb0\x5b\x28\x95\x30\x90\x01\x51\x68\xc4\xf0\x8a\xc9\xf4\x21\x7e\xb9\x98\xb1\x6d\xc8\x7b\xac\x28\x87\xc5\xc3\xc2\x3c\xa0\x52\x57\x5d\x02\x16\x07\x77\x77\xf0\x73\xdf\xc7\x65\x2b\x06\x76\xbb\x25\x5c\x27\x38\x05\xf6\x1d\x09\xa5\xf4\x49\x84\xb4\xfd\x19\x24\x16\x27\xf4\xff\x24\x1b\x37\xd1\xeb\x95\xaf\xb1\x4d\x2b\x45\x6c\x93\xca\xef\x5e\xa4\x72\xa9\x1c\xb8\x44\xfb\xdd\x32\xb3\x07\x33\xa7\xf4\x3c\x7d\x1b\x0a\xca\xa5\x07\xa5\x56\x34\x40\xa8\x31\xec\x23\x3e\xa1\xc2\x88\x90\x54\xe8\x51\x52\x63\x8f\x01\x81\x38\x48\x8a\xcc\x04\x9a\x2c\x0b\x76\x3b\xb8\x38\x94\xee\xa0\xf9\xbf\xf5\x76\xf6\x51\x5c\x50\xb1\x96\xf4\x13\x55\x8a\x86\xcc\xc4\xb1\x97\x62\x38\xce\x45\x39\x6d\x4c\x8f\x86\xbb\x3e\xde\x4d\x8f\x57\x8b\x31\x7e\xb1\x7b\xbd\x93\x79\x50\x44\xd7\xa1\xfd\x42\x9e\x27\x60\x3a\x60\x87\x78\x3e\x60\x88\x7e\x0e\x7b\x5c\xc5\xbf\x67\x3f\x02\x7c\xa9\xe9\x94\x38\x17\x7b\xbf\x27\xbd\x86\x1e\xdf\xb7\x18\x78\x74\xce\x91\x7f\x9f\x3b\x9f\xa3\x2a\xa1\x17\x97\x8e\xe5\x93\xda\x7e\x75\xc3\x3c\xb0\xf4\x52\x79\x3f\x8c\x00\x8b\xdd\xcb\xcf\xe4\xbf\xa2\x6f\x3c\x52\xcc\x8f\x48\x3c\x98\xd9\x04\xfa\x7f\x08\x3c\xab\xe9\x71\x8d\x7f\x82\x6b\x2a\x45\x4d\xb2\x86\x6e\xaa\xa6\x39\xd2\x19\x6e\x1a\x29\xc9\x67\x27\x27\x5a\x89\x82\x27\xd7\x6d\x5d\x33\x42\x20\x4b\x69\x6d\x3b\x40\x65\xc3\x27\xd0\xb0\x3b\xef\xc6\x44\x31\x44\xce\x3f\x86\x6e\xc1\x17\x2d\x69\xe1\x6b\x54\xf3\x66\x08\x86\x34\x76\x44\x4d\x0e\xa5\x8d\x3e\x90\xe6\xcf\x86\xc8\x1b\x76\xfb\x14\xf0\xf6\x95\x29\xe0\xed\x1e\x05\x5c\x57\xaf\x4b\x01\xd7\xd5\x1e\x85\xbf\x22\xba\xc0\x96\xce\xdf\x2d\xdf\xbf\x85\x57\x25\xd3\x90\x61\xdc\x57\xc5\xc7\x06\xce\xa7\x8b\x5d\x1f\x54\xb0\x5b\xc9\x1b\xb8\xd9\xc2\x79\xb0\xbe\x08\xa4\x0d\xbb\xee\xde\x72\x9a\xc6\x31\x88\x7c\x2f\x3a\xf7\xb1\x79\xfc\x42\x99\x05\xc9\xba\xf8\xec\xbe\x90\xd3\x97\xcc\x43\x37\xfc\x93\x43\x2d\x31\x7c\xb4\xe8\xfd\xef\xd8\xcc\xbd\xa3\x2f\xf7\x9e\xe6\x90\xb1\x08\x32\x30\x9e\xb5\x6e\x5c\xdb\xf4\x70\x45\x99\xf0\x8a\xc4\xf9\xe1\xd8\x1b\x8c\xf1\x72\x3e\x86\x0e\xad\xf1\x31\x73\x3c\x69\x8f\x87\x06\x09\x03\x9b\xf1\x7d\x2a\x32\x91\x80\x4b\x30\xb8\xdd\x9b\x27\xcf\x32\x4b\xa5\x34\xf8\xd0\x3d\x48\x03\xe8\x20\x3a\x4f\x27\x92\x59\x71\x15\x69\xba\xff\x38\x10\x47\x29\xf5\x03\x43\x9d\x59\xea\x62\x84\x3d\xe6\x98\x1e\x1a\xf6\x3e\x79\xd7\xdc\xb5\x0f\xeb\x5a\x2e\x0e\x15\xff\xea\x86\xff\x85\xba\xeb\xaa\x7f\xa1\xd2\xbd\xf4\xa9\xb3\x45\x1c\x01\x0b\xd9\x38\xd2\xe3\x4a\xd7\xff\xa9\xd2\x5d\x29\x90\xd2\x9b\x74\xfa\x66\xa2\x38\x19\x9b\x3e\x90\xfc\x01\xa9\x8f\xa4\x99\x6a\x1f\x13\x9e\x94\xb3\x8f\x3d\x2e\xe6\x53\x88\x67\xff\x0c\x00\x4a\xb1\x52\x38\xd3\x0f\x00\x00")
 
 func manifests0000_90_ingressOperator_03_prometheusrulesYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -582,7 +583,7 @@ func manifests0000_90_ingressOperator_03_prometheusrulesYaml() (*asset, error) {
 	return a, nil
 }
 
-var _manifests01ClusterRoleBindingYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x91\xbd\x4e\xec\x30\x10\x85\xfb\x3c\xc5\x68\x6f\xed\x5c\xdd\xee\x2a\x1d\x50\xd0\x2f\x12\xfd\xc4\x9e\x4d\x86\x75\x66\x22\xcf\x38\x12\x3c\x3d\x32\xfb\xd3\x80\x16\xa8\xed\xef\xe8\xcc\x77\xfe\xc0\x3d\x4b\x32\xf0\x99\x40\x57\x2a\xe8\x5a\x20\xe6\x6a\x4e\x05\x8a\x66\x02\x57\x60\x37\x78\xa2\xb2\x71\x24\xb8\x8b\x51\xab\x78\xdf\x1d\x59\xd2\x00\x0f\xa7\xaf\x7b\xcd\xd4\x82\x58\xa6\x0e\x57\x7e\xa6\x62\xac\x32\x40\x19\x31\xf6\x58\x7d\xd6\xc2\x6f\xe8\xac\xd2\x1f\xff\x5b\xcf\xfa\x77\xfb\xd7\x2d\xe4\x98\xd0\x71\xe8\x00\x04\x17\x1a\x5a\x03\xb1\x99\x0f\x1e\x58\xa6\x42\x66\xe1\xd2\xa9\x03\x40\x11\xf5\x8f\x0c\x6b\x04\x00\x4b\xcc\x35\x51\x5f\x28\x13\x1a\xf5\x57\xba\xe5\xf3\xb8\x84\x98\xb5\xa6\xb0\xa0\xe0\x44\x69\x80\x9d\x97\x4a\xbb\xef\x51\xa3\x7c\xb8\x50\x61\xe6\x69\x0e\xb8\x21\x67\x1c\x39\xb3\xbf\xfe\x22\x87\x65\xca\x14\x44\x13\x85\x44\x1b\xe5\x76\xcc\x15\xb7\x3a\xbe\x50\x74\x1b\xba\x00\x27\x97\x67\xc5\x67\xc3\x57\x29\x5f\xa8\x68\x0f\xb6\x62\xbc\xad\xac\xcd\xb7\xa7\x43\x93\xf5\x69\xac\xe6\x73\xe5\xc7\xa2\x75\xbd\xb1\xd2\x4f\x86\x79\x0f\x00\x00\xff\xff\x73\x86\x17\xa7\x42\x02\x00\x00")
+var _manifests01ClusterRoleBindingYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x91\x31\x6f\xe3\x30\x0c\x85\x77\xff\x0a\x22\x37\xcb\x87\xdb\x0e\xde\xee\x3a\x74\x4f\x81\xee\xb4\xc4\xd8\x6c\x64\xd2\x10\x29\x03\xed\xaf\x2f\xd4\x24\x5e\x5a\xa4\xe8\xae\xef\xe9\xf1\x7b\xbf\xe0\x3f\x4b\x32\xf0\x99\x40\x57\x2a\xe8\x5a\x20\xe6\x6a\x4e\x05\x8a\x66\x02\x57\x60\x37\x78\xa2\xb2\x71\x24\xf8\x17\xa3\x56\xf1\xbe\x3b\xb3\xa4\x01\x1e\x2e\x4f\x8f\x9a\xa9\x05\xb1\x4c\x1d\xae\xfc\x4c\xc5\x58\x65\x80\x32\x62\xec\xb1\xfa\xac\x85\xdf\xd0\x59\xa5\x3f\xff\xb5\x9e\xf5\xf7\xf6\xa7\x5b\xc8\x31\xa1\xe3\xd0\x01\x08\x2e\x34\xb4\x06\x62\x33\x9f\x3c\xb0\x4c\x85\xcc\xc2\xad\x53\x07\x80\x22\xea\x1f\x19\xd6\x08\x00\x96\x98\x6b\xa2\xbe\x50\x26\x34\xea\x77\xba\xe5\xf3\xb8\x84\x98\xb5\xa6\xb0\xa0\xe0\x44\x69\x80\x83\x97\x4a\x87\xef\x51\xa3\x7c\xba\x51\x61\xe6\x69\x0e\xb8\x21\x67\x1c\x39\xb3\xbf\xfe\x20\x87\x65\xca\x14\x44\x13\x85\x44\x1b\xe5\x76\xcc\x8e\x5b\x1d\x5f\x28\xba\x0d\x5d\x80\x8b\xcb\xab\xe2\xab\xe1\x5d\xca\x17\x2a\x9a\x2d\x5b\x31\xde\x57\xd6\xe6\x3b\xd2\xa9\xc9\xfa\x34\x56\xf3\xb9\xf2\x63\xd1\xba\xde\x59\x69\xef\x70\xe7\x97\xf7\x01\x00\x73\x86\x17\xa7\x42\x02\x00\x00")
 
 func manifests01ClusterRoleBindingYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -602,7 +603,7 @@ func manifests01ClusterRoleBindingYaml() (*asset, error) {
 	return a, nil
 }
 
-var _manifests01RoleBindingYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x92\xbd\x6e\xe4\x30\x0c\x84\x7b\x3f\x05\xb1\x5b\xcb\x87\xeb\x0e\xee\x2e\x4d\xfa\x0d\x90\x9e\x96\x68\x9b\x59\x99\x34\x24\xda\x40\xf2\xf4\x81\x76\xbd\x3f\x01\xf2\x83\x20\x45\x52\xa4\x16\x47\x43\x7e\x33\x5b\xb8\x61\x09\x19\x6c\x20\xd0\x89\x12\x9a\x26\x48\x1a\x09\x4c\x81\x2d\xc3\x1d\xa5\x85\x3d\xc1\x7f\xef\x75\x16\xab\xab\x3d\x4b\x68\x60\xa7\x91\x8a\x92\xa5\xaf\x70\xe2\x7b\x4a\x99\x55\x1a\x48\x2d\xfa\x1a\x67\x1b\x34\xf1\x13\x1a\xab\xd4\xfb\x7f\xb9\x66\xfd\xb3\xfc\xad\x46\x32\x0c\x68\xd8\x54\x00\x82\x23\x35\xc0\xd2\x27\xca\xd9\x9d\xac\xd7\x87\x3c\xa1\xa7\xa6\x2c\x24\x79\xe0\xce\xdc\x2b\x73\x28\xa2\x76\x70\xc8\xe5\x3f\x00\x16\x1f\xe7\x40\x75\xa2\x48\x98\xa9\x3e\xab\x8b\x3b\xb7\xa3\xf3\x51\xe7\xe0\x46\x14\xec\x29\x34\xb0\xb1\x34\xd3\xe6\x63\x69\xa6\xd8\x9d\x54\x6e\xe0\x7e\x70\xb8\x20\x47\x6c\x39\xb2\x3d\x7e\xe2\x1f\x96\x3e\x92\x13\x0d\xe4\x02\x2d\x14\xcb\x31\x67\x79\x9e\xdb\x07\xf2\x96\x9b\xca\xc1\x11\xf1\x4a\x7e\x05\xff\x75\x64\x25\xd5\x1d\x75\x05\xd6\x25\xc3\x02\x72\xe2\xdb\xa4\xf3\xf4\x4e\x78\x6f\x9b\x3b\xe7\xaa\xed\x75\x1b\xa0\xd3\xf4\xb2\x4d\xa6\x10\x28\x92\xd1\x61\x0c\x50\xc2\xf5\x7c\xae\xb6\xc0\x72\x52\xac\xbb\x7b\x95\x8e\xfb\xcb\x5d\xdf\xd4\xba\xe3\x1a\xbf\x5d\xfb\x21\x5d\x7b\x0e\x00\x00\xff\xff\x2d\x9f\xa9\x08\xac\x04\x00\x00")
+var _manifests01RoleBindingYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x92\xbd\x6e\xe4\x30\x0c\x84\x7b\x3f\x05\xb1\x5b\xcb\x87\xeb\x0e\xee\x2e\x4d\xfa\x0d\x90\x9e\x96\x68\x9b\x59\x99\x34\x24\xda\x40\xf2\xf4\x81\x76\xbd\x3f\x01\xf2\x83\x20\x45\x52\xa4\x16\x47\x43\x7e\x33\x5b\xb8\x61\x09\x19\x6c\x20\xd0\x89\x12\x9a\x26\x48\x1a\x09\x4c\x81\x2d\xc3\x1d\xa5\x85\x3d\xc1\x7f\xef\x75\x16\xab\xab\x3d\x4b\x68\x60\xa7\x91\x8a\x92\xa5\xaf\x70\xe2\x7b\x4a\x99\x55\x1a\x48\x2d\xfa\x1a\x67\x1b\x34\xf1\x13\x1a\xab\xd4\xfb\x7f\xb9\x66\xfd\xb3\xfc\xad\x46\x32\x0c\x68\xd8\x54\x00\x82\x23\x35\xc0\xd2\x27\xca\xd9\x9d\xac\xd7\x87\x3c\xa1\xa7\xa6\x2c\x24\x79\xe0\xce\xdc\x2b\x73\x28\xa2\x76\x70\xc8\xe5\x3f\x00\x16\x1f\xe7\x40\x75\xa2\x48\x98\xa9\x3e\xab\x8b\x3b\xb7\xa3\xf3\x51\xe7\xe0\x46\x14\xec\x29\x34\xb0\xb1\x34\xd3\xe6\x63\x69\xa6\xd8\x9d\x54\x6e\xe0\x7e\x70\xb8\x20\x47\x6c\x39\xb2\x3d\x7e\xe2\x1f\x96\x3e\x92\x13\x0d\xe4\x02\x2d\x14\xcb\x31\x67\x79\x9e\xdb\x07\xf2\x96\x9b\xca\xc1\x11\xf1\x4a\x7e\x05\xff\x75\x64\x25\xd5\x1d\x75\x05\xd6\x25\xc3\x02\x72\xe2\xdb\xa4\xf3\xf4\x4e\x78\x6f\x9b\x3b\xe7\xaa\xed\x75\x1b\xa0\xd3\xf4\xb2\x4d\xa6\x10\x28\x92\xd1\x61\x0c\x50\xc2\xf5\x7c\xae\xb6\xc0\x72\x52\xac\xbb\x7b\x95\x8e\xfb\xcb\x5d\xdf\xd4\xba\xe3\x1a\xbf\x5d\xfb\x21\x5d\x7b\x1e\x00\x2d\x9f\xa9\x08\xac\x04\x00\x00")
 
 func manifests01RoleBindingYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -622,7 +623,7 @@ func manifests01RoleBindingYaml() (*asset, error) {
 	return a, nil
 }
 
-var _manifests01RoleYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x52\xbd\x6e\xde\x30\x0c\xdc\xf5\x14\x82\xb3\x15\x90\x8b\x6e\x85\xc7\x2e\xdd\x3b\x74\xa7\xa5\xfb\x6c\x22\x32\x29\x48\xb2\x81\xf4\xe9\x0b\xff\x36\xc9\xd7\x20\xe8\xd2\x25\x1b\x25\xde\x1d\xff\xee\xc1\xfe\xd0\x08\x7b\xd3\x6c\xeb\x08\xab\x09\x99\xaa\x66\xcb\xb5\x20\xde\x5a\xf3\xc8\x12\xba\x0d\x63\x28\xf1\x4f\xe4\xc2\x2a\x9d\xcd\x3d\xf9\x96\xe6\x3a\x6a\xe6\x5f\x54\x59\xa5\x7d\xfc\x5a\x5a\xd6\xcf\xcb\x17\x33\xa1\x52\xa0\x4a\x9d\xb1\x56\x68\x42\x67\x59\x86\x8c\x52\xdc\x29\x7f\x24\x4a\x22\x8f\x6e\x2d\x2a\x65\xe4\x5b\x75\x7f\xc1\x91\x88\xd6\xad\x42\x59\xf5\xac\x65\xf1\x71\x0e\x68\x33\x22\xa8\xa0\xbd\xd8\x6b\x75\xee\x27\xe7\xa3\xce\xc1\x4d\x24\x34\x20\x74\xb6\xa9\x79\x46\xf3\x3e\x75\x9d\xf7\x64\xb9\x91\x87\xd1\xd1\x42\x1c\xa9\xe7\xc8\xf5\xe9\x1f\x74\x58\x86\x08\x27\x1a\xe0\x02\x16\xc4\x75\x98\x8b\x9e\xe7\x88\xd2\x19\x67\x29\xf1\xf7\xac\x73\xda\xa6\x72\xd7\xe2\x5f\x68\x19\x6b\x33\x8a\xce\xd9\xe3\x80\x35\x9f\xd6\x0e\x16\xe4\xfe\xd9\xc7\xbd\x5a\xd3\xdc\x53\x93\x86\xb2\x05\x05\x79\x61\x8f\xfd\x01\x09\x49\x59\xea\xfe\x4a\xeb\x7d\x4b\x85\xd4\x45\xe3\x3c\xc1\x47\xe2\xe9\x00\x2e\x38\x51\x5e\xe5\xc6\xc3\x44\xe9\xd4\xf3\x19\x5b\xea\xdd\xbe\x28\x6d\x9c\x57\x9d\x05\xa4\xa8\x4f\xd3\xa5\xff\xac\xc1\x57\x8a\xce\x39\xf3\x96\x63\xab\xda\x80\x88\x8a\x3d\x4f\x12\xb6\xe0\x1b\x4b\x60\x19\x8a\x79\xb0\x2c\x27\xe3\xb0\xdb\x3e\xc8\x1f\x2b\xfe\x6f\xbb\xef\xf5\x3f\x8e\xc9\xdf\x5c\xe3\xbd\x27\xb2\xc6\xc3\xa1\x6b\xd4\x9f\x47\x7c\xe1\x88\xfd\xdc\xe6\x77\x00\x00\x00\xff\xff\x6a\x68\x9e\x35\xc3\x04\x00\x00")
+var _manifests01RoleYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x53\xbd\x8e\xdc\x3c\x0c\xec\xf5\x14\x82\xaf\xfb\x00\xf9\x43\xba\xc0\x65\x9a\xf4\x29\xd2\xd3\xd2\xac\x4d\x9c\x4c\x0a\x92\x6c\xe0\xf2\xf4\x81\xff\x36\x7b\xf0\x1d\x2e\x69\xd2\xa4\xa3\x24\xce\x0c\x49\x0d\x9f\xec\x37\x8d\xb0\x37\xcd\xb6\x8e\xb0\x9a\x90\xa9\x6a\xb6\x5c\x0b\xe2\xad\x35\xcf\x2c\xa1\xdb\x72\x0c\x25\xfe\x8e\x5c\x58\xa5\xb3\xb9\x27\xdf\xd2\x5c\x47\xcd\xfc\x83\x2a\xab\xb4\xcf\x9f\x4b\xcb\xfa\xff\xf2\xc9\x4c\xa8\x14\xa8\x52\x67\xac\x15\x9a\xd0\x59\x96\x21\xa3\x14\x77\xd2\x1f\x0f\x25\x91\x47\xb7\x8a\x4a\x19\xf9\x56\xdd\x1b\x79\x24\xa2\x75\x53\x28\x2b\x9f\xb5\x2c\x3e\xce\x01\x6d\x46\x04\x15\xb4\x77\xf4\xaa\xce\xfd\xe4\x7c\xd4\x39\xb8\x89\x84\x06\x84\xce\x36\x35\xcf\x68\x3e\x86\xae\xfd\x9e\x28\x37\xf2\x30\x3a\x5a\x88\x23\xf5\x1c\xb9\xbe\xfc\x01\x0f\xcb\x10\xe1\x44\x03\x5c\xc0\x82\xb8\x36\x73\x87\xe7\x39\xa2\x74\xc6\x59\x4a\xfc\x35\xeb\x9c\xb6\xae\xdc\x7d\xf0\xaf\xb8\x8c\xb5\x19\x45\xe7\xec\x71\xa4\x35\xff\xad\x9d\x2c\xc8\xfd\xc3\xc5\x95\xad\x69\xae\xd0\xa4\xa1\x6c\x41\x41\x5e\xd8\x63\x3f\x40\x42\x52\x96\xba\x9f\xd2\xfa\xbf\xa5\x42\xea\xa2\x71\x9e\xe0\x23\xf1\x74\x24\x2e\x38\xb3\xbc\xca\x8d\x87\x89\xd2\xc9\xe7\x33\x6a\xf9\x9d\xba\x28\xa5\x72\xad\x2c\x20\x45\x7d\x99\xee\xfc\x0f\x05\x7e\xc8\xe8\x55\x73\x60\x79\xb4\xe0\x55\x60\x33\xca\x1b\x74\xce\x39\xf3\xde\x02\x54\xb5\x01\x11\x15\xfb\x3b\x49\xd8\x82\x2f\x2c\x81\x65\x28\xe6\xc9\xb2\x9c\x88\xc3\xbd\xfb\x5c\x7e\x39\xfb\x6f\x6f\xcf\xae\xff\xef\xec\xcc\xbb\x63\xbc\x3a\x20\x6b\x3c\x0c\xbf\x46\xfd\xf9\x89\xaf\x1c\x11\x10\x51\x61\x7e\x0e\x00\xab\x14\x16\xbc\x12\x05\x00\x00")
 
 func manifests01RoleYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -637,12 +638,12 @@ func manifests01RoleYaml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "manifests/01-role.yaml", size: 1219, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x77, 0xe5, 0x92, 0x85, 0x96, 0x0, 0xd4, 0xff, 0x8a, 0x66, 0x86, 0x5d, 0x30, 0x5e, 0xbb, 0x48, 0x7f, 0x3c, 0x7e, 0xed, 0xcd, 0x43, 0x7f, 0x39, 0x5, 0x9d, 0x4c, 0xad, 0x86, 0x84, 0x29, 0xe0}}
+	info := bindataFileInfo{name: "manifests/01-role.yaml", size: 1298, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x47, 0x49, 0x82, 0xa8, 0xdf, 0xc7, 0x84, 0x33, 0x7c, 0x95, 0xbc, 0xc5, 0x28, 0xa7, 0x4d, 0x50, 0xcc, 0x5a, 0xd1, 0x89, 0x9c, 0x5a, 0x9a, 0x6d, 0x95, 0x36, 0xe, 0x57, 0xe9, 0xe2, 0x29, 0x5}}
 	return a, nil
 }
 
-var _manifests01ServiceAccountYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x90\xb1\x6e\xf3\x30\x0c\x84\x77\x3f\x05\x91\xcc\xf2\x8f\x7f\xd5\xd6\xb1\x73\x81\xee\x8c\x74\xb1\x89\xca\x94\x2b\x52\x06\xfa\xf6\x85\x82\x24\x53\x81\xa2\xb3\xee\xfb\xee\xc4\x33\xbd\xa4\x54\xbb\x3a\x5d\x6b\x23\x5f\x41\x75\x47\x63\xaf\x8d\xc4\x0d\xe5\x3a\xd3\xab\x93\xad\xb5\x97\x4c\x0d\x9f\x5d\x1a\x48\x79\x83\xed\x9c\x40\x96\xea\x8e\x3c\x9d\x69\x47\xdb\xc4\x4c\xaa\xda\x3c\x7d\x88\xe6\x48\x6f\x68\x87\x24\xdc\xfd\x13\xef\xf2\x8e\x36\x12\x91\x8e\xff\xd3\x06\xe7\xcc\xce\x71\xa2\x9b\x2f\x92\xe8\xd2\x60\x16\x1e\x03\xee\x0f\xb7\xa2\x38\x66\xa9\xad\x72\xf5\xf0\x43\x8e\x55\xab\xb3\x8f\xf6\xe1\x23\x12\x4d\xa5\x67\xcc\x0d\x05\x6c\x98\x9f\xf4\x2c\xf5\x9f\x5c\xb6\x90\x4a\xed\x39\x6c\xac\xbc\x20\x47\x3a\x79\xeb\x38\xfd\x8e\x8e\x8b\x3c\xa8\xb0\xca\xb2\x06\x3e\x58\x0a\x5f\xa4\x88\x7f\xfd\xc1\x23\xba\x14\x04\xad\x19\x21\xe3\x40\x19\x9f\x79\xe2\xdf\x01\x00\x00\xff\xff\xea\x53\x09\xdb\x95\x01\x00\x00")
+var _manifests01ServiceAccountYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x90\xb1\x4e\xc4\x30\x10\x44\xfb\x7c\xc5\x2a\x57\x3b\x88\xd6\x1d\x25\x35\x12\xfd\x9e\x3d\x49\x56\x38\xeb\xb0\x5e\x47\xe2\xef\x51\x4e\x77\x54\x48\x88\xda\xf3\xde\x8c\xf7\x42\x2f\x29\xd5\xae\x4e\x73\x35\xf2\x15\x54\x77\x18\x7b\x35\x12\x6f\x28\xf3\x44\xaf\x4e\x6d\xad\xbd\x64\x32\x7c\x76\x31\x90\xf2\x86\xb6\x73\x02\xb5\x54\x77\xe4\xe1\x42\x3b\x6c\x93\xd6\xa4\x6a\x9b\x86\x0f\xd1\x1c\xe9\x0d\x76\x48\xc2\xdd\x3f\xf0\x2e\xef\xb0\x33\x11\xe9\x78\x1e\x36\x38\x67\x76\x8e\x03\xdd\x7c\x91\x44\x17\x43\x6b\xe1\x31\xe0\xfe\x70\x2b\x8a\xe7\x2c\x6d\xab\xcc\x1e\x7e\xc9\xb1\x6a\x75\xf6\xb3\xfd\xf4\x11\x89\xa6\xd2\x33\x26\x43\x01\x37\x4c\x3f\xf4\x24\xf5\x49\xae\x5b\x48\xa5\xf6\x1c\x36\x56\x5e\x90\x23\x8d\x6e\x1d\xe3\xdf\xe8\x79\x91\x07\x15\x56\x59\xd6\xc0\x07\x4b\xe1\xab\x14\xf1\xaf\x7f\x78\x44\x97\x82\xa0\x35\x23\x64\x1c\x28\xe7\x67\x22\x8d\x6e\x1d\xe3\xf0\x3d\x00\xea\x53\x09\xdb\x95\x01\x00\x00")
 
 func manifests01ServiceAccountYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -662,7 +663,7 @@ func manifests01ServiceAccountYaml() (*asset, error) {
 	return a, nil
 }
 
-var _manifests01ServiceYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x90\xbf\x6a\x33\x31\x10\xc4\xfb\x7b\x0a\xe1\x5e\xf7\xf1\xe1\xca\x6a\x53\xa5\x33\x04\xd2\xef\xe9\xc6\xe7\x25\xba\x95\xd8\xdd\x3b\xf0\xdb\x07\xff\x4b\x48\x5c\x98\x94\xa3\xd1\x6f\x66\x24\x6a\xfc\x0e\x35\xae\x92\xc2\xfa\xbf\xfb\x60\x19\x53\x78\x83\xae\x9c\xd1\xcd\x70\x1a\xc9\x29\x75\x21\x90\x48\x75\x72\xae\x62\x67\x19\x02\x4b\x2e\xcb\x88\x5e\x51\x40\x86\xbe\x36\x88\x1d\xf9\xe0\x3d\xd7\x7f\x3c\xcc\x31\x97\xba\x8c\x71\x26\xa1\x09\x63\x0a\x1b\xd7\x05\x9b\xe7\xa8\xa1\x1c\xee\x54\x3c\xf2\x74\x8c\xb4\x12\x17\x1a\xb8\xb0\x9f\xfe\x90\xc3\x32\x15\x44\xa9\x23\xe2\x88\x15\xa5\x36\xe8\x0f\xdc\xae\xcf\xec\x07\x38\xfd\xde\xa0\x2b\xcb\x14\x33\xd4\xa3\x21\x2b\x3c\x0a\xcd\x48\x61\x86\x2b\x67\x8b\x5e\xac\x0b\xa1\xd0\x80\x72\xfb\x8f\xab\xcf\x32\x29\xcc\xe2\xb9\x8c\xbc\x6a\x77\x37\x6e\xe0\x4d\x5b\xa3\x8c\x14\xbe\x4a\xe3\x03\x67\x0d\xf9\x1c\xdc\xaa\xfa\xa5\x21\x3e\x04\x5d\xcd\x14\x76\xdb\xdd\xf6\x22\x9d\x74\x82\xef\x2f\x87\xdf\xd7\x0c\x05\xd9\xab\x3e\x99\xe9\xa7\x86\x14\x5e\xca\x62\x0e\x7d\xdd\x77\x9f\x01\x00\x00\xff\xff\x4b\xbb\xe1\xcf\x1a\x02\x00\x00")
+var _manifests01ServiceYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x90\xbf\x6a\x33\x31\x10\xc4\xfb\x7b\x8a\xc5\xbd\xee\xe3\xc3\x95\xd5\xa6\x4a\x67\x08\xa4\xdf\xd3\x8d\xcf\x4b\x74\x92\xd8\xdd\x3b\xf0\xdb\x07\xff\x49\x88\xe3\xc2\xa4\x1c\x46\xbf\x99\xd1\x72\x93\x77\xa8\x49\x2d\x91\xd6\xff\xdd\x87\x94\x31\xd2\x1b\x74\x95\x84\x6e\x86\xf3\xc8\xce\xb1\x23\xe2\x52\xaa\xb3\x4b\x2d\x76\x96\x44\x52\x52\x5e\x46\xf4\x8a\x0c\x36\xf4\xb5\xa1\xd8\x51\x0e\xde\x4b\xfd\x27\xc3\x1c\x52\xae\xcb\x18\x66\x2e\x3c\x61\x8c\xb4\x71\x5d\xb0\x79\x8e\x1a\xf2\xe1\x8b\x0a\x47\x99\x8e\x81\x57\x96\xcc\x83\x64\xf1\xd3\x1f\x72\xa4\x4c\x19\xa1\xd4\x11\x61\xc4\x8a\x5c\x1b\xf4\x0e\xb7\xeb\x37\xfb\x01\xce\xbf\x37\xe8\x2a\x65\x0a\x09\xea\xc1\x90\x14\x1e\x0a\xcf\x88\x34\xc3\x55\x92\x05\xcf\xd6\x11\x65\x1e\x90\x6f\xf7\xb8\xfa\x52\x26\x85\x59\x38\x97\xb1\x57\xed\x88\xee\xc0\x9b\xb6\xc6\x09\x91\xbe\x4b\xc3\x03\x67\x0d\xe9\x1c\xdc\xaa\xfa\xa5\x21\x3c\x04\x5d\xcd\x48\xbb\xed\x6e\x7b\x91\xce\x3a\xc1\xf7\x55\xfd\xe7\x33\x43\x46\xf2\xaa\x4f\x66\xfa\xa9\x21\xd2\x4b\x5e\xcc\xa1\xaf\xfb\xee\x73\x00\x4b\xbb\xe1\xcf\x1a\x02\x00\x00")
 
 func manifests01ServiceYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -682,7 +683,7 @@ func manifests01ServiceYaml() (*asset, error) {
 	return a, nil
 }
 
-var _manifests01TrustedCaConfigmapYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x91\xbd\x4e\xec\x30\x10\x85\xfb\x3c\xc5\x68\xb7\x76\xae\x6e\x9b\x96\x9a\x0e\xd1\x4f\xec\x93\x64\x58\x67\xc6\xb2\x27\x8b\x78\x7b\xb4\x7f\xb0\x20\x24\x44\xe9\xb1\xbf\x63\x9d\x6f\xf6\xf4\xb4\x80\x14\xfe\x6a\xf5\x40\x56\x50\xd9\xad\x92\x34\xaa\x68\xc5\xb4\xc9\x98\x41\xd3\x69\xa4\x2f\x88\x2e\x3a\x77\x7b\xf2\x05\xe4\x75\x6b\x8e\x44\x91\x69\xdc\x34\x65\x90\xa8\x1b\xf9\x22\x8d\xa2\xe9\x24\xf3\xca\xa5\xef\xb8\xc8\x33\x6a\x13\xd3\x81\x8e\xff\xbb\x83\x68\x1a\xe8\xe1\x7c\xff\xc8\xa5\x5b\xe1\x9c\xd8\x79\xe8\x88\x58\xd5\x9c\x5d\x4c\xdb\xe9\x48\x24\x1a\xf3\x96\xd0\x57\x64\x70\x43\x6f\x05\xda\x16\x99\xbc\x17\xfb\x27\xe3\x1a\x62\xb6\x2d\x85\x95\x95\x67\xa4\x81\x76\x5e\x37\xec\x7e\x47\x1b\xf2\x74\xa3\xc2\x22\xf3\x12\xf8\xc8\x92\x79\x94\x2c\xfe\xf6\x87\x1c\xd1\x39\x23\xa8\x25\x84\x84\x23\xf2\xc9\xdf\x17\xfc\x47\x2c\x56\xb0\x23\x98\xe6\xfb\xbf\x32\x8f\xc8\xd7\xe2\x17\x7f\xdf\xfa\x9e\xfd\x87\xab\xf6\x10\xf9\x62\xfd\x2e\x41\x79\xc5\x40\x9f\x0f\xae\xa3\x56\x38\x62\xa0\x8f\xb0\x20\x3a\x57\xb4\x16\x6e\xdb\xee\xde\x03\x00\x00\xff\xff\x4b\xe9\x81\x95\x05\x02\x00\x00")
+var _manifests01TrustedCaConfigmapYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x91\xb1\x6e\xeb\x30\x0c\x45\x77\x7f\x05\x91\xcc\xf2\xc3\x5b\xbd\x76\xee\x56\x74\xa7\xa5\x6b\x9b\x8d\x4c\x0a\x12\x9d\xa2\x7f\x5f\xa4\x71\x8a\x04\x28\x50\x74\xd4\x15\xce\x25\x78\x78\xa4\x97\x05\xa4\xf0\x77\xab\x27\xb2\x82\xca\x6e\x95\xa4\x51\x45\x2b\xa6\x4d\xc6\x0c\x9a\x2e\x91\xbe\x21\xba\xe8\xdc\x1d\xc9\x17\x90\xd7\xad\x39\x12\x45\xa6\x71\xd3\x94\x41\xa2\x6e\xe4\x8b\x34\x8a\xa6\x93\xcc\x2b\x97\xbe\xe3\x22\xaf\xa8\x4d\x4c\x07\x3a\xff\xef\x4e\xa2\x69\xa0\xa7\xaf\xff\x67\x2e\xdd\x0a\xe7\xc4\xce\x43\x47\xc4\xaa\xe6\xec\x62\xda\x2e\x4f\x22\xd1\x98\xb7\x84\xbe\x22\x83\x1b\x7a\x2b\xd0\xb6\xc8\xe4\xbd\xd8\x3f\x19\xd7\x10\xb3\x6d\x29\xac\xac\x3c\x23\x0d\x74\xf0\xba\xe1\xf0\x3b\xda\x90\xa7\x1b\x15\x16\x99\x97\xc0\x67\x96\xcc\xa3\x64\xf1\x8f\x3f\xf4\x88\xce\x19\x41\x2d\x21\x24\x9c\x91\x2f\xfe\x1e\xf0\x1f\xb1\x58\xc1\x8e\x60\x9a\xef\x67\x65\x1e\x91\xf7\xc5\xaf\xfe\x1e\xa9\xab\xff\xb0\x6b\x0f\x91\xaf\xd6\xef\x1a\x94\x57\x0c\xb7\xbb\x84\xc8\x7b\xd4\x0a\x47\x0c\xf4\x5d\x16\x44\xe7\x8a\xd6\x82\x15\x54\x76\xab\xdd\xe7\x00\x4b\xe9\x81\x95\x05\x02\x00\x00")
 
 func manifests01TrustedCaConfigmapYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -702,7 +703,27 @@ func manifests01TrustedCaConfigmapYaml() (*asset, error) {
 	return a, nil
 }
 
-var _manifests02DeploymentIbmCloudManagedYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x57\xdd\x6f\xdb\x36\x10\x7f\xf7\x5f\x41\x64\x03\xba\x01\xa5\xe5\xa4\x1f\x5b\x05\xe4\xc1\x73\xdc\x26\x40\xe2\x18\x76\xd0\x62\x4f\xc6\x99\x3a\xdb\x9c\x29\x52\x3d\x1e\xdd\x08\xc3\xfe\xf7\x81\x92\xeb\x48\xb6\xf3\x01\x74\x0f\xd3\x93\x44\xde\xfd\x8e\xf7\xbb\x0f\x9e\x7e\x12\x5f\xfa\x93\xd1\xd5\xe8\x53\x2a\x2e\x6e\xc5\xe8\xf6\x4e\x0c\x2f\xae\xee\x5e\x8b\x25\x5a\x24\x60\xcc\xc4\xbc\x14\x2b\x50\xeb\x24\x14\x19\x30\xca\x82\xdc\x42\x1b\x94\x39\x58\xbd\x40\xcf\xbe\xeb\x57\x1d\x28\xf4\x67\x24\xaf\x9d\x4d\x05\x14\x85\x4f\x36\xa7\x9d\xb5\xb6\x59\x2a\x2e\xb0\x30\xae\xcc\xd1\x72\x27\x47\x86\x0c\x18\xd2\x8e\x10\x60\xad\x63\x60\xed\xac\x8f\x9f\x42\x28\x67\x17\x7a\xd9\x75\x05\x5a\xbf\xd2\x0b\xee\x6a\x97\x68\xfb\x17\x2a\x8e\x16\xef\xcb\x54\x68\xbb\x24\xf4\x5e\xba\x22\x1e\xcc\x51\xa5\xa6\xad\x32\x21\xc3\x2e\xa1\x41\xf0\xb8\xa7\x3f\xcf\xa5\x32\x2e\x64\xf1\xb0\xb0\xc4\x2c\x15\x27\x4c\x01\x4f\x3a\x42\x58\xc8\xf1\x28\x66\xdc\xf0\x05\x28\x4c\xc5\x0e\x4c\x1e\xc8\xf9\x02\x55\x3c\x38\x61\x61\xb4\x02\x9f\x8a\xd3\x8e\x10\x1e\x0d\x2a\x76\x54\xbb\x94\x03\xab\xd5\x35\xcc\xd1\x6c\x7d\x7c\xc2\xaa\xe7\x48\xf6\xb2\xac\x05\xb9\x2c\x30\x15\x13\x54\x84\xc0\xd8\x11\x82\x31\x2f\x0c\x30\x6e\x81\x1b\x44\xc6\xe7\x80\xcc\x0a\x03\x68\x89\xdc\xfd\xe6\x68\x6d\x1c\x64\x6d\x66\x6a\x3e\x62\x54\x52\xf1\xea\xef\x13\x5c\x2c\x50\xf1\x49\x2a\x4e\xc6\x84\x0b\x24\xc2\xec\x22\x90\xb6\xcb\xa9\x5a\x61\x16\x8c\xb6\xcb\x93\x7f\x5e\x6d\xa1\x4d\xcb\xa3\x27\x7c\x12\xe2\x3b\x4b\xdb\x00\x33\x68\x8b\xb4\x53\x95\x42\xb9\x3c\x07\x9b\x3d\x60\xc9\xe3\x38\xf5\x96\x67\x20\x6e\x7c\x4b\xb9\x8b\x55\x63\xf5\xe7\x5f\xbe\xf4\xef\x06\x97\xb3\x51\xff\x66\x38\x1d\xf7\x07\xc3\x5f\x5b\x2a\x3a\x87\x65\x5b\xfc\xea\xa6\xff\x69\x4f\x48\x81\x05\x2a\x8f\xc8\x0e\xfa\xa3\xfe\xe4\xcf\xd9\x11\x95\x6d\x02\xca\x4d\x5d\x06\x2d\xad\xc9\xf0\x7a\xd8\x9f\x0e\x67\x9f\x87\x93\xe9\xd5\xed\xe8\x41\x11\xed\xa6\xe9\x7b\xcd\xe4\x9e\xf4\x6e\x5f\x88\x0d\x98\x80\xa9\xe8\x75\x7b\xdd\x53\xe9\x2d\x14\x7e\xe5\xf8\x40\x7f\xcf\xfd\x7d\xfd\x8f\xe4\xf2\xb4\xb1\x28\xc4\x42\xa3\xc9\x26\xb8\x68\xaf\x6e\xd7\xc7\xc0\xab\x74\x97\x71\xdd\x63\x8c\xd7\x66\x2b\x4e\x0e\x0f\xbb\x4b\xbb\xc4\x91\x5e\x6a\x2b\x57\x50\xd5\xb3\x24\x17\x18\x29\xdd\xbc\xed\xf6\x0e\xb0\x9a\x34\xbf\x00\x52\x99\xe0\x19\xe9\xa0\x4a\xd3\x58\x32\xfe\x81\xa1\x2a\x9e\x3f\xaa\x3f\x0e\xc6\x8c\x9d\xd1\xaa\x4c\xc5\xd5\x62\xe4\x78\x4c\xe8\x63\x7b\xfb\x2e\xf5\x44\x3d\xc4\x87\xd0\xbb\x40\x0a\x7d\x93\x6e\xc2\xaf\x21\xf6\xd2\x76\x08\x54\x11\x52\x71\xda\xcb\x5b\x8b\x39\xe6\x8e\xca\x54\xbc\x7b\x7f\xa3\x77\x1b\x1e\x55\x20\xcd\xe5\xc0\x59\xc6\x7b\x6e\xc2\x80\x31\xee\xdb\x98\xf4\x46\x1b\x5c\xe2\xd0\x2b\x30\x55\xab\x48\xc5\x02\x8c\xc7\x86\xa4\x82\x02\xe6\xda\x68\xd6\xb8\x77\x90\x8c\x5c\xd1\x5e\x91\xa2\x7f\x7d\xfd\xd0\x6b\x90\x72\x6d\x2b\xd8\x1b\xf4\x3e\xb2\xb4\x65\xe8\x23\x18\x33\x07\xb5\xbe\x73\xd7\x6e\xe9\x6f\xed\x90\xa8\xc1\xc5\xc6\x99\x90\xe3\x8d\x0b\xb6\xe9\xba\x14\x79\x5c\xa9\x33\x2f\x41\x56\x49\xb1\xd6\x89\x02\xc9\x14\x3c\x27\x78\xcf\x04\x8a\x31\x4b\x0a\x6c\x52\x53\xf3\x5e\xc9\x60\x26\x15\xb4\xe8\x85\xec\xd6\x9a\xb2\xda\xc6\x47\x0c\x6d\x80\x12\x0a\x36\xf1\xb1\xed\xb2\x4f\x1e\xd2\xc4\x23\x6d\xb4\x42\x50\x2a\x8a\x1f\x98\x9c\xbb\x60\x33\xe9\x41\xb2\x5b\xa3\x7d\xce\xac\x14\x40\xcb\x96\xb7\x52\x1a\xb7\x64\xe7\x39\x43\xa2\xd6\x7a\x15\x56\x94\x46\x7b\x46\x2b\x21\xcb\x62\x4a\x9d\xa7\x1f\xde\x7c\x78\xd3\x92\x63\xe3\xa5\xd2\xc5\x0a\x49\xfa\xa0\x19\xfd\xf9\xdd\xf5\x74\x36\x1c\x5c\x5c\x0e\x67\x93\x69\x7f\xf6\xe5\xea\xee\x72\xd6\x1f\x4e\x67\xa7\x67\xbf\xcf\x3e\x0d\x6e\x66\xd3\xcb\xfe\xd9\xbb\xf7\xaf\x1f\xa4\x86\x83\x8b\x67\xe4\x0e\x70\x06\x7f\x0c\x5e\x84\x73\x54\xee\x09\xb4\x96\x67\xa1\xf0\x4c\x08\xf9\xf9\x8a\xb9\x48\x93\xe4\xf4\xec\xb7\x6e\xd5\xff\xd2\xf7\xbd\x5e\xaf\x97\x1c\xd2\x80\xc4\x32\x0e\x28\xe7\x55\xe6\xb0\xf1\x49\x41\x7a\x03\x8c\xf1\xbd\xab\xf6\x6e\x90\xa8\xb2\xdd\x97\x6b\x2c\x9f\xd0\x5c\x63\xb9\xdf\x48\xbe\x06\x28\xe3\x6d\x7a\xd0\x50\xd6\x61\x8e\x92\xe6\xa0\xb6\xb3\xcb\x5e\x1f\xa9\xd3\x66\x4f\x68\xb7\x5b\x38\x6a\x57\xc3\xee\xe6\x1c\x3b\xe2\x54\xb4\xa2\xff\x1d\x2c\x47\x26\xad\xfc\x7f\xde\x65\xde\xf6\xfe\x3f\x5d\xe6\xa5\xdd\xa2\x11\xb9\xc7\x78\x8a\x71\x7f\xae\x50\xad\xcb\x70\xda\x1a\xe8\xe2\x13\xa3\x46\x16\x19\x7d\x15\x79\x9f\x0a\xa3\x6d\xb8\xdf\xee\x17\xa4\x5d\xc5\x90\x01\xef\x47\x95\x45\x5f\x7a\xc6\x7c\x77\xc7\x28\xd2\xac\x15\x98\xce\x33\x94\x52\xb0\x7d\x3f\x72\x76\xe2\x1c\xef\xb5\x2d\x8f\x4a\xb9\xbc\x18\xd7\x83\x78\x93\xb1\xed\xdc\x18\x2c\xeb\x1c\x2f\x70\x01\xc1\xf0\xce\x50\xd5\xc3\xfa\x75\x0f\x1b\x3d\x75\x47\xb1\x33\xf1\xbb\x39\x4e\x4a\x51\x8f\x88\xa9\x18\xb9\xed\x4c\xf8\x70\x9e\x35\x96\x69\xc5\x96\x24\x67\xb0\xdb\x66\x28\x87\xe8\xf6\x4e\x76\x77\xb5\x8a\xe1\xbd\xf6\xec\x8f\xe0\x0f\xef\x51\x05\x3e\x02\xbf\x87\x1c\x2c\x21\xa8\x15\xcc\x1b\x47\x79\x04\xbe\xe9\xd3\x14\x95\xb3\x59\x1c\xd8\xcf\x7a\x3f\x60\xdd\x3a\x96\x31\x6d\xca\x1f\xb4\x5d\x27\x75\x83\xe8\xc7\xf3\xb4\xbe\x98\x9a\xf1\xae\x57\x46\x8f\x68\xc8\xed\x4f\xd5\x0d\xb4\xca\x4a\x33\xe6\xad\xca\x93\xb5\x93\x0a\xe4\x3c\xd8\xcc\x60\xab\x49\x56\x59\x5d\xd5\x56\xd5\x5d\x77\x32\x2f\xbc\x7b\x1f\xd9\x90\x4f\x5f\x9d\x05\xb9\xf8\xe7\x87\x59\xcb\xd9\xc3\x86\x26\xf7\xd2\xfa\x2e\xa2\xec\x8f\xb1\x10\x32\x8d\xb6\xf5\x3f\xb7\x27\xb1\xf5\xaf\x3a\xc2\xbf\x01\x00\x00\xff\xff\x89\x10\xa4\x60\x10\x0f\x00\x00")
+var _manifests01WebhookServiceYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x8f\xb1\x6a\xc3\x40\x10\x44\x7b\x7d\xc5\xe1\xfe\x1c\x42\x5c\x5d\x9b\x2a\x9d\x21\x90\x7e\xa5\x1b\x4b\x8b\x4f\xbb\xc7\xde\x4a\xc1\x7f\x1f\xec\xd8\xc1\xe0\xc2\xa4\x1c\x66\xde\xcc\x2e\x55\xfe\x82\x35\x56\x49\x61\x7d\xed\x8e\x2c\x39\x85\x4f\xd8\xca\x03\xba\x19\x4e\x99\x9c\x52\x17\x02\x89\xa8\x93\xb3\x4a\x3b\xcb\x10\x58\x86\xb2\x64\x6c\x0d\x05\xd4\xb0\xd5\x0a\x69\x13\x1f\x7c\xcb\xfa\xc2\xfd\x1c\x87\xa2\x4b\x8e\x33\x09\x8d\xc8\x29\x6c\xdc\x16\x6c\x9e\xa3\x0d\xe5\x70\xa3\xe2\xc4\xe3\x14\x69\x25\x2e\xd4\x73\x61\x3f\xfd\xa3\x87\x65\x2c\x88\xa2\x19\x31\x63\x45\xd1\x0a\xbb\xc3\x0b\xf5\x28\xd7\x5f\x84\x66\xa4\xc0\x32\x1a\x5a\x8b\xe7\x20\xb9\x5a\x77\x33\xbe\xd1\x4f\xaa\xc7\xab\x6e\x95\x06\xa4\xf0\x37\x16\x1f\xb8\x56\x31\x9c\x8b\xab\x9a\x5f\x16\xe2\x43\xd1\xaf\x99\xc2\x6e\xf7\x76\x51\x4e\x36\xc2\xf7\x6a\x7e\x9f\x6a\x28\x18\x5c\xed\xc9\x95\x7e\xaa\x48\xe1\xbd\x2c\xcd\x61\x1f\xfb\xee\x67\x00\xab\xee\xa4\xc0\xd5\x01\x00\x00")
+
+func manifests01WebhookServiceYamlBytes() ([]byte, error) {
+	return bindataRead(
+		_manifests01WebhookServiceYaml,
+		"manifests/01-webhook-service.yaml",
+	)
+}
+
+func manifests01WebhookServiceYaml() (*asset, error) {
+	bytes, err := manifests01WebhookServiceYamlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "manifests/01-webhook-service.yaml", size: 469, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xb2, 0xbe, 0x3a, 0x94, 0x56, 0xe2, 0x18, 0xc7, 0x7, 0x24, 0x70, 0x58, 0x6a, 0xb1, 0x49, 0x53, 0x57, 0x26, 0xe9, 0xb2, 0xb2, 0xef, 0xbb, 0xcc, 0x89, 0x47, 0xec, 0x82, 0x5d, 0xbe, 0x39, 0xc1}}
+	return a, nil
+}
+
+var _manifests02DeploymentIbmCloudManagedYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x58\x5f\x6f\x22\xbb\x15\x7f\xe7\x53\x58\x69\xa5\x6d\xa5\x6b\x86\x64\xf7\x6e\xbb\x96\xf6\x81\x02\x77\x37\x2a\x21\x08\xd8\xbb\xea\x13\x3a\x78\x0e\x8c\x8b\xc7\x9e\x6b\x9f\x21\x99\x56\xfd\xee\x95\x67\x80\x99\x01\x42\x22\xed\x7d\xb8\x22\x2f\x39\xff\x7d\xfe\xfc\xec\x33\x7f\x62\xdf\xfb\xb3\xc9\xfd\xe4\x8b\x60\xc3\x47\x36\x79\x5c\xb0\xd1\xf0\x7e\xf1\x13\xdb\xa0\x41\x07\x84\x31\x5b\x15\x2c\x01\xb9\x8d\xf2\x2c\x06\x42\x9e\x39\xbb\x56\x1a\x79\x0a\x46\xad\xd1\x93\xef\xfa\xa4\x03\x99\xfa\x15\x9d\x57\xd6\x08\x06\x59\xe6\xa3\xdd\x6d\x67\xab\x4c\x2c\xd8\x10\x33\x6d\x8b\x14\x0d\x75\x52\x24\x88\x81\x40\x74\x18\x03\x63\x2c\x01\x29\x6b\x7c\xf8\x97\x31\x69\xcd\x5a\x6d\xba\x36\x43\xe3\x13\xb5\xa6\xae\xb2\x91\x32\xff\x46\x49\xc1\xe3\x73\x21\x98\x32\x1b\x87\xde\x73\x9b\x85\xc0\xac\x2b\xd5\x94\x91\x3a\x8f\xb1\xeb\x50\x23\x78\x3c\xd1\x5f\xa5\x5c\x6a\x9b\xc7\x21\x58\xd8\x60\x2c\xd8\x0d\xb9\x1c\x6f\x3a\x8c\x19\x48\xf1\xa2\xcd\xc0\xf0\x19\x48\x14\xec\x68\x8c\x9f\xc9\xf9\x0c\x65\x08\xdc\x61\xa6\x95\x04\x2f\xd8\x5d\x87\x31\x8f\x1a\x25\x59\x17\x38\x8c\xa5\x40\x32\x19\xc3\x0a\xb5\xaf\x08\x57\xbc\x7a\x0a\xc9\xde\x14\x95\xa0\xb3\x5a\x2b\xb3\xf9\x56\x66\xfc\xa0\x9b\xc2\xf3\x3c\x77\x1b\x14\xac\x57\x53\xbe\x19\xd8\x81\xd2\xb0\xd2\x28\xd8\x6d\x49\xa7\x22\x43\xc1\x66\x4d\x13\x1d\xc6\x08\xd3\x4c\x1f\xad\x35\x4b\xc1\xd8\x85\x72\x84\x3f\x02\xb7\x41\xea\x3e\x59\xb7\xd5\x16\xe2\x76\x6e\xab\x8c\x86\xba\x0a\xf6\xee\xbf\x37\xb8\x5e\xa3\xa4\x1b\xc1\x6e\xa6\x0e\xd7\xe8\x1c\xc6\xc3\xdc\x29\xb3\x99\xcb\x04\xe3\x3c\x84\x72\xf3\xbf\x77\x7b\xd3\xba\x95\x93\x2b\x59\x61\xec\x90\xe7\x7d\x8b\x10\x28\x83\xee\xa8\xca\x99\xb4\x69\x0a\x26\x3e\x10\x18\xe3\x97\xed\x84\x1f\x67\x9e\xc0\x51\xe3\x7f\xce\x8f\xd5\x6e\x50\xff\xfc\x97\xef\xfd\xc5\xe0\xeb\x72\xd2\x7f\x18\xcd\xa7\xfd\xc1\xe8\xaf\x2d\x15\x95\xc2\xa6\x2d\x7e\xff\xd0\xff\x72\x22\x24\xc1\x80\x2b\x2e\xc8\x0e\xfa\x93\xfe\xec\x5f\xcb\x0b\x2a\x96\x50\x73\x69\x75\xd5\x42\x17\x54\x1f\x17\xa3\xf1\x72\xf0\x38\x1e\x8f\x06\x8b\xc7\xd9\x25\x13\xfb\x29\xe0\xbb\x6a\x16\x5b\xda\xb3\xd1\x78\xd4\x9f\x8f\x96\xbf\x8e\x66\xf3\xfb\xc7\x49\x5b\x51\x23\xc4\xe8\x78\xd9\xbe\x2d\xc6\x13\xae\x12\x6b\xb7\x5c\x2b\x4f\x68\x38\xc4\x71\x33\x9f\xef\xc4\xa7\x0f\x1f\xde\x1f\xca\x1a\x28\x9c\x27\x08\x9a\x92\x6b\x0a\xbd\xb6\x02\xb9\xdc\x13\xc6\x5c\x02\x5f\xe5\x26\xd6\xcd\x43\x47\x48\x32\xca\xb6\x2a\x92\x50\xc9\x45\xf8\x4c\x0e\x24\x61\x1c\x65\x98\x46\xa4\x7d\xad\xd7\xcd\x30\x3d\xea\xa2\xd9\x35\x9b\x22\xd4\x59\xb0\x93\x1c\x1c\xf9\x8c\xed\x40\xe7\x61\xb2\xba\xbd\xee\x2d\xf7\x06\x32\x9f\xd8\x66\xa7\x54\xfa\x27\x7d\x71\xaa\xff\x8b\xb3\x69\xed\x34\xfc\xd6\x0a\x75\x3c\xc3\x75\x9b\xba\xa7\x4f\x81\x12\x71\x1c\xc5\xee\xa5\x56\x0c\x34\xc1\xca\x4a\x9f\x07\x7b\x9c\xc7\xc8\x3a\xb5\x51\x86\x27\x50\x42\x25\x77\x36\x27\x74\x62\xf7\xa1\xdb\x3b\xb3\xd5\xec\xbf\x37\x98\x94\x3a\x14\xc7\x9d\x01\xa0\x08\x58\xe2\xcf\x33\x74\xa9\x47\xdf\xe0\x26\x10\x08\x35\xa6\x48\xae\xa8\x87\xe0\xd4\x4b\x39\x13\x3f\x10\x65\xa9\x3f\xcd\xb5\x9e\x5a\xad\x64\x21\xd8\xfd\x7a\x62\x69\xea\xd0\xa3\xa9\xa5\xb4\xda\xa1\x41\xef\xa7\xce\xae\xf6\x78\xb9\xaf\x1a\x28\x9d\x3b\x5c\x24\x0e\x7d\x62\x75\x2c\xd8\xfb\x06\x37\x21\xca\xbe\x20\xb5\x4b\x9d\x95\x35\x8e\xaa\xa1\xf8\x4f\x9b\x65\x1d\x09\x56\x71\x1a\x0c\x65\x14\x29\xd0\x43\xd4\x50\xcc\x51\x5a\x13\x7b\xc1\xde\xd7\x85\x64\x2c\x43\xa7\x6c\x7c\xe4\xdd\xf6\xde\x02\xa4\x07\x8f\x0d\xd8\xe5\x35\xa2\x4e\xcb\x58\xc2\x30\x1f\xb9\x07\x6b\x7b\x04\xb8\xaa\xd5\x8c\xae\xd2\x3a\x39\x96\x43\x88\xd5\xef\x9c\xd3\x60\xb3\x78\x53\x4a\x5f\x4e\x98\x43\x6f\x73\x27\xb1\x91\x95\x40\xfc\x2d\x0f\x0f\x9b\xb6\x57\x99\xe5\x82\xdd\xf6\x6a\x84\x09\xbf\x14\x53\xeb\x0a\xc1\x7e\xfe\xf8\xa0\x8e\x0c\x8f\x32\x77\x8a\x8a\x81\x35\x84\xcf\xad\xe0\x41\x6b\xfb\x34\x75\x6a\xa7\x34\x6e\x70\xe4\x25\xe8\xf2\x11\x24\xd8\x1a\xb4\xaf\x27\x9f\x31\x09\x19\xac\x94\x56\xa4\xda\xc1\x31\x16\x3b\x9b\xb5\x29\x9c\xf5\xc7\xe3\x23\x85\xd0\xa5\xca\x94\x66\x1f\xd0\xfb\xd0\xf1\xfb\x6e\xff\x05\xb4\x5e\x81\xdc\x2e\xec\xd8\x6e\xfc\xa3\x19\x39\xd7\xe8\x8e\x9d\xd5\x79\x8a\x0f\x36\x37\xed\x26\x49\x03\xa5\xc2\xaa\x57\xe0\xf8\xac\x0b\x6a\x68\x6f\xb0\x42\xdd\x1e\x8d\x2e\x04\x0b\x6f\xb1\x17\x1c\xed\xc0\x45\x2e\x37\x91\x47\xe9\x90\x7c\x54\x8f\xbc\x47\xb7\x53\x12\x41\xca\x20\xde\xb0\x5b\x35\xde\xca\xe6\x26\xe6\x1e\x38\xd9\x2d\x9a\xd7\xdc\x72\x06\x6e\xd3\x3a\x2d\xe7\xda\x6e\xc8\x7a\x8a\xd1\xd5\xb9\x09\xf7\x54\x59\x56\x6c\x5e\x6c\xe8\xfd\x67\xf1\xe9\xfd\xa7\xba\x63\x83\x5c\x79\x27\xa9\x2c\x41\xc7\x7d\xae\x08\xfd\xe7\xc5\x78\xbe\x1c\x0d\x86\x5f\x47\xcb\xd9\xbc\xbf\xfc\x7e\xbf\xf8\xba\xec\x8f\xe6\xcb\xdb\xbb\xbf\x2f\xbf\x0c\x1e\x96\xf3\xaf\xfd\xbb\x9f\x3f\xfe\x54\x4b\x8d\x06\xc3\x57\xe4\xce\xec\x0c\xfe\x31\x78\x93\x9d\x8b\x72\x57\xac\xb5\x4e\x96\x67\x9e\x1c\x42\xfa\x39\x40\x9d\x88\xa2\xdb\xbb\xbf\x75\xcb\x1b\x53\x7c\xec\xf5\x7a\xbd\xe8\x3c\x0d\xe8\x88\x87\x6d\xe1\x73\xd9\x39\xa4\x7d\x94\x39\xb5\x03\xc2\x70\x73\x77\xe5\xc9\x63\x2c\x64\x6e\xcf\xe7\x5b\x2c\xae\x68\x6e\xb1\x38\xbd\x14\x7e\xcb\xa1\x08\x0f\xd3\xb3\xcb\x61\x9b\xaf\x90\xbb\x15\xc8\xfd\x22\x71\x72\x27\x54\x6d\x73\x22\xf4\x76\xc8\x6c\x56\xff\x60\x2c\xdc\x62\x4a\xfa\xdf\x1d\x65\x3e\xf4\xfe\x38\x28\xf3\x56\xb4\x68\x54\xee\xa5\x3c\x85\x56\x79\x6d\x50\x8d\x8d\x71\xde\xda\xae\xc2\x5f\xa8\x9a\x33\x48\xe8\xcb\xca\x7b\xc1\xb4\x32\xf9\xf3\x9e\x9f\x39\x65\x4b\x1c\xd6\xe0\xfd\xa4\xf4\xe8\x0b\x4f\x98\x1e\xdf\x0b\xd2\x29\x52\x12\x74\xe7\x95\x94\xba\xdc\xf4\xfd\xc4\x9a\x99\xb5\xd4\x0a\x2b\xac\x7c\x52\xda\x34\x9b\x56\x5b\x71\xad\x72\xdc\xc3\x72\x43\x2a\xc5\x21\xae\x21\xd7\x87\xae\xdb\x63\x58\xbf\xc2\xb0\xc9\xb5\x5b\x9b\xac\x46\xd7\xde\xcc\x38\xab\xb6\x2d\xc1\x26\x76\xbf\x5e\xd5\xf1\x6c\xb1\x10\x65\xb6\xb8\xb3\x1a\xbb\xed\x0c\xa5\x10\x9e\x49\x47\xd9\x83\x2b\xc1\x46\xcf\xca\x93\xbf\x60\x7f\xf4\x8c\x32\xa7\x0b\xe6\x4f\x2c\xe7\xc6\x21\xc8\x24\xec\xa1\xaf\x99\x6f\x9e\xa9\xbe\x93\xef\x7a\x3f\xe0\xdd\x58\xe2\x01\xdf\x8b\x1f\xf4\x4d\x36\xb3\xda\x6e\x8a\x79\x16\xac\x0d\xac\x09\x6b\xb9\x6a\x74\x38\xaf\x76\xd7\xf3\x4e\xbc\xb8\xed\xbf\xe9\x51\x16\xd6\xfa\x2d\x3e\x1d\xb6\xf7\x66\x18\xff\x0c\xc9\x6e\x9f\x34\xb1\x9e\x82\xc1\xa3\xec\x53\x82\xe6\x9b\xf1\x40\xca\xaf\x55\x48\xbf\x60\x43\x3b\xb1\x74\xd2\x18\xd5\xb8\x36\xce\xf1\xf2\x04\x56\x57\x6e\xf3\x0c\x15\x65\xf2\x82\x06\xdf\x7f\xbb\x79\x80\x16\x60\x28\xc2\xb4\x95\x09\x5e\x35\x4f\xbd\xae\x35\xe1\xbf\x7e\xd7\xbd\xbc\xd2\x5d\x79\x55\xbc\xc0\xe0\xd7\x1f\x05\x99\xb3\xe1\x03\x13\x36\x3e\x1f\x30\x76\x01\xaa\xf9\xc9\xc0\x2e\x82\x95\xa6\x40\xf8\x41\x1e\x2b\x34\xad\xcf\x46\x27\x12\xfb\xf3\xd9\x2d\x9a\xce\xff\x07\x00\x3c\x25\x5b\xd1\x77\x13\x00\x00")
 
 func manifests02DeploymentIbmCloudManagedYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -717,12 +738,12 @@ func manifests02DeploymentIbmCloudManagedYaml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "manifests/02-deployment-ibm-cloud-managed.yaml", size: 3856, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x3d, 0x41, 0x2d, 0xa2, 0x67, 0xcd, 0x8b, 0x3d, 0x43, 0x65, 0x5c, 0xda, 0xa4, 0x97, 0x60, 0xf0, 0x7b, 0xf7, 0x82, 0xbb, 0xe6, 0x14, 0x7, 0xd3, 0xe4, 0x28, 0xd9, 0xb6, 0xe0, 0x28, 0x16, 0x24}}
+	info := bindataFileInfo{name: "manifests/02-deployment-ibm-cloud-managed.yaml", size: 4983, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x1d, 0x87, 0x9d, 0xfc, 0xe3, 0x35, 0xb7, 0x89, 0x67, 0x79, 0x99, 0x9f, 0x21, 0xb4, 0x95, 0xe2, 0x3e, 0x50, 0x9a, 0x25, 0x4a, 0xc7, 0xd4, 0xc0, 0x8e, 0x8a, 0xb8, 0x17, 0x14, 0x38, 0xfd, 0x5}}
 	return a, nil
 }
 
-var _manifests02DeploymentYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x58\x6f\x6f\xf3\xb8\x0d\x7f\xdf\x4f\x21\x78\x03\x6e\x03\x4e\x49\xda\xbb\x7b\xb6\x33\xd0\x17\x59\x9a\xe7\x5a\xa0\x69\x83\xa4\xb8\x62\x18\x86\x80\x91\x99\x58\x8b\x2c\xf9\x28\x2a\x4f\x83\x61\xdf\x7d\x90\xe3\xfc\xb1\x93\xa6\x7d\x73\xc0\xf9\x55\x6a\x91\x3f\x92\x3f\x52\x24\x5d\x28\xf5\xaf\x48\x5e\x3b\x9b\x0a\x28\x4b\xdf\x5d\x5f\x5f\xad\xb4\xcd\x52\x71\x87\xa5\x71\x9b\x02\x2d\x5f\x15\xc8\x90\x01\x43\x7a\x25\x84\x85\x02\x53\xa1\xed\x92\xd0\x7b\xe9\x4a\x24\x60\x47\xf5\x81\x2f\x41\x61\x2a\x5c\x89\xd6\xe7\x7a\xc1\xf2\x8c\x1c\x58\xeb\x18\x58\x3b\xeb\x23\x9e\x10\xca\xd9\x85\x5e\x76\xf6\x4a\x1d\xed\xba\xda\xfe\x07\x15\xcb\x92\xdc\xdb\xe6\xac\x35\x21\xb4\x55\x26\x64\xd8\x21\x34\x08\x1e\x9b\xfa\x1e\xcd\x42\x16\x60\x61\x89\x99\xcc\xf5\x32\x97\xb0\x06\x6d\x60\xae\x8d\xe6\x4d\x2a\x12\xa6\x80\xc9\x27\x70\xb4\x5d\x1a\x94\xd6\x65\x28\x33\x5c\xa3\x89\x2e\xec\xd5\x7d\x89\x2a\xc6\x40\x58\x1a\xad\xc0\xa7\xe2\xfa\x4a\x08\xcf\x04\x8c\xcb\xcd\x36\x3a\xde\x94\x98\x8a\x09\x2a\x42\x60\x8c\xc7\x68\x50\xb1\xa3\xed\x71\x01\xac\xf2\x47\x98\xa3\xa9\xd9\xb8\xc0\x30\x63\x51\x1a\x60\xac\x35\x8f\x92\x12\x9f\x13\x5e\x2b\xeb\x40\x4b\xe4\xce\x37\x47\x2b\xe3\x20\x6b\x06\xb7\xe5\x27\x66\x38\x15\xdf\xfd\x37\xc1\xc5\x02\x15\x27\xa9\x48\xc6\x84\x0b\x24\xc2\xec\x2e\x90\xb6\xcb\xa9\xca\x31\x0b\x46\xdb\x65\xf2\xbf\xef\x6a\x68\xd3\x70\xf9\x82\xd3\x42\xec\x58\xaa\x7e\xa3\x0a\xa4\x79\x33\x70\x96\xf1\x8d\x0f\xfa\x14\x6c\xdf\x3f\x39\x3b\x71\x8e\x53\x11\xe9\xdd\x1f\x79\x54\xca\x15\xe5\x98\xdc\x42\x1b\x3c\xa8\xec\xb9\x0d\x96\x75\x81\x77\xb8\x80\x60\x78\x47\xa2\xcb\x70\xda\x60\x3a\x3e\xab\x30\x47\xb2\xc8\xe8\x63\xfc\xce\xa7\xc2\x68\x1b\xde\x0e\x51\xc4\x3c\x93\x33\xd8\x69\x4a\x16\xe0\xb9\xca\x7b\x52\x8b\xb2\x33\x31\xc2\x63\xb2\xa5\x58\x61\xac\xac\xcb\x18\xc9\xde\xd6\x8e\xa3\x54\x24\xc3\x37\xed\xd9\x1f\x8e\xb6\x99\x48\x45\xf2\xe4\x6a\xee\x31\x39\x63\xa5\x65\x20\x58\x42\x50\x39\xcc\x0f\xd2\x9f\xb5\x32\x7c\x43\x15\xf8\x48\xed\x10\xdf\x14\x95\xb3\x59\x2c\xed\x9b\xde\xc7\x3e\x58\xc7\x92\x10\xb2\xcd\xef\xeb\x81\x47\x5a\x6b\x85\x7d\xa5\x5c\xb0\xfc\xf4\x7e\xed\x09\x51\x92\x76\x55\xc5\x19\xf0\x7e\x2b\xe9\x37\x9e\xb1\x90\xca\x84\x98\x11\xa9\x48\xb3\x56\x60\x6a\x05\xe5\x2c\x83\xb6\x48\x47\xd5\x2d\x2f\xd5\xf7\x07\x95\x1d\x1f\x30\xc6\x7d\x1b\x93\x5e\x6b\x83\x4b\x1c\x7a\x05\xa6\x0a\x2d\x15\x0b\x30\x1e\x1b\xb2\x0a\xca\x6d\x9b\xd2\xe8\x9b\x28\x42\x64\xe4\xca\x54\xfc\x2b\xe9\x3f\x3e\x26\xff\x3e\xbe\x08\x48\x85\xb6\x15\xe4\x08\xbd\x87\x25\x8e\x9d\xd1\x6a\x93\x8a\xaf\x60\xcc\x1c\xd4\xea\xc5\x3d\xba\xa5\x7f\xb6\x43\xa2\x86\xdb\xba\x88\xc2\xc1\x98\x9d\xc2\xc3\xe2\xc9\xf1\x98\xd0\xc7\xc6\xdf\x92\x3b\xea\xec\x5d\x47\x7a\xa9\xed\x9e\xc4\x36\x33\x69\x6c\x54\xfe\x18\x41\xb9\xa2\x00\x9b\x1d\x87\x24\x2f\x11\x2a\x85\x67\x20\x6e\xbc\x91\x72\x3f\x65\x1a\xef\x93\x3f\xff\xe5\xb5\xff\x32\xb8\x9f\x3d\xf5\x47\xc3\xe9\xb8\x3f\x18\xfe\x35\x69\x29\x56\x01\xb4\x95\x1e\x46\xfd\x5f\x4e\x45\x15\x58\xa0\xcd\x79\x8d\x41\xff\xa9\x3f\xf9\xe7\xec\xbc\x62\x3d\x42\xe4\x7a\x3b\x51\xdb\xba\x93\xe1\xe3\xb0\x3f\x1d\xce\x7e\x1d\x4e\xa6\x0f\xcf\x4f\x0d\x75\xb4\xeb\x66\xb2\x77\x25\xd7\x52\x6a\x15\xc4\x1a\x4c\xc0\x54\x24\xbd\x4e\xaf\x73\x2d\xbd\x85\xd2\xe7\x8e\x93\xb3\x48\x2d\x86\xce\x21\x7d\x25\x57\xb4\x6b\x4e\x88\x85\x46\x93\x4d\x70\x71\x7a\x52\x9f\x8d\x81\xf3\x74\x3f\x90\x3a\xe7\x72\x74\x70\xa3\xa2\xee\x7c\x18\x27\xd5\x95\x43\xb5\x01\x48\x72\x21\xf6\xdf\xf5\x8f\x9d\xde\x59\xcc\xe3\xac\x7c\x12\xfa\xf3\x85\x4b\xe8\x5d\x20\xd5\xbe\x8d\x84\xbf\x05\xf4\x7c\x72\x47\x55\x19\x52\x71\xdd\x2b\x5a\xaf\x0b\x2c\x1c\x6d\x52\xf1\xd3\x97\x91\x3e\x3a\x5a\x3b\x13\x0a\x1c\xc5\x36\xe6\x9b\x57\x63\x1b\x19\x53\x74\x33\x93\x0a\x1a\x78\x45\x54\xd8\xd2\xde\x45\x56\xdd\x72\xa5\xbb\x0a\x64\x25\xdd\xc5\x37\x26\x50\x8c\x59\xb7\xc4\xa2\xe5\x33\x64\xcf\xd6\x6c\x5a\x13\xf6\x60\x6e\xee\x82\xcd\xa4\x07\xc9\x6e\x85\xf6\x5d\x93\x6b\xa0\x2e\x05\xdb\xf5\x71\xad\x61\xdf\x3d\xb0\x5b\x77\x66\xd8\x76\xe6\xcf\x18\xdf\x99\x8e\x83\x44\xd2\x1c\xd4\x76\xeb\xfb\xa3\xb4\xd6\xba\xf1\xfd\x16\x60\x53\xed\x0c\xed\x3a\x6a\xb9\x7d\x5a\x3e\x40\xcb\x56\x66\xa5\x34\x6e\xc9\xce\x73\x86\x44\xad\x93\x2a\x56\x94\x46\x7b\x46\x2b\x21\xcb\x62\x71\xde\xa6\x3f\xff\xf0\xf3\x0f\x2d\x49\x36\x5e\x2a\x5d\xe6\x48\xd2\x07\xcd\xe8\x6f\x5f\x1e\xa7\xb3\xe1\xe0\xee\x7e\x38\x9b\x4c\xfb\xb3\xd7\x87\x97\xfb\x59\x7f\x38\x9d\x5d\xdf\xfc\x7d\xf6\xcb\x60\x34\x9b\xde\xf7\x6f\x7e\xfa\xf2\xfd\x41\x6a\x38\xb8\xfb\x40\xee\x04\x67\xf0\x8f\xc1\xa7\x70\xce\xca\x5d\x40\x6b\xc5\x16\x4a\xcf\x84\x50\xdc\xe6\xcc\x65\xda\xed\x5e\xdf\xfc\xad\x53\xb5\xb8\xf4\x4b\xaf\xd7\xeb\x75\xcf\x51\x81\xc4\x32\x2e\x88\xb7\xd5\x85\x60\xe3\xbb\x25\xe9\x35\x30\xc6\xdf\x1d\x75\x32\x49\xa2\x52\x2d\x21\x57\xb8\xb9\xa0\xbb\xc2\xe3\x6a\x2c\x1d\xb5\xaf\xea\x7e\x65\x18\x3b\xe2\x54\xb4\x92\xb5\x5b\x8e\x0b\x64\xd2\xca\xff\x6e\x9d\xe5\xc7\xde\x27\x3b\x4b\xbb\x79\x1c\xc5\xfb\xbe\xdb\x91\xaf\x8f\xaf\xf3\xd6\xe8\xd1\x4e\xfc\x3e\xc6\xb6\x77\xa4\xcd\x7b\x4e\x58\x6f\x73\xa7\x1a\x17\x3a\xe2\xf6\x0b\x72\x04\xe5\x31\xda\x85\xfe\xa9\x19\x0b\xdf\x1e\xb7\xd5\x4e\xab\x40\xce\x83\xcd\x0c\xb6\x0a\x26\x3e\x65\xc5\x58\x55\x6b\x7b\xa9\x43\x83\xfd\x93\x78\xc9\xb5\xdf\x6d\xa6\xa2\x6e\x80\xa2\xea\xa3\x42\x81\x15\x73\x14\xc1\x63\x26\xd8\x89\x92\xdc\x5a\x67\x28\x74\x86\x96\x35\x6f\x84\x0b\xec\xe3\x0b\xce\x51\xd4\x83\xa9\xb3\xc7\xfd\xea\x48\xe0\x1b\x14\xa5\xc1\xef\x05\x47\x23\xa7\xa0\xdf\x34\xe7\xa2\xef\x7d\x28\x70\xe2\x0c\xbe\x6a\xce\x5f\x71\xfe\xb0\xc3\x67\x27\x20\x70\x1e\xff\x52\xc0\x58\x8b\xbf\x4e\x45\x88\x1f\xb8\xe2\xa1\x3f\x12\xcf\x0f\x77\x83\x9d\x63\x24\xc0\x66\x62\xfa\x32\xed\xb4\xb8\x7f\x67\x3c\x94\xe4\xe2\xe7\x3a\x36\x96\xbb\x33\xa5\x2d\x5b\x8b\xfb\x4b\x44\x69\x57\x79\x4d\xf3\xc9\xfc\x11\x02\x42\xa6\xd1\x36\xfe\xc1\x70\xf5\xff\x00\x00\x00\xff\xff\x73\xdf\xd0\x5e\xc0\x10\x00\x00")
+var _manifests02DeploymentYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x58\x6d\x6f\xdb\xba\xf5\x7f\xdf\x4f\x71\xe0\xfc\x81\xfe\x07\x54\xb6\xd3\xf6\x76\xab\x80\xbe\xf0\x1c\xb7\x0d\x6e\x9e\x60\xa7\x37\x18\x86\xc1\x38\xa6\x8e\x2d\xce\x14\xc9\x4b\x1e\x39\xd1\x86\x7d\xf7\x81\x92\x6c\x4b\xb2\xe3\xe4\x6e\xb8\xc0\x22\xbf\x09\xcf\x23\x7f\x3c\x4f\x24\x5a\xf9\x0b\x39\x2f\x8d\x8e\x01\xad\xf5\x83\xcd\xf9\x9b\xb5\xd4\x49\x0c\x17\x64\x95\x29\x32\xd2\xfc\x26\x23\xc6\x04\x19\xe3\x37\x00\x1a\x33\x8a\x41\xea\x95\x23\xef\x23\x63\xc9\x21\x1b\x57\x13\xbc\x45\x41\x31\x18\x4b\xda\xa7\x72\xc9\xd1\x11\x3e\xd4\xda\x30\xb2\x34\xda\x07\x7d\x00\xc2\xe8\xa5\x5c\xf5\x77\x42\x7d\x69\x06\x52\xff\x9d\x04\x47\xd6\x99\xa7\xe2\xa8\x35\x00\xa9\x85\xca\x13\xea\x3b\x52\x84\x9e\xda\xf2\x9e\xd4\x32\xca\x50\xe3\x8a\x92\x28\x95\xab\x34\xc2\x0d\x4a\x85\x0b\xa9\x24\x17\x31\xf4\xd8\xe5\xd4\x7b\x85\x1e\xa9\x57\x8a\x22\x6d\x12\x8a\x12\xda\x90\x0a\x2e\xec\xc4\xbd\x25\x11\xf6\x70\x06\xd3\x5c\x03\x3f\x1a\x70\x64\x95\x14\xe8\xc1\x1b\xe0\x14\x19\x38\x25\xc0\x24\x93\x3e\x40\x0c\x8f\xb4\x48\x8d\x59\x83\x67\x2c\x3c\xd4\x2e\x29\x02\xd4\x09\x60\xa9\xc8\x33\xea\x64\x51\x80\x40\x0d\x8c\x6b\x02\xb3\x21\x57\x6a\x51\x84\x09\x39\x20\x45\x22\x80\x07\xe5\xae\x41\x2e\x1b\xc4\xb7\x1e\x82\xa7\xa5\xa2\x25\x4a\xe5\xfb\x6f\x60\xe7\x52\x0c\xef\x4b\xc2\x94\xac\x42\x41\x60\x34\x6d\x69\x80\x0c\x08\x2c\x33\x6a\xf9\x6d\x38\x25\x07\x6b\x22\xeb\xc1\x93\xdb\x48\xbd\x0a\xeb\xa5\x96\xed\x4e\x82\xe7\x6d\x5f\x2b\x3f\x7d\x2a\x2d\x24\xb9\x0b\x32\xa8\x21\xb7\x2b\x87\x09\x05\x7f\x3c\x3b\x64\x5a\x15\x01\x38\x00\x2e\x2c\xc5\x30\x35\x4a\x49\xbd\xfa\x61\x13\x64\x2a\xd7\x5d\x73\xa5\x62\x05\xc8\xf0\x69\x96\xbb\x15\xc5\x30\xdc\xaf\xfc\xd0\x3b\x1c\x63\x38\x0f\x06\x4a\x88\x8c\xab\xa4\x32\x64\x91\x5e\xe1\x82\x54\x1d\x6e\x27\x42\x98\x29\xb3\x6a\x67\xaf\x19\xf5\x00\x47\x02\x37\xfc\x18\xdd\x8a\xb8\xff\x68\xdc\x5a\x19\x4c\xda\xd1\x53\x05\x60\x48\xa1\x18\xde\xfe\xb3\x47\xcb\x25\x09\xee\xc5\xd0\xbb\x73\xb4\x24\xe7\x28\xb9\x28\x11\x9a\x89\x94\x92\x3c\xec\xb7\xf7\xaf\xb7\xb5\x6a\xd5\x72\xf9\x84\xd3\x00\xdb\x30\x0c\x9f\x27\x91\x3b\xc9\xc5\xd8\x68\xa6\x27\xde\xcb\xbb\x5c\x8f\xfc\x8d\xd1\x53\x63\x38\x86\x10\xfe\x3b\x92\x27\x21\x4c\x66\xef\x9c\x59\x4a\xb5\x03\xbb\x71\x3a\xb9\x0e\xb1\x71\x41\x4b\xcc\x15\xd7\xe4\x10\x68\xb3\x16\xd2\xe1\xb7\xce\x17\xe4\x34\x31\xf9\xb0\x7f\xe3\x63\x50\x52\xe7\x4f\x3b\x7a\x90\x8a\x9c\x51\xd4\x6f\x73\x66\xe8\xb9\x4c\xac\x5e\xcd\xca\x46\x91\x6b\x83\x1d\xc1\x9a\x42\xea\x9e\xd6\xb1\x55\x00\xb0\xc5\x28\x86\xde\xe4\x49\x7a\xf6\x7b\x52\x75\x12\x31\xf4\x6e\x4c\x8d\x3d\xf5\x8e\x58\xe9\x18\xc8\xb5\x23\x14\x29\x2e\x14\xfd\x56\x2b\x93\x27\x12\x39\x37\xc4\xf6\xfb\x9b\x91\x30\x3a\xf1\x31\x9c\xbf\x1f\xbe\xec\x83\x36\x1c\x39\xc2\xa4\xf8\x7d\x3d\x38\x83\x99\x0d\x66\x42\xbe\xef\x2a\x08\xa0\x70\xc6\x57\x35\xc6\xf7\x01\x6e\x35\x20\x34\x2a\x24\x08\x95\x87\x23\x78\x07\x0b\xc3\xe9\x4e\xd3\x4e\xdc\xe5\x1a\x8c\x2e\x55\x86\xe2\x13\x44\xfa\x35\x17\x1b\x6b\x94\x59\x15\x95\xd5\xb1\xd1\xa1\x4a\x48\xcd\x8d\xc3\x0f\xc9\xbf\xa6\xc7\x2a\xc7\xdb\x52\x3f\x87\x13\x6b\xe3\x94\x1a\xcf\x21\x65\x76\xbc\x8f\x29\xe9\x1f\xda\x23\x4b\xbf\x94\xe1\x08\x63\xb8\x30\x37\x86\xb7\xe7\xbf\x63\x2c\x13\xef\x30\xb2\x8f\x56\x92\x17\x53\x33\x24\xa4\xdb\x48\x41\x23\x21\x4c\xae\xf9\xe6\x14\xab\x75\xd2\x94\xb9\xab\xd0\xfb\x8a\xd3\x17\x9e\x29\x8b\x6a\x60\x23\xe1\x24\x4b\x81\xaa\x16\x10\x46\x33\x4a\x4d\xae\xe1\x50\x74\xda\x9d\x93\x35\x22\xfc\x50\x29\xf3\x78\xe7\xe4\x46\x2a\x5a\xd1\xc4\x0b\x54\x65\x90\xc4\xb0\x44\xe5\xf7\x30\x85\x4f\xa0\xad\x3a\xaa\xa4\x0e\x24\x00\x89\x33\x36\x86\xbf\xf6\x46\x57\x57\xbd\xbf\x35\x68\x4c\x2e\x93\xba\x54\x79\x4d\xde\xe3\x8a\xee\x8c\x92\xa2\x88\xe1\x2b\x2a\xb5\x40\xb1\xbe\x37\x57\x66\xe5\x6f\xf5\xc4\xb9\x96\xdb\x32\x0b\xcc\xb9\x52\x5b\x81\xcb\xe5\x8d\xe1\x3b\x47\x3e\xcc\x28\x1d\xbe\xc6\x10\x32\x30\x4e\xae\xa4\xde\x81\xd8\x45\x26\x0e\x25\xdf\x37\x35\x08\x93\x65\xa8\x93\xe6\x96\xa2\x53\x80\x46\xa1\xa1\xbb\xa6\x86\x08\xa2\x68\x37\x10\xb5\xd6\x7b\xff\xf7\xff\x0f\xa3\xfb\xf1\xf7\xf9\xcd\xe8\x7a\x32\xbb\x1b\x8d\x27\x7f\xd8\xe7\x64\x25\x58\x6e\xa0\x2b\x74\x79\x3d\xfa\x76\xc8\x2a\x50\xa3\x2b\x8e\x4b\x8c\x47\x37\xa3\xe9\x5f\xe6\xc7\x05\x0d\x93\x8a\x84\x51\x55\x98\x1f\x57\x70\x7b\x3f\xb9\x9a\x8f\x6f\xaf\xae\x26\xe3\xfb\xdb\xe9\x33\x8a\xea\xb1\x29\xda\x54\x53\x64\x57\xc7\x74\x72\x35\x19\xcd\x26\xf3\x5f\x26\xd3\xd9\xe5\xed\xcd\x81\x78\x35\x2d\x44\x65\xba\x75\x48\xf5\x88\x11\x29\xe9\x99\x74\x84\x49\xd2\xc6\xbc\x17\x7f\xfe\xf8\xf1\x43\x57\x61\x4a\xa8\x38\x3d\x2d\x34\xec\x0a\xb1\x0b\xf9\x95\x44\x02\xa3\x45\xae\x93\x46\x35\x08\xf4\x01\xb1\x18\xd8\xb5\x1c\x08\xac\x38\x07\xf4\xc4\x0e\x05\x53\x32\xb0\x94\x0d\x58\xf9\xbd\x64\xdf\x52\xd6\x90\x26\xbd\x69\x67\xc6\x36\x3f\x3b\xc0\xb4\x78\x00\x36\xa8\x72\x8a\xa1\x37\xec\x0f\xfb\xe7\x91\xd7\x68\x7d\x6a\xb8\x77\x54\x53\x27\x9c\x8e\x69\xfa\xea\x4c\xd6\x76\x23\x7c\x4b\x49\x2a\x99\xd2\xf2\x90\x52\xd3\xee\x90\xd3\x78\x37\x07\xf5\x8f\x05\xf4\xde\x8d\x32\x3c\x8e\x6f\xe3\x20\x15\x53\x2c\x27\xfb\xc8\x99\x3c\xb4\xfd\xcd\xc7\xfe\xf0\xa8\xce\x66\x08\xbf\x52\xf5\xeb\xb3\x7c\x6f\xe6\x58\xa0\xbf\xd2\x5c\x58\x60\x52\x94\x11\xbb\x62\x9f\x50\x87\xd6\xac\x71\xfb\x6e\x16\xbe\x68\x5f\xbe\xef\x8c\xe3\x18\x42\x34\x1f\xe9\x2b\x75\x1a\xbc\x20\xd9\xc6\xaf\xda\x56\x95\x0a\x0d\xc2\x19\x4c\xa9\xac\x54\x55\x0f\xae\x91\x09\x77\x08\xc9\xe0\xd9\x58\x0f\x8e\xbc\x35\x3a\x09\x63\xbb\x71\x20\xd9\x87\x9b\x08\xba\xa2\xa5\xa5\x3c\x36\xb0\x61\xb3\x2e\x94\x3e\x55\xdd\x34\xb6\x7f\x4a\x6e\x48\x93\xf7\x77\xce\x2c\x5a\xe3\x24\x40\xca\x6c\xbf\x51\xa7\xe5\x00\xd8\x32\xd0\x06\x95\xc3\xff\xe8\x12\x4b\x74\x0e\x36\x13\x6e\x80\x92\x25\xaa\x0b\x52\x58\xec\x66\x98\x0f\x6d\x24\x2c\x39\x69\x92\xfd\x84\xd3\xa6\x86\x4b\x52\xee\xe8\x3e\x75\xe4\x53\xa3\x92\x18\x9a\x27\x70\x06\x3f\x13\xd9\x36\x56\x26\x67\x30\xd5\x9d\xab\x3e\x98\x6d\x87\x87\x30\x25\xab\x1a\x32\x91\x92\x6f\x69\x4a\x71\x43\xe0\x0b\x2d\x28\x69\x42\x15\xa6\x1d\xf9\x1f\x61\x15\x24\x8b\x57\x43\xf5\xdf\xc0\xe0\xc8\x9b\xdc\x89\x6e\x8b\x77\xf4\x6b\x4e\xbe\x1d\xd3\xe1\x13\x36\x8f\xe1\x7c\xd8\x2c\x82\xe1\xcb\x28\x33\xae\x88\xe1\xa7\x4f\xd7\xb2\x41\xda\x18\x95\x67\x74\x1d\x66\xa3\x96\xa6\x6d\x6a\xee\x2b\x73\x83\x08\x90\x05\x81\xaa\x3c\xbd\x50\x9d\x5b\x62\x01\xb4\x5b\xad\x8a\xce\x05\x68\x6f\x6e\x61\x72\x9d\x44\x1e\x23\x36\x6b\xd2\xcf\x9a\xdc\xa0\x1b\xb8\x5c\x0f\x3c\x09\x47\xec\x07\xfb\xb2\x50\x07\x03\x56\xe3\xde\x6b\x8c\x6f\x4d\x87\xf9\x35\x72\x0b\x14\xd5\xab\xc7\xff\xca\xbc\x56\x4f\x53\xbf\xe6\x58\x84\xb9\xfa\xa0\x00\x76\xdc\x3e\x2c\x7c\xe8\x56\x9d\x93\x8d\x22\x65\x56\x6c\x3c\x27\xe4\xda\xdd\x39\x8a\xca\xd9\x94\x9a\xed\x9b\xbc\xff\x12\x7f\xfe\xf0\xb9\x19\x92\x81\xb3\xec\xba\xd2\xa6\xe4\x22\x9f\x4b\x26\xff\xe5\xfe\x6a\x36\x9f\x8c\x2f\xbe\x4f\xe6\xd3\xd9\x68\xfe\x70\x79\xff\x7d\x3e\x9a\xcc\xe6\xe7\xef\xff\x34\xff\x36\xbe\x9e\xcf\xbe\x8f\xde\xff\xf4\xe9\xdd\x9e\x6b\x32\xbe\x78\x81\xef\x40\xcf\xf8\xcf\xe3\x57\xe9\x39\xca\x77\x42\x5b\x67\x6f\xb9\xf5\xec\x08\xb3\x2f\xa1\x5e\xc6\x83\xc1\xf9\xfb\x3f\xf6\xcb\x51\x20\xfe\x34\x1c\x0e\x87\x83\x63\x50\x90\xe3\x28\xdc\xdf\xbf\x94\x09\xc1\xca\x0f\xac\x93\x1b\x64\x0a\xf3\x49\x5f\x1c\x8c\xa7\x01\xbf\x9a\x23\x5a\x53\x71\x42\x76\x4d\xc5\x6f\x6b\x64\x1f\x3e\x1f\x6b\x64\xa1\x45\x4a\xe1\x7f\xb7\xca\xf2\x71\xf8\xca\xca\xd2\x2d\x1e\x8d\xfd\x3e\xef\x76\x00\xf9\xe5\x74\xae\xca\xd9\xce\x5e\x74\x42\x47\x55\x3b\x9a\x9e\x55\x2b\x37\xcf\x48\x9c\xa8\x88\xd5\x0b\xea\x35\xda\xa6\xb6\x13\xf5\x53\x32\x65\x2d\x4c\x76\x4f\x0e\xfb\x31\xb6\x1d\x30\xfb\xc6\xf3\xdc\xb0\x7b\x06\xf7\xa9\xac\xdf\x09\x05\x41\x5d\x00\xa1\xac\xa3\x61\x86\x80\x05\x41\xee\x29\x01\x36\x60\x9d\xd9\xc8\x84\x40\x26\xa4\x59\x72\x01\x26\x67\x1f\x16\x42\x5b\xad\x07\xb8\x6d\x97\x3c\x83\xaf\xc6\x01\x3d\x61\x66\x15\xbd\x03\x0e\x46\x0e\x95\x3e\x4a\x4e\x61\xe4\x7d\x9e\xd1\xd4\x28\x7a\x90\x9c\x3e\xd0\xe2\x72\xab\x9f\x0d\x60\xce\x69\xf8\x4f\x20\x53\xcd\xfe\x30\x83\x3c\x3c\x5f\xc0\xe5\xe8\x1a\x6e\x2f\x2f\xc6\x5b\xc7\x5c\xf9\x20\x3b\xbb\x9f\xf5\x3b\xd8\x3f\xd3\x1e\xac\x33\xe1\xb9\x9a\x5a\x37\xc6\x23\xa1\x1d\x75\x5e\x03\xee\x83\x96\xf8\x38\xcc\x07\xfd\x07\x00\xf3\x44\x92\x6e\x3d\xb0\xbf\xf9\xf7\x00\xd4\xfc\xf3\x63\xc0\x17\x00\x00")
 
 func manifests02DeploymentYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -737,12 +758,12 @@ func manifests02DeploymentYaml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "manifests/02-deployment.yaml", size: 4288, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xe2, 0xdc, 0xf4, 0x85, 0x69, 0xac, 0xa8, 0x77, 0x8e, 0x57, 0xdc, 0xeb, 0xaf, 0x9a, 0x38, 0xee, 0xf2, 0x2, 0x89, 0x8, 0x16, 0x97, 0x9, 0xb1, 0xda, 0x1e, 0xe8, 0x3a, 0x13, 0x8, 0xfe, 0x41}}
+	info := bindataFileInfo{name: "manifests/02-deployment.yaml", size: 6080, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xbc, 0x72, 0x2c, 0x72, 0xa6, 0x73, 0x5f, 0x36, 0x77, 0xaa, 0xd4, 0x36, 0x83, 0x7a, 0x83, 0x54, 0x42, 0xb9, 0xaa, 0x2d, 0x75, 0xe6, 0xd8, 0x9e, 0x37, 0xb5, 0xf9, 0xe1, 0xad, 0xab, 0xb7, 0x33}}
 	return a, nil
 }
 
-var _manifests03ClusterOperatorYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x52\xbd\x6e\xdb\x40\x0c\xde\xf5\x14\x84\xbd\xf6\xd4\x64\xd5\xea\xa9\x93\x97\x22\x3b\x7d\x47\x4b\x6c\x4f\xa4\x70\xe4\x39\xf0\xdb\x17\x92\x25\x37\xf1\xd2\x1a\x88\x26\x01\xbc\xef\x97\xdc\xc3\xcf\x81\x0d\x0a\x99\xd6\x12\x09\xd8\x40\xd4\x21\x16\x42\xa7\x04\xa7\x2b\x1c\xde\x8e\xdf\x80\x7d\x9e\xa8\xe4\x2b\x54\xa3\x04\x68\x80\x10\x75\x1c\xab\x70\x44\x67\x95\x66\x0f\x23\xc5\x01\x85\x6d\x04\x57\x70\xca\x19\x7c\xa0\x19\x0f\xef\x03\xc7\x01\x0e\xb9\x9a\x53\x39\x4e\x54\xd0\xb5\xfc\x15\x75\x85\x77\x64\x87\xb3\x96\xb6\xd9\xc3\x0f\xe9\x0b\x99\x1d\x54\xbc\x68\xce\x54\x56\x3b\x06\x28\x09\xea\x94\x96\x7f\xff\xe8\xbb\x6d\x70\xe2\x37\x2a\xc6\x2a\x1d\x44\x95\x33\xf7\xad\x4e\x24\x36\xf0\xd9\x5b\xd6\xef\x97\xd7\xe6\x37\x4b\xea\x1e\x5d\x34\x23\x39\x26\x74\xec\x1a\x00\xc1\x91\x3a\xe0\x9b\x7e\x03\x80\x22\xea\x4b\x3c\x9b\xc7\x00\x2c\x31\xd7\x44\x6d\xa1\x4c\x68\xf4\x59\x82\x4f\x63\x88\x59\x6b\x0a\x23\x0a\xf6\x94\x3a\xd8\x79\xa9\xb4\xfb\x37\xd4\x28\x9f\x37\x54\x18\xb8\x1f\x02\x5e\x90\x33\x9e\x38\xb3\x5f\x9f\xe0\x61\xe9\x33\x05\xd1\x44\x21\xd1\x85\xb2\x4e\x54\xee\x70\x73\xf4\xba\x24\xb9\xdc\xaa\x5a\x53\x85\x35\xb8\x6e\x9d\xc0\xf2\x5d\xb6\x3e\x77\x2f\xed\x4b\xfb\x1a\x4c\x70\xb2\x41\x7d\xf6\x51\x28\xcf\x07\x72\x3c\xfd\xa2\xe8\x77\x9a\xbe\x68\x9d\x3a\xd8\xed\x56\x86\x3b\xed\xcd\x61\x58\x9b\x0d\x0f\x42\xdb\x16\xbb\x05\x60\x13\x46\xb2\xe7\x18\xbf\x8c\x28\x44\x14\x2c\xd7\xff\xe6\xdb\x92\x7c\x5a\xc3\x07\x89\x05\xf3\x54\x05\xeb\x83\x78\xbf\xfe\x07\xc5\x75\xde\x7e\xbd\x72\x12\x2b\x14\xb5\x24\x6b\xfe\x04\x00\x00\xff\xff\xb4\x4c\x89\x97\x17\x04\x00\x00")
+var _manifests03ClusterOperatorYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x52\xbd\x6e\xdb\x40\x0c\xde\xf5\x14\x84\xbd\xf6\xd4\x64\xd5\xea\xa9\x93\x97\x22\x3b\x7d\x47\x4b\x6c\x4f\xa4\x70\xe4\x39\xf0\xdb\x17\x92\x65\x35\xf1\xd2\x1a\x08\xb4\x08\x20\xbf\xdf\xe3\x1e\x7e\x0e\x6c\x50\xc8\xb4\x96\x48\xc0\x06\xa2\x0e\xb1\x10\x3a\x25\x38\x5d\xe1\xf0\x76\xfc\x06\xec\xf3\x44\x25\x5f\xa1\x1a\x25\x40\x03\x84\xa8\xe3\x58\x85\x23\x3a\xab\x34\x7b\x18\x29\x0e\x28\x6c\x23\xb8\x82\x53\xce\xe0\x03\xcd\x78\x78\x1f\x38\x0e\x70\xc8\xd5\x9c\xca\x71\xa2\x82\xae\xe5\xaf\xa8\x2b\xbc\x23\x3b\x9c\xb5\xb4\xcd\x1e\x7e\x48\x5f\xc8\xec\xa0\xe2\x45\x73\xa6\xb2\xda\x31\x40\x49\x50\xa7\xb4\xfc\xfb\x47\xdf\x6d\x83\x13\xbf\x51\x31\x56\xe9\x20\xaa\x9c\xb9\x6f\x75\x22\xb1\x81\xcf\xde\xb2\x7e\xbf\xbc\x36\xbf\x59\x52\xf7\xe8\xa2\x19\xc9\x31\xa1\x63\xd7\x00\x08\x8e\xd4\x01\xdf\xf4\x1b\x00\x14\x51\x5f\xe2\xd9\x3c\x06\x60\x89\xb9\x26\x6a\x0b\x65\x42\xa3\xcf\x12\x7c\x1a\x43\xcc\x5a\x53\x18\x51\xb0\xa7\xd4\xc1\xce\x4b\xa5\xdd\xbf\xa1\x46\xf9\x7c\x47\x85\x81\xfb\x21\xe0\x05\x39\xe3\x89\x33\xfb\xf5\x09\x1e\x96\x3e\x53\x10\x4d\x14\x12\x5d\x28\xeb\x44\x65\x83\x9b\xa3\xd7\x25\xc9\xe5\x56\xd5\x9a\x2a\xac\xc1\xf5\xde\xc9\xec\x77\x5b\xea\x60\xf7\xd2\xbe\xb4\xaf\xc1\x04\x27\x1b\xd4\xe7\x3c\x85\xf2\x7c\x20\xc7\xd3\x2f\x8a\xbe\xd1\xf4\x45\xeb\xd4\xc1\x6e\xde\x98\xbf\x8d\xf6\xe6\x30\xac\xcd\x86\x07\xa1\xfb\x2b\x76\x8b\x0f\x9b\x30\x92\x3d\xc7\xf8\x65\x44\x21\xa2\x60\xb9\xfe\x37\xdf\x3d\xc9\xa7\x67\xf8\x20\xb1\x84\x79\xaa\x82\x75\x21\x6e\xd7\xff\xa0\xb8\xce\xdb\xaf\x57\x4e\x62\x85\xa2\x96\x64\xcd\x9f\x01\x00\xb4\x4c\x89\x97\x17\x04\x00\x00")
 
 func manifests03ClusterOperatorYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -762,7 +783,7 @@ func manifests03ClusterOperatorYaml() (*asset, error) {
 	return a, nil
 }
 
-var _manifestsImageReferences = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\xd0\xb1\x6e\xc3\x20\x10\x80\xe1\xdd\x4f\x71\xf2\x8e\xd3\x4a\x9d\x98\xbb\x74\xae\xd4\xfd\xe2\x5e\x9c\x93\x03\x47\xef\x8e\xa8\x79\xfb\xca\x4e\x15\x91\x56\x5e\x32\x81\x00\xfd\x1f\x30\x73\xfe\x8c\xf0\x96\x70\xa2\x77\x57\xc2\xd4\x61\xe1\x0f\x52\x63\xc9\x11\x78\x59\x1f\xa4\x50\xb6\x23\x1f\x7c\x60\xd9\x9d\x9f\x3b\x2b\x34\xc6\x0e\xc0\x71\xb2\x65\x0c\x90\x31\x51\x84\xf1\x54\xcd\x49\x03\xe7\x49\xc9\x2c\x48\x21\x45\x17\xed\x00\x00\x0e\x2a\x29\xc2\x3a\x05\xb8\xaa\xfd\xab\x8c\x33\xe9\x8a\xf7\xbf\x3b\xd7\x52\x7f\x23\x77\xa2\x3c\x71\x0e\x5b\xed\x78\x42\x27\xf3\xbe\xb9\xc6\x11\x8b\xca\xf7\x25\xa8\x54\xa7\x06\x7f\xd8\xbe\x0f\xc6\xf3\xcb\xf0\xd4\x7a\x73\xdd\x53\xd0\x3d\x8e\x61\x3d\xb6\x01\x36\xde\x3d\xf7\x55\xf1\xb2\x7c\xec\x3f\xf6\x4f\xf7\xf6\xd2\x9f\x00\x00\x00\xff\xff\x20\xb5\x82\x62\xb3\x01\x00\x00")
+var _manifestsImageReferences = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\xd0\xbd\x4e\xc4\x30\x0c\x07\xf0\x3d\x4f\x61\x65\x4f\x0f\x24\xa6\xcc\x2c\xcc\x48\xec\xbe\xe0\xeb\x45\x4d\xe2\xe0\xb8\x27\xfa\xf6\xa8\x3d\x84\x5a\x8e\x4a\xe8\xa6\x44\xf9\xf8\xff\x6c\x0f\xb1\xbc\x7b\x78\xc9\xd8\xd3\xab\x0a\x61\x36\x58\xe3\x1b\x49\x8b\x5c\x3c\xc4\xf9\xbc\xe3\x4a\xa5\x9d\xe3\x49\xbb\xc8\x87\xcb\xa3\x69\x95\x82\x37\x00\x8a\x7d\x9b\x57\x07\x05\x33\x79\x08\x69\x6c\x4a\xe2\x62\xe9\x85\x5a\x73\x5c\x49\x50\x59\x0c\x00\xc0\x49\x38\x7b\x58\xb6\x00\x57\xd5\x3e\x73\x18\x48\x16\xdc\x7e\xdf\x5c\x93\xec\x0f\x79\x60\x89\x7d\x2c\x6e\x2f\xdb\x27\x54\x6a\x6a\x57\x65\x9c\xb1\x0a\x7f\x4e\x4e\x78\x54\x5a\xe1\x77\xdb\xdb\x40\x7f\x79\xea\x1e\xd6\xde\x30\x1e\xc9\xc9\x11\x83\x5b\x9e\xed\x80\x2b\x6f\xcb\x7d\x8c\x38\xcd\x83\xbd\x61\x7f\xe5\xfe\xd1\xe9\xfc\x45\x29\x51\x26\x95\xc9\x05\x4e\x89\xc2\x66\xde\xff\xab\xe0\x46\xde\xc9\xf5\x09\x95\x9a\x5a\xf3\x35\x00\xe6\x68\x92\x9f\x35\x02\x00\x00")
 
 func manifestsImageReferencesBytes() ([]byte, error) {
 	return bindataRead(
@@ -777,8 +798,8 @@ func manifestsImageReferences() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "manifests/image-references", size: 435, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xd6, 0x59, 0xee, 0x2, 0x5b, 0xf2, 0xa4, 0xa5, 0xb6, 0x18, 0xda, 0xf9, 0xd2, 0x80, 0xca, 0x85, 0x23, 0x1f, 0x58, 0x59, 0x6d, 0xf3, 0x1b, 0x59, 0x5a, 0xee, 0x39, 0xfb, 0xea, 0x10, 0xc7, 0xbb}}
+	info := bindataFileInfo{name: "manifests/image-references", size: 565, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xa2, 0xad, 0x7b, 0xdb, 0x27, 0x32, 0x94, 0x74, 0x19, 0x9b, 0x9b, 0x22, 0x8a, 0x5, 0xad, 0x2b, 0x52, 0xf1, 0xed, 0x4f, 0x76, 0x95, 0x34, 0x27, 0xb7, 0xd5, 0xac, 0x9a, 0x17, 0xc2, 0x58, 0x7e}}
 	return a, nil
 }
 
@@ -933,6 +954,8 @@ var _bindata = map[string]func() (*asset, error){
 
 	"manifests/01-trusted-ca-configmap.yaml": manifests01TrustedCaConfigmapYaml,
 
+	"manifests/01-webhook-service.yaml": manifests01WebhookServiceYaml,
+
 	"manifests/02-deployment-ibm-cloud-managed.yaml": manifests02DeploymentIbmCloudManagedYaml,
 
 	"manifests/02-deployment.yaml": manifests02DeploymentYaml,
@@ -1024,6 +1047,7 @@ var _bintree = &bintree{nil, map[string]*bintree{
 		"01-service-account.yaml":                                {manifests01ServiceAccountYaml, map[string]*bintree{}},
 		"01-service.yaml":                                        {manifests01ServiceYaml, map[string]*bintree{}},
 		"01-trusted-ca-configmap.yaml":                           {manifests01TrustedCaConfigmapYaml, map[string]*bintree{}},
+		"01-webhook-service.yaml":                                {manifests01WebhookServiceYaml, map[string]*bintree{}},
 		"02-deployment-ibm-cloud-managed.yaml":                   {manifests02DeploymentIbmCloudManagedYaml, map[string]*bintree{}},
 		"02-deployment.yaml":                                     {manifests02DeploymentYaml, map[string]*bintree{}},
 		"03-cluster-operator.yaml":                               {manifests03ClusterOperatorYaml, map[string]*bintree{}},
@@ -6,8 +6,6 @@ import (
 	"fmt"
 	"io"
 
-	operatorcontroller "github.com/openshift/cluster-ingress-operator/pkg/operator/controller"
-
 	operatorv1 "github.com/openshift/api/operator/v1"
 
 	appsv1 "k8s.io/api/apps/v1"
@@ -76,19 +74,22 @@ func MustAssetReader(asset string) io.Reader {
 	return bytes.NewReader(MustAsset(asset))
 }
 
-func RouterNamespace() *corev1.Namespace {
+func RouterNamespace(operandNamespace string) *corev1.Namespace {
 	ns, err := NewNamespace(MustAssetReader(RouterNamespaceAsset))
 	if err != nil {
 		panic(err)
 	}
+	ns.Name = operandNamespace
+	ns.Labels["name"] = ns.Name
 	return ns
 }
 
-func RouterServiceAccount() *corev1.ServiceAccount {
+func RouterServiceAccount(operandNamespace string) *corev1.ServiceAccount {
 	sa, err := NewServiceAccount(MustAssetReader(RouterServiceAccountAsset))
 	if err != nil {
 		panic(err)
 	}
+	sa.Namespace = operandNamespace
 	return sa
 }
 
@@ -100,19 +101,26 @@ func RouterClusterRole() *rbacv1.ClusterRole {
 	return cr
 }
 
-func RouterClusterRoleBinding() *rbacv1.ClusterRoleBinding {
+func RouterClusterRoleBinding(operandNamespace string) *rbacv1.ClusterRoleBinding {
 	crb, err := NewClusterRoleBinding(MustAssetReader(RouterClusterRoleBindingAsset))
 	if err != nil {
 		panic(err)
 	}
+	// The binding is named after the operand namespace so that operator
+	// instances with different operand namespaces each bind the cluster
+	// role to their own router service account.
+	crb.Name = operandNamespace + "-router"
+	for i := range crb.Subjects {
+		crb.Subjects[i].Namespace = operandNamespace
+	}
 	return crb
 }
 
-func RouterStatsSecret(cr *operatorv1.IngressController) *corev1.Secret {
+func RouterStatsSecret(cr *operatorv1.IngressController, operandNamespace string) *corev1.Secret {
 	s := &corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      fmt.Sprintf("router-stats-%s", cr.Name),
-			Namespace: operatorcontroller.DefaultOperandNamespace,
+			Namespace: operandNamespace,
 		},
 		Type: corev1.SecretTypeOpaque,
 		Data: map[string][]byte{},
@@ -165,27 +173,30 @@ func MetricsClusterRoleBinding() *rbacv1.ClusterRoleBinding {
 	return crb
 }
 
-func MetricsRole() *rbacv1.Role {
+func MetricsRole(operandNamespace string) *rbacv1.Role {
 	r, err := NewRole(MustAssetReader(MetricsRoleAsset))
 	if err != nil {
 		panic(err)
 	}
+	r.Namespace = operandNamespace
 	return r
 }
 
-func MetricsRoleBinding() *rbacv1.RoleBinding {
+func MetricsRoleBinding(operandNamespace string) *rbacv1.RoleBinding {
 	rb, err := NewRoleBinding(MustAssetReader(MetricsRoleBindingAsset))
 	if err != nil {
 		panic(err)
 	}
+	rb.Namespace = operandNamespace
 	return rb
 }
 
-func CanaryNamespace() *corev1.Namespace {
+func CanaryNamespace(canaryNamespace string) *corev1.Namespace {
 	ns, err := NewNamespace(MustAssetReader(CanaryNamespaceAsset))
 	if err != nil {
 		panic(err)
 	}
+	ns.Name = canaryNamespace
 	return ns
 }
 
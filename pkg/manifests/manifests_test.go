@@ -29,18 +29,55 @@ func TestManifests(t *testing.T) {
 		},
 	}
 
-	RouterServiceAccount()
+	RouterServiceAccount("openshift-ingress")
 	RouterClusterRole()
-	RouterClusterRoleBinding()
-	RouterStatsSecret(ci)
+	RouterClusterRoleBinding("openshift-ingress")
+	RouterStatsSecret(ci, "openshift-ingress")
 
 	MetricsClusterRole()
 	MetricsClusterRoleBinding()
-	MetricsRole()
-	MetricsRoleBinding()
+	MetricsRole("openshift-ingress")
+	MetricsRoleBinding("openshift-ingress")
 
-	RouterNamespace()
+	RouterNamespace("openshift-ingress")
 	RouterDeployment()
 	InternalIngressControllerService()
 	LoadBalancerService()
 }
+
+// TestManifestsUseConfiguredNamespaces verifies that manifests for operands
+// and canary resources use the given namespaces.
+func TestManifestsUseConfiguredNamespaces(t *testing.T) {
+	ci := &operatorv1.IngressController{ObjectMeta: metav1.ObjectMeta{Name: "default"}}
+
+	if ns := RouterNamespace("tenant-ingress"); ns.Name != "tenant-ingress" || ns.Labels["name"] != "tenant-ingress" {
+		t.Errorf("expected router namespace %q with matching name label, got %q with label %q", "tenant-ingress", ns.Name, ns.Labels["name"])
+	}
+	if sa := RouterServiceAccount("tenant-ingress"); sa.Namespace != "tenant-ingress" {
+		t.Errorf("expected router service account in namespace %q, got %q", "tenant-ingress", sa.Namespace)
+	}
+	crb := RouterClusterRoleBinding("tenant-ingress")
+	if crb.Name != "tenant-ingress-router" {
+		t.Errorf("expected router cluster role binding name %q, got %q", "tenant-ingress-router", crb.Name)
+	}
+	for _, subject := range crb.Subjects {
+		if subject.Namespace != "tenant-ingress" {
+			t.Errorf("expected router cluster role binding subject in namespace %q, got %q", "tenant-ingress", subject.Namespace)
+		}
+	}
+	if s := RouterStatsSecret(ci, "tenant-ingress"); s.Namespace != "tenant-ingress" {
+		t.Errorf("expected router stats secret in namespace %q, got %q", "tenant-ingress", s.Namespace)
+	}
+	if r := MetricsRole("tenant-ingress"); r.Namespace != "tenant-ingress" {
+		t.Errorf("expected metrics role in namespace %q, got %q", "tenant-ingress", r.Namespace)
+	}
+	if rb := MetricsRoleBinding("tenant-ingress"); rb.Namespace != "tenant-ingress" {
+		t.Errorf("expected metrics role binding in namespace %q, got %q", "tenant-ingress", rb.Namespace)
+	}
+	if ns := CanaryNamespace("tenant-ingress-canary"); ns.Name != "tenant-ingress-canary" {
+		t.Errorf("expected canary namespace %q, got %q", "tenant-ingress-canary", ns.Name)
+	}
+	if crb := RouterClusterRoleBinding("openshift-ingress"); crb.Name != "openshift-ingress-router" {
+		t.Errorf("expected default router cluster role binding name %q, got %q", "openshift-ingress-router", crb.Name)
+	}
+}
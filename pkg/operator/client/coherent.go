@@ -0,0 +1,407 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+var (
+	// coherenceTimeout is how long a read waits for the cache to observe
+	// the client's own writes before the read falls back to the API.  It
+	// is a variable to enable unit testing.
+	coherenceTimeout = 5 * time.Second
+
+	// coherencePollInterval is how often a read that is waiting for the
+	// cache checks whether the cache has observed the client's writes.
+	coherencePollInterval = 20 * time.Millisecond
+
+	// pendingWriteExpiry is how long the client tracks a write that the
+	// cache has not observed, for example because another client deleted
+	// the object before the cache observed the write.
+	pendingWriteExpiry = 2 * time.Minute
+)
+
+const (
+	sourceAPI   = "api"
+	sourceCache = "cache"
+)
+
+// writeKey identifies an object that the client has written.
+type writeKey struct {
+	groupKind schema.GroupKind
+	types.NamespacedName
+}
+
+// pendingWrite describes a write that the cache might not have observed yet.
+type pendingWrite struct {
+	// resourceVersion is the object's resource version after the write.
+	resourceVersion string
+	// deleted indicates that the write deleted the object.
+	deleted bool
+	// uid is the deleted object's UID.
+	uid types.UID
+	// recorded is when the write was recorded.
+	recorded time.Time
+}
+
+// coherentClient is a client that reads objects from an informer cache and
+// writes objects to the API, and that guarantees that reads observe the
+// client's own prior writes.  The client records the resource version of each
+// object that it writes, and a read of an object that the client has written
+// waits for the cache to observe the write, or, if the cache does not observe
+// the write in time, reads the object from the API instead.
+//
+// The client only reads from the cache the kinds of objects that it is
+// configured to cache and only in the namespaces that the cache covers.  It
+// reads all other objects, lists of namespaced objects across all namespaces,
+// and lists that use field selectors from the API.
+type coherentClient struct {
+	client.Client
+
+	cache client.Reader
+
+	// cachedKinds maps each kind that the client reads from the cache to
+	// a Boolean value indicating whether the kind is namespaced.
+	cachedKinds map[schema.GroupKind]bool
+	// cachedNamespaces is the set of namespaces that the cache covers.
+	cachedNamespaces sets.String
+
+	lock   sync.Mutex
+	writes map[writeKey]pendingWrite
+}
+
+// NewCoherentClient returns a client that reads the given kinds of objects in
+// the given namespaces, as well as the given kinds of cluster-scoped objects,
+// from the given cache, and that otherwise uses the given API client.  Reads
+// observe the client's own prior writes.
+func NewCoherentClient(api client.Client, cache client.Reader, cachedNamespaces []string, cachedObjects ...client.Object) (client.Client, error) {
+	cachedKinds := map[schema.GroupKind]bool{}
+	for _, obj := range cachedObjects {
+		gvk, err := apiutil.GVKForObject(obj, api.Scheme())
+		if err != nil {
+			return nil, err
+		}
+		mapping, err := api.RESTMapper().RESTMapping(gvk.GroupKind(), gvk.Version)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get REST mapping for %s: %w", gvk, err)
+		}
+		cachedKinds[gvk.GroupKind()] = mapping.Scope.Name() == meta.RESTScopeNameNamespace
+	}
+	return &coherentClient{
+		Client:           api,
+		cache:            cache,
+		cachedKinds:      cachedKinds,
+		cachedNamespaces: sets.NewString(cachedNamespaces...),
+		writes:           map[writeKey]pendingWrite{},
+	}, nil
+}
+
+// Get implements client.Reader.
+func (c *coherentClient) Get(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+	gk, ok := c.cachedGroupKind(obj)
+	if ok && c.namespaceCached(gk, key.Namespace) {
+		match := func(name types.NamespacedName) bool { return name == key }
+		if c.waitForWrites(ctx, gk, match, func() client.Object { return obj.DeepCopyObject().(client.Object) }) {
+			err := c.cache.Get(ctx, key, obj, opts...)
+			if !isCacheNotStarted(err) {
+				countRequest("get", gk, sourceCache)
+				return err
+			}
+		}
+	}
+	countRequest("get", gk, sourceAPI)
+	return c.Client.Get(ctx, key, obj, opts...)
+}
+
+// List implements client.Reader.
+func (c *coherentClient) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	gk, newItem, ok := c.cachedGroupKindForList(list)
+	listOpts := &client.ListOptions{}
+	listOpts.ApplyOptions(opts)
+	if ok && listOpts.FieldSelector == nil && c.namespaceCached(gk, listOpts.Namespace) {
+		match := func(name types.NamespacedName) bool {
+			return len(listOpts.Namespace) == 0 || name.Namespace == listOpts.Namespace
+		}
+		if c.waitForWrites(ctx, gk, match, newItem) {
+			err := c.cache.List(ctx, list, opts...)
+			if !isCacheNotStarted(err) {
+				countRequest("list", gk, sourceCache)
+				return err
+			}
+		}
+	}
+	countRequest("list", gk, sourceAPI)
+	return c.Client.List(ctx, list, opts...)
+}
+
+// Create implements client.Writer.
+func (c *coherentClient) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+	gk, _ := c.cachedGroupKind(obj)
+	countRequest("create", gk, sourceAPI)
+	if err := c.Client.Create(ctx, obj, opts...); err != nil {
+		return err
+	}
+	c.recordWrite(obj, false)
+	return nil
+}
+
+// Update implements client.Writer.
+func (c *coherentClient) Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+	gk, _ := c.cachedGroupKind(obj)
+	countRequest("update", gk, sourceAPI)
+	if err := c.Client.Update(ctx, obj, opts...); err != nil {
+		return err
+	}
+	c.recordWrite(obj, false)
+	return nil
+}
+
+// Patch implements client.Writer.
+func (c *coherentClient) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+	gk, _ := c.cachedGroupKind(obj)
+	countRequest("patch", gk, sourceAPI)
+	if err := c.Client.Patch(ctx, obj, patch, opts...); err != nil {
+		return err
+	}
+	c.recordWrite(obj, false)
+	return nil
+}
+
+// Delete implements client.Writer.
+func (c *coherentClient) Delete(ctx context.Context, obj client.Object, opts ...client.DeleteOption) error {
+	gk, ok := c.cachedGroupKind(obj)
+	countRequest("delete", gk, sourceAPI)
+	// Callers often delete an object that they identify only by name.
+	// Look up the UID of the object that the cache has so that reads can
+	// tell when the cache observes the deletion.
+	if ok && len(obj.GetUID()) == 0 && c.namespaceCached(gk, obj.GetNamespace()) {
+		cached := obj.DeepCopyObject().(client.Object)
+		if err := c.cache.Get(ctx, client.ObjectKeyFromObject(obj), cached); err == nil {
+			obj.SetUID(cached.GetUID())
+			defer obj.SetUID("")
+		}
+	}
+	if err := c.Client.Delete(ctx, obj, opts...); err != nil {
+		return err
+	}
+	c.recordWrite(obj, true)
+	return nil
+}
+
+// DeleteAllOf implements client.Writer.  Reads do not wait for the cache to
+// observe deletions that DeleteAllOf makes.
+func (c *coherentClient) DeleteAllOf(ctx context.Context, obj client.Object, opts ...client.DeleteAllOfOption) error {
+	gk, _ := c.cachedGroupKind(obj)
+	countRequest("deleteallof", gk, sourceAPI)
+	return c.Client.DeleteAllOf(ctx, obj, opts...)
+}
+
+// Status implements client.StatusClient.
+func (c *coherentClient) Status() client.StatusWriter {
+	return &coherentStatusWriter{client: c, writer: c.Client.Status()}
+}
+
+// coherentStatusWriter is a status writer that records its writes in a
+// coherentClient.
+type coherentStatusWriter struct {
+	client *coherentClient
+	writer client.StatusWriter
+}
+
+// Update implements client.StatusWriter.
+func (w *coherentStatusWriter) Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+	gk, _ := w.client.cachedGroupKind(obj)
+	countRequest("update", gk, sourceAPI)
+	if err := w.writer.Update(ctx, obj, opts...); err != nil {
+		return err
+	}
+	w.client.recordWrite(obj, false)
+	return nil
+}
+
+// Patch implements client.StatusWriter.
+func (w *coherentStatusWriter) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+	gk, _ := w.client.cachedGroupKind(obj)
+	countRequest("patch", gk, sourceAPI)
+	if err := w.writer.Patch(ctx, obj, patch, opts...); err != nil {
+		return err
+	}
+	w.client.recordWrite(obj, false)
+	return nil
+}
+
+// cachedGroupKind returns the group and kind of the given object and a Boolean
+// value indicating whether the client reads objects of that kind from the
+// cache.
+func (c *coherentClient) cachedGroupKind(obj client.Object) (schema.GroupKind, bool) {
+	gvk, err := apiutil.GVKForObject(obj, c.Scheme())
+	if err != nil {
+		return schema.GroupKind{}, false
+	}
+	_, ok := c.cachedKinds[gvk.GroupKind()]
+	return gvk.GroupKind(), ok
+}
+
+// cachedGroupKindForList returns the group and kind of the items of the given
+// list, a function that returns a new item, and a Boolean value indicating
+// whether the client reads objects of that kind from the cache.
+func (c *coherentClient) cachedGroupKindForList(list client.ObjectList) (schema.GroupKind, func() client.Object, bool) {
+	gvk, err := apiutil.GVKForObject(list, c.Scheme())
+	if err != nil || !strings.HasSuffix(gvk.Kind, "List") {
+		return schema.GroupKind{}, nil, false
+	}
+	gvk.Kind = strings.TrimSuffix(gvk.Kind, "List")
+	if _, ok := c.cachedKinds[gvk.GroupKind()]; !ok {
+		return gvk.GroupKind(), nil, false
+	}
+	if _, err := c.Scheme().New(gvk); err != nil {
+		return gvk.GroupKind(), nil, false
+	}
+	newItem := func() client.Object {
+		obj, _ := c.Scheme().New(gvk)
+		return obj.(client.Object)
+	}
+	return gvk.GroupKind(), newItem, true
+}
+
+// namespaceCached returns a Boolean value indicating whether the cache covers
+// objects of the given kind in the given namespace.  For namespaced kinds, the
+// empty namespace means all namespaces, which the cache does not cover.
+func (c *coherentClient) namespaceCached(gk schema.GroupKind, namespace string) bool {
+	if !c.cachedKinds[gk] {
+		return true
+	}
+	return c.cachedNamespaces.Has(namespace)
+}
+
+// recordWrite records a write of the given object so that subsequent reads
+// wait for the cache to observe it.
+func (c *coherentClient) recordWrite(obj client.Object, deleted bool) {
+	gk, ok := c.cachedGroupKind(obj)
+	if !ok || !c.namespaceCached(gk, obj.GetNamespace()) {
+		return
+	}
+	if deleted && len(obj.GetUID()) == 0 {
+		// The cache does not have the object, so there is no
+		// deletion for it to observe.
+		return
+	}
+	key := writeKey{groupKind: gk, NamespacedName: client.ObjectKeyFromObject(obj)}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.writes[key] = pendingWrite{
+		resourceVersion: obj.GetResourceVersion(),
+		deleted:         deleted,
+		uid:             obj.GetUID(),
+		recorded:        time.Now(),
+	}
+}
+
+// pendingWrites returns the writes of objects of the given kind with names that
+// match the given function that the cache might not have observed yet.
+func (c *coherentClient) pendingWrites(gk schema.GroupKind, match func(types.NamespacedName) bool) map[writeKey]pendingWrite {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	pending := map[writeKey]pendingWrite{}
+	for key, write := range c.writes {
+		if time.Since(write.recorded) > pendingWriteExpiry {
+			delete(c.writes, key)
+			continue
+		}
+		if key.groupKind == gk && match(key.NamespacedName) {
+			pending[key] = write
+		}
+	}
+	return pending
+}
+
+// forgetWrite stops tracking the given write unless the client has written
+// the object again since.
+func (c *coherentClient) forgetWrite(key writeKey, write pendingWrite) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.writes[key] == write {
+		delete(c.writes, key)
+	}
+}
+
+// waitForWrites waits for the cache to observe the client's writes of objects
+// of the given kind with names that match the given function, using the given
+// function to allocate objects for reading from the cache.  It returns a
+// Boolean value indicating whether the cache observed the writes within the
+// coherence timeout.
+func (c *coherentClient) waitForWrites(ctx context.Context, gk schema.GroupKind, match func(types.NamespacedName) bool, newObj func() client.Object) bool {
+	pending := c.pendingWrites(gk, match)
+	if len(pending) == 0 {
+		return true
+	}
+	start := time.Now()
+	err := wait.PollImmediateWithContext(ctx, coherencePollInterval, coherenceTimeout, func(ctx context.Context) (bool, error) {
+		for key, write := range pending {
+			if observed, err := c.writeObserved(ctx, key, write, newObj()); err != nil {
+				return false, err
+			} else if !observed {
+				return false, nil
+			}
+			c.forgetWrite(key, write)
+			delete(pending, key)
+		}
+		return true, nil
+	})
+	cacheCoherenceWaitSeconds.Observe(time.Since(start).Seconds())
+	if err != nil {
+		cacheCoherenceTimeoutsTotal.Inc()
+		return false
+	}
+	return true
+}
+
+// writeObserved returns a Boolean value indicating whether the cache has
+// observed the given write, using the given object to read from the cache.
+func (c *coherentClient) writeObserved(ctx context.Context, key writeKey, write pendingWrite, obj client.Object) (bool, error) {
+	if err := c.cache.Get(ctx, key.NamespacedName, obj); err != nil {
+		if errors.IsNotFound(err) {
+			return write.deleted, nil
+		}
+		return false, err
+	}
+	if write.deleted {
+		return obj.GetUID() != write.uid || obj.GetDeletionTimestamp() != nil, nil
+	}
+	return resourceVersionAtLeast(obj.GetResourceVersion(), write.resourceVersion), nil
+}
+
+// resourceVersionAtLeast returns a Boolean value indicating whether the given
+// resource version is at least the given minimum.  Resource versions are
+// compared as integers, which is what the API server uses; if either is not an
+// integer, they must be equal.
+func resourceVersionAtLeast(resourceVersion, minimum string) bool {
+	rv, err1 := strconv.ParseUint(resourceVersion, 10, 64)
+	min, err2 := strconv.ParseUint(minimum, 10, 64)
+	if err1 != nil || err2 != nil {
+		return resourceVersion == minimum
+	}
+	return rv >= min
+}
+
+// isCacheNotStarted returns a Boolean value indicating whether the given error
+// indicates that the cache has not been started yet.
+func isCacheNotStarted(err error) bool {
+	_, ok := err.(*cache.ErrCacheNotStarted)
+	return ok
+}
@@ -0,0 +1,325 @@
+package client
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	configv1 "github.com/openshift/api/config/v1"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// fakeCache is a client.Reader that serves objects from a snapshot of another
+// client's objects, which the test updates explicitly to simulate an informer
+// cache that lags behind the API.
+type fakeCache struct {
+	lock    sync.Mutex
+	objects map[schema.GroupVersionKind]map[types.NamespacedName]client.Object
+}
+
+// sync replaces the cache's objects of the given list's kind with the objects
+// that the given client has.
+func (c *fakeCache) sync(t *testing.T, cl client.Client, list client.ObjectList) {
+	t.Helper()
+	if err := cl.List(context.Background(), list); err != nil {
+		t.Fatalf("failed to list objects: %v", err)
+	}
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		t.Fatalf("failed to extract list: %v", err)
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.objects == nil {
+		c.objects = map[schema.GroupVersionKind]map[types.NamespacedName]client.Object{}
+	}
+	gvk, err := apiutil.GVKForObject(list, scheme)
+	if err != nil {
+		t.Fatalf("failed to get GVK for list: %v", err)
+	}
+	gvk.Kind = gvk.Kind[:len(gvk.Kind)-len("List")]
+	c.objects[gvk] = map[types.NamespacedName]client.Object{}
+	for _, item := range items {
+		obj := item.(client.Object)
+		c.objects[gvk][client.ObjectKeyFromObject(obj)] = obj.DeepCopyObject().(client.Object)
+	}
+}
+
+func (c *fakeCache) Get(_ context.Context, key client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+	gvk, err := apiutil.GVKForObject(obj, scheme)
+	if err != nil {
+		return err
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	cached, ok := c.objects[gvk][key]
+	if !ok {
+		return errors.NewNotFound(schema.GroupResource{Group: gvk.Group, Resource: gvk.Kind}, key.Name)
+	}
+	reflect.ValueOf(obj).Elem().Set(reflect.ValueOf(cached.DeepCopyObject()).Elem())
+	return nil
+}
+
+func (c *fakeCache) List(_ context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	gvk, err := apiutil.GVKForObject(list, scheme)
+	if err != nil {
+		return err
+	}
+	gvk.Kind = gvk.Kind[:len(gvk.Kind)-len("List")]
+	listOpts := &client.ListOptions{}
+	listOpts.ApplyOptions(opts)
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	items := []runtime.Object{}
+	for key, obj := range c.objects[gvk] {
+		if len(listOpts.Namespace) == 0 || key.Namespace == listOpts.Namespace {
+			items = append(items, obj.DeepCopyObject())
+		}
+	}
+	return meta.SetList(list, items)
+}
+
+// newTestCoherentClient returns a fake API client, a fake cache, and a coherent
+// client that caches configmaps in the "cached" namespace and
+// clusteroperators.
+func newTestCoherentClient(t *testing.T, objs ...client.Object) (client.Client, *fakeCache, client.Client) {
+	t.Helper()
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(corev1.SchemeGroupVersion.WithKind("ConfigMap"), meta.RESTScopeNamespace)
+	mapper.Add(configv1.GroupVersion.WithKind("ClusterOperator"), meta.RESTScopeRoot)
+	api := fake.NewClientBuilder().WithScheme(scheme).WithRESTMapper(mapper).WithObjects(objs...).Build()
+	cache := &fakeCache{}
+	cache.sync(t, api, &corev1.ConfigMapList{})
+	cache.sync(t, api, &configv1.ClusterOperatorList{})
+	cl, err := NewCoherentClient(api, cache, []string{"cached"}, &corev1.ConfigMap{}, &configv1.ClusterOperator{})
+	if err != nil {
+		t.Fatalf("failed to create coherent client: %v", err)
+	}
+	return api, cache, cl
+}
+
+// TestCoherentClientReadsOwnWrites verifies that reads through the coherent
+// client observe the client's own writes, either from the cache once the cache
+// observes the writes or from the API if the cache does not observe the writes
+// in time.
+func TestCoherentClientReadsOwnWrites(t *testing.T) {
+	defer func(d time.Duration) { coherenceTimeout = d }(coherenceTimeout)
+	coherenceTimeout = 200 * time.Millisecond
+
+	existing := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "cached", Name: "existing", UID: "1"},
+		Data:       map[string]string{"key": "old"},
+	}
+	key := client.ObjectKeyFromObject(existing)
+	testCases := []struct {
+		description string
+		write       func(cl client.Client) error
+		// cacheSyncs indicates whether the cache observes the write
+		// before the coherence timeout.
+		cacheSyncs     bool
+		expectNotFound bool
+		expectData     string
+	}{
+		{
+			description: "update observed by the cache",
+			write: func(cl client.Client) error {
+				cm := &corev1.ConfigMap{}
+				if err := cl.Get(context.Background(), key, cm); err != nil {
+					return err
+				}
+				cm.Data["key"] = "new"
+				return cl.Update(context.Background(), cm)
+			},
+			cacheSyncs: true,
+			expectData: "new",
+		},
+		{
+			description: "update not observed by the cache",
+			write: func(cl client.Client) error {
+				cm := &corev1.ConfigMap{}
+				if err := cl.Get(context.Background(), key, cm); err != nil {
+					return err
+				}
+				cm.Data["key"] = "new"
+				return cl.Update(context.Background(), cm)
+			},
+			cacheSyncs: false,
+			expectData: "new",
+		},
+		{
+			description: "delete by name observed by the cache",
+			write: func(cl client.Client) error {
+				cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: key.Namespace, Name: key.Name}}
+				return cl.Delete(context.Background(), cm)
+			},
+			cacheSyncs:     true,
+			expectNotFound: true,
+		},
+		{
+			description: "delete by name not observed by the cache",
+			write: func(cl client.Client) error {
+				cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: key.Namespace, Name: key.Name}}
+				return cl.Delete(context.Background(), cm)
+			},
+			cacheSyncs:     false,
+			expectNotFound: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			api, cache, cl := newTestCoherentClient(t, existing.DeepCopy())
+			if err := tc.write(cl); err != nil {
+				t.Fatalf("write failed: %v", err)
+			}
+			cacheReads := testutil.ToFloat64(requestsTotal.WithLabelValues("get", "ConfigMap", sourceCache))
+			timeouts := testutil.ToFloat64(cacheCoherenceTimeoutsTotal)
+			if tc.cacheSyncs {
+				go func() {
+					time.Sleep(50 * time.Millisecond)
+					cache.sync(t, api, &corev1.ConfigMapList{})
+				}()
+			}
+			cm := &corev1.ConfigMap{}
+			err := cl.Get(context.Background(), key, cm)
+			switch {
+			case tc.expectNotFound && !errors.IsNotFound(err):
+				t.Fatalf("expected NotFound, got %v", err)
+			case !tc.expectNotFound && err != nil:
+				t.Fatalf("failed to get configmap: %v", err)
+			case !tc.expectNotFound && cm.Data["key"] != tc.expectData:
+				t.Errorf("expected data %q, got %q", tc.expectData, cm.Data["key"])
+			}
+			servedFromCache := testutil.ToFloat64(requestsTotal.WithLabelValues("get", "ConfigMap", sourceCache)) > cacheReads
+			timedOut := testutil.ToFloat64(cacheCoherenceTimeoutsTotal) > timeouts
+			if servedFromCache != tc.cacheSyncs {
+				t.Errorf("expected read served from cache to be %t, got %t", tc.cacheSyncs, servedFromCache)
+			}
+			if timedOut == tc.cacheSyncs {
+				t.Errorf("expected coherence timeout to be %t, got %t", !tc.cacheSyncs, timedOut)
+			}
+		})
+	}
+}
+
+// TestCoherentClientCreateThenList verifies that a list through the coherent
+// client includes an object that the client has just created.
+func TestCoherentClientCreateThenList(t *testing.T) {
+	api, cache, cl := newTestCoherentClient(t)
+	cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "cached", Name: "new"}}
+	if err := cl.Create(context.Background(), cm); err != nil {
+		t.Fatalf("failed to create configmap: %v", err)
+	}
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		cache.sync(t, api, &corev1.ConfigMapList{})
+	}()
+	list := &corev1.ConfigMapList{}
+	if err := cl.List(context.Background(), list, client.InNamespace("cached")); err != nil {
+		t.Fatalf("failed to list configmaps: %v", err)
+	}
+	if len(list.Items) != 1 || list.Items[0].Name != "new" {
+		t.Errorf("expected list to contain the new configmap, got %v", list.Items)
+	}
+}
+
+// TestCoherentClientReadSource verifies that the coherent client reads from
+// the cache only the kinds and namespaces that the cache covers.
+func TestCoherentClientReadSource(t *testing.T) {
+	objs := []client.Object{
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "cached", Name: "a"}},
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "uncached", Name: "b"}},
+		&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "cached", Name: "c"}},
+		&configv1.ClusterOperator{ObjectMeta: metav1.ObjectMeta{Name: "ingress"}},
+	}
+	testCases := []struct {
+		description string
+		read        func(cl client.Client) error
+		kind        string
+		verb        string
+		expect      string
+	}{
+		{
+			description: "configmap in a cached namespace",
+			read: func(cl client.Client) error {
+				return cl.Get(context.Background(), types.NamespacedName{Namespace: "cached", Name: "a"}, &corev1.ConfigMap{})
+			},
+			kind:   "ConfigMap",
+			verb:   "get",
+			expect: sourceCache,
+		},
+		{
+			description: "configmap in an uncached namespace",
+			read: func(cl client.Client) error {
+				return cl.Get(context.Background(), types.NamespacedName{Namespace: "uncached", Name: "b"}, &corev1.ConfigMap{})
+			},
+			kind:   "ConfigMap",
+			verb:   "get",
+			expect: sourceAPI,
+		},
+		{
+			description: "uncached kind",
+			read: func(cl client.Client) error {
+				return cl.Get(context.Background(), types.NamespacedName{Namespace: "cached", Name: "c"}, &corev1.Secret{})
+			},
+			kind:   "Secret",
+			verb:   "get",
+			expect: sourceAPI,
+		},
+		{
+			description: "cluster-scoped cached kind",
+			read: func(cl client.Client) error {
+				return cl.Get(context.Background(), types.NamespacedName{Name: "ingress"}, &configv1.ClusterOperator{})
+			},
+			kind:   "ClusterOperator.config.openshift.io",
+			verb:   "get",
+			expect: sourceCache,
+		},
+		{
+			description: "list in a cached namespace",
+			read: func(cl client.Client) error {
+				return cl.List(context.Background(), &corev1.ConfigMapList{}, client.InNamespace("cached"))
+			},
+			kind:   "ConfigMap",
+			verb:   "list",
+			expect: sourceCache,
+		},
+		{
+			description: "list across all namespaces",
+			read: func(cl client.Client) error {
+				return cl.List(context.Background(), &corev1.ConfigMapList{})
+			},
+			kind:   "ConfigMap",
+			verb:   "list",
+			expect: sourceAPI,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			_, _, cl := newTestCoherentClient(t, objs...)
+			counter := requestsTotal.WithLabelValues(tc.verb, tc.kind, tc.expect)
+			before := testutil.ToFloat64(counter)
+			if err := tc.read(cl); err != nil {
+				t.Fatalf("read failed: %v", err)
+			}
+			if after := testutil.ToFloat64(counter); after != before+1 {
+				t.Errorf("expected read to be served from %s", tc.expect)
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package client
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var (
+	// requestsTotal counts the client's requests, by verb, kind, and
+	// whether the client served the request from the cache or the API.
+	requestsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "ingress_operator_client_requests_total",
+		Help: "Report the number of requests that the operator's client has made, by verb, kind, and source (cache or api).",
+	}, []string{"verb", "kind", "source"})
+
+	// cacheCoherenceWaitSeconds observes how long reads wait for the cache
+	// to observe the client's own writes.
+	cacheCoherenceWaitSeconds = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "ingress_operator_client_cache_coherence_wait_seconds",
+		Help:    "Report how long reads wait for the cache to observe the operator's own writes.",
+		Buckets: []float64{0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5},
+	})
+
+	// cacheCoherenceTimeoutsTotal counts reads that fell back to the API
+	// because the cache did not observe the client's own writes in time.
+	cacheCoherenceTimeoutsTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "ingress_operator_client_cache_coherence_timeouts_total",
+		Help: "Report the number of reads that fell back to the API because the cache did not observe the operator's own writes in time.",
+	})
+
+	// metricsList is a list of metrics for this package.
+	metricsList = []prometheus.Collector{
+		requestsTotal,
+		cacheCoherenceWaitSeconds,
+		cacheCoherenceTimeoutsTotal,
+	}
+)
+
+// countRequest increments the ingress_operator_client_requests_total metric
+// for the given verb, kind, and source.
+func countRequest(verb string, gk schema.GroupKind, source string) {
+	requestsTotal.WithLabelValues(verb, gk.String(), source).Inc()
+}
+
+// RegisterMetrics calls prometheus.Register on each metric in metricsList, and
+// returns on errors.
+func RegisterMetrics() error {
+	for _, metric := range metricsList {
+		if err := prometheus.Register(metric); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -1,5 +1,11 @@
 package config
 
+import (
+	"time"
+
+	"github.com/openshift/cluster-ingress-operator/pkg/util/trustedca"
+)
+
 // Config is configuration for the operator and should include things like
 // operated images, scheduling configuration, etc.
 type Config struct {
@@ -9,11 +15,63 @@ type Config struct {
 	// Namespace is the operator namespace.
 	Namespace string
 
+	// OperandNamespace is the namespace for ingresscontrollers' router
+	// deployments and related resources.
+	OperandNamespace string
+
+	// CanaryNamespace is the namespace for the ingress canary check
+	// resources.
+	CanaryNamespace string
+
+	// ConfigNamespace is the namespace from which the operator reads
+	// user-specified configuration, such as secrets and configmaps that
+	// ingresscontrollers reference.
+	ConfigNamespace string
+
 	// IngressControllerImage is the ingress controller image to manage.
 	IngressControllerImage string
 
-	// CanaryImage is the ingress operator image, which runs a canary command.
+	// CanaryImage is the ingress operator image, which runs a canary command
+	// and the router tracing sidecar.
 	CanaryImage string
 
+	// OTelCollectorImage is the OpenTelemetry collector image, which
+	// exports access logs using OTLP.
+	OTelCollectorImage string
+
+	// WebhookListenAddr is the address on which the operator serves its
+	// admission webhook.  If empty, the operator does not serve the
+	// webhook.
+	WebhookListenAddr string
+
+	// LeaderElection configures leader election among replicas of the
+	// operator.
+	LeaderElection LeaderElectionConfig
+
+	// TrustedCABundle is the trusted CA bundle that the operator's HTTP
+	// clients use.  The operator recreates its clients when the bundle is
+	// reloaded.  If nil, the clients use the system certificate pool.
+	TrustedCABundle *trustedca.Bundle
+
 	Stop chan struct{}
 }
+
+// LeaderElectionConfig is configuration for leader election among replicas of
+// the operator.  Only the leader runs controllers; other replicas keep their
+// caches synced so that they can take over if the leader fails.
+type LeaderElectionConfig struct {
+	// Enabled specifies whether the operator uses leader election.
+	Enabled bool
+
+	// LeaseDuration is the duration that non-leader replicas wait after
+	// the leader last renewed its lease before they try to acquire it.
+	LeaseDuration time.Duration
+
+	// RenewDeadline is the duration that the leader retries renewing its
+	// lease before it gives up leadership.
+	RenewDeadline time.Duration
+
+	// RetryPeriod is the duration that replicas wait between attempts to
+	// acquire or renew the lease.
+	RetryPeriod time.Duration
+}
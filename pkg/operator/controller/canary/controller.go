@@ -3,8 +3,10 @@ package canary
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"strconv"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	logf "github.com/openshift/cluster-ingress-operator/pkg/log"
@@ -43,6 +45,11 @@ const (
 	// canaryCheckFailureCount is how many successive failing canary checks should
 	// be observed before the default ingress controller goes degraded.
 	canaryCheckFailureCount = 5
+	// canaryPollerStallTimeout is how long the canary route poller may go
+	// without starting a check before PollerHealthzCheck reports the poller
+	// as stalled.  A single check is bounded by the probe's HTTP timeout,
+	// so this leaves ample room for slow checks.
+	canaryPollerStallTimeout = 10 * canaryCheckFrequency
 
 	// CanaryRouteRotationAnnotation is an annotation on the default ingress controller
 	// that specifies whether or not the canary check loop should periodically rotate
@@ -62,8 +69,27 @@ const (
 var (
 	log              = logf.Logger.WithName(canaryControllerName)
 	routeProbeRunner sync.Once
+
+	// lastCanaryCheck is the time at which the canary route poller last
+	// started a check.  It is unset until the poller starts.
+	lastCanaryCheck atomic.Value
 )
 
+// PollerHealthzCheck is a healthz.Checker that fails if the canary route poller
+// has started but has stopped running checks.  The poller only runs on the
+// operator replica that holds the leader election lease, so the check passes
+// on other replicas.
+func PollerHealthzCheck(_ *http.Request) error {
+	last, ok := lastCanaryCheck.Load().(time.Time)
+	if !ok {
+		return nil
+	}
+	if since := time.Since(last); since > canaryPollerStallTimeout {
+		return fmt.Errorf("canary route poller has not run a check in %s", since.Round(time.Second))
+	}
+	return nil
+}
+
 // New creates the canary controller.
 //
 // The canary controller will watch the Default IngressController, as well as
@@ -90,7 +116,7 @@ func New(mgr manager.Manager, config Config) (controller.Controller, error) {
 
 	// trigger reconcile requests for the canary controller via events for the canary route.
 	canaryRoutePredicate := predicate.NewPredicateFuncs(func(o client.Object) bool {
-		return o.GetName() == operatorcontroller.CanaryRouteName().Name
+		return o.GetName() == operatorcontroller.CanaryRouteName(config.CanaryNamespace).Name
 	})
 
 	// filter out canary route updates where the canary controller changes the canary route's Spec.Port,
@@ -186,7 +212,9 @@ func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		r.mu.Unlock()
 	}
 
-	// Start probing the canary route.
+	// Start probing the canary route.  Controllers only reconcile on the
+	// operator replica that holds the leader election lease, so only the
+	// leader probes the route and reports its status.
 	routeProbeRunner.Do(func() {
 		r.startCanaryRoutePolling(r.config.Stop)
 	})
@@ -196,9 +224,10 @@ func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 
 // Config holds all the things necessary for the controller to run.
 type Config struct {
-	Namespace   string
-	CanaryImage string
-	Stop        chan struct{}
+	Namespace       string
+	CanaryNamespace string
+	CanaryImage     string
+	Stop            chan struct{}
 }
 
 // reconciler handles the actual canary reconciliation logic in response to
@@ -230,7 +259,10 @@ func (r *reconciler) startCanaryRoutePolling(stop <-chan struct{}) error {
 	// for status reporting.
 	successiveFail := 0
 
+	lastCanaryCheck.Store(time.Now())
 	go wait.Until(func() {
+		lastCanaryCheck.Store(time.Now())
+
 		// Get the current canary route every iteration in case it has been modified
 		haveRoute, route, err := r.currentCanaryRoute()
 		if err != nil {
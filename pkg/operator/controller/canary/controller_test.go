@@ -2,6 +2,7 @@ package canary
 
 import (
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 
@@ -145,3 +146,48 @@ func TestCycleServicePort(t *testing.T) {
 		}
 	}
 }
+
+// TestPollerHealthzCheck verifies that PollerHealthzCheck passes before the
+// canary route poller starts and while it runs checks, and fails once the
+// poller stalls.
+func TestPollerHealthzCheck(t *testing.T) {
+	defer func(v interface{}) {
+		if v != nil {
+			lastCanaryCheck.Store(v)
+		}
+	}(lastCanaryCheck.Load())
+
+	testCases := []struct {
+		description string
+		lastCheck   time.Time
+		expectErr   bool
+	}{
+		{
+			description: "recent check",
+			lastCheck:   time.Now().Add(-canaryCheckFrequency),
+			expectErr:   false,
+		},
+		{
+			description: "stalled poller",
+			lastCheck:   time.Now().Add(-2 * canaryPollerStallTimeout),
+			expectErr:   true,
+		},
+	}
+	if lastCanaryCheck.Load() == nil {
+		if err := PollerHealthzCheck(nil); err != nil {
+			t.Errorf("expected check to pass before the poller starts, got %v", err)
+		}
+	}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			lastCanaryCheck.Store(tc.lastCheck)
+			err := PollerHealthzCheck(nil)
+			if tc.expectErr && err == nil {
+				t.Error("expected an error")
+			}
+			if !tc.expectErr && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
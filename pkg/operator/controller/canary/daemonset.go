@@ -17,7 +17,7 @@ import (
 
 // ensureCanaryDaemonSet ensures the canary daemonset exists
 func (r *reconciler) ensureCanaryDaemonSet() (bool, *appsv1.DaemonSet, error) {
-	desired := desiredCanaryDaemonSet(r.config.CanaryImage)
+	desired := desiredCanaryDaemonSet(r.config.CanaryNamespace, r.config.CanaryImage)
 	haveDs, current, err := r.currentCanaryDaemonSet()
 	if err != nil {
 		return false, nil, err
@@ -43,7 +43,7 @@ func (r *reconciler) ensureCanaryDaemonSet() (bool, *appsv1.DaemonSet, error) {
 // currentCanaryDaemonSet returns the current canary daemonset
 func (r *reconciler) currentCanaryDaemonSet() (bool, *appsv1.DaemonSet, error) {
 	daemonset := &appsv1.DaemonSet{}
-	if err := r.client.Get(context.TODO(), controller.CanaryDaemonSetName(), daemonset); err != nil {
+	if err := r.client.Get(context.TODO(), controller.CanaryDaemonSetName(r.config.CanaryNamespace), daemonset); err != nil {
 		if errors.IsNotFound(err) {
 			return false, nil, nil
 		}
@@ -77,11 +77,11 @@ func (r *reconciler) updateCanaryDaemonSet(current, desired *appsv1.DaemonSet) (
 	return true, nil
 }
 
-// desiredCanaryDaemonSet returns the desired canary daemonset read in
-// from manifests
-func desiredCanaryDaemonSet(canaryImage string) *appsv1.DaemonSet {
+// desiredCanaryDaemonSet returns the desired canary daemonset in the given
+// namespace read in from manifests
+func desiredCanaryDaemonSet(namespace, canaryImage string) *appsv1.DaemonSet {
 	daemonset := manifests.CanaryDaemonSet()
-	name := controller.CanaryDaemonSetName()
+	name := controller.CanaryDaemonSetName(namespace)
 	daemonset.Name = name.Name
 	daemonset.Namespace = name.Namespace
 
@@ -16,9 +16,9 @@ import (
 func TestDesiredCanaryDaemonSet(t *testing.T) {
 	// canaryImageName is the ingress-operator image
 	canaryImageName := "openshift/origin-cluster-ingress-operator:latest"
-	daemonset := desiredCanaryDaemonSet(canaryImageName)
+	daemonset := desiredCanaryDaemonSet("openshift-ingress-canary", canaryImageName)
 
-	expectedDaemonSetName := controller.CanaryDaemonSetName()
+	expectedDaemonSetName := controller.CanaryDaemonSetName("openshift-ingress-canary")
 
 	if !cmp.Equal(daemonset.Name, expectedDaemonSetName.Name) {
 		t.Errorf("expected daemonset name to be %s, but got %s", expectedDaemonSetName.Name, daemonset.Name)
@@ -187,7 +187,7 @@ func TestCanaryDaemonsetChanged(t *testing.T) {
 	}
 
 	for _, tc := range testCases {
-		original := desiredCanaryDaemonSet("")
+		original := desiredCanaryDaemonSet("openshift-ingress-canary", "")
 		mutated := original.DeepCopy()
 		tc.mutate(mutated)
 		if changed, updated := canaryDaemonSetChanged(original, mutated); changed != tc.expect {
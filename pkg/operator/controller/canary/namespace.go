@@ -14,14 +14,21 @@ import (
 	projectv1 "github.com/openshift/api/project/v1"
 )
 
-// ensureCanaryNamespace ensures that the ingress-canary namespace exists
+// ensureCanaryNamespace ensures that the ingress-canary namespace exists and
+// that this instance of the operator has claimed it.
 func (r *reconciler) ensureCanaryNamespace() (bool, *corev1.Namespace, error) {
-	desired := manifests.CanaryNamespace()
+	desired := manifests.CanaryNamespace(r.config.CanaryNamespace)
+	desired.Annotations[controller.OwningOperatorNamespaceAnnotation] = r.config.Namespace
 
 	haveNamespace, current, err := r.currentCanaryNamespace()
 	if err != nil {
 		return false, nil, err
 	}
+	if haveNamespace {
+		if err := controller.ValidateNamespaceClaim(current, r.config.Namespace); err != nil {
+			return true, current, err
+		}
+	}
 
 	switch {
 	case !haveNamespace:
@@ -43,7 +50,7 @@ func (r *reconciler) ensureCanaryNamespace() (bool, *corev1.Namespace, error) {
 // currentCanaryNamespace gets the current canary namespace resource
 func (r *reconciler) currentCanaryNamespace() (bool, *corev1.Namespace, error) {
 	ns := &corev1.Namespace{}
-	if err := r.client.Get(context.TODO(), types.NamespacedName{Name: controller.DefaultCanaryNamespace}, ns); err != nil {
+	if err := r.client.Get(context.TODO(), types.NamespacedName{Name: r.config.CanaryNamespace}, ns); err != nil {
 		if errors.IsNotFound(err) {
 			return false, nil, nil
 		}
@@ -78,19 +85,29 @@ func (r *reconciler) updateCanaryNamespace(current, desired *corev1.Namespace) (
 }
 
 // canaryNamespaceChanged returns true if current and expected differ by the openshift
-// namespace node-selector annotation
+// namespace node-selector annotation or by the owning operator namespace
+// annotation.
 func canaryNamespaceChanged(current, expected *corev1.Namespace) (bool, *corev1.Namespace) {
 	updated := current.DeepCopy()
+	changed := false
 
 	if updated.Annotations == nil {
 		updated.Annotations = map[string]string{}
 	}
 
-	if current.Annotations[projectv1.ProjectNodeSelector] == expected.Annotations[projectv1.ProjectNodeSelector] {
-		return false, nil
+	if current.Annotations[projectv1.ProjectNodeSelector] != expected.Annotations[projectv1.ProjectNodeSelector] {
+		updated.Annotations[projectv1.ProjectNodeSelector] = expected.Annotations[projectv1.ProjectNodeSelector]
+		changed = true
 	}
 
-	updated.Annotations[projectv1.ProjectNodeSelector] = expected.Annotations[projectv1.ProjectNodeSelector]
+	if owner, ok := expected.Annotations[controller.OwningOperatorNamespaceAnnotation]; ok && current.Annotations[controller.OwningOperatorNamespaceAnnotation] != owner {
+		updated.Annotations[controller.OwningOperatorNamespaceAnnotation] = owner
+		changed = true
+	}
+
+	if !changed {
+		return false, nil
+	}
 
 	return true, updated
 }
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/openshift/cluster-ingress-operator/pkg/manifests"
+	"github.com/openshift/cluster-ingress-operator/pkg/operator/controller"
 
 	corev1 "k8s.io/api/core/v1"
 
@@ -28,10 +29,17 @@ func TestCanaryNamespaceChanged(t *testing.T) {
 			},
 			expect: true,
 		},
+		{
+			description: "if owning operator namespace annotation is added",
+			mutate: func(ns *corev1.Namespace) {
+				ns.Annotations[controller.OwningOperatorNamespaceAnnotation] = "openshift-ingress-operator"
+			},
+			expect: true,
+		},
 	}
 
 	for _, tc := range testCases {
-		original := manifests.CanaryNamespace()
+		original := manifests.CanaryNamespace("openshift-ingress-canary")
 		mutated := original.DeepCopy()
 		tc.mutate(mutated)
 		if changed, updated := canaryNamespaceChanged(original, mutated); changed != tc.expect {
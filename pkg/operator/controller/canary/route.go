@@ -18,7 +18,7 @@ import (
 
 // ensureCanaryRoute ensures the canary route exists
 func (r *reconciler) ensureCanaryRoute(service *corev1.Service) (bool, *routev1.Route, error) {
-	desired, err := desiredCanaryRoute(service)
+	desired, err := desiredCanaryRoute(r.config.CanaryNamespace, service)
 	if err != nil {
 		return false, nil, fmt.Errorf("failed to build canary route: %v", err)
 	}
@@ -48,7 +48,7 @@ func (r *reconciler) ensureCanaryRoute(service *corev1.Service) (bool, *routev1.
 // currentCanaryRoute gets the current canary route resource
 func (r *reconciler) currentCanaryRoute() (bool, *routev1.Route, error) {
 	route := &routev1.Route{}
-	if err := r.client.Get(context.TODO(), controller.CanaryRouteName(), route); err != nil {
+	if err := r.client.Get(context.TODO(), controller.CanaryRouteName(r.config.CanaryNamespace), route); err != nil {
 		if errors.IsNotFound(err) {
 			return false, nil, nil
 		}
@@ -122,12 +122,12 @@ func canaryRouteChanged(current, expected *routev1.Route) (bool, *routev1.Route)
 	return true, updated
 }
 
-// desiredCanaryRoute returns the desired canary route read in
-// from manifests
-func desiredCanaryRoute(service *corev1.Service) (*routev1.Route, error) {
+// desiredCanaryRoute returns the desired canary route in the given namespace
+// read in from manifests
+func desiredCanaryRoute(namespace string, service *corev1.Service) (*routev1.Route, error) {
 	route := manifests.CanaryRoute()
 
-	name := controller.CanaryRouteName()
+	name := controller.CanaryRouteName(namespace)
 
 	route.Namespace = name.Namespace
 	route.Name = name.Name
@@ -141,7 +141,7 @@ func desiredCanaryRoute(service *corev1.Service) (*routev1.Route, error) {
 		manifests.OwningIngressCanaryCheckLabel: canaryControllerName,
 	}
 
-	route.Spec.To.Name = controller.CanaryServiceName().Name
+	route.Spec.To.Name = controller.CanaryServiceName(namespace).Name
 
 	// Set spec.port.targetPort to the first port available in the canary service.
 	// The canary controller may toggle which targetPort the route targets
@@ -18,8 +18,8 @@ func TestDesiredCanaryRoute(t *testing.T) {
 	daemonsetRef := metav1.OwnerReference{
 		Name: "test",
 	}
-	service := desiredCanaryService(daemonsetRef)
-	route, err := desiredCanaryRoute(service)
+	service := desiredCanaryService("openshift-ingress-canary", daemonsetRef)
+	route, err := desiredCanaryRoute("openshift-ingress-canary", service)
 
 	if err != nil {
 		t.Fatalf("desiredCanaryService returned an error: %v", err)
@@ -124,10 +124,10 @@ func TestCanaryRouteChanged(t *testing.T) {
 	daemonsetRef := metav1.OwnerReference{
 		Name: "test",
 	}
-	service := desiredCanaryService(daemonsetRef)
+	service := desiredCanaryService("openshift-ingress-canary", daemonsetRef)
 
 	for _, tc := range testCases {
-		original, err := desiredCanaryRoute(service)
+		original, err := desiredCanaryRoute("openshift-ingress-canary", service)
 		if err != nil {
 			t.Fatalf("desiredCanaryService returned an error: %v", err)
 		}
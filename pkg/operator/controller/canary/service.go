@@ -14,7 +14,7 @@ import (
 
 // ensureCanaryService ensures the ingress canary service exists
 func (r *reconciler) ensureCanaryService(daemonsetRef metav1.OwnerReference) (bool, *corev1.Service, error) {
-	desired := desiredCanaryService(daemonsetRef)
+	desired := desiredCanaryService(r.config.CanaryNamespace, daemonsetRef)
 	haveService, current, err := r.currentCanaryService()
 	if err != nil {
 		return false, nil, err
@@ -31,7 +31,7 @@ func (r *reconciler) ensureCanaryService(daemonsetRef metav1.OwnerReference) (bo
 // currentCanaryService gets the current ingress canary service resource
 func (r *reconciler) currentCanaryService() (bool, *corev1.Service, error) {
 	current := &corev1.Service{}
-	err := r.client.Get(context.TODO(), controller.CanaryServiceName(), current)
+	err := r.client.Get(context.TODO(), controller.CanaryServiceName(r.config.CanaryNamespace), current)
 	if err != nil {
 		if errors.IsNotFound(err) {
 			return false, nil, nil
@@ -51,11 +51,12 @@ func (r *reconciler) createCanaryService(service *corev1.Service) error {
 	return nil
 }
 
-// desiredCanaryService returns the desired canary service read in from manifests
-func desiredCanaryService(daemonsetRef metav1.OwnerReference) *corev1.Service {
+// desiredCanaryService returns the desired canary service in the given
+// namespace read in from manifests
+func desiredCanaryService(namespace string, daemonsetRef metav1.OwnerReference) *corev1.Service {
 	s := manifests.CanaryService()
 
-	name := controller.CanaryServiceName()
+	name := controller.CanaryServiceName(namespace)
 	s.Namespace = name.Namespace
 	s.Name = name.Name
 
@@ -16,7 +16,7 @@ func TestDesiredCanaryService(t *testing.T) {
 	daemonsetRef := metav1.OwnerReference{
 		Name: "test",
 	}
-	service := desiredCanaryService(daemonsetRef)
+	service := desiredCanaryService("openshift-ingress-canary", daemonsetRef)
 
 	expectedServiceName := types.NamespacedName{
 		Namespace: "openshift-ingress-canary",
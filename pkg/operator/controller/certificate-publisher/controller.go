@@ -95,7 +95,7 @@ func New(mgr manager.Manager, operatorNamespace, operandNamespace string) (runti
 		UpdateFunc:  func(e event.UpdateEvent) bool { return reconciler.secretChanged(e.ObjectOld, e.ObjectNew) },
 		GenericFunc: func(e event.GenericEvent) bool { return reconciler.hasSecret(e.Object, e.Object) },
 	}, predicate.NewPredicateFuncs(func(o client.Object) bool {
-		return reconciler.hasClusterIngressDomain(o) || isDefaultIngressController(o)
+		return reconciler.hasClusterIngressDomain(o) || reconciler.isDefaultIngressController(o)
 	})); err != nil {
 		return nil, err
 	}
@@ -182,8 +182,8 @@ func (r *reconciler) hasClusterIngressDomain(o client.Object) bool {
 
 // isDefaultIngressController returns true if the given ingresscontroller is the
 // "default" ingresscontroller.
-func isDefaultIngressController(o client.Object) bool {
-	return o.GetNamespace() == controller.DefaultOperatorNamespace && o.GetName() == manifests.DefaultIngressControllerName
+func (r *reconciler) isDefaultIngressController(o client.Object) bool {
+	return o.GetNamespace() == r.operatorNamespace && o.GetName() == manifests.DefaultIngressControllerName
 }
 
 func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
@@ -212,11 +212,11 @@ func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	// In an operator maintained cluster, this is always `oc get -n openshift-ingress-operator ingresscontroller/default`, skip the rest and return here.
 	// TODO if network-edge wishes to expand the scope of the CA bundle (and you could legitimately see a need/desire to have one CA that verifies all ingress traffic).
 	// TODO this could be accomplished using union logic similar to the kube-apiserver's join of multiple CAs.
-	if request.NamespacedName.Namespace == controller.DefaultOperatorNamespace && request.NamespacedName.Name == manifests.DefaultIngressControllerName {
+	if request.NamespacedName.Namespace == r.operatorNamespace && request.NamespacedName.Name == manifests.DefaultIngressControllerName {
 		var defaultIngressController *operatorv1.IngressController
 		for i := range controllers.Items {
 			ic := &controllers.Items[i]
-			if isDefaultIngressController(ic) {
+			if r.isDefaultIngressController(ic) {
 				defaultIngressController = ic
 				break
 			}
@@ -226,7 +226,7 @@ func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		}
 
 		var wildcardServingCertKeySecret *corev1.Secret
-		secretName := controller.RouterEffectiveDefaultCertificateSecretName(defaultIngressController, controller.DefaultOperandNamespace)
+		secretName := controller.RouterEffectiveDefaultCertificateSecretName(defaultIngressController, r.operandNamespace)
 		for i := range secrets.Items {
 			secret := &secrets.Items[i]
 			if secret.Namespace == secretName.Namespace && secret.Name == secretName.Name {
@@ -27,7 +27,7 @@ func (r *reconciler) ensureRouterCASecret() (*corev1.Secret, error) {
 	if current != nil {
 		return current, nil
 	}
-	desired, err := desiredRouterCASecret(r.operatorNamespace)
+	desired, err := desiredRouterCASecret(r.config.OperatorNamespace)
 	if err != nil {
 		return nil, err
 	}
@@ -47,7 +47,7 @@ func (r *reconciler) ensureRouterCASecret() (*corev1.Secret, error) {
 
 // currentRouterCASecret returns the current router CA secret.
 func (r *reconciler) currentRouterCASecret() (*corev1.Secret, error) {
-	name := controller.RouterCASecretName(r.operatorNamespace)
+	name := controller.RouterCASecretName(r.config.OperatorNamespace)
 	secret := &corev1.Secret{}
 	if err := r.client.Get(context.TODO(), name, secret); err != nil {
 		if errors.IsNotFound(err) {
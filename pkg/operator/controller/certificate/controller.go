@@ -2,6 +2,8 @@
 //
 //  1. Managing a CA for minting self-signed certs.
 //  2. Managing self-signed certificates for any ingresscontrollers which require them.
+//  3. Managing the serving certificate, the validatingwebhookconfiguration, and
+//     the mutatingwebhookconfiguration for the operator's admission webhook.
 package certificate
 
 import (
@@ -20,6 +22,7 @@ import (
 	"k8s.io/client-go/tools/record"
 
 	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 
 	operatorv1 "github.com/openshift/api/operator/v1"
 
@@ -27,6 +30,7 @@ import (
 	runtimecontroller "sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 )
@@ -37,11 +41,24 @@ const (
 
 var log = logf.Logger.WithName(controllerName)
 
-func New(mgr manager.Manager, operatorNamespace string) (runtimecontroller.Controller, error) {
+// Config holds all the things necessary for the controller to run.
+type Config struct {
+	// OperatorNamespace is the namespace in which the operator runs.
+	OperatorNamespace string
+	// OperandNamespace is the namespace in which the operator manages
+	// the router deployments.
+	OperandNamespace string
+	// WebhookEnabled specifies whether the operator serves its admission
+	// webhook.  If it does not, the controller deletes the webhook's
+	// validatingwebhookconfiguration and mutatingwebhookconfiguration.
+	WebhookEnabled bool
+}
+
+func New(mgr manager.Manager, config Config) (runtimecontroller.Controller, error) {
 	reconciler := &reconciler{
-		client:            mgr.GetClient(),
-		recorder:          mgr.GetEventRecorderFor(controllerName),
-		operatorNamespace: operatorNamespace,
+		client:   mgr.GetClient(),
+		recorder: mgr.GetEventRecorderFor(controllerName),
+		config:   config,
 	}
 	c, err := runtimecontroller.New(controllerName, mgr, runtimecontroller.Options{Reconciler: reconciler})
 	if err != nil {
@@ -50,13 +67,22 @@ func New(mgr manager.Manager, operatorNamespace string) (runtimecontroller.Contr
 	if err := c.Watch(&source.Kind{Type: &operatorv1.IngressController{}}, &handler.EnqueueRequestForObject{}); err != nil {
 		return nil, err
 	}
+	// Watch the webhook's serving certificate secret so that the controller
+	// replaces it if it is deleted or modified.
+	webhookServingCertSecretName := controller.WebhookServingCertSecretName(config.OperatorNamespace)
+	isWebhookServingCertSecret := predicate.NewPredicateFuncs(func(o client.Object) bool {
+		return o.GetNamespace() == webhookServingCertSecretName.Namespace && o.GetName() == webhookServingCertSecretName.Name
+	})
+	if err := c.Watch(&source.Kind{Type: &corev1.Secret{}}, &handler.EnqueueRequestForObject{}, isWebhookServingCertSecret); err != nil {
+		return nil, err
+	}
 	return c, nil
 }
 
 type reconciler struct {
-	client            client.Client
-	recorder          record.EventRecorder
-	operatorNamespace string
+	client   client.Client
+	recorder record.EventRecorder
+	config   Config
 }
 
 func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
@@ -69,6 +95,26 @@ func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 
 	result := reconcile.Result{}
 	errs := []error{}
+	if r.config.WebhookEnabled {
+		if renewAfter, err := r.ensureWebhook(ca); err != nil {
+			errs = append(errs, err)
+		} else {
+			result.RequeueAfter = renewAfter
+		}
+	} else {
+		if err := r.ensureValidatingWebhookConfigurationDeleted(); err != nil {
+			errs = append(errs, err)
+		}
+		if err := r.ensureMutatingWebhookConfigurationDeleted(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	// Requests for the webhook's serving certificate secret only need
+	// the webhook to be reconciled.
+	if request.NamespacedName == controller.WebhookServingCertSecretName(r.config.OperatorNamespace) {
+		return result, utilerrors.NewAggregate(errs)
+	}
+
 	ingress := &operatorv1.IngressController{}
 	if err := r.client.Get(ctx, request.NamespacedName, ingress); err != nil {
 		if errors.IsNotFound(err) {
@@ -82,13 +128,15 @@ func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		log.Info("ingresscontroller domain not set; reconciliation will be skipped", "request", request)
 	} else {
 		deployment := &appsv1.Deployment{}
-		err = r.client.Get(ctx, controller.RouterDeploymentName(ingress), deployment)
+		err = r.client.Get(ctx, controller.RouterDeploymentName(ingress, r.config.OperandNamespace), deployment)
 		if err != nil {
 			if errors.IsNotFound(err) {
 				// All ingresses should have a deployment, so this one may not have been
 				// created yet. Retry after a reasonable amount of time.
 				log.Info("deployment not found; will retry default cert sync", "ingresscontroller", ingress.Name)
-				result.RequeueAfter = 5 * time.Second
+				if result.RequeueAfter == 0 || result.RequeueAfter > 5*time.Second {
+					result.RequeueAfter = 5 * time.Second
+				}
 			} else {
 				errs = append(errs, fmt.Errorf("failed to get deployment: %v", err))
 			}
@@ -0,0 +1,400 @@
+package certificate
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/openshift/library-go/pkg/crypto"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	iov1 "github.com/openshift/api/operatoringress/v1"
+
+	"github.com/openshift/cluster-ingress-operator/pkg/operator/controller"
+	"github.com/openshift/cluster-ingress-operator/pkg/operator/webhook"
+
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+const (
+	// webhookServingCertRenewBefore is how long before the webhook's
+	// serving certificate expires the controller renews it.
+	webhookServingCertRenewBefore = 30 * 24 * time.Hour
+
+	// webhookTimeoutSeconds is how long the API server waits for the
+	// webhook to respond before it ignores the webhook.
+	webhookTimeoutSeconds = int32(10)
+)
+
+// ensureWebhook ensures that the serving certificate secret, the
+// validatingwebhookconfiguration, and the mutatingwebhookconfiguration for the
+// operator's admission webhook exist and are current.  Returns the duration after which the serving certificate needs
+// to be renewed.
+func (r *reconciler) ensureWebhook(caSecret *corev1.Secret) (time.Duration, error) {
+	renewAfter, err := r.ensureWebhookServingCertSecret(caSecret)
+	if err != nil {
+		return 0, fmt.Errorf("failed to ensure webhook serving certificate: %w", err)
+	}
+	if err := r.ensureValidatingWebhookConfiguration(caSecret); err != nil {
+		return 0, fmt.Errorf("failed to ensure validatingwebhookconfiguration: %w", err)
+	}
+	if err := r.ensureMutatingWebhookConfiguration(caSecret); err != nil {
+		return 0, fmt.Errorf("failed to ensure mutatingwebhookconfiguration: %w", err)
+	}
+	return renewAfter, nil
+}
+
+// ensureWebhookServingCertSecret ensures that the webhook's serving certificate
+// secret exists and has a certificate that the router CA signed, that is valid
+// for the webhook service, and that does not expire soon.  Returns the duration
+// after which the certificate needs to be renewed.
+func (r *reconciler) ensureWebhookServingCertSecret(caSecret *corev1.Secret) (time.Duration, error) {
+	name := controller.WebhookServingCertSecretName(r.config.OperatorNamespace)
+	hostname := webhookServiceHostname(r.config.OperatorNamespace)
+	now := time.Now()
+
+	haveSecret := true
+	current := &corev1.Secret{}
+	if err := r.client.Get(context.TODO(), name, current); err != nil {
+		if !errors.IsNotFound(err) {
+			return 0, fmt.Errorf("failed to get secret %s: %w", name, err)
+		}
+		haveSecret = false
+	}
+	if haveSecret {
+		if notAfter, ok := webhookServingCertValid(current, caSecret.Data[corev1.TLSCertKey], hostname, now); ok {
+			return notAfter.Add(-webhookServingCertRenewBefore).Sub(now), nil
+		}
+	}
+
+	ca, err := crypto.GetCAFromBytes(caSecret.Data[corev1.TLSCertKey], caSecret.Data[corev1.TLSPrivateKeyKey])
+	if err != nil {
+		return 0, fmt.Errorf("failed to get CA from secret %s/%s: %w", caSecret.Namespace, caSecret.Name, err)
+	}
+	desired, notAfter, err := desiredWebhookServingCertSecret(ca, r.config.OperatorNamespace)
+	if err != nil {
+		return 0, err
+	}
+	if !haveSecret {
+		if err := r.client.Create(context.TODO(), desired); err != nil {
+			return 0, fmt.Errorf("failed to create secret %s: %w", name, err)
+		}
+		r.recorder.Eventf(desired, "Normal", "CreatedWebhookServingCert", "Created the admission webhook's serving certificate")
+	} else {
+		updated := current.DeepCopy()
+		updated.Type = desired.Type
+		updated.Data = desired.Data
+		if err := r.client.Update(context.TODO(), updated); err != nil {
+			return 0, fmt.Errorf("failed to update secret %s: %w", name, err)
+		}
+		r.recorder.Eventf(updated, "Normal", "RenewedWebhookServingCert", "Renewed the admission webhook's serving certificate")
+	}
+	return notAfter.Add(-webhookServingCertRenewBefore).Sub(now), nil
+}
+
+// webhookServiceHostname returns the hostname of the webhook service, which the
+// API server uses to verify the webhook's serving certificate.
+func webhookServiceHostname(operatorNamespace string) string {
+	name := controller.WebhookServiceName(operatorNamespace)
+	return fmt.Sprintf("%s.%s.svc", name.Name, name.Namespace)
+}
+
+// desiredWebhookServingCertSecret returns the desired webhook serving
+// certificate secret, with a new certificate that the given CA signs, and the
+// certificate's expiry.
+func desiredWebhookServingCertSecret(ca *crypto.CA, operatorNamespace string) (*corev1.Secret, time.Time, error) {
+	cert, err := ca.MakeServerCert(sets.NewString(webhookServiceHostname(operatorNamespace)), 0)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("failed to make certificate: %w", err)
+	}
+	certBytes, keyBytes, err := cert.GetPEMBytes()
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("failed to encode certificate: %w", err)
+	}
+
+	name := controller.WebhookServingCertSecretName(operatorNamespace)
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name.Name,
+			Namespace: name.Namespace,
+		},
+		Type: corev1.SecretTypeTLS,
+		Data: map[string][]byte{
+			corev1.TLSCertKey:       certBytes,
+			corev1.TLSPrivateKeyKey: keyBytes,
+		},
+	}
+	return secret, cert.Certs[0].NotAfter, nil
+}
+
+// webhookServingCertValid returns the expiry of the certificate in the given
+// secret, and a Boolean value indicating whether the certificate matches its
+// key, is signed by the given CA, is valid for the given hostname, and does not
+// expire soon.
+func webhookServingCertValid(secret *corev1.Secret, caCert []byte, hostname string, now time.Time) (time.Time, bool) {
+	if _, err := tls.X509KeyPair(secret.Data[corev1.TLSCertKey], secret.Data[corev1.TLSPrivateKeyKey]); err != nil {
+		return time.Time{}, false
+	}
+	block, _ := pem.Decode(secret.Data[corev1.TLSCertKey])
+	if block == nil {
+		return time.Time{}, false
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return time.Time{}, false
+	}
+	roots := x509.NewCertPool()
+	if !roots.AppendCertsFromPEM(caCert) {
+		return time.Time{}, false
+	}
+	if _, err := cert.Verify(x509.VerifyOptions{DNSName: hostname, Roots: roots, CurrentTime: now}); err != nil {
+		return time.Time{}, false
+	}
+	if cert.NotAfter.Before(now.Add(webhookServingCertRenewBefore)) {
+		return time.Time{}, false
+	}
+	return cert.NotAfter, true
+}
+
+// ensureValidatingWebhookConfiguration ensures that the validatingwebhook
+// configuration for the operator's admission webhook exists and is current.
+func (r *reconciler) ensureValidatingWebhookConfiguration(caSecret *corev1.Secret) error {
+	desired := desiredValidatingWebhookConfiguration(r.config.OperatorNamespace, caSecret.Data[corev1.TLSCertKey])
+	current := &admissionregistrationv1.ValidatingWebhookConfiguration{}
+	if err := r.client.Get(context.TODO(), controller.ValidatingWebhookConfigurationName(r.config.OperatorNamespace), current); err != nil {
+		if !errors.IsNotFound(err) {
+			return err
+		}
+		if err := r.client.Create(context.TODO(), desired); err != nil {
+			return err
+		}
+		log.Info("created validatingwebhookconfiguration", "name", desired.Name)
+		return nil
+	}
+	if changed, updated := validatingWebhookConfigurationChanged(current, desired); changed {
+		if err := r.client.Update(context.TODO(), updated); err != nil {
+			return err
+		}
+		log.Info("updated validatingwebhookconfiguration", "name", updated.Name)
+	}
+	return nil
+}
+
+// ensureValidatingWebhookConfigurationDeleted deletes the validatingwebhook
+// configuration for the operator's admission webhook if it exists, so that the
+// API server does not wait for a webhook that the operator does not serve.
+func (r *reconciler) ensureValidatingWebhookConfigurationDeleted() error {
+	vwc := &admissionregistrationv1.ValidatingWebhookConfiguration{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: controller.ValidatingWebhookConfigurationName(r.config.OperatorNamespace).Name,
+		},
+	}
+	if err := r.client.Delete(context.TODO(), vwc); err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to delete validatingwebhookconfiguration %s: %w", vwc.Name, err)
+	}
+	log.Info("deleted validatingwebhookconfiguration", "name", vwc.Name)
+	return nil
+}
+
+// desiredValidatingWebhookConfiguration returns the desired validatingwebhook
+// configuration for the operator's admission webhook, which trusts the given CA
+// certificate.
+//
+// The webhook is only called for objects in the operator namespace, and only
+// for creates and updates of the main resource, so that the operator can always
+// update status.  Failures to call the webhook are ignored: the operator must
+// be able to create the default ingresscontroller before any replica serves the
+// webhook, and the ingress controller still validates ingresscontrollers when
+// it admits them.
+func desiredValidatingWebhookConfiguration(operatorNamespace string, caBundle []byte) *admissionregistrationv1.ValidatingWebhookConfiguration {
+	failurePolicy := admissionregistrationv1.Ignore
+	matchPolicy := admissionregistrationv1.Equivalent
+	sideEffects := admissionregistrationv1.SideEffectClassNone
+	scope := admissionregistrationv1.NamespacedScope
+	timeoutSeconds := webhookTimeoutSeconds
+	webhookFor := func(name, path, group, version, resource string) admissionregistrationv1.ValidatingWebhook {
+		return admissionregistrationv1.ValidatingWebhook{
+			Name:         name,
+			ClientConfig: webhookClientConfig(operatorNamespace, path, caBundle),
+			Rules: []admissionregistrationv1.RuleWithOperations{{
+				Operations: []admissionregistrationv1.OperationType{
+					admissionregistrationv1.Create,
+					admissionregistrationv1.Update,
+				},
+				Rule: admissionregistrationv1.Rule{
+					APIGroups:   []string{group},
+					APIVersions: []string{version},
+					Resources:   []string{resource},
+					Scope:       &scope,
+				},
+			}},
+			FailurePolicy: &failurePolicy,
+			MatchPolicy:   &matchPolicy,
+			NamespaceSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					corev1.LabelMetadataName: operatorNamespace,
+				},
+			},
+			ObjectSelector:          &metav1.LabelSelector{},
+			SideEffects:             &sideEffects,
+			TimeoutSeconds:          &timeoutSeconds,
+			AdmissionReviewVersions: []string{"v1"},
+		}
+	}
+	return &admissionregistrationv1.ValidatingWebhookConfiguration{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: controller.ValidatingWebhookConfigurationName(operatorNamespace).Name,
+		},
+		Webhooks: []admissionregistrationv1.ValidatingWebhook{
+			webhookFor("ingresscontrollers.operator.openshift.io", webhook.IngressControllerPath, operatorv1.GroupVersion.Group, operatorv1.GroupVersion.Version, "ingresscontrollers"),
+			webhookFor("dnsrecords.ingress.operator.openshift.io", webhook.DNSRecordPath, iov1.GroupVersion.Group, iov1.GroupVersion.Version, "dnsrecords"),
+		},
+	}
+}
+
+// validatingWebhookConfigurationChanged returns a Boolean value indicating
+// whether the current validatingwebhookconfiguration matches the expected one,
+// and if they do not match, an updated one.
+func validatingWebhookConfigurationChanged(current, expected *admissionregistrationv1.ValidatingWebhookConfiguration) (bool, *admissionregistrationv1.ValidatingWebhookConfiguration) {
+	if cmp.Equal(current.Webhooks, expected.Webhooks, cmpopts.EquateEmpty()) {
+		return false, nil
+	}
+	updated := current.DeepCopy()
+	updated.Webhooks = expected.Webhooks
+	return true, updated
+}
+
+// ensureMutatingWebhookConfiguration ensures that the mutatingwebhook
+// configuration for the operator's admission webhook exists and is current.
+func (r *reconciler) ensureMutatingWebhookConfiguration(caSecret *corev1.Secret) error {
+	desired := desiredMutatingWebhookConfiguration(r.config.OperatorNamespace, caSecret.Data[corev1.TLSCertKey])
+	current := &admissionregistrationv1.MutatingWebhookConfiguration{}
+	if err := r.client.Get(context.TODO(), controller.MutatingWebhookConfigurationName(r.config.OperatorNamespace), current); err != nil {
+		if !errors.IsNotFound(err) {
+			return err
+		}
+		if err := r.client.Create(context.TODO(), desired); err != nil {
+			return err
+		}
+		log.Info("created mutatingwebhookconfiguration", "name", desired.Name)
+		return nil
+	}
+	if changed, updated := mutatingWebhookConfigurationChanged(current, desired); changed {
+		if err := r.client.Update(context.TODO(), updated); err != nil {
+			return err
+		}
+		log.Info("updated mutatingwebhookconfiguration", "name", updated.Name)
+	}
+	return nil
+}
+
+// ensureMutatingWebhookConfigurationDeleted deletes the mutatingwebhook
+// configuration for the operator's admission webhook if it exists.
+func (r *reconciler) ensureMutatingWebhookConfigurationDeleted() error {
+	mwc := &admissionregistrationv1.MutatingWebhookConfiguration{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: controller.MutatingWebhookConfigurationName(r.config.OperatorNamespace).Name,
+		},
+	}
+	if err := r.client.Delete(context.TODO(), mwc); err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to delete mutatingwebhookconfiguration %s: %w", mwc.Name, err)
+	}
+	log.Info("deleted mutatingwebhookconfiguration", "name", mwc.Name)
+	return nil
+}
+
+// desiredMutatingWebhookConfiguration returns the desired mutatingwebhook
+// configuration for the operator's admission webhook, which trusts the given CA
+// certificate.
+//
+// The webhook is only called when ingresscontrollers in the operator namespace
+// are created, so that defaulting never changes an existing ingresscontroller.
+// As with the validatingwebhookconfiguration, failures to call the webhook are
+// ignored, in which case admission sets the defaults in the ingresscontroller's
+// status only.
+func desiredMutatingWebhookConfiguration(operatorNamespace string, caBundle []byte) *admissionregistrationv1.MutatingWebhookConfiguration {
+	failurePolicy := admissionregistrationv1.Ignore
+	matchPolicy := admissionregistrationv1.Equivalent
+	sideEffects := admissionregistrationv1.SideEffectClassNone
+	reinvocationPolicy := admissionregistrationv1.NeverReinvocationPolicy
+	scope := admissionregistrationv1.NamespacedScope
+	timeoutSeconds := webhookTimeoutSeconds
+	return &admissionregistrationv1.MutatingWebhookConfiguration{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: controller.MutatingWebhookConfigurationName(operatorNamespace).Name,
+		},
+		Webhooks: []admissionregistrationv1.MutatingWebhook{{
+			Name:         "ingresscontrollers.operator.openshift.io",
+			ClientConfig: webhookClientConfig(operatorNamespace, webhook.IngressControllerDefaultingPath, caBundle),
+			Rules: []admissionregistrationv1.RuleWithOperations{{
+				Operations: []admissionregistrationv1.OperationType{
+					admissionregistrationv1.Create,
+				},
+				Rule: admissionregistrationv1.Rule{
+					APIGroups:   []string{operatorv1.GroupVersion.Group},
+					APIVersions: []string{operatorv1.GroupVersion.Version},
+					Resources:   []string{"ingresscontrollers"},
+					Scope:       &scope,
+				},
+			}},
+			FailurePolicy: &failurePolicy,
+			MatchPolicy:   &matchPolicy,
+			NamespaceSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					corev1.LabelMetadataName: operatorNamespace,
+				},
+			},
+			ObjectSelector:          &metav1.LabelSelector{},
+			SideEffects:             &sideEffects,
+			TimeoutSeconds:          &timeoutSeconds,
+			AdmissionReviewVersions: []string{"v1"},
+			ReinvocationPolicy:      &reinvocationPolicy,
+		}},
+	}
+}
+
+// mutatingWebhookConfigurationChanged returns a Boolean value indicating
+// whether the current mutatingwebhookconfiguration matches the expected one,
+// and if they do not match, an updated one.
+func mutatingWebhookConfigurationChanged(current, expected *admissionregistrationv1.MutatingWebhookConfiguration) (bool, *admissionregistrationv1.MutatingWebhookConfiguration) {
+	if cmp.Equal(current.Webhooks, expected.Webhooks, cmpopts.EquateEmpty()) {
+		return false, nil
+	}
+	updated := current.DeepCopy()
+	updated.Webhooks = expected.Webhooks
+	return true, updated
+}
+
+// webhookClientConfig returns the client config with which the API server calls
+// the operator's admission webhook on the given path, trusting the given CA
+// certificate.
+func webhookClientConfig(operatorNamespace, path string, caBundle []byte) admissionregistrationv1.WebhookClientConfig {
+	service := controller.WebhookServiceName(operatorNamespace)
+	port := int32(443)
+	return admissionregistrationv1.WebhookClientConfig{
+		Service: &admissionregistrationv1.ServiceReference{
+			Namespace: service.Namespace,
+			Name:      service.Name,
+			Path:      &path,
+			Port:      &port,
+		},
+		CABundle: caBundle,
+	}
+}
@@ -0,0 +1,123 @@
+package certificate
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openshift/library-go/pkg/crypto"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// TestWebhookServingCertValid verifies that webhookServingCertValid accepts a
+// certificate from desiredWebhookServingCertSecret and rejects it once it is
+// about to expire, if the CA changes, or if the service hostname changes.
+func TestWebhookServingCertValid(t *testing.T) {
+	newCA := func() ([]byte, *crypto.CA) {
+		certBytes, keyBytes, err := generateRouterCA()
+		if err != nil {
+			t.Fatalf("failed to generate CA: %v", err)
+		}
+		ca, err := crypto.GetCAFromBytes(certBytes, keyBytes)
+		if err != nil {
+			t.Fatalf("failed to get CA: %v", err)
+		}
+		return certBytes, ca
+	}
+	caCert, ca := newCA()
+	otherCACert, _ := newCA()
+
+	secret, notAfter, err := desiredWebhookServingCertSecret(ca, "openshift-ingress-operator")
+	if err != nil {
+		t.Fatalf("failed to get desired secret: %v", err)
+	}
+	if secret.Name != "webhook-serving-cert" || secret.Namespace != "openshift-ingress-operator" || secret.Type != corev1.SecretTypeTLS {
+		t.Fatalf("unexpected secret: %s/%s of type %s", secret.Namespace, secret.Name, secret.Type)
+	}
+
+	now := time.Now()
+	testCases := []struct {
+		name     string
+		caCert   []byte
+		hostname string
+		now      time.Time
+		expected bool
+	}{
+		{
+			name:     "valid",
+			caCert:   caCert,
+			hostname: "webhook.openshift-ingress-operator.svc",
+			now:      now,
+			expected: true,
+		},
+		{
+			name:     "about to expire",
+			caCert:   caCert,
+			hostname: "webhook.openshift-ingress-operator.svc",
+			now:      notAfter.Add(-webhookServingCertRenewBefore).Add(time.Hour),
+		},
+		{
+			name:     "signed by a different CA",
+			caCert:   otherCACert,
+			hostname: "webhook.openshift-ingress-operator.svc",
+			now:      now,
+		},
+		{
+			name:     "different hostname",
+			caCert:   caCert,
+			hostname: "webhook.other-namespace.svc",
+			now:      now,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, valid := webhookServingCertValid(secret, tc.caCert, tc.hostname, tc.now); valid != tc.expected {
+				t.Errorf("expected valid=%t, got %t", tc.expected, valid)
+			}
+		})
+	}
+}
+
+// TestValidatingWebhookConfigurationChanged verifies that
+// validatingWebhookConfigurationChanged detects changes to the CA bundle and
+// ignores a configuration that matches the desired one.
+func TestValidatingWebhookConfigurationChanged(t *testing.T) {
+	current := desiredValidatingWebhookConfiguration("openshift-ingress-operator", []byte("ca"))
+	if current.Name != "openshift-ingress-operator" || len(current.Webhooks) != 2 {
+		t.Fatalf("unexpected validatingwebhookconfiguration: %+v", current)
+	}
+	if changed, _ := validatingWebhookConfigurationChanged(current, desiredValidatingWebhookConfiguration("openshift-ingress-operator", []byte("ca"))); changed {
+		t.Errorf("expected no change")
+	}
+	expected := desiredValidatingWebhookConfiguration("openshift-ingress-operator", []byte("new-ca"))
+	changed, updated := validatingWebhookConfigurationChanged(current, expected)
+	if !changed {
+		t.Fatalf("expected a change")
+	}
+	for _, webhook := range updated.Webhooks {
+		if string(webhook.ClientConfig.CABundle) != "new-ca" {
+			t.Errorf("expected webhook %s to have the new CA bundle", webhook.Name)
+		}
+	}
+}
+
+// TestMutatingWebhookConfigurationChanged verifies that
+// mutatingWebhookConfigurationChanged detects changes to the CA bundle and
+// ignores a configuration that matches the desired one.
+func TestMutatingWebhookConfigurationChanged(t *testing.T) {
+	current := desiredMutatingWebhookConfiguration("openshift-ingress-operator", []byte("ca"))
+	if current.Name != "openshift-ingress-operator" || len(current.Webhooks) != 1 {
+		t.Fatalf("unexpected mutatingwebhookconfiguration: %+v", current)
+	}
+	if changed, _ := mutatingWebhookConfigurationChanged(current, desiredMutatingWebhookConfiguration("openshift-ingress-operator", []byte("ca"))); changed {
+		t.Errorf("expected no change")
+	}
+	expected := desiredMutatingWebhookConfiguration("openshift-ingress-operator", []byte("new-ca"))
+	changed, updated := mutatingWebhookConfigurationChanged(current, expected)
+	if !changed {
+		t.Fatalf("expected a change")
+	}
+	if string(updated.Webhooks[0].ClientConfig.CABundle) != "new-ca" {
+		t.Errorf("expected webhook %s to have the new CA bundle", updated.Webhooks[0].Name)
+	}
+}
@@ -0,0 +1,49 @@
+package controller
+
+import (
+	"fmt"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// OwningOperatorNamespaceAnnotation is an annotation on an operand or
+	// canary namespace that identifies, by its namespace, the instance of
+	// the operator that manages resources in the namespace.  Two instances
+	// of the operator that use the same operand or canary namespace would
+	// overwrite each other's resources, so an instance refuses to use a
+	// namespace that another instance has claimed.
+	OwningOperatorNamespaceAnnotation = "ingress.operator.openshift.io/owning-operator-namespace"
+
+	// OperandNamespaceAnnotation is an annotation on an ingresscontroller
+	// that identifies the operand namespace of the instance of the
+	// operator that manages the ingresscontroller.  Two instances of the
+	// operator that watch the same operator namespace but use different
+	// operand namespaces would both create routers for the same
+	// ingresscontroller, so an instance refuses to manage an
+	// ingresscontroller that another instance has claimed.
+	OperandNamespaceAnnotation = "ingress.operator.openshift.io/operand-namespace"
+)
+
+// ValidateNamespaceClaim returns an error if the given namespace has been
+// claimed by an instance of the operator other than the one that runs in the
+// given operator namespace.  A namespace that no instance has claimed is valid.
+func ValidateNamespaceClaim(ns *corev1.Namespace, operatorNamespace string) error {
+	if owner, ok := ns.Annotations[OwningOperatorNamespaceAnnotation]; ok && owner != operatorNamespace {
+		return fmt.Errorf("namespace %q is managed by the ingress operator in namespace %q", ns.Name, owner)
+	}
+	return nil
+}
+
+// ValidateIngressControllerClaim returns an error if the given
+// ingresscontroller has been claimed by an instance of the operator that uses
+// an operand namespace other than the given one.  An ingresscontroller that no
+// instance has claimed is valid.
+func ValidateIngressControllerClaim(ic *operatorv1.IngressController, operandNamespace string) error {
+	if ns, ok := ic.Annotations[OperandNamespaceAnnotation]; ok && ns != operandNamespace {
+		return fmt.Errorf("ingresscontroller %s/%s is managed by the ingress operator with operand namespace %q", ic.Namespace, ic.Name, ns)
+	}
+	return nil
+}
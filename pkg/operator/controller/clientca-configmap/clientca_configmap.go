@@ -30,7 +30,7 @@ func (r *reconciler) ensureClientCAConfigMap(ctx context.Context, ic *operatorv1
 		return false, nil, err
 	}
 
-	destName := operatorcontroller.ClientCAConfigMapName(ic)
+	destName := operatorcontroller.ClientCAConfigMapName(ic, r.config.TargetNamespace)
 	have, current, err := r.currentClientCAConfigMap(ctx, destName)
 	if err != nil {
 		return false, nil, err
@@ -106,7 +106,7 @@ func New(mgr manager.Manager, config Config) (controller.Controller, error) {
 		clientCAOperatorConfigmapIndexFieldName,
 		client.IndexerFunc(func(o client.Object) []string {
 			ic := o.(*operatorv1.IngressController)
-			return []string{operatorcontroller.ClientCAConfigMapName(ic).Name}
+			return []string{operatorcontroller.ClientCAConfigMapName(ic, config.TargetNamespace).Name}
 		}),
 	); err != nil {
 		return nil, fmt.Errorf("failed to create index for operator-managed client CA configmaps: %w", err)
@@ -265,26 +265,26 @@ func namespacedName(namespace, name string) string {
 	return fmt.Sprintf("%s/%s", namespace, name)
 }
 
-func componentRouteResources(componentRoute aggregatedComponentRoute) []client.ListOption {
+func componentRouteResources(componentRoute aggregatedComponentRoute, namespace string) []client.ListOption {
 	return []client.ListOption{
 		client.MatchingLabels{
 			componentRouteHashLabelKey: componentRoute.Hash,
 		},
-		client.InNamespace(operatorcontroller.GlobalUserSpecifiedConfigNamespace),
+		client.InNamespace(namespace),
 	}
 }
 
-func allComponentRouteResources() []client.ListOption {
+func allComponentRouteResources(namespace string) []client.ListOption {
 	return []client.ListOption{
 		client.HasLabels{componentRouteHashLabelKey},
-		client.InNamespace(operatorcontroller.GlobalUserSpecifiedConfigNamespace),
+		client.InNamespace(namespace),
 	}
 }
 
 func (r *reconciler) deleteOrphanedRoles(componentRoutes []aggregatedComponentRoute, existingHashes sets.String) []error {
 	errors := []error{}
 	roleList := &rbacv1.RoleList{}
-	if err := r.cache.List(context.TODO(), roleList, allComponentRouteResources()...); err != nil {
+	if err := r.cache.List(context.TODO(), roleList, allComponentRouteResources(r.config.SecretNamespace)...); err != nil {
 		return append(errors, err)
 	}
 	for _, item := range roleList.Items {
@@ -325,7 +325,7 @@ func (r *reconciler) ensureServiceCertKeyPairSecretRole(componentRoute aggregate
 	}
 
 	roleList := &rbacv1.RoleList{}
-	if err := r.cache.List(context.TODO(), roleList, componentRouteResources(componentRoute)...); err != nil {
+	if err := r.cache.List(context.TODO(), roleList, componentRouteResources(componentRoute, r.config.SecretNamespace)...); err != nil {
 		return "", err
 	}
 
@@ -7,6 +7,7 @@ import (
 
 	logf "github.com/openshift/cluster-ingress-operator/pkg/log"
 	operatorcontroller "github.com/openshift/cluster-ingress-operator/pkg/operator/controller"
+	"github.com/openshift/cluster-ingress-operator/pkg/util/trustedca"
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -38,7 +39,20 @@ const (
 
 var log = logf.Logger.WithName(controllerName)
 
+// Config holds all the things necessary for the controller to run.
+type Config struct {
+	// OperandNamespace is the namespace in which the operator manages the
+	// client CA and CRL configmaps.
+	OperandNamespace string
+	// TrustedCABundle is the operator's trusted CA bundle, which the
+	// controller uses to verify the certificates of CRL distribution
+	// points that use TLS, and of proxies.  If nil, the controller uses the
+	// system certificate pool.
+	TrustedCABundle *trustedca.Bundle
+}
+
 type reconciler struct {
+	config Config
 	client client.Client
 	cache  cache.Cache
 }
@@ -46,9 +60,10 @@ type reconciler struct {
 // New returns a new controller that manages a certificate revocation list
 // configmap for each ingress controller that has any client CA certificates
 // that specify a CRL distribution point.
-func New(mgr manager.Manager) (controller.Controller, error) {
+func New(mgr manager.Manager, config Config) (controller.Controller, error) {
 	operatorCache := mgr.GetCache()
 	reconciler := &reconciler{
+		config: config,
 		client: mgr.GetClient(),
 		cache:  operatorCache,
 	}
@@ -69,7 +84,7 @@ func New(mgr manager.Manager) (controller.Controller, error) {
 		}
 		// Index the ingresscontroller using the name of the
 		// operator-managed client CA configmap.
-		return []string{operatorcontroller.ClientCAConfigMapName(ic).Name}
+		return []string{operatorcontroller.ClientCAConfigMapName(ic, config.OperandNamespace).Name}
 	})); err != nil {
 		return nil, fmt.Errorf("failed to create index for ingresscontroller: %w", err)
 	}
@@ -86,7 +101,7 @@ func New(mgr manager.Manager) (controller.Controller, error) {
 		}
 		// Index the ingresscontroller using the name of the
 		// operator-managed CRL configmap.
-		return []string{operatorcontroller.CRLConfigMapName(ic).Name}
+		return []string{operatorcontroller.CRLConfigMapName(ic, config.OperandNamespace).Name}
 	})); err != nil {
 		return nil, fmt.Errorf("failed to create index for ingresscontroller: %w", err)
 	}
@@ -150,7 +165,7 @@ func (r *reconciler) ingressControllersWithClientCAConfigmap(name string) ([]ope
 // configmap.
 func (r *reconciler) clientCAConfigmapToIngressController(o client.Object) []reconcile.Request {
 	requests := []reconcile.Request{}
-	if o.GetNamespace() != operatorcontroller.DefaultOperandNamespace {
+	if o.GetNamespace() != r.config.OperandNamespace {
 		return requests
 	}
 	controllers, err := r.ingressControllersWithClientCAConfigmap(o.GetName())
@@ -190,7 +205,7 @@ func (r *reconciler) ingressControllersWithCRLConfigmap(name string) ([]operator
 // configmap.
 func (r *reconciler) crlConfigmapToIngressController(o client.Object) []reconcile.Request {
 	requests := []reconcile.Request{}
-	if o.GetNamespace() != operatorcontroller.DefaultOperandNamespace {
+	if o.GetNamespace() != r.config.OperandNamespace {
 		return requests
 	}
 	controllers, err := r.ingressControllersWithCRLConfigmap(o.GetName())
@@ -215,7 +230,7 @@ func (r *reconciler) crlConfigmapToIngressController(o client.Object) []reconcil
 // ingresscontroller exists, false otherwise.
 func (r *reconciler) hasConfigmap(meta metav1.Object, o runtime.Object) bool {
 	ic := o.(*operatorv1.IngressController)
-	name := operatorcontroller.ClientCAConfigMapName(ic)
+	name := operatorcontroller.ClientCAConfigMapName(ic, r.config.OperandNamespace)
 	if len(name.Name) == 0 {
 		return false
 	}
@@ -256,7 +271,7 @@ func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	}
 
 	deployment := &appsv1.Deployment{}
-	if err := r.cache.Get(ctx, operatorcontroller.RouterDeploymentName(ic), deployment); err != nil {
+	if err := r.cache.Get(ctx, operatorcontroller.RouterDeploymentName(ic, r.config.OperandNamespace), deployment); err != nil {
 		if errors.IsNotFound(err) {
 			log.Info("deployment not found; will retry client CA CRL sync", "ingresscontroller", ic.Name)
 			return reconcile.Result{RequeueAfter: 5 * time.Second}, nil
@@ -274,7 +289,7 @@ func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	}
 
 	var haveCAConfigmap bool
-	clientCAConfigmapName := operatorcontroller.ClientCAConfigMapName(ic)
+	clientCAConfigmapName := operatorcontroller.ClientCAConfigMapName(ic, r.config.OperandNamespace)
 	clientCAConfigmap := &corev1.ConfigMap{}
 	if err := r.cache.Get(ctx, clientCAConfigmapName, clientCAConfigmap); err != nil {
 		if !errors.IsNotFound(err) {
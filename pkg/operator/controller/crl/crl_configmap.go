@@ -66,7 +66,9 @@ func (r *reconciler) ensureCRLConfigmap(ctx context.Context, ic *operatorv1.Ingr
 		}
 	}
 
-	wantCM, desired, ctx, err := desiredCRLConfigMap(ctx, ic, ownerRef, clientCAData, oldCRLs)
+	httpClient := r.httpClient()
+	defer httpClient.CloseIdleConnections()
+	wantCM, desired, ctx, err := desiredCRLConfigMap(ctx, httpClient, ic, namespace, ownerRef, clientCAData, oldCRLs)
 	if err != nil {
 		return false, nil, ctx, fmt.Errorf("failed to build configmap: %w", err)
 	}
@@ -135,7 +137,7 @@ func buildCRLMap(crlData []byte) (map[string]*pkix.CertificateList, error) {
 // indicating whether a configmap is desired, the configmap if one is desired,
 // the context (containing the next CRL update time as "nextCRLUpdate"), and an
 // error if one occurred
-func desiredCRLConfigMap(ctx context.Context, ic *operatorv1.IngressController, ownerRef metav1.OwnerReference, clientCAData []byte, crls map[string]*pkix.CertificateList) (bool, *corev1.ConfigMap, context.Context, error) {
+func desiredCRLConfigMap(ctx context.Context, httpClient *http.Client, ic *operatorv1.IngressController, namespace string, ownerRef metav1.OwnerReference, clientCAData []byte, crls map[string]*pkix.CertificateList) (bool, *corev1.ConfigMap, context.Context, error) {
 	if len(ic.Spec.ClientTLS.ClientCertificatePolicy) == 0 || len(ic.Spec.ClientTLS.ClientCA.Name) == 0 {
 		return false, nil, ctx, nil
 	}
@@ -173,7 +175,7 @@ func desiredCRLConfigMap(ctx context.Context, ic *operatorv1.IngressController,
 			}
 		}
 		log.Info("retrieving certificate revocation list", "subject key identifier", subjectKeyId)
-		if crl, err := getCRL(cert.CRLDistributionPoints); err != nil {
+		if crl, err := getCRL(httpClient, cert.CRLDistributionPoints); err != nil {
 			// Creating or updating the configmap with incomplete
 			// data would compromise security by potentially
 			// permitting revoked certificates.
@@ -208,7 +210,7 @@ func desiredCRLConfigMap(ctx context.Context, ic *operatorv1.IngressController,
 	}
 	crlData := buf.String()
 
-	crlConfigmapName := controller.CRLConfigMapName(ic)
+	crlConfigmapName := controller.CRLConfigMapName(ic, namespace)
 	crlConfigmap := corev1.ConfigMap{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      crlConfigmapName.Name,
@@ -225,7 +227,7 @@ func desiredCRLConfigMap(ctx context.Context, ic *operatorv1.IngressController,
 
 // getCRL gets a certificate revocation list using the provided distribution
 // points and returns the certificate list.
-func getCRL(distributionPoints []string) (*pkix.CertificateList, error) {
+func getCRL(httpClient *http.Client, distributionPoints []string) (*pkix.CertificateList, error) {
 	var errs []error
 	for _, distributionPoint := range distributionPoints {
 		// The distribution point is typically a URL with the "http"
@@ -239,7 +241,7 @@ func getCRL(distributionPoints []string) (*pkix.CertificateList, error) {
 		switch {
 		case strings.HasPrefix(distributionPoint, "http:"):
 			log.Info("retrieving CRL distribution point", "distribution point", distributionPoint)
-			crl, err := getHTTPCRL(distributionPoint)
+			crl, err := getHTTPCRL(httpClient, distributionPoint)
 			if err != nil {
 				errs = append(errs, fmt.Errorf("error getting %q: %w", distributionPoint, err))
 				continue
@@ -252,9 +254,10 @@ func getCRL(distributionPoints []string) (*pkix.CertificateList, error) {
 	return nil, kerrors.NewAggregate(errs)
 }
 
-// getHTTPCRL gets a certificate revocation list using the provided HTTP URL.
-func getHTTPCRL(url string) (*pkix.CertificateList, error) {
-	resp, err := http.Get(url)
+// getHTTPCRL gets a certificate revocation list using the provided HTTP client
+// and URL.
+func getHTTPCRL(httpClient *http.Client, url string) (*pkix.CertificateList, error) {
+	resp, err := httpClient.Get(url)
 	if err != nil {
 		return nil, fmt.Errorf("http.Get failed: %w", err)
 	}
@@ -270,12 +273,19 @@ func getHTTPCRL(url string) (*pkix.CertificateList, error) {
 	return crl, nil
 }
 
+// httpClient returns a new HTTP client for getting certificate revocation
+// lists.  The client uses the proxy from the environment and trusts the current
+// trusted CA bundle.
+func (r *reconciler) httpClient() *http.Client {
+	return &http.Client{Transport: r.config.TrustedCABundle.Transport()}
+}
+
 // currentCRLConfigMap returns the current CRL configmap.  Returns a Boolean
 // indicating whether the configmap existed, the configmap if it did exist, and
 // an error value.
 func (r *reconciler) currentCRLConfigMap(ctx context.Context, ic *operatorv1.IngressController) (bool, *corev1.ConfigMap, error) {
 	cm := &corev1.ConfigMap{}
-	if err := r.client.Get(ctx, controller.CRLConfigMapName(ic), cm); err != nil {
+	if err := r.client.Get(ctx, controller.CRLConfigMapName(ic, r.config.OperandNamespace), cm); err != nil {
 		if errors.IsNotFound(err) {
 			return false, nil, nil
 		}
@@ -3,14 +3,17 @@ package dns
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"os"
 	"reflect"
+	"sync"
 	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 
 	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/flowcontrol"
 
 	iov1 "github.com/openshift/api/operatoringress/v1"
 	"github.com/openshift/cluster-ingress-operator/pkg/dns"
@@ -28,6 +31,7 @@ import (
 	oputil "github.com/openshift/cluster-ingress-operator/pkg/util"
 	awsutil "github.com/openshift/cluster-ingress-operator/pkg/util/aws"
 	"github.com/openshift/cluster-ingress-operator/pkg/util/slice"
+	"github.com/openshift/cluster-ingress-operator/pkg/util/trustedca"
 
 	corev1 "k8s.io/api/core/v1"
 
@@ -63,6 +67,13 @@ const (
 	kubeCloudConfigName = "kube-cloud-config"
 	// cloudCABundleKey is the key in the kube cloud config ConfigMap where the custom CA bundle is located
 	cloudCABundleKey = "ca-bundle.pem"
+
+	// publishBackoffInitial is the initial delay before retrying to publish
+	// a record to a zone after a failure.
+	publishBackoffInitial = 30 * time.Second
+	// publishBackoffMax is the maximum delay before retrying to publish a
+	// record to a zone after repeated failures.
+	publishBackoffMax = 10 * time.Minute
 )
 
 var log = logf.Logger.WithName(controllerName)
@@ -73,6 +84,7 @@ func New(mgr manager.Manager, config Config) (runtimecontroller.Controller, erro
 		client:   mgr.GetClient(),
 		cache:    mgr.GetCache(),
 		recorder: mgr.GetEventRecorderFor(controllerName),
+		backoff:  flowcontrol.NewBackOff(publishBackoffInitial, publishBackoffMax),
 	}
 	c, err := runtimecontroller.New(controllerName, mgr, runtimecontroller.Options{Reconciler: reconciler})
 	if err != nil {
@@ -102,6 +114,24 @@ func New(mgr manager.Manager, config Config) (runtimecontroller.Controller, erro
 	}); err != nil {
 		return nil, err
 	}
+	// When the trusted CA bundle changes, reconcile all dnsrecords so that
+	// the controller recreates the DNS provider with the new bundle.  The
+	// channel is buffered, and the send is non-blocking, so that a reload
+	// does not block if the controller is not running or if a reconcile is
+	// already pending.
+	trustedCABundleChanged := make(chan event.GenericEvent, 1)
+	config.TrustedCABundle.Subscribe(func() {
+		select {
+		case trustedCABundleChanged <- event.GenericEvent{Object: &iov1.DNSRecord{}}:
+		default:
+		}
+	})
+	if err := c.Watch(&source.Channel{Source: trustedCABundleChanged}, handler.EnqueueRequestsFromMapFunc(reconciler.ToDNSRecords)); err != nil {
+		return nil, err
+	}
+	if err := mgr.Add(manager.RunnableFunc(reconciler.startZoneAudit)); err != nil {
+		return nil, err
+	}
 	return c, nil
 }
 
@@ -109,17 +139,47 @@ func New(mgr manager.Manager, config Config) (runtimecontroller.Controller, erro
 type Config struct {
 	Namespace              string
 	OperatorReleaseVersion string
+	// TrustedCABundle is the operator's trusted CA bundle, which DNS
+	// providers use to verify the cloud API's certificates.  When the
+	// bundle changes, the controller recreates the DNS provider.  If nil,
+	// DNS providers use the system certificate pool.
+	TrustedCABundle *trustedca.Bundle
 }
 
 type reconciler struct {
 	config Config
 
-	client           client.Client
-	cache            cache.Cache
+	client   client.Client
+	cache    cache.Cache
+	recorder record.EventRecorder
+
+	// backoff tracks, for each record and zone, the delay before the
+	// controller retries to publish the record to the zone after a
+	// failure.
+	backoff *flowcontrol.Backoff
+	// lastPublishFailure records, for each record and zone that is
+	// backing off, the time of the last failure to publish, so that the
+	// controller requeues when the backoff expires rather than after the
+	// full backoff delay.
+	lastPublishFailure map[string]time.Time
+
+	// providerLock protects writes to dnsProvider and infraConfig, which
+	// happen on the reconcile goroutine, and reads from other goroutines.
+	providerLock     sync.RWMutex
 	dnsProvider      dns.Provider
 	infraConfig      *configv1.Infrastructure
 	cloudCredentials *corev1.Secret
-	recorder         record.EventRecorder
+	// trustedCABundleGeneration is the generation of the trusted CA
+	// bundle with which the current provider was created.
+	trustedCABundleGeneration int64
+}
+
+// currentDNSProvider returns the current DNS provider and the infrastructure
+// config with which it was created.  It is safe to call from any goroutine.
+func (r *reconciler) currentDNSProvider() (dns.Provider, *configv1.Infrastructure) {
+	r.providerLock.RLock()
+	defer r.providerLock.RUnlock()
+	return r.dnsProvider, r.infraConfig
 }
 
 func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
@@ -148,6 +208,18 @@ func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	}
 
 	if err := r.createDNSProviderIfNeeded(dnsConfig, record); err != nil {
+		// Report the failure in the dnsrecord's status, from which the
+		// ingresscontroller's DNSReady condition is computed.
+		if record.DeletionTimestamp == nil {
+			statuses := providerErrorStatuses(dnsZones(dnsConfig), record, err)
+			if !dnsZoneStatusSlicesEqual(statuses, record.Status.Zones) {
+				updated := record.DeepCopy()
+				updated.Status.Zones = statuses
+				if err := r.client.Status().Update(ctx, updated); err != nil {
+					log.Error(err, "failed to update dnsrecord", "dnsrecord", updated)
+				}
+			}
+		}
 		return reconcile.Result{}, err
 	}
 
@@ -178,30 +250,21 @@ func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 
 	if err := r.cache.Get(ctx, types.NamespacedName{Namespace: record.Namespace, Name: ingressName}, &operatorv1.IngressController{}); err != nil {
 		if errors.IsNotFound(err) {
-			// TODO: what should we do here? something upstream could detect and delete the orphan? add new conditions?
-			// is it safe to retry without verifying the owner isn't a new object?
-			log.V(2).Info("warning: dnsrecord owner not found; the dnsrecord may be orphaned; will retry", "dnsrecord", record, "ingresscontroller", ingressName)
-			return reconcile.Result{RequeueAfter: 1 * time.Minute}, nil
+			return r.handleOrphanedRecord(ctx, record, ingressName)
 		} else {
 			log.Error(err, "failed to get ingresscontroller for dnsrecord; will retry", "dnsrecord", record, "ingresscontroller", ingressName)
 			return reconcile.Result{RequeueAfter: 15 * time.Second}, nil
 		}
 	}
 
-	var zones []configv1.DNSZone
-	if dnsConfig.Spec.PrivateZone != nil {
-		zones = append(zones, *dnsConfig.Spec.PrivateZone)
-	}
-	if dnsConfig.Spec.PublicZone != nil {
-		zones = append(zones, *dnsConfig.Spec.PublicZone)
+	if err := r.clearOrphanedAnnotation(ctx, record); err != nil {
+		return reconcile.Result{}, err
 	}
-	requeue, statuses := r.publishRecordToZones(zones, record)
+
+	requeueAfter, statuses := r.publishRecordToZones(dnsZones(dnsConfig), record)
 
 	// Requeue if publishing records failed.
-	result := reconcile.Result{}
-	if requeue {
-		result.RequeueAfter = 30 * time.Second
-	}
+	result := reconcile.Result{RequeueAfter: requeueAfter}
 
 	if !dnsZoneStatusSlicesEqual(statuses, record.Status.Zones) {
 		updated := record.DeepCopy()
@@ -218,18 +281,53 @@ func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	return result, nil
 }
 
+// dnsZones returns the zones in the given DNS config to which records are
+// published.
+func dnsZones(dnsConfig *configv1.DNS) []configv1.DNSZone {
+	var zones []configv1.DNSZone
+	if dnsConfig.Spec.PrivateZone != nil {
+		zones = append(zones, *dnsConfig.Spec.PrivateZone)
+	}
+	if dnsConfig.Spec.PublicZone != nil {
+		zones = append(zones, *dnsConfig.Spec.PublicZone)
+	}
+	return zones
+}
+
+// providerErrorStatuses returns the given record's zone statuses updated to
+// report that the record cannot be published to any of the given zones because
+// the DNS provider could not be initialized.
+func providerErrorStatuses(zones []configv1.DNSZone, record *iov1.DNSRecord, err error) []iov1.DNSZoneStatus {
+	var statuses []iov1.DNSZoneStatus
+	for _, zone := range zones {
+		statuses = append(statuses, iov1.DNSZoneStatus{
+			DNSZone: zone,
+			Conditions: []iov1.DNSZoneCondition{{
+				Type:    iov1.DNSRecordPublishedConditionType,
+				Status:  string(operatorv1.ConditionFalse),
+				Reason:  "ProviderError",
+				Message: fmt.Sprintf("The DNS provider could not be initialized: %v", err),
+			}},
+		})
+	}
+	return mergeStatuses(zones, record.Status.DeepCopy().Zones, statuses)
+}
+
 // createDNSProviderIfNeeded creates a new DNS provider if none has yet been
-// created or if the infrastructure platform status or cloud credentials have
-// changed since the current provider was created.  After creating a new
-// provider, createDNSProviderIfNeeded updates the reconciler state
-// with the new provider and current platform status and cloud credentials.
-func (r *reconciler) createDNSProviderIfNeeded(dnsConfig *configv1.DNS, record *iov1.DNSRecord) error {
+// created or if the infrastructure platform status, cloud credentials, or
+// trusted CA bundle have changed since the current provider was created.  After
+// creating a new provider, createDNSProviderIfNeeded updates the reconciler
+// state with the new provider and current platform status, cloud credentials,
+// and trusted CA bundle generation.
+func (r *reconciler) createDNSProviderIfNeeded(dnsConfig *configv1.DNS, record *iov1.DNSRecord) (err error) {
 	var needUpdate bool
 
 	if record.Spec.DNSManagementPolicy == iov1.UnmanagedDNS {
 		return nil
 	}
 
+	defer func() { SetProviderInitializedMetric(err) }()
+
 	infraConfig := &configv1.Infrastructure{}
 	if err := r.client.Get(context.TODO(), types.NamespacedName{Name: "cluster"}, infraConfig); err != nil {
 		return fmt.Errorf("failed to get infrastructure 'config': %v", err)
@@ -261,13 +359,21 @@ func (r *reconciler) createDNSProviderIfNeeded(dnsConfig *configv1.DNS, record *
 		needUpdate = true
 	}
 
+	trustedCABundleGeneration := r.config.TrustedCABundle.Generation()
+	if trustedCABundleGeneration != r.trustedCABundleGeneration {
+		needUpdate = true
+	}
+
 	if needUpdate {
 		dnsProvider, err := r.createDNSProvider(dnsConfig, platformStatus, &infraConfig.Status, creds)
 		if err != nil {
 			return fmt.Errorf("failed to create DNS provider: %v", err)
 		}
 
+		r.providerLock.Lock()
 		r.dnsProvider, r.infraConfig, r.cloudCredentials = dnsProvider, infraConfig, creds
+		r.trustedCABundleGeneration = trustedCABundleGeneration
+		r.providerLock.Unlock()
 	}
 
 	return nil
@@ -285,9 +391,10 @@ func (r *reconciler) replacePublishedRecord(zone configv1.DNSZone, record *iov1.
 
 	err := r.dnsProvider.Replace(record, zone)
 	if err != nil {
-		log.Error(err, "failed to replace DNS record in zone", "record", record.Spec, "dnszone", zone)
+		reason := dns.ReasonForError(err)
+		log.Error(err, "failed to replace DNS record in zone", "record", record.Spec, "dnszone", zone, "reason", reason)
 		condition.Status = string(operatorv1.ConditionFalse)
-		condition.Reason = "ProviderError"
+		condition.Reason = string(reason)
 		condition.Message = fmt.Sprintf("The DNS provider failed to replace the record: %v", err)
 	} else {
 		log.Info("replaced DNS record in zone", "record", record.Spec, "dnszone", zone)
@@ -311,9 +418,10 @@ func (r *reconciler) publishRecord(zone configv1.DNSZone, record *iov1.DNSRecord
 
 	err := r.dnsProvider.Ensure(record, zone)
 	if err != nil {
-		log.Error(err, "failed to publish DNS record to zone", "record", record.Spec, "dnszone", zone)
+		reason := dns.ReasonForError(err)
+		log.Error(err, "failed to publish DNS record to zone", "record", record.Spec, "dnszone", zone, "reason", reason)
 		condition.Status = string(operatorv1.ConditionFalse)
-		condition.Reason = "ProviderError"
+		condition.Reason = string(reason)
 		condition.Message = fmt.Sprintf("The DNS provider failed to ensure the record: %v", err)
 	} else {
 		log.Info("published DNS record to zone", "record", record.Spec, "dnszone", zone)
@@ -325,11 +433,17 @@ func (r *reconciler) publishRecord(zone configv1.DNSZone, record *iov1.DNSRecord
 	return condition, err
 }
 
-// publishRecordToZones attempts to publish records and returns a bool
-// indicating if we need to requeue due to errors and list of latest DNS Zone status.
-func (r *reconciler) publishRecordToZones(zones []configv1.DNSZone, record *iov1.DNSRecord) (bool, []iov1.DNSZoneStatus) {
+// publishRecordToZones attempts to publish records and returns the delay after
+// which the record should be requeued due to errors, or zero if no requeue is
+// needed, and list of latest DNS Zone status.
+func (r *reconciler) publishRecordToZones(zones []configv1.DNSZone, record *iov1.DNSRecord) (time.Duration, []iov1.DNSZoneStatus) {
 	var statuses []iov1.DNSZoneStatus
-	var requeue bool
+	var requeueAfter time.Duration
+	requeue := func(delay time.Duration) {
+		if requeueAfter == 0 || delay < requeueAfter {
+			requeueAfter = delay
+		}
+	}
 	dnsPolicy := record.Spec.DNSManagementPolicy
 	for i := range zones {
 		isRecordPublished := recordIsAlreadyPublishedToZone(record, &zones[i])
@@ -342,6 +456,16 @@ func (r *reconciler) publishRecordToZones(zones []configv1.DNSZone, record *iov1
 			continue
 		}
 
+		// Don't retry publishing to a zone until the backoff from the
+		// last failure has expired, unless the record has been
+		// modified since.
+		backoffID := publishBackoffID(record, zones[i])
+		if record.Generation == record.Status.ObservedGeneration && r.backoff.IsInBackOffSinceUpdate(backoffID, r.backoff.Clock.Now()) {
+			log.V(2).Info("skipping zone to which publishing the DNS record is backing off", "record", record.Spec, "dnszone", zones[i])
+			requeue(r.publishBackoffRemaining(backoffID))
+			continue
+		}
+
 		var err error
 		var condition iov1.DNSZoneCondition
 		if dnsPolicy == iov1.UnmanagedDNS {
@@ -360,9 +484,18 @@ func (r *reconciler) publishRecordToZones(zones []configv1.DNSZone, record *iov1
 		}
 
 		// Check if replacing or publishing record resulted in an error.
-		// If it did, re-enqueue the request for processing.
+		// If it did, back off and re-enqueue the request for processing.
 		if err != nil {
-			requeue = true
+			now := r.backoff.Clock.Now()
+			r.backoff.Next(backoffID, now)
+			if r.lastPublishFailure == nil {
+				r.lastPublishFailure = map[string]time.Time{}
+			}
+			r.lastPublishFailure[backoffID] = now
+			requeue(r.backoff.Get(backoffID))
+			IncrementPublishFailuresMetric(r.providerName(), zones[i], condition.Reason)
+		} else {
+			r.deletePublishBackoff(backoffID)
 		}
 
 		statuses = append(statuses, iov1.DNSZoneStatus{
@@ -371,7 +504,42 @@ func (r *reconciler) publishRecordToZones(zones []configv1.DNSZone, record *iov1
 		})
 	}
 
-	return requeue, mergeStatuses(zones, record.Status.DeepCopy().Zones, statuses)
+	return requeueAfter, mergeStatuses(zones, record.Status.DeepCopy().Zones, statuses)
+}
+
+// publishBackoffRemaining returns the time remaining until the backoff for the
+// given record and zone expires.
+func (r *reconciler) publishBackoffRemaining(backoffID string) time.Duration {
+	remaining := r.backoff.Get(backoffID)
+	if failed, ok := r.lastPublishFailure[backoffID]; ok {
+		remaining -= r.backoff.Clock.Since(failed)
+	}
+	if remaining < time.Second {
+		remaining = time.Second
+	}
+	return remaining
+}
+
+// deletePublishBackoff resets the backoff for the given record and zone.
+func (r *reconciler) deletePublishBackoff(backoffID string) {
+	r.backoff.DeleteEntry(backoffID)
+	delete(r.lastPublishFailure, backoffID)
+}
+
+// publishBackoffID returns the key that identifies the given record and zone
+// in the controller's publish backoff.
+func publishBackoffID(record *iov1.DNSRecord, zone configv1.DNSZone) string {
+	return fmt.Sprintf("%s/%s/%s", record.Namespace, record.Name, zoneLabel(zone))
+}
+
+// providerName returns the name of the platform of the current DNS provider,
+// for use in metrics.
+func (r *reconciler) providerName() string {
+	_, infraConfig := r.currentDNSProvider()
+	if infraConfig == nil || infraConfig.Status.PlatformStatus == nil {
+		return ""
+	}
+	return string(infraConfig.Status.PlatformStatus.Type)
 }
 
 // recordIsAlreadyPublishedToZone returns a Boolean value indicating whether the
@@ -408,6 +576,7 @@ func (r *reconciler) delete(record *iov1.DNSRecord) error {
 		} else {
 			log.Info("deleted dnsrecord from DNS provider", "record", record.Spec, "zone", zone)
 		}
+		r.deletePublishBackoff(publishBackoffID(record, zone))
 	}
 	if len(errs) == 0 {
 		updated := record.DeepCopy()
@@ -665,6 +834,14 @@ func (r *reconciler) createDNSProvider(dnsConfig *configv1.DNS, platformStatus *
 		if err != nil {
 			return nil, fmt.Errorf("failed to get the custom CA bundle: %w", err)
 		}
+		// The AWS SDK trusts only the certificates in the custom CA
+		// bundle if one is given, and otherwise the system certificate
+		// pool, which it never reloads.  Give it the operator's trusted
+		// CA bundle as well so that a recreated provider trusts the
+		// current bundle.
+		if trustedCABundle := r.config.TrustedCABundle.PEM(); len(trustedCABundle) != 0 {
+			cfg.CustomCABundle = string(trustedCABundle) + "\n" + cfg.CustomCABundle
+		}
 
 		provider, err := awsdns.NewProvider(cfg, r.config.OperatorReleaseVersion)
 		if err != nil {
@@ -684,6 +861,7 @@ func (r *reconciler) createDNSProvider(dnsConfig *configv1.DNS, platformStatus *
 			SubscriptionID: string(creds.Data["azure_subscription_id"]),
 			ARMEndpoint:    platformStatus.Azure.ARMEndpoint,
 			InfraID:        infraStatus.InfrastructureName,
+			Transport:      r.transport(),
 		}, r.config.OperatorReleaseVersion)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create Azure DNS manager: %v", err)
@@ -694,6 +872,7 @@ func (r *reconciler) createDNSProvider(dnsConfig *configv1.DNS, platformStatus *
 			Project:         platformStatus.GCP.ProjectID,
 			CredentialsJSON: creds.Data["service_account.json"],
 			UserAgent:       userAgent,
+			Transport:       r.transport(),
 		})
 		if err != nil {
 			return nil, fmt.Errorf("failed to create GCP DNS provider: %v", err)
@@ -707,12 +886,12 @@ func (r *reconciler) createDNSProvider(dnsConfig *configv1.DNS, platformStatus *
 
 		var err error
 		if platformStatus.IBMCloud.CISInstanceCRN != "" {
-			dnsProvider, err = getIbmDNSProvider(dnsConfig, creds, platformStatus.IBMCloud.CISInstanceCRN, userAgent, true)
+			dnsProvider, err = getIbmDNSProvider(dnsConfig, creds, platformStatus.IBMCloud.CISInstanceCRN, userAgent, true, r.transport())
 			if err != nil {
 				return nil, err
 			}
 		} else if platformStatus.IBMCloud.DNSInstanceCRN != "" {
-			dnsProvider, err = getIbmDNSProvider(dnsConfig, creds, platformStatus.IBMCloud.DNSInstanceCRN, userAgent, false)
+			dnsProvider, err = getIbmDNSProvider(dnsConfig, creds, platformStatus.IBMCloud.DNSInstanceCRN, userAgent, false, r.transport())
 			if err != nil {
 				return nil, err
 			}
@@ -724,12 +903,12 @@ func (r *reconciler) createDNSProvider(dnsConfig *configv1.DNS, platformStatus *
 		// Power VS platform will use the ibm dns implementation
 		var err error
 		if platformStatus.PowerVS.CISInstanceCRN != "" {
-			dnsProvider, err = getIbmDNSProvider(dnsConfig, creds, platformStatus.PowerVS.CISInstanceCRN, userAgent, true)
+			dnsProvider, err = getIbmDNSProvider(dnsConfig, creds, platformStatus.PowerVS.CISInstanceCRN, userAgent, true, r.transport())
 			if err != nil {
 				return nil, err
 			}
 		} else if platformStatus.PowerVS.DNSInstanceCRN != "" {
-			dnsProvider, err = getIbmDNSProvider(dnsConfig, creds, platformStatus.PowerVS.DNSInstanceCRN, userAgent, false)
+			dnsProvider, err = getIbmDNSProvider(dnsConfig, creds, platformStatus.PowerVS.DNSInstanceCRN, userAgent, false, r.transport())
 			if err != nil {
 				return nil, err
 			}
@@ -751,6 +930,7 @@ func (r *reconciler) createDNSProvider(dnsConfig *configv1.DNS, platformStatus *
 			Region:       platformStatus.AlibabaCloud.Region,
 			AccessKeyID:  cred.AccessKeyID,
 			AccessSecret: cred.AccessKeySecret,
+			Transport:    r.transport(),
 		})
 		if err != nil {
 			return nil, fmt.Errorf("failed to create AlibabaCloud DNS manager: %v", err)
@@ -762,6 +942,16 @@ func (r *reconciler) createDNSProvider(dnsConfig *configv1.DNS, platformStatus *
 	return dnsProvider, nil
 }
 
+// transport returns a new HTTP transport that trusts the operator's trusted CA
+// bundle, or nil, to use the SDK's default transport, if the operator has no
+// trusted CA bundle.
+func (r *reconciler) transport() http.RoundTripper {
+	if r.config.TrustedCABundle == nil {
+		return nil
+	}
+	return r.config.TrustedCABundle.Transport()
+}
+
 // customCABundle will get the custom CA bundle, if present, configured in the kube cloud config.
 func (r *reconciler) customCABundle() (string, error) {
 	cm := &corev1.ConfigMap{}
@@ -785,7 +975,7 @@ func (r *reconciler) customCABundle() (string, error) {
 }
 
 // getIbmDNSProvider initializes and returns an IBM DNS provider instance.
-func getIbmDNSProvider(dnsConfig *configv1.DNS, creds *corev1.Secret, instanceCRN, userAgent string, isPublic bool) (dns.Provider, error) {
+func getIbmDNSProvider(dnsConfig *configv1.DNS, creds *corev1.Secret, instanceCRN, userAgent string, isPublic bool, transport http.RoundTripper) (dns.Provider, error) {
 	zones := []string{}
 	if dnsConfig.Spec.PrivateZone != nil {
 		zones = append(zones, dnsConfig.Spec.PrivateZone.ID)
@@ -798,6 +988,7 @@ func getIbmDNSProvider(dnsConfig *configv1.DNS, creds *corev1.Secret, instanceCR
 		APIKey:    string(creds.Data["ibmcloud_api_key"]),
 		Zones:     zones,
 		UserAgent: userAgent,
+		Transport: transport,
 	}
 
 	if isPublic {
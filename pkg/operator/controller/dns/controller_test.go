@@ -1,7 +1,18 @@
 package dns
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -9,9 +20,12 @@ import (
 	operatorv1 "github.com/openshift/api/operator/v1"
 	iov1 "github.com/openshift/api/operatoringress/v1"
 	"github.com/openshift/cluster-ingress-operator/pkg/dns"
+	"github.com/openshift/cluster-ingress-operator/pkg/util/trustedca"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/flowcontrol"
+	utilclocktesting "k8s.io/utils/clock/testing"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
@@ -114,8 +128,8 @@ func TestPublishRecordToZones(t *testing.T) {
 			record.Spec.DNSManagementPolicy = iov1.UnmanagedDNS
 		}
 		r := &reconciler{
-			// TODO To write a fake provider that can return errors and add more test cases.
 			dnsProvider: &dns.FakeProvider{},
+			backoff:     flowcontrol.NewBackOff(publishBackoffInitial, publishBackoffMax),
 		}
 
 		_, actual := r.publishRecordToZones(test.zones, record)
@@ -126,6 +140,95 @@ func TestPublishRecordToZones(t *testing.T) {
 	}
 }
 
+// failingProvider is a dns.Provider that fails every request with the
+// configured error.
+type failingProvider struct {
+	err   error
+	calls int
+}
+
+func (p *failingProvider) Ensure(record *iov1.DNSRecord, zone configv1.DNSZone) error {
+	p.calls++
+	return p.err
+}
+func (p *failingProvider) Delete(record *iov1.DNSRecord, zone configv1.DNSZone) error {
+	p.calls++
+	return p.err
+}
+func (p *failingProvider) Replace(record *iov1.DNSRecord, zone configv1.DNSZone) error {
+	p.calls++
+	return p.err
+}
+
+// TestPublishRecordToZonesBacksOff verifies that publishRecordToZones
+// classifies provider errors into condition reasons and backs off
+// exponentially when publishing to a zone fails repeatedly.
+func TestPublishRecordToZonesBacksOff(t *testing.T) {
+	fakeClock := utilclocktesting.NewFakeClock(time.Now())
+	provider := &failingProvider{
+		err: dns.NewError(dns.ErrorReasonThrottled, fmt.Errorf("rate exceeded")),
+	}
+	r := &reconciler{
+		dnsProvider: provider,
+		backoff:     flowcontrol.NewFakeBackOff(publishBackoffInitial, publishBackoffMax, fakeClock),
+	}
+	record := &iov1.DNSRecord{
+		ObjectMeta: metav1.ObjectMeta{Name: "default-wildcard", Generation: 1},
+		Spec: iov1.DNSRecordSpec{
+			DNSName:             "*.apps.dnszone.io.",
+			RecordType:          iov1.CNAMERecordType,
+			DNSManagementPolicy: iov1.ManagedDNS,
+			Targets:             []string{"lb.example.com"},
+		},
+	}
+	zones := []configv1.DNSZone{{ID: "zone1"}}
+
+	requeueAfter, statuses := r.publishRecordToZones(zones, record)
+	if requeueAfter != publishBackoffInitial {
+		t.Fatalf("expected requeue after %s, got %s", publishBackoffInitial, requeueAfter)
+	}
+	if len(statuses) != 1 || len(statuses[0].Conditions) != 1 {
+		t.Fatalf("expected one zone status with one condition, got %#v", statuses)
+	}
+	if reason := statuses[0].Conditions[0].Reason; reason != string(dns.ErrorReasonThrottled) {
+		t.Fatalf("expected condition reason %q, got %q", dns.ErrorReasonThrottled, reason)
+	}
+	record.Status.Zones = statuses
+	record.Status.ObservedGeneration = record.Generation
+
+	// While backing off, the controller should not call the provider,
+	// and it should requeue when the backoff expires.
+	fakeClock.Step(10 * time.Second)
+	requeueAfter, _ = r.publishRecordToZones(zones, record)
+	if provider.calls != 1 {
+		t.Fatalf("expected provider to be called once while backing off, got %d calls", provider.calls)
+	}
+	if expected := publishBackoffInitial - 10*time.Second; requeueAfter != expected {
+		t.Fatalf("expected requeue after %s, got %s", expected, requeueAfter)
+	}
+
+	// After the backoff expires, a second failure should double the delay.
+	fakeClock.Step(publishBackoffInitial - 10*time.Second)
+	requeueAfter, _ = r.publishRecordToZones(zones, record)
+	if provider.calls != 2 {
+		t.Fatalf("expected provider to be called twice, got %d calls", provider.calls)
+	}
+	if requeueAfter != 2*publishBackoffInitial {
+		t.Fatalf("expected requeue after %s, got %s", 2*publishBackoffInitial, requeueAfter)
+	}
+
+	// A successful publish resets the backoff.
+	fakeClock.Step(2 * publishBackoffInitial)
+	provider.err = nil
+	requeueAfter, statuses = r.publishRecordToZones(zones, record)
+	if requeueAfter != 0 {
+		t.Fatalf("expected no requeue, got %s", requeueAfter)
+	}
+	if status := statuses[0].Conditions[0].Status; status != string(operatorv1.ConditionTrue) {
+		t.Fatalf("expected condition status True, got %s", status)
+	}
+}
+
 // TestPublishRecordToZonesMergesStatus verifies that publishRecordToZones
 // correctly merges status updates.
 func TestPublishRecordToZonesMergesStatus(t *testing.T) {
@@ -212,7 +315,10 @@ func TestPublishRecordToZonesMergesStatus(t *testing.T) {
 			},
 			Status: iov1.DNSRecordStatus{Zones: tc.oldZoneStatuses},
 		}
-		r := &reconciler{dnsProvider: &dns.FakeProvider{}}
+		r := &reconciler{
+			dnsProvider: &dns.FakeProvider{},
+			backoff:     flowcontrol.NewBackOff(publishBackoffInitial, publishBackoffMax),
+		}
 		zone := []configv1.DNSZone{{ID: "zone2"}}
 		oldStatuses := record.Status.DeepCopy().Zones
 		_, newStatuses := r.publishRecordToZones(zone, record)
@@ -827,3 +933,116 @@ func TestCustomCABundle(t *testing.T) {
 		})
 	}
 }
+
+// writeCACert writes a new self-signed PEM-encoded CA certificate to the file
+// at the given path.
+func writeCACert(t *testing.T, path string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestCreateDNSProviderIfNeededTrustedCABundle verifies that
+// createDNSProviderIfNeeded recreates the DNS provider when the trusted CA
+// bundle changes, and only then.
+func TestCreateDNSProviderIfNeededTrustedCABundle(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tls-ca-bundle.pem")
+	writeCACert(t, path)
+	bundle, err := trustedca.Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	scheme := runtime.NewScheme()
+	configv1.Install(scheme)
+	infraConfig := &configv1.Infrastructure{
+		ObjectMeta: metav1.ObjectMeta{Name: "cluster"},
+		Status: configv1.InfrastructureStatus{
+			PlatformStatus: &configv1.PlatformStatus{Type: configv1.NonePlatformType},
+		},
+	}
+	r := &reconciler{
+		config: Config{TrustedCABundle: bundle},
+		client: fake.NewFakeClientWithScheme(scheme, infraConfig),
+	}
+	dnsConfig := &configv1.DNS{}
+	record := &iov1.DNSRecord{Spec: iov1.DNSRecordSpec{DNSManagementPolicy: iov1.ManagedDNS}}
+
+	if err := r.createDNSProviderIfNeeded(dnsConfig, record); err != nil {
+		t.Fatalf("failed to create DNS provider: %v", err)
+	}
+	if r.dnsProvider == nil || r.trustedCABundleGeneration != 1 {
+		t.Fatalf("expected provider to be created with trusted CA bundle generation 1, got generation %d", r.trustedCABundleGeneration)
+	}
+
+	// The provider should not be recreated while nothing changes.
+	r.dnsProvider = nil
+	if err := r.createDNSProviderIfNeeded(dnsConfig, record); err != nil {
+		t.Fatalf("failed to create DNS provider: %v", err)
+	}
+	if r.dnsProvider != nil {
+		t.Fatalf("expected provider not to be recreated when nothing changed")
+	}
+
+	writeCACert(t, path)
+	if _, err := bundle.Reload(); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.createDNSProviderIfNeeded(dnsConfig, record); err != nil {
+		t.Fatalf("failed to create DNS provider: %v", err)
+	}
+	if r.dnsProvider == nil || r.trustedCABundleGeneration != 2 {
+		t.Fatalf("expected provider to be recreated with trusted CA bundle generation 2, got generation %d", r.trustedCABundleGeneration)
+	}
+}
+
+// TestProviderErrorStatuses verifies that providerErrorStatuses marks the
+// record as not published in every zone when the DNS provider cannot be
+// initialized.
+func TestProviderErrorStatuses(t *testing.T) {
+	zones := []configv1.DNSZone{{ID: "private"}, {ID: "public"}}
+	record := &iov1.DNSRecord{Status: iov1.DNSRecordStatus{Zones: []iov1.DNSZoneStatus{{
+		DNSZone: zones[0],
+		Conditions: []iov1.DNSZoneCondition{{
+			Type:   iov1.DNSRecordPublishedConditionType,
+			Status: string(operatorv1.ConditionTrue),
+			Reason: "ProviderSuccess",
+		}},
+	}}}}
+
+	statuses := providerErrorStatuses(zones, record, fmt.Errorf("credentials expired"))
+	if len(statuses) != len(zones) {
+		t.Fatalf("expected %d zone statuses, got %d", len(zones), len(statuses))
+	}
+	for _, status := range statuses {
+		if len(status.Conditions) != 1 {
+			t.Fatalf("expected 1 condition for zone %v, got %v", status.DNSZone, status.Conditions)
+		}
+		cond := status.Conditions[0]
+		if cond.Status != string(operatorv1.ConditionFalse) || cond.Reason != "ProviderError" {
+			t.Errorf("expected zone %v to report ProviderError, got %+v", status.DNSZone, cond)
+		}
+	}
+	if record.Status.Zones[0].Conditions[0].Status != string(operatorv1.ConditionTrue) {
+		t.Errorf("expected the record's status not to be modified")
+	}
+}
@@ -0,0 +1,92 @@
+package dns
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	configv1 "github.com/openshift/api/config/v1"
+
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+var (
+	// orphanedRecordsDeleted counts the DNSRecords that the controller has
+	// deleted because their owning ingresscontroller no longer exists.
+	orphanedRecordsDeleted = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "dns_record_orphans_deleted_total",
+		Help: "Report the number of DNS records deleted because their owning ingress controller no longer exists.",
+	})
+
+	// unmanagedRecords reports, for each zone, the number of records that
+	// the cluster owns but that no DNSRecord manages.
+	unmanagedRecords = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "dns_zone_unmanaged_records",
+		Help: "Report the number of records in a DNS zone that the cluster owns but no longer manages.",
+	}, []string{"zone"})
+
+	// publishFailures counts failures to publish a DNS record to a zone,
+	// by the classification of the failure.
+	publishFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "dns_record_publish_failures_total",
+		Help: "Report the number of failures to publish DNS records, by provider, zone, and reason.",
+	}, []string{"provider", "zone", "reason"})
+
+	// providerInitialized reports whether the controller's most recent
+	// attempt to initialize the DNS provider succeeded.  A failure to reach
+	// the cloud DNS API is reported here rather than in the operator's
+	// readiness, because a DNS outage affects every replica equally and
+	// does not make the operator's webhooks unable to serve.
+	providerInitialized = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "dns_provider_initialized",
+		Help: "Report whether the most recent attempt to initialize the DNS provider succeeded (1) or failed (0).",
+	})
+
+	// metricsList is a list of metrics for this package.
+	metricsList = []prometheus.Collector{
+		orphanedRecordsDeleted,
+		unmanagedRecords,
+		publishFailures,
+		providerInitialized,
+	}
+)
+
+// SetUnmanagedRecordsMetric updates the dns_zone_unmanaged_records metric
+// value for the given zone.
+func SetUnmanagedRecordsMetric(zone configv1.DNSZone, count int) {
+	unmanagedRecords.WithLabelValues(zoneLabel(zone)).Set(float64(count))
+}
+
+// IncrementPublishFailuresMetric increments the
+// dns_record_publish_failures_total metric for the given provider, zone, and
+// reason.
+func IncrementPublishFailuresMetric(provider string, zone configv1.DNSZone, reason string) {
+	publishFailures.WithLabelValues(provider, zoneLabel(zone), reason).Inc()
+}
+
+// SetProviderInitializedMetric updates the dns_provider_initialized metric with
+// the result of an attempt to initialize the DNS provider.
+func SetProviderInitializedMetric(err error) {
+	if err != nil {
+		providerInitialized.Set(0)
+	} else {
+		providerInitialized.Set(1)
+	}
+}
+
+// zoneLabel returns a metric label value that identifies the given zone.
+func zoneLabel(zone configv1.DNSZone) string {
+	if len(zone.ID) != 0 {
+		return zone.ID
+	}
+	return labels.Set(zone.Tags).String()
+}
+
+// RegisterMetrics calls prometheus.Register on each metric in metricsList, and
+// returns on errors.
+func RegisterMetrics() error {
+	for _, metric := range metricsList {
+		if err := prometheus.Register(metric); err != nil {
+			return err
+		}
+	}
+	return nil
+}
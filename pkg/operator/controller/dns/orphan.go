@@ -0,0 +1,212 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	configv1 "github.com/openshift/api/config/v1"
+	iov1 "github.com/openshift/api/operatoringress/v1"
+	"github.com/openshift/cluster-ingress-operator/pkg/dns"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	// orphanedSinceAnnotation is the annotation that the controller sets
+	// on a DNSRecord, with an RFC 3339 timestamp as its value, when it
+	// first observes that the ingresscontroller that owns the DNSRecord
+	// does not exist.
+	orphanedSinceAnnotation = "ingress.operator.openshift.io/orphaned-since"
+
+	// orphanGracePeriod is how long a DNSRecord must be orphaned before
+	// the controller deletes it.  The grace period gives a user who
+	// deletes and recreates an ingresscontroller time to recreate it
+	// before its DNS record is removed from the zone.
+	orphanGracePeriod = 10 * time.Minute
+
+	// zoneAuditInterval is how often the controller lists the contents of
+	// the cluster's DNS zones to look for records that the cluster owns
+	// but that no DNSRecord manages.
+	zoneAuditInterval = 30 * time.Minute
+)
+
+// handleOrphanedRecord handles a DNSRecord whose owning ingresscontroller does
+// not exist.  The first time the controller observes the orphaned record, it
+// annotates the record with the current time.  Once the record has been
+// orphaned for longer than orphanGracePeriod, the controller deletes it, which
+// causes the finalizer to remove the record from the DNS provider.
+func (r *reconciler) handleOrphanedRecord(ctx context.Context, record *iov1.DNSRecord, ingressName string) (reconcile.Result, error) {
+	now := clock.Now()
+
+	orphanedSince, ok := orphanedSinceTime(record)
+	if !ok {
+		updated := record.DeepCopy()
+		if updated.Annotations == nil {
+			updated.Annotations = map[string]string{}
+		}
+		updated.Annotations[orphanedSinceAnnotation] = now.UTC().Format(time.RFC3339)
+		if err := r.client.Update(ctx, updated); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed to annotate orphaned dnsrecord %s: %w", record.Name, err)
+		}
+		log.Info("dnsrecord owner not found; marked dnsrecord as orphaned", "dnsrecord", record.Name, "ingresscontroller", ingressName, "gracePeriod", orphanGracePeriod)
+		r.recorder.Eventf(record, "Warning", "Orphaned", "The owning ingresscontroller %q does not exist; the record will be deleted in %s unless the ingresscontroller is recreated.", ingressName, orphanGracePeriod)
+		return reconcile.Result{RequeueAfter: orphanGracePeriod}, nil
+	}
+
+	if remaining := orphanGracePeriod - now.Sub(orphanedSince); remaining > 0 {
+		log.V(2).Info("dnsrecord is orphaned; waiting for grace period to expire", "dnsrecord", record.Name, "ingresscontroller", ingressName, "remaining", remaining)
+		return reconcile.Result{RequeueAfter: remaining}, nil
+	}
+
+	if err := r.client.Delete(ctx, record); err != nil && !errors.IsNotFound(err) {
+		return reconcile.Result{}, fmt.Errorf("failed to delete orphaned dnsrecord %s: %w", record.Name, err)
+	}
+	log.Info("deleted orphaned dnsrecord", "dnsrecord", record.Name, "ingresscontroller", ingressName, "orphanedSince", orphanedSince)
+	r.recorder.Eventf(record, "Normal", "DeletedOrphan", "Deleted record because the owning ingresscontroller %q has not existed since %s.", ingressName, orphanedSince.Format(time.RFC3339))
+	orphanedRecordsDeleted.Inc()
+
+	return reconcile.Result{}, nil
+}
+
+// clearOrphanedAnnotation removes the orphaned-since annotation from the given
+// DNSRecord if it is present, which happens when the owning ingresscontroller
+// is recreated within the grace period.
+func (r *reconciler) clearOrphanedAnnotation(ctx context.Context, record *iov1.DNSRecord) error {
+	if _, ok := record.Annotations[orphanedSinceAnnotation]; !ok {
+		return nil
+	}
+	updated := record.DeepCopy()
+	delete(updated.Annotations, orphanedSinceAnnotation)
+	if err := r.client.Update(ctx, updated); err != nil {
+		return fmt.Errorf("failed to remove orphaned annotation from dnsrecord %s: %w", record.Name, err)
+	}
+	log.Info("dnsrecord owner found; dnsrecord is no longer orphaned", "dnsrecord", record.Name)
+	record.ObjectMeta = updated.ObjectMeta
+	return nil
+}
+
+// orphanedSinceTime returns the time at which the given DNSRecord was first
+// observed to be orphaned and a Boolean value indicating whether the record
+// has a valid orphaned-since annotation.
+func orphanedSinceTime(record *iov1.DNSRecord) (time.Time, bool) {
+	v, ok := record.Annotations[orphanedSinceAnnotation]
+	if !ok {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		log.Info("ignoring invalid orphaned annotation on dnsrecord", "dnsrecord", record.Name, "value", v)
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+// startZoneAudit periodically audits the cluster's DNS zones until the given
+// context is done.
+func (r *reconciler) startZoneAudit(ctx context.Context) error {
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if err := r.auditZones(ctx); err != nil {
+			log.Error(err, "failed to audit DNS zones")
+		}
+	}, zoneAuditInterval)
+	return nil
+}
+
+// auditZones lists the contents of the cluster's DNS zones and reports records
+// that the cluster owns but that no DNSRecord manages.  Such records are
+// typically left behind when a DNSRecord is deleted while the DNS provider is
+// failing or when the operator is not running.  The records are reported
+// through logs and the dns_zone_unmanaged_records metric; they are never
+// deleted automatically because a record that the operator believes it owns
+// may have been created by a user.
+func (r *reconciler) auditZones(ctx context.Context) error {
+	provider, infraConfig := r.currentDNSProvider()
+	if provider == nil {
+		log.V(2).Info("skipping DNS zone audit because the DNS provider has not been initialized")
+		return nil
+	}
+	lister, ok := provider.(dns.Lister)
+	if !ok {
+		log.V(2).Info("skipping DNS zone audit because the DNS provider does not support listing records")
+		return nil
+	}
+
+	dnsConfig := &configv1.DNS{}
+	if err := r.client.Get(ctx, types.NamespacedName{Name: "cluster"}, dnsConfig); err != nil {
+		return fmt.Errorf("failed to get dns 'cluster': %w", err)
+	}
+
+	records := &iov1.DNSRecordList{}
+	if err := r.cache.List(ctx, records, client.InNamespace(r.config.Namespace)); err != nil {
+		return fmt.Errorf("failed to list dnsrecords: %w", err)
+	}
+
+	var zones []configv1.DNSZone
+	if dnsConfig.Spec.PrivateZone != nil {
+		zones = append(zones, *dnsConfig.Spec.PrivateZone)
+	}
+	if dnsConfig.Spec.PublicZone != nil {
+		zones = append(zones, *dnsConfig.Spec.PublicZone)
+	}
+
+	for _, zone := range zones {
+		zoneRecords, err := lister.List(zone)
+		if err != nil {
+			return fmt.Errorf("failed to list records in zone %v: %w", zone, err)
+		}
+		unmanaged := unmanagedOwnedRecords(zoneRecords, records.Items, infraConfig.Status.InfrastructureName, dnsConfig.Spec.BaseDomain)
+		for _, record := range unmanaged {
+			log.Info("found record that the cluster owns but no dnsrecord manages", "dnsName", record.DNSName, "recordType", record.RecordType, "targets", record.Targets, "zone", zone)
+		}
+		SetUnmanagedRecordsMetric(zone, len(unmanaged))
+	}
+
+	return nil
+}
+
+// unmanagedOwnedRecords returns the records in zoneRecords that the cluster
+// owns and that no DNSRecord in dnsRecords manages.  A record is considered
+// owned by the cluster if the provider has tagged it with the cluster's
+// infrastructure ID, or, for providers that do not tag records, if it is a
+// wildcard record under the cluster's base domain, which is the only kind of
+// record that the operator publishes.
+func unmanagedOwnedRecords(zoneRecords []dns.Record, dnsRecords []iov1.DNSRecord, infraID, baseDomain string) []dns.Record {
+	managed := sets.NewString()
+	for _, record := range dnsRecords {
+		managed.Insert(normalizeDNSName(record.Spec.DNSName))
+	}
+
+	var unmanaged []dns.Record
+	for _, record := range zoneRecords {
+		name := normalizeDNSName(record.DNSName)
+		if managed.Has(name) {
+			continue
+		}
+		switch {
+		case len(record.OwnerID) != 0:
+			if record.OwnerID != infraID {
+				continue
+			}
+		case len(baseDomain) == 0:
+			continue
+		case !strings.HasPrefix(name, "*.") || !strings.HasSuffix(name, "."+normalizeDNSName(baseDomain)):
+			continue
+		}
+		unmanaged = append(unmanaged, record)
+	}
+	return unmanaged
+}
+
+// normalizeDNSName returns the given DNS name in lower case and without a
+// trailing dot.
+func normalizeDNSName(name string) string {
+	return strings.ToLower(strings.TrimSuffix(name, "."))
+}
@@ -0,0 +1,146 @@
+package dns
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	iov1 "github.com/openshift/api/operatoringress/v1"
+	"github.com/openshift/cluster-ingress-operator/pkg/dns"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	utilclock "k8s.io/utils/clock"
+	utilclocktesting "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+)
+
+func TestHandleOrphanedRecord(t *testing.T) {
+	start := time.Date(2022, 10, 1, 0, 0, 0, 0, time.UTC)
+	fakeClock := utilclocktesting.NewFakeClock(start)
+	clock = fakeClock
+	defer func() {
+		clock = utilclock.RealClock{}
+	}()
+
+	scheme := runtime.NewScheme()
+	iov1.AddToScheme(scheme)
+	dnsRecord := &iov1.DNSRecord{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "openshift-ingress-operator",
+			Name:      "default-wildcard",
+		},
+	}
+	cl := fake.NewClientBuilder().WithScheme(scheme).WithObjects(dnsRecord).Build()
+	r := &reconciler{client: cl, recorder: record.NewFakeRecorder(10)}
+	name := types.NamespacedName{Namespace: dnsRecord.Namespace, Name: dnsRecord.Name}
+
+	get := func() *iov1.DNSRecord {
+		current := &iov1.DNSRecord{}
+		if err := cl.Get(context.Background(), name, current); err != nil {
+			t.Fatalf("failed to get dnsrecord: %v", err)
+		}
+		return current
+	}
+
+	// The first reconcile should mark the record as orphaned.
+	result, err := r.handleOrphanedRecord(context.Background(), get(), "default")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.RequeueAfter != orphanGracePeriod {
+		t.Errorf("expected requeue after %s, got %s", orphanGracePeriod, result.RequeueAfter)
+	}
+	if since, ok := orphanedSinceTime(get()); !ok || !since.Equal(start) {
+		t.Fatalf("expected dnsrecord to be marked orphaned since %s, got %s (valid=%t)", start, since, ok)
+	}
+
+	// Before the grace period expires, the record should be kept.
+	fakeClock.Step(orphanGracePeriod / 2)
+	result, err = r.handleOrphanedRecord(context.Background(), get(), "default")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.RequeueAfter != orphanGracePeriod/2 {
+		t.Errorf("expected requeue after %s, got %s", orphanGracePeriod/2, result.RequeueAfter)
+	}
+
+	// Recreating the owner clears the annotation.
+	current := get()
+	if err := r.clearOrphanedAnnotation(context.Background(), current); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := orphanedSinceTime(get()); ok {
+		t.Fatal("expected orphaned annotation to be removed")
+	}
+
+	// Once the owner is gone again for longer than the grace period, the
+	// record should be deleted.
+	if _, err := r.handleOrphanedRecord(context.Background(), get(), "default"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	fakeClock.Step(orphanGracePeriod)
+	if _, err := r.handleOrphanedRecord(context.Background(), get(), "default"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := cl.Get(context.Background(), name, &iov1.DNSRecord{}); !errors.IsNotFound(err) {
+		t.Fatalf("expected dnsrecord to be deleted, got %v", err)
+	}
+}
+
+func TestUnmanagedOwnedRecords(t *testing.T) {
+	dnsRecords := []iov1.DNSRecord{{
+		Spec: iov1.DNSRecordSpec{DNSName: "*.apps.mycluster.example.com."},
+	}}
+	tests := []struct {
+		name        string
+		zoneRecords []dns.Record
+		expect      []dns.Record
+	}{
+		{
+			name: "managed wildcard record",
+			zoneRecords: []dns.Record{
+				{DNSName: "*.apps.mycluster.example.com.", RecordType: iov1.CNAMERecordType},
+			},
+		},
+		{
+			name: "unmanaged wildcard record under the base domain",
+			zoneRecords: []dns.Record{
+				{DNSName: "*.apps.mycluster.example.com.", RecordType: iov1.CNAMERecordType},
+				{DNSName: "*.shard.mycluster.example.com.", RecordType: iov1.CNAMERecordType},
+			},
+			expect: []dns.Record{
+				{DNSName: "*.shard.mycluster.example.com.", RecordType: iov1.CNAMERecordType},
+			},
+		},
+		{
+			name: "unmanaged non-wildcard and foreign records",
+			zoneRecords: []dns.Record{
+				{DNSName: "api.mycluster.example.com.", RecordType: iov1.ARecordType},
+				{DNSName: "*.apps.othercluster.example.org.", RecordType: iov1.ARecordType},
+			},
+		},
+		{
+			name: "records tagged with an infrastructure ID",
+			zoneRecords: []dns.Record{
+				{DNSName: "*.shard.mycluster.example.com.", RecordType: iov1.ARecordType, OwnerID: "othercluster-abcde"},
+				{DNSName: "foo.mycluster.example.com.", RecordType: iov1.ARecordType, OwnerID: "mycluster-xyz12"},
+			},
+			expect: []dns.Record{
+				{DNSName: "foo.mycluster.example.com.", RecordType: iov1.ARecordType, OwnerID: "mycluster-xyz12"},
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := unmanagedOwnedRecords(tc.zoneRecords, dnsRecords, "mycluster-xyz12", "mycluster.example.com")
+			if diff := cmp.Diff(tc.expect, actual); len(diff) != 0 {
+				t.Errorf("unexpected result (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package dns
+
+import (
+	"fmt"
+	"net"
+
+	iov1 "github.com/openshift/api/operatoringress/v1"
+
+	"github.com/openshift/cluster-ingress-operator/pkg/manifests"
+)
+
+// ValidateDNSRecord returns the reasons for which the DNS providers would fail
+// to publish the given dnsrecord, as well as warnings about settings for which
+// the controller ignores the dnsrecord.  The API server's schema validation
+// already checks that the spec's required fields are set and that the record
+// type is valid.
+func ValidateDNSRecord(record *iov1.DNSRecord) ([]error, []string) {
+	var (
+		errs     []error
+		warnings []string
+	)
+
+	switch record.Spec.RecordType {
+	case iov1.ARecordType:
+		for i, target := range record.Spec.Targets {
+			if net.ParseIP(target) == nil {
+				errs = append(errs, fmt.Errorf("spec.targets[%d]: %q is not an IP address, which an A record requires", i, target))
+			}
+		}
+	case iov1.CNAMERecordType:
+		if len(record.Spec.Targets) != 1 {
+			errs = append(errs, fmt.Errorf("spec.targets: a CNAME record must have exactly 1 target, found %d", len(record.Spec.Targets)))
+		}
+		for i, target := range record.Spec.Targets {
+			if net.ParseIP(target) != nil {
+				errs = append(errs, fmt.Errorf("spec.targets[%d]: %q is an IP address, which a CNAME record cannot target", i, target))
+			}
+		}
+	}
+
+	if _, ok := record.Labels[manifests.OwningIngressControllerLabel]; !ok {
+		warnings = append(warnings, fmt.Sprintf("dnsrecord does not have the %s label, so the operator ignores it", manifests.OwningIngressControllerLabel))
+	}
+	if record.Spec.RecordTTL == 0 {
+		warnings = append(warnings, "spec.recordTTL is 0, so the operator ignores the dnsrecord until the TTL is set")
+	}
+
+	return errs, warnings
+}
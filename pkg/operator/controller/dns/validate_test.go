@@ -0,0 +1,86 @@
+package dns
+
+import (
+	"testing"
+
+	iov1 "github.com/openshift/api/operatoringress/v1"
+
+	"github.com/openshift/cluster-ingress-operator/pkg/manifests"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TestValidateDNSRecord verifies that ValidateDNSRecord rejects dnsrecords that
+// the DNS providers cannot publish and warns about dnsrecords that the
+// controller ignores.
+func TestValidateDNSRecord(t *testing.T) {
+	record := func(recordType iov1.DNSRecordType, ttl int64, targets ...string) *iov1.DNSRecord {
+		return &iov1.DNSRecord{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "default-wildcard",
+				Labels: map[string]string{manifests.OwningIngressControllerLabel: "default"},
+			},
+			Spec: iov1.DNSRecordSpec{
+				DNSName:    "*.apps.example.com.",
+				RecordType: recordType,
+				RecordTTL:  ttl,
+				Targets:    targets,
+			},
+		}
+	}
+	testCases := []struct {
+		name             string
+		record           *iov1.DNSRecord
+		expectedErrors   int
+		expectedWarnings int
+	}{
+		{
+			name:   "valid A record",
+			record: record(iov1.ARecordType, 30, "192.0.2.1", "2001:db8::1"),
+		},
+		{
+			name:   "valid CNAME record",
+			record: record(iov1.CNAMERecordType, 30, "lb.example.com"),
+		},
+		{
+			name:           "A record with a hostname",
+			record:         record(iov1.ARecordType, 30, "192.0.2.1", "lb.example.com"),
+			expectedErrors: 1,
+		},
+		{
+			name:           "CNAME record with multiple targets",
+			record:         record(iov1.CNAMERecordType, 30, "lb1.example.com", "lb2.example.com"),
+			expectedErrors: 1,
+		},
+		{
+			name:           "CNAME record with an IP address",
+			record:         record(iov1.CNAMERecordType, 30, "192.0.2.1"),
+			expectedErrors: 1,
+		},
+		{
+			name:             "zero TTL",
+			record:           record(iov1.CNAMERecordType, 0, "lb.example.com"),
+			expectedWarnings: 1,
+		},
+		{
+			name: "missing owner label",
+			record: func() *iov1.DNSRecord {
+				r := record(iov1.CNAMERecordType, 30, "lb.example.com")
+				r.Labels = nil
+				return r
+			}(),
+			expectedWarnings: 1,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			errs, warnings := ValidateDNSRecord(tc.record)
+			if len(errs) != tc.expectedErrors {
+				t.Errorf("expected %d errors, got %v", tc.expectedErrors, errs)
+			}
+			if len(warnings) != tc.expectedWarnings {
+				t.Errorf("expected %d warnings, got %v", tc.expectedWarnings, warnings)
+			}
+		})
+	}
+}
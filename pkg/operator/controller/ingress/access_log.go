@@ -0,0 +1,262 @@
+package ingress
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// jsonAccessLogFormat is the access log format that logs each request
+	// as a JSON object; see jsonHTTPLogFormat.
+	jsonAccessLogFormat = "JSON"
+
+	// jsonAccessLogSchemaVersion is the version of the field schema of
+	// JSON access logs.  It must be incremented whenever a field is
+	// renamed or removed or its type changes.
+	jsonAccessLogSchemaVersion = 1
+
+	// otlpGRPCProtocol is the OTLP protocol that exports using gRPC.
+	otlpGRPCProtocol = "grpc"
+	// otlpHTTPProtocol is the OTLP protocol that exports using
+	// HTTP/protobuf.
+	otlpHTTPProtocol = "http"
+
+	// otelCollectorConfigKey is the key in the access-logging configmap of
+	// the OpenTelemetry collector's configuration file.
+	otelCollectorConfigKey = "otel-collector.yaml"
+	// otelCollectorSyslogAddress is the address on which the OpenTelemetry
+	// collector sidecar receives access logs from the router.  The
+	// containers share the pod's network namespace.
+	otelCollectorSyslogAddress = "127.0.0.1:1514"
+)
+
+// accessLogOptions holds the access logging parameters that an
+// ingresscontroller can specify using the accessLogging unsupported config
+// override.  These parameters supplement spec.logging.access.
+type accessLogOptions struct {
+	// Format is either empty, in which case the router uses
+	// spec.logging.access.httpLogFormat or its default format, or
+	// jsonAccessLogFormat.
+	Format string `json:"format"`
+	// OTLP, if specified, configures the logging sidecar to export access
+	// logs to an OpenTelemetry endpoint instead of writing them to its
+	// standard output.  It requires the Container destination type.
+	OTLP *otlpDestination `json:"otlp"`
+}
+
+// otlpDestination specifies an OpenTelemetry endpoint for access logs or
+// traces.
+type otlpDestination struct {
+	// Endpoint is a host:port address for otlpGRPCProtocol or an http or
+	// https URL for otlpHTTPProtocol.
+	Endpoint string `json:"endpoint"`
+	// Protocol is otlpGRPCProtocol or otlpHTTPProtocol.  The default is
+	// otlpGRPCProtocol.
+	Protocol string `json:"protocol"`
+	// Insecure disables TLS for otlpGRPCProtocol.
+	Insecure bool `json:"insecure"`
+}
+
+// accessLogOptionsForIngressController returns the access logging options for
+// the given ingresscontroller, with defaults applied, or nil if it does not
+// specify any.  An error is returned if the options cannot be parsed or are
+// invalid.
+func accessLogOptionsForIngressController(ic *operatorv1.IngressController) (*accessLogOptions, error) {
+	if len(ic.Spec.UnsupportedConfigOverrides.Raw) == 0 {
+		return nil, nil
+	}
+	var unsupportedConfigOverrides struct {
+		AccessLogging *accessLogOptions `json:"accessLogging"`
+	}
+	if err := json.Unmarshal(ic.Spec.UnsupportedConfigOverrides.Raw, &unsupportedConfigOverrides); err != nil {
+		return nil, fmt.Errorf("ingresscontroller %q has invalid spec.unsupportedConfigOverrides: %w", ic.Name, err)
+	}
+	options := unsupportedConfigOverrides.AccessLogging
+	if options == nil {
+		return nil, nil
+	}
+
+	accessLogging := accessLoggingForIngressController(ic)
+	if accessLogging == nil {
+		return nil, fmt.Errorf("spec.unsupportedConfigOverrides.accessLogging requires spec.logging.access to be specified")
+	}
+	switch options.Format {
+	case "":
+	case jsonAccessLogFormat:
+		if len(accessLogging.HttpLogFormat) != 0 {
+			return nil, fmt.Errorf("spec.unsupportedConfigOverrides.accessLogging.format %q may not be used with spec.logging.access.httpLogFormat", jsonAccessLogFormat)
+		}
+	default:
+		return nil, fmt.Errorf("spec.unsupportedConfigOverrides.accessLogging.format must be empty or %q, got %q", jsonAccessLogFormat, options.Format)
+	}
+	if otlp := options.OTLP; otlp != nil {
+		if accessLogging.Destination.Type != operatorv1.ContainerLoggingDestinationType {
+			return nil, fmt.Errorf("spec.unsupportedConfigOverrides.accessLogging.otlp requires spec.logging.access.destination.type to be %q", operatorv1.ContainerLoggingDestinationType)
+		}
+		if err := validateOTLPDestination("spec.unsupportedConfigOverrides.accessLogging.otlp", otlp); err != nil {
+			return nil, err
+		}
+	}
+
+	return options, nil
+}
+
+// validateOTLPDestination applies the default protocol to the given OTLP
+// destination and validates it.  Errors are prefixed with the given field path.
+func validateOTLPDestination(path string, otlp *otlpDestination) error {
+	switch otlp.Protocol {
+	case "":
+		otlp.Protocol = otlpGRPCProtocol
+		fallthrough
+	case otlpGRPCProtocol:
+		if _, _, err := net.SplitHostPort(otlp.Endpoint); err != nil {
+			return fmt.Errorf("%s.endpoint must be a host:port address for protocol %q: %w", path, otlpGRPCProtocol, err)
+		}
+	case otlpHTTPProtocol:
+		u, err := url.Parse(otlp.Endpoint)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || len(u.Host) == 0 {
+			return fmt.Errorf("%s.endpoint must be an http or https URL for protocol %q, got %q", path, otlpHTTPProtocol, otlp.Endpoint)
+		}
+		if otlp.Insecure {
+			return fmt.Errorf("%s.insecure may only be used with protocol %q", path, otlpGRPCProtocol)
+		}
+	default:
+		return fmt.Errorf("%s.protocol must be %q or %q, got %q", path, otlpGRPCProtocol, otlpHTTPProtocol, otlp.Protocol)
+	}
+	return nil
+}
+
+// jsonHTTPLogFormat returns an HAProxy log format that logs each request as a
+// JSON object with a stable set of fields, including the headers and cookie
+// that the given access logging configuration captures.  String values that
+// come from the request or response are escaped using the json converter;
+// numeric values are logged as JSON numbers.
+func jsonHTTPLogFormat(accessLogging *operatorv1.AccessLogging) string {
+	fields := []string{
+		fmt.Sprintf(`"schemaVersion":%d`, jsonAccessLogSchemaVersion),
+		`"timestamp":"%t"`,
+		`"clientIP":"%ci"`,
+		`"clientPort":%cp`,
+		`"frontend":"%ft"`,
+		`"backend":"%b"`,
+		`"server":"%s"`,
+		`"method":"%[capture.req.method,json(utf8s)]"`,
+		`"uri":"%[capture.req.uri,json(utf8s)]"`,
+		`"httpVersion":"%[capture.req.ver,json(utf8s)]"`,
+		`"statusCode":%ST`,
+		`"bytesRead":%B`,
+		`"bytesUploaded":%U`,
+		`"requestTime":%TR`,
+		`"queueTime":%Tw`,
+		`"connectTime":%Tc`,
+		`"responseTime":%Tr`,
+		`"activeTime":%Ta`,
+		`"totalTime":%Tt`,
+		`"terminationState":"%tsc"`,
+		`"activeConnections":%ac`,
+		`"frontendConnections":%fc`,
+		`"backendConnections":%bc`,
+		`"serverConnections":%sc`,
+		`"retries":"%rc"`,
+		`"serverQueue":%sq`,
+		`"backendQueue":%bq`,
+		`"requestHeaders":` + jsonCapturedHeaders("capture.req.hdr", accessLogging.HTTPCaptureHeaders.Request),
+		`"responseHeaders":` + jsonCapturedHeaders("capture.res.hdr", accessLogging.HTTPCaptureHeaders.Response),
+	}
+	if len(accessLogging.HTTPCaptureCookies) != 0 {
+		// Cookie values cannot contain quotation marks or backslashes,
+		// so the captured cookie does not need to be escaped.
+		fields = append(fields, `"cookie":"%CC"`)
+	}
+	return "{" + strings.Join(fields, ",") + "}"
+}
+
+// jsonCapturedHeaders returns a log format for a JSON object that maps each of
+// the given captured headers to its value, using the given sample fetch to get
+// the captured value by index.  The router declares captures in the same order
+// as the headers are specified.
+func jsonCapturedHeaders(fetch string, headers []operatorv1.IngressControllerCaptureHTTPHeader) string {
+	items := make([]string, 0, len(headers))
+	for i, header := range headers {
+		items = append(items, fmt.Sprintf(`"%s":"%%[%s(%d),json(utf8s)]"`, header.Name, fetch, i))
+	}
+	return "{" + strings.Join(items, ",") + "}"
+}
+
+// desiredOTelCollectorConfig returns the configuration file for the
+// OpenTelemetry collector sidecar, which receives access logs from the router
+// using syslog and exports them to the given OTLP destination.  If the access
+// logs are JSON, the collector parses each log message into attributes.
+func desiredOTelCollectorConfig(ic *operatorv1.IngressController, options *accessLogOptions) (string, error) {
+	syslogReceiver := map[string]interface{}{
+		"udp": map[string]interface{}{
+			"listen_address": otelCollectorSyslogAddress,
+		},
+		"protocol": "rfc3164",
+		"location": "UTC",
+	}
+	if options.Format == jsonAccessLogFormat {
+		syslogReceiver["operators"] = []interface{}{
+			map[string]interface{}{
+				"type":       "json_parser",
+				"parse_from": "attributes.message",
+				"parse_to":   "attributes.http",
+			},
+		}
+	}
+
+	exporterName := "otlp"
+	exporter := map[string]interface{}{
+		"endpoint": options.OTLP.Endpoint,
+	}
+	switch options.OTLP.Protocol {
+	case otlpHTTPProtocol:
+		exporterName = "otlphttp"
+	default:
+		exporter["tls"] = map[string]interface{}{
+			"insecure": options.OTLP.Insecure,
+		}
+	}
+
+	config := map[string]interface{}{
+		"receivers": map[string]interface{}{
+			"syslog": syslogReceiver,
+		},
+		"processors": map[string]interface{}{
+			"batch": map[string]interface{}{},
+			"resource": map[string]interface{}{
+				"attributes": []interface{}{
+					map[string]interface{}{
+						"key":    "openshift.ingresscontroller.name",
+						"value":  ic.Name,
+						"action": "upsert",
+					},
+				},
+			},
+		},
+		"exporters": map[string]interface{}{
+			exporterName: exporter,
+		},
+		"service": map[string]interface{}{
+			"pipelines": map[string]interface{}{
+				"logs": map[string]interface{}{
+					"receivers":  []string{"syslog"},
+					"processors": []string{"resource", "batch"},
+					"exporters":  []string{exporterName},
+				},
+			},
+		},
+	}
+	data, err := yaml.Marshal(config)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
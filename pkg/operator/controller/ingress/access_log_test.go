@@ -0,0 +1,384 @@
+package ingress
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	corev1 "k8s.io/api/core/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"sigs.k8s.io/yaml"
+)
+
+// newAccessLoggingIngressController returns an ingresscontroller with access
+// logging to the given destination type and with the given unsupported config
+// overrides.
+func newAccessLoggingIngressController(destinationType operatorv1.LoggingDestinationType, overrides string) *operatorv1.IngressController {
+	ic := &operatorv1.IngressController{
+		ObjectMeta: metav1.ObjectMeta{Name: "default"},
+		Spec: operatorv1.IngressControllerSpec{
+			Logging: &operatorv1.IngressControllerLogging{
+				Access: &operatorv1.AccessLogging{
+					Destination: operatorv1.LoggingDestination{Type: destinationType},
+				},
+			},
+		},
+	}
+	switch destinationType {
+	case operatorv1.ContainerLoggingDestinationType:
+		ic.Spec.Logging.Access.Destination.Container = &operatorv1.ContainerLoggingDestinationParameters{}
+	case operatorv1.SyslogLoggingDestinationType:
+		ic.Spec.Logging.Access.Destination.Syslog = &operatorv1.SyslogLoggingDestinationParameters{
+			Address: "1.2.3.4",
+			Port:    514,
+		}
+	}
+	if len(overrides) != 0 {
+		ic.Spec.UnsupportedConfigOverrides = runtime.RawExtension{Raw: []byte(overrides)}
+	}
+	return ic
+}
+
+// TestAccessLogOptionsForIngressController verifies that
+// accessLogOptionsForIngressController applies defaults and rejects invalid
+// access logging options.
+func TestAccessLogOptionsForIngressController(t *testing.T) {
+	testCases := []struct {
+		description     string
+		destinationType operatorv1.LoggingDestinationType
+		httpLogFormat   string
+		noAccessLogging bool
+		overrides       string
+		expect          *accessLogOptions
+		expectError     bool
+	}{
+		{
+			description:     "no overrides",
+			destinationType: operatorv1.ContainerLoggingDestinationType,
+			expect:          nil,
+		},
+		{
+			description:     "JSON format to syslog",
+			destinationType: operatorv1.SyslogLoggingDestinationType,
+			overrides:       `{"accessLogging":{"format":"JSON"}}`,
+			expect:          &accessLogOptions{Format: jsonAccessLogFormat},
+		},
+		{
+			description:     "OTLP with default protocol",
+			destinationType: operatorv1.ContainerLoggingDestinationType,
+			overrides:       `{"accessLogging":{"format":"JSON","otlp":{"endpoint":"collector.example.com:4317"}}}`,
+			expect: &accessLogOptions{
+				Format: jsonAccessLogFormat,
+				OTLP:   &otlpDestination{Endpoint: "collector.example.com:4317", Protocol: otlpGRPCProtocol},
+			},
+		},
+		{
+			description:     "OTLP over HTTP",
+			destinationType: operatorv1.ContainerLoggingDestinationType,
+			overrides:       `{"accessLogging":{"otlp":{"endpoint":"https://collector.example.com:4318","protocol":"http"}}}`,
+			expect: &accessLogOptions{
+				OTLP: &otlpDestination{Endpoint: "https://collector.example.com:4318", Protocol: otlpHTTPProtocol},
+			},
+		},
+		{
+			description:     "access logging not enabled",
+			noAccessLogging: true,
+			overrides:       `{"accessLogging":{"format":"JSON"}}`,
+			expectError:     true,
+		},
+		{
+			description:     "invalid format",
+			destinationType: operatorv1.ContainerLoggingDestinationType,
+			overrides:       `{"accessLogging":{"format":"XML"}}`,
+			expectError:     true,
+		},
+		{
+			description:     "JSON format with httpLogFormat",
+			destinationType: operatorv1.ContainerLoggingDestinationType,
+			httpLogFormat:   "%ci:%cp",
+			overrides:       `{"accessLogging":{"format":"JSON"}}`,
+			expectError:     true,
+		},
+		{
+			description:     "OTLP with syslog destination",
+			destinationType: operatorv1.SyslogLoggingDestinationType,
+			overrides:       `{"accessLogging":{"otlp":{"endpoint":"collector.example.com:4317"}}}`,
+			expectError:     true,
+		},
+		{
+			description:     "OTLP gRPC endpoint without port",
+			destinationType: operatorv1.ContainerLoggingDestinationType,
+			overrides:       `{"accessLogging":{"otlp":{"endpoint":"collector.example.com"}}}`,
+			expectError:     true,
+		},
+		{
+			description:     "OTLP HTTP endpoint that is not a URL",
+			destinationType: operatorv1.ContainerLoggingDestinationType,
+			overrides:       `{"accessLogging":{"otlp":{"endpoint":"collector.example.com:4318","protocol":"http"}}}`,
+			expectError:     true,
+		},
+		{
+			description:     "OTLP HTTP with insecure",
+			destinationType: operatorv1.ContainerLoggingDestinationType,
+			overrides:       `{"accessLogging":{"otlp":{"endpoint":"http://collector.example.com:4318","protocol":"http","insecure":true}}}`,
+			expectError:     true,
+		},
+		{
+			description:     "OTLP with invalid protocol",
+			destinationType: operatorv1.ContainerLoggingDestinationType,
+			overrides:       `{"accessLogging":{"otlp":{"endpoint":"collector.example.com:4317","protocol":"udp"}}}`,
+			expectError:     true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			ic := newAccessLoggingIngressController(tc.destinationType, tc.overrides)
+			if tc.noAccessLogging {
+				ic.Spec.Logging = nil
+			} else {
+				ic.Spec.Logging.Access.HttpLogFormat = tc.httpLogFormat
+			}
+			actual, err := accessLogOptionsForIngressController(ic)
+			switch {
+			case tc.expectError && err == nil:
+				t.Fatalf("expected error, got %+v", actual)
+			case !tc.expectError && err != nil:
+				t.Fatalf("unexpected error: %v", err)
+			case !tc.expectError && !reflect.DeepEqual(tc.expect, actual):
+				t.Errorf("expected %+v, got %+v", tc.expect, actual)
+			}
+		})
+	}
+}
+
+// TestJSONHTTPLogFormat verifies that jsonHTTPLogFormat produces valid JSON
+// with the captured headers and cookie once HAProxy substitutes the log format
+// variables.
+func TestJSONHTTPLogFormat(t *testing.T) {
+	accessLogging := &operatorv1.AccessLogging{
+		HTTPCaptureHeaders: operatorv1.IngressControllerCaptureHTTPHeaders{
+			Request: []operatorv1.IngressControllerCaptureHTTPHeader{
+				{Name: "Host", MaxLength: 90},
+				{Name: "User-Agent", MaxLength: 128},
+			},
+			Response: []operatorv1.IngressControllerCaptureHTTPHeader{
+				{Name: "Content-Type", MaxLength: 64},
+			},
+		},
+		HTTPCaptureCookies: []operatorv1.IngressControllerCaptureHTTPCookie{{
+			IngressControllerCaptureHTTPCookieUnion: operatorv1.IngressControllerCaptureHTTPCookieUnion{
+				MatchType: operatorv1.CookieMatchTypeExact,
+				Name:      "session",
+			},
+			MaxLength: 256,
+		}},
+	}
+	format := jsonHTTPLogFormat(accessLogging)
+
+	for _, expected := range []string{
+		`"requestHeaders":{"Host":"%[capture.req.hdr(0),json(utf8s)]","User-Agent":"%[capture.req.hdr(1),json(utf8s)]"}`,
+		`"responseHeaders":{"Content-Type":"%[capture.res.hdr(0),json(utf8s)]"}`,
+		`"cookie":"%CC"`,
+	} {
+		if !strings.Contains(format, expected) {
+			t.Errorf("expected log format to contain %s, got %s", expected, format)
+		}
+	}
+
+	// Simulate HAProxy's substitution: sample fetches become escaped
+	// strings, and log variables become numbers, which are valid both
+	// inside and outside quotation marks.
+	sampleFetch := regexp.MustCompile(`%\[[^]]*\]`)
+	logVariable := regexp.MustCompile(`%[A-Za-z]+`)
+	line := sampleFetch.ReplaceAllString(format, `GET \"quoted\"`)
+	line = logVariable.ReplaceAllString(line, "200")
+	var parsed map[string]interface{}
+	if err := json.Unmarshal([]byte(line), &parsed); err != nil {
+		t.Fatalf("expected log line to be valid JSON: %v\nlog line: %s", err, line)
+	}
+	if parsed["schemaVersion"] != float64(jsonAccessLogSchemaVersion) {
+		t.Errorf("expected schemaVersion %d, got %v", jsonAccessLogSchemaVersion, parsed["schemaVersion"])
+	}
+
+	// Without captures, the header objects are empty and there is no
+	// cookie.
+	format = jsonHTTPLogFormat(&operatorv1.AccessLogging{})
+	if !strings.Contains(format, `"requestHeaders":{}`) || strings.Contains(format, `"cookie"`) {
+		t.Errorf("unexpected log format without captures: %s", format)
+	}
+}
+
+// TestDesiredOTelCollectorConfig verifies that desiredOTelCollectorConfig
+// configures the expected exporter and parses JSON access logs.
+func TestDesiredOTelCollectorConfig(t *testing.T) {
+	testCases := []struct {
+		description    string
+		options        *accessLogOptions
+		expectExporter string
+		expectParser   bool
+	}{
+		{
+			description: "gRPC",
+			options: &accessLogOptions{
+				OTLP: &otlpDestination{Endpoint: "collector.example.com:4317", Protocol: otlpGRPCProtocol, Insecure: true},
+			},
+			expectExporter: "otlp",
+		},
+		{
+			description: "HTTP with JSON access logs",
+			options: &accessLogOptions{
+				Format: jsonAccessLogFormat,
+				OTLP:   &otlpDestination{Endpoint: "https://collector.example.com:4318", Protocol: otlpHTTPProtocol},
+			},
+			expectExporter: "otlphttp",
+			expectParser:   true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			ic := newAccessLoggingIngressController(operatorv1.ContainerLoggingDestinationType, "")
+			data, err := desiredOTelCollectorConfig(ic, tc.options)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var config struct {
+				Receivers struct {
+					Syslog struct {
+						UDP struct {
+							ListenAddress string `json:"listen_address"`
+						} `json:"udp"`
+						Operators []map[string]string `json:"operators"`
+					} `json:"syslog"`
+				} `json:"receivers"`
+				Exporters map[string]struct {
+					Endpoint string `json:"endpoint"`
+				} `json:"exporters"`
+				Service struct {
+					Pipelines struct {
+						Logs struct {
+							Exporters []string `json:"exporters"`
+						} `json:"logs"`
+					} `json:"pipelines"`
+				} `json:"service"`
+			}
+			if err := yaml.Unmarshal([]byte(data), &config); err != nil {
+				t.Fatalf("failed to parse collector config: %v\n%s", err, data)
+			}
+			if config.Receivers.Syslog.UDP.ListenAddress != otelCollectorSyslogAddress {
+				t.Errorf("expected syslog receiver on %s, got %q", otelCollectorSyslogAddress, config.Receivers.Syslog.UDP.ListenAddress)
+			}
+			if exporter, ok := config.Exporters[tc.expectExporter]; !ok || exporter.Endpoint != tc.options.OTLP.Endpoint {
+				t.Errorf("expected exporter %s with endpoint %s, got %+v", tc.expectExporter, tc.options.OTLP.Endpoint, config.Exporters)
+			}
+			if !reflect.DeepEqual(config.Service.Pipelines.Logs.Exporters, []string{tc.expectExporter}) {
+				t.Errorf("expected logs pipeline to use exporter %s, got %v", tc.expectExporter, config.Service.Pipelines.Logs.Exporters)
+			}
+			if hasParser := len(config.Receivers.Syslog.Operators) != 0; hasParser != tc.expectParser {
+				t.Errorf("expected JSON parser %t, got %t", tc.expectParser, hasParser)
+			}
+		})
+	}
+}
+
+// TestDesiredRsyslogConfigMapAccessLogOptions verifies that the
+// access-logging configmap has the rsyslog or OpenTelemetry collector
+// configuration that the access logging options require.
+func TestDesiredRsyslogConfigMapAccessLogOptions(t *testing.T) {
+	testCases := []struct {
+		description string
+		overrides   string
+		expectKey   string
+		expectValue string
+	}{
+		{
+			description: "default",
+			expectKey:   "rsyslog.conf",
+			expectValue: rsyslogConfiguration,
+		},
+		{
+			description: "JSON format",
+			overrides:   `{"accessLogging":{"format":"JSON"}}`,
+			expectKey:   "rsyslog.conf",
+			expectValue: rsyslogJSONConfiguration,
+		},
+		{
+			description: "OTLP",
+			overrides:   `{"accessLogging":{"otlp":{"endpoint":"collector.example.com:4317"}}}`,
+			expectKey:   otelCollectorConfigKey,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			ic := newAccessLoggingIngressController(operatorv1.ContainerLoggingDestinationType, tc.overrides)
+			want, cm, err := desiredRsyslogConfigMap(ic, "openshift-ingress", metav1.OwnerReference{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !want {
+				t.Fatal("expected configmap to be desired")
+			}
+			if len(cm.Data) != 1 {
+				t.Errorf("expected exactly one key, got %v", cm.Data)
+			}
+			value, ok := cm.Data[tc.expectKey]
+			if !ok {
+				t.Fatalf("expected key %s, got %v", tc.expectKey, cm.Data)
+			}
+			if len(tc.expectValue) != 0 && value != tc.expectValue {
+				t.Errorf("expected %s to be %q, got %q", tc.expectKey, tc.expectValue, value)
+			}
+		})
+	}
+}
+
+// TestDesiredRouterDeploymentAccessLogOptions verifies that
+// desiredRouterDeployment configures the router and the logging sidecar for
+// JSON access logs and OTLP export.
+func TestDesiredRouterDeploymentAccessLogOptions(t *testing.T) {
+	const otelCollectorImage = "quay.io/openshift/otel-collector:latest"
+	ic, ingressConfig, infraConfig, apiConfig, networkConfig, proxyNeeded := getRouterDeploymentComponents(t)
+	accessLoggingIC := newAccessLoggingIngressController(operatorv1.ContainerLoggingDestinationType, `{"accessLogging":{"format":"JSON","otlp":{"endpoint":"collector.example.com:4317"}}}`)
+	ic.Spec.Logging = accessLoggingIC.Spec.Logging
+	ic.Spec.UnsupportedConfigOverrides = accessLoggingIC.Spec.UnsupportedConfigOverrides
+
+	deployment, err := desiredRouterDeployment(ic, "openshift-ingress", ingressControllerImage, otelCollectorImage, "", ingressConfig, infraConfig, apiConfig, networkConfig, proxyNeeded, false, nil)
+	if err != nil {
+		t.Fatalf("invalid router Deployment: %v", err)
+	}
+	tests := []envData{
+		{RouterSyslogAddressEnvName, true, otelCollectorSyslogAddress},
+		{RouterSyslogFormatEnvName, true, fmt.Sprintf("%q", jsonHTTPLogFormat(ic.Spec.Logging.Access))},
+	}
+	if err := checkDeploymentEnvironment(t, deployment, tests); err != nil {
+		t.Error(err)
+	}
+	var sidecar *corev1.Container
+	for i, container := range deployment.Spec.Template.Spec.Containers {
+		if container.Name == operatorv1.ContainerLoggingSidecarContainerName {
+			sidecar = &deployment.Spec.Template.Spec.Containers[i]
+		}
+	}
+	if sidecar == nil {
+		t.Fatal("expected logging sidecar container")
+	}
+	if sidecar.Image != otelCollectorImage {
+		t.Errorf("expected sidecar image %s, got %s", otelCollectorImage, sidecar.Image)
+	}
+	if !reflect.DeepEqual(sidecar.Args, []string{"--config=/etc/otelcol/" + otelCollectorConfigKey}) {
+		t.Errorf("unexpected sidecar args: %v", sidecar.Args)
+	}
+	for _, volume := range deployment.Spec.Template.Spec.Volumes {
+		if volume.Name == "rsyslog-socket" {
+			t.Error("expected no rsyslog socket volume")
+		}
+	}
+	checkDeploymentHasEnvSorted(t, deployment)
+}
@@ -59,6 +59,10 @@ const (
 	IngressControllerLoadBalancerProgressingConditionType        = "LoadBalancerProgressing"
 	IngressControllerCanaryCheckSuccessConditionType             = "CanaryChecksSucceeding"
 	IngressControllerEvaluationConditionsDetectedConditionType   = "EvaluationConditionsDetected"
+	IngressControllerReplicasSpreadAcrossZonesConditionType      = "ReplicasSpreadAcrossZones"
+	IngressControllerRateLimitingConditionType                   = "RateLimiting"
+	IngressControllerStagedRolloutVerifiedConditionType          = "StagedRolloutVerified"
+	IngressControllerHTTPErrorCodePagesValidConditionType        = "HTTPErrorCodePagesValid"
 
 	routerDefaultHeaderBufferSize           = 32768
 	routerDefaultHeaderBufferMaxRewriteSize = 8192
@@ -87,11 +91,13 @@ var (
 // The controller will be pre-configured to watch for IngressController resources
 // in the manager namespace.
 func New(mgr manager.Manager, config Config) (controller.Controller, error) {
+	healthChecker := &routerPodHealthChecker{config: mgr.GetConfig()}
 	reconciler := &reconciler{
-		config:   config,
-		client:   mgr.GetClient(),
-		cache:    mgr.GetCache(),
-		recorder: mgr.GetEventRecorderFor(controllerName),
+		config:               config,
+		client:               mgr.GetClient(),
+		cache:                mgr.GetCache(),
+		recorder:             mgr.GetEventRecorderFor(controllerName),
+		checkRouterPodHealth: healthChecker.check,
 	}
 	c, err := controller.New(controllerName, mgr, controller.Options{Reconciler: reconciler})
 	if err != nil {
@@ -125,9 +131,48 @@ func New(mgr manager.Manager, config Config) (controller.Controller, error) {
 	if err := c.Watch(&source.Kind{Type: &configv1.Ingress{}}, handler.EnqueueRequestsFromMapFunc(reconciler.ingressConfigToIngressController)); err != nil {
 		return nil, err
 	}
+	// If an error-page configmap changes, reconcile the ingresscontrollers
+	// that use it to update their HTTPErrorCodePagesValid status
+	// conditions.
+	if err := c.Watch(&source.Kind{Type: &corev1.ConfigMap{}}, handler.EnqueueRequestsFromMapFunc(reconciler.errorPageConfigMapToIngressController), predicate.NewPredicateFuncs(func(o client.Object) bool {
+		return o.GetNamespace() == config.ConfigNamespace
+	})); err != nil {
+		return nil, err
+	}
+	// If a tracing credentials secret changes, reconcile the
+	// ingresscontrollers that use it to update the operator's copy.
+	if err := c.Watch(&source.Kind{Type: &corev1.Secret{}}, handler.EnqueueRequestsFromMapFunc(reconciler.tracingSecretToIngressController), predicate.NewPredicateFuncs(func(o client.Object) bool {
+		return o.GetNamespace() == config.ConfigNamespace
+	})); err != nil {
+		return nil, err
+	}
 	return c, nil
 }
 
+// errorPageConfigMapToIngressController maps a configmap in the config
+// namespace to the ingresscontrollers that use it for custom error pages.
+func (r *reconciler) errorPageConfigMapToIngressController(o client.Object) []reconcile.Request {
+	var requests []reconcile.Request
+	controllers := &operatorv1.IngressControllerList{}
+	if err := r.cache.List(context.Background(), controllers, client.InNamespace(r.config.Namespace)); err != nil {
+		log.Error(err, "failed to list ingresscontrollers for configmap", "namespace", o.GetNamespace(), "name", o.GetName())
+		return requests
+	}
+	for _, ic := range controllers.Items {
+		if ic.Spec.HttpErrorCodePages.Name != o.GetName() {
+			continue
+		}
+		log.Info("queueing ingresscontroller", "name", ic.Name, "namespace", o.GetNamespace(), "configmap", o.GetName())
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Namespace: ic.Namespace,
+				Name:      ic.Name,
+			},
+		})
+	}
+	return requests
+}
+
 func (r *reconciler) ingressConfigToIngressController(o client.Object) []reconcile.Request {
 	var requests []reconcile.Request
 	controllers := &operatorv1.IngressControllerList{}
@@ -180,8 +225,22 @@ func enqueueRequestForOwningIngressController(namespace string) handler.EventHan
 
 // Config holds all the things necessary for the controller to run.
 type Config struct {
-	Namespace              string
+	Namespace string
+	// OperandNamespace is the namespace for ingresscontrollers' router
+	// deployments and related resources.
+	OperandNamespace string
+	// ConfigNamespace is the namespace from which the operator reads
+	// user-specified configuration, such as configmaps that
+	// ingresscontrollers reference.
+	ConfigNamespace string
+	// CanaryNamespace is the namespace of the canary route, which is used
+	// to check that routers route requests.
+	CanaryNamespace        string
 	IngressControllerImage string
+	OTelCollectorImage     string
+	// OperatorImage is the ingress operator image, which runs the router
+	// tracing sidecar.
+	OperatorImage string
 }
 
 // reconciler handles the actual ingress reconciliation logic in response to
@@ -191,6 +250,11 @@ type reconciler struct {
 	client   client.Client
 	cache    cache.Cache
 	recorder record.EventRecorder
+
+	// checkRouterPodHealth checks the health of a router pod and returns
+	// a message describing the failure, if any.  Staged rollouts use it to
+	// verify canary pods.
+	checkRouterPodHealth func(*operatorv1.IngressController, *corev1.Pod) (string, error)
 }
 
 // admissionRejection is an error type for ingresscontroller admission
@@ -224,6 +288,27 @@ func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{}, fmt.Errorf("failed to get ingresscontroller %q: %v", request, err)
 	}
 
+	// Only proceed if this instance of the operator manages the
+	// ingresscontroller, and claim the ingresscontroller if no instance
+	// has claimed it yet.
+	if err := operatorcontroller.ValidateIngressControllerClaim(ingress, r.config.OperandNamespace); err != nil {
+		log.Error(err, "ingresscontroller is claimed by another instance of the operator; reconciliation will be skipped", "request", request)
+		r.recorder.Event(ingress, "Warning", "ClaimedByOtherOperator", err.Error())
+		return reconcile.Result{}, nil
+	}
+	if _, ok := ingress.Annotations[operatorcontroller.OperandNamespaceAnnotation]; !ok && ingress.DeletionTimestamp == nil {
+		updated := ingress.DeepCopy()
+		if updated.Annotations == nil {
+			updated.Annotations = map[string]string{}
+		}
+		updated.Annotations[operatorcontroller.OperandNamespaceAnnotation] = r.config.OperandNamespace
+		if err := r.client.Update(ctx, updated); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed to claim ingresscontroller %q: %w", request, err)
+		}
+		log.Info("claimed ingresscontroller", "request", request, "operandNamespace", r.config.OperandNamespace)
+		return reconcile.Result{Requeue: true}, nil
+	}
+
 	// If the ingresscontroller is deleted, handle that and return early.
 	if ingress.DeletionTimestamp != nil {
 		if err := r.ensureIngressDeleted(ingress); err != nil {
@@ -338,7 +423,7 @@ func (r *reconciler) admit(current *operatorv1.IngressController, ingressConfig
 	// get the default from the APIServer config (which is assumed to be
 	// valid).
 
-	if err := r.validate(updated); err != nil {
+	if err := r.validate(updated, platformStatus); err != nil {
 		switch err := err.(type) {
 		case *admissionRejection:
 			updated.Status.Conditions = MergeConditions(updated.Status.Conditions, operatorv1.OperatorCondition{
@@ -723,18 +808,36 @@ func tlsProfileSpecForSecurityProfile(profile *configv1.TLSSecurityProfile) *con
 // returns an error value, which will have a non-nil value of type
 // admissionRejection if the ingresscontroller is invalid, or a non-nil value of
 // a different type if validation could not be completed.
-func (r *reconciler) validate(ic *operatorv1.IngressController) error {
-	var errors []error
-
+func (r *reconciler) validate(ic *operatorv1.IngressController, platformStatus *configv1.PlatformStatus) error {
 	ingresses := &operatorv1.IngressControllerList{}
 	if err := r.cache.List(context.TODO(), ingresses, client.InNamespace(r.config.Namespace)); err != nil {
 		return fmt.Errorf("failed to list ingresscontrollers: %v", err)
 	}
 
+	return validateIngressController(ic, platformStatus, ingresses.Items, r.config.OTelCollectorImage, r.config.OperatorImage)
+}
+
+// validateIngressController validates the given ingresscontroller against the
+// given platform, the other existing ingresscontrollers, and the operator's
+// OpenTelemetry collector and ingress operator images.  Returns an
+// admissionRejection value if the ingresscontroller is invalid.
+func validateIngressController(ic *operatorv1.IngressController, platformStatus *configv1.PlatformStatus, existing []operatorv1.IngressController, otelCollectorImage, operatorImage string) error {
+	if err := utilerrors.NewAggregate(ingressControllerValidationErrors(ic, platformStatus, existing, otelCollectorImage, operatorImage)); err != nil {
+		return &admissionRejection{err.Error()}
+	}
+
+	return nil
+}
+
+// ingressControllerValidationErrors returns the reasons for which
+// validateIngressController rejects the given ingresscontroller.
+func ingressControllerValidationErrors(ic *operatorv1.IngressController, platformStatus *configv1.PlatformStatus, existing []operatorv1.IngressController, otelCollectorImage, operatorImage string) []error {
+	var errors []error
+
 	if err := validateDomain(ic); err != nil {
 		errors = append(errors, err)
 	}
-	if err := validateDomainUniqueness(ic, ingresses.Items); err != nil {
+	if err := validateDomainUniqueness(ic, existing); err != nil {
 		errors = append(errors, err)
 	}
 	if err := validateTLSSecurityProfile(ic); err != nil {
@@ -746,11 +849,45 @@ func (r *reconciler) validate(ic *operatorv1.IngressController) error {
 	if err := validateClientTLS(ic); err != nil {
 		errors = append(errors, err)
 	}
-	if err := utilerrors.NewAggregate(errors); err != nil {
-		return &admissionRejection{err.Error()}
+	if _, _, err := loadBalancerServicePassthrough(ic, platformStatus); err != nil {
+		errors = append(errors, err)
+	}
+	if _, err := awsLoadBalancerTuningAnnotations(ic, platformStatus); err != nil {
+		errors = append(errors, err)
+	}
+	if _, err := routerAutoscalingForIngressController(ic); err != nil {
+		errors = append(errors, err)
+	}
+	if _, err := replicaPolicyForIngressController(ic); err != nil {
+		errors = append(errors, err)
+	}
+	if _, err := httpHeaderActionsForIngressController(ic); err != nil {
+		errors = append(errors, err)
+	}
+	if options, err := accessLogOptionsForIngressController(ic); err != nil {
+		errors = append(errors, err)
+	} else if options != nil && options.OTLP != nil && len(otelCollectorImage) == 0 {
+		errors = append(errors, fmt.Errorf("spec.unsupportedConfigOverrides.accessLogging.otlp requires the operator to be configured with an OpenTelemetry collector image"))
+	}
+	if _, err := routerResourcesForIngressController(ic); err != nil {
+		errors = append(errors, err)
+	}
+	if _, err := rolloutPolicyForIngressController(ic); err != nil {
+		errors = append(errors, err)
+	}
+	if _, err := rateLimitingForIngressController(ic); err != nil {
+		errors = append(errors, err)
+	}
+	if _, err := stagedRolloutForIngressController(ic); err != nil {
+		errors = append(errors, err)
+	}
+	if tracing, err := routerTracingForIngressController(ic); err != nil {
+		errors = append(errors, err)
+	} else if tracing != nil && tracing.OTLP != nil && len(operatorImage) == 0 {
+		errors = append(errors, fmt.Errorf("spec.unsupportedConfigOverrides.tracing.otlp requires the operator to be configured with the ingress operator image"))
 	}
 
-	return nil
+	return errors
 }
 
 func validateDomain(ic *operatorv1.IngressController) error {
@@ -768,7 +905,13 @@ func validateDomainUniqueness(desired *operatorv1.IngressController, existing []
 		if !ingresscontroller.IsAdmitted(&current) {
 			continue
 		}
-		if desired.UID != current.UID && desired.Status.Domain == current.Status.Domain {
+		// An ingresscontroller that has not been created, such as one
+		// that is being linted, has no UID and is identified by name.
+		isSelf := desired.UID == current.UID
+		if len(desired.UID) == 0 {
+			isSelf = desired.Namespace == current.Namespace && desired.Name == current.Name
+		}
+		if !isSelf && desired.Status.Domain == current.Status.Domain {
 			return fmt.Errorf("conflicts with: %s", current.Name)
 		}
 	}
@@ -993,7 +1136,7 @@ func (r *reconciler) ensureIngressController(ci *operatorv1.IngressController, d
 	var haveClientCAConfigmap bool
 	clientCAConfigmap := &corev1.ConfigMap{}
 	if len(ci.Spec.ClientTLS.ClientCA.Name) != 0 {
-		name := operatorcontroller.ClientCAConfigMapName(ci)
+		name := operatorcontroller.ClientCAConfigMapName(ci, r.config.OperandNamespace)
 		if err := r.cache.Get(context.TODO(), name, clientCAConfigmap); err != nil {
 			errs = append(errs, fmt.Errorf("failed to get client CA configmap: %w", err))
 			return utilerrors.NewAggregate(errs)
@@ -1001,8 +1144,50 @@ func (r *reconciler) ensureIngressController(ci *operatorv1.IngressController, d
 		haveClientCAConfigmap = true
 	}
 
-	haveDepl, deployment, err := r.ensureRouterDeployment(ci, infraConfig, ingressConfig, apiConfig, networkConfig, haveClientCAConfigmap, clientCAConfigmap, platformStatus)
-	if err != nil {
+	// The error-page configmap controller syncs the valid error pages to
+	// the operand namespace; the ingresscontroller's status reports the
+	// invalid ones.
+	var errorPagesConfigmap *corev1.ConfigMap
+	if len(ci.Spec.HttpErrorCodePages.Name) != 0 {
+		cm := &corev1.ConfigMap{}
+		name := types.NamespacedName{Namespace: r.config.ConfigNamespace, Name: ci.Spec.HttpErrorCodePages.Name}
+		if err := r.cache.Get(context.TODO(), name, cm); err != nil {
+			if !kerrors.IsNotFound(err) {
+				errs = append(errs, fmt.Errorf("failed to get error-page configmap: %w", err))
+				return utilerrors.NewAggregate(errs)
+			}
+		} else {
+			errorPagesConfigmap = cm
+		}
+	}
+
+	// The NodeAware replica policy needs the nodes in order to compute the
+	// default number of replicas, so failing to list them is fatal only
+	// for ingresscontrollers that use that policy.  Otherwise, the nodes
+	// are used only to compute the ReplicasSpreadAcrossZones status
+	// condition, which is reported as Unknown if the nodes are not known.
+	var nodes []corev1.Node
+	nodeList := &corev1.NodeList{}
+	if err := r.cache.List(context.TODO(), nodeList); err != nil {
+		if policy, _ := replicaPolicyForIngressController(ci); policy != nil && policy.DefaultReplicas == nodeAwareDefaultReplicas {
+			errs = append(errs, fmt.Errorf("failed to list nodes: %w", err))
+			return utilerrors.NewAggregate(errs)
+		}
+		log.Error(err, "failed to list nodes", "ingresscontroller", ci.Name)
+	} else {
+		nodes = nodeList.Items
+		if nodes == nil {
+			nodes = []corev1.Node{}
+		}
+	}
+
+	haveDepl, deployment, err := r.ensureRouterDeployment(ci, infraConfig, ingressConfig, apiConfig, networkConfig, haveClientCAConfigmap, clientCAConfigmap, platformStatus, nodes)
+	if _, ok := err.(retryable.Error); ok && haveDepl {
+		// A staged rollout is being verified.  Keep reconciling the
+		// ingresscontroller's other resources, and check on the
+		// rollout again later.
+		errs = append(errs, err)
+	} else if err != nil {
 		errs = append(errs, fmt.Errorf("failed to ensure deployment: %v", err))
 		return utilerrors.NewAggregate(errs)
 	} else if !haveDepl {
@@ -1029,6 +1214,11 @@ func (r *reconciler) ensureIngressController(ci *operatorv1.IngressController, d
 			errs = append(errs, fmt.Errorf("failed to ensure wildcard dnsrecord for %s: %v", ci.Name, err))
 		} else {
 			wildcardRecord = record
+			// Return the error as is so that a retryable error
+			// causes the migration to be checked again.
+			if err := r.completeLoadBalancerServiceMigration(ci, deploymentRef, platformStatus, lbService, wildcardRecord); err != nil {
+				errs = append(errs, err)
+			}
 		}
 	}
 
@@ -1046,21 +1236,29 @@ func (r *reconciler) ensureIngressController(ci *operatorv1.IngressController, d
 		errs = append(errs, err)
 	}
 
+	if err := r.ensureRouterTracing(ci, deploymentRef); err != nil {
+		errs = append(errs, err)
+	}
+
 	if _, _, err := r.ensureRouterPodDisruptionBudget(ci, deploymentRef); err != nil {
 		errs = append(errs, err)
 	}
 
+	if _, _, err := r.ensureRouterHorizontalPodAutoscaler(ci, deploymentRef); err != nil {
+		errs = append(errs, err)
+	}
+
 	operandEvents := &corev1.EventList{}
-	if err := r.cache.List(context.TODO(), operandEvents, client.InNamespace(operatorcontroller.DefaultOperandNamespace)); err != nil {
-		errs = append(errs, fmt.Errorf("failed to list events in namespace %q: %v", operatorcontroller.DefaultOperandNamespace, err))
+	if err := r.cache.List(context.TODO(), operandEvents, client.InNamespace(r.config.OperandNamespace)); err != nil {
+		errs = append(errs, fmt.Errorf("failed to list events in namespace %q: %v", r.config.OperandNamespace, err))
 	}
 
 	pods := &corev1.PodList{}
-	if err := r.cache.List(context.TODO(), pods, client.InNamespace(operatorcontroller.DefaultOperandNamespace)); err != nil {
-		errs = append(errs, fmt.Errorf("failed to list pods in namespace %q: %v", operatorcontroller.DefaultOperatorNamespace, err))
+	if err := r.cache.List(context.TODO(), pods, client.InNamespace(r.config.OperandNamespace)); err != nil {
+		errs = append(errs, fmt.Errorf("failed to list pods in namespace %q: %v", r.config.OperandNamespace, err))
 	}
 
-	syncStatusErr, updated := r.syncIngressControllerStatus(ci, deployment, deploymentRef, pods.Items, lbService, operandEvents.Items, wildcardRecord, dnsConfig, platformStatus)
+	syncStatusErr, updated := r.syncIngressControllerStatus(ci, deployment, deploymentRef, pods.Items, nodes, lbService, operandEvents.Items, wildcardRecord, dnsConfig, platformStatus, errorPagesConfigmap)
 	errs = append(errs, syncStatusErr)
 
 	// If syncIngressControllerStatus updated our ingress status, it's important we query for that new object.
@@ -1131,7 +1329,7 @@ func (r *reconciler) allRouterPodsDeleted(ingress *operatorv1.IngressController)
 	labels := map[string]string{
 		operatorcontroller.ControllerDeploymentLabel: ingress.Name,
 	}
-	if err := r.client.List(context.TODO(), podList, client.InNamespace(operatorcontroller.DefaultOperandNamespace), client.MatchingLabels(labels)); err != nil {
+	if err := r.client.List(context.TODO(), podList, client.InNamespace(r.config.OperandNamespace), client.MatchingLabels(labels)); err != nil {
 		return false, fmt.Errorf("failed to list all pods owned by %s: %w", ingress.Name, err)
 	}
 	// If any pods exist, return false since they haven't all been deleted.
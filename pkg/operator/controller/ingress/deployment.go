@@ -107,7 +107,7 @@ const (
 
 // ensureRouterDeployment ensures the router deployment exists for a given
 // ingresscontroller.
-func (r *reconciler) ensureRouterDeployment(ci *operatorv1.IngressController, infraConfig *configv1.Infrastructure, ingressConfig *configv1.Ingress, apiConfig *configv1.APIServer, networkConfig *configv1.Network, haveClientCAConfigmap bool, clientCAConfigmap *corev1.ConfigMap, platformStatus *configv1.PlatformStatus) (bool, *appsv1.Deployment, error) {
+func (r *reconciler) ensureRouterDeployment(ci *operatorv1.IngressController, infraConfig *configv1.Infrastructure, ingressConfig *configv1.Ingress, apiConfig *configv1.APIServer, networkConfig *configv1.Network, haveClientCAConfigmap bool, clientCAConfigmap *corev1.ConfigMap, platformStatus *configv1.PlatformStatus, nodes []corev1.Node) (bool, *appsv1.Deployment, error) {
 	haveDepl, current, err := r.currentRouterDeployment(ci)
 	if err != nil {
 		return false, nil, err
@@ -116,11 +116,31 @@ func (r *reconciler) ensureRouterDeployment(ci *operatorv1.IngressController, in
 	if err != nil {
 		return false, nil, fmt.Errorf("failed to determine if proxy protocol is needed for ingresscontroller %s/%s: %v", ci.Namespace, ci.Name, err)
 	}
-	desired, err := desiredRouterDeployment(ci, r.config.IngressControllerImage, ingressConfig, infraConfig, apiConfig, networkConfig, proxyNeeded, haveClientCAConfigmap, clientCAConfigmap)
+	desired, err := desiredRouterDeployment(ci, r.config.OperandNamespace, r.config.IngressControllerImage, r.config.OTelCollectorImage, r.config.OperatorImage, ingressConfig, infraConfig, apiConfig, networkConfig, proxyNeeded, haveClientCAConfigmap, clientCAConfigmap)
 	if err != nil {
 		return haveDepl, current, fmt.Errorf("failed to build router deployment: %v", err)
 	}
 
+	autoscaling, _ := routerAutoscalingForIngressController(ci)
+	switch {
+	case autoscaling != nil:
+		// If a horizontal pod autoscaler manages the deployment's
+		// replica count, keep whatever count the autoscaler last set
+		// so that the operator and the autoscaler do not fight over
+		// spec.replicas.
+		if haveDepl && current.Spec.Replicas != nil {
+			replicas := *current.Spec.Replicas
+			desired.Spec.Replicas = &replicas
+		}
+	case ci.Spec.Replicas == nil && !singleReplica(ingressConfig, infraConfig):
+		// If the ingresscontroller opts in to node-aware default
+		// replicas, derive the replica count from the nodes and zones
+		// to which the router pods can be scheduled.
+		if policy, _ := replicaPolicyForIngressController(ci); policy != nil && policy.DefaultReplicas == nodeAwareDefaultReplicas {
+			setRouterDeploymentReplicas(ci, desired, determineNodeAwareReplicas(nodes, &desired.Spec.Template.Spec))
+		}
+	}
+
 	switch {
 	case !haveDepl:
 		if err := r.createRouterDeployment(desired); err != nil {
@@ -128,6 +148,12 @@ func (r *reconciler) ensureRouterDeployment(ci *operatorv1.IngressController, in
 		}
 		return r.currentRouterDeployment(ci)
 	case haveDepl:
+		if staged, _ := stagedRolloutForIngressController(ci); staged != nil {
+			return r.ensureStagedRollout(ci, staged, current, desired)
+		}
+		if err := r.abandonStagedRollout(ci, current); err != nil {
+			return true, current, err
+		}
 		if updated, err := r.updateRouterDeployment(current, desired); err != nil {
 			return true, current, err
 		} else if updated {
@@ -141,7 +167,7 @@ func (r *reconciler) ensureRouterDeployment(ci *operatorv1.IngressController, in
 // ingresscontroller are deleted.
 func (r *reconciler) ensureRouterDeleted(ci *operatorv1.IngressController) error {
 	deployment := &appsv1.Deployment{}
-	name := controller.RouterDeploymentName(ci)
+	name := controller.RouterDeploymentName(ci, r.config.OperandNamespace)
 	deployment.Name = name.Name
 	deployment.Namespace = name.Namespace
 	if err := r.client.Delete(context.TODO(), deployment); err != nil {
@@ -221,10 +247,28 @@ func determineDeploymentReplicas(ic *operatorv1.IngressController, ingressConfig
 	return DetermineReplicas(ingressConfig, infraConfig)
 }
 
+// setRouterDeploymentReplicas sets the replica count of the given router
+// deployment and updates the deployment strategy's max unavailable value to
+// match the new replica count, unless the given ingresscontroller's rollout
+// policy specifies the max unavailable value.
+func setRouterDeploymentReplicas(ci *operatorv1.IngressController, deployment *appsv1.Deployment, replicas int32) {
+	deployment.Spec.Replicas = &replicas
+	if rollout, _ := rolloutPolicyForIngressController(ci); rollout != nil && rollout.MaxUnavailable != nil {
+		return
+	}
+	if rollingUpdate := deployment.Spec.Strategy.RollingUpdate; rollingUpdate != nil && rollingUpdate.MaxUnavailable != nil {
+		maxUnavailable := intstr.FromString("50%")
+		if replicas >= 4 {
+			maxUnavailable = intstr.FromString("25%")
+		}
+		rollingUpdate.MaxUnavailable = &maxUnavailable
+	}
+}
+
 // desiredRouterDeployment returns the desired router deployment.
-func desiredRouterDeployment(ci *operatorv1.IngressController, ingressControllerImage string, ingressConfig *configv1.Ingress, infraConfig *configv1.Infrastructure, apiConfig *configv1.APIServer, networkConfig *configv1.Network, proxyNeeded bool, haveClientCAConfigmap bool, clientCAConfigmap *corev1.ConfigMap) (*appsv1.Deployment, error) {
+func desiredRouterDeployment(ci *operatorv1.IngressController, operandNamespace, ingressControllerImage, otelCollectorImage, operatorImage string, ingressConfig *configv1.Ingress, infraConfig *configv1.Infrastructure, apiConfig *configv1.APIServer, networkConfig *configv1.Network, proxyNeeded bool, haveClientCAConfigmap bool, clientCAConfigmap *corev1.ConfigMap) (*appsv1.Deployment, error) {
 	deployment := manifests.RouterDeployment()
-	name := controller.RouterDeploymentName(ci)
+	name := controller.RouterDeploymentName(ci, operandNamespace)
 	deployment.Name = name.Name
 	deployment.Namespace = name.Namespace
 
@@ -255,6 +299,16 @@ func desiredRouterDeployment(ci *operatorv1.IngressController, ingressController
 	routerVolumeMounts := deployment.Spec.Template.Spec.Containers[0].VolumeMounts
 
 	desiredReplicas := determineDeploymentReplicas(ci, ingressConfig, infraConfig)
+	autoscaling, err := routerAutoscalingForIngressController(ci)
+	if err != nil {
+		return nil, err
+	}
+	if autoscaling != nil {
+		// The horizontal pod autoscaler manages the replica count;
+		// start from its lower bound.  ensureRouterDeployment
+		// preserves the current replica count on updates.
+		desiredReplicas = autoscaling.MinReplicas
+	}
 	deployment.Spec.Replicas = &desiredReplicas
 
 	configureAffinity := false
@@ -385,16 +439,32 @@ func desiredRouterDeployment(ci *operatorv1.IngressController, ingressController
 		}
 	}
 
+	// Apply the rollout parameters that the ingresscontroller specifies
+	// in place of the defaults.
+	rollout, err := rolloutPolicyForIngressController(ci)
+	if err != nil {
+		return nil, err
+	}
+	if rollout != nil {
+		applyRolloutPolicy(deployment, rollout)
+	}
+
 	// Configure topology constraints to spread replicas across availability
 	// zones.  We want to allow scheduling more replicas than there are AZs,
-	// so we specify "ScheduleAnyway".  We want to allow scheduling a
-	// newer-generation replica on the same node as an older-generation
-	// replica where the deployment strategy allows and depends on doing so,
-	// so we specify a label selector with the deployment's hash.
+	// so we specify "ScheduleAnyway" unless the ingresscontroller's replica
+	// policy asks for a hard "DoNotSchedule" constraint.  We want to allow
+	// scheduling a newer-generation replica on the same node as an
+	// older-generation replica where the deployment strategy allows and
+	// depends on doing so, so we specify a label selector with the
+	// deployment's hash.
+	policy, err := replicaPolicyForIngressController(ci)
+	if err != nil {
+		return nil, err
+	}
 	deployment.Spec.Template.Spec.TopologySpreadConstraints = []corev1.TopologySpreadConstraint{{
 		MaxSkew:           int32(1),
 		TopologyKey:       corev1.LabelTopologyZone,
-		WhenUnsatisfiable: corev1.ScheduleAnyway,
+		WhenUnsatisfiable: policy.ZoneSpread,
 		LabelSelector: &metav1.LabelSelector{
 			MatchExpressions: []metav1.LabelSelectorRequirement{
 				{
@@ -454,7 +524,7 @@ func desiredRouterDeployment(ci *operatorv1.IngressController, ingressController
 	routerVolumeMounts = append(routerVolumeMounts, certsVolumeMount)
 
 	if len(ci.Spec.HttpErrorCodePages.Name) != 0 {
-		configmapName := controller.HttpErrorCodePageConfigMapName(ci)
+		configmapName := controller.HttpErrorCodePageConfigMapName(ci, operandNamespace)
 		httpErrorCodeConfigVolume := corev1.Volume{
 			Name: "error-pages",
 			VolumeSource: corev1.VolumeSource{
@@ -468,18 +538,19 @@ func desiredRouterDeployment(ci *operatorv1.IngressController, ingressController
 		volumes = append(volumes, httpErrorCodeConfigVolume)
 		httpErrorCodeVolumeMount := corev1.VolumeMount{
 			Name:      httpErrorCodeConfigVolume.Name,
-			MountPath: "/var/lib/haproxy/conf/error_code_pages",
+			MountPath: HttpErrorCodePagesMountPath,
 		}
 		routerVolumeMounts = append(routerVolumeMounts, httpErrorCodeVolumeMount)
 		if len(configmapName.Name) != 0 {
-			env = append(env, corev1.EnvVar{
-				Name:  "ROUTER_ERRORFILE_503",
-				Value: "/var/lib/haproxy/conf/error_code_pages/error-page-503.http",
-			})
-			env = append(env, corev1.EnvVar{
-				Name:  "ROUTER_ERRORFILE_404",
-				Value: "/var/lib/haproxy/conf/error_code_pages/error-page-404.http",
-			})
+			// The sync controller provides a page for every supported
+			// code, using a default page for any code for which the
+			// user did not provide one.
+			for _, code := range SupportedHttpErrorCodes {
+				env = append(env, corev1.EnvVar{
+					Name:  fmt.Sprintf("ROUTER_ERRORFILE_%d", code),
+					Value: filepath.Join(HttpErrorCodePagesMountPath, HttpErrorCodePageKey(code)),
+				})
+			}
 		}
 	}
 
@@ -487,6 +558,10 @@ func desiredRouterDeployment(ci *operatorv1.IngressController, ingressController
 	env = append(env, corev1.EnvVar{Name: "ROUTER_METRICS_TLS_CERT_FILE", Value: filepath.Join(certsVolumeMountPath, "tls.crt")})
 	env = append(env, corev1.EnvVar{Name: "ROUTER_METRICS_TLS_KEY_FILE", Value: filepath.Join(certsVolumeMountPath, "tls.key")})
 
+	if limits, _ := rateLimitingForIngressController(ci); limits != nil {
+		env = append(env, rateLimitingEnv(limits)...)
+	}
+
 	var unsupportedConfigOverrides struct {
 		LoadBalancingAlgorithm string `json:"loadBalancingAlgorithm"`
 		DynamicConfigManager   string `json:"dynamicConfigManager"`
@@ -708,14 +783,59 @@ func desiredRouterDeployment(ci *operatorv1.IngressController, ingressController
 	deployment.Spec.Template.Spec.Volumes[0].Secret.SecretName = secretName.Name
 
 	if accessLogging := accessLoggingForIngressController(ci); accessLogging != nil {
+		logOptions, _ := accessLogOptionsForIngressController(ci)
+		if logOptions == nil {
+			logOptions = &accessLogOptions{}
+		}
 		switch {
+		case accessLogging.Destination.Type == operatorv1.ContainerLoggingDestinationType && logOptions.OTLP != nil && len(otelCollectorImage) != 0:
+			otelCollectorConfigVolume := corev1.Volume{
+				Name: "otel-collector-config",
+				VolumeSource: corev1.VolumeSource{
+					ConfigMap: &corev1.ConfigMapVolumeSource{
+						LocalObjectReference: corev1.LocalObjectReference{
+							Name: controller.RsyslogConfigMapName(ci, operandNamespace).Name,
+						},
+					},
+				},
+			}
+			otelCollectorConfigVolumeMount := corev1.VolumeMount{
+				Name:      otelCollectorConfigVolume.Name,
+				MountPath: "/etc/otelcol",
+			}
+			otelCollectorContainer := corev1.Container{
+				Name:  operatorv1.ContainerLoggingSidecarContainerName,
+				Image: otelCollectorImage,
+				Args: []string{
+					"--config=" + filepath.Join(otelCollectorConfigVolumeMount.MountPath, otelCollectorConfigKey),
+				},
+				ImagePullPolicy: corev1.PullIfNotPresent,
+				VolumeMounts: []corev1.VolumeMount{
+					otelCollectorConfigVolumeMount,
+				},
+				Resources: corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("100m"),
+						corev1.ResourceMemory: resource.MustParse("256Mi"),
+					},
+				},
+			}
+
+			// The router sends access logs to the collector using
+			// syslog over UDP on the loopback interface.
+			env = append(env,
+				corev1.EnvVar{Name: RouterSyslogAddressEnvName, Value: otelCollectorSyslogAddress},
+				corev1.EnvVar{Name: RouterLogLevelEnvName, Value: "info"},
+			)
+			volumes = append(volumes, otelCollectorConfigVolume)
+			deployment.Spec.Template.Spec.Containers = append(deployment.Spec.Template.Spec.Containers, otelCollectorContainer)
 		case accessLogging.Destination.Type == operatorv1.ContainerLoggingDestinationType:
 			rsyslogConfigVolume := corev1.Volume{
 				Name: "rsyslog-config",
 				VolumeSource: corev1.VolumeSource{
 					ConfigMap: &corev1.ConfigMapVolumeSource{
 						LocalObjectReference: corev1.LocalObjectReference{
-							Name: controller.RsyslogConfigMapName(ci).Name,
+							Name: controller.RsyslogConfigMapName(ci, operandNamespace).Name,
 						},
 					},
 				},
@@ -793,7 +913,10 @@ func desiredRouterDeployment(ci *operatorv1.IngressController, ingressController
 			)
 		}
 
-		if len(accessLogging.HttpLogFormat) > 0 {
+		switch {
+		case logOptions.Format == jsonAccessLogFormat:
+			env = append(env, corev1.EnvVar{Name: RouterSyslogFormatEnvName, Value: fmt.Sprintf("%q", jsonHTTPLogFormat(accessLogging))})
+		case len(accessLogging.HttpLogFormat) > 0:
 			env = append(env, corev1.EnvVar{Name: RouterSyslogFormatEnvName, Value: fmt.Sprintf("%q", accessLogging.HttpLogFormat)})
 		}
 		if val := serializeCaptureHeaders(accessLogging.HTTPCaptureHeaders.Request); len(val) != 0 {
@@ -954,6 +1077,32 @@ func desiredRouterDeployment(ci *operatorv1.IngressController, ingressController
 		env = append(env, corev1.EnvVar{Name: RouterHTTPHeaderNameCaseAdjustments, Value: v})
 	}
 
+	// The router emits spans if the ingresscontroller specifies an OTLP
+	// endpoint for them.
+	tracing, _ := routerTracingForIngressController(ci)
+	emitSpans := tracing != nil && tracing.OTLP != nil && len(operatorImage) != 0
+	requestHeaderActions := tracingRequestHeaderActions(tracing, emitSpans)
+	var responseHeaderActions []httpHeaderAction
+	if actions, _ := httpHeaderActionsForIngressController(ci); actions != nil {
+		requestHeaderActions = append(actions.Request, requestHeaderActions...)
+		responseHeaderActions = actions.Response
+	}
+	if len(requestHeaderActions) != 0 {
+		env = append(env, corev1.EnvVar{Name: RouterHTTPRequestHeaders, Value: serializeHTTPHeaderActions(requestHeaderActions)})
+	}
+	if len(responseHeaderActions) != 0 {
+		env = append(env, corev1.EnvVar{Name: RouterHTTPResponseHeaders, Value: serializeHTTPHeaderActions(responseHeaderActions)})
+	}
+
+	if emitSpans {
+		// The router logs a record for each request to the tracing
+		// sidecar, which creates and exports the spans.
+		env = append(env, tracingEnv(tracing)...)
+		container, tracingVolumes := desiredRouterTracingContainer(ci, tracing, operandNamespace, operatorImage)
+		volumes = append(volumes, tracingVolumes...)
+		deployment.Spec.Template.Spec.Containers = append(deployment.Spec.Template.Spec.Containers, container)
+	}
+
 	if ci.Spec.HTTPEmptyRequestsPolicy == operatorv1.HTTPEmptyRequestsPolicyIgnore {
 		env = append(env, corev1.EnvVar{Name: RouterHTTPIgnoreProbes, Value: "true"})
 	}
@@ -993,7 +1142,7 @@ func desiredRouterDeployment(ci *operatorv1.IngressController, ingressController
 		)
 
 		if len(ci.Spec.ClientTLS.ClientCA.Name) != 0 {
-			clientCAConfigmapName := controller.ClientCAConfigMapName(ci)
+			clientCAConfigmapName := controller.ClientCAConfigMapName(ci, operandNamespace)
 			clientCAVolumeName := "client-ca"
 			clientCAVolumeMountPath := "/etc/pki/tls/client-ca"
 			clientCABundleFilename := "ca-bundle.pem"
@@ -1053,7 +1202,7 @@ func desiredRouterDeployment(ci *operatorv1.IngressController, ingressController
 					}
 				}
 				if someClientCAHasCRL {
-					clientCACRLSecretName := controller.CRLConfigMapName(ci)
+					clientCACRLSecretName := controller.CRLConfigMapName(ci, operandNamespace)
 					clientCACRLVolumeName := "client-ca-crl"
 					clientCACRLVolumeMountPath := "/etc/pki/tls/client-ca-crl"
 					clientCACRLFilename := "crl.pem"
@@ -1128,6 +1277,16 @@ func desiredRouterDeployment(ci *operatorv1.IngressController, ingressController
 		},
 	)
 
+	// Apply the container resources that the ingresscontroller specifies
+	// now that all the sidecars have been added.
+	resources, err := routerResourcesForIngressController(ci)
+	if err != nil {
+		return nil, err
+	}
+	if resources != nil {
+		applyRouterResources(deployment, resources)
+	}
+
 	// Compute the hash for topology spread constraints and possibly
 	// affinity policy now, after all the other fields have been computed,
 	// and inject it into the appropriate fields.
@@ -1369,6 +1528,7 @@ func hashableDeployment(deployment *appsv1.Deployment, onlyTemplate bool) *appsv
 			StartupProbe:    hashableProbe(container.StartupProbe),
 			SecurityContext: container.SecurityContext,
 			Ports:           container.Ports,
+			Resources:       hashableResources(container.Resources),
 		}
 	}
 	sort.Slice(containers, func(i, j int) bool {
@@ -1377,6 +1537,7 @@ func hashableDeployment(deployment *appsv1.Deployment, onlyTemplate bool) *appsv
 	hashableDeployment.Spec.Template.Spec.Containers = containers
 	hashableDeployment.Spec.Template.Spec.DNSPolicy = deployment.Spec.Template.Spec.DNSPolicy
 	hashableDeployment.Spec.Template.Spec.HostNetwork = deployment.Spec.Template.Spec.HostNetwork
+	hashableDeployment.Spec.Template.Spec.TerminationGracePeriodSeconds = deployment.Spec.Template.Spec.TerminationGracePeriodSeconds
 	volumes := make([]corev1.Volume, len(deployment.Spec.Template.Spec.Volumes))
 	for i, vol := range deployment.Spec.Template.Spec.Volumes {
 		volumes[i] = *vol.DeepCopy()
@@ -1491,7 +1652,7 @@ func hashableProbe(probe *corev1.Probe) *corev1.Probe {
 // currentRouterDeployment returns the current router deployment.
 func (r *reconciler) currentRouterDeployment(ci *operatorv1.IngressController) (bool, *appsv1.Deployment, error) {
 	deployment := &appsv1.Deployment{}
-	if err := r.client.Get(context.TODO(), controller.RouterDeploymentName(ci), deployment); err != nil {
+	if err := r.client.Get(context.TODO(), controller.RouterDeploymentName(ci, r.config.OperandNamespace), deployment); err != nil {
 		if errors.IsNotFound(err) {
 			return false, nil, nil
 		}
@@ -1584,6 +1745,7 @@ func deploymentConfigChanged(current, expected *appsv1.Deployment) (bool, *appsv
 	copyProbe(expected.Spec.Template.Spec.Containers[0].ReadinessProbe, updated.Spec.Template.Spec.Containers[0].ReadinessProbe)
 	copyProbe(expected.Spec.Template.Spec.Containers[0].StartupProbe, updated.Spec.Template.Spec.Containers[0].StartupProbe)
 	updated.Spec.Template.Spec.Containers[0].VolumeMounts = expected.Spec.Template.Spec.Containers[0].VolumeMounts
+	updated.Spec.Template.Spec.Containers[0].Resources = expected.Spec.Template.Spec.Containers[0].Resources
 	updated.Spec.Template.Spec.Containers[0].Ports = expected.Spec.Template.Spec.Containers[0].Ports
 	updated.Spec.Template.Spec.Tolerations = expected.Spec.Template.Spec.Tolerations
 	updated.Spec.Template.Spec.TopologySpreadConstraints = expected.Spec.Template.Spec.TopologySpreadConstraints
@@ -1594,6 +1756,7 @@ func deploymentConfigChanged(current, expected *appsv1.Deployment) (bool, *appsv
 	}
 	updated.Spec.Replicas = &replicas
 	updated.Spec.MinReadySeconds = expected.Spec.MinReadySeconds
+	updated.Spec.Template.Spec.TerminationGracePeriodSeconds = expected.Spec.Template.Spec.TerminationGracePeriodSeconds
 	return true, updated
 }
 
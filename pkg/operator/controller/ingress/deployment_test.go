@@ -17,10 +17,13 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
 const (
@@ -166,7 +169,7 @@ func TestTuningOptions(t *testing.T) {
 	ic.Spec.TuningOptions.HealthCheckInterval = &metav1.Duration{Duration: 15 * time.Second}
 	ic.Spec.TuningOptions.ReloadInterval = metav1.Duration{Duration: 30 * time.Second}
 
-	deployment, err := desiredRouterDeployment(ic, ingressControllerImage, ingressConfig, infraConfig, apiConfig, networkConfig, false, false, nil)
+	deployment, err := desiredRouterDeployment(ic, "openshift-ingress", ingressControllerImage, "", "", ingressConfig, infraConfig, apiConfig, networkConfig, false, false, nil)
 	if err != nil {
 		t.Fatalf("invalid router Deployment: %v", err)
 	}
@@ -263,7 +266,7 @@ func getRouterDeploymentComponents(t *testing.T) (*operatorv1.IngressController,
 func TestDesiredRouterDeployment(t *testing.T) {
 	ic, ingressConfig, infraConfig, apiConfig, networkConfig, proxyNeeded := getRouterDeploymentComponents(t)
 
-	deployment, err := desiredRouterDeployment(ic, ingressControllerImage, ingressConfig, infraConfig, apiConfig, networkConfig, proxyNeeded, false, nil)
+	deployment, err := desiredRouterDeployment(ic, "openshift-ingress", ingressControllerImage, "", "", ingressConfig, infraConfig, apiConfig, networkConfig, proxyNeeded, false, nil)
 	if err != nil {
 		t.Fatalf("invalid router Deployment: %v", err)
 	}
@@ -310,6 +313,7 @@ func TestDesiredRouterDeployment(t *testing.T) {
 		{"ROUTER_DEFAULT_TUNNEL_TIMEOUT", false, ""},
 		{"ROUTER_ERRORFILE_503", false, ""},
 		{"ROUTER_ERRORFILE_404", false, ""},
+		{"ROUTER_ERRORFILE_500", false, ""},
 		{"ROUTER_HAPROXY_CONFIG_MANAGER", false, ""},
 		{"ROUTER_H1_CASE_ADJUST", false, ""},
 		{"ROUTER_INSPECT_DELAY", false, ""},
@@ -342,7 +346,7 @@ func TestDesiredRouterDeployment(t *testing.T) {
 func TestDesiredRouterDeploymentSpecTemplate(t *testing.T) {
 	ic, ingressConfig, infraConfig, apiConfig, networkConfig, proxyNeeded := getRouterDeploymentComponents(t)
 
-	deployment, err := desiredRouterDeployment(ic, ingressControllerImage, ingressConfig, infraConfig, apiConfig, networkConfig, proxyNeeded, false, nil)
+	deployment, err := desiredRouterDeployment(ic, "openshift-ingress", ingressControllerImage, "", "", ingressConfig, infraConfig, apiConfig, networkConfig, proxyNeeded, false, nil)
 	if err != nil {
 		t.Fatalf("invalid router Deployment: %v", err)
 	}
@@ -470,7 +474,7 @@ func TestDesiredRouterDeploymentSpecAndNetwork(t *testing.T) {
 	if err != nil {
 		t.Errorf("failed to determine infrastructure platform status for ingresscontroller %s/%s: %v", ic.Namespace, ic.Name, err)
 	}
-	deployment, err := desiredRouterDeployment(ic, ingressControllerImage, ingressConfig, infraConfig, apiConfig, networkConfig, proxyNeeded, false, nil)
+	deployment, err := desiredRouterDeployment(ic, "openshift-ingress", ingressControllerImage, "", "", ingressConfig, infraConfig, apiConfig, networkConfig, proxyNeeded, false, nil)
 	if err != nil {
 		t.Fatalf("invalid router Deployment: %v", err)
 	}
@@ -507,6 +511,10 @@ func TestDesiredRouterDeploymentSpecAndNetwork(t *testing.T) {
 		{"ROUTER_TCP_BALANCE_SCHEME", true, "source"},
 		{"ROUTER_MAX_CONNECTIONS", true, "auto"},
 		{RouterReloadIntervalEnvName, true, "5s"},
+		{"ROUTER_ERRORFILE_503", true, "/var/lib/haproxy/conf/error_code_pages/error-page-503.http"},
+		{"ROUTER_ERRORFILE_404", true, "/var/lib/haproxy/conf/error_code_pages/error-page-404.http"},
+		{"ROUTER_ERRORFILE_500", true, "/var/lib/haproxy/conf/error_code_pages/error-page-500.http"},
+		{"ROUTER_ERRORFILE_401", false, ""},
 		{"ROUTER_USE_PROXY_PROTOCOL", true, "true"},
 		{"ROUTER_UNIQUE_ID_HEADER_NAME", true, "unique-id"},
 		{"ROUTER_UNIQUE_ID_FORMAT", true, `"%{+X}o %ci:%cp_%fi:%fp_%Ts_%rt:%pid"`},
@@ -557,7 +565,7 @@ func TestDesiredRouterDeploymentSpecAndNetwork(t *testing.T) {
 	if err != nil {
 		t.Errorf("failed to determine infrastructure platform status for ingresscontroller %s/%s: %v", ic.Namespace, ic.Name, err)
 	}
-	deployment, err = desiredRouterDeployment(ic, ingressControllerImage, ingressConfig, infraConfig, apiConfig, networkConfig, proxyNeeded, false, nil)
+	deployment, err = desiredRouterDeployment(ic, "openshift-ingress", ingressControllerImage, "", "", ingressConfig, infraConfig, apiConfig, networkConfig, proxyNeeded, false, nil)
 	if err != nil {
 		t.Fatalf("invalid router Deployment: %v", err)
 	}
@@ -672,7 +680,7 @@ func TestDesiredRouterDeploymentVariety(t *testing.T) {
 	if err != nil {
 		t.Errorf("failed to determine infrastructure platform status for ingresscontroller %s/%s: %v", ic.Namespace, ic.Name, err)
 	}
-	deployment, err := desiredRouterDeployment(ic, ingressControllerImage, ingressConfig, infraConfig, apiConfig, networkConfig, proxyNeeded, false, nil)
+	deployment, err := desiredRouterDeployment(ic, "openshift-ingress", ingressControllerImage, "", "", ingressConfig, infraConfig, apiConfig, networkConfig, proxyNeeded, false, nil)
 	if err != nil {
 		t.Fatalf("invalid router Deployment: %v", err)
 	}
@@ -786,7 +794,7 @@ func TestDesiredRouterDeploymentHostNetworkNil(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	deployment, err := desiredRouterDeployment(ic, ingressControllerImage, ingressConfig, infraConfig, apiConfig, networkConfig, proxyNeeded, false, nil)
+	deployment, err := desiredRouterDeployment(ic, "openshift-ingress", ingressControllerImage, "", "", ingressConfig, infraConfig, apiConfig, networkConfig, proxyNeeded, false, nil)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -817,7 +825,7 @@ func TestDesiredRouterDeploymentSingleReplica(t *testing.T) {
 		},
 	}
 
-	deployment, err := desiredRouterDeployment(ic, ingressControllerImage, ingressConfig, infraConfig, apiConfig, networkConfig, false, false, nil)
+	deployment, err := desiredRouterDeployment(ic, "openshift-ingress", ingressControllerImage, "", "", ingressConfig, infraConfig, apiConfig, networkConfig, false, false, nil)
 	if err != nil {
 		t.Fatalf("invalid router Deployment: %v", err)
 	}
@@ -835,6 +843,106 @@ func TestDesiredRouterDeploymentSingleReplica(t *testing.T) {
 	}
 }
 
+// TestDesiredRouterDeploymentZoneSpread verifies that the replicaPolicy
+// unsupported config override controls whether the zone topology spread
+// constraint is soft or hard.
+func TestDesiredRouterDeploymentZoneSpread(t *testing.T) {
+	ic, ingressConfig, infraConfig, apiConfig, networkConfig, proxyNeeded := getRouterDeploymentComponents(t)
+
+	for _, zoneSpread := range []corev1.UnsatisfiableConstraintAction{corev1.ScheduleAnyway, corev1.DoNotSchedule} {
+		ic.Spec.UnsupportedConfigOverrides = runtime.RawExtension{Raw: []byte(fmt.Sprintf(`{"replicaPolicy":{"zoneSpread":%q}}`, zoneSpread))}
+		deployment, err := desiredRouterDeployment(ic, "openshift-ingress", ingressControllerImage, "", "", ingressConfig, infraConfig, apiConfig, networkConfig, proxyNeeded, false, nil)
+		if err != nil {
+			t.Fatalf("invalid router Deployment: %v", err)
+		}
+		constraints := deployment.Spec.Template.Spec.TopologySpreadConstraints
+		if len(constraints) != 1 || constraints[0].TopologyKey != corev1.LabelTopologyZone {
+			t.Fatalf("expected a single zone topology spread constraint, got %+v", constraints)
+		}
+		if constraints[0].WhenUnsatisfiable != zoneSpread {
+			t.Errorf("expected whenUnsatisfiable %q, got %q", zoneSpread, constraints[0].WhenUnsatisfiable)
+		}
+	}
+}
+
+// TestDesiredRouterDeploymentHTTPHeaderActions verifies that
+// desiredRouterDeployment sets the request and response header environment
+// variables when the ingresscontroller specifies header actions.
+func TestDesiredRouterDeploymentHTTPHeaderActions(t *testing.T) {
+	ic, ingressConfig, infraConfig, apiConfig, networkConfig, proxyNeeded := getRouterDeploymentComponents(t)
+	deployment, err := desiredRouterDeployment(ic, "openshift-ingress", ingressControllerImage, "", "", ingressConfig, infraConfig, apiConfig, networkConfig, proxyNeeded, false, nil)
+	if err != nil {
+		t.Fatalf("invalid router Deployment: %v", err)
+	}
+	tests := []envData{
+		{RouterHTTPRequestHeaders, false, ""},
+		{RouterHTTPResponseHeaders, false, ""},
+	}
+	if err := checkDeploymentEnvironment(t, deployment, tests); err != nil {
+		t.Error(err)
+	}
+
+	ic.Spec.UnsupportedConfigOverrides = runtime.RawExtension{
+		Raw: []byte(`{"httpHeaderActions":{"request":[{"name":"X-Debug","action":"Delete"}],"response":[{"name":"X-Frame-Options","action":"Set","value":"SAMEORIGIN"},{"name":"Server","action":"Delete"}]}}`),
+	}
+	deployment, err = desiredRouterDeployment(ic, "openshift-ingress", ingressControllerImage, "", "", ingressConfig, infraConfig, apiConfig, networkConfig, proxyNeeded, false, nil)
+	if err != nil {
+		t.Fatalf("invalid router Deployment: %v", err)
+	}
+	tests = []envData{
+		{RouterHTTPRequestHeaders, true, "X-Debug:Delete"},
+		{RouterHTTPResponseHeaders, true, "X-Frame-Options:SAMEORIGIN:Set,Server:Delete"},
+	}
+	if err := checkDeploymentEnvironment(t, deployment, tests); err != nil {
+		t.Error(err)
+	}
+	checkDeploymentHasEnvSorted(t, deployment)
+}
+
+// TestEnsureRouterDeploymentNodeAwareReplicas verifies that an
+// ingresscontroller that opts in to node-aware default replicas gets one
+// replica per zone in which router pods can be scheduled.
+func TestEnsureRouterDeploymentNodeAwareReplicas(t *testing.T) {
+	ic, ingressConfig, infraConfig, apiConfig, networkConfig, _ := getRouterDeploymentComponents(t)
+	ic.Spec.Replicas = nil
+	ic.Spec.UnsupportedConfigOverrides = runtime.RawExtension{Raw: []byte(`{"replicaPolicy":{"defaultReplicas":"NodeAware"}}`)}
+	nodes := []corev1.Node{
+		testNode("a", "us-east-1a"),
+		testNode("b", "us-east-1b"),
+		testNode("c", "us-east-1c"),
+		testNode("d", "us-east-1c"),
+	}
+
+	scheme := runtime.NewScheme()
+	appsv1.AddToScheme(scheme)
+	r := &reconciler{client: fake.NewClientBuilder().WithScheme(scheme).Build()}
+	r.config.OperandNamespace = "openshift-ingress"
+	r.config.IngressControllerImage = ingressControllerImage
+
+	platformStatus := &configv1.PlatformStatus{Type: configv1.NonePlatformType}
+	_, deployment, err := r.ensureRouterDeployment(ic, infraConfig, ingressConfig, apiConfig, networkConfig, false, nil, platformStatus, nodes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *deployment.Spec.Replicas != 3 {
+		t.Errorf("expected 3 replicas, got %d", *deployment.Spec.Replicas)
+	}
+
+	// Adding a fourth zone scales the deployment up, and the rolling
+	// update parameters follow the replica count.
+	nodes = append(nodes, testNode("e", "us-east-1d"))
+	_, deployment, err = r.ensureRouterDeployment(ic, infraConfig, ingressConfig, apiConfig, networkConfig, false, nil, platformStatus, nodes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *deployment.Spec.Replicas != 4 {
+		t.Errorf("expected 4 replicas, got %d", *deployment.Spec.Replicas)
+	}
+	if maxUnavailable := deployment.Spec.Strategy.RollingUpdate.MaxUnavailable; *maxUnavailable != intstr.FromString("25%") {
+		t.Errorf("expected max unavailable 25%%, got %s", maxUnavailable.String())
+	}
+}
+
 func checkContainerPort(t *testing.T, d *appsv1.Deployment, portName string, port int32) {
 	t.Helper()
 	for _, p := range d.Spec.Template.Spec.Containers[0].Ports {
@@ -1392,6 +1500,23 @@ func TestDeploymentConfigChanged(t *testing.T) {
 			},
 			expect: true,
 		},
+		{
+			description: "if router container resources change",
+			mutate: func(deployment *appsv1.Deployment) {
+				deployment.Spec.Template.Spec.Containers[0].Resources.Requests = corev1.ResourceList{
+					corev1.ResourceCPU: resource.MustParse("200m"),
+				}
+			},
+			expect: true,
+		},
+		{
+			description: "if .spec.template.spec.terminationGracePeriodSeconds changes",
+			mutate: func(deployment *appsv1.Deployment) {
+				gracePeriod := int64(600)
+				deployment.Spec.Template.Spec.TerminationGracePeriodSeconds = &gracePeriod
+			},
+			expect: true,
+		},
 		{
 			description: "if .spec.HTTPCompressionPolicy changes",
 			mutate: func(deployment *appsv1.Deployment) {
@@ -2053,7 +2178,7 @@ func TestDesiredRouterDeploymentDefaultPlacement(t *testing.T) {
 			// This value does not matter in the context of this test, just use a dummy value
 			dummyProxyNeeded := true
 
-			deployment, err := desiredRouterDeployment(ic, ingressControllerImage, tc.ingressConfig, tc.infraConfig, apiConfig, networkConfig, dummyProxyNeeded, false, nil)
+			deployment, err := desiredRouterDeployment(ic, "openshift-ingress", ingressControllerImage, "", "", tc.ingressConfig, tc.infraConfig, apiConfig, networkConfig, dummyProxyNeeded, false, nil)
 			if err != nil {
 				t.Error(err)
 			}
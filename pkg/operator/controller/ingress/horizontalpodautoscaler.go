@@ -0,0 +1,257 @@
+package ingress
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	"github.com/openshift/cluster-ingress-operator/pkg/manifests"
+	"github.com/openshift/cluster-ingress-operator/pkg/operator/controller"
+
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// defaultAutoscalingMinReplicas is the minimum number of replicas for
+	// an autoscaled router deployment if the ingresscontroller specifies
+	// neither spec.replicas nor a minimum.
+	defaultAutoscalingMinReplicas = 2
+
+	// defaultAutoscalingTargetCPUUtilizationPercentage is the target
+	// average CPU utilization, as a percentage of the router container's
+	// CPU request, if the ingresscontroller specifies no target.
+	defaultAutoscalingTargetCPUUtilizationPercentage = 70
+
+	// routerConnectionsMetricName is the name of the per-pod metric that
+	// the horizontal pod autoscaler uses to scale on HAProxy connections.
+	// The metric must be served by the custom metrics API, for example by
+	// prometheus-adapter.
+	routerConnectionsMetricName = "haproxy_frontend_current_sessions"
+)
+
+// routerAutoscaling holds the autoscaling parameters that an ingresscontroller
+// can specify using the autoscaling unsupported config override.
+type routerAutoscaling struct {
+	// MinReplicas is the lower limit for the number of replicas.  If
+	// zero, spec.replicas is used if it is set, and
+	// defaultAutoscalingMinReplicas otherwise.
+	MinReplicas int32 `json:"minReplicas"`
+	// MaxReplicas is the upper limit for the number of replicas.
+	MaxReplicas int32 `json:"maxReplicas"`
+	// TargetCPUUtilizationPercentage is the target average CPU
+	// utilization across router pods.
+	TargetCPUUtilizationPercentage int32 `json:"targetCPUUtilizationPercentage"`
+	// TargetAverageConnections is the target average number of current
+	// HAProxy sessions per router pod.
+	TargetAverageConnections int64 `json:"targetAverageConnections"`
+}
+
+// routerAutoscalingForIngressController returns the autoscaling parameters for
+// the given ingresscontroller, with defaults applied, or nil if the
+// ingresscontroller does not enable autoscaling.  An error is returned if the
+// parameters cannot be parsed or are invalid.
+func routerAutoscalingForIngressController(ic *operatorv1.IngressController) (*routerAutoscaling, error) {
+	if len(ic.Spec.UnsupportedConfigOverrides.Raw) == 0 {
+		return nil, nil
+	}
+	var unsupportedConfigOverrides struct {
+		Autoscaling *routerAutoscaling `json:"autoscaling"`
+	}
+	if err := json.Unmarshal(ic.Spec.UnsupportedConfigOverrides.Raw, &unsupportedConfigOverrides); err != nil {
+		return nil, fmt.Errorf("ingresscontroller %q has invalid spec.unsupportedConfigOverrides: %w", ic.Name, err)
+	}
+	autoscaling := unsupportedConfigOverrides.Autoscaling
+	if autoscaling == nil {
+		return nil, nil
+	}
+
+	if autoscaling.MinReplicas == 0 {
+		autoscaling.MinReplicas = defaultAutoscalingMinReplicas
+		if ic.Spec.Replicas != nil {
+			autoscaling.MinReplicas = *ic.Spec.Replicas
+		}
+	}
+	if autoscaling.TargetCPUUtilizationPercentage == 0 && autoscaling.TargetAverageConnections == 0 {
+		autoscaling.TargetCPUUtilizationPercentage = defaultAutoscalingTargetCPUUtilizationPercentage
+	}
+
+	switch {
+	case autoscaling.MinReplicas < 1:
+		return nil, fmt.Errorf("spec.unsupportedConfigOverrides.autoscaling.minReplicas must be at least 1, got %d", autoscaling.MinReplicas)
+	case autoscaling.MaxReplicas < autoscaling.MinReplicas:
+		return nil, fmt.Errorf("spec.unsupportedConfigOverrides.autoscaling.maxReplicas (%d) must be at least minReplicas (%d)", autoscaling.MaxReplicas, autoscaling.MinReplicas)
+	case autoscaling.TargetCPUUtilizationPercentage < 0:
+		return nil, fmt.Errorf("spec.unsupportedConfigOverrides.autoscaling.targetCPUUtilizationPercentage must be positive, got %d", autoscaling.TargetCPUUtilizationPercentage)
+	case autoscaling.TargetAverageConnections < 0:
+		return nil, fmt.Errorf("spec.unsupportedConfigOverrides.autoscaling.targetAverageConnections must be positive, got %d", autoscaling.TargetAverageConnections)
+	}
+
+	return autoscaling, nil
+}
+
+// ensureRouterHorizontalPodAutoscaler ensures the horizontal pod autoscaler
+// exists for the given ingresscontroller if it enables autoscaling and does
+// not exist otherwise.  Returns a Boolean indicating whether the HPA exists,
+// the HPA if it does exist, and an error value.
+func (r *reconciler) ensureRouterHorizontalPodAutoscaler(ic *operatorv1.IngressController, deploymentRef metav1.OwnerReference) (bool, *autoscalingv2.HorizontalPodAutoscaler, error) {
+	wantHPA, desired, err := desiredRouterHorizontalPodAutoscaler(ic, r.config.OperandNamespace, deploymentRef)
+	if err != nil {
+		return false, nil, fmt.Errorf("failed to build horizontal pod autoscaler: %w", err)
+	}
+
+	haveHPA, current, err := r.currentRouterHorizontalPodAutoscaler(ic)
+	if err != nil {
+		return false, nil, err
+	}
+
+	switch {
+	case !wantHPA && !haveHPA:
+		return false, nil, nil
+	case !wantHPA && haveHPA:
+		if err := r.client.Delete(context.TODO(), current); err != nil {
+			if !errors.IsNotFound(err) {
+				return true, current, fmt.Errorf("failed to delete horizontal pod autoscaler: %w", err)
+			}
+		} else {
+			log.Info("deleted horizontal pod autoscaler", "horizontalpodautoscaler", current)
+		}
+		return false, nil, nil
+	case wantHPA && !haveHPA:
+		if err := r.client.Create(context.TODO(), desired); err != nil {
+			return false, nil, fmt.Errorf("failed to create horizontal pod autoscaler: %w", err)
+		}
+		log.Info("created horizontal pod autoscaler", "horizontalpodautoscaler", desired)
+		return r.currentRouterHorizontalPodAutoscaler(ic)
+	case wantHPA && haveHPA:
+		if updated, err := r.updateRouterHorizontalPodAutoscaler(current, desired); err != nil {
+			return true, current, fmt.Errorf("failed to update horizontal pod autoscaler: %w", err)
+		} else if updated {
+			return r.currentRouterHorizontalPodAutoscaler(ic)
+		}
+	}
+
+	return true, current, nil
+}
+
+// desiredRouterHorizontalPodAutoscaler returns the desired horizontal pod
+// autoscaler for the router deployment.  Returns a Boolean indicating whether
+// an HPA is desired, as well as the HPA if one is desired.
+func desiredRouterHorizontalPodAutoscaler(ic *operatorv1.IngressController, operandNamespace string, deploymentRef metav1.OwnerReference) (bool, *autoscalingv2.HorizontalPodAutoscaler, error) {
+	autoscaling, err := routerAutoscalingForIngressController(ic)
+	if err != nil || autoscaling == nil {
+		return false, nil, err
+	}
+
+	var metrics []autoscalingv2.MetricSpec
+	if v := autoscaling.TargetCPUUtilizationPercentage; v != 0 {
+		metrics = append(metrics, autoscalingv2.MetricSpec{
+			Type: autoscalingv2.ResourceMetricSourceType,
+			Resource: &autoscalingv2.ResourceMetricSource{
+				Name: corev1.ResourceCPU,
+				Target: autoscalingv2.MetricTarget{
+					Type:               autoscalingv2.UtilizationMetricType,
+					AverageUtilization: &v,
+				},
+			},
+		})
+	}
+	if v := autoscaling.TargetAverageConnections; v != 0 {
+		metrics = append(metrics, autoscalingv2.MetricSpec{
+			Type: autoscalingv2.PodsMetricSourceType,
+			Pods: &autoscalingv2.PodsMetricSource{
+				Metric: autoscalingv2.MetricIdentifier{
+					Name: routerConnectionsMetricName,
+				},
+				Target: autoscalingv2.MetricTarget{
+					Type:         autoscalingv2.AverageValueMetricType,
+					AverageValue: resource.NewQuantity(v, resource.DecimalSI),
+				},
+			},
+		})
+	}
+
+	name := controller.RouterHorizontalPodAutoscalerName(ic, operandNamespace)
+	minReplicas := autoscaling.MinReplicas
+	hpa := &autoscalingv2.HorizontalPodAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name.Name,
+			Namespace: name.Namespace,
+			Labels: map[string]string{
+				manifests.OwningIngressControllerLabel: ic.Name,
+			},
+		},
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+				APIVersion: deploymentRef.APIVersion,
+				Kind:       deploymentRef.Kind,
+				Name:       deploymentRef.Name,
+			},
+			MinReplicas: &minReplicas,
+			MaxReplicas: autoscaling.MaxReplicas,
+			Metrics:     metrics,
+		},
+	}
+	hpa.SetOwnerReferences([]metav1.OwnerReference{deploymentRef})
+
+	return true, hpa, nil
+}
+
+// currentRouterHorizontalPodAutoscaler returns the current horizontal pod
+// autoscaler for the router deployment.  Returns a Boolean indicating whether
+// the HPA existed, the HPA if it did exist, and an error value.
+func (r *reconciler) currentRouterHorizontalPodAutoscaler(ic *operatorv1.IngressController) (bool, *autoscalingv2.HorizontalPodAutoscaler, error) {
+	hpa := &autoscalingv2.HorizontalPodAutoscaler{}
+	if err := r.client.Get(context.TODO(), controller.RouterHorizontalPodAutoscalerName(ic, r.config.OperandNamespace), hpa); err != nil {
+		if errors.IsNotFound(err) {
+			return false, nil, nil
+		}
+		return false, nil, err
+	}
+	return true, hpa, nil
+}
+
+// updateRouterHorizontalPodAutoscaler updates a horizontal pod autoscaler.
+// Returns a Boolean indicating whether the HPA was updated, and an error
+// value.
+func (r *reconciler) updateRouterHorizontalPodAutoscaler(current, desired *autoscalingv2.HorizontalPodAutoscaler) (bool, error) {
+	changed, updated := horizontalPodAutoscalerChanged(current, desired)
+	if !changed {
+		return false, nil
+	}
+
+	// Diff before updating because the client may mutate the object.
+	diff := cmp.Diff(current, updated, cmpopts.EquateEmpty())
+	if err := r.client.Update(context.TODO(), updated); err != nil {
+		return false, err
+	}
+	log.Info("updated horizontal pod autoscaler", "namespace", updated.Namespace, "name", updated.Name, "diff", diff)
+	return true, nil
+}
+
+// horizontalPodAutoscalerChanged checks whether the current horizontal pod
+// autoscaler spec matches the expected spec and if not returns an updated one.
+// Fields that the API server defaults, such as spec.behavior, are ignored.
+func horizontalPodAutoscalerChanged(current, expected *autoscalingv2.HorizontalPodAutoscaler) (bool, *autoscalingv2.HorizontalPodAutoscaler) {
+	if cmp.Equal(current.Spec.ScaleTargetRef, expected.Spec.ScaleTargetRef) &&
+		cmp.Equal(current.Spec.MinReplicas, expected.Spec.MinReplicas) &&
+		current.Spec.MaxReplicas == expected.Spec.MaxReplicas &&
+		cmp.Equal(current.Spec.Metrics, expected.Spec.Metrics, cmpopts.EquateEmpty()) {
+		return false, nil
+	}
+
+	updated := current.DeepCopy()
+	updated.Spec.ScaleTargetRef = expected.Spec.ScaleTargetRef
+	updated.Spec.MinReplicas = expected.Spec.MinReplicas
+	updated.Spec.MaxReplicas = expected.Spec.MaxReplicas
+	updated.Spec.Metrics = expected.Spec.Metrics
+	return true, updated
+}
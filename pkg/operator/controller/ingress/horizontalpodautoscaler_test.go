@@ -0,0 +1,247 @@
+package ingress
+
+import (
+	"context"
+	"testing"
+
+	configv1 "github.com/openshift/api/config/v1"
+	operatorv1 "github.com/openshift/api/operator/v1"
+	"github.com/openshift/cluster-ingress-operator/pkg/operator/controller"
+
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// TestRouterAutoscalingForIngressController verifies that
+// routerAutoscalingForIngressController applies defaults and rejects invalid
+// autoscaling parameters.
+func TestRouterAutoscalingForIngressController(t *testing.T) {
+	pointerTo := func(v int32) *int32 { return &v }
+	testCases := []struct {
+		description string
+		replicas    *int32
+		overrides   string
+		expect      *routerAutoscaling
+		expectError bool
+	}{
+		{
+			description: "no overrides",
+			expect:      nil,
+		},
+		{
+			description: "overrides without autoscaling",
+			overrides:   `{"loadBalancerServiceLabels":{"foo":"bar"}}`,
+			expect:      nil,
+		},
+		{
+			description: "only maxReplicas",
+			overrides:   `{"autoscaling":{"maxReplicas":6}}`,
+			expect: &routerAutoscaling{
+				MinReplicas:                    2,
+				MaxReplicas:                    6,
+				TargetCPUUtilizationPercentage: 70,
+			},
+		},
+		{
+			description: "minReplicas defaults to spec.replicas",
+			replicas:    pointerTo(3),
+			overrides:   `{"autoscaling":{"maxReplicas":6,"targetAverageConnections":1000}}`,
+			expect: &routerAutoscaling{
+				MinReplicas:              3,
+				MaxReplicas:              6,
+				TargetAverageConnections: 1000,
+			},
+		},
+		{
+			description: "both targets",
+			overrides:   `{"autoscaling":{"minReplicas":1,"maxReplicas":4,"targetCPUUtilizationPercentage":50,"targetAverageConnections":500}}`,
+			expect: &routerAutoscaling{
+				MinReplicas:                    1,
+				MaxReplicas:                    4,
+				TargetCPUUtilizationPercentage: 50,
+				TargetAverageConnections:       500,
+			},
+		},
+		{
+			description: "maxReplicas less than minReplicas",
+			overrides:   `{"autoscaling":{"minReplicas":4,"maxReplicas":2}}`,
+			expectError: true,
+		},
+		{
+			description: "negative minReplicas",
+			overrides:   `{"autoscaling":{"minReplicas":-1,"maxReplicas":2}}`,
+			expectError: true,
+		},
+		{
+			description: "negative target",
+			overrides:   `{"autoscaling":{"maxReplicas":2,"targetAverageConnections":-5}}`,
+			expectError: true,
+		},
+		{
+			description: "malformed overrides",
+			overrides:   `{"autoscaling":{"maxReplicas":"two"}}`,
+			expectError: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			ic := &operatorv1.IngressController{
+				ObjectMeta: metav1.ObjectMeta{Name: "default"},
+				Spec: operatorv1.IngressControllerSpec{
+					Replicas: tc.replicas,
+				},
+			}
+			if len(tc.overrides) != 0 {
+				ic.Spec.UnsupportedConfigOverrides = runtime.RawExtension{Raw: []byte(tc.overrides)}
+			}
+			actual, err := routerAutoscalingForIngressController(ic)
+			switch {
+			case tc.expectError && err == nil:
+				t.Fatalf("expected error, got %+v", actual)
+			case !tc.expectError && err != nil:
+				t.Fatalf("unexpected error: %v", err)
+			case tc.expectError:
+				return
+			}
+			if tc.expect == nil && actual == nil {
+				return
+			}
+			if tc.expect == nil || actual == nil || *tc.expect != *actual {
+				t.Errorf("expected %+v, got %+v", tc.expect, actual)
+			}
+		})
+	}
+}
+
+// TestEnsureRouterHorizontalPodAutoscaler verifies that the HPA is created,
+// updated, and deleted as the ingresscontroller's autoscaling parameters
+// change.
+func TestEnsureRouterHorizontalPodAutoscaler(t *testing.T) {
+	trueVar := true
+	deploymentRef := metav1.OwnerReference{
+		APIVersion: "apps/v1",
+		Kind:       "Deployment",
+		Name:       "router-default",
+		UID:        "1",
+		Controller: &trueVar,
+	}
+	ic := &operatorv1.IngressController{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "openshift-ingress-operator",
+			Name:      "default",
+		},
+	}
+	scheme := runtime.NewScheme()
+	autoscalingv2.AddToScheme(scheme)
+	cl := fake.NewClientBuilder().WithScheme(scheme).Build()
+	r := &reconciler{client: cl}
+	r.config.OperandNamespace = "openshift-ingress"
+
+	setOverrides := func(overrides string) {
+		ic.Spec.UnsupportedConfigOverrides = runtime.RawExtension{Raw: []byte(overrides)}
+	}
+
+	// No autoscaling: no HPA.
+	if have, _, err := r.ensureRouterHorizontalPodAutoscaler(ic, deploymentRef); err != nil {
+		t.Fatal(err)
+	} else if have {
+		t.Fatal("expected no horizontal pod autoscaler")
+	}
+
+	// Autoscaling on CPU: HPA is created.
+	setOverrides(`{"autoscaling":{"maxReplicas":5}}`)
+	have, hpa, err := r.ensureRouterHorizontalPodAutoscaler(ic, deploymentRef)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !have {
+		t.Fatal("expected horizontal pod autoscaler to be created")
+	}
+	if hpa.Spec.ScaleTargetRef.Name != controller.RouterDeploymentName(ic, "openshift-ingress").Name || hpa.Spec.ScaleTargetRef.Kind != "Deployment" {
+		t.Errorf("unexpected scale target: %+v", hpa.Spec.ScaleTargetRef)
+	}
+	if *hpa.Spec.MinReplicas != 2 || hpa.Spec.MaxReplicas != 5 {
+		t.Errorf("expected replicas 2-5, got %d-%d", *hpa.Spec.MinReplicas, hpa.Spec.MaxReplicas)
+	}
+	if len(hpa.Spec.Metrics) != 1 || hpa.Spec.Metrics[0].Type != autoscalingv2.ResourceMetricSourceType {
+		t.Errorf("expected a single CPU metric, got %+v", hpa.Spec.Metrics)
+	}
+
+	// Fields defaulted by the API server are not reverted.
+	hpa.Spec.Behavior = &autoscalingv2.HorizontalPodAutoscalerBehavior{}
+	if err := cl.Update(context.Background(), hpa); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := r.ensureRouterHorizontalPodAutoscaler(ic, deploymentRef); err != nil {
+		t.Fatal(err)
+	}
+	if _, current, err := r.currentRouterHorizontalPodAutoscaler(ic); err != nil {
+		t.Fatal(err)
+	} else if current.Spec.Behavior == nil {
+		t.Error("expected spec.behavior to be preserved")
+	}
+
+	// Switching to connections: HPA is updated.
+	setOverrides(`{"autoscaling":{"minReplicas":3,"maxReplicas":8,"targetAverageConnections":2000}}`)
+	if _, hpa, err = r.ensureRouterHorizontalPodAutoscaler(ic, deploymentRef); err != nil {
+		t.Fatal(err)
+	}
+	if *hpa.Spec.MinReplicas != 3 || hpa.Spec.MaxReplicas != 8 {
+		t.Errorf("expected replicas 3-8, got %d-%d", *hpa.Spec.MinReplicas, hpa.Spec.MaxReplicas)
+	}
+	if len(hpa.Spec.Metrics) != 1 || hpa.Spec.Metrics[0].Pods == nil || hpa.Spec.Metrics[0].Pods.Metric.Name != routerConnectionsMetricName {
+		t.Errorf("expected a single connections metric, got %+v", hpa.Spec.Metrics)
+	}
+
+	// Autoscaling disabled: HPA is deleted.
+	setOverrides(`{}`)
+	if have, _, err := r.ensureRouterHorizontalPodAutoscaler(ic, deploymentRef); err != nil {
+		t.Fatal(err)
+	} else if have {
+		t.Fatal("expected horizontal pod autoscaler to be deleted")
+	}
+	if have, _, err := r.currentRouterHorizontalPodAutoscaler(ic); err != nil {
+		t.Fatal(err)
+	} else if have {
+		t.Fatal("expected horizontal pod autoscaler to be gone")
+	}
+}
+
+// TestEnsureRouterDeploymentPreservesAutoscaledReplicas verifies that the
+// operator does not revert the replica count that the horizontal pod
+// autoscaler sets on an autoscaled router deployment.
+func TestEnsureRouterDeploymentPreservesAutoscaledReplicas(t *testing.T) {
+	ic, ingressConfig, infraConfig, apiConfig, networkConfig, _ := getRouterDeploymentComponents(t)
+	ic.Spec.UnsupportedConfigOverrides = runtime.RawExtension{Raw: []byte(`{"autoscaling":{"minReplicas":2,"maxReplicas":10}}`)}
+
+	deployment, err := desiredRouterDeployment(ic, "openshift-ingress", ingressControllerImage, "", "", ingressConfig, infraConfig, apiConfig, networkConfig, false, false, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *deployment.Spec.Replicas != 2 {
+		t.Fatalf("expected initial replicas to be the autoscaling minimum, got %d", *deployment.Spec.Replicas)
+	}
+
+	// Simulate the autoscaler scaling the deployment out.
+	replicas := int32(7)
+	deployment.Spec.Replicas = &replicas
+	scheme := runtime.NewScheme()
+	appsv1.AddToScheme(scheme)
+	cl := fake.NewClientBuilder().WithScheme(scheme).WithObjects(deployment).Build()
+	r := &reconciler{client: cl}
+	r.config.OperandNamespace = "openshift-ingress"
+	r.config.IngressControllerImage = ingressControllerImage
+
+	_, current, err := r.ensureRouterDeployment(ic, infraConfig, ingressConfig, apiConfig, networkConfig, false, nil, &configv1.PlatformStatus{Type: configv1.NonePlatformType}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *current.Spec.Replicas != 7 {
+		t.Errorf("expected replicas to be preserved at 7, got %d", *current.Spec.Replicas)
+	}
+}
@@ -0,0 +1,161 @@
+package ingress
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	corev1 "k8s.io/api/core/v1"
+
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+)
+
+// HttpErrorCodePageKeyRegexp matches the keys of custom error pages in the
+// error-page configmap.  The first submatch is the status code.
+var HttpErrorCodePageKeyRegexp = regexp.MustCompile(`^error-page-([0-9]{3})\.http$`)
+
+// SupportedHttpErrorCodes is the list of HTTP status codes for which the router
+// serves custom error pages.  These are the status codes that HAProxy's
+// errorfile directive accepts, except for 200, 401, and 407, which HAProxy uses
+// for the router's health check and for the stats endpoint's authentication
+// challenge.  The router reads the error page for each code from the
+// ROUTER_ERRORFILE_<code> environment variable.
+var SupportedHttpErrorCodes = []int{400, 403, 404, 405, 408, 410, 413, 425, 429, 500, 501, 502, 503, 504}
+
+// HttpErrorCodePagesMountPath is the directory in which the router container
+// mounts the error-page configmap.
+const HttpErrorCodePagesMountPath = "/var/lib/haproxy/conf/error_code_pages"
+
+// HttpErrorCodePageKey returns the configmap key for the given status code's
+// error page.
+func HttpErrorCodePageKey(code int) string {
+	return fmt.Sprintf("error-page-%d.http", code)
+}
+
+// maxHttpErrorCodePageSize returns the maximum size in bytes of an error page
+// for the given ingresscontroller.  HAProxy requires that an error page fit in
+// a buffer, less the space reserved for header rewrites.
+func maxHttpErrorCodePageSize(ic *operatorv1.IngressController) int {
+	bufferBytes := int(ic.Spec.TuningOptions.HeaderBufferBytes)
+	if bufferBytes == 0 {
+		bufferBytes = routerDefaultHeaderBufferSize
+	}
+	maxRewriteBytes := int(ic.Spec.TuningOptions.HeaderBufferMaxRewriteBytes)
+	if maxRewriteBytes == 0 {
+		maxRewriteBytes = routerDefaultHeaderBufferMaxRewriteSize
+	}
+	return bufferBytes - maxRewriteBytes
+}
+
+// ValidateHttpErrorCodePages validates the error pages in the given
+// ingresscontroller's error-page configmap and returns a copy of the configmap
+// with only the valid error pages, along with an error describing any invalid
+// error pages.  Keys that are not error pages are ignored.
+func ValidateHttpErrorCodePages(ic *operatorv1.IngressController, source *corev1.ConfigMap) (*corev1.ConfigMap, error) {
+	supported := make(map[int]bool, len(SupportedHttpErrorCodes))
+	for _, code := range SupportedHttpErrorCodes {
+		supported[code] = true
+	}
+	maxSize := maxHttpErrorCodePageSize(ic)
+
+	valid := source.DeepCopy()
+	valid.Data = map[string]string{}
+	keys := make([]string, 0, len(source.Data))
+	for key := range source.Data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	var errs []error
+	for _, key := range keys {
+		match := HttpErrorCodePageKeyRegexp.FindStringSubmatch(key)
+		if match == nil {
+			continue
+		}
+		code, err := strconv.Atoi(match[1])
+		if err != nil || !supported[code] {
+			errs = append(errs, fmt.Errorf("%s: status code %s is not supported", key, match[1]))
+			continue
+		}
+		if err := validateHttpErrorCodePage(code, source.Data[key], maxSize); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", key, err))
+			continue
+		}
+		valid.Data[key] = source.Data[key]
+	}
+	return valid, utilerrors.NewAggregate(errs)
+}
+
+// validateHttpErrorCodePage verifies that the given page is a well-formed raw
+// HTTP response with the given status code and that it is no larger than the
+// given size.
+func validateHttpErrorCodePage(code int, page string, maxSize int) error {
+	if len(page) > maxSize {
+		return fmt.Errorf("page is %d bytes, which exceeds the maximum of %d bytes", len(page), maxSize)
+	}
+	if !strings.HasPrefix(page, "HTTP/1.") {
+		return fmt.Errorf("page must begin with an HTTP/1.x status line")
+	}
+	response, err := http.ReadResponse(bufio.NewReader(strings.NewReader(page)), nil)
+	if err != nil {
+		return fmt.Errorf("page is not a valid HTTP response: %w", err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode != code {
+		return fmt.Errorf("page has status code %d, expected %d", response.StatusCode, code)
+	}
+	if _, err := io.ReadAll(response.Body); err != nil {
+		return fmt.Errorf("page body does not match its headers: %w", err)
+	}
+	return nil
+}
+
+// computeHttpErrorCodePagesValidCondition computes the ingresscontroller's
+// HTTPErrorCodePagesValid status condition from the ingresscontroller's
+// error-page configmap, which is nil if it does not exist in the given config
+// namespace.  Returns nil if the
+// ingresscontroller has never specified custom error pages.
+func computeHttpErrorCodePagesValidCondition(ic *operatorv1.IngressController, source *corev1.ConfigMap, configNamespace string) []operatorv1.OperatorCondition {
+	name := ic.Spec.HttpErrorCodePages.Name
+	if len(name) == 0 {
+		for _, cond := range ic.Status.Conditions {
+			if cond.Type == IngressControllerHTTPErrorCodePagesValidConditionType {
+				return []operatorv1.OperatorCondition{{
+					Type:    IngressControllerHTTPErrorCodePagesValidConditionType,
+					Status:  operatorv1.ConditionTrue,
+					Reason:  "NoCustomErrorPages",
+					Message: "The ingresscontroller does not specify custom error pages.",
+				}}
+			}
+		}
+		return nil
+	}
+	if source == nil {
+		return []operatorv1.OperatorCondition{{
+			Type:    IngressControllerHTTPErrorCodePagesValidConditionType,
+			Status:  operatorv1.ConditionFalse,
+			Reason:  "ConfigMapNotFound",
+			Message: fmt.Sprintf("The configmap %q referenced by spec.httpErrorCodePages does not exist in the %q namespace.", name, configNamespace),
+		}}
+	}
+	if _, err := ValidateHttpErrorCodePages(ic, source); err != nil {
+		return []operatorv1.OperatorCondition{{
+			Type:    IngressControllerHTTPErrorCodePagesValidConditionType,
+			Status:  operatorv1.ConditionFalse,
+			Reason:  "InvalidErrorPages",
+			Message: fmt.Sprintf("The configmap %q has invalid error pages, which are ignored: %v", name, err),
+		}}
+	}
+	return []operatorv1.OperatorCondition{{
+		Type:    IngressControllerHTTPErrorCodePagesValidConditionType,
+		Status:  operatorv1.ConditionTrue,
+		Reason:  "Valid",
+		Message: fmt.Sprintf("The error pages in configmap %q are valid.", name),
+	}}
+}
@@ -0,0 +1,217 @@
+package ingress
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	configv1 "github.com/openshift/api/config/v1"
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	corev1 "k8s.io/api/core/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// testErrorPage404 and testErrorPage503 are valid custom error pages.
+	testErrorPage404 = "HTTP/1.0 404 Not Found\r\nConnection: close\r\nContent-Type: text/html\r\n\r\n<html><body>not found</body></html>\r\n"
+	testErrorPage503 = "HTTP/1.0 503 Service Unavailable\r\nConnection: close\r\nContent-Type: text/html\r\n\r\n<html><body>unavailable</body></html>\r\n"
+)
+
+// TestValidateHttpErrorCodePages verifies that ValidateHttpErrorCodePages
+// keeps valid error pages and reports invalid ones.
+func TestValidateHttpErrorCodePages(t *testing.T) {
+	large503 := "HTTP/1.0 503 Service Unavailable\r\n\r\n" + strings.Repeat("x", 2048)
+	testCases := []struct {
+		description string
+		data        map[string]string
+		tuning      operatorv1.IngressControllerTuningOptions
+		expectKeys  []string
+		expectError bool
+	}{
+		{
+			description: "valid 404 and 503 pages",
+			data: map[string]string{
+				"error-page-404.http": testErrorPage404,
+				"error-page-503.http": testErrorPage503,
+			},
+			expectKeys: []string{"error-page-404.http", "error-page-503.http"},
+		},
+		{
+			description: "keys that are not error pages are ignored",
+			data: map[string]string{
+				"README":              "not a page",
+				"error-page-503.http": testErrorPage503,
+			},
+			expectKeys: []string{"error-page-503.http"},
+		},
+		{
+			description: "status code does not match key",
+			data: map[string]string{
+				"error-page-404.http": testErrorPage503,
+			},
+			expectError: true,
+		},
+		{
+			description: "status code that the router does not serve",
+			data: map[string]string{
+				"error-page-401.http":  "HTTP/1.1 401 Unauthorized\r\n\r\n",
+				"error-page-4040.http": testErrorPage404,
+				"error-page-503.http":  testErrorPage503,
+			},
+			expectKeys:  []string{"error-page-503.http"},
+			expectError: true,
+		},
+		{
+			description: "status codes that HAProxy serves besides 404 and 503",
+			data: map[string]string{
+				"error-page-400.http": "HTTP/1.1 400 Bad Request\r\n\r\n",
+				"error-page-403.http": "HTTP/1.1 403 Forbidden\r\n\r\n",
+				"error-page-408.http": "HTTP/1.1 408 Request Timeout\r\n\r\n",
+				"error-page-500.http": "HTTP/1.1 500 Internal Server Error\r\n\r\n",
+				"error-page-502.http": "HTTP/1.1 502 Bad Gateway\r\n\r\n",
+				"error-page-504.http": "HTTP/1.1 504 Gateway Timeout\r\n\r\n",
+			},
+			expectKeys: []string{"error-page-400.http", "error-page-403.http", "error-page-408.http", "error-page-500.http", "error-page-502.http", "error-page-504.http"},
+		},
+		{
+			description: "page exceeds maximum size",
+			data: map[string]string{
+				"error-page-404.http": testErrorPage404,
+				"error-page-503.http": large503,
+			},
+			tuning: operatorv1.IngressControllerTuningOptions{
+				HeaderBufferBytes:           16384,
+				HeaderBufferMaxRewriteBytes: 15360,
+			},
+			expectKeys:  []string{"error-page-404.http"},
+			expectError: true,
+		},
+		{
+			description: "missing status line",
+			data: map[string]string{
+				"error-page-503.http": "<html>unavailable</html>",
+			},
+			expectError: true,
+		},
+		{
+			description: "malformed headers",
+			data: map[string]string{
+				"error-page-503.http": "HTTP/1.1 503 Service Unavailable\r\nContent-Type text/html\r\n\r\nunavailable",
+			},
+			expectError: true,
+		},
+		{
+			description: "body shorter than content length",
+			data: map[string]string{
+				"error-page-503.http": "HTTP/1.1 503 Service Unavailable\r\nContent-Length: 100\r\n\r\nunavailable",
+			},
+			expectError: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			ic := &operatorv1.IngressController{}
+			ic.Spec.TuningOptions = tc.tuning
+			source := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-custom-error-code-pages", Namespace: "openshift-config"},
+				Data:       tc.data,
+			}
+			valid, err := ValidateHttpErrorCodePages(ic, source)
+			switch {
+			case tc.expectError && err == nil:
+				t.Error("expected error, got nil")
+			case !tc.expectError && err != nil:
+				t.Errorf("unexpected error: %v", err)
+			}
+			var keys []string
+			for key := range valid.Data {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+			if len(keys) != 0 || len(tc.expectKeys) != 0 {
+				if !reflect.DeepEqual(tc.expectKeys, keys) {
+					t.Errorf("expected keys %v, got %v", tc.expectKeys, keys)
+				}
+			}
+		})
+	}
+}
+
+// TestComputeHttpErrorCodePagesValidCondition verifies that
+// computeHttpErrorCodePagesValidCondition returns the expected status and
+// reason, and that it omits the condition for an ingresscontroller that has
+// never specified custom error pages.
+func TestComputeHttpErrorCodePagesValidCondition(t *testing.T) {
+	validSource := &corev1.ConfigMap{Data: map[string]string{"error-page-503.http": testErrorPage503}}
+	invalidSource := &corev1.ConfigMap{Data: map[string]string{"error-page-503.http": "<html>unavailable</html>"}}
+	testCases := []struct {
+		description   string
+		configMapName string
+		haveCondition bool
+		source        *corev1.ConfigMap
+		expectNone    bool
+		expectStatus  operatorv1.ConditionStatus
+		expectReason  string
+	}{
+		{
+			description: "no custom error pages",
+			expectNone:  true,
+		},
+		{
+			description:   "custom error pages removed",
+			haveCondition: true,
+			expectStatus:  operatorv1.ConditionTrue,
+			expectReason:  "NoCustomErrorPages",
+		},
+		{
+			description:   "configmap not found",
+			configMapName: "my-custom-error-code-pages",
+			expectStatus:  operatorv1.ConditionFalse,
+			expectReason:  "ConfigMapNotFound",
+		},
+		{
+			description:   "invalid error pages",
+			configMapName: "my-custom-error-code-pages",
+			source:        invalidSource,
+			expectStatus:  operatorv1.ConditionFalse,
+			expectReason:  "InvalidErrorPages",
+		},
+		{
+			description:   "valid error pages",
+			configMapName: "my-custom-error-code-pages",
+			source:        validSource,
+			expectStatus:  operatorv1.ConditionTrue,
+			expectReason:  "Valid",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			ic := &operatorv1.IngressController{
+				ObjectMeta: metav1.ObjectMeta{Name: "default"},
+			}
+			ic.Spec.HttpErrorCodePages = configv1.ConfigMapNameReference{Name: tc.configMapName}
+			if tc.haveCondition {
+				ic.Status.Conditions = []operatorv1.OperatorCondition{{
+					Type:   IngressControllerHTTPErrorCodePagesValidConditionType,
+					Status: operatorv1.ConditionTrue,
+				}}
+			}
+			actual := computeHttpErrorCodePagesValidCondition(ic, tc.source, "openshift-config")
+			if tc.expectNone {
+				if len(actual) != 0 {
+					t.Errorf("expected no condition, got %+v", actual)
+				}
+				return
+			}
+			if len(actual) != 1 {
+				t.Fatalf("expected one condition, got %+v", actual)
+			}
+			if actual[0].Status != tc.expectStatus || actual[0].Reason != tc.expectReason {
+				t.Errorf("expected status %s and reason %s, got %s and %s", tc.expectStatus, tc.expectReason, actual[0].Status, actual[0].Reason)
+			}
+		})
+	}
+}
@@ -0,0 +1,181 @@
+package ingress
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"unicode"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+const (
+	// RouterHTTPRequestHeaders is the environment variable that specifies
+	// the actions that the router performs on request headers.
+	RouterHTTPRequestHeaders = "ROUTER_HTTP_REQUEST_HEADERS"
+	// RouterHTTPResponseHeaders is the environment variable that specifies
+	// the actions that the router performs on response headers.
+	RouterHTTPResponseHeaders = "ROUTER_HTTP_RESPONSE_HEADERS"
+
+	// setHTTPHeaderAction sets a header to the specified value, replacing
+	// any values that the header already has.
+	setHTTPHeaderAction = "Set"
+	// deleteHTTPHeaderAction removes every value of a header.
+	deleteHTTPHeaderAction = "Delete"
+
+	// maxHTTPHeaderActions is the maximum number of request or response
+	// header actions.
+	maxHTTPHeaderActions = 20
+	// maxHTTPHeaderNameLength is the maximum length of a header name.
+	maxHTTPHeaderNameLength = 255
+	// maxHTTPHeaderValueLength is the maximum length of a header value.
+	maxHTTPHeaderValueLength = 16384
+)
+
+// httpHeaderNameRegexp matches a valid HTTP header name, which is an RFC 7230
+// token.
+var httpHeaderNameRegexp = regexp.MustCompile("^[-!#$%&'*+.0-9A-Z^_`a-z|~]+$")
+
+var (
+	// forbiddenHTTPHeaderNames is the set of header names, in lower case,
+	// that neither request nor response header actions may modify because
+	// the router or other ingresscontroller fields manage them.
+	forbiddenHTTPHeaderNames = sets.NewString("strict-transport-security", "proxy", "cookie", "set-cookie")
+	// forbiddenHTTPRequestHeaderNames is the set of additional header
+	// names, in lower case, that request header actions may not modify.
+	forbiddenHTTPRequestHeaderNames = sets.NewString("host")
+)
+
+// httpHeaderAction specifies an action that the router performs on an HTTP
+// header.
+type httpHeaderAction struct {
+	// Name is the name of the header.
+	Name string `json:"name"`
+	// Action is either setHTTPHeaderAction or deleteHTTPHeaderAction.
+	Action string `json:"action"`
+	// Value is the value to which to set the header.  It is required for
+	// setHTTPHeaderAction and forbidden for deleteHTTPHeaderAction.
+	// HAProxy interprets "%" as the start of a log-format expression, so a
+	// literal "%" must be written as "%%".
+	Value string `json:"value,omitempty"`
+}
+
+// httpHeaderActions holds the header actions that an ingresscontroller can
+// specify using the httpHeaderActions unsupported config override.  The
+// actions are applied in order, to every request or response that the router
+// handles.
+type httpHeaderActions struct {
+	Request  []httpHeaderAction `json:"request"`
+	Response []httpHeaderAction `json:"response"`
+}
+
+// httpHeaderActionsForIngressController returns the HTTP header actions for
+// the given ingresscontroller, or nil if it does not specify any.  An error is
+// returned if the actions cannot be parsed or are invalid.
+func httpHeaderActionsForIngressController(ic *operatorv1.IngressController) (*httpHeaderActions, error) {
+	if len(ic.Spec.UnsupportedConfigOverrides.Raw) == 0 {
+		return nil, nil
+	}
+	var unsupportedConfigOverrides struct {
+		HTTPHeaderActions *httpHeaderActions `json:"httpHeaderActions"`
+	}
+	if err := json.Unmarshal(ic.Spec.UnsupportedConfigOverrides.Raw, &unsupportedConfigOverrides); err != nil {
+		return nil, fmt.Errorf("ingresscontroller %q has invalid spec.unsupportedConfigOverrides: %w", ic.Name, err)
+	}
+	actions := unsupportedConfigOverrides.HTTPHeaderActions
+	if actions == nil {
+		return nil, nil
+	}
+
+	var errs []error
+	errs = append(errs, validateHTTPHeaderActions("spec.unsupportedConfigOverrides.httpHeaderActions.request", actions.Request, true)...)
+	errs = append(errs, validateHTTPHeaderActions("spec.unsupportedConfigOverrides.httpHeaderActions.response", actions.Response, false)...)
+	if err := utilerrors.NewAggregate(errs); err != nil {
+		return nil, err
+	}
+
+	return actions, nil
+}
+
+// validateHTTPHeaderActions validates the given list of request or response
+// header actions and returns any errors, prefixed with the given field path.
+func validateHTTPHeaderActions(path string, actions []httpHeaderAction, isRequest bool) []error {
+	var errs []error
+	if len(actions) > maxHTTPHeaderActions {
+		errs = append(errs, fmt.Errorf("%s must have at most %d items, got %d", path, maxHTTPHeaderActions, len(actions)))
+	}
+	seen := sets.NewString()
+	for i, action := range actions {
+		fieldPath := fmt.Sprintf("%s[%d]", path, i)
+		name := strings.ToLower(action.Name)
+		switch {
+		case len(action.Name) == 0:
+			errs = append(errs, fmt.Errorf("%s.name must be specified", fieldPath))
+		case len(action.Name) > maxHTTPHeaderNameLength:
+			errs = append(errs, fmt.Errorf("%s.name must be at most %d characters", fieldPath, maxHTTPHeaderNameLength))
+		case !httpHeaderNameRegexp.MatchString(action.Name):
+			errs = append(errs, fmt.Errorf("%s.name %q is not a valid HTTP header name", fieldPath, action.Name))
+		case forbiddenHTTPHeaderNames.Has(name), isRequest && forbiddenHTTPRequestHeaderNames.Has(name):
+			errs = append(errs, fmt.Errorf("%s.name %q is managed by the router and may not be modified", fieldPath, action.Name))
+		case seen.Has(name):
+			errs = append(errs, fmt.Errorf("%s.name %q is specified more than once", fieldPath, action.Name))
+		}
+		seen.Insert(name)
+
+		switch action.Action {
+		case setHTTPHeaderAction:
+			if err := validateHTTPHeaderValue(action.Value); err != nil {
+				errs = append(errs, fmt.Errorf("%s.value: %w", fieldPath, err))
+			}
+		case deleteHTTPHeaderAction:
+			if len(action.Value) != 0 {
+				errs = append(errs, fmt.Errorf("%s.value must be empty for action %q", fieldPath, deleteHTTPHeaderAction))
+			}
+		default:
+			errs = append(errs, fmt.Errorf("%s.action must be %q or %q, got %q", fieldPath, setHTTPHeaderAction, deleteHTTPHeaderAction, action.Action))
+		}
+	}
+	return errs
+}
+
+// validateHTTPHeaderValue verifies that the given value is non-empty, is not
+// too long, has no control characters, and escapes every "%" as "%%".
+func validateHTTPHeaderValue(value string) error {
+	if len(value) == 0 {
+		return fmt.Errorf("must be specified for action %q", setHTTPHeaderAction)
+	}
+	if len(value) > maxHTTPHeaderValueLength {
+		return fmt.Errorf("must be at most %d characters", maxHTTPHeaderValueLength)
+	}
+	for _, r := range value {
+		if unicode.IsControl(r) {
+			return fmt.Errorf("must not contain control characters")
+		}
+	}
+	if strings.Contains(strings.ReplaceAll(value, "%%", ""), "%") {
+		return fmt.Errorf(`must escape "%%" as "%%%%"`)
+	}
+	return nil
+}
+
+// serializeHTTPHeaderActions returns the value of the RouterHTTPRequestHeaders
+// or RouterHTTPResponseHeaders environment variable for the given actions: a
+// comma-separated list of "name:value:Set" and "name:Delete" items, with the
+// name and value query-escaped.
+func serializeHTTPHeaderActions(actions []httpHeaderAction) string {
+	items := make([]string, 0, len(actions))
+	for _, action := range actions {
+		switch action.Action {
+		case setHTTPHeaderAction:
+			items = append(items, fmt.Sprintf("%s:%s:%s", url.QueryEscape(action.Name), url.QueryEscape(action.Value), action.Action))
+		case deleteHTTPHeaderAction:
+			items = append(items, fmt.Sprintf("%s:%s", url.QueryEscape(action.Name), action.Action))
+		}
+	}
+	return strings.Join(items, ",")
+}
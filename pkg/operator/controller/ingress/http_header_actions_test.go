@@ -0,0 +1,163 @@
+package ingress
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// TestHTTPHeaderActionsForIngressController verifies that
+// httpHeaderActionsForIngressController parses valid header actions and
+// rejects invalid ones.
+func TestHTTPHeaderActionsForIngressController(t *testing.T) {
+	tooMany := make([]string, maxHTTPHeaderActions+1)
+	for i := range tooMany {
+		tooMany[i] = fmt.Sprintf(`{"name":"X-Header-%d","action":"Delete"}`, i)
+	}
+	testCases := []struct {
+		description string
+		overrides   string
+		expect      *httpHeaderActions
+		expectError bool
+	}{
+		{
+			description: "no overrides",
+			expect:      nil,
+		},
+		{
+			description: "overrides without header actions",
+			overrides:   `{"loadBalancingAlgorithm":"leastconn"}`,
+			expect:      nil,
+		},
+		{
+			description: "set and delete",
+			overrides:   `{"httpHeaderActions":{"request":[{"name":"X-Client","action":"Set","value":"router"}],"response":[{"name":"X-Frame-Options","action":"Set","value":"DENY"},{"name":"Server","action":"Delete"}]}}`,
+			expect: &httpHeaderActions{
+				Request: []httpHeaderAction{
+					{Name: "X-Client", Action: setHTTPHeaderAction, Value: "router"},
+				},
+				Response: []httpHeaderAction{
+					{Name: "X-Frame-Options", Action: setHTTPHeaderAction, Value: "DENY"},
+					{Name: "Server", Action: deleteHTTPHeaderAction},
+				},
+			},
+		},
+		{
+			description: "escaped percent sign",
+			overrides:   `{"httpHeaderActions":{"response":[{"name":"X-Discount","action":"Set","value":"50%%"}]}}`,
+			expect: &httpHeaderActions{
+				Response: []httpHeaderAction{
+					{Name: "X-Discount", Action: setHTTPHeaderAction, Value: "50%%"},
+				},
+			},
+		},
+		{
+			description: "host response header",
+			overrides:   `{"httpHeaderActions":{"response":[{"name":"Host","action":"Delete"}]}}`,
+			expect: &httpHeaderActions{
+				Response: []httpHeaderAction{
+					{Name: "Host", Action: deleteHTTPHeaderAction},
+				},
+			},
+		},
+		{
+			description: "host request header",
+			overrides:   `{"httpHeaderActions":{"request":[{"name":"Host","action":"Set","value":"example.com"}]}}`,
+			expectError: true,
+		},
+		{
+			description: "managed response header",
+			overrides:   `{"httpHeaderActions":{"response":[{"name":"Strict-Transport-Security","action":"Delete"}]}}`,
+			expectError: true,
+		},
+		{
+			description: "invalid header name",
+			overrides:   `{"httpHeaderActions":{"response":[{"name":"X Frame Options","action":"Delete"}]}}`,
+			expectError: true,
+		},
+		{
+			description: "missing header name",
+			overrides:   `{"httpHeaderActions":{"response":[{"action":"Delete"}]}}`,
+			expectError: true,
+		},
+		{
+			description: "duplicate header name",
+			overrides:   `{"httpHeaderActions":{"response":[{"name":"x-frame-options","action":"Delete"},{"name":"X-Frame-Options","action":"Set","value":"DENY"}]}}`,
+			expectError: true,
+		},
+		{
+			description: "invalid action",
+			overrides:   `{"httpHeaderActions":{"response":[{"name":"X-Frame-Options","action":"Append","value":"DENY"}]}}`,
+			expectError: true,
+		},
+		{
+			description: "set without value",
+			overrides:   `{"httpHeaderActions":{"response":[{"name":"X-Frame-Options","action":"Set"}]}}`,
+			expectError: true,
+		},
+		{
+			description: "delete with value",
+			overrides:   `{"httpHeaderActions":{"response":[{"name":"Server","action":"Delete","value":"haproxy"}]}}`,
+			expectError: true,
+		},
+		{
+			description: "value with control character",
+			overrides:   `{"httpHeaderActions":{"response":[{"name":"X-Frame-Options","action":"Set","value":"DENY\r\nX-Injected: true"}]}}`,
+			expectError: true,
+		},
+		{
+			description: "value with unescaped percent sign",
+			overrides:   `{"httpHeaderActions":{"response":[{"name":"X-Client-IP","action":"Set","value":"%ci"}]}}`,
+			expectError: true,
+		},
+		{
+			description: "too many actions",
+			overrides:   `{"httpHeaderActions":{"request":[` + strings.Join(tooMany, ",") + `]}}`,
+			expectError: true,
+		},
+		{
+			description: "malformed overrides",
+			overrides:   `{"httpHeaderActions":{"response":{"name":"Server"}}}`,
+			expectError: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			ic := &operatorv1.IngressController{
+				ObjectMeta: metav1.ObjectMeta{Name: "default"},
+			}
+			if len(tc.overrides) != 0 {
+				ic.Spec.UnsupportedConfigOverrides = runtime.RawExtension{Raw: []byte(tc.overrides)}
+			}
+			actual, err := httpHeaderActionsForIngressController(ic)
+			switch {
+			case tc.expectError && err == nil:
+				t.Fatalf("expected error, got %+v", actual)
+			case !tc.expectError && err != nil:
+				t.Fatalf("unexpected error: %v", err)
+			case !tc.expectError && !reflect.DeepEqual(tc.expect, actual):
+				t.Errorf("expected %+v, got %+v", tc.expect, actual)
+			}
+		})
+	}
+}
+
+// TestSerializeHTTPHeaderActions verifies that serializeHTTPHeaderActions
+// escapes header names and values.
+func TestSerializeHTTPHeaderActions(t *testing.T) {
+	actions := []httpHeaderAction{
+		{Name: "X-Frame-Options", Action: setHTTPHeaderAction, Value: "DENY"},
+		{Name: "Content-Security-Policy", Action: setHTTPHeaderAction, Value: "default-src 'self'; img-src *, data:"},
+		{Name: "Server", Action: deleteHTTPHeaderAction},
+	}
+	expected := "X-Frame-Options:DENY:Set,Content-Security-Policy:default-src+%27self%27%3B+img-src+%2A%2C+data%3A:Set,Server:Delete"
+	if actual := serializeHTTPHeaderActions(actions); actual != expected {
+		t.Errorf("expected %q, got %q", expected, actual)
+	}
+}
@@ -23,7 +23,7 @@ const (
 // ensureInternalRouterServiceForIngress ensures that an internal service exists
 // for a given IngressController.
 func (r *reconciler) ensureInternalIngressControllerService(ic *operatorv1.IngressController, deploymentRef metav1.OwnerReference) (*corev1.Service, error) {
-	desired := desiredInternalIngressControllerService(ic, deploymentRef)
+	desired := desiredInternalIngressControllerService(ic, r.config.OperandNamespace, deploymentRef)
 	current, err := r.currentInternalIngressControllerService(ic)
 	if err != nil {
 		return nil, err
@@ -41,7 +41,7 @@ func (r *reconciler) ensureInternalIngressControllerService(ic *operatorv1.Ingre
 
 func (r *reconciler) currentInternalIngressControllerService(ic *operatorv1.IngressController) (*corev1.Service, error) {
 	current := &corev1.Service{}
-	err := r.client.Get(context.TODO(), controller.InternalIngressControllerServiceName(ic), current)
+	err := r.client.Get(context.TODO(), controller.InternalIngressControllerServiceName(ic, r.config.OperandNamespace), current)
 	if err != nil {
 		if errors.IsNotFound(err) {
 			return nil, nil
@@ -51,10 +51,10 @@ func (r *reconciler) currentInternalIngressControllerService(ic *operatorv1.Ingr
 	return current, nil
 }
 
-func desiredInternalIngressControllerService(ic *operatorv1.IngressController, deploymentRef metav1.OwnerReference) *corev1.Service {
+func desiredInternalIngressControllerService(ic *operatorv1.IngressController, operandNamespace string, deploymentRef metav1.OwnerReference) *corev1.Service {
 	s := manifests.InternalIngressControllerService()
 
-	name := controller.InternalIngressControllerServiceName(ic)
+	name := controller.InternalIngressControllerServiceName(ic, operandNamespace)
 
 	s.Namespace = name.Namespace
 	s.Name = name.Name
@@ -0,0 +1,139 @@
+package ingress
+
+import (
+	"fmt"
+	"time"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// LintResult is the result of linting an ingresscontroller.
+type LintResult struct {
+	// IngressController is the ingresscontroller with the defaults that
+	// admission sets.
+	IngressController *operatorv1.IngressController
+	// Errors are the reasons for which the operator would reject the
+	// ingresscontroller or fail to reconcile it.
+	Errors []string
+	// Warnings are settings that the operator would accept but ignore or
+	// change.
+	Warnings []string
+}
+
+// LintIngressController runs the operator's admission and defaulting logic
+// against the given ingresscontroller without using a client, and reports the
+// reasons for which the operator would reject the ingresscontroller, as well as
+// settings that the operator would ignore or change.  The existing
+// ingresscontrollers are used to check that the ingresscontroller's domain is
+// unique; existing ingresscontrollers that admission has not yet processed are
+// given the domain that admission would give them, and existing
+// ingresscontrollers that admission rejected are ignored.
+func LintIngressController(ic *operatorv1.IngressController, existing []operatorv1.IngressController, config RenderConfig) *LintResult {
+	result := &LintResult{IngressController: ic.DeepCopy()}
+	if config.InfraConfig == nil || config.InfraConfig.Status.PlatformStatus == nil {
+		result.Errors = append(result.Errors, "infrastructure config has no platform status")
+		return result
+	}
+	config = config.complete()
+
+	others := make([]operatorv1.IngressController, 0, len(existing))
+	for i := range existing {
+		other := existing[i].DeepCopy()
+		if len(other.Namespace) == 0 {
+			other.Namespace = config.OperatorNamespace
+		}
+		if !hasAdmittedCondition(other) {
+			setDefaultDomain(other, config.IngressConfig)
+			other.Status.Conditions = MergeConditions(other.Status.Conditions, operatorv1.OperatorCondition{
+				Type:   IngressControllerAdmittedConditionType,
+				Status: operatorv1.ConditionTrue,
+			})
+		}
+		others = append(others, *other)
+	}
+
+	admitted, errs := admitIngressController(ic, config, others)
+	result.IngressController = admitted
+	for _, err := range errs {
+		result.Errors = append(result.Errors, err.Error())
+	}
+	result.Warnings = lintWarnings(admitted, config)
+	if len(errs) != 0 {
+		return result
+	}
+
+	if len(admitted.Spec.ClientTLS.ClientCA.Name) != 0 && config.ClientCAConfigMap == nil {
+		result.Warnings = append(result.Warnings, fmt.Sprintf("spec.clientTLS.clientCA references configmap %q, which is not available, so the router deployment was not checked", admitted.Spec.ClientTLS.ClientCA.Name))
+		return result
+	}
+	if _, _, err := RenderIngressController(admitted, config); err != nil {
+		result.Errors = append(result.Errors, err.Error())
+	}
+	return result
+}
+
+// hasAdmittedCondition returns a Boolean value indicating whether admission has
+// processed the given ingresscontroller, whether or not it admitted it.
+func hasAdmittedCondition(ic *operatorv1.IngressController) bool {
+	for _, cond := range ic.Status.Conditions {
+		if cond.Type == IngressControllerAdmittedConditionType {
+			return true
+		}
+	}
+	return false
+}
+
+// lintWarnings returns the settings of the given admitted ingresscontroller
+// that the operator would ignore or change.
+func lintWarnings(ic *operatorv1.IngressController, config RenderConfig) []string {
+	var warnings []string
+
+	if eps := ic.Status.EndpointPublishingStrategy; eps != nil && eps.Type == operatorv1.LoadBalancerServiceStrategyType && eps.LoadBalancer != nil && eps.LoadBalancer.DNSManagementPolicy == operatorv1.UnmanagedLoadBalancerDNS && len(ic.Status.Domain) != 0 && !manageDNSForDomain(ic.Status.Domain, config.InfraConfig.Status.PlatformStatus, config.DNSConfig) {
+		warnings = append(warnings, fmt.Sprintf("domain %q does not match the cluster's base domain %q, so the operator does not manage the wildcard DNS record", ic.Status.Domain, config.DNSConfig.Spec.BaseDomain))
+	}
+
+	tuning := ic.Spec.TuningOptions
+	for _, timeout := range []struct {
+		field string
+		value *metav1.Duration
+	}{
+		{"spec.tuningOptions.clientTimeout", tuning.ClientTimeout},
+		{"spec.tuningOptions.clientFinTimeout", tuning.ClientFinTimeout},
+		{"spec.tuningOptions.serverTimeout", tuning.ServerTimeout},
+		{"spec.tuningOptions.serverFinTimeout", tuning.ServerFinTimeout},
+		{"spec.tuningOptions.tunnelTimeout", tuning.TunnelTimeout},
+		{"spec.tuningOptions.tlsInspectDelay", tuning.TLSInspectDelay},
+		{"spec.tuningOptions.healthCheckInterval", tuning.HealthCheckInterval},
+	} {
+		if timeout.value == nil {
+			continue
+		}
+		switch d := timeout.value.Duration; {
+		case d < 0:
+			warnings = append(warnings, fmt.Sprintf("%s is negative and is ignored", timeout.field))
+		case d > haproxyMaxTimeoutMilliseconds:
+			warnings = append(warnings, fmt.Sprintf("%s exceeds the maximum timeout that HAProxy allows and is clipped to %s", timeout.field, durationToHAProxyTimespec(d)))
+		}
+	}
+	if interval := tuning.HealthCheckInterval; interval != nil && interval.Duration > 0 && interval.Duration < time.Second {
+		warnings = append(warnings, "spec.tuningOptions.healthCheckInterval is less than 1s and is ignored")
+	}
+
+	if val, ok := ic.Annotations[RouterHardStopAfterAnnotation]; ok && len(val) != 0 {
+		if clipped, err := clipHAProxyTimeoutValue(val); err != nil {
+			warnings = append(warnings, fmt.Sprintf("annotation %s has an invalid value %q and is ignored: %v", RouterHardStopAfterAnnotation, val, err))
+		} else if clipped != val {
+			warnings = append(warnings, fmt.Sprintf("annotation %s exceeds the maximum timeout that HAProxy allows and is clipped to %s", RouterHardStopAfterAnnotation, clipped))
+		}
+	}
+
+	if len(ic.Spec.HttpErrorCodePages.Name) != 0 && config.ErrorPagesConfigMap != nil {
+		if _, err := ValidateHttpErrorCodePages(ic, config.ErrorPagesConfigMap); err != nil {
+			warnings = append(warnings, fmt.Sprintf("configmap %q referenced by spec.httpErrorCodePages has invalid error pages, which are ignored: %v", ic.Spec.HttpErrorCodePages.Name, err))
+		}
+	}
+
+	return warnings
+}
@@ -0,0 +1,170 @@
+package ingress
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	configv1 "github.com/openshift/api/config/v1"
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	corev1 "k8s.io/api/core/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TestLintIngressController verifies that LintIngressController reports the
+// reasons for which the operator would reject an ingresscontroller and the
+// settings that the operator would ignore or change.
+func TestLintIngressController(t *testing.T) {
+	config := RenderConfig{
+		OperatorNamespace: "openshift-ingress-operator",
+		DNSConfig:         &configv1.DNS{Spec: configv1.DNSSpec{BaseDomain: "example.com"}},
+		InfraConfig: &configv1.Infrastructure{
+			Status: configv1.InfrastructureStatus{
+				PlatformStatus: &configv1.PlatformStatus{Type: configv1.AWSPlatformType},
+			},
+		},
+		IngressConfig: &configv1.Ingress{Spec: configv1.IngressSpec{Domain: "apps.example.com"}},
+	}
+	ic := func(name, domain string) *operatorv1.IngressController {
+		return &operatorv1.IngressController{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       operatorv1.IngressControllerSpec{Domain: domain},
+		}
+	}
+
+	testCases := []struct {
+		name             string
+		ic               *operatorv1.IngressController
+		existing         []operatorv1.IngressController
+		config           RenderConfig
+		expectedErrors   []string
+		expectedWarnings []string
+	}{
+		{
+			name:   "valid",
+			ic:     ic("default", ""),
+			config: config,
+		},
+		{
+			name:           "no platform status",
+			ic:             ic("default", ""),
+			config:         RenderConfig{InfraConfig: &configv1.Infrastructure{}},
+			expectedErrors: []string{"infrastructure config has no platform status"},
+		},
+		{
+			name:           "no domain",
+			ic:             ic("default", ""),
+			config:         RenderConfig{InfraConfig: config.InfraConfig},
+			expectedErrors: []string{"domain is required"},
+		},
+		{
+			name:           "domain conflicts with an existing ingresscontroller that has not been admitted",
+			ic:             ic("sharded", "apps.example.com"),
+			existing:       []operatorv1.IngressController{*ic("default", "")},
+			config:         config,
+			expectedErrors: []string{"conflicts with: default"},
+		},
+		{
+			name: "domain conflicts with an existing ingresscontroller that was rejected",
+			ic:   ic("sharded", "apps.example.com"),
+			existing: []operatorv1.IngressController{func() operatorv1.IngressController {
+				rejected := ic("default", "")
+				rejected.Status.Domain = "apps.example.com"
+				rejected.Status.Conditions = []operatorv1.OperatorCondition{{
+					Type:   IngressControllerAdmittedConditionType,
+					Status: operatorv1.ConditionFalse,
+				}}
+				return *rejected
+			}()},
+			config: config,
+		},
+		{
+			name:     "same ingresscontroller in the existing ingresscontrollers",
+			ic:       ic("default", ""),
+			existing: []operatorv1.IngressController{*ic("default", "")},
+			config:   config,
+		},
+		{
+			name:             "domain does not match the base domain",
+			ic:               ic("sharded", "apps.example.org"),
+			config:           config,
+			expectedWarnings: []string{`domain "apps.example.org" does not match the cluster's base domain "example.com"`},
+		},
+		{
+			name: "clipped and ignored tuning options",
+			ic: func() *operatorv1.IngressController {
+				ic := ic("default", "")
+				ic.Annotations = map[string]string{RouterHardStopAfterAnnotation: "1y"}
+				ic.Spec.TuningOptions.ServerTimeout = &metav1.Duration{Duration: 1000 * time.Hour}
+				ic.Spec.TuningOptions.HealthCheckInterval = &metav1.Duration{Duration: 500 * time.Millisecond}
+				return ic
+			}(),
+			config: config,
+			expectedWarnings: []string{
+				"spec.tuningOptions.serverTimeout exceeds the maximum timeout that HAProxy allows and is clipped to 2147483647ms",
+				"spec.tuningOptions.healthCheckInterval is less than 1s and is ignored",
+				"annotation ingress.operator.openshift.io/hard-stop-after has an invalid value",
+			},
+		},
+		{
+			name: "client CA configmap not provided",
+			ic: func() *operatorv1.IngressController {
+				ic := ic("default", "")
+				ic.Spec.ClientTLS.ClientCertificatePolicy = operatorv1.ClientCertificatePolicyRequired
+				ic.Spec.ClientTLS.ClientCA.Name = "client-ca"
+				return ic
+			}(),
+			config:           config,
+			expectedWarnings: []string{`spec.clientTLS.clientCA references configmap "client-ca", which is not available`},
+		},
+		{
+			name: "unsupported error page",
+			ic: func() *operatorv1.IngressController {
+				ic := ic("default", "")
+				ic.Spec.HttpErrorCodePages.Name = "error-pages"
+				return ic
+			}(),
+			config: func() RenderConfig {
+				c := config
+				c.ErrorPagesConfigMap = &corev1.ConfigMap{Data: map[string]string{
+					"error-page-418.http": "HTTP/1.1 418 I'm a teapot\r\n\r\n",
+				}}
+				return c
+			}(),
+			expectedWarnings: []string{`configmap "error-pages" referenced by spec.httpErrorCodePages has invalid error pages`},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := LintIngressController(tc.ic, tc.existing, tc.config)
+			checkMessages(t, "errors", result.Errors, tc.expectedErrors)
+			checkMessages(t, "warnings", result.Warnings, tc.expectedWarnings)
+			if len(result.Errors) == 0 && len(tc.config.IngressConfig.Spec.Domain) != 0 {
+				if result.IngressController.Status.EndpointPublishingStrategy == nil {
+					t.Errorf("expected the endpoint publishing strategy to be defaulted")
+				}
+				if result.IngressController.Namespace != "openshift-ingress-operator" {
+					t.Errorf("expected the namespace to be defaulted, got %q", result.IngressController.Namespace)
+				}
+			}
+		})
+	}
+}
+
+// checkMessages verifies that each actual message starts with the
+// corresponding expected prefix.
+func checkMessages(t *testing.T, what string, actual, expected []string) {
+	t.Helper()
+	if len(actual) != len(expected) {
+		t.Errorf("expected %s %q, got %q", what, expected, actual)
+		return
+	}
+	for i := range expected {
+		if !strings.HasPrefix(actual[i], expected[i]) {
+			t.Errorf("expected %s %q, got %q", what, expected, actual)
+			return
+		}
+	}
+}
@@ -23,6 +23,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
 
 	crclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -135,6 +136,26 @@ const (
 	// scope changes if changing scope requires deleting service
 	// load-balancers on the current platform.
 	autoDeleteLoadBalancerAnnotation = "ingress.operator.openshift.io/auto-delete-load-balancer"
+
+	// passthroughAnnotationsAnnotation is an annotation that the operator
+	// sets on a LoadBalancer-type service to record the keys, as a sorted,
+	// comma-separated list, of the annotations that the operator applied
+	// to the service from the ingresscontroller's
+	// spec.unsupportedConfigOverrides.loadBalancerServiceAnnotations field.
+	// The operator uses this list to remove annotations that the user has
+	// removed from the ingresscontroller.
+	passthroughAnnotationsAnnotation = "ingress.operator.openshift.io/passthrough-annotations"
+
+	// passthroughLabelsAnnotation is like passthroughAnnotationsAnnotation
+	// but records the keys of the labels that the operator applied from
+	// spec.unsupportedConfigOverrides.loadBalancerServiceLabels.
+	passthroughLabelsAnnotation = "ingress.operator.openshift.io/passthrough-labels"
+
+	// awsLoadBalancerTuningAnnotationsAnnotation is like
+	// passthroughAnnotationsAnnotation but records the keys of the
+	// annotations that the operator applied from
+	// spec.unsupportedConfigOverrides.awsLoadBalancer.
+	awsLoadBalancerTuningAnnotationsAnnotation = "ingress.operator.openshift.io/aws-load-balancer-tuning-annotations"
 )
 
 var (
@@ -235,6 +256,17 @@ var (
 			//
 			// https://kubernetes.io/docs/concepts/services-networking/service/#proxy-protocol-support-on-aws
 			awsLBProxyProtocolAnnotation,
+			// Annotations that record which annotations and labels
+			// the operator applied from the ingresscontroller's
+			// unsupported config overrides.  The AWS load balancer
+			// parameters that can be specified using the
+			// awsLoadBalancer unsupported config override are not
+			// in this set; the operator manages them only if it
+			// applies them, as recorded in the
+			// aws-load-balancer-tuning-annotations annotation.
+			passthroughAnnotationsAnnotation,
+			passthroughLabelsAnnotation,
+			awsLoadBalancerTuningAnnotationsAnnotation,
 		)
 
 		// Azure and GCP support switching between internal and external
@@ -255,13 +287,84 @@ var (
 
 		return result
 	}()
+
+	// passthroughAnnotationPrefixes maps platform to the prefixes of the
+	// cloud-provider annotations that an ingresscontroller may specify for
+	// its LoadBalancer-type service.  Platforms that are absent from this
+	// map do not allow any passthrough annotations.
+	passthroughAnnotationPrefixes = map[configv1.PlatformType][]string{
+		configv1.AWSPlatformType: {
+			"service.beta.kubernetes.io/aws-load-balancer-",
+		},
+		configv1.AzurePlatformType: {
+			"service.beta.kubernetes.io/azure-",
+		},
+		configv1.GCPPlatformType: {
+			"cloud.google.com/",
+			"networking.gke.io/",
+		},
+		configv1.IBMCloudPlatformType: {
+			"service.kubernetes.io/ibm-load-balancer-cloud-provider-",
+		},
+		configv1.PowerVSPlatformType: {
+			"service.kubernetes.io/ibm-load-balancer-cloud-provider-",
+		},
+		configv1.AlibabaCloudPlatformType: {
+			"service.beta.kubernetes.io/alibaba-cloud-loadbalancer-",
+		},
+		configv1.OpenStackPlatformType: {
+			"loadbalancer.openstack.org/",
+		},
+	}
+
+	// deniedPassthroughAnnotations is the set of annotations that an
+	// ingresscontroller may not specify for its LoadBalancer-type service
+	// even if the platform allowlist would otherwise allow them.  These
+	// annotations either are set by the operator from API fields, so
+	// passing them through would conflict with the API, or change the
+	// load balancer in ways that bypass the ingresscontroller's scope or
+	// allowed source ranges.
+	deniedPassthroughAnnotations = func() sets.String {
+		result := sets.NewString(
+			awsLBAdditionalResourceTags,
+			corev1.AnnotationLoadBalancerSourceRangesKey,
+			// Changes the scope of the load balancer.
+			"service.beta.kubernetes.io/aws-load-balancer-scheme",
+			"service.beta.kubernetes.io/azure-load-balancer-internal-subnet",
+			"networking.gke.io/load-balancer-type",
+		).Union(managedLoadBalancerServiceAnnotations).Union(awsLoadBalancerTuningAnnotationKeys)
+		for _, annotations := range InternalLBAnnotations {
+			for name := range annotations {
+				result.Insert(name)
+			}
+		}
+		for _, annotations := range externalLBAnnotations {
+			for name := range annotations {
+				result.Insert(name)
+			}
+		}
+		return result
+	}()
+
+	// deniedPassthroughLabelDomains is the list of label domains that an
+	// ingresscontroller may not use for labels on its LoadBalancer-type
+	// service.  Labels in these domains are interpreted by Kubernetes
+	// components; for example, service.kubernetes.io/service-proxy-name
+	// causes kube-proxy to ignore the service.
+	deniedPassthroughLabelDomains = []string{
+		"kubernetes.io",
+		"k8s.io",
+		"openshift.io",
+	}
 )
 
 // ensureLoadBalancerService creates an LB service if one is desired but absent.
 // Always returns the current LB service if one exists (whether it already
-// existed or was created during the course of the function).
+// existed or was created during the course of the function).  While a
+// blue/green migration is in progress, the returned service is the one that
+// the wildcard DNS record should target, which may be the migration service.
 func (r *reconciler) ensureLoadBalancerService(ci *operatorv1.IngressController, deploymentRef metav1.OwnerReference, platformStatus *configv1.PlatformStatus) (bool, *corev1.Service, error) {
-	wantLBS, desiredLBService, err := desiredLoadBalancerService(ci, deploymentRef, platformStatus)
+	wantLBS, desiredLBService, err := desiredLoadBalancerService(ci, r.config.OperandNamespace, deploymentRef, platformStatus)
 	if err != nil {
 		return false, nil, err
 	}
@@ -274,12 +377,39 @@ func (r *reconciler) ensureLoadBalancerService(ci *operatorv1.IngressController,
 	// BZ2054200: Don't modify/delete services that are not directly owned by this controller.
 	ownLBS := isServiceOwnedByIngressController(currentLBService, ci)
 
+	if haveLBS && ownLBS {
+		if updated, err := r.normalizeLoadBalancerServiceAnnotations(currentLBService); err != nil {
+			return true, currentLBService, fmt.Errorf("failed to normalize annotations for load balancer service: %w", err)
+		} else if updated {
+			haveLBS, currentLBService, err = r.currentLoadBalancerService(ci)
+			if err != nil {
+				return haveLBS, currentLBService, err
+			}
+		}
+	}
+
+	haveMigrationLBS, migrationLBService, err := r.currentMigrationLoadBalancerService(ci)
+	if err != nil {
+		return haveLBS, currentLBService, err
+	}
+	if haveMigrationLBS && !wantLBS {
+		if err := r.deleteLoadBalancerService(migrationLBService, &crclient.DeleteOptions{}); err != nil {
+			return haveLBS, currentLBService, err
+		}
+	}
+	if wantLBS && (!haveLBS || ownLBS) {
+		startMigration := haveLBS && useBlueGreenLoadBalancerMigration(ci) && loadBalancerServiceNeedsReplacement(currentLBService, desiredLBService, platformStatus)
+		if haveMigrationLBS || startMigration {
+			return r.migrateLoadBalancerService(ci, currentLBService, migrationLBService, desiredLBService, platformStatus)
+		}
+	}
+
 	switch {
 	case !wantLBS && !haveLBS:
 		return false, nil, nil
 	case !wantLBS && haveLBS:
 		if !ownLBS {
-			return false, nil, fmt.Errorf("a conflicting load balancer service exists that is not owned by the ingress controller: %s", controller.LoadBalancerServiceName(ci))
+			return false, nil, fmt.Errorf("a conflicting load balancer service exists that is not owned by the ingress controller: %s", controller.LoadBalancerServiceName(ci, r.config.OperandNamespace))
 		}
 		if err := r.deleteLoadBalancerService(currentLBService, &crclient.DeleteOptions{}); err != nil {
 			return true, currentLBService, err
@@ -292,15 +422,7 @@ func (r *reconciler) ensureLoadBalancerService(ci *operatorv1.IngressController,
 		return r.currentLoadBalancerService(ci)
 	case wantLBS && haveLBS:
 		if !ownLBS {
-			return false, nil, fmt.Errorf("a conflicting load balancer service exists that is not owned by the ingress controller: %s", controller.LoadBalancerServiceName(ci))
-		}
-		if updated, err := r.normalizeLoadBalancerServiceAnnotations(currentLBService); err != nil {
-			return true, currentLBService, fmt.Errorf("failed to normalize annotations for load balancer service: %w", err)
-		} else if updated {
-			haveLBS, currentLBService, err = r.currentLoadBalancerService(ci)
-			if err != nil {
-				return haveLBS, currentLBService, err
-			}
+			return false, nil, fmt.Errorf("a conflicting load balancer service exists that is not owned by the ingress controller: %s", controller.LoadBalancerServiceName(ci, r.config.OperandNamespace))
 		}
 		deleteIfScopeChanged := false
 		if _, ok := ci.Annotations[autoDeleteLoadBalancerAnnotation]; ok {
@@ -327,13 +449,13 @@ func isServiceOwnedByIngressController(service *corev1.Service, ic *operatorv1.I
 // ingresscontroller, or nil if an LB service isn't desired. An LB service is
 // desired if the high availability type is Cloud. An LB service will declare an
 // owner reference to the given deployment.
-func desiredLoadBalancerService(ci *operatorv1.IngressController, deploymentRef metav1.OwnerReference, platform *configv1.PlatformStatus) (bool, *corev1.Service, error) {
+func desiredLoadBalancerService(ci *operatorv1.IngressController, operandNamespace string, deploymentRef metav1.OwnerReference, platform *configv1.PlatformStatus) (bool, *corev1.Service, error) {
 	if ci.Status.EndpointPublishingStrategy.Type != operatorv1.LoadBalancerServiceStrategyType {
 		return false, nil, nil
 	}
 	service := manifests.LoadBalancerService()
 
-	name := controller.LoadBalancerServiceName(ci)
+	name := controller.LoadBalancerServiceName(ci, operandNamespace)
 
 	service.Namespace = name.Namespace
 	service.Name = name.Name
@@ -417,6 +539,20 @@ func desiredLoadBalancerService(ci *operatorv1.IngressController, deploymentRef
 			service.Annotations[awsLBHealthCheckTimeoutAnnotation] = awsLBHealthCheckTimeoutDefault
 			service.Annotations[awsLBHealthCheckUnhealthyThresholdAnnotation] = awsLBHealthCheckUnhealthyThresholdDefault
 			service.Annotations[awsLBHealthCheckHealthyThresholdAnnotation] = awsLBHealthCheckHealthyThresholdDefault
+
+			// Apply any health check, cross-zone, subnet, Elastic IP,
+			// access log, and security group parameters that the
+			// ingresscontroller specifies.
+			tuningAnnotations, err := awsLoadBalancerTuningAnnotations(ci, platform)
+			if err != nil {
+				return true, service, err
+			}
+			for k, v := range tuningAnnotations {
+				service.Annotations[k] = v
+			}
+			if len(tuningAnnotations) != 0 {
+				service.Annotations[awsLoadBalancerTuningAnnotationsAnnotation] = strings.Join(sets.StringKeySet(tuningAnnotations).List(), ",")
+			}
 		case configv1.IBMCloudPlatformType, configv1.PowerVSPlatformType:
 			// Set ExternalTrafficPolicy to type Cluster - IBM's LoadBalancer impl is created within the cluster.
 			// LB places VIP on one of the worker nodes, using keepalived to maintain the VIP and ensuring redundancy
@@ -449,10 +585,147 @@ func desiredLoadBalancerService(ci *operatorv1.IngressController, deploymentRef
 		}
 	}
 
+	annotations, labels, err := loadBalancerServicePassthrough(ci, platform)
+	if err != nil {
+		return true, service, err
+	}
+	if len(annotations) != 0 {
+		for k, v := range annotations {
+			service.Annotations[k] = v
+		}
+		service.Annotations[passthroughAnnotationsAnnotation] = strings.Join(sets.StringKeySet(annotations).List(), ",")
+	}
+	if len(labels) != 0 {
+		for k, v := range labels {
+			service.Labels[k] = v
+		}
+		service.Annotations[passthroughLabelsAnnotation] = strings.Join(sets.StringKeySet(labels).List(), ",")
+	}
+
 	service.SetOwnerReferences([]metav1.OwnerReference{deploymentRef})
 	return true, service, nil
 }
 
+// loadBalancerServicePassthrough returns the annotations and labels that the
+// given ingresscontroller specifies for its LoadBalancer-type service using the
+// loadBalancerServiceAnnotations and loadBalancerServiceLabels unsupported
+// config overrides.  An error is returned if the overrides cannot be parsed or
+// specify an annotation or label that is not allowed on the given platform.
+func loadBalancerServicePassthrough(ic *operatorv1.IngressController, platform *configv1.PlatformStatus) (map[string]string, map[string]string, error) {
+	if len(ic.Spec.UnsupportedConfigOverrides.Raw) == 0 {
+		return nil, nil, nil
+	}
+	var unsupportedConfigOverrides struct {
+		LoadBalancerServiceAnnotations map[string]string `json:"loadBalancerServiceAnnotations"`
+		LoadBalancerServiceLabels      map[string]string `json:"loadBalancerServiceLabels"`
+	}
+	if err := json.Unmarshal(ic.Spec.UnsupportedConfigOverrides.Raw, &unsupportedConfigOverrides); err != nil {
+		return nil, nil, fmt.Errorf("ingresscontroller %q has invalid spec.unsupportedConfigOverrides: %w", ic.Name, err)
+	}
+	annotations := unsupportedConfigOverrides.LoadBalancerServiceAnnotations
+	labels := unsupportedConfigOverrides.LoadBalancerServiceLabels
+
+	var errs []error
+	for _, k := range sets.StringKeySet(annotations).List() {
+		if err := validatePassthroughAnnotation(k, platform); err != nil {
+			errs = append(errs, fmt.Errorf("invalid spec.unsupportedConfigOverrides.loadBalancerServiceAnnotations: %w", err))
+		}
+	}
+	for _, k := range sets.StringKeySet(labels).List() {
+		if err := validatePassthroughLabel(k, labels[k]); err != nil {
+			errs = append(errs, fmt.Errorf("invalid spec.unsupportedConfigOverrides.loadBalancerServiceLabels: %w", err))
+		}
+	}
+	if err := kerrors.NewAggregate(errs); err != nil {
+		return nil, nil, err
+	}
+
+	return annotations, labels, nil
+}
+
+// validatePassthroughAnnotation returns an error if the given annotation key
+// may not be passed through to a LoadBalancer-type service on the given
+// platform.
+func validatePassthroughAnnotation(key string, platform *configv1.PlatformStatus) error {
+	if errs := validation.IsQualifiedName(key); len(errs) != 0 {
+		return fmt.Errorf("annotation %q: %s", key, strings.Join(errs, "; "))
+	}
+	if deniedPassthroughAnnotations.Has(key) {
+		return fmt.Errorf("annotation %q is managed by the operator or is not allowed", key)
+	}
+	if !isAllowedPassthroughAnnotation(key, platform.Type) {
+		return fmt.Errorf("annotation %q is not allowed on platform %q", key, platform.Type)
+	}
+	return nil
+}
+
+// isAllowedPassthroughAnnotation returns a Boolean value indicating whether
+// the given annotation key matches the allowlist for the given platform and is
+// not denied.
+func isAllowedPassthroughAnnotation(key string, platform configv1.PlatformType) bool {
+	if deniedPassthroughAnnotations.Has(key) {
+		return false
+	}
+	for _, prefix := range passthroughAnnotationPrefixes[platform] {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// validatePassthroughLabel returns an error if the given label may not be
+// passed through to a LoadBalancer-type service.
+func validatePassthroughLabel(key, value string) error {
+	if errs := validation.IsQualifiedName(key); len(errs) != 0 {
+		return fmt.Errorf("label %q: %s", key, strings.Join(errs, "; "))
+	}
+	if errs := validation.IsValidLabelValue(value); len(errs) != 0 {
+		return fmt.Errorf("label %q has invalid value %q: %s", key, value, strings.Join(errs, "; "))
+	}
+	if !isAllowedPassthroughLabel(key) {
+		return fmt.Errorf("label %q is managed by the operator or is not allowed", key)
+	}
+	return nil
+}
+
+// isAllowedPassthroughLabel returns a Boolean value indicating whether the
+// given label key may be passed through to a LoadBalancer-type service.
+func isAllowedPassthroughLabel(key string) bool {
+	if key == "router" || key == manifests.OwningIngressControllerLabel {
+		return false
+	}
+	if i := strings.Index(key, "/"); i != -1 {
+		domain := key[:i]
+		for _, denied := range deniedPassthroughLabelDomains {
+			if domain == denied || strings.HasSuffix(domain, "."+denied) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// managedAWSLoadBalancerTuningAnnotations returns the keys of the AWS load
+// balancer tuning annotations that the operator applied to the current service
+// or wants to apply to the expected service.
+func managedAWSLoadBalancerTuningAnnotations(current, expected *corev1.Service) sets.String {
+	keys := passthroughKeys(expected, awsLoadBalancerTuningAnnotationsAnnotation).Union(passthroughKeys(current, awsLoadBalancerTuningAnnotationsAnnotation))
+	return keys.Intersection(awsLoadBalancerTuningAnnotationKeys)
+}
+
+// passthroughKeys returns the keys that are recorded in the given service's
+// annotation with the given name.
+func passthroughKeys(service *corev1.Service, annotation string) sets.String {
+	result := sets.NewString()
+	for _, k := range strings.Split(service.Annotations[annotation], ",") {
+		if k = strings.TrimSpace(k); len(k) != 0 {
+			result.Insert(k)
+		}
+	}
+	return result
+}
+
 // shouldUseLocalWithFallback returns a Boolean value indicating whether the
 // local-with-fallback annotation should be set for the given service, and
 // returns an error if the given ingresscontroller has an invalid unsupported
@@ -489,7 +762,7 @@ func shouldUseLocalWithFallback(ic *operatorv1.IngressController, service *corev
 // ingresscontroller.
 func (r *reconciler) currentLoadBalancerService(ci *operatorv1.IngressController) (bool, *corev1.Service, error) {
 	service := &corev1.Service{}
-	if err := r.client.Get(context.TODO(), controller.LoadBalancerServiceName(ci), service); err != nil {
+	if err := r.client.Get(context.TODO(), controller.LoadBalancerServiceName(ci, r.config.OperandNamespace), service); err != nil {
 		if errors.IsNotFound(err) {
 			return false, nil, nil
 		}
@@ -564,7 +837,7 @@ func (r *reconciler) updateLoadBalancerService(current, desired *corev1.Service,
 		return true, nil
 	}
 
-	changed, updated := loadBalancerServiceChanged(current, desired)
+	changed, updated := loadBalancerServiceChanged(current, desired, platform)
 	if !changed {
 		return false, nil
 	}
@@ -603,7 +876,7 @@ func scopeEqual(a, b *corev1.Service, platform *configv1.PlatformStatus) bool {
 
 // loadBalancerServiceChanged checks if the current load balancer service
 // matches the expected and if not returns an updated one.
-func loadBalancerServiceChanged(current, expected *corev1.Service) (bool, *corev1.Service) {
+func loadBalancerServiceChanged(current, expected *corev1.Service, platform *configv1.PlatformStatus) (bool, *corev1.Service) {
 	// Preserve most fields and annotations.  If a new release of the
 	// operator starts managing an annotation or spec field that it
 	// previously ignored, it could stomp user changes when the user
@@ -612,7 +885,48 @@ func loadBalancerServiceChanged(current, expected *corev1.Service) (bool, *corev
 	// avoid problems, make sure the previous release blocks upgrades when
 	// the user has modified an annotation or spec field that the new
 	// release manages.
-	changed, updated := loadBalancerServiceAnnotationsChanged(current, expected, managedLoadBalancerServiceAnnotations)
+	//
+	// Besides the annotations that the operator always manages, manage
+	// the passthrough annotations that the operator previously applied or
+	// wants to apply.  Annotations that the operator previously applied
+	// but that are no longer allowed are left alone.  Likewise, manage the
+	// AWS load balancer tuning annotations only if the operator previously
+	// applied them or wants to apply them, so that annotations that the
+	// user set on the service are preserved.
+	managedAnnotations := managedLoadBalancerServiceAnnotations.Union(passthroughKeys(expected, passthroughAnnotationsAnnotation))
+	for k := range passthroughKeys(current, passthroughAnnotationsAnnotation) {
+		if isAllowedPassthroughAnnotation(k, platform.Type) {
+			managedAnnotations.Insert(k)
+		}
+	}
+	managedAnnotations = managedAnnotations.Union(managedAWSLoadBalancerTuningAnnotations(current, expected))
+	changed, updated := loadBalancerServiceAnnotationsChanged(current, expected, managedAnnotations)
+
+	managedLabels := passthroughKeys(expected, passthroughLabelsAnnotation)
+	for k := range passthroughKeys(current, passthroughLabelsAnnotation) {
+		if isAllowedPassthroughLabel(k) {
+			managedLabels.Insert(k)
+		}
+	}
+	for k := range managedLabels {
+		currentVal, have := current.Labels[k]
+		expectedVal, want := expected.Labels[k]
+		if have == want && currentVal == expectedVal {
+			continue
+		}
+		if !changed {
+			changed = true
+			updated = current.DeepCopy()
+		}
+		if want {
+			if updated.Labels == nil {
+				updated.Labels = map[string]string{}
+			}
+			updated.Labels[k] = expectedVal
+		} else {
+			delete(updated.Labels, k)
+		}
+	}
 
 	// If spec.loadBalancerSourceRanges is nonempty on the service, that
 	// means that allowedSourceRanges is nonempty on the ingresscontroller,
@@ -701,7 +1015,7 @@ func loadBalancerServiceTagsModified(current, expected *corev1.Service) (bool, *
 // the service, then the return value is a non-nil error indicating that the
 // modification must be reverted before upgrading is allowed.
 func loadBalancerServiceIsUpgradeable(ic *operatorv1.IngressController, deploymentRef metav1.OwnerReference, current *corev1.Service, platform *configv1.PlatformStatus) error {
-	want, desired, err := desiredLoadBalancerService(ic, deploymentRef, platform)
+	want, desired, err := desiredLoadBalancerService(ic, current.Namespace, deploymentRef, platform)
 	if err != nil {
 		return err
 	}
@@ -730,9 +1044,12 @@ func loadBalancerServiceIsProgressing(ic *operatorv1.IngressController, service
 	}
 	if wantScope != haveScope {
 		err := fmt.Errorf("The IngressController scope was changed from %q to %q.", haveScope, wantScope)
-		switch platform.Type {
-		case configv1.AWSPlatformType, configv1.IBMCloudPlatformType:
-			err = fmt.Errorf("%[1]s  To effectuate this change, you must delete the service: `oc -n %[2]s delete svc/%[3]s`; the service load-balancer will then be deprovisioned and a new one created.  This will most likely cause the new load-balancer to have a different host name and IP address from the old one's.  Alternatively, you can revert the change to the IngressController: `oc -n openshift-ingress-operator patch ingresscontrollers/%[4]s --type=merge --patch='{\"spec\":{\"endpointPublishingStrategy\":{\"loadBalancer\":{\"scope\":\"%[5]s\"}}}}'", err.Error(), service.Namespace, service.Name, ic.Name, haveScope)
+		_, platformHasMutableScope := platformsWithMutableScope[platform.Type]
+		switch {
+		case useBlueGreenLoadBalancerMigration(ic) && !platformHasMutableScope:
+			err = fmt.Errorf("%s  A new load balancer is being provisioned; the operator will switch the wildcard DNS record to it once it is ready and then delete the old load balancer.  Until then, both load balancers exist and are billed by the cloud provider.  To avoid running a second load balancer, remove the %s annotation from the IngressController and delete the service instead.", err.Error(), loadBalancerMigrationStrategyAnnotation)
+		case platform.Type == configv1.AWSPlatformType, platform.Type == configv1.IBMCloudPlatformType:
+			err = fmt.Errorf("%[1]s  To effectuate this change, you must delete the service: `oc -n %[2]s delete svc/%[3]s`; the service load-balancer will then be deprovisioned and a new one created.  This will most likely cause the new load-balancer to have a different host name and IP address from the old one's.  Alternatively, you can revert the change to the IngressController: `oc -n openshift-ingress-operator patch ingresscontrollers/%[4]s --type=merge --patch='{\"spec\":{\"endpointPublishingStrategy\":{\"loadBalancer\":{\"scope\":\"%[5]s\"}}}}'`, or annotate the IngressController with %[6]s=%[7]s to have the operator migrate to a new load balancer without downtime; note that during such a migration a second load balancer is created and billed by the cloud provider.", err.Error(), service.Namespace, service.Name, ic.Name, haveScope, loadBalancerMigrationStrategyAnnotation, blueGreenLoadBalancerMigrationStrategy)
 		}
 		errs = append(errs, err)
 	}
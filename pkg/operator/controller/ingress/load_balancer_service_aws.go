@@ -0,0 +1,303 @@
+package ingress
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	configv1 "github.com/openshift/api/config/v1"
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+const (
+	// awsLBCrossZoneLoadBalancingAnnotation enables or disables cross-zone
+	// load balancing.
+	//
+	// https://kubernetes.io/docs/reference/labels-annotations-taints/#service-beta-kubernetes-io-aws-load-balancer-cross-zone-load-balancing-enabled
+	awsLBCrossZoneLoadBalancingAnnotation = "service.beta.kubernetes.io/aws-load-balancer-cross-zone-load-balancing-enabled"
+
+	// awsLBSubnetsAnnotation is a comma-separated list of subnet IDs or
+	// names in which the load balancer is provisioned.
+	awsLBSubnetsAnnotation = "service.beta.kubernetes.io/aws-load-balancer-subnets"
+
+	// awsLBEIPAllocationsAnnotation is a comma-separated list of Elastic IP
+	// allocation IDs to assign to an external NLB, one per subnet.
+	awsLBEIPAllocationsAnnotation = "service.beta.kubernetes.io/aws-load-balancer-eip-allocations"
+
+	// awsLBAccessLogEnabledAnnotation enables access logs for a Classic
+	// ELB.
+	awsLBAccessLogEnabledAnnotation = "service.beta.kubernetes.io/aws-load-balancer-access-log-enabled"
+
+	// awsLBAccessLogEmitIntervalAnnotation is the interval, in minutes, at
+	// which a Classic ELB publishes access logs.  Must be 5 or 60.
+	awsLBAccessLogEmitIntervalAnnotation = "service.beta.kubernetes.io/aws-load-balancer-access-log-emit-interval"
+
+	// awsLBAccessLogS3BucketNameAnnotation is the name of the S3 bucket to
+	// which a Classic ELB publishes access logs.
+	awsLBAccessLogS3BucketNameAnnotation = "service.beta.kubernetes.io/aws-load-balancer-access-log-s3-bucket-name"
+
+	// awsLBAccessLogS3BucketPrefixAnnotation is the prefix of the access
+	// log objects that a Classic ELB publishes to the S3 bucket.
+	awsLBAccessLogS3BucketPrefixAnnotation = "service.beta.kubernetes.io/aws-load-balancer-access-log-s3-bucket-prefix"
+
+	// awsLBSecurityGroupsAnnotation is a comma-separated list of security
+	// groups that replace the security group that the cloud provider
+	// creates for a Classic ELB.
+	awsLBSecurityGroupsAnnotation = "service.beta.kubernetes.io/aws-load-balancer-security-groups"
+
+	// awsLBExtraSecurityGroupsAnnotation is a comma-separated list of
+	// security groups that are added to a Classic ELB in addition to the
+	// security group that the cloud provider creates.
+	awsLBExtraSecurityGroupsAnnotation = "service.beta.kubernetes.io/aws-load-balancer-extra-security-groups"
+)
+
+// awsLoadBalancerTuningAnnotationKeys is the set of annotations that
+// awsLoadBalancerTuningAnnotations may return.
+var awsLoadBalancerTuningAnnotationKeys = sets.NewString(
+	awsLBHealthCheckIntervalAnnotation,
+	awsLBHealthCheckTimeoutAnnotation,
+	awsLBHealthCheckUnhealthyThresholdAnnotation,
+	awsLBHealthCheckHealthyThresholdAnnotation,
+	awsLBCrossZoneLoadBalancingAnnotation,
+	awsLBSubnetsAnnotation,
+	awsLBEIPAllocationsAnnotation,
+	awsLBAccessLogEnabledAnnotation,
+	awsLBAccessLogEmitIntervalAnnotation,
+	awsLBAccessLogS3BucketNameAnnotation,
+	awsLBAccessLogS3BucketPrefixAnnotation,
+	awsLBSecurityGroupsAnnotation,
+	awsLBExtraSecurityGroupsAnnotation,
+)
+
+// awsLoadBalancerTuning holds the AWS load balancer parameters that an
+// ingresscontroller can specify using the awsLoadBalancer unsupported config
+// override.
+type awsLoadBalancerTuning struct {
+	// HealthCheck overrides the default health check parameters.
+	HealthCheck *awsLoadBalancerHealthCheck `json:"healthCheck"`
+	// CrossZoneLoadBalancing enables or disables cross-zone load
+	// balancing.  If nil, the cloud provider's default is used.
+	CrossZoneLoadBalancing *bool `json:"crossZoneLoadBalancing"`
+	// Subnets pins the load balancer to the given subnet IDs or names.
+	Subnets []string `json:"subnets"`
+	// EIPAllocations assigns the given Elastic IP allocations to an
+	// external NLB.  If Subnets is specified, EIPAllocations must have
+	// the same length.
+	EIPAllocations []string `json:"eipAllocations"`
+	// AccessLog configures access logs for a Classic ELB.
+	AccessLog *awsLoadBalancerAccessLog `json:"accessLog"`
+	// SecurityGroups replaces the security group that the cloud provider
+	// creates for a Classic ELB.
+	SecurityGroups []string `json:"securityGroups"`
+	// ExtraSecurityGroups adds security groups to a Classic ELB.
+	ExtraSecurityGroups []string `json:"extraSecurityGroups"`
+}
+
+// awsLoadBalancerHealthCheck holds health check parameters.  Zero values mean
+// that the operator's default is used.
+type awsLoadBalancerHealthCheck struct {
+	IntervalSeconds    int `json:"intervalSeconds"`
+	TimeoutSeconds     int `json:"timeoutSeconds"`
+	HealthyThreshold   int `json:"healthyThreshold"`
+	UnhealthyThreshold int `json:"unhealthyThreshold"`
+}
+
+// awsLoadBalancerAccessLog holds access log parameters for a Classic ELB.
+type awsLoadBalancerAccessLog struct {
+	Enabled             bool   `json:"enabled"`
+	BucketName          string `json:"bucketName"`
+	BucketPrefix        string `json:"bucketPrefix"`
+	EmitIntervalMinutes int    `json:"emitIntervalMinutes"`
+}
+
+// awsLoadBalancerTuningAnnotations returns the service annotations for the
+// AWS load balancer parameters that the given ingresscontroller specifies
+// using the awsLoadBalancer unsupported config override.  An error is returned
+// if the override cannot be parsed or specifies invalid parameters.  The
+// returned annotations override the defaults that desiredLoadBalancerService
+// sets.
+func awsLoadBalancerTuningAnnotations(ic *operatorv1.IngressController, platform *configv1.PlatformStatus) (map[string]string, error) {
+	if len(ic.Spec.UnsupportedConfigOverrides.Raw) == 0 {
+		return nil, nil
+	}
+	var unsupportedConfigOverrides struct {
+		AWSLoadBalancer *awsLoadBalancerTuning `json:"awsLoadBalancer"`
+	}
+	if err := json.Unmarshal(ic.Spec.UnsupportedConfigOverrides.Raw, &unsupportedConfigOverrides); err != nil {
+		return nil, fmt.Errorf("ingresscontroller %q has invalid spec.unsupportedConfigOverrides: %w", ic.Name, err)
+	}
+	tuning := unsupportedConfigOverrides.AWSLoadBalancer
+	if tuning == nil {
+		return nil, nil
+	}
+	if platform == nil || platform.Type != configv1.AWSPlatformType {
+		return nil, fmt.Errorf("spec.unsupportedConfigOverrides.awsLoadBalancer is only supported on AWS")
+	}
+
+	lbType := operatorv1.AWSClassicLoadBalancer
+	isInternal := false
+	if eps := ic.Status.EndpointPublishingStrategy; eps != nil && eps.LoadBalancer != nil {
+		lb := eps.LoadBalancer
+		isInternal = lb.Scope == operatorv1.InternalLoadBalancer
+		if lb.ProviderParameters != nil && lb.ProviderParameters.AWS != nil && lb.ProviderParameters.AWS.Type == operatorv1.AWSNetworkLoadBalancer {
+			lbType = operatorv1.AWSNetworkLoadBalancer
+		}
+	}
+
+	var errs []error
+	annotations := map[string]string{}
+	field := func(name string) string {
+		return "spec.unsupportedConfigOverrides.awsLoadBalancer." + name
+	}
+
+	if hc := tuning.HealthCheck; hc != nil {
+		errs = append(errs, validateAWSLoadBalancerHealthCheck(hc, lbType))
+		if hc.IntervalSeconds != 0 {
+			annotations[awsLBHealthCheckIntervalAnnotation] = strconv.Itoa(hc.IntervalSeconds)
+		}
+		if hc.TimeoutSeconds != 0 {
+			annotations[awsLBHealthCheckTimeoutAnnotation] = strconv.Itoa(hc.TimeoutSeconds)
+		}
+		if hc.HealthyThreshold != 0 {
+			annotations[awsLBHealthCheckHealthyThresholdAnnotation] = strconv.Itoa(hc.HealthyThreshold)
+		}
+		if hc.UnhealthyThreshold != 0 {
+			annotations[awsLBHealthCheckUnhealthyThresholdAnnotation] = strconv.Itoa(hc.UnhealthyThreshold)
+		}
+	}
+
+	if tuning.CrossZoneLoadBalancing != nil {
+		annotations[awsLBCrossZoneLoadBalancingAnnotation] = strconv.FormatBool(*tuning.CrossZoneLoadBalancing)
+	}
+
+	if len(tuning.Subnets) != 0 {
+		errs = append(errs, validateAWSResourceIDs(field("subnets"), tuning.Subnets, ""))
+		annotations[awsLBSubnetsAnnotation] = strings.Join(tuning.Subnets, ",")
+	}
+
+	if len(tuning.EIPAllocations) != 0 {
+		switch {
+		case lbType != operatorv1.AWSNetworkLoadBalancer:
+			errs = append(errs, fmt.Errorf("%s is only supported for Network Load Balancers", field("eipAllocations")))
+		case isInternal:
+			errs = append(errs, fmt.Errorf("%s is only supported for external load balancers", field("eipAllocations")))
+		case len(tuning.Subnets) != 0 && len(tuning.Subnets) != len(tuning.EIPAllocations):
+			errs = append(errs, fmt.Errorf("%s must specify one allocation for each of the %d subnets in %s", field("eipAllocations"), len(tuning.Subnets), field("subnets")))
+		}
+		errs = append(errs, validateAWSResourceIDs(field("eipAllocations"), tuning.EIPAllocations, "eipalloc-"))
+		annotations[awsLBEIPAllocationsAnnotation] = strings.Join(tuning.EIPAllocations, ",")
+	}
+
+	if al := tuning.AccessLog; al != nil {
+		switch {
+		case lbType != operatorv1.AWSClassicLoadBalancer:
+			errs = append(errs, fmt.Errorf("%s is only supported for Classic Load Balancers", field("accessLog")))
+		case al.Enabled && len(al.BucketName) == 0:
+			errs = append(errs, fmt.Errorf("%s is required when access logs are enabled", field("accessLog.bucketName")))
+		case al.EmitIntervalMinutes != 0 && al.EmitIntervalMinutes != 5 && al.EmitIntervalMinutes != 60:
+			errs = append(errs, fmt.Errorf("%s must be 5 or 60, got %d", field("accessLog.emitIntervalMinutes"), al.EmitIntervalMinutes))
+		}
+		annotations[awsLBAccessLogEnabledAnnotation] = strconv.FormatBool(al.Enabled)
+		if al.Enabled {
+			annotations[awsLBAccessLogS3BucketNameAnnotation] = al.BucketName
+			if len(al.BucketPrefix) != 0 {
+				annotations[awsLBAccessLogS3BucketPrefixAnnotation] = al.BucketPrefix
+			}
+			if al.EmitIntervalMinutes != 0 {
+				annotations[awsLBAccessLogEmitIntervalAnnotation] = strconv.Itoa(al.EmitIntervalMinutes)
+			}
+		}
+	}
+
+	for _, sg := range []struct {
+		name       string
+		annotation string
+		values     []string
+	}{
+		{"securityGroups", awsLBSecurityGroupsAnnotation, tuning.SecurityGroups},
+		{"extraSecurityGroups", awsLBExtraSecurityGroupsAnnotation, tuning.ExtraSecurityGroups},
+	} {
+		if len(sg.values) == 0 {
+			continue
+		}
+		if lbType != operatorv1.AWSClassicLoadBalancer {
+			errs = append(errs, fmt.Errorf("%s is only supported for Classic Load Balancers", field(sg.name)))
+		}
+		errs = append(errs, validateAWSResourceIDs(field(sg.name), sg.values, "sg-"))
+		annotations[sg.annotation] = strings.Join(sg.values, ",")
+	}
+
+	if err := kerrors.NewAggregate(errs); err != nil {
+		return nil, err
+	}
+	return annotations, nil
+}
+
+// validateAWSLoadBalancerHealthCheck validates the given health check
+// parameters for the given load balancer type against the limits that AWS
+// imposes.
+func validateAWSLoadBalancerHealthCheck(hc *awsLoadBalancerHealthCheck, lbType operatorv1.AWSLoadBalancerType) error {
+	const prefix = "spec.unsupportedConfigOverrides.awsLoadBalancer.healthCheck"
+	var errs []error
+	inRange := func(name string, v, min, max int) {
+		if v != 0 && (v < min || v > max) {
+			errs = append(errs, fmt.Errorf("%s.%s must be between %d and %d, got %d", prefix, name, min, max, v))
+		}
+	}
+	switch lbType {
+	case operatorv1.AWSNetworkLoadBalancer:
+		if hc.IntervalSeconds != 0 && hc.IntervalSeconds != 10 && hc.IntervalSeconds != 30 {
+			errs = append(errs, fmt.Errorf("%s.intervalSeconds must be 10 or 30 for Network Load Balancers, got %d", prefix, hc.IntervalSeconds))
+		}
+		if hc.TimeoutSeconds != 0 {
+			errs = append(errs, fmt.Errorf("%s.timeoutSeconds is not supported for Network Load Balancers", prefix))
+		}
+		// NLB target groups require the healthy and unhealthy
+		// thresholds to be equal.
+		healthy, unhealthy := hc.HealthyThreshold, hc.UnhealthyThreshold
+		if healthy == 0 {
+			healthy, _ = strconv.Atoi(awsLBHealthCheckHealthyThresholdDefault)
+		}
+		if unhealthy == 0 {
+			unhealthy, _ = strconv.Atoi(awsLBHealthCheckUnhealthyThresholdDefault)
+		}
+		if healthy != unhealthy {
+			errs = append(errs, fmt.Errorf("%s.healthyThreshold and %s.unhealthyThreshold must be equal for Network Load Balancers", prefix, prefix))
+		}
+	default:
+		inRange("intervalSeconds", hc.IntervalSeconds, 5, 300)
+		inRange("timeoutSeconds", hc.TimeoutSeconds, 2, 60)
+		interval, timeout := hc.IntervalSeconds, hc.TimeoutSeconds
+		if interval == 0 {
+			interval, _ = strconv.Atoi(awsLBHealthCheckIntervalDefault)
+		}
+		if timeout == 0 {
+			timeout, _ = strconv.Atoi(awsLBHealthCheckTimeoutDefault)
+		}
+		if timeout >= interval {
+			errs = append(errs, fmt.Errorf("%s.timeoutSeconds (%d) must be less than %s.intervalSeconds (%d)", prefix, timeout, prefix, interval))
+		}
+	}
+	inRange("healthyThreshold", hc.HealthyThreshold, 2, 10)
+	inRange("unhealthyThreshold", hc.UnhealthyThreshold, 2, 10)
+	return kerrors.NewAggregate(errs)
+}
+
+// validateAWSResourceIDs returns an error if any of the given values is empty,
+// contains a comma, or, if prefix is nonempty, does not have the given prefix.
+func validateAWSResourceIDs(field string, values []string, prefix string) error {
+	var errs []error
+	for i, v := range values {
+		switch {
+		case len(v) == 0 || strings.ContainsAny(v, ", "):
+			errs = append(errs, fmt.Errorf("%s[%d] is invalid: %q", field, i, v))
+		case len(prefix) != 0 && !strings.HasPrefix(v, prefix):
+			errs = append(errs, fmt.Errorf("%s[%d] must start with %q: %q", field, i, prefix, v))
+		}
+	}
+	return kerrors.NewAggregate(errs)
+}
@@ -0,0 +1,178 @@
+package ingress
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	configv1 "github.com/openshift/api/config/v1"
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// TestAWSLoadBalancerTuningAnnotations verifies that
+// awsLoadBalancerTuningAnnotations translates the awsLoadBalancer unsupported
+// config override into service annotations and rejects invalid parameters.
+func TestAWSLoadBalancerTuningAnnotations(t *testing.T) {
+	aws := &configv1.PlatformStatus{Type: configv1.AWSPlatformType}
+	testCases := []struct {
+		description string
+		platform    *configv1.PlatformStatus
+		lbType      operatorv1.AWSLoadBalancerType
+		scope       operatorv1.LoadBalancerScope
+		overrides   string
+		expect      map[string]string
+		expectError bool
+	}{
+		{
+			description: "no override",
+			platform:    aws,
+			lbType:      operatorv1.AWSClassicLoadBalancer,
+			overrides:   `{"loadBalancingAlgorithm":"leastconn"}`,
+		},
+		{
+			description: "classic health check, cross-zone, access logs and security groups",
+			platform:    aws,
+			lbType:      operatorv1.AWSClassicLoadBalancer,
+			overrides:   `{"awsLoadBalancer":{"healthCheck":{"intervalSeconds":10,"timeoutSeconds":5,"healthyThreshold":3,"unhealthyThreshold":4},"crossZoneLoadBalancing":true,"accessLog":{"enabled":true,"bucketName":"logs","bucketPrefix":"router","emitIntervalMinutes":5},"extraSecurityGroups":["sg-1","sg-2"]}}`,
+			expect: map[string]string{
+				awsLBHealthCheckIntervalAnnotation:           "10",
+				awsLBHealthCheckTimeoutAnnotation:            "5",
+				awsLBHealthCheckHealthyThresholdAnnotation:   "3",
+				awsLBHealthCheckUnhealthyThresholdAnnotation: "4",
+				awsLBCrossZoneLoadBalancingAnnotation:        "true",
+				awsLBAccessLogEnabledAnnotation:              "true",
+				awsLBAccessLogS3BucketNameAnnotation:         "logs",
+				awsLBAccessLogS3BucketPrefixAnnotation:       "router",
+				awsLBAccessLogEmitIntervalAnnotation:         "5",
+				awsLBExtraSecurityGroupsAnnotation:           "sg-1,sg-2",
+			},
+		},
+		{
+			description: "NLB subnets and Elastic IPs",
+			platform:    aws,
+			lbType:      operatorv1.AWSNetworkLoadBalancer,
+			overrides:   `{"awsLoadBalancer":{"subnets":["subnet-a","subnet-b"],"eipAllocations":["eipalloc-a","eipalloc-b"],"crossZoneLoadBalancing":false}}`,
+			expect: map[string]string{
+				awsLBSubnetsAnnotation:                "subnet-a,subnet-b",
+				awsLBEIPAllocationsAnnotation:         "eipalloc-a,eipalloc-b",
+				awsLBCrossZoneLoadBalancingAnnotation: "false",
+			},
+		},
+		{
+			description: "not AWS",
+			platform:    &configv1.PlatformStatus{Type: configv1.GCPPlatformType},
+			overrides:   `{"awsLoadBalancer":{"crossZoneLoadBalancing":true}}`,
+			expectError: true,
+		},
+		{
+			description: "classic timeout not less than interval",
+			platform:    aws,
+			lbType:      operatorv1.AWSClassicLoadBalancer,
+			overrides:   `{"awsLoadBalancer":{"healthCheck":{"intervalSeconds":5,"timeoutSeconds":5}}}`,
+			expectError: true,
+		},
+		{
+			description: "NLB interval not 10 or 30",
+			platform:    aws,
+			lbType:      operatorv1.AWSNetworkLoadBalancer,
+			overrides:   `{"awsLoadBalancer":{"healthCheck":{"intervalSeconds":15}}}`,
+			expectError: true,
+		},
+		{
+			description: "NLB unequal thresholds",
+			platform:    aws,
+			lbType:      operatorv1.AWSNetworkLoadBalancer,
+			overrides:   `{"awsLoadBalancer":{"healthCheck":{"healthyThreshold":3}}}`,
+			expectError: true,
+		},
+		{
+			description: "threshold out of range",
+			platform:    aws,
+			lbType:      operatorv1.AWSClassicLoadBalancer,
+			overrides:   `{"awsLoadBalancer":{"healthCheck":{"unhealthyThreshold":11}}}`,
+			expectError: true,
+		},
+		{
+			description: "Elastic IPs on a classic load balancer",
+			platform:    aws,
+			lbType:      operatorv1.AWSClassicLoadBalancer,
+			overrides:   `{"awsLoadBalancer":{"eipAllocations":["eipalloc-a"]}}`,
+			expectError: true,
+		},
+		{
+			description: "Elastic IPs on an internal NLB",
+			platform:    aws,
+			lbType:      operatorv1.AWSNetworkLoadBalancer,
+			scope:       operatorv1.InternalLoadBalancer,
+			overrides:   `{"awsLoadBalancer":{"eipAllocations":["eipalloc-a"]}}`,
+			expectError: true,
+		},
+		{
+			description: "Elastic IP count does not match subnets",
+			platform:    aws,
+			lbType:      operatorv1.AWSNetworkLoadBalancer,
+			overrides:   `{"awsLoadBalancer":{"subnets":["subnet-a","subnet-b"],"eipAllocations":["eipalloc-a"]}}`,
+			expectError: true,
+		},
+		{
+			description: "access logs without a bucket",
+			platform:    aws,
+			lbType:      operatorv1.AWSClassicLoadBalancer,
+			overrides:   `{"awsLoadBalancer":{"accessLog":{"enabled":true}}}`,
+			expectError: true,
+		},
+		{
+			description: "access logs on an NLB",
+			platform:    aws,
+			lbType:      operatorv1.AWSNetworkLoadBalancer,
+			overrides:   `{"awsLoadBalancer":{"accessLog":{"enabled":true,"bucketName":"logs"}}}`,
+			expectError: true,
+		},
+		{
+			description: "invalid security group",
+			platform:    aws,
+			lbType:      operatorv1.AWSClassicLoadBalancer,
+			overrides:   `{"awsLoadBalancer":{"securityGroups":["default"]}}`,
+			expectError: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			scope := tc.scope
+			if len(scope) == 0 {
+				scope = operatorv1.ExternalLoadBalancer
+			}
+			ic := &operatorv1.IngressController{
+				ObjectMeta: metav1.ObjectMeta{Name: "default"},
+				Spec: operatorv1.IngressControllerSpec{
+					UnsupportedConfigOverrides: runtime.RawExtension{Raw: []byte(tc.overrides)},
+				},
+				Status: operatorv1.IngressControllerStatus{
+					EndpointPublishingStrategy: &operatorv1.EndpointPublishingStrategy{
+						Type: operatorv1.LoadBalancerServiceStrategyType,
+						LoadBalancer: &operatorv1.LoadBalancerStrategy{
+							Scope: scope,
+							ProviderParameters: &operatorv1.ProviderLoadBalancerParameters{
+								Type: operatorv1.AWSLoadBalancerProvider,
+								AWS:  &operatorv1.AWSLoadBalancerParameters{Type: tc.lbType},
+							},
+						},
+					},
+				},
+			}
+			annotations, err := awsLoadBalancerTuningAnnotations(ic, tc.platform)
+			switch {
+			case tc.expectError && err == nil:
+				t.Fatal("expected an error")
+			case !tc.expectError && err != nil:
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.expect, annotations); len(diff) != 0 {
+				t.Errorf("unexpected annotations (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
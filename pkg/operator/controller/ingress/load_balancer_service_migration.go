@@ -0,0 +1,375 @@
+package ingress
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	configv1 "github.com/openshift/api/config/v1"
+	operatorv1 "github.com/openshift/api/operator/v1"
+	iov1 "github.com/openshift/api/operatoringress/v1"
+
+	"github.com/openshift/cluster-ingress-operator/pkg/operator/controller"
+	retryable "github.com/openshift/cluster-ingress-operator/pkg/util/retryableerror"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	crclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// loadBalancerMigrationStrategyAnnotation is an annotation that can be
+	// set on an IngressController to specify how the operator replaces the
+	// service load-balancer when the ingresscontroller's scope or load
+	// balancer type changes in a way that the platform cannot apply to the
+	// existing load balancer.
+	loadBalancerMigrationStrategyAnnotation = "ingress.operator.openshift.io/load-balancer-migration-strategy"
+
+	// blueGreenLoadBalancerMigrationStrategy is the value of the
+	// load-balancer-migration-strategy annotation that enables blue/green
+	// migration.  With this strategy, the operator provisions a new load
+	// balancer alongside the old one, switches the wildcard DNS record to
+	// the new load balancer once it is ready, and deletes the old load
+	// balancer after the record's TTL has elapsed.
+	//
+	// Note that this strategy trades downtime for cost: while a migration
+	// is in progress, two cloud load balancers exist at the same time and
+	// both are billed by the cloud provider.  Because the migration has two
+	// phases (see migrateLoadBalancerService), a second load balancer is
+	// provisioned twice, each time for as long as it takes to provision it,
+	// to pass health checks, and for the DNS record's TTL to elapse.  A
+	// cloud load balancer belongs to its service and a service cannot be
+	// renamed, so the second phase is what lets the ingresscontroller's
+	// load balancer keep its usual service name, which the operator's
+	// status, monitoring, and users' tooling refer to.  Ingresscontrollers
+	// that do not set the annotation keep the default behavior, in which
+	// the service is deleted and recreated and no second load balancer is
+	// created.
+	blueGreenLoadBalancerMigrationStrategy = "BlueGreen"
+
+	// loadBalancerMigrationDNSTargetAnnotation is an annotation that the
+	// operator sets on the migration service to record the name of the
+	// service that the wildcard DNS record was last observed to target.
+	loadBalancerMigrationDNSTargetAnnotation = "ingress.operator.openshift.io/load-balancer-migration-dns-target"
+
+	// loadBalancerMigrationDNSSwitchedAtAnnotation is an annotation that the
+	// operator sets on the migration service to record the time, in RFC
+	// 3339 format, at which the wildcard DNS record was first observed to be
+	// published with the target recorded in the
+	// load-balancer-migration-dns-target annotation.
+	loadBalancerMigrationDNSSwitchedAtAnnotation = "ingress.operator.openshift.io/load-balancer-migration-dns-switched-at"
+
+	// loadBalancerMigrationPollInterval is how often the operator checks
+	// on a migration that is waiting for a load balancer to be provisioned
+	// or for the wildcard DNS record to be published.
+	loadBalancerMigrationPollInterval = 15 * time.Second
+)
+
+// lookupHost resolves the given host name.  It is a variable to enable unit
+// testing.
+var lookupHost = func(host string) ([]string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	return net.DefaultResolver.LookupHost(ctx, host)
+}
+
+// useBlueGreenLoadBalancerMigration returns a Boolean value indicating whether
+// the given ingresscontroller has opted in to blue/green migration of its
+// service load-balancer.
+func useBlueGreenLoadBalancerMigration(ic *operatorv1.IngressController) bool {
+	return ic.Annotations[loadBalancerMigrationStrategyAnnotation] == blueGreenLoadBalancerMigrationStrategy
+}
+
+// loadBalancerServiceNeedsReplacement returns a Boolean value indicating
+// whether the current service load-balancer must be replaced in order to
+// apply the desired service, which is the case if the scope changed on a
+// platform that does not support mutating the scope or if the AWS load
+// balancer type, or the subnets or Elastic IP allocations of an AWS NLB,
+// changed.
+func loadBalancerServiceNeedsReplacement(current, desired *corev1.Service, platform *configv1.PlatformStatus) bool {
+	if _, ok := platformsWithMutableScope[platform.Type]; !ok && !scopeEqual(current, desired, platform) {
+		return true
+	}
+	if platform.Type == configv1.AWSPlatformType {
+		if current.Annotations[AWSLBTypeAnnotation] != desired.Annotations[AWSLBTypeAnnotation] {
+			return true
+		}
+		if desired.Annotations[AWSLBTypeAnnotation] == AWSNLBAnnotation {
+			// Only consider the subnets and Elastic IP allocations
+			// if the operator manages them; the user may have set
+			// them on the service directly.
+			managed := managedAWSLoadBalancerTuningAnnotations(current, desired)
+			for _, name := range []string{awsLBSubnetsAnnotation, awsLBEIPAllocationsAnnotation} {
+				if managed.Has(name) && current.Annotations[name] != desired.Annotations[name] {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// currentMigrationLoadBalancerService returns the ingresscontroller's
+// migration service, if one exists.
+func (r *reconciler) currentMigrationLoadBalancerService(ci *operatorv1.IngressController) (bool, *corev1.Service, error) {
+	service := &corev1.Service{}
+	if err := r.client.Get(context.TODO(), controller.MigrationLoadBalancerServiceName(ci, r.config.OperandNamespace), service); err != nil {
+		if errors.IsNotFound(err) {
+			return false, nil, nil
+		}
+		return false, nil, err
+	}
+	if !isServiceOwnedByIngressController(service, ci) {
+		return false, nil, fmt.Errorf("a conflicting load balancer service exists that is not owned by the ingress controller: %s", controller.MigrationLoadBalancerServiceName(ci, r.config.OperandNamespace))
+	}
+	return true, service, nil
+}
+
+// migrateLoadBalancerService advances a blue/green migration of the given
+// ingresscontroller's service load-balancer and returns the service that the
+// wildcard DNS record should target.  Either current or migration may be nil.
+//
+// The migration has two phases so that the ingresscontroller's load balancer
+// keeps its usual service name when the migration completes.  In the first
+// phase, the operator creates the migration service with the desired
+// configuration, switches DNS to it once it is provisioned and healthy, and
+// deletes the old service.  In the second phase, the operator recreates the
+// service under its usual name, switches DNS back to it once it is provisioned
+// and healthy, and deletes the migration service.  Deletion is handled by
+// completeLoadBalancerServiceMigration, which waits for the DNS record to be
+// published and for its TTL to elapse.
+func (r *reconciler) migrateLoadBalancerService(ci *operatorv1.IngressController, current, migration, desired *corev1.Service, platform *configv1.PlatformStatus) (bool, *corev1.Service, error) {
+	desiredMigration := desired.DeepCopy()
+	desiredMigration.Name = controller.MigrationLoadBalancerServiceName(ci, r.config.OperandNamespace).Name
+
+	switch {
+	case migration == nil:
+		if err := r.createLoadBalancerService(desiredMigration); err != nil {
+			return true, current, err
+		}
+		r.recorder.Eventf(ci, "Normal", "LoadBalancerMigrationStarted", "Provisioning load balancer %s/%s to replace %s/%s; both load balancers exist, and are billed by the cloud provider, until the migration completes", desiredMigration.Namespace, desiredMigration.Name, current.Namespace, current.Name)
+		return true, current, nil
+	case current == nil:
+		if err := r.createLoadBalancerService(desired); err != nil {
+			return true, migration, err
+		}
+		return true, migration, nil
+	}
+
+	// If the service has the desired configuration, this is the second
+	// phase of the migration, or the user reverted the change that
+	// triggered the migration.  Either way, the service should become the
+	// target once it is ready.
+	if !loadBalancerServiceNeedsReplacement(current, desired, platform) {
+		if _, err := r.updateLoadBalancerService(current, desired, platform, false); err != nil {
+			return true, current, fmt.Errorf("failed to update load balancer service: %w", err)
+		}
+		if r.loadBalancerServiceIsHealthy(ci, current) {
+			return true, current, nil
+		}
+		return true, migration, nil
+	}
+
+	// The service has the old configuration, so this is the first phase.
+	if !loadBalancerServiceNeedsReplacement(migration, desired, platform) {
+		if r.loadBalancerServiceIsHealthy(ci, migration) {
+			return true, migration, nil
+		}
+		return true, current, nil
+	}
+
+	// Neither service has the desired configuration, which means that the
+	// user changed the ingresscontroller again during the migration.  If
+	// DNS has not been switched to the migration service yet, it is safe to
+	// discard it and start over; otherwise, the migration service remains
+	// the target and the old service is replaced in the second phase.
+	_, record, err := r.currentWildcardDNSRecord(ci)
+	if err != nil {
+		return true, current, err
+	}
+	if dnsRecordTargetsService(record, migration) {
+		return true, migration, nil
+	}
+	if err := r.deleteLoadBalancerService(migration, &crclient.DeleteOptions{}); err != nil {
+		return true, current, err
+	}
+	return true, current, nil
+}
+
+// completeLoadBalancerServiceMigration deletes the service that a blue/green
+// migration is replacing once the wildcard DNS record has been published with
+// the given active service as its target and the record's TTL has elapsed.
+// Returns a retryable error while the migration is waiting.
+func (r *reconciler) completeLoadBalancerServiceMigration(ci *operatorv1.IngressController, deploymentRef metav1.OwnerReference, platform *configv1.PlatformStatus, active *corev1.Service, record *iov1.DNSRecord) error {
+	haveMigration, migration, err := r.currentMigrationLoadBalancerService(ci)
+	if err != nil || !haveMigration || active == nil {
+		return err
+	}
+	haveCurrent, current, err := r.currentLoadBalancerService(ci)
+	if err != nil {
+		return err
+	}
+	_, desired, err := desiredLoadBalancerService(ci, r.config.OperandNamespace, deploymentRef, platform)
+	if err != nil {
+		return err
+	}
+
+	// Retire the old service once DNS has switched to the migration
+	// service, or retire the migration service once DNS has switched back
+	// to a service that has the desired configuration.
+	var retiring *corev1.Service
+	if haveCurrent {
+		needsReplacement := loadBalancerServiceNeedsReplacement(current, desired, platform)
+		switch active.Name {
+		case migration.Name:
+			if needsReplacement {
+				retiring = current
+			}
+		case current.Name:
+			if !needsReplacement {
+				retiring = migration
+			}
+		}
+	}
+	if retiring == nil {
+		return retryable.New(fmt.Errorf("waiting for the new load balancer for ingresscontroller %s to be provisioned and pass health checks", ci.Name), loadBalancerMigrationPollInterval)
+	}
+
+	if record != nil && !dnsRecordTargetsService(record, active) {
+		return retryable.New(fmt.Errorf("waiting for dnsrecord %s/%s to target load balancer service %s/%s", record.Namespace, record.Name, active.Namespace, active.Name), loadBalancerMigrationPollInterval)
+	}
+	if record != nil && !dnsRecordIsPublished(record) {
+		return retryable.New(fmt.Errorf("waiting for dnsrecord %s/%s to be published", record.Namespace, record.Name), loadBalancerMigrationPollInterval)
+	}
+
+	// Record when DNS was first observed to target the active service so
+	// that the wait survives operator restarts.
+	switchedAt, err := time.Parse(time.RFC3339, migration.Annotations[loadBalancerMigrationDNSSwitchedAtAnnotation])
+	if err != nil || migration.Annotations[loadBalancerMigrationDNSTargetAnnotation] != active.Name {
+		switchedAt = clock.Now()
+		updated := migration.DeepCopy()
+		if updated.Annotations == nil {
+			updated.Annotations = map[string]string{}
+		}
+		updated.Annotations[loadBalancerMigrationDNSTargetAnnotation] = active.Name
+		updated.Annotations[loadBalancerMigrationDNSSwitchedAtAnnotation] = switchedAt.UTC().Format(time.RFC3339)
+		if err := r.client.Update(context.TODO(), updated); err != nil {
+			return fmt.Errorf("failed to update load balancer service %s/%s: %w", migration.Namespace, migration.Name, err)
+		}
+		log.Info("wildcard dnsrecord switched to new load balancer", "ingresscontroller", ci.Name, "service", active.Name)
+	}
+
+	ttl := time.Duration(defaultRecordTTL) * time.Second
+	if record != nil && record.Spec.RecordTTL > 0 {
+		ttl = time.Duration(record.Spec.RecordTTL) * time.Second
+	}
+	if remaining := ttl - clock.Since(switchedAt); remaining > 0 {
+		return retryable.New(fmt.Errorf("waiting %s for the TTL of the wildcard dnsrecord to elapse before deleting load balancer service %s/%s", remaining.Round(time.Second), retiring.Namespace, retiring.Name), remaining)
+	}
+
+	foreground := metav1.DeletePropagationForeground
+	if err := r.deleteLoadBalancerService(retiring, &crclient.DeleteOptions{PropagationPolicy: &foreground}); err != nil {
+		return err
+	}
+	if retiring.Name == migration.Name {
+		r.recorder.Eventf(ci, "Normal", "LoadBalancerMigrationCompleted", "Deleted load balancer %s/%s after switching DNS to %s/%s", retiring.Namespace, retiring.Name, active.Namespace, active.Name)
+	} else {
+		r.recorder.Eventf(ci, "Normal", "LoadBalancerMigrationProgressing", "Deleted load balancer %s/%s after switching DNS to %s/%s; recreating it with the new configuration", retiring.Namespace, retiring.Name, active.Namespace, active.Name)
+	}
+	return nil
+}
+
+// loadBalancerServiceIsProvisioned returns a Boolean value indicating whether
+// the given service's load balancer has been provisioned and, if the load
+// balancer has a host name, whether the host name resolves.  A new load
+// balancer's host name may not resolve for some time after the service's
+// status is updated, and switching DNS before then would cause an outage.
+func loadBalancerServiceIsProvisioned(service *corev1.Service) bool {
+	if len(service.Status.LoadBalancer.Ingress) == 0 {
+		return false
+	}
+	ingress := service.Status.LoadBalancer.Ingress[0]
+	switch {
+	case len(ingress.IP) != 0:
+		return true
+	case len(ingress.Hostname) != 0:
+		addrs, err := lookupHost(ingress.Hostname)
+		if err != nil {
+			log.V(1).Info("load balancer host name does not resolve yet", "namespace", service.Namespace, "name", service.Name, "hostname", ingress.Hostname, "error", err)
+			return false
+		}
+		return len(addrs) != 0
+	}
+	return false
+}
+
+// loadBalancerServiceIsHealthy returns a Boolean value indicating whether the
+// given service's load balancer has been provisioned and forwards requests to
+// the given ingresscontroller's routers, which route them.  DNS must not be
+// switched to a load balancer that is provisioned but cannot reach the
+// routers, for example because of the cloud provider's health checks or
+// firewall rules.
+func (r *reconciler) loadBalancerServiceIsHealthy(ci *operatorv1.IngressController, service *corev1.Service) bool {
+	if !loadBalancerServiceIsProvisioned(service) {
+		return false
+	}
+	ingress := service.Status.LoadBalancer.Ingress[0]
+	host := ingress.IP
+	if len(host) == 0 {
+		host = ingress.Hostname
+	}
+	failure, err := r.probeRouters(ci, net.JoinHostPort(host, "443"))
+	switch {
+	case err != nil:
+		log.Info("failed to check the health of the new load balancer", "namespace", service.Namespace, "name", service.Name, "error", err)
+		return false
+	case len(failure) != 0:
+		log.Info("new load balancer failed its health check", "namespace", service.Namespace, "name", service.Name, "reason", failure)
+		return false
+	}
+	return true
+}
+
+// dnsRecordTargetsService returns a Boolean value indicating whether the given
+// DNS record targets the given service's load balancer.
+func dnsRecordTargetsService(record *iov1.DNSRecord, service *corev1.Service) bool {
+	if record == nil || len(service.Status.LoadBalancer.Ingress) == 0 {
+		return false
+	}
+	ingress := service.Status.LoadBalancer.Ingress[0]
+	for _, target := range record.Spec.Targets {
+		if (len(ingress.Hostname) != 0 && target == ingress.Hostname) || (len(ingress.IP) != 0 && target == ingress.IP) {
+			return true
+		}
+	}
+	return false
+}
+
+// dnsRecordIsPublished returns a Boolean value indicating whether the DNS
+// controller has published the current generation of the given DNS record to
+// all of its zones.  A record with the "Unmanaged" DNS management policy is
+// considered published.
+func dnsRecordIsPublished(record *iov1.DNSRecord) bool {
+	if record.Spec.DNSManagementPolicy == iov1.UnmanagedDNS {
+		return true
+	}
+	if record.Status.ObservedGeneration != record.Generation || len(record.Status.Zones) == 0 {
+		return false
+	}
+	for _, zone := range record.Status.Zones {
+		published := false
+		for _, cond := range zone.Conditions {
+			if cond.Type == iov1.DNSRecordPublishedConditionType && cond.Status == string(operatorv1.ConditionTrue) {
+				published = true
+			}
+		}
+		if !published {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,257 @@
+package ingress
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	configv1 "github.com/openshift/api/config/v1"
+	operatorv1 "github.com/openshift/api/operator/v1"
+	iov1 "github.com/openshift/api/operatoringress/v1"
+	routev1 "github.com/openshift/api/route/v1"
+
+	"github.com/openshift/cluster-ingress-operator/pkg/operator/controller"
+	retryable "github.com/openshift/cluster-ingress-operator/pkg/util/retryableerror"
+
+	corev1 "k8s.io/api/core/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+
+	"k8s.io/client-go/tools/record"
+
+	utilclock "k8s.io/utils/clock"
+	utilclocktesting "k8s.io/utils/clock/testing"
+
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// TestBlueGreenLoadBalancerMigration verifies that changing the scope of an
+// ingresscontroller that uses the blue/green migration strategy replaces the
+// service load-balancer without switching DNS to the new load balancer until it
+// passes the canary route probe, and without deleting the old load balancer
+// until DNS has been switched to the new one and the record's TTL has elapsed.
+func TestBlueGreenLoadBalancerMigration(t *testing.T) {
+	fakeClock := utilclocktesting.NewFakeClock(time.Date(2022, 10, 1, 0, 0, 0, 0, time.UTC))
+	clock = fakeClock
+	defer func() {
+		clock = utilclock.RealClock{}
+	}()
+	realLookupHost := lookupHost
+	lookupHost = func(host string) ([]string, error) { return []string{"10.0.0.1"}, nil }
+	defer func() {
+		lookupHost = realLookupHost
+	}()
+	// unhealthy is the set of load balancer addresses that fail the
+	// canary route probe.
+	unhealthy := map[string]bool{}
+	realProbeCanaryRoute := probeCanaryRoute
+	probeCanaryRoute = func(route *routev1.Route, address string) (string, error) {
+		if unhealthy[address] {
+			return "The canary route responded with HTTP status 503.", nil
+		}
+		return "", nil
+	}
+	defer func() {
+		probeCanaryRoute = realProbeCanaryRoute
+	}()
+
+	platform := &configv1.PlatformStatus{Type: configv1.AWSPlatformType}
+	trueVar := true
+	deploymentRef := metav1.OwnerReference{
+		APIVersion: "apps/v1",
+		Kind:       "Deployment",
+		Name:       "router-default",
+		UID:        "1",
+		Controller: &trueVar,
+	}
+	ic := &operatorv1.IngressController{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "openshift-ingress-operator",
+			Name:      "default",
+			Annotations: map[string]string{
+				loadBalancerMigrationStrategyAnnotation: blueGreenLoadBalancerMigrationStrategy,
+			},
+		},
+		Status: operatorv1.IngressControllerStatus{
+			Domain: "apps.mycluster.example.com",
+			EndpointPublishingStrategy: &operatorv1.EndpointPublishingStrategy{
+				Type: operatorv1.LoadBalancerServiceStrategyType,
+				LoadBalancer: &operatorv1.LoadBalancerStrategy{
+					Scope:               operatorv1.ExternalLoadBalancer,
+					DNSManagementPolicy: operatorv1.ManagedLoadBalancerDNS,
+				},
+			},
+		},
+	}
+	_, oldService, err := desiredLoadBalancerService(ic, "openshift-ingress", deploymentRef, platform)
+	if err != nil {
+		t.Fatal(err)
+	}
+	oldService.Status.LoadBalancer.Ingress = []corev1.LoadBalancerIngress{{Hostname: "old.elb.example.com"}}
+	_, wildcardRecord := desiredWildcardDNSRecord(ic, oldService)
+
+	canaryRoute := &routev1.Route{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "openshift-ingress-canary",
+			Name:      "canary",
+		},
+		Spec: routev1.RouteSpec{Host: "canary-openshift-ingress-canary.apps.mycluster.example.com"},
+		Status: routev1.RouteStatus{
+			Ingress: []routev1.RouteIngress{{
+				RouterName: "default",
+				Conditions: []routev1.RouteIngressCondition{{
+					Type:   routev1.RouteAdmitted,
+					Status: corev1.ConditionTrue,
+				}},
+			}},
+		},
+	}
+
+	scheme := runtime.NewScheme()
+	corev1.AddToScheme(scheme)
+	iov1.AddToScheme(scheme)
+	operatorv1.AddToScheme(scheme)
+	routev1.AddToScheme(scheme)
+	cl := fake.NewClientBuilder().WithScheme(scheme).WithObjects(oldService, wildcardRecord, canaryRoute).Build()
+	r := &reconciler{client: cl, recorder: record.NewFakeRecorder(10)}
+	r.config.OperandNamespace = "openshift-ingress"
+	r.config.CanaryNamespace = "openshift-ingress-canary"
+
+	// Change the scope, which requires replacing the load balancer on AWS.
+	ic.Status.EndpointPublishingStrategy.LoadBalancer.Scope = operatorv1.InternalLoadBalancer
+
+	serviceName := controller.LoadBalancerServiceName(ic, "openshift-ingress")
+	migrationName := controller.MigrationLoadBalancerServiceName(ic, "openshift-ingress")
+	ensure := func(expectActive types.NamespacedName) {
+		t.Helper()
+		_, active, err := r.ensureLoadBalancerService(ic, deploymentRef, platform)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if active == nil || active.Name != expectActive.Name {
+			t.Fatalf("expected active service %s, got %v", expectActive.Name, active)
+		}
+	}
+	setHostname := func(name types.NamespacedName, hostname string) {
+		t.Helper()
+		service := &corev1.Service{}
+		if err := cl.Get(context.Background(), name, service); err != nil {
+			t.Fatal(err)
+		}
+		service.Status.LoadBalancer.Ingress = []corev1.LoadBalancerIngress{{Hostname: hostname}}
+		if err := cl.Update(context.Background(), service); err != nil {
+			t.Fatal(err)
+		}
+	}
+	publish := func(hostname string) *iov1.DNSRecord {
+		t.Helper()
+		current := &iov1.DNSRecord{}
+		if err := cl.Get(context.Background(), controller.WildcardDNSRecordName(ic), current); err != nil {
+			t.Fatal(err)
+		}
+		current.Spec.Targets = []string{hostname}
+		current.Status.ObservedGeneration = current.Generation
+		current.Status.Zones = []iov1.DNSZoneStatus{{
+			DNSZone: configv1.DNSZone{ID: "zone"},
+			Conditions: []iov1.DNSZoneCondition{{
+				Type:   iov1.DNSRecordPublishedConditionType,
+				Status: string(operatorv1.ConditionTrue),
+			}},
+		}}
+		if err := cl.Update(context.Background(), current); err != nil {
+			t.Fatal(err)
+		}
+		return current
+	}
+	complete := func(active types.NamespacedName, record *iov1.DNSRecord, expectWait bool) {
+		t.Helper()
+		service := &corev1.Service{}
+		if err := cl.Get(context.Background(), active, service); err != nil {
+			t.Fatal(err)
+		}
+		err := r.completeLoadBalancerServiceMigration(ic, deploymentRef, platform, service, record)
+		if _, isRetryable := err.(retryable.Error); expectWait != isRetryable {
+			t.Fatalf("expected waiting to be %t, got error %v", expectWait, err)
+		}
+	}
+	exists := func(name types.NamespacedName) bool {
+		return cl.Get(context.Background(), name, &corev1.Service{}) == nil
+	}
+
+	// Phase 1: the migration service is created, and DNS is switched to
+	// it once it is provisioned and passes the canary route probe.
+	ensure(serviceName)
+	if !exists(migrationName) {
+		t.Fatal("expected migration service to be created")
+	}
+	complete(serviceName, publish("old.elb.example.com"), true)
+	unhealthy["new.elb.example.com:443"] = true
+	setHostname(migrationName, "new.elb.example.com")
+	ensure(serviceName)
+	complete(serviceName, publish("old.elb.example.com"), true)
+	unhealthy["new.elb.example.com:443"] = false
+	ensure(migrationName)
+	complete(migrationName, publish("old.elb.example.com"), true)
+	complete(migrationName, publish("new.elb.example.com"), true)
+	if !exists(serviceName) {
+		t.Fatal("expected old service to be kept until the TTL elapses")
+	}
+	fakeClock.Step(time.Duration(defaultRecordTTL) * time.Second)
+	complete(migrationName, publish("new.elb.example.com"), false)
+	if exists(serviceName) {
+		t.Fatal("expected old service to be deleted after the TTL elapses")
+	}
+
+	// Phase 2: the service is recreated with the new scope, and DNS is
+	// switched back to it once it is provisioned.
+	ensure(migrationName)
+	current := &corev1.Service{}
+	if err := cl.Get(context.Background(), serviceName, current); err != nil {
+		t.Fatalf("expected service to be recreated: %v", err)
+	}
+	if !IsServiceInternal(current) {
+		t.Fatal("expected recreated service to be internal")
+	}
+	setHostname(serviceName, "newer.elb.example.com")
+	ensure(serviceName)
+	complete(serviceName, publish("newer.elb.example.com"), true)
+	fakeClock.Step(time.Duration(defaultRecordTTL) * time.Second)
+	complete(serviceName, publish("newer.elb.example.com"), false)
+	if exists(migrationName) {
+		t.Fatal("expected migration service to be deleted")
+	}
+	ensure(serviceName)
+	if exists(migrationName) {
+		t.Fatal("expected no new migration to be started")
+	}
+}
+
+// TestLoadBalancerServiceNeedsReplacementIgnoresUnmanagedSubnets verifies that
+// subnets that the user set directly on an NLB service do not cause the
+// service to be replaced, but subnets that the operator applied from the
+// awsLoadBalancer override do.
+func TestLoadBalancerServiceNeedsReplacementIgnoresUnmanagedSubnets(t *testing.T) {
+	platform := &configv1.PlatformStatus{Type: configv1.AWSPlatformType}
+	service := func(annotations map[string]string) *corev1.Service {
+		svc := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+			AWSLBTypeAnnotation: AWSNLBAnnotation,
+		}}}
+		for k, v := range annotations {
+			svc.Annotations[k] = v
+		}
+		return svc
+	}
+	desired := service(nil)
+	if loadBalancerServiceNeedsReplacement(service(map[string]string{awsLBSubnetsAnnotation: "subnet-a"}), desired, platform) {
+		t.Error("expected subnets that the user set not to require replacement")
+	}
+	current := service(map[string]string{
+		awsLBSubnetsAnnotation:                     "subnet-a",
+		awsLoadBalancerTuningAnnotationsAnnotation: awsLBSubnetsAnnotation,
+	})
+	if !loadBalancerServiceNeedsReplacement(current, desired, platform) {
+		t.Error("expected removing subnets that the operator applied to require replacement")
+	}
+}
@@ -331,7 +331,7 @@ func TestDesiredLoadBalancerService(t *testing.T) {
 			t.Errorf("test %q failed; expected IsProxyProtocolNeeded to return %v, got %v", tc.description, tc.proxyNeeded, proxyNeeded)
 		}
 
-		haveSvc, svc, err := desiredLoadBalancerService(ic, deploymentRef, infraConfig.Status.PlatformStatus)
+		haveSvc, svc, err := desiredLoadBalancerService(ic, "openshift-ingress", deploymentRef, infraConfig.Status.PlatformStatus)
 		switch {
 		case err != nil:
 			t.Errorf("test %q failed; unexpected error from desiredLoadBalancerService for endpoint publishing strategy type %v: %v", tc.description, tc.strategyType, err)
@@ -601,7 +601,7 @@ func TestDesiredLoadBalancerServiceAWSIdleTimeout(t *testing.T) {
 					},
 				},
 			}
-			haveSvc, svc, err := desiredLoadBalancerService(ic, deploymentRef, infraConfig.Status.PlatformStatus)
+			haveSvc, svc, err := desiredLoadBalancerService(ic, "openshift-ingress", deploymentRef, infraConfig.Status.PlatformStatus)
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -857,8 +857,54 @@ func TestLoadBalancerServiceChanged(t *testing.T) {
 			},
 			expect: true,
 		},
+		{
+			description: "if an unrecorded service.beta.kubernetes.io/aws-load-balancer-cross-zone-load-balancing-enabled annotation is added",
+			mutate: func(svc *corev1.Service) {
+				svc.Annotations["service.beta.kubernetes.io/aws-load-balancer-cross-zone-load-balancing-enabled"] = "true"
+			},
+			expect: false,
+		},
+		{
+			description: "if the service.beta.kubernetes.io/aws-load-balancer-cross-zone-load-balancing-enabled annotation is added from the awsLoadBalancer override",
+			mutate: func(svc *corev1.Service) {
+				svc.Annotations["service.beta.kubernetes.io/aws-load-balancer-cross-zone-load-balancing-enabled"] = "true"
+				svc.Annotations["ingress.operator.openshift.io/aws-load-balancer-tuning-annotations"] = "service.beta.kubernetes.io/aws-load-balancer-cross-zone-load-balancing-enabled"
+			},
+			expect: true,
+		},
+		{
+			description: "if an unrecorded annotation is added",
+			mutate: func(svc *corev1.Service) {
+				svc.Annotations["service.beta.kubernetes.io/aws-load-balancer-ssl-ports"] = "443"
+			},
+			expect: false,
+		},
+		{
+			description: "if a passthrough annotation is added",
+			mutate: func(svc *corev1.Service) {
+				svc.Annotations["service.beta.kubernetes.io/aws-load-balancer-ssl-ports"] = "443"
+				svc.Annotations["ingress.operator.openshift.io/passthrough-annotations"] = "service.beta.kubernetes.io/aws-load-balancer-ssl-ports"
+			},
+			expect: true,
+		},
+		{
+			description: "if an unrecorded label is added",
+			mutate: func(svc *corev1.Service) {
+				svc.Labels = map[string]string{"team": "network-edge"}
+			},
+			expect: false,
+		},
+		{
+			description: "if a passthrough label is added",
+			mutate: func(svc *corev1.Service) {
+				svc.Labels = map[string]string{"team": "network-edge"}
+				svc.Annotations["ingress.operator.openshift.io/passthrough-labels"] = "team"
+			},
+			expect: true,
+		},
 	}
 
+	platform := &configv1.PlatformStatus{Type: configv1.AWSPlatformType}
 	for _, tc := range testCases {
 		t.Run(tc.description, func(t *testing.T) {
 			original := corev1.Service{
@@ -900,10 +946,10 @@ func TestLoadBalancerServiceChanged(t *testing.T) {
 			}
 			mutated := original.DeepCopy()
 			tc.mutate(mutated)
-			if changed, updated := loadBalancerServiceChanged(&original, mutated); changed != tc.expect {
+			if changed, updated := loadBalancerServiceChanged(&original, mutated, platform); changed != tc.expect {
 				t.Errorf("expected loadBalancerServiceChanged to be %t, got %t", tc.expect, changed)
 			} else if changed {
-				if changedAgain, _ := loadBalancerServiceChanged(mutated, updated); changedAgain {
+				if changedAgain, _ := loadBalancerServiceChanged(mutated, updated, platform); changedAgain {
 					t.Error("loadBalancerServiceChanged does not behave as a fixed point function")
 				}
 			}
@@ -1194,7 +1240,7 @@ func TestUpdateLoadBalancerServiceSourceRanges(t *testing.T) {
 					},
 				},
 			}
-			wantSvc, desired, err := desiredLoadBalancerService(ic, deploymentRef, infraConfig.Status.PlatformStatus)
+			wantSvc, desired, err := desiredLoadBalancerService(ic, "openshift-ingress", deploymentRef, infraConfig.Status.PlatformStatus)
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -1208,7 +1254,7 @@ func TestUpdateLoadBalancerServiceSourceRanges(t *testing.T) {
 			}
 			current.Spec.LoadBalancerSourceRanges = tc.currentLoadBalancerSourceRanges
 
-			changed, svc := loadBalancerServiceChanged(current, desired)
+			changed, svc := loadBalancerServiceChanged(current, desired, infraConfig.Status.PlatformStatus)
 			if changed != tc.expectChanged {
 				t.Errorf("expected changed to be %t, got %t", tc.expectChanged, changed)
 			}
@@ -1229,3 +1275,148 @@ func TestUpdateLoadBalancerServiceSourceRanges(t *testing.T) {
 		})
 	}
 }
+
+// TestLoadBalancerServicePassthrough verifies that desiredLoadBalancerService
+// applies the annotations and labels that are specified using unsupported
+// config overrides, that loadBalancerServiceChanged removes them when they are
+// removed from the overrides, and that disallowed annotations and labels are
+// rejected.
+func TestLoadBalancerServicePassthrough(t *testing.T) {
+	aws := &configv1.PlatformStatus{Type: configv1.AWSPlatformType}
+	gcp := &configv1.PlatformStatus{Type: configv1.GCPPlatformType}
+	testCases := []struct {
+		description string
+		platform    *configv1.PlatformStatus
+		overrides   string
+		expectError bool
+	}{
+		{
+			description: "no overrides",
+			platform:    aws,
+		},
+		{
+			description: "allowed annotation and label",
+			platform:    aws,
+			overrides:   `{"loadBalancerServiceAnnotations":{"service.beta.kubernetes.io/aws-load-balancer-ssl-ports":"443"},"loadBalancerServiceLabels":{"example.com/team":"network-edge"}}`,
+		},
+		{
+			description: "annotation for another platform",
+			platform:    gcp,
+			overrides:   `{"loadBalancerServiceAnnotations":{"service.beta.kubernetes.io/aws-load-balancer-ssl-ports":"443"}}`,
+			expectError: true,
+		},
+		{
+			description: "annotation that the operator manages",
+			platform:    aws,
+			overrides:   `{"loadBalancerServiceAnnotations":{"service.beta.kubernetes.io/aws-load-balancer-internal":"true"}}`,
+			expectError: true,
+		},
+		{
+			description: "annotation that replaces security groups",
+			platform:    aws,
+			overrides:   `{"loadBalancerServiceAnnotations":{"service.beta.kubernetes.io/aws-load-balancer-security-groups":"sg-1"}}`,
+			expectError: true,
+		},
+		{
+			description: "source ranges annotation",
+			platform:    aws,
+			overrides:   `{"loadBalancerServiceAnnotations":{"service.beta.kubernetes.io/load-balancer-source-ranges":"0.0.0.0/0"}}`,
+			expectError: true,
+		},
+		{
+			description: "label in a kubernetes.io domain",
+			platform:    aws,
+			overrides:   `{"loadBalancerServiceLabels":{"service.kubernetes.io/service-proxy-name":"foo"}}`,
+			expectError: true,
+		},
+		{
+			description: "label that the operator manages",
+			platform:    aws,
+			overrides:   `{"loadBalancerServiceLabels":{"router":"foo"}}`,
+			expectError: true,
+		},
+		{
+			description: "label with an invalid value",
+			platform:    aws,
+			overrides:   `{"loadBalancerServiceLabels":{"team":"network edge"}}`,
+			expectError: true,
+		},
+	}
+	trueVar := true
+	deploymentRef := metav1.OwnerReference{
+		APIVersion: "apps/v1",
+		Kind:       "Deployment",
+		Name:       "router-default",
+		UID:        "1",
+		Controller: &trueVar,
+	}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			ic := &operatorv1.IngressController{
+				ObjectMeta: metav1.ObjectMeta{Name: "default"},
+				Status: operatorv1.IngressControllerStatus{
+					EndpointPublishingStrategy: &operatorv1.EndpointPublishingStrategy{
+						Type: operatorv1.LoadBalancerServiceStrategyType,
+						LoadBalancer: &operatorv1.LoadBalancerStrategy{
+							Scope: operatorv1.ExternalLoadBalancer,
+						},
+					},
+				},
+			}
+			if len(tc.overrides) != 0 {
+				ic.Spec.UnsupportedConfigOverrides = runtime.RawExtension{Raw: []byte(tc.overrides)}
+			}
+			_, desired, err := desiredLoadBalancerService(ic, "openshift-ingress", deploymentRef, tc.platform)
+			switch {
+			case tc.expectError && err == nil:
+				t.Fatal("expected an error")
+			case tc.expectError:
+				return
+			case err != nil:
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			annotations, labels, _ := loadBalancerServicePassthrough(ic, tc.platform)
+			for k, v := range annotations {
+				if desired.Annotations[k] != v {
+					t.Errorf("expected annotation %s=%s, got %q", k, v, desired.Annotations[k])
+				}
+			}
+			for k, v := range labels {
+				if desired.Labels[k] != v {
+					t.Errorf("expected label %s=%s, got %q", k, v, desired.Labels[k])
+				}
+			}
+
+			// Removing the overrides should remove the annotations
+			// and labels from the service.
+			ic.Spec.UnsupportedConfigOverrides = runtime.RawExtension{}
+			_, withoutOverrides, err := desiredLoadBalancerService(ic, "openshift-ingress", deploymentRef, tc.platform)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			changed, updated := loadBalancerServiceChanged(desired, withoutOverrides, tc.platform)
+			if changed != (len(annotations)+len(labels) != 0) {
+				t.Fatalf("unexpected result from loadBalancerServiceChanged: %t", changed)
+			}
+			if !changed {
+				return
+			}
+			for k := range annotations {
+				if _, ok := updated.Annotations[k]; ok {
+					t.Errorf("expected annotation %s to be removed", k)
+				}
+			}
+			for k := range labels {
+				if _, ok := updated.Labels[k]; ok {
+					t.Errorf("expected label %s to be removed", k)
+				}
+			}
+			for _, k := range []string{passthroughAnnotationsAnnotation, passthroughLabelsAnnotation} {
+				if _, ok := updated.Annotations[k]; ok {
+					t.Errorf("expected annotation %s to be removed", k)
+				}
+			}
+		})
+	}
+}
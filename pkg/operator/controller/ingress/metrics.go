@@ -104,7 +104,7 @@ func RegisterMetrics() error {
 
 // ensureMetricsIntegration ensures that router prometheus metrics is integrated with openshift-monitoring for the given ingresscontroller.
 func (r *reconciler) ensureMetricsIntegration(ci *operatorv1.IngressController, svc *corev1.Service, deploymentRef metav1.OwnerReference) error {
-	statsSecret := manifests.RouterStatsSecret(ci)
+	statsSecret := manifests.RouterStatsSecret(ci, r.config.OperandNamespace)
 	if err := r.client.Get(context.TODO(), types.NamespacedName{Namespace: statsSecret.Namespace, Name: statsSecret.Name}, statsSecret); err != nil {
 		if !errors.IsNotFound(err) {
 			return fmt.Errorf("failed to get router stats secret %s/%s, %v", statsSecret.Namespace, statsSecret.Name, err)
@@ -139,7 +139,7 @@ func (r *reconciler) ensureMetricsIntegration(ci *operatorv1.IngressController,
 		log.Info("created router metrics cluster role binding", "name", crb.Name)
 	}
 
-	mr := manifests.MetricsRole()
+	mr := manifests.MetricsRole(r.config.OperandNamespace)
 	if err := r.client.Get(context.TODO(), types.NamespacedName{Namespace: mr.Namespace, Name: mr.Name}, mr); err != nil {
 		if !errors.IsNotFound(err) {
 			return fmt.Errorf("failed to get router metrics role %s: %v", mr.Name, err)
@@ -150,7 +150,7 @@ func (r *reconciler) ensureMetricsIntegration(ci *operatorv1.IngressController,
 		log.Info("created router metrics role", "name", mr.Name)
 	}
 
-	mrb := manifests.MetricsRoleBinding()
+	mrb := manifests.MetricsRoleBinding(r.config.OperandNamespace)
 	if err := r.client.Get(context.TODO(), types.NamespacedName{Namespace: mrb.Namespace, Name: mrb.Name}, mrb); err != nil {
 		if !errors.IsNotFound(err) {
 			return fmt.Errorf("failed to get router metrics role binding %s: %v", mrb.Name, err)
@@ -8,8 +8,6 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 
-	operatorcontroller "github.com/openshift/cluster-ingress-operator/pkg/operator/controller"
-
 	operatorv1 "github.com/openshift/api/operator/v1"
 	"github.com/openshift/cluster-ingress-operator/pkg/manifests"
 	"github.com/openshift/cluster-ingress-operator/pkg/operator/controller"
@@ -54,7 +52,7 @@ func (r *reconciler) ensureServiceMonitor(ic *operatorv1.IngressController, svc
 // desiredServiceMonitor returns the desired servicemonitor for the given
 // ingresscontroller and service.
 func desiredServiceMonitor(ic *operatorv1.IngressController, svc *corev1.Service, deploymentRef metav1.OwnerReference) *unstructured.Unstructured {
-	name := controller.IngressControllerServiceMonitorName(ic)
+	name := controller.IngressControllerServiceMonitorName(ic, svc.Namespace)
 	sm := &unstructured.Unstructured{
 		Object: map[string]interface{}{
 			"metadata": map[string]interface{}{
@@ -64,7 +62,7 @@ func desiredServiceMonitor(ic *operatorv1.IngressController, svc *corev1.Service
 			"spec": map[string]interface{}{
 				"namespaceSelector": map[string]interface{}{
 					"matchNames": []interface{}{
-						operatorcontroller.DefaultOperandNamespace,
+						svc.Namespace,
 					},
 				},
 				"selector": map[string]interface{}{
@@ -116,7 +114,7 @@ func (r *reconciler) currentServiceMonitor(ic *operatorv1.IngressController) (bo
 		Kind:    "ServiceMonitor",
 		Version: "v1",
 	})
-	if err := r.client.Get(context.TODO(), controller.IngressControllerServiceMonitorName(ic), sm); err != nil {
+	if err := r.client.Get(context.TODO(), controller.IngressControllerServiceMonitorName(ic, r.config.OperandNamespace), sm); err != nil {
 		if errors.IsNotFound(err) {
 			return false, nil, nil
 		}
@@ -16,14 +16,21 @@ import (
 	operatorcontroller "github.com/openshift/cluster-ingress-operator/pkg/operator/controller"
 )
 
-// ensureRouterNamespace ensures that the router namespace exists.
+// ensureRouterNamespace ensures that the router namespace exists and that this
+// instance of the operator has claimed it.
 func (r *reconciler) ensureRouterNamespace() (bool, *corev1.Namespace, error) {
-	desired := manifests.RouterNamespace()
+	desired := manifests.RouterNamespace(r.config.OperandNamespace)
+	desired.Annotations[operatorcontroller.OwningOperatorNamespaceAnnotation] = r.config.Namespace
 
 	haveNamespace, current, err := r.currentRouterNamespace()
 	if err != nil {
 		return false, nil, err
 	}
+	if haveNamespace {
+		if err := operatorcontroller.ValidateNamespaceClaim(current, r.config.Namespace); err != nil {
+			return true, current, err
+		}
+	}
 
 	switch {
 	case !haveNamespace:
@@ -46,7 +53,7 @@ func (r *reconciler) ensureRouterNamespace() (bool, *corev1.Namespace, error) {
 func (r *reconciler) currentRouterNamespace() (bool, *corev1.Namespace, error) {
 	namespace := &corev1.Namespace{}
 	name := types.NamespacedName{
-		Name: operatorcontroller.DefaultOperandNamespace,
+		Name: r.config.OperandNamespace,
 	}
 	if err := r.client.Get(context.TODO(), name, namespace); err != nil {
 		if errors.IsNotFound(err) {
@@ -120,6 +127,11 @@ func routerNamespaceChanged(current, expected *corev1.Namespace) (bool, *corev1.
 		}
 	}
 
+	if owner, ok := expected.Annotations[operatorcontroller.OwningOperatorNamespaceAnnotation]; ok && current.Annotations[operatorcontroller.OwningOperatorNamespaceAnnotation] != owner {
+		updated.Annotations[operatorcontroller.OwningOperatorNamespaceAnnotation] = owner
+		changed = true
+	}
+
 	if !changed {
 		return false, nil
 	}
@@ -128,7 +140,7 @@ func routerNamespaceChanged(current, expected *corev1.Namespace) (bool, *corev1.
 }
 
 func (r *reconciler) ensureRouterServiceAccount() error {
-	sa := manifests.RouterServiceAccount()
+	sa := manifests.RouterServiceAccount(r.config.OperandNamespace)
 	if err := r.client.Get(context.TODO(), types.NamespacedName{Namespace: sa.Namespace, Name: sa.Name}, sa); err != nil {
 		if !errors.IsNotFound(err) {
 			return fmt.Errorf("failed to get router service account %s/%s: %v", sa.Namespace, sa.Name, err)
@@ -143,7 +155,7 @@ func (r *reconciler) ensureRouterServiceAccount() error {
 }
 
 func (r *reconciler) ensureRouterClusterRoleBinding() error {
-	crb := manifests.RouterClusterRoleBinding()
+	crb := manifests.RouterClusterRoleBinding(r.config.OperandNamespace)
 	if err := r.client.Get(context.TODO(), types.NamespacedName{Name: crb.Name}, crb); err != nil {
 		if !errors.IsNotFound(err) {
 			return fmt.Errorf("failed to get router cluster role binding %s: %v", crb.Name, err)
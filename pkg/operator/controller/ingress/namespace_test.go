@@ -51,7 +51,7 @@ func TestRouterNamespaceChanged(t *testing.T) {
 	}
 
 	for _, tc := range testCases {
-		desired := manifests.RouterNamespace()
+		desired := manifests.RouterNamespace("openshift-ingress")
 		mutated := desired.DeepCopy()
 		tc.mutate(mutated)
 		if changed, updated := routerNamespaceChanged(mutated, desired); changed != tc.expect {
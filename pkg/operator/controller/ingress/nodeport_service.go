@@ -47,7 +47,7 @@ func (r *reconciler) ensureNodePortService(ic *operatorv1.IngressController, dep
 		}
 	}
 
-	wantService, desired, err := desiredNodePortService(ic, deploymentRef, wantMetricsPort)
+	wantService, desired, err := desiredNodePortService(ic, r.config.OperandNamespace, deploymentRef, wantMetricsPort)
 	if err != nil {
 		return false, nil, err
 	}
@@ -60,7 +60,7 @@ func (r *reconciler) ensureNodePortService(ic *operatorv1.IngressController, dep
 		return false, nil, nil
 	case !wantService && haveService:
 		if !ownLBS {
-			return false, nil, fmt.Errorf("a conflicting nodeport service exists that is not owned by the ingress controller: %s", controller.LoadBalancerServiceName(ic))
+			return false, nil, fmt.Errorf("a conflicting nodeport service exists that is not owned by the ingress controller: %s", controller.LoadBalancerServiceName(ic, r.config.OperandNamespace))
 		}
 		if err := r.client.Delete(context.TODO(), current); err != nil {
 			if !errors.IsNotFound(err) {
@@ -78,7 +78,7 @@ func (r *reconciler) ensureNodePortService(ic *operatorv1.IngressController, dep
 		return r.currentNodePortService(ic)
 	case wantService && haveService:
 		if !ownLBS {
-			return false, nil, fmt.Errorf("a conflicting nodeport service exists that is not owned by the ingress controller: %s", controller.LoadBalancerServiceName(ic))
+			return false, nil, fmt.Errorf("a conflicting nodeport service exists that is not owned by the ingress controller: %s", controller.LoadBalancerServiceName(ic, r.config.OperandNamespace))
 		}
 		if updated, err := r.updateNodePortService(current, desired); err != nil {
 			return true, current, fmt.Errorf("failed to update NodePort service: %v", err)
@@ -92,12 +92,12 @@ func (r *reconciler) ensureNodePortService(ic *operatorv1.IngressController, dep
 
 // desiredNodePortService returns a Boolean indicating whether a NodePort
 // service is desired, as well as the NodePort service if one is desired.
-func desiredNodePortService(ic *operatorv1.IngressController, deploymentRef metav1.OwnerReference, wantMetricsPort bool) (bool, *corev1.Service, error) {
+func desiredNodePortService(ic *operatorv1.IngressController, operandNamespace string, deploymentRef metav1.OwnerReference, wantMetricsPort bool) (bool, *corev1.Service, error) {
 	if ic.Status.EndpointPublishingStrategy.Type != operatorv1.NodePortServiceStrategyType {
 		return false, nil, nil
 	}
 
-	name := controller.NodePortServiceName(ic)
+	name := controller.NodePortServiceName(ic, operandNamespace)
 	service := &corev1.Service{
 		ObjectMeta: metav1.ObjectMeta{
 			Annotations: map[string]string{},
@@ -154,7 +154,7 @@ func desiredNodePortService(ic *operatorv1.IngressController, deploymentRef meta
 // service if it does exist and an error value.
 func (r *reconciler) currentNodePortService(ic *operatorv1.IngressController) (bool, *corev1.Service, error) {
 	service := &corev1.Service{}
-	if err := r.client.Get(context.TODO(), controller.NodePortServiceName(ic), service); err != nil {
+	if err := r.client.Get(context.TODO(), controller.NodePortServiceName(ic, r.config.OperandNamespace), service); err != nil {
 		if errors.IsNotFound(err) {
 			return false, nil, nil
 		}
@@ -132,7 +132,7 @@ func TestDesiredNodePortService(t *testing.T) {
 				},
 			},
 		}
-		want, svc, err := desiredNodePortService(ic, deploymentRef, tc.wantMetricsPort)
+		want, svc, err := desiredNodePortService(ic, "openshift-ingress", deploymentRef, tc.wantMetricsPort)
 		if err != nil {
 			t.Errorf("unexpected error from desiredNodePortService: %v", err)
 		} else if want != tc.expect {
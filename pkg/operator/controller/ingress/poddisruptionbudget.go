@@ -21,7 +21,7 @@ import (
 // a given ingresscontroller.  Returns a Boolean indicating whether the PDB
 // exists, the PDB if it does exist, and an error value.
 func (r *reconciler) ensureRouterPodDisruptionBudget(ic *operatorv1.IngressController, deploymentRef metav1.OwnerReference) (bool, *policyv1.PodDisruptionBudget, error) {
-	wantPDB, desired, err := desiredRouterPodDisruptionBudget(ic, deploymentRef)
+	wantPDB, desired, err := desiredRouterPodDisruptionBudget(ic, r.config.OperandNamespace, deploymentRef)
 	if err != nil {
 		return false, nil, fmt.Errorf("failed to build pod disruption budget: %v", err)
 	}
@@ -63,18 +63,39 @@ func (r *reconciler) ensureRouterPodDisruptionBudget(ic *operatorv1.IngressContr
 // desiredRouterPodDisruptionBudget returns the desired router pod disruption
 // budget.  Returns a Boolean indicating whether a PDB is desired, as well as
 // the PDB if one is desired.
-func desiredRouterPodDisruptionBudget(ic *operatorv1.IngressController, deploymentRef metav1.OwnerReference) (bool, *policyv1.PodDisruptionBudget, error) {
-	if ic.Spec.Replicas != nil && *ic.Spec.Replicas < int32(2) {
+func desiredRouterPodDisruptionBudget(ic *operatorv1.IngressController, operandNamespace string, deploymentRef metav1.OwnerReference) (bool, *policyv1.PodDisruptionBudget, error) {
+	replicas := ic.Spec.Replicas
+	// If the deployment is autoscaled, base the budget on the fewest
+	// replicas the autoscaler may scale the deployment down to.
+	autoscaling, err := routerAutoscalingForIngressController(ic)
+	if err != nil {
+		return false, nil, err
+	}
+	if autoscaling != nil {
+		replicas = &autoscaling.MinReplicas
+	}
+
+	if replicas != nil && *replicas < int32(2) {
 		return false, nil, nil
 	}
 
-	maxUnavailable := "50%"
-	if ic.Spec.Replicas != nil && int(*ic.Spec.Replicas) >= 4 {
-		maxUnavailable = "25%"
+	maxUnavailable := intstr.FromString("50%")
+	if replicas != nil && int(*replicas) >= 4 {
+		maxUnavailable = intstr.FromString("25%")
+	}
+	// Keep the budget consistent with the deployment strategy's max
+	// unavailable value if the ingresscontroller specifies one.  A value
+	// of zero would block voluntary disruptions such as node drains, so in
+	// that case keep the default budget.
+	rollout, err := rolloutPolicyForIngressController(ic)
+	if err != nil {
+		return false, nil, err
+	}
+	if rollout != nil && rollout.MaxUnavailable != nil && !isZeroIntOrPercent(rollout.MaxUnavailable) {
+		maxUnavailable = *rollout.MaxUnavailable
 	}
 
-	name := controller.RouterPodDisruptionBudgetName(ic)
-	pointerTo := func(ios intstr.IntOrString) *intstr.IntOrString { return &ios }
+	name := controller.RouterPodDisruptionBudgetName(ic, operandNamespace)
 	pdb := policyv1.PodDisruptionBudget{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      name.Name,
@@ -83,7 +104,7 @@ func desiredRouterPodDisruptionBudget(ic *operatorv1.IngressController, deployme
 		Spec: policyv1.PodDisruptionBudgetSpec{
 			// The disruption controller rounds MaxUnavailable up.
 			// https://github.com/kubernetes/kubernetes/blob/65dc445aa2d581b4fa829258e46e4faf44e999b6/pkg/controller/disruption/disruption.go#L539
-			MaxUnavailable: pointerTo(intstr.FromString(maxUnavailable)),
+			MaxUnavailable: &maxUnavailable,
 			Selector:       controller.IngressControllerDeploymentPodSelector(ic),
 		},
 	}
@@ -97,7 +118,7 @@ func desiredRouterPodDisruptionBudget(ic *operatorv1.IngressController, deployme
 // did exist, and an error value.
 func (r *reconciler) currentRouterPodDisruptionBudget(ic *operatorv1.IngressController) (bool, *policyv1.PodDisruptionBudget, error) {
 	pdb := &policyv1.PodDisruptionBudget{}
-	if err := r.client.Get(context.TODO(), controller.RouterPodDisruptionBudgetName(ic), pdb); err != nil {
+	if err := r.client.Get(context.TODO(), controller.RouterPodDisruptionBudgetName(ic, r.config.OperandNamespace), pdb); err != nil {
 		if errors.IsNotFound(err) {
 			return false, nil, nil
 		}
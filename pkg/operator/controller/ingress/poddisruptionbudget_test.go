@@ -6,6 +6,7 @@ import (
 	operatorv1 "github.com/openshift/api/operator/v1"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
@@ -71,7 +72,7 @@ func TestDesiredPodDisruptionBudget(t *testing.T) {
 			UID:        "1",
 			Controller: &trueVar,
 		}
-		wantPDB, pdb, err := desiredRouterPodDisruptionBudget(ic, deploymentRef)
+		wantPDB, pdb, err := desiredRouterPodDisruptionBudget(ic, "openshift-ingress", deploymentRef)
 		if err != nil {
 			t.Errorf("%q: unexpected error: %v", tc.description, err)
 		} else if !wantPDB {
@@ -87,3 +88,65 @@ func TestDesiredPodDisruptionBudget(t *testing.T) {
 		}
 	}
 }
+
+// TestDesiredPodDisruptionBudgetAutoscaled verifies that the pod disruption
+// budget for an autoscaled router deployment is based on the autoscaling
+// minimum rather than on spec.replicas.
+func TestDesiredPodDisruptionBudgetAutoscaled(t *testing.T) {
+	pointerTo := func(v_ int) *int32 { v := int32(v_); return &v }
+	testCases := []struct {
+		description          string
+		replicas             *int32
+		overrides            string
+		expectPDB            bool
+		expectMaxUnavailable intstr.IntOrString
+	}{
+		{
+			description: "if minReplicas is 1, PDB should be absent",
+			replicas:    pointerTo(4),
+			overrides:   `{"autoscaling":{"minReplicas":1,"maxReplicas":4}}`,
+			expectPDB:   false,
+		},
+		{
+			description:          "if minReplicas is 2, PDB should be 50%",
+			replicas:             pointerTo(1),
+			overrides:            `{"autoscaling":{"minReplicas":2,"maxReplicas":10}}`,
+			expectPDB:            true,
+			expectMaxUnavailable: intstr.FromString("50%"),
+		},
+		{
+			description:          "if minReplicas is 4, PDB should be 25%",
+			overrides:            `{"autoscaling":{"minReplicas":4,"maxReplicas":10}}`,
+			expectPDB:            true,
+			expectMaxUnavailable: intstr.FromString("25%"),
+		},
+	}
+	for _, tc := range testCases {
+		trueVar := true
+		ic := &operatorv1.IngressController{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "default",
+			},
+			Spec: operatorv1.IngressControllerSpec{
+				Replicas:                   tc.replicas,
+				UnsupportedConfigOverrides: runtime.RawExtension{Raw: []byte(tc.overrides)},
+			},
+		}
+		deploymentRef := metav1.OwnerReference{
+			APIVersion: "apps/v1",
+			Kind:       "Deployment",
+			Name:       "router-default",
+			UID:        "1",
+			Controller: &trueVar,
+		}
+		wantPDB, pdb, err := desiredRouterPodDisruptionBudget(ic, "openshift-ingress", deploymentRef)
+		switch {
+		case err != nil:
+			t.Errorf("%q: unexpected error: %v", tc.description, err)
+		case wantPDB != tc.expectPDB:
+			t.Errorf("%q: expected %t, got %t", tc.description, tc.expectPDB, wantPDB)
+		case wantPDB && *pdb.Spec.MaxUnavailable != tc.expectMaxUnavailable:
+			t.Errorf("%q: expected %#v, got %#v", tc.description, tc.expectMaxUnavailable, pdb.Spec.MaxUnavailable)
+		}
+	}
+}
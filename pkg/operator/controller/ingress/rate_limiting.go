@@ -0,0 +1,181 @@
+package ingress
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// maxRateLimitingAllowlistEntries is the maximum number of addresses
+	// and CIDR blocks that an ingresscontroller may exempt from rate
+	// limiting.
+	maxRateLimitingAllowlistEntries = 100
+
+	// routerDefaultMaxConnections is the router's default value for
+	// maxconn, which applies when spec.tuningOptions.maxConnections is 0.
+	routerDefaultMaxConnections = 50000
+
+	RouterRateLimitHTTPRequestsPerSecondEnvName = "ROUTER_RATE_LIMIT_HTTP_REQUESTS_PER_SECOND"
+	RouterRateLimitConcurrentConnectionsEnvName = "ROUTER_RATE_LIMIT_CONCURRENT_CONNECTIONS"
+	RouterRateLimitAllowlistEnvName             = "ROUTER_RATE_LIMIT_ALLOWLIST"
+
+	// RouterMetricsHAProxyExportedEnvName is the router environment
+	// variable that lists the fields of HAProxy's statistics, by position
+	// in HAProxy's CSV statistics output, that the router exposes as
+	// metrics.
+	RouterMetricsHAProxyExportedEnvName = "ROUTER_METRICS_HAPROXY_EXPORTED"
+	// routerRateLimitingExportedMetrics is the value of
+	// ROUTER_METRICS_HAPROXY_EXPORTED for routers with rate limits.  It has
+	// the fields that the router exports by default and the denied requests
+	// field (10), in which HAProxy counts the requests and connections that
+	// the rate limits reject, so that the router exposes the
+	// haproxy_frontend_requests_denied_total metric.
+	routerRateLimitingExportedMetrics = "2,4,5,7,8,9,10,13,14,17,21,24,33,35,40,43,60"
+)
+
+// rateLimiting holds the rate limiting parameters that an ingresscontroller
+// can specify using the rateLimiting unsupported config override.  The router
+// tracks each source IP address in a stick table in its public frontends,
+// rejects HTTP requests in excess of the request rate with status 429, and
+// rejects connections in excess of the connection limit.  HAProxy counts the
+// rejections in the frontends' denied requests counter, which the router
+// exposes in its metrics.
+type rateLimiting struct {
+	// RequestsPerSecond is the maximum rate of HTTP requests that the
+	// router accepts from a single source IP address.  Zero means no
+	// limit.
+	RequestsPerSecond int32 `json:"requestsPerSecond"`
+	// ConcurrentConnections is the maximum number of concurrent
+	// connections that the router accepts from a single source IP address.
+	// Zero means no limit.  The effective limit does not exceed the
+	// router's maximum number of connections.
+	ConcurrentConnections int32 `json:"concurrentConnections"`
+	// Allowlist is a list of IP addresses and CIDR blocks that are exempt
+	// from the limits.
+	Allowlist []string `json:"allowlist"`
+}
+
+// rateLimitingForIngressController returns the effective rate limiting
+// configuration for the given ingresscontroller, or nil if it does not specify
+// one.  An error is returned if the configuration cannot be parsed or is
+// invalid.
+func rateLimitingForIngressController(ic *operatorv1.IngressController) (*rateLimiting, error) {
+	if len(ic.Spec.UnsupportedConfigOverrides.Raw) == 0 {
+		return nil, nil
+	}
+	var unsupportedConfigOverrides struct {
+		RateLimiting json.RawMessage `json:"rateLimiting"`
+	}
+	if err := json.Unmarshal(ic.Spec.UnsupportedConfigOverrides.Raw, &unsupportedConfigOverrides); err != nil {
+		return nil, fmt.Errorf("ingresscontroller %q has invalid spec.unsupportedConfigOverrides: %w", ic.Name, err)
+	}
+	if len(unsupportedConfigOverrides.RateLimiting) == 0 || string(unsupportedConfigOverrides.RateLimiting) == "null" {
+		return nil, nil
+	}
+	limits := &rateLimiting{}
+	decoder := json.NewDecoder(bytes.NewReader(unsupportedConfigOverrides.RateLimiting))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(limits); err != nil {
+		return nil, fmt.Errorf("spec.unsupportedConfigOverrides.rateLimiting is invalid: %w", err)
+	}
+
+	if limits.RequestsPerSecond < 0 {
+		return nil, fmt.Errorf("spec.unsupportedConfigOverrides.rateLimiting.requestsPerSecond may not be negative, got %d", limits.RequestsPerSecond)
+	}
+	if limits.ConcurrentConnections < 0 {
+		return nil, fmt.Errorf("spec.unsupportedConfigOverrides.rateLimiting.concurrentConnections may not be negative, got %d", limits.ConcurrentConnections)
+	}
+	if limits.RequestsPerSecond == 0 && limits.ConcurrentConnections == 0 {
+		return nil, fmt.Errorf("spec.unsupportedConfigOverrides.rateLimiting must specify requestsPerSecond or concurrentConnections")
+	}
+	if len(limits.Allowlist) > maxRateLimitingAllowlistEntries {
+		return nil, fmt.Errorf("spec.unsupportedConfigOverrides.rateLimiting.allowlist may have at most %d entries, got %d", maxRateLimitingAllowlistEntries, len(limits.Allowlist))
+	}
+	for i, entry := range limits.Allowlist {
+		if net.ParseIP(entry) != nil {
+			continue
+		}
+		if _, _, err := net.ParseCIDR(entry); err != nil {
+			return nil, fmt.Errorf("spec.unsupportedConfigOverrides.rateLimiting.allowlist[%d] must be an IP address or CIDR block, got %q", i, entry)
+		}
+	}
+
+	// A per-source connection limit above the router's global limit has
+	// no effect, so report the global limit instead.  With "auto", the
+	// router computes the global limit at startup, so the operator cannot
+	// know it.
+	switch maxConnections := ic.Spec.TuningOptions.MaxConnections; {
+	case maxConnections == 0 && limits.ConcurrentConnections > routerDefaultMaxConnections:
+		limits.ConcurrentConnections = routerDefaultMaxConnections
+	case maxConnections > 0 && limits.ConcurrentConnections > maxConnections:
+		limits.ConcurrentConnections = maxConnections
+	}
+
+	return limits, nil
+}
+
+// rateLimitingEnv returns the router environment variables that configure the
+// given rate limits and expose the router's count of rejected requests and
+// connections.
+func rateLimitingEnv(limits *rateLimiting) []corev1.EnvVar {
+	var env []corev1.EnvVar
+	if limits.RequestsPerSecond > 0 {
+		env = append(env, corev1.EnvVar{Name: RouterRateLimitHTTPRequestsPerSecondEnvName, Value: strconv.Itoa(int(limits.RequestsPerSecond))})
+	}
+	if limits.ConcurrentConnections > 0 {
+		env = append(env, corev1.EnvVar{Name: RouterRateLimitConcurrentConnectionsEnvName, Value: strconv.Itoa(int(limits.ConcurrentConnections))})
+	}
+	if len(limits.Allowlist) != 0 {
+		env = append(env, corev1.EnvVar{Name: RouterRateLimitAllowlistEnvName, Value: strings.Join(limits.Allowlist, " ")})
+	}
+	env = append(env, corev1.EnvVar{Name: RouterMetricsHAProxyExportedEnvName, Value: routerRateLimitingExportedMetrics})
+	return env
+}
+
+// computeRateLimitingCondition computes the ingress controller's current
+// RateLimiting status condition, which reports the effective rate limits.
+func computeRateLimitingCondition(ic *operatorv1.IngressController) operatorv1.OperatorCondition {
+	limits, err := rateLimitingForIngressController(ic)
+	switch {
+	case err != nil:
+		return operatorv1.OperatorCondition{
+			Type:    IngressControllerRateLimitingConditionType,
+			Status:  operatorv1.ConditionFalse,
+			Reason:  "InvalidConfiguration",
+			Message: fmt.Sprintf("The rate limiting configuration is invalid: %v", err),
+		}
+	case limits == nil:
+		return operatorv1.OperatorCondition{
+			Type:    IngressControllerRateLimitingConditionType,
+			Status:  operatorv1.ConditionFalse,
+			Reason:  "NotConfigured",
+			Message: "No rate limits are configured.",
+		}
+	}
+
+	var effective []string
+	if limits.RequestsPerSecond > 0 {
+		effective = append(effective, fmt.Sprintf("%d requests per second", limits.RequestsPerSecond))
+	}
+	if limits.ConcurrentConnections > 0 {
+		effective = append(effective, fmt.Sprintf("%d concurrent connections", limits.ConcurrentConnections))
+	}
+	message := fmt.Sprintf("Each source IP address is limited to %s.", strings.Join(effective, " and "))
+	if len(limits.Allowlist) != 0 {
+		message += fmt.Sprintf(" Exempt sources: %s.", strings.Join(limits.Allowlist, ", "))
+	}
+	return operatorv1.OperatorCondition{
+		Type:    IngressControllerRateLimitingConditionType,
+		Status:  operatorv1.ConditionTrue,
+		Reason:  "RateLimitsConfigured",
+		Message: message,
+	}
+}
@@ -0,0 +1,216 @@
+package ingress
+
+import (
+	"reflect"
+	"testing"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// TestRateLimitingForIngressController verifies that
+// rateLimitingForIngressController computes the effective rate limits and
+// rejects invalid ones.
+func TestRateLimitingForIngressController(t *testing.T) {
+	testCases := []struct {
+		description    string
+		overrides      string
+		maxConnections int32
+		expect         *rateLimiting
+		expectError    bool
+	}{
+		{
+			description: "no overrides",
+			expect:      nil,
+		},
+		{
+			description: "overrides without rate limiting",
+			overrides:   `{"loadBalancingAlgorithm":"leastconn"}`,
+			expect:      nil,
+		},
+		{
+			description: "request rate",
+			overrides:   `{"rateLimiting":{"requestsPerSecond":100}}`,
+			expect:      &rateLimiting{RequestsPerSecond: 100},
+		},
+		{
+			description: "all limits",
+			overrides:   `{"rateLimiting":{"requestsPerSecond":100,"concurrentConnections":20}}`,
+			expect: &rateLimiting{
+				RequestsPerSecond:     100,
+				ConcurrentConnections: 20,
+			},
+		},
+		{
+			description: "connection limit above default maximum connections",
+			overrides:   `{"rateLimiting":{"concurrentConnections":100000}}`,
+			expect:      &rateLimiting{ConcurrentConnections: routerDefaultMaxConnections},
+		},
+		{
+			description:    "connection limit above maximum connections",
+			overrides:      `{"rateLimiting":{"concurrentConnections":3000}}`,
+			maxConnections: 2000,
+			expect:         &rateLimiting{ConcurrentConnections: 2000},
+		},
+		{
+			description:    "connection limit with automatic maximum connections",
+			overrides:      `{"rateLimiting":{"concurrentConnections":100000}}`,
+			maxConnections: -1,
+			expect:         &rateLimiting{ConcurrentConnections: 100000},
+		},
+		{
+			description: "no limits",
+			overrides:   `{"rateLimiting":{}}`,
+			expectError: true,
+		},
+		{
+			description: "negative request rate",
+			overrides:   `{"rateLimiting":{"requestsPerSecond":-1}}`,
+			expectError: true,
+		},
+		{
+			description: "negative connection limit",
+			overrides:   `{"rateLimiting":{"requestsPerSecond":100,"concurrentConnections":-1}}`,
+			expectError: true,
+		},
+		{
+			description: "allowlist",
+			overrides:   `{"rateLimiting":{"requestsPerSecond":100,"allowlist":["10.0.0.0/8","192.168.0.1","fd00::/8"]}}`,
+			expect: &rateLimiting{
+				RequestsPerSecond: 100,
+				Allowlist:         []string{"10.0.0.0/8", "192.168.0.1", "fd00::/8"},
+			},
+		},
+		{
+			description: "invalid allowlist entry",
+			overrides:   `{"rateLimiting":{"requestsPerSecond":100,"allowlist":["10.0.0.0/33"]}}`,
+			expectError: true,
+		},
+		{
+			description: "unknown field",
+			overrides:   `{"rateLimiting":{"requestsPerSecond":100,"burst":10}}`,
+			expectError: true,
+		},
+		{
+			description: "malformed overrides",
+			overrides:   `{"rateLimiting":{"requestsPerSecond":"fast"}}`,
+			expectError: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			ic := &operatorv1.IngressController{
+				ObjectMeta: metav1.ObjectMeta{Name: "default"},
+			}
+			ic.Spec.TuningOptions.MaxConnections = tc.maxConnections
+			if len(tc.overrides) != 0 {
+				ic.Spec.UnsupportedConfigOverrides = runtime.RawExtension{Raw: []byte(tc.overrides)}
+			}
+			actual, err := rateLimitingForIngressController(ic)
+			switch {
+			case tc.expectError && err == nil:
+				t.Fatalf("expected error, got %+v", actual)
+			case !tc.expectError && err != nil:
+				t.Fatalf("unexpected error: %v", err)
+			case !tc.expectError && !reflect.DeepEqual(tc.expect, actual):
+				t.Errorf("expected %+v, got %+v", tc.expect, actual)
+			}
+		})
+	}
+}
+
+// TestComputeRateLimitingCondition verifies that the RateLimiting condition
+// reports the effective rate limits.
+func TestComputeRateLimitingCondition(t *testing.T) {
+	testCases := []struct {
+		description    string
+		overrides      string
+		maxConnections int32
+		expect         operatorv1.OperatorCondition
+	}{
+		{
+			description: "not configured",
+			expect: operatorv1.OperatorCondition{
+				Type:    IngressControllerRateLimitingConditionType,
+				Status:  operatorv1.ConditionFalse,
+				Reason:  "NotConfigured",
+				Message: "No rate limits are configured.",
+			},
+		},
+		{
+			description:    "configured",
+			overrides:      `{"rateLimiting":{"requestsPerSecond":100,"concurrentConnections":5000,"allowlist":["10.0.0.0/8","192.168.0.1"]}}`,
+			maxConnections: 2000,
+			expect: operatorv1.OperatorCondition{
+				Type:    IngressControllerRateLimitingConditionType,
+				Status:  operatorv1.ConditionTrue,
+				Reason:  "RateLimitsConfigured",
+				Message: "Each source IP address is limited to 100 requests per second and 2000 concurrent connections. Exempt sources: 10.0.0.0/8, 192.168.0.1.",
+			},
+		},
+		{
+			description: "invalid",
+			overrides:   `{"rateLimiting":{}}`,
+			expect: operatorv1.OperatorCondition{
+				Type:    IngressControllerRateLimitingConditionType,
+				Status:  operatorv1.ConditionFalse,
+				Reason:  "InvalidConfiguration",
+				Message: "The rate limiting configuration is invalid: spec.unsupportedConfigOverrides.rateLimiting must specify requestsPerSecond or concurrentConnections",
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			ic := &operatorv1.IngressController{
+				ObjectMeta: metav1.ObjectMeta{Name: "default"},
+			}
+			ic.Spec.TuningOptions.MaxConnections = tc.maxConnections
+			if len(tc.overrides) != 0 {
+				ic.Spec.UnsupportedConfigOverrides = runtime.RawExtension{Raw: []byte(tc.overrides)}
+			}
+			if actual := computeRateLimitingCondition(ic); !reflect.DeepEqual(tc.expect, actual) {
+				t.Errorf("expected %+v, got %+v", tc.expect, actual)
+			}
+		})
+	}
+}
+
+// TestDesiredRouterDeploymentRateLimiting verifies that
+// desiredRouterDeployment sets the router's rate limiting environment
+// variables and exposes the router's denied requests counter.
+func TestDesiredRouterDeploymentRateLimiting(t *testing.T) {
+	ic, ingressConfig, infraConfig, apiConfig, networkConfig, proxyNeeded := getRouterDeploymentComponents(t)
+	deployment, err := desiredRouterDeployment(ic, "openshift-ingress", ingressControllerImage, "", "", ingressConfig, infraConfig, apiConfig, networkConfig, proxyNeeded, false, nil)
+	if err != nil {
+		t.Fatalf("invalid router Deployment: %v", err)
+	}
+	tests := []envData{
+		{RouterRateLimitHTTPRequestsPerSecondEnvName, false, ""},
+		{RouterRateLimitConcurrentConnectionsEnvName, false, ""},
+		{RouterRateLimitAllowlistEnvName, false, ""},
+		{RouterMetricsHAProxyExportedEnvName, false, ""},
+	}
+	if err := checkDeploymentEnvironment(t, deployment, tests); err != nil {
+		t.Error(err)
+	}
+
+	ic.Spec.UnsupportedConfigOverrides = runtime.RawExtension{
+		Raw: []byte(`{"rateLimiting":{"requestsPerSecond":100,"allowlist":["10.0.0.0/8","192.168.0.1"]}}`),
+	}
+	deployment, err = desiredRouterDeployment(ic, "openshift-ingress", ingressControllerImage, "", "", ingressConfig, infraConfig, apiConfig, networkConfig, proxyNeeded, false, nil)
+	if err != nil {
+		t.Fatalf("invalid router Deployment: %v", err)
+	}
+	tests = []envData{
+		{RouterRateLimitHTTPRequestsPerSecondEnvName, true, "100"},
+		{RouterRateLimitConcurrentConnectionsEnvName, false, ""},
+		{RouterRateLimitAllowlistEnvName, true, "10.0.0.0/8 192.168.0.1"},
+		{RouterMetricsHAProxyExportedEnvName, true, routerRateLimitingExportedMetrics},
+	}
+	if err := checkDeploymentEnvironment(t, deployment, tests); err != nil {
+		t.Error(err)
+	}
+	checkDeploymentHasEnvSorted(t, deployment)
+}
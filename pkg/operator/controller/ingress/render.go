@@ -0,0 +1,222 @@
+package ingress
+
+import (
+	"fmt"
+
+	configv1 "github.com/openshift/api/config/v1"
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	"github.com/openshift/cluster-ingress-operator/pkg/manifests"
+	operatorcontroller "github.com/openshift/cluster-ingress-operator/pkg/operator/controller"
+	"github.com/openshift/cluster-ingress-operator/pkg/util/ingresscontroller"
+
+	corev1 "k8s.io/api/core/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// RenderConfig is the operator and cluster configuration with which to render
+// an ingresscontroller's operand manifests.
+type RenderConfig struct {
+	// OperatorNamespace is the namespace in which the operator watches
+	// ingresscontrollers.
+	OperatorNamespace string
+	// OperandNamespace is the namespace for the ingresscontroller's
+	// router deployment and related resources.  If empty, the default
+	// operand namespace is used.
+	OperandNamespace string
+	// IngressControllerImage is the router image.
+	IngressControllerImage string
+	// OTelCollectorImage is the OpenTelemetry collector image, if any.
+	OTelCollectorImage string
+	// OperatorImage is the ingress operator image, which runs the router
+	// tracing sidecar, if any.
+	OperatorImage string
+
+	APIConfig     *configv1.APIServer
+	DNSConfig     *configv1.DNS
+	InfraConfig   *configv1.Infrastructure
+	IngressConfig *configv1.Ingress
+	NetworkConfig *configv1.Network
+
+	// ClientCAConfigMap is the configmap with the client CA bundle that
+	// the ingresscontroller's spec.clientTLS.clientCA references, if any.
+	ClientCAConfigMap *corev1.ConfigMap
+	// ErrorPagesConfigMap is the configmap with the custom error pages
+	// that the ingresscontroller's spec.httpErrorCodePages references, if
+	// any.  Linting reports the error pages that the router would not
+	// serve.
+	ErrorPagesConfigMap *corev1.ConfigMap
+	// LoadBalancerIngress is the status of the load balancer for the
+	// ingresscontroller's LoadBalancer-type service, which determines the
+	// target of the wildcard DNS record.  If it is empty, no DNS record is
+	// rendered.
+	LoadBalancerIngress []corev1.LoadBalancerIngress
+}
+
+// RenderIngressController admits the given ingresscontroller the same way that
+// the operator does and returns the resources that the operator would create
+// for it, without using a client.  The returned ingresscontroller has the
+// status that admission sets.
+//
+// The rendered resources have no owner references, which the operator sets
+// using the UID of the router deployment when it creates them.  The rendered
+// deployment's replica count is the count that the ingresscontroller or the
+// cluster topology specifies and does not take node-aware defaulting into
+// account, and the operator's copy of the tracing credentials secret is not
+// rendered, as these depend on the cluster's current state.
+func RenderIngressController(ic *operatorv1.IngressController, config RenderConfig) (*operatorv1.IngressController, []client.Object, error) {
+	if config.InfraConfig == nil || config.InfraConfig.Status.PlatformStatus == nil {
+		return nil, nil, fmt.Errorf("infrastructure config has no platform status")
+	}
+	config = config.complete()
+	platformStatus := config.InfraConfig.Status.PlatformStatus
+	apiConfig, ingressConfig, networkConfig := config.APIConfig, config.IngressConfig, config.NetworkConfig
+
+	admitted, errs := admitIngressController(ic, config, nil)
+	if err := utilerrors.NewAggregate(errs); err != nil {
+		return nil, nil, fmt.Errorf("ingresscontroller %s would be rejected: %w", admitted.Name, err)
+	}
+
+	haveClientCAConfigmap := false
+	clientCAConfigmap := &corev1.ConfigMap{}
+	if len(admitted.Spec.ClientTLS.ClientCA.Name) != 0 {
+		if config.ClientCAConfigMap == nil {
+			return nil, nil, fmt.Errorf("ingresscontroller %s specifies a client CA configmap, but none was provided", admitted.Name)
+		}
+		haveClientCAConfigmap = true
+		clientCAConfigmap = config.ClientCAConfigMap
+	}
+
+	var objects []client.Object
+
+	_, clusterRole, _ := desiredClusterRole()
+	operandNamespace := config.OperandNamespace
+	namespace := manifests.RouterNamespace(operandNamespace)
+	namespace.Annotations[operatorcontroller.OwningOperatorNamespaceAnnotation] = admitted.Namespace
+	_, serviceCAConfigMap, _ := desiredServiceCAConfigMap(operandNamespace)
+	objects = append(objects, clusterRole, namespace, manifests.RouterServiceAccount(operandNamespace), manifests.RouterClusterRoleBinding(operandNamespace), serviceCAConfigMap)
+
+	proxyNeeded, err := IsProxyProtocolNeeded(admitted, platformStatus)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to determine if proxy protocol is needed for ingresscontroller %s: %w", admitted.Name, err)
+	}
+	deployment, err := desiredRouterDeployment(admitted, operandNamespace, config.IngressControllerImage, config.OTelCollectorImage, config.OperatorImage, ingressConfig, config.InfraConfig, apiConfig, networkConfig, proxyNeeded, haveClientCAConfigmap, clientCAConfigmap)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to build router deployment: %w", err)
+	}
+	objects = append(objects, deployment)
+
+	trueVar := true
+	deploymentRef := metav1.OwnerReference{
+		APIVersion: "apps/v1",
+		Kind:       "Deployment",
+		Name:       deployment.Name,
+		Controller: &trueVar,
+	}
+
+	wantLB, lbService, err := desiredLoadBalancerService(admitted, operandNamespace, deploymentRef, platformStatus)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to build load balancer service: %w", err)
+	}
+	if wantLB {
+		objects = append(objects, lbService)
+
+		service := lbService.DeepCopy()
+		service.Status.LoadBalancer.Ingress = config.LoadBalancerIngress
+		if wantRecord, record := desiredWildcardDNSRecord(admitted, service); wantRecord {
+			objects = append(objects, record)
+		}
+	}
+
+	if wantNodePort, nodePortService, err := desiredNodePortService(admitted, operandNamespace, deploymentRef, true); err != nil {
+		return nil, nil, fmt.Errorf("failed to build nodeport service: %w", err)
+	} else if wantNodePort {
+		objects = append(objects, nodePortService)
+	}
+
+	internalService := desiredInternalIngressControllerService(admitted, operandNamespace, deploymentRef)
+	objects = append(objects, internalService, desiredServiceMonitor(admitted, internalService, deploymentRef))
+
+	if wantCM, rsyslogConfigMap, err := desiredRsyslogConfigMap(admitted, operandNamespace, deploymentRef); err != nil {
+		return nil, nil, fmt.Errorf("failed to build rsyslog configmap: %w", err)
+	} else if wantCM {
+		objects = append(objects, rsyslogConfigMap)
+	}
+
+	tracing, _ := routerTracingForIngressController(admitted)
+	if wantCM, tracingConfigMap, err := desiredRouterTracingConfigMap(admitted, tracing, operandNamespace, deploymentRef); err != nil {
+		return nil, nil, fmt.Errorf("failed to build router tracing configmap: %w", err)
+	} else if wantCM {
+		objects = append(objects, tracingConfigMap)
+	}
+
+	if wantPDB, pdb, err := desiredRouterPodDisruptionBudget(admitted, operandNamespace, deploymentRef); err != nil {
+		return nil, nil, fmt.Errorf("failed to build pod disruption budget: %w", err)
+	} else if wantPDB {
+		objects = append(objects, pdb)
+	}
+
+	if wantHPA, hpa, err := desiredRouterHorizontalPodAutoscaler(admitted, operandNamespace, deploymentRef); err != nil {
+		return nil, nil, fmt.Errorf("failed to build horizontal pod autoscaler: %w", err)
+	} else if wantHPA {
+		objects = append(objects, hpa)
+	}
+
+	for _, obj := range objects {
+		obj.SetOwnerReferences(nil)
+	}
+
+	return admitted, objects, nil
+}
+
+// complete returns a copy of the config in which any cluster config that is
+// not provided is replaced by an empty object.
+func (c RenderConfig) complete() RenderConfig {
+	if len(c.OperandNamespace) == 0 {
+		c.OperandNamespace = operatorcontroller.DefaultOperandNamespace
+	}
+	if c.APIConfig == nil {
+		c.APIConfig = &configv1.APIServer{}
+	}
+	if c.DNSConfig == nil {
+		c.DNSConfig = &configv1.DNS{}
+	}
+	if c.IngressConfig == nil {
+		c.IngressConfig = &configv1.Ingress{}
+	}
+	if c.NetworkConfig == nil {
+		c.NetworkConfig = &configv1.Network{}
+	}
+	return c
+}
+
+// admitIngressController returns a copy of the given ingresscontroller with
+// the defaults that admission sets, and the reasons for which admission would
+// reject it given the existing ingresscontrollers.  If the ingresscontroller
+// would be admitted, the copy has the Admitted condition that admission sets.
+// The config must be complete and have a platform status.
+func admitIngressController(ic *operatorv1.IngressController, config RenderConfig, existing []operatorv1.IngressController) (*operatorv1.IngressController, []error) {
+	platformStatus := config.InfraConfig.Status.PlatformStatus
+
+	admitted := ic.DeepCopy()
+	if len(admitted.Namespace) == 0 {
+		admitted.Namespace = config.OperatorNamespace
+	}
+	setDefaultDomain(admitted, config.IngressConfig)
+	domainMatchesBaseDomain := manageDNSForDomain(admitted.Status.Domain, platformStatus, config.DNSConfig)
+	setDefaultPublishingStrategy(admitted, platformStatus, domainMatchesBaseDomain, config.IngressConfig, ingresscontroller.IsAdmitted(ic))
+	if errs := ingressControllerValidationErrors(admitted, platformStatus, existing, config.OTelCollectorImage, config.OperatorImage); len(errs) != 0 {
+		return admitted, errs
+	}
+	admitted.Status.Conditions = MergeConditions(admitted.Status.Conditions, operatorv1.OperatorCondition{
+		Type:   IngressControllerAdmittedConditionType,
+		Status: operatorv1.ConditionTrue,
+		Reason: "Valid",
+	})
+	admitted.Status.ObservedGeneration = admitted.Generation
+	return admitted, nil
+}
@@ -0,0 +1,178 @@
+package ingress
+
+import (
+	"reflect"
+	"testing"
+
+	configv1 "github.com/openshift/api/config/v1"
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	iov1 "github.com/openshift/api/operatoringress/v1"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// TestRenderIngressController verifies that RenderIngressController admits an
+// ingresscontroller and returns the resources that the operator would create
+// for it for the given platform.
+func TestRenderIngressController(t *testing.T) {
+	infraConfig := func(platform configv1.PlatformType) *configv1.Infrastructure {
+		return &configv1.Infrastructure{
+			Status: configv1.InfrastructureStatus{
+				PlatformStatus:         &configv1.PlatformStatus{Type: platform},
+				InfrastructureTopology: configv1.HighlyAvailableTopologyMode,
+				ControlPlaneTopology:   configv1.HighlyAvailableTopologyMode,
+			},
+		}
+	}
+	ingressConfig := &configv1.Ingress{Spec: configv1.IngressSpec{Domain: "apps.example.com"}}
+	dnsConfig := &configv1.DNS{Spec: configv1.DNSSpec{BaseDomain: "example.com"}}
+
+	testCases := []struct {
+		name                string
+		platform            configv1.PlatformType
+		loadBalancerIngress []corev1.LoadBalancerIngress
+		expectedStrategy    operatorv1.EndpointPublishingStrategyType
+		expectedKinds       []string
+	}{
+		{
+			name:             "host network on bare metal",
+			platform:         configv1.BareMetalPlatformType,
+			expectedStrategy: operatorv1.HostNetworkStrategyType,
+			expectedKinds:    []string{"ClusterRole", "Namespace", "ServiceAccount", "ClusterRoleBinding", "ConfigMap", "Deployment", "Service", "Unstructured", "PodDisruptionBudget"},
+		},
+		{
+			name:             "load balancer on AWS without an address",
+			platform:         configv1.AWSPlatformType,
+			expectedStrategy: operatorv1.LoadBalancerServiceStrategyType,
+			expectedKinds:    []string{"ClusterRole", "Namespace", "ServiceAccount", "ClusterRoleBinding", "ConfigMap", "Deployment", "Service", "Service", "Unstructured", "PodDisruptionBudget"},
+		},
+		{
+			name:                "load balancer on AWS with an address",
+			platform:            configv1.AWSPlatformType,
+			loadBalancerIngress: []corev1.LoadBalancerIngress{{Hostname: "lb.example.com"}},
+			expectedStrategy:    operatorv1.LoadBalancerServiceStrategyType,
+			expectedKinds:       []string{"ClusterRole", "Namespace", "ServiceAccount", "ClusterRoleBinding", "ConfigMap", "Deployment", "Service", "DNSRecord", "Service", "Unstructured", "PodDisruptionBudget"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			replicas := int32(2)
+			ic := &operatorv1.IngressController{
+				ObjectMeta: metav1.ObjectMeta{Name: "default"},
+				Spec:       operatorv1.IngressControllerSpec{Replicas: &replicas},
+			}
+			admitted, objects, err := RenderIngressController(ic, RenderConfig{
+				OperatorNamespace:      "openshift-ingress-operator",
+				IngressControllerImage: "quay.io/openshift/router:latest",
+				DNSConfig:              dnsConfig,
+				InfraConfig:            infraConfig(tc.platform),
+				IngressConfig:          ingressConfig,
+				LoadBalancerIngress:    tc.loadBalancerIngress,
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if admitted.Namespace != "openshift-ingress-operator" {
+				t.Errorf("expected namespace openshift-ingress-operator, got %q", admitted.Namespace)
+			}
+			if admitted.Status.Domain != "apps.example.com" {
+				t.Errorf("expected domain apps.example.com, got %q", admitted.Status.Domain)
+			}
+			if admitted.Status.EndpointPublishingStrategy == nil || admitted.Status.EndpointPublishingStrategy.Type != tc.expectedStrategy {
+				t.Errorf("expected endpoint publishing strategy %s, got %+v", tc.expectedStrategy, admitted.Status.EndpointPublishingStrategy)
+			}
+			if ic.Status.EndpointPublishingStrategy != nil {
+				t.Errorf("expected the given ingresscontroller not to be mutated")
+			}
+
+			kinds := make([]string, 0, len(objects))
+			for _, obj := range objects {
+				kinds = append(kinds, kindOf(obj))
+				if len(obj.GetOwnerReferences()) != 0 {
+					t.Errorf("expected %s %s to have no owner references", kindOf(obj), obj.GetName())
+				}
+				if deployment, ok := obj.(*appsv1.Deployment); ok {
+					if deployment.Spec.Template.Spec.Containers[0].Image != "quay.io/openshift/router:latest" {
+						t.Errorf("expected the router image to be set, got %q", deployment.Spec.Template.Spec.Containers[0].Image)
+					}
+					if *deployment.Spec.Replicas != replicas {
+						t.Errorf("expected %d replicas, got %d", replicas, *deployment.Spec.Replicas)
+					}
+				}
+				if record, ok := obj.(*iov1.DNSRecord); ok {
+					if record.Spec.DNSName != "*.apps.example.com." || len(record.Spec.Targets) != 1 || record.Spec.Targets[0] != "lb.example.com" {
+						t.Errorf("unexpected wildcard DNS record: %+v", record.Spec)
+					}
+				}
+			}
+			if len(kinds) != len(tc.expectedKinds) {
+				t.Fatalf("expected kinds %v, got %v", tc.expectedKinds, kinds)
+			}
+			for i := range kinds {
+				if kinds[i] != tc.expectedKinds[i] {
+					t.Fatalf("expected kinds %v, got %v", tc.expectedKinds, kinds)
+				}
+			}
+		})
+	}
+}
+
+// TestRenderIngressControllerErrors verifies that RenderIngressController
+// returns an error if the ingresscontroller would be rejected or if it depends
+// on configuration that was not provided.
+func TestRenderIngressControllerErrors(t *testing.T) {
+	infraConfig := &configv1.Infrastructure{
+		Status: configv1.InfrastructureStatus{
+			PlatformStatus: &configv1.PlatformStatus{Type: configv1.NonePlatformType},
+		},
+	}
+	testCases := []struct {
+		name   string
+		ic     *operatorv1.IngressController
+		config RenderConfig
+	}{
+		{
+			name:   "no platform status",
+			ic:     &operatorv1.IngressController{ObjectMeta: metav1.ObjectMeta{Name: "default"}},
+			config: RenderConfig{InfraConfig: &configv1.Infrastructure{}},
+		},
+		{
+			name:   "no domain",
+			ic:     &operatorv1.IngressController{ObjectMeta: metav1.ObjectMeta{Name: "default"}},
+			config: RenderConfig{InfraConfig: infraConfig},
+		},
+		{
+			name: "client CA configmap not provided",
+			ic: &operatorv1.IngressController{
+				ObjectMeta: metav1.ObjectMeta{Name: "default"},
+				Spec: operatorv1.IngressControllerSpec{
+					Domain: "apps.example.com",
+					ClientTLS: operatorv1.ClientTLS{
+						ClientCertificatePolicy: operatorv1.ClientCertificatePolicyRequired,
+						ClientCA:                configv1.ConfigMapNameReference{Name: "client-ca"},
+					},
+				},
+			},
+			config: RenderConfig{InfraConfig: infraConfig},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, _, err := RenderIngressController(tc.ic, tc.config); err == nil {
+				t.Errorf("expected an error")
+			}
+		})
+	}
+}
+
+// kindOf returns the name of the Go type of the given object, which is the
+// kind for typed objects.
+func kindOf(obj client.Object) string {
+	return reflect.TypeOf(obj).Elem().Name()
+}
@@ -1,9 +1,41 @@
 package ingress
 
 import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
 	configv1 "github.com/openshift/api/config/v1"
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+const (
+	// staticDefaultReplicas is the replica policy that uses the default
+	// from DetermineReplicas when spec.replicas is unset.
+	staticDefaultReplicas = "Static"
+	// nodeAwareDefaultReplicas is the replica policy that derives the
+	// default from the number of nodes and zones on which the router pods
+	// can be scheduled when spec.replicas is unset.
+	nodeAwareDefaultReplicas = "NodeAware"
 )
 
+// replicaPolicy holds the replica and zone-spreading parameters that an
+// ingresscontroller can specify using the replicaPolicy unsupported config
+// override.
+type replicaPolicy struct {
+	// DefaultReplicas is either staticDefaultReplicas or
+	// nodeAwareDefaultReplicas.  The default is staticDefaultReplicas.
+	DefaultReplicas string `json:"defaultReplicas"`
+	// ZoneSpread is the whenUnsatisfiable value for the deployment's zone
+	// topology spread constraint.  The default is ScheduleAnyway.
+	ZoneSpread corev1.UnsatisfiableConstraintAction `json:"zoneSpread"`
+}
+
 // DetermineReplicas implements the replicas choice algorithm as described in
 // the documentation for the IngressController replicas parameter. Used both in
 // determining the number of replicas for the default IngressController and in
@@ -20,6 +52,178 @@ func DetermineReplicas(ingressConfig *configv1.Ingress, infraConfig *configv1.In
 		return 1
 	}
 
-	// TODO: Set the replicas value to the number of workers.
+	// An ingresscontroller can opt in to deriving the default from the
+	// number of nodes and zones; see determineNodeAwareReplicas.
 	return 2
 }
+
+// replicaPolicyForIngressController returns the replica policy for the given
+// ingresscontroller, with defaults applied.  An error is returned if the
+// policy cannot be parsed or is invalid.
+func replicaPolicyForIngressController(ic *operatorv1.IngressController) (*replicaPolicy, error) {
+	var unsupportedConfigOverrides struct {
+		ReplicaPolicy replicaPolicy `json:"replicaPolicy"`
+	}
+	if len(ic.Spec.UnsupportedConfigOverrides.Raw) != 0 {
+		if err := json.Unmarshal(ic.Spec.UnsupportedConfigOverrides.Raw, &unsupportedConfigOverrides); err != nil {
+			return nil, fmt.Errorf("ingresscontroller %q has invalid spec.unsupportedConfigOverrides: %w", ic.Name, err)
+		}
+	}
+	policy := unsupportedConfigOverrides.ReplicaPolicy
+
+	switch policy.DefaultReplicas {
+	case "":
+		policy.DefaultReplicas = staticDefaultReplicas
+	case staticDefaultReplicas, nodeAwareDefaultReplicas:
+	default:
+		return nil, fmt.Errorf("spec.unsupportedConfigOverrides.replicaPolicy.defaultReplicas must be %q or %q, got %q", staticDefaultReplicas, nodeAwareDefaultReplicas, policy.DefaultReplicas)
+	}
+	switch policy.ZoneSpread {
+	case "":
+		policy.ZoneSpread = corev1.ScheduleAnyway
+	case corev1.ScheduleAnyway, corev1.DoNotSchedule:
+	default:
+		return nil, fmt.Errorf("spec.unsupportedConfigOverrides.replicaPolicy.zoneSpread must be %q or %q, got %q", corev1.ScheduleAnyway, corev1.DoNotSchedule, policy.ZoneSpread)
+	}
+
+	return &policy, nil
+}
+
+// determineNodeAwareReplicas returns the default number of replicas for a
+// router deployment with the given pod spec on a cluster with the given nodes.
+// The result is one replica per zone in which the pods can be scheduled, but
+// at least 2, and no more than the number of nodes on which the pods can be
+// scheduled.  If no node can be found on which the pods can be scheduled, 2 is
+// returned.
+func determineNodeAwareReplicas(nodes []corev1.Node, podSpec *corev1.PodSpec) int32 {
+	eligible := eligibleNodes(nodes, podSpec)
+	if len(eligible) == 0 {
+		return 2
+	}
+
+	replicas := int32(nodeZones(eligible).Len())
+	if replicas < 2 {
+		replicas = 2
+	}
+	if replicas > int32(len(eligible)) {
+		replicas = int32(len(eligible))
+	}
+	return replicas
+}
+
+// nodeZones returns the set of zones of the given nodes.  Nodes that do not
+// have a zone label are ignored.
+func nodeZones(nodes []corev1.Node) sets.String {
+	zones := sets.NewString()
+	for _, node := range nodes {
+		if zone, ok := node.Labels[corev1.LabelTopologyZone]; ok && len(zone) != 0 {
+			zones.Insert(zone)
+		}
+	}
+	return zones
+}
+
+// eligibleNodes returns the nodes to which a pod with the given pod spec can be
+// scheduled, taking into account the node selector, required node affinity,
+// tolerations, and whether each node is schedulable.
+func eligibleNodes(nodes []corev1.Node, podSpec *corev1.PodSpec) []corev1.Node {
+	var eligible []corev1.Node
+	for i := range nodes {
+		if nodeIsEligible(&nodes[i], podSpec) {
+			eligible = append(eligible, nodes[i])
+		}
+	}
+	return eligible
+}
+
+// nodeIsEligible returns a Boolean value indicating whether a pod with the
+// given pod spec can be scheduled to the given node.
+func nodeIsEligible(node *corev1.Node, podSpec *corev1.PodSpec) bool {
+	if node.Spec.Unschedulable {
+		return false
+	}
+	if !labels.SelectorFromSet(podSpec.NodeSelector).Matches(labels.Set(node.Labels)) {
+		return false
+	}
+	if affinity := podSpec.Affinity; affinity != nil && affinity.NodeAffinity != nil && affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution != nil {
+		matchesAnyTerm := false
+		for _, term := range affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms {
+			if nodeSelectorTermMatches(node, term) {
+				matchesAnyTerm = true
+				break
+			}
+		}
+		if !matchesAnyTerm {
+			return false
+		}
+	}
+	for i := range node.Spec.Taints {
+		taint := &node.Spec.Taints[i]
+		if taint.Effect != corev1.TaintEffectNoSchedule && taint.Effect != corev1.TaintEffectNoExecute {
+			continue
+		}
+		tolerated := false
+		for j := range podSpec.Tolerations {
+			if podSpec.Tolerations[j].ToleratesTaint(taint) {
+				tolerated = true
+				break
+			}
+		}
+		if !tolerated {
+			return false
+		}
+	}
+	return true
+}
+
+// nodeSelectorTermMatches returns a Boolean value indicating whether the given
+// node matches all the label requirements of the given node selector term.
+// Field requirements are ignored.
+func nodeSelectorTermMatches(node *corev1.Node, term corev1.NodeSelectorTerm) bool {
+	if len(term.MatchExpressions) == 0 && len(term.MatchFields) == 0 {
+		// An empty term matches no nodes.
+		return false
+	}
+	for _, req := range term.MatchExpressions {
+		value, exists := node.Labels[req.Key]
+		switch req.Operator {
+		case corev1.NodeSelectorOpIn:
+			if !exists || !sets.NewString(req.Values...).Has(value) {
+				return false
+			}
+		case corev1.NodeSelectorOpNotIn:
+			if exists && sets.NewString(req.Values...).Has(value) {
+				return false
+			}
+		case corev1.NodeSelectorOpExists:
+			if !exists {
+				return false
+			}
+		case corev1.NodeSelectorOpDoesNotExist:
+			if exists {
+				return false
+			}
+		case corev1.NodeSelectorOpGt, corev1.NodeSelectorOpLt:
+			if !exists || len(req.Values) != 1 {
+				return false
+			}
+			actual, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return false
+			}
+			bound, err := strconv.ParseInt(req.Values[0], 10, 64)
+			if err != nil {
+				return false
+			}
+			if req.Operator == corev1.NodeSelectorOpGt && !(actual > bound) {
+				return false
+			}
+			if req.Operator == corev1.NodeSelectorOpLt && !(actual < bound) {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,200 @@
+package ingress
+
+import (
+	"testing"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	corev1 "k8s.io/api/core/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// testNode returns a schedulable worker node in the given zone.  If zone is
+// empty, the node has no zone label.
+func testNode(name, zone string) corev1.Node {
+	node := corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+			Labels: map[string]string{
+				"kubernetes.io/os":               "linux",
+				"node-role.kubernetes.io/worker": "",
+			},
+		},
+	}
+	if len(zone) != 0 {
+		node.Labels[corev1.LabelTopologyZone] = zone
+	}
+	return node
+}
+
+func TestReplicaPolicyForIngressController(t *testing.T) {
+	testCases := []struct {
+		description string
+		overrides   string
+		expect      replicaPolicy
+		expectError bool
+	}{
+		{
+			description: "no overrides",
+			expect:      replicaPolicy{DefaultReplicas: staticDefaultReplicas, ZoneSpread: corev1.ScheduleAnyway},
+		},
+		{
+			description: "node-aware replicas",
+			overrides:   `{"replicaPolicy":{"defaultReplicas":"NodeAware"}}`,
+			expect:      replicaPolicy{DefaultReplicas: nodeAwareDefaultReplicas, ZoneSpread: corev1.ScheduleAnyway},
+		},
+		{
+			description: "hard zone spread",
+			overrides:   `{"replicaPolicy":{"zoneSpread":"DoNotSchedule"}}`,
+			expect:      replicaPolicy{DefaultReplicas: staticDefaultReplicas, ZoneSpread: corev1.DoNotSchedule},
+		},
+		{
+			description: "invalid default replicas",
+			overrides:   `{"replicaPolicy":{"defaultReplicas":"Workers"}}`,
+			expectError: true,
+		},
+		{
+			description: "invalid zone spread",
+			overrides:   `{"replicaPolicy":{"zoneSpread":"Always"}}`,
+			expectError: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			ic := &operatorv1.IngressController{
+				ObjectMeta: metav1.ObjectMeta{Name: "default"},
+			}
+			if len(tc.overrides) != 0 {
+				ic.Spec.UnsupportedConfigOverrides = runtime.RawExtension{Raw: []byte(tc.overrides)}
+			}
+			actual, err := replicaPolicyForIngressController(ic)
+			switch {
+			case tc.expectError && err == nil:
+				t.Fatalf("expected error, got %+v", actual)
+			case !tc.expectError && err != nil:
+				t.Fatalf("unexpected error: %v", err)
+			case !tc.expectError && *actual != tc.expect:
+				t.Errorf("expected %+v, got %+v", tc.expect, *actual)
+			}
+		})
+	}
+}
+
+func TestDetermineNodeAwareReplicas(t *testing.T) {
+	workerSpec := &corev1.PodSpec{
+		NodeSelector: map[string]string{
+			"kubernetes.io/os":               "linux",
+			"node-role.kubernetes.io/worker": "",
+		},
+		Affinity: &corev1.Affinity{
+			NodeAffinity: &corev1.NodeAffinity{
+				RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+					NodeSelectorTerms: []corev1.NodeSelectorTerm{{
+						MatchExpressions: []corev1.NodeSelectorRequirement{{
+							Key:      "node-role.kubernetes.io/remote-worker",
+							Operator: corev1.NodeSelectorOpNotIn,
+							Values:   []string{""},
+						}},
+					}},
+				},
+			},
+		},
+	}
+	infraSpec := &corev1.PodSpec{
+		NodeSelector: map[string]string{"node-role.kubernetes.io/infra": ""},
+		Tolerations: []corev1.Toleration{{
+			Key:      "node-role.kubernetes.io/infra",
+			Operator: corev1.TolerationOpExists,
+			Effect:   corev1.TaintEffectNoSchedule,
+		}},
+	}
+	infraNode := func(name, zone string, tainted bool) corev1.Node {
+		node := testNode(name, zone)
+		node.Labels["node-role.kubernetes.io/infra"] = ""
+		if tainted {
+			node.Spec.Taints = []corev1.Taint{{
+				Key:    "node-role.kubernetes.io/infra",
+				Effect: corev1.TaintEffectNoSchedule,
+			}}
+		}
+		return node
+	}
+	remoteWorker := testNode("remote", "us-east-1d")
+	remoteWorker.Labels["node-role.kubernetes.io/remote-worker"] = ""
+	cordoned := testNode("cordoned", "us-east-1e")
+	cordoned.Spec.Unschedulable = true
+
+	testCases := []struct {
+		description string
+		nodes       []corev1.Node
+		podSpec     *corev1.PodSpec
+		expect      int32
+	}{
+		{
+			description: "no nodes",
+			podSpec:     workerSpec,
+			expect:      2,
+		},
+		{
+			description: "single worker",
+			nodes:       []corev1.Node{testNode("a", "us-east-1a")},
+			podSpec:     workerSpec,
+			expect:      1,
+		},
+		{
+			description: "many workers in one zone",
+			nodes:       []corev1.Node{testNode("a", "us-east-1a"), testNode("b", "us-east-1a"), testNode("c", "us-east-1a")},
+			podSpec:     workerSpec,
+			expect:      2,
+		},
+		{
+			description: "workers without zone labels",
+			nodes:       []corev1.Node{testNode("a", ""), testNode("b", ""), testNode("c", "")},
+			podSpec:     workerSpec,
+			expect:      2,
+		},
+		{
+			description: "workers in three zones",
+			nodes:       []corev1.Node{testNode("a", "us-east-1a"), testNode("b", "us-east-1b"), testNode("c", "us-east-1c"), testNode("d", "us-east-1a")},
+			podSpec:     workerSpec,
+			expect:      3,
+		},
+		{
+			description: "remote and cordoned workers are ignored",
+			nodes:       []corev1.Node{testNode("a", "us-east-1a"), testNode("b", "us-east-1b"), remoteWorker, cordoned},
+			podSpec:     workerSpec,
+			expect:      2,
+		},
+		{
+			description: "infra nodes selected by node placement",
+			nodes:       []corev1.Node{testNode("a", "us-east-1a"), testNode("b", "us-east-1b"), testNode("c", "us-east-1c"), infraNode("i1", "us-east-1a", true), infraNode("i2", "us-east-1b", true)},
+			podSpec:     infraSpec,
+			expect:      2,
+		},
+		{
+			description: "untolerated taints are honored",
+			nodes:       []corev1.Node{infraNode("i1", "us-east-1a", false), infraNode("i2", "us-east-1b", false), infraNode("i3", "us-east-1c", false)},
+			podSpec: &corev1.PodSpec{
+				NodeSelector: map[string]string{"node-role.kubernetes.io/infra": ""},
+			},
+			expect: 3,
+		},
+		{
+			description: "untolerated taints exclude nodes",
+			nodes:       []corev1.Node{infraNode("i1", "us-east-1a", true), infraNode("i2", "us-east-1b", true), infraNode("i3", "us-east-1c", false)},
+			podSpec: &corev1.PodSpec{
+				NodeSelector: map[string]string{"node-role.kubernetes.io/infra": ""},
+			},
+			expect: 1,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			if actual := determineNodeAwareReplicas(tc.nodes, tc.podSpec); actual != tc.expect {
+				t.Errorf("expected %d, got %d", tc.expect, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,205 @@
+package ingress
+
+import (
+	"encoding/json"
+	"fmt"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// routerResources holds the container resource parameters that an
+// ingresscontroller can specify using the resources unsupported config
+// override.
+type routerResources struct {
+	// Router specifies the requests and limits of the router container.
+	// Any resource that it does not specify keeps its default request.
+	Router *corev1.ResourceRequirements `json:"router"`
+	// LoggingSidecar specifies the requests and limits of the access
+	// logging sidecar container, if the ingresscontroller has one.
+	LoggingSidecar *corev1.ResourceRequirements `json:"loggingSidecar"`
+	// GuaranteedQoS specifies that every container in the router pod has
+	// equal requests and limits for CPU and memory so that the pod has the
+	// Guaranteed QoS class.  The router's CPU and memory must be
+	// specified, and any sidecar's limits default to its requests.
+	GuaranteedQoS bool `json:"guaranteedQoS"`
+}
+
+// managedContainerResources is the set of resources that an ingresscontroller
+// may specify for its containers.
+var managedContainerResources = []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory}
+
+// routerResourcesForIngressController returns the container resources for the
+// given ingresscontroller, or nil if it does not specify any.  An error is
+// returned if the resources cannot be parsed or are invalid.
+func routerResourcesForIngressController(ic *operatorv1.IngressController) (*routerResources, error) {
+	if len(ic.Spec.UnsupportedConfigOverrides.Raw) == 0 {
+		return nil, nil
+	}
+	var unsupportedConfigOverrides struct {
+		Resources *routerResources `json:"resources"`
+	}
+	if err := json.Unmarshal(ic.Spec.UnsupportedConfigOverrides.Raw, &unsupportedConfigOverrides); err != nil {
+		return nil, fmt.Errorf("ingresscontroller %q has invalid spec.unsupportedConfigOverrides: %w", ic.Name, err)
+	}
+	resources := unsupportedConfigOverrides.Resources
+	if resources == nil {
+		return nil, nil
+	}
+
+	if err := validateContainerResources("spec.unsupportedConfigOverrides.resources.router", resources.Router, resources.GuaranteedQoS); err != nil {
+		return nil, err
+	}
+	if err := validateContainerResources("spec.unsupportedConfigOverrides.resources.loggingSidecar", resources.LoggingSidecar, resources.GuaranteedQoS); err != nil {
+		return nil, err
+	}
+	if resources.GuaranteedQoS {
+		for _, name := range managedContainerResources {
+			if !hasResourceValue(resources.Router, name) {
+				return nil, fmt.Errorf("spec.unsupportedConfigOverrides.resources.guaranteedQoS requires spec.unsupportedConfigOverrides.resources.router to specify %s", name)
+			}
+		}
+	}
+
+	return resources, nil
+}
+
+// validateContainerResources returns an error if the given container resources
+// specify a resource other than CPU and memory, a non-positive quantity, or a
+// request that exceeds the corresponding limit.  With Guaranteed QoS, requests
+// and limits that are both specified must be equal.  Errors are prefixed with
+// the given field path.
+func validateContainerResources(path string, resources *corev1.ResourceRequirements, guaranteed bool) error {
+	if resources == nil {
+		return nil
+	}
+	for field, list := range map[string]corev1.ResourceList{"requests": resources.Requests, "limits": resources.Limits} {
+		for name, quantity := range list {
+			if name != corev1.ResourceCPU && name != corev1.ResourceMemory {
+				return fmt.Errorf("%s.%s may only specify %s and %s, got %s", path, field, corev1.ResourceCPU, corev1.ResourceMemory, name)
+			}
+			if quantity.Sign() <= 0 {
+				return fmt.Errorf("%s.%s.%s must be positive, got %s", path, field, name, quantity.String())
+			}
+		}
+	}
+	for _, name := range managedContainerResources {
+		request, haveRequest := resources.Requests[name]
+		limit, haveLimit := resources.Limits[name]
+		if !haveRequest || !haveLimit {
+			continue
+		}
+		switch cmp := request.Cmp(limit); {
+		case cmp > 0:
+			return fmt.Errorf("%s.requests.%s (%s) may not exceed %s.limits.%s (%s)", path, name, request.String(), path, name, limit.String())
+		case cmp != 0 && guaranteed:
+			return fmt.Errorf("%s.requests.%s (%s) must equal %s.limits.%s (%s) for Guaranteed QoS", path, name, request.String(), path, name, limit.String())
+		}
+	}
+	return nil
+}
+
+// hasResourceValue returns a Boolean indicating whether the given container
+// resources specify a request or limit for the named resource.
+func hasResourceValue(resources *corev1.ResourceRequirements, name corev1.ResourceName) bool {
+	if resources == nil {
+		return false
+	}
+	_, haveRequest := resources.Requests[name]
+	_, haveLimit := resources.Limits[name]
+	return haveRequest || haveLimit
+}
+
+// applyRouterResources sets the container resources of the given router
+// deployment as the given resource configuration specifies.  The router
+// container must be the first container in the pod template.
+func applyRouterResources(deployment *appsv1.Deployment, resources *routerResources) {
+	containers := deployment.Spec.Template.Spec.Containers
+	mergeContainerResources(&containers[0].Resources, resources.Router)
+	for i := range containers[1:] {
+		container := &containers[i+1]
+		if container.Name == operatorv1.ContainerLoggingSidecarContainerName {
+			mergeContainerResources(&container.Resources, resources.LoggingSidecar)
+		}
+	}
+	if !resources.GuaranteedQoS {
+		return
+	}
+	for i := range containers {
+		setGuaranteedResources(&containers[i].Resources)
+	}
+}
+
+// mergeContainerResources overrides the requests and limits in the given
+// container resources with the ones that the given override specifies.
+func mergeContainerResources(resources *corev1.ResourceRequirements, override *corev1.ResourceRequirements) {
+	if override == nil {
+		return
+	}
+	for name, quantity := range override.Requests {
+		if resources.Requests == nil {
+			resources.Requests = corev1.ResourceList{}
+		}
+		resources.Requests[name] = quantity.DeepCopy()
+	}
+	for name, quantity := range override.Limits {
+		if resources.Limits == nil {
+			resources.Limits = corev1.ResourceList{}
+		}
+		resources.Limits[name] = quantity.DeepCopy()
+		// A request that exceeds the new limit would be invalid, and
+		// a request that the override does not specify should follow
+		// the limit, as the API server's defaulting does.
+		if _, ok := override.Requests[name]; !ok {
+			if request, ok := resources.Requests[name]; ok && request.Cmp(quantity) > 0 {
+				resources.Requests[name] = quantity.DeepCopy()
+			}
+		}
+	}
+}
+
+// setGuaranteedResources sets the requests and limits for CPU and memory in
+// the given container resources to be equal, using the limit if one is
+// specified and the request otherwise.
+func setGuaranteedResources(resources *corev1.ResourceRequirements) {
+	for _, name := range managedContainerResources {
+		quantity, ok := resources.Limits[name]
+		if !ok {
+			if quantity, ok = resources.Requests[name]; !ok {
+				continue
+			}
+		}
+		if resources.Requests == nil {
+			resources.Requests = corev1.ResourceList{}
+		}
+		if resources.Limits == nil {
+			resources.Limits = corev1.ResourceList{}
+		}
+		resources.Requests[name] = quantity.DeepCopy()
+		resources.Limits[name] = quantity.DeepCopy()
+	}
+}
+
+// hashableResources returns a copy of the given container resources with each
+// quantity in canonical form, so that quantities that are equal but were parsed
+// from different strings or read from the API have the same hash.
+func hashableResources(resources corev1.ResourceRequirements) corev1.ResourceRequirements {
+	canonical := func(list corev1.ResourceList) corev1.ResourceList {
+		if len(list) == 0 {
+			return nil
+		}
+		result := make(corev1.ResourceList, len(list))
+		for name, quantity := range list {
+			result[name] = *resource.NewMilliQuantity(quantity.MilliValue(), resource.DecimalSI)
+		}
+		return result
+	}
+	return corev1.ResourceRequirements{
+		Limits:   canonical(resources.Limits),
+		Requests: canonical(resources.Requests),
+	}
+}
@@ -0,0 +1,236 @@
+package ingress
+
+import (
+	"testing"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// TestRouterResourcesForIngressController verifies that
+// routerResourcesForIngressController accepts valid container resources and
+// rejects invalid ones.
+func TestRouterResourcesForIngressController(t *testing.T) {
+	testCases := []struct {
+		description string
+		overrides   string
+		expectNil   bool
+		expectError bool
+	}{
+		{
+			description: "no overrides",
+			expectNil:   true,
+		},
+		{
+			description: "overrides without resources",
+			overrides:   `{"loadBalancingAlgorithm":"leastconn"}`,
+			expectNil:   true,
+		},
+		{
+			description: "router requests and limits",
+			overrides:   `{"resources":{"router":{"requests":{"cpu":"500m","memory":"512Mi"},"limits":{"cpu":"2","memory":"1Gi"}}}}`,
+		},
+		{
+			description: "logging sidecar requests",
+			overrides:   `{"resources":{"loggingSidecar":{"requests":{"cpu":"50m"}}}}`,
+		},
+		{
+			description: "guaranteed QoS with limits",
+			overrides:   `{"resources":{"guaranteedQoS":true,"router":{"limits":{"cpu":"2","memory":"1Gi"}}}}`,
+		},
+		{
+			description: "guaranteed QoS with equal requests and limits",
+			overrides:   `{"resources":{"guaranteedQoS":true,"router":{"requests":{"cpu":"2000m","memory":"1Gi"},"limits":{"cpu":"2","memory":"1024Mi"}}}}`,
+		},
+		{
+			description: "guaranteed QoS without router memory",
+			overrides:   `{"resources":{"guaranteedQoS":true,"router":{"limits":{"cpu":"2"}}}}`,
+			expectError: true,
+		},
+		{
+			description: "guaranteed QoS with unequal requests and limits",
+			overrides:   `{"resources":{"guaranteedQoS":true,"router":{"requests":{"cpu":"1","memory":"1Gi"},"limits":{"cpu":"2","memory":"1Gi"}}}}`,
+			expectError: true,
+		},
+		{
+			description: "request exceeds limit",
+			overrides:   `{"resources":{"router":{"requests":{"memory":"2Gi"},"limits":{"memory":"1Gi"}}}}`,
+			expectError: true,
+		},
+		{
+			description: "unmanaged resource",
+			overrides:   `{"resources":{"router":{"limits":{"ephemeral-storage":"1Gi"}}}}`,
+			expectError: true,
+		},
+		{
+			description: "zero quantity",
+			overrides:   `{"resources":{"loggingSidecar":{"limits":{"cpu":"0"}}}}`,
+			expectError: true,
+		},
+		{
+			description: "malformed quantity",
+			overrides:   `{"resources":{"router":{"limits":{"cpu":"lots"}}}}`,
+			expectError: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			ic := &operatorv1.IngressController{
+				ObjectMeta: metav1.ObjectMeta{Name: "default"},
+			}
+			if len(tc.overrides) != 0 {
+				ic.Spec.UnsupportedConfigOverrides = runtime.RawExtension{Raw: []byte(tc.overrides)}
+			}
+			actual, err := routerResourcesForIngressController(ic)
+			switch {
+			case tc.expectError && err == nil:
+				t.Fatalf("expected error, got %+v", actual)
+			case !tc.expectError && err != nil:
+				t.Fatalf("unexpected error: %v", err)
+			case !tc.expectError && tc.expectNil != (actual == nil):
+				t.Errorf("expected nil %t, got %+v", tc.expectNil, actual)
+			}
+		})
+	}
+}
+
+// TestDesiredRouterDeploymentResources verifies that desiredRouterDeployment
+// applies the container resources and Guaranteed QoS mode.
+func TestDesiredRouterDeploymentResources(t *testing.T) {
+	testCases := []struct {
+		description          string
+		overrides            string
+		expectRouter         corev1.ResourceRequirements
+		expectLoggingSidecar corev1.ResourceRequirements
+	}{
+		{
+			description: "defaults",
+			overrides:   `{}`,
+			expectRouter: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("100m"),
+					corev1.ResourceMemory: resource.MustParse("256Mi"),
+				},
+			},
+			expectLoggingSidecar: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("100m"),
+					corev1.ResourceMemory: resource.MustParse("256Mi"),
+				},
+			},
+		},
+		{
+			description: "router limits and sidecar requests",
+			overrides:   `{"resources":{"router":{"requests":{"cpu":"1"},"limits":{"cpu":"4","memory":"128Mi"}},"loggingSidecar":{"requests":{"cpu":"50m"}}}}`,
+			expectRouter: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceCPU: resource.MustParse("1"),
+					// The default request exceeds the limit,
+					// so it follows the limit.
+					corev1.ResourceMemory: resource.MustParse("128Mi"),
+				},
+				Limits: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("4"),
+					corev1.ResourceMemory: resource.MustParse("128Mi"),
+				},
+			},
+			expectLoggingSidecar: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("50m"),
+					corev1.ResourceMemory: resource.MustParse("256Mi"),
+				},
+			},
+		},
+		{
+			description: "guaranteed QoS",
+			overrides:   `{"resources":{"guaranteedQoS":true,"router":{"limits":{"cpu":"2","memory":"1Gi"}},"loggingSidecar":{"limits":{"memory":"512Mi"}}}}`,
+			expectRouter: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("2"),
+					corev1.ResourceMemory: resource.MustParse("1Gi"),
+				},
+				Limits: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("2"),
+					corev1.ResourceMemory: resource.MustParse("1Gi"),
+				},
+			},
+			expectLoggingSidecar: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("100m"),
+					corev1.ResourceMemory: resource.MustParse("512Mi"),
+				},
+				Limits: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("100m"),
+					corev1.ResourceMemory: resource.MustParse("512Mi"),
+				},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			ic, ingressConfig, infraConfig, apiConfig, networkConfig, proxyNeeded := getRouterDeploymentComponents(t)
+			ic.Spec.Logging = &operatorv1.IngressControllerLogging{
+				Access: &operatorv1.AccessLogging{
+					Destination: operatorv1.LoggingDestination{
+						Type:      operatorv1.ContainerLoggingDestinationType,
+						Container: &operatorv1.ContainerLoggingDestinationParameters{},
+					},
+				},
+			}
+			ic.Spec.UnsupportedConfigOverrides = runtime.RawExtension{Raw: []byte(tc.overrides)}
+			deployment, err := desiredRouterDeployment(ic, "openshift-ingress", ingressControllerImage, "", "", ingressConfig, infraConfig, apiConfig, networkConfig, proxyNeeded, false, nil)
+			if err != nil {
+				t.Fatalf("invalid router Deployment: %v", err)
+			}
+			containers := deployment.Spec.Template.Spec.Containers
+			if len(containers) != 2 || containers[1].Name != operatorv1.ContainerLoggingSidecarContainerName {
+				t.Fatalf("expected router and logging sidecar containers, got %+v", containers)
+			}
+			if !equality.Semantic.DeepEqual(containers[0].Resources, tc.expectRouter) {
+				t.Errorf("expected router resources %+v, got %+v", tc.expectRouter, containers[0].Resources)
+			}
+			if !equality.Semantic.DeepEqual(containers[1].Resources, tc.expectLoggingSidecar) {
+				t.Errorf("expected logging sidecar resources %+v, got %+v", tc.expectLoggingSidecar, containers[1].Resources)
+			}
+		})
+	}
+}
+
+// TestDeploymentConfigChangedResourcesRepresentation verifies that
+// deploymentConfigChanged ignores differences in the representation of equal
+// resource quantities, such as those between the quantities that the operator
+// computes and the ones that the API returns.
+func TestDeploymentConfigChangedResourcesRepresentation(t *testing.T) {
+	newDeployment := func(cpu, memory string) *appsv1.Deployment {
+		return &appsv1.Deployment{
+			Spec: appsv1.DeploymentSpec{
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{{
+							Name: "router",
+							Resources: corev1.ResourceRequirements{
+								Requests: corev1.ResourceList{
+									corev1.ResourceCPU:    resource.MustParse(cpu),
+									corev1.ResourceMemory: resource.MustParse(memory),
+								},
+							},
+						}},
+					},
+				},
+			},
+		}
+	}
+	if changed, _ := deploymentConfigChanged(newDeployment("100m", "256Mi"), newDeployment("0.1", "268435456")); changed {
+		t.Error("expected equal quantities with different representations not to change the deployment")
+	}
+	if changed, _ := deploymentConfigChanged(newDeployment("100m", "256Mi"), newDeployment("200m", "256Mi")); !changed {
+		t.Error("expected changed quantities to change the deployment")
+	}
+}
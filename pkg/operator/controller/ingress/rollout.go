@@ -0,0 +1,153 @@
+package ingress
+
+import (
+	"encoding/json"
+	"fmt"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	appsv1 "k8s.io/api/apps/v1"
+
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+const (
+	// maxRouterTerminationGracePeriodSeconds is the longest termination
+	// grace period that an ingresscontroller may specify.
+	maxRouterTerminationGracePeriodSeconds = 24 * 60 * 60
+	// maxRouterMinReadySeconds is the longest minimum ready period that an
+	// ingresscontroller may specify.
+	maxRouterMinReadySeconds = 60 * 60
+)
+
+// rolloutPolicy holds the rollout parameters that an ingresscontroller can
+// specify using the rollout unsupported config override.  Each parameter that
+// is not specified keeps the value that the operator computes by default.
+type rolloutPolicy struct {
+	// MaxUnavailable is the deployment strategy's maximum number or
+	// percentage of unavailable replicas during a rolling update.  It is
+	// also the pod disruption budget's maximum number or percentage of
+	// unavailable replicas, unless it is zero.
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable"`
+	// MaxSurge is the deployment strategy's maximum number or percentage
+	// of replicas above the desired replica count during a rolling update.
+	// It must be zero with the HostNetwork endpoint publishing strategy,
+	// as surge pods could not bind the host ports on nodes that already
+	// run a router pod.
+	MaxSurge *intstr.IntOrString `json:"maxSurge"`
+	// TerminationGracePeriodSeconds is the time that a router pod has to
+	// drain connections after it is asked to terminate.
+	TerminationGracePeriodSeconds *int64 `json:"terminationGracePeriodSeconds"`
+	// MinReadySeconds is the time that a new router pod must be ready
+	// before the deployment controller considers it available and
+	// continues the rolling update.
+	MinReadySeconds *int32 `json:"minReadySeconds"`
+}
+
+// rolloutPolicyForIngressController returns the rollout policy for the given
+// ingresscontroller, or nil if it does not specify one.  An error is returned
+// if the policy cannot be parsed or is invalid, taking into account the
+// defaults for the ingresscontroller's endpoint publishing strategy.
+func rolloutPolicyForIngressController(ic *operatorv1.IngressController) (*rolloutPolicy, error) {
+	if len(ic.Spec.UnsupportedConfigOverrides.Raw) == 0 {
+		return nil, nil
+	}
+	var unsupportedConfigOverrides struct {
+		Rollout *rolloutPolicy `json:"rollout"`
+	}
+	if err := json.Unmarshal(ic.Spec.UnsupportedConfigOverrides.Raw, &unsupportedConfigOverrides); err != nil {
+		return nil, fmt.Errorf("ingresscontroller %q has invalid spec.unsupportedConfigOverrides: %w", ic.Name, err)
+	}
+	policy := unsupportedConfigOverrides.Rollout
+	if policy == nil {
+		return nil, nil
+	}
+
+	if err := validateRolloutIntOrPercent("spec.unsupportedConfigOverrides.rollout.maxUnavailable", policy.MaxUnavailable); err != nil {
+		return nil, err
+	}
+	if err := validateRolloutIntOrPercent("spec.unsupportedConfigOverrides.rollout.maxSurge", policy.MaxSurge); err != nil {
+		return nil, err
+	}
+	hostNetwork := ic.Status.EndpointPublishingStrategy != nil && ic.Status.EndpointPublishingStrategy.Type == operatorv1.HostNetworkStrategyType
+	if hostNetwork && policy.MaxSurge != nil && !isZeroIntOrPercent(policy.MaxSurge) {
+		return nil, fmt.Errorf("spec.unsupportedConfigOverrides.rollout.maxSurge must be zero when the endpoint publishing strategy is HostNetwork, got %q", policy.MaxSurge.String())
+	}
+	// The deployment controller rejects a rolling update that can neither
+	// remove nor add pods.  Compare against the default that applies to
+	// the field that the policy does not specify: with HostNetwork, the
+	// default max surge is zero, and otherwise, the defaults are non-zero.
+	maxUnavailableIsZero := policy.MaxUnavailable != nil && isZeroIntOrPercent(policy.MaxUnavailable)
+	maxSurgeIsZero := (policy.MaxSurge != nil && isZeroIntOrPercent(policy.MaxSurge)) || (policy.MaxSurge == nil && hostNetwork)
+	if maxUnavailableIsZero && maxSurgeIsZero {
+		if policy.MaxSurge == nil {
+			return nil, fmt.Errorf("spec.unsupportedConfigOverrides.rollout.maxUnavailable may not be zero when the endpoint publishing strategy is HostNetwork, as max surge is zero")
+		}
+		return nil, fmt.Errorf("spec.unsupportedConfigOverrides.rollout.maxUnavailable and spec.unsupportedConfigOverrides.rollout.maxSurge may not both be zero")
+	}
+	if v := policy.TerminationGracePeriodSeconds; v != nil && (*v < 1 || *v > maxRouterTerminationGracePeriodSeconds) {
+		return nil, fmt.Errorf("spec.unsupportedConfigOverrides.rollout.terminationGracePeriodSeconds must be between 1 and %d, got %d", maxRouterTerminationGracePeriodSeconds, *v)
+	}
+	if v := policy.MinReadySeconds; v != nil && (*v < 0 || *v > maxRouterMinReadySeconds) {
+		return nil, fmt.Errorf("spec.unsupportedConfigOverrides.rollout.minReadySeconds must be between 0 and %d, got %d", maxRouterMinReadySeconds, *v)
+	}
+
+	return policy, nil
+}
+
+// validateRolloutIntOrPercent returns an error if the given value is neither a
+// non-negative integer nor a percentage between 0% and 100%.  Errors are
+// prefixed with the given field path.
+func validateRolloutIntOrPercent(path string, v *intstr.IntOrString) error {
+	if v == nil {
+		return nil
+	}
+	switch v.Type {
+	case intstr.Int:
+		if v.IntVal < 0 {
+			return fmt.Errorf("%s may not be negative, got %d", path, v.IntVal)
+		}
+	case intstr.String:
+		if msgs := validation.IsValidPercent(v.StrVal); len(msgs) != 0 {
+			return fmt.Errorf("%s must be an integer or a percentage, got %q", path, v.StrVal)
+		}
+		if percent, _ := intstr.GetScaledValueFromIntOrPercent(v, 100, false); percent > 100 {
+			return fmt.Errorf("%s may not exceed 100%%, got %q", path, v.StrVal)
+		}
+	}
+	return nil
+}
+
+// isZeroIntOrPercent returns a Boolean indicating whether the given value is 0
+// or 0%.
+func isZeroIntOrPercent(v *intstr.IntOrString) bool {
+	value, err := intstr.GetScaledValueFromIntOrPercent(v, 100, true)
+	return err == nil && value == 0
+}
+
+// applyRolloutPolicy overrides the default rollout parameters of the given
+// router deployment with the ones that the given policy specifies.
+func applyRolloutPolicy(deployment *appsv1.Deployment, policy *rolloutPolicy) {
+	if policy.MaxUnavailable != nil || policy.MaxSurge != nil {
+		deployment.Spec.Strategy.Type = appsv1.RollingUpdateDeploymentStrategyType
+		if deployment.Spec.Strategy.RollingUpdate == nil {
+			deployment.Spec.Strategy.RollingUpdate = &appsv1.RollingUpdateDeployment{}
+		}
+		if policy.MaxUnavailable != nil {
+			maxUnavailable := *policy.MaxUnavailable
+			deployment.Spec.Strategy.RollingUpdate.MaxUnavailable = &maxUnavailable
+		}
+		if policy.MaxSurge != nil {
+			maxSurge := *policy.MaxSurge
+			deployment.Spec.Strategy.RollingUpdate.MaxSurge = &maxSurge
+		}
+	}
+	if policy.TerminationGracePeriodSeconds != nil {
+		gracePeriod := *policy.TerminationGracePeriodSeconds
+		deployment.Spec.Template.Spec.TerminationGracePeriodSeconds = &gracePeriod
+	}
+	if policy.MinReadySeconds != nil {
+		deployment.Spec.MinReadySeconds = *policy.MinReadySeconds
+	}
+}
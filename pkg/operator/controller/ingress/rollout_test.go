@@ -0,0 +1,253 @@
+package ingress
+
+import (
+	"reflect"
+	"testing"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	appsv1 "k8s.io/api/apps/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// TestRolloutPolicyForIngressController verifies that
+// rolloutPolicyForIngressController parses valid rollout policies and rejects
+// invalid ones.
+func TestRolloutPolicyForIngressController(t *testing.T) {
+	intOrStringPtr := func(v intstr.IntOrString) *intstr.IntOrString { return &v }
+	int64Ptr := func(v int64) *int64 { return &v }
+	int32Ptr := func(v int32) *int32 { return &v }
+	testCases := []struct {
+		description string
+		overrides   string
+		strategy    operatorv1.EndpointPublishingStrategyType
+		expect      *rolloutPolicy
+		expectError bool
+	}{
+		{
+			description: "no overrides",
+			expect:      nil,
+		},
+		{
+			description: "overrides without rollout",
+			overrides:   `{"loadBalancingAlgorithm":"leastconn"}`,
+			expect:      nil,
+		},
+		{
+			description: "slow rollout",
+			overrides:   `{"rollout":{"maxUnavailable":1,"maxSurge":"10%","terminationGracePeriodSeconds":7200,"minReadySeconds":120}}`,
+			expect: &rolloutPolicy{
+				MaxUnavailable:                intOrStringPtr(intstr.FromInt(1)),
+				MaxSurge:                      intOrStringPtr(intstr.FromString("10%")),
+				TerminationGracePeriodSeconds: int64Ptr(7200),
+				MinReadySeconds:               int32Ptr(120),
+			},
+		},
+		{
+			description: "fast rollout",
+			overrides:   `{"rollout":{"maxUnavailable":"100%","maxSurge":0,"terminationGracePeriodSeconds":30,"minReadySeconds":0}}`,
+			expect: &rolloutPolicy{
+				MaxUnavailable:                intOrStringPtr(intstr.FromString("100%")),
+				MaxSurge:                      intOrStringPtr(intstr.FromInt(0)),
+				TerminationGracePeriodSeconds: int64Ptr(30),
+				MinReadySeconds:               int32Ptr(0),
+			},
+		},
+		{
+			description: "zero max unavailable and max surge",
+			overrides:   `{"rollout":{"maxUnavailable":"0%","maxSurge":0}}`,
+			expectError: true,
+		},
+		{
+			description: "zero max unavailable with default max surge",
+			overrides:   `{"rollout":{"maxUnavailable":0}}`,
+			strategy:    operatorv1.LoadBalancerServiceStrategyType,
+			expect: &rolloutPolicy{
+				MaxUnavailable: intOrStringPtr(intstr.FromInt(0)),
+			},
+		},
+		{
+			description: "zero max unavailable with HostNetwork default max surge",
+			overrides:   `{"rollout":{"maxUnavailable":0}}`,
+			strategy:    operatorv1.HostNetworkStrategyType,
+			expectError: true,
+		},
+		{
+			description: "non-zero max surge with HostNetwork",
+			overrides:   `{"rollout":{"maxSurge":"25%"}}`,
+			strategy:    operatorv1.HostNetworkStrategyType,
+			expectError: true,
+		},
+		{
+			description: "zero max surge with HostNetwork",
+			overrides:   `{"rollout":{"maxUnavailable":1,"maxSurge":"0%"}}`,
+			strategy:    operatorv1.HostNetworkStrategyType,
+			expect: &rolloutPolicy{
+				MaxUnavailable: intOrStringPtr(intstr.FromInt(1)),
+				MaxSurge:       intOrStringPtr(intstr.FromString("0%")),
+			},
+		},
+		{
+			description: "negative max unavailable",
+			overrides:   `{"rollout":{"maxUnavailable":-1}}`,
+			expectError: true,
+		},
+		{
+			description: "max surge above 100%",
+			overrides:   `{"rollout":{"maxSurge":"150%"}}`,
+			expectError: true,
+		},
+		{
+			description: "max surge not a percentage",
+			overrides:   `{"rollout":{"maxSurge":"many"}}`,
+			expectError: true,
+		},
+		{
+			description: "zero termination grace period",
+			overrides:   `{"rollout":{"terminationGracePeriodSeconds":0}}`,
+			expectError: true,
+		},
+		{
+			description: "termination grace period too long",
+			overrides:   `{"rollout":{"terminationGracePeriodSeconds":86401}}`,
+			expectError: true,
+		},
+		{
+			description: "negative min ready seconds",
+			overrides:   `{"rollout":{"minReadySeconds":-1}}`,
+			expectError: true,
+		},
+		{
+			description: "min ready seconds too long",
+			overrides:   `{"rollout":{"minReadySeconds":3601}}`,
+			expectError: true,
+		},
+		{
+			description: "malformed overrides",
+			overrides:   `{"rollout":{"minReadySeconds":"soon"}}`,
+			expectError: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			ic := &operatorv1.IngressController{
+				ObjectMeta: metav1.ObjectMeta{Name: "default"},
+			}
+			if len(tc.overrides) != 0 {
+				ic.Spec.UnsupportedConfigOverrides = runtime.RawExtension{Raw: []byte(tc.overrides)}
+			}
+			if len(tc.strategy) != 0 {
+				ic.Status.EndpointPublishingStrategy = &operatorv1.EndpointPublishingStrategy{Type: tc.strategy}
+			}
+			actual, err := rolloutPolicyForIngressController(ic)
+			switch {
+			case tc.expectError && err == nil:
+				t.Fatalf("expected error, got %+v", actual)
+			case !tc.expectError && err != nil:
+				t.Fatalf("unexpected error: %v", err)
+			case !tc.expectError && !reflect.DeepEqual(tc.expect, actual):
+				t.Errorf("expected %+v, got %+v", tc.expect, actual)
+			}
+		})
+	}
+}
+
+// TestDesiredRouterDeploymentRolloutPolicy verifies that
+// desiredRouterDeployment applies the rollout policy and that the pod
+// disruption budget stays consistent with it.
+func TestDesiredRouterDeploymentRolloutPolicy(t *testing.T) {
+	testCases := []struct {
+		description                string
+		overrides                  string
+		expectMaxUnavailable       intstr.IntOrString
+		expectMaxSurge             intstr.IntOrString
+		expectGracePeriod          int64
+		expectMinReadySeconds      int32
+		expectPDBMaxUnavailable    intstr.IntOrString
+		expectScaledMaxUnavailable intstr.IntOrString
+	}{
+		{
+			description:                "defaults",
+			expectMaxUnavailable:       intstr.FromString("50%"),
+			expectMaxSurge:             intstr.FromString("25%"),
+			expectGracePeriod:          3600,
+			expectMinReadySeconds:      30,
+			expectPDBMaxUnavailable:    intstr.FromString("50%"),
+			expectScaledMaxUnavailable: intstr.FromString("25%"),
+		},
+		{
+			description:                "slow rollout",
+			overrides:                  `{"rollout":{"maxUnavailable":1,"maxSurge":1,"terminationGracePeriodSeconds":7200,"minReadySeconds":120}}`,
+			expectMaxUnavailable:       intstr.FromInt(1),
+			expectMaxSurge:             intstr.FromInt(1),
+			expectGracePeriod:          7200,
+			expectMinReadySeconds:      120,
+			expectPDBMaxUnavailable:    intstr.FromInt(1),
+			expectScaledMaxUnavailable: intstr.FromInt(1),
+		},
+		{
+			description:                "surge only",
+			overrides:                  `{"rollout":{"maxUnavailable":0,"minReadySeconds":0}}`,
+			expectMaxUnavailable:       intstr.FromInt(0),
+			expectMaxSurge:             intstr.FromString("25%"),
+			expectGracePeriod:          3600,
+			expectMinReadySeconds:      0,
+			expectPDBMaxUnavailable:    intstr.FromString("50%"),
+			expectScaledMaxUnavailable: intstr.FromInt(0),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			ic, ingressConfig, infraConfig, apiConfig, networkConfig, proxyNeeded := getRouterDeploymentComponents(t)
+			replicas := int32(2)
+			ic.Spec.Replicas = &replicas
+			if len(tc.overrides) != 0 {
+				ic.Spec.UnsupportedConfigOverrides = runtime.RawExtension{Raw: []byte(tc.overrides)}
+			}
+			deployment, err := desiredRouterDeployment(ic, "openshift-ingress", ingressControllerImage, "", "", ingressConfig, infraConfig, apiConfig, networkConfig, proxyNeeded, false, nil)
+			if err != nil {
+				t.Fatalf("invalid router Deployment: %v", err)
+			}
+			checkRollingUpdateParams(t, deployment, tc.expectMaxUnavailable, tc.expectMaxSurge)
+			if gracePeriod := deployment.Spec.Template.Spec.TerminationGracePeriodSeconds; gracePeriod == nil || *gracePeriod != tc.expectGracePeriod {
+				t.Errorf("expected termination grace period %d, got %v", tc.expectGracePeriod, gracePeriod)
+			}
+			if deployment.Spec.MinReadySeconds != tc.expectMinReadySeconds {
+				t.Errorf("expected min ready seconds %d, got %d", tc.expectMinReadySeconds, deployment.Spec.MinReadySeconds)
+			}
+
+			// Scaling the deployment must not override a max
+			// unavailable value that the policy specifies.
+			setRouterDeploymentReplicas(ic, deployment, 4)
+			checkRollingUpdateParams(t, deployment, tc.expectScaledMaxUnavailable, tc.expectMaxSurge)
+
+			_, pdb, err := desiredRouterPodDisruptionBudget(ic, "openshift-ingress", metav1.OwnerReference{})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if pdb == nil {
+				t.Fatal("expected pod disruption budget")
+			}
+			if !reflect.DeepEqual(*pdb.Spec.MaxUnavailable, tc.expectPDBMaxUnavailable) {
+				t.Errorf("expected pod disruption budget max unavailable %s, got %s", tc.expectPDBMaxUnavailable.String(), pdb.Spec.MaxUnavailable.String())
+			}
+		})
+	}
+}
+
+// TestApplyRolloutPolicySingleReplica verifies that applyRolloutPolicy sets a
+// rolling update strategy on a deployment that has none.
+func TestApplyRolloutPolicySingleReplica(t *testing.T) {
+	maxSurge := intstr.FromInt(1)
+	deployment := &appsv1.Deployment{}
+	applyRolloutPolicy(deployment, &rolloutPolicy{MaxSurge: &maxSurge})
+	if deployment.Spec.Strategy.Type != appsv1.RollingUpdateDeploymentStrategyType {
+		t.Errorf("expected strategy type %s, got %s", appsv1.RollingUpdateDeploymentStrategyType, deployment.Spec.Strategy.Type)
+	}
+	if rollingUpdate := deployment.Spec.Strategy.RollingUpdate; rollingUpdate == nil || rollingUpdate.MaxUnavailable != nil || !reflect.DeepEqual(rollingUpdate.MaxSurge, &maxSurge) {
+		t.Errorf("unexpected rolling update parameters: %+v", rollingUpdate)
+	}
+}
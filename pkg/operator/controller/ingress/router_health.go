@@ -0,0 +1,317 @@
+package ingress
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	routev1 "github.com/openshift/api/route/v1"
+
+	"github.com/openshift/cluster-ingress-operator/pkg/operator/controller"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+
+	"k8s.io/client-go/rest"
+)
+
+const (
+	// serviceCACertFile is the file in the operator's pod that has the
+	// service CA certificate, which signs the router's metrics
+	// certificate.
+	serviceCACertFile = "/var/run/secrets/kubernetes.io/serviceaccount/service-ca.crt"
+
+	// routerHealthCheckTimeout is the timeout for each request that the
+	// operator sends to a router pod to check its health.
+	routerHealthCheckTimeout = 10 * time.Second
+
+	// haproxyUpMetric is the router metric that reports whether the last
+	// scrape of HAProxy succeeded.
+	haproxyUpMetric = "haproxy_up"
+	// routerReloadFailureMetric is the router metric that reports whether
+	// the last reload of HAProxy failed.
+	routerReloadFailureMetric = "template_router_reload_failure"
+
+	// canaryHealthcheckResponse is the response body of the canary
+	// application.  It must match canary.CanaryHealthcheckResponse, which
+	// this package cannot import.
+	canaryHealthcheckResponse = "Healthcheck requested"
+)
+
+// probeCanaryRoute sends a request for the given canary route's host to the
+// given address, which is a host and port on which a router or a load
+// balancer in front of routers serves HTTPS, and returns a message describing
+// the failure if the response is not the canary application's.  An error is
+// returned if no response is received.  It is a variable to enable unit
+// testing.
+var probeCanaryRoute = func(route *routev1.Route, address string) (string, error) {
+	if len(route.Spec.Host) == 0 {
+		return "", fmt.Errorf("canary route %s/%s has no host", route.Namespace, route.Name)
+	}
+	dialer := &net.Dialer{Timeout: routerHealthCheckTimeout}
+	client := &http.Client{
+		Timeout: routerHealthCheckTimeout,
+		Transport: &http.Transport{
+			// Send the request to the given address instead of
+			// the address to which the route's host resolves.
+			DialContext: func(ctx context.Context, network, _ string) (net.Conn, error) {
+				return dialer.DialContext(ctx, network, address)
+			},
+			// The canary route uses edge termination with the
+			// router's default certificate, which may be self
+			// signed.
+			TLSClientConfig:   &tls.Config{InsecureSkipVerify: true},
+			DisableKeepAlives: true,
+		},
+	}
+	response, err := client.Get("https://" + route.Spec.Host)
+	if err != nil {
+		return "", fmt.Errorf("failed to send canary request for %s to %s: %w", route.Spec.Host, address, err)
+	}
+	defer response.Body.Close()
+	body, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return "", fmt.Errorf("failed to read canary response for %s from %s: %w", route.Spec.Host, address, err)
+	}
+	if response.StatusCode != http.StatusOK {
+		return fmt.Sprintf("The canary route %s responded through %s with HTTP status %d.", route.Spec.Host, address, response.StatusCode), nil
+	}
+	if !strings.Contains(string(body), canaryHealthcheckResponse) {
+		return fmt.Sprintf("The canary route %s responded through %s without the canary application's response.", route.Spec.Host, address), nil
+	}
+	return "", nil
+}
+
+// probeDefaultCertificate connects to the given address, which is a host and
+// port on which a router or a load balancer in front of routers serves HTTPS,
+// and returns a message describing the failure if the server does not present
+// the given PEM-encoded default certificate for the given server name.  An
+// error is returned if the TLS handshake fails.  It is a variable to enable
+// unit testing.
+var probeDefaultCertificate = func(address, serverName string, defaultCertificate []byte) (string, error) {
+	block, _ := pem.Decode(defaultCertificate)
+	if block == nil {
+		return "", fmt.Errorf("failed to decode the default certificate")
+	}
+	dialer := &net.Dialer{Timeout: routerHealthCheckTimeout}
+	conn, err := tls.DialWithDialer(dialer, "tcp", address, &tls.Config{
+		ServerName:         serverName,
+		InsecureSkipVerify: true,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to connect to %s: %w", address, err)
+	}
+	defer conn.Close()
+	certs := conn.ConnectionState().PeerCertificates
+	if len(certs) == 0 || !bytes.Equal(certs[0].Raw, block.Bytes) {
+		return fmt.Sprintf("%s did not present the ingresscontroller's default certificate.", address), nil
+	}
+	return "", nil
+}
+
+// probeRouters checks that the given address, which is a host and port on which
+// a router or a load balancer in front of routers serves HTTPS, forwards
+// requests to the given ingresscontroller's routers and that they route them.
+// If the ingresscontroller has admitted the canary route, a request for the
+// canary route is sent to the address; otherwise, the address must present the
+// ingresscontroller's default certificate.  Returns a message describing the
+// failure, if any, or an error if the check could not be made.
+func (r *reconciler) probeRouters(ic *operatorv1.IngressController, address string) (string, error) {
+	route := &routev1.Route{}
+	name := controller.CanaryRouteName(r.config.CanaryNamespace)
+	if err := r.client.Get(context.TODO(), name, route); err != nil {
+		if !errors.IsNotFound(err) {
+			return "", fmt.Errorf("failed to get canary route %s: %w", name, err)
+		}
+	} else if canaryRouteAdmittedBy(route, ic) {
+		return probeCanaryRoute(route, address)
+	}
+
+	secret := &corev1.Secret{}
+	secretName := controller.RouterEffectiveDefaultCertificateSecretName(ic, r.config.OperandNamespace)
+	if err := r.client.Get(context.TODO(), secretName, secret); err != nil {
+		return "", fmt.Errorf("failed to get default certificate secret %s: %w", secretName, err)
+	}
+	return probeDefaultCertificate(address, "router-health-check."+ic.Status.Domain, secret.Data[corev1.TLSCertKey])
+}
+
+// probeRouterPod checks that the given router pod of the given ingresscontroller
+// routes requests, using probeRouters with the pod's HTTPS port.  Returns a
+// message describing the failure, if any, or an error if the check could not
+// be made.
+func (r *reconciler) probeRouterPod(ic *operatorv1.IngressController, pod *corev1.Pod) (string, error) {
+	if len(pod.Status.PodIP) == 0 {
+		return "", fmt.Errorf("pod %s/%s has no IP address", pod.Namespace, pod.Name)
+	}
+	address := net.JoinHostPort(pod.Status.PodIP, strconv.Itoa(int(routerPodHTTPSPort(pod))))
+	failure, err := r.probeRouters(ic, address)
+	if len(failure) != 0 {
+		return fmt.Sprintf("Canary pod %s failed the canary route check: %s", pod.Name, failure), nil
+	}
+	return "", err
+}
+
+// canaryRouteAdmittedBy returns a Boolean value indicating whether the given
+// ingresscontroller has admitted the given canary route, in which case the
+// ingresscontroller's routers serve the route.
+func canaryRouteAdmittedBy(route *routev1.Route, ic *operatorv1.IngressController) bool {
+	for _, ingress := range route.Status.Ingress {
+		if ingress.RouterName != ic.Name {
+			continue
+		}
+		for _, cond := range ingress.Conditions {
+			if cond.Type == routev1.RouteAdmitted && cond.Status == corev1.ConditionTrue {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// routerPodHealthChecker checks the health of router pods using the router's
+// health and metrics endpoints.
+type routerPodHealthChecker struct {
+	// config is the operator's REST config, which has the bearer token
+	// that the router's metrics endpoint authorizes.
+	config *rest.Config
+}
+
+// check checks the health of the given router pod of the given
+// ingresscontroller.  check returns a message describing the failure if the
+// router reports that it is not ready, that HAProxy is down, or that the last
+// reload of HAProxy failed.  An error is returned if the health of the pod
+// cannot be determined.
+func (c *routerPodHealthChecker) check(ic *operatorv1.IngressController, pod *corev1.Pod) (string, error) {
+	if len(pod.Status.PodIP) == 0 {
+		return "", fmt.Errorf("pod %s/%s has no IP address", pod.Namespace, pod.Name)
+	}
+	host := net.JoinHostPort(pod.Status.PodIP, strconv.Itoa(int(routerPodStatsPort(pod))))
+
+	healthClient := &http.Client{Timeout: routerHealthCheckTimeout}
+	response, err := healthClient.Get("http://" + host + "/healthz/ready")
+	if err != nil {
+		return "", fmt.Errorf("failed to check the health of pod %s/%s: %w", pod.Namespace, pod.Name, err)
+	}
+	response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return fmt.Sprintf("Canary pod %s failed the router's health check with HTTP status %d.", pod.Name, response.StatusCode), nil
+	}
+
+	families, err := c.scrapeMetrics(ic, pod, host)
+	if err != nil {
+		return "", err
+	}
+	if gaugeValue(families[haproxyUpMetric]) == 0 {
+		return fmt.Sprintf("Canary pod %s reported that HAProxy is down.", pod.Name), nil
+	}
+	if gaugeValue(families[routerReloadFailureMetric]) != 0 {
+		return fmt.Sprintf("Canary pod %s reported that HAProxy failed to reload.", pod.Name), nil
+	}
+	return "", nil
+}
+
+// scrapeMetrics returns the metrics of the given router pod, which serves them
+// at the given host using a certificate for the ingresscontroller's internal
+// service.
+func (c *routerPodHealthChecker) scrapeMetrics(ic *operatorv1.IngressController, pod *corev1.Pod, host string) (map[string]*dto.MetricFamily, error) {
+	caCert, err := ioutil.ReadFile(serviceCACertFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read service CA certificate: %w", err)
+	}
+	roots := x509.NewCertPool()
+	if !roots.AppendCertsFromPEM(caCert) {
+		return nil, fmt.Errorf("failed to parse service CA certificate from %s", serviceCACertFile)
+	}
+	token := c.config.BearerToken
+	if len(c.config.BearerTokenFile) != 0 {
+		b, err := ioutil.ReadFile(c.config.BearerTokenFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read bearer token: %w", err)
+		}
+		token = string(b)
+	}
+
+	internalService := controller.InternalIngressControllerServiceName(ic, pod.Namespace)
+	metricsClient := &http.Client{
+		Timeout: routerHealthCheckTimeout,
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{
+				RootCAs:    roots,
+				ServerName: fmt.Sprintf("%s.%s.svc", internalService.Name, internalService.Namespace),
+			},
+		},
+	}
+	request, err := http.NewRequest("GET", "https://"+host+"/metrics", nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Authorization", "Bearer "+token)
+	response, err := metricsClient.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get metrics from pod %s/%s: %w", pod.Namespace, pod.Name, err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to get metrics from pod %s/%s: HTTP status %d", pod.Namespace, pod.Name, response.StatusCode)
+	}
+	var parser expfmt.TextParser
+	families, err := parser.TextToMetricFamilies(response.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse metrics from pod %s/%s: %w", pod.Namespace, pod.Name, err)
+	}
+	return families, nil
+}
+
+// routerPodStatsPort returns the port on which the given router pod serves
+// its health and metrics endpoints.
+func routerPodStatsPort(pod *corev1.Pod) int32 {
+	for _, container := range pod.Spec.Containers {
+		for _, port := range container.Ports {
+			if port.Name == StatsPortName {
+				return port.ContainerPort
+			}
+		}
+	}
+	return routerDefaultHostNetworkStatsPort
+}
+
+// routerPodHTTPSPort returns the port on which the given router pod serves
+// HTTPS.
+func routerPodHTTPSPort(pod *corev1.Pod) int32 {
+	for _, container := range pod.Spec.Containers {
+		for _, port := range container.Ports {
+			if port.Name == HTTPSPortName {
+				return port.ContainerPort
+			}
+		}
+	}
+	return routerDefaultHostNetworkHTTPSPort
+}
+
+// gaugeValue returns the value of the first gauge in the given metric family,
+// or -1 if the family has none.
+func gaugeValue(family *dto.MetricFamily) float64 {
+	if family == nil {
+		return -1
+	}
+	for _, metric := range family.Metric {
+		if metric.Gauge != nil {
+			return metric.Gauge.GetValue()
+		}
+	}
+	return -1
+}
@@ -0,0 +1,85 @@
+package ingress
+
+import (
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	routev1 "github.com/openshift/api/route/v1"
+)
+
+// TestProbeCanaryRoute verifies that probeCanaryRoute sends the request for the
+// canary route's host to the given address and reports responses that are not
+// the canary application's.
+func TestProbeCanaryRoute(t *testing.T) {
+	route := &routev1.Route{Spec: routev1.RouteSpec{Host: "canary.apps.example.com"}}
+	testCases := []struct {
+		name          string
+		status        int
+		body          string
+		expectFailure bool
+	}{
+		{
+			name:   "canary response",
+			status: http.StatusOK,
+			body:   canaryHealthcheckResponse,
+		},
+		{
+			name:          "route not available",
+			status:        http.StatusServiceUnavailable,
+			body:          "Application is not available",
+			expectFailure: true,
+		},
+		{
+			name:          "wrong application",
+			status:        http.StatusOK,
+			body:          "hello",
+			expectFailure: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var host string
+			server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				host = r.Host
+				w.WriteHeader(tc.status)
+				fmt.Fprint(w, tc.body)
+			}))
+			defer server.Close()
+
+			failure, err := probeCanaryRoute(route, strings.TrimPrefix(server.URL, "https://"))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if host != route.Spec.Host {
+				t.Errorf("expected request for host %q, got %q", route.Spec.Host, host)
+			}
+			if expectFailure := len(failure) != 0; expectFailure != tc.expectFailure {
+				t.Errorf("expected failure to be %t, got %q", tc.expectFailure, failure)
+			}
+		})
+	}
+}
+
+// TestProbeDefaultCertificate verifies that probeDefaultCertificate reports an
+// address that does not present the expected default certificate.
+func TestProbeDefaultCertificate(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+	address := strings.TrimPrefix(server.URL, "https://")
+	served := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+
+	failure, err := probeDefaultCertificate(address, "router-health-check.apps.example.com", served)
+	if err != nil || len(failure) != 0 {
+		t.Errorf("expected the served certificate to pass, got failure %q and error %v", failure, err)
+	}
+
+	other := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("not the served certificate")})
+	failure, err = probeDefaultCertificate(address, "router-health-check.apps.example.com", other)
+	if err != nil || len(failure) == 0 {
+		t.Errorf("expected a different certificate to fail, got failure %q and error %v", failure, err)
+	}
+}
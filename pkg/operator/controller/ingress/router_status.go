@@ -59,7 +59,7 @@ func (r *reconciler) syncRouteStatus(ic *operatorv1.IngressController) []error {
 // isRouterDeploymentRolloutComplete determines whether the rollout of the ingress router deployment is complete.
 func (r *reconciler) isRouterDeploymentRolloutComplete(ic *operatorv1.IngressController) (bool, error) {
 	deployment := appsv1.Deployment{}
-	deploymentName := operatorcontroller.RouterDeploymentName(ic)
+	deploymentName := operatorcontroller.RouterDeploymentName(ic, r.config.OperandNamespace)
 	if err := r.client.Get(context.TODO(), deploymentName, &deployment); err != nil {
 		return false, fmt.Errorf("failed to get deployment %s: %w", deploymentName, err)
 	}
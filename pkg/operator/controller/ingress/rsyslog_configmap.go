@@ -22,6 +22,16 @@ const (
 $SystemLogSocketName /var/lib/rsyslog/rsyslog.sock
 $ModLoad omstdout.so
 *.* :omstdout:
+`
+	// rsyslogJSONConfiguration is the contents for rsyslog.conf when the
+	// access logs are JSON.  It writes only the log message, without the
+	// syslog header, so that each line of output is a JSON object.  The
+	// message begins with a space, which the template drops.
+	rsyslogJSONConfiguration = `$ModLoad imuxsock
+$SystemLogSocketName /var/lib/rsyslog/rsyslog.sock
+$ModLoad omstdout.so
+$template AccessLogMessage,"%msg:2:$%\n"
+*.* :omstdout:;AccessLogMessage
 `
 )
 
@@ -30,7 +40,7 @@ $ModLoad omstdout.so
 // indicating whether the configmap exists, the configmap if it does exist, and
 // an error value.
 func (r *reconciler) ensureRsyslogConfigMap(ic *operatorv1.IngressController, deploymentRef metav1.OwnerReference) (bool, *corev1.ConfigMap, error) {
-	wantCM, desired, err := desiredRsyslogConfigMap(ic, deploymentRef)
+	wantCM, desired, err := desiredRsyslogConfigMap(ic, r.config.OperandNamespace, deploymentRef)
 	if err != nil {
 		return false, nil, fmt.Errorf("failed to build configmap: %v", err)
 	}
@@ -72,21 +82,38 @@ func (r *reconciler) ensureRsyslogConfigMap(ic *operatorv1.IngressController, de
 // desiredRsyslogConfigMap returns the desired rsyslog configmap.  Returns a
 // Boolean indicating whether a configmap is desired, as well as the configmap
 // if one is desired.
-func desiredRsyslogConfigMap(ic *operatorv1.IngressController, deploymentRef metav1.OwnerReference) (bool, *corev1.ConfigMap, error) {
+func desiredRsyslogConfigMap(ic *operatorv1.IngressController, operandNamespace string, deploymentRef metav1.OwnerReference) (bool, *corev1.ConfigMap, error) {
 	accessLogging := accessLoggingForIngressController(ic)
 	if accessLogging == nil || accessLogging.Destination.Type != operatorv1.ContainerLoggingDestinationType {
 		return false, nil, nil
 	}
 
-	name := controller.RsyslogConfigMapName(ic)
+	data := map[string]string{
+		"rsyslog.conf": rsyslogConfiguration,
+	}
+	options, _ := accessLogOptionsForIngressController(ic)
+	switch {
+	case options != nil && options.OTLP != nil:
+		// The OpenTelemetry collector sidecar is used instead of
+		// rsyslog.
+		config, err := desiredOTelCollectorConfig(ic, options)
+		if err != nil {
+			return false, nil, err
+		}
+		data = map[string]string{
+			otelCollectorConfigKey: config,
+		}
+	case options != nil && options.Format == jsonAccessLogFormat:
+		data["rsyslog.conf"] = rsyslogJSONConfiguration
+	}
+
+	name := controller.RsyslogConfigMapName(ic, operandNamespace)
 	cm := corev1.ConfigMap{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      name.Name,
 			Namespace: name.Namespace,
 		},
-		Data: map[string]string{
-			"rsyslog.conf": rsyslogConfiguration,
-		},
+		Data: data,
 	}
 	cm.SetOwnerReferences([]metav1.OwnerReference{deploymentRef})
 
@@ -98,7 +125,7 @@ func desiredRsyslogConfigMap(ic *operatorv1.IngressController, deploymentRef met
 // exist, and an error value.
 func (r *reconciler) currentRsyslogConfigMap(ic *operatorv1.IngressController) (bool, *corev1.ConfigMap, error) {
 	cm := &corev1.ConfigMap{}
-	if err := r.client.Get(context.TODO(), controller.RsyslogConfigMapName(ic), cm); err != nil {
+	if err := r.client.Get(context.TODO(), controller.RsyslogConfigMapName(ic, r.config.OperandNamespace), cm); err != nil {
 		if errors.IsNotFound(err) {
 			return false, nil, nil
 		}
@@ -18,7 +18,7 @@ import (
 // exists.  Returns a Boolean indicating whether the configmap exists, the
 // configmap if it does exist, and an error value.
 func (r *reconciler) ensureServiceCAConfigMap() (bool, *corev1.ConfigMap, error) {
-	wantCM, desired, err := desiredServiceCAConfigMap()
+	wantCM, desired, err := desiredServiceCAConfigMap(r.config.OperandNamespace)
 	if err != nil {
 		return false, nil, fmt.Errorf("failed to build configmap: %v", err)
 	}
@@ -60,8 +60,8 @@ func (r *reconciler) ensureServiceCAConfigMap() (bool, *corev1.ConfigMap, error)
 // desiredServiceCAConfigMap returns the desired configmap for the service CA
 // bundle.  Returns a Boolean indicating whether a configmap is desired, as well
 // as the configmap if one is desired.
-func desiredServiceCAConfigMap() (bool, *corev1.ConfigMap, error) {
-	name := controller.ServiceCAConfigMapName()
+func desiredServiceCAConfigMap(operandNamespace string) (bool, *corev1.ConfigMap, error) {
+	name := controller.ServiceCAConfigMapName(operandNamespace)
 	cm := corev1.ConfigMap{
 		ObjectMeta: metav1.ObjectMeta{
 			Annotations: map[string]string{
@@ -81,7 +81,7 @@ func desiredServiceCAConfigMap() (bool, *corev1.ConfigMap, error) {
 // configmap if it did exist, and an error value.
 func (r *reconciler) currentServiceCAConfigMap() (bool, *corev1.ConfigMap, error) {
 	cm := &corev1.ConfigMap{}
-	if err := r.client.Get(context.TODO(), controller.ServiceCAConfigMapName(), cm); err != nil {
+	if err := r.client.Get(context.TODO(), controller.ServiceCAConfigMapName(r.config.OperandNamespace), cm); err != nil {
 		if errors.IsNotFound(err) {
 			return false, nil, nil
 		}
@@ -0,0 +1,543 @@
+package ingress
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	"github.com/openshift/cluster-ingress-operator/pkg/operator/controller"
+	retryable "github.com/openshift/cluster-ingress-operator/pkg/util/retryableerror"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	crclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// stagedRolloutCanaryLabel is the label that identifies the pods of a
+	// staged rollout's canary deployment, and the value is the name of the
+	// owning ingresscontroller.  Canary pods have this label in place of
+	// the router pods' deployment label, so that the router's services,
+	// pod disruption budget, and horizontal pod autoscaler do not select
+	// them.
+	stagedRolloutCanaryLabel = "ingresscontroller.operator.openshift.io/staged-rollout-canary"
+
+	// stagedRolloutCandidateHashAnnotation is an annotation that the
+	// operator sets on the router deployment to record the pod template
+	// hash that a staged rollout is verifying.
+	stagedRolloutCandidateHashAnnotation = "ingresscontroller.operator.openshift.io/staged-rollout-candidate-hash"
+
+	// stagedRolloutRejectedHashAnnotation is an annotation that the
+	// operator sets on the router deployment to record the pod template
+	// hash that a staged rollout last failed to verify, so that the
+	// operator does not retry the same template.
+	stagedRolloutRejectedHashAnnotation = "ingresscontroller.operator.openshift.io/staged-rollout-rejected-hash"
+
+	// stagedRolloutFailureMessageAnnotation is an annotation that the
+	// operator sets on the router deployment to record why the rejected
+	// pod template failed verification.
+	stagedRolloutFailureMessageAnnotation = "ingresscontroller.operator.openshift.io/staged-rollout-failure-message"
+
+	// stagedRolloutVerifiedHashAnnotation is an annotation that the
+	// operator sets on the router deployment to record the pod template
+	// hash that a staged rollout verified, while the operator waits for
+	// the canary pods to terminate before it promotes the pod template.
+	stagedRolloutVerifiedHashAnnotation = "ingresscontroller.operator.openshift.io/staged-rollout-verified-hash"
+
+	// defaultStagedRolloutCanaryReplicas is the default number of canary
+	// replicas.
+	defaultStagedRolloutCanaryReplicas = int32(1)
+	// maxStagedRolloutCanaryReplicas is the maximum number of canary
+	// replicas.
+	maxStagedRolloutCanaryReplicas = int32(10)
+
+	// defaultStagedRolloutVerificationSeconds is the default period for
+	// which every canary replica must be ready before the operator
+	// promotes the rollout.
+	defaultStagedRolloutVerificationSeconds = int32(120)
+	// minStagedRolloutVerificationSeconds and
+	// maxStagedRolloutVerificationSeconds bound the verification period.
+	minStagedRolloutVerificationSeconds = int32(10)
+	maxStagedRolloutVerificationSeconds = int32(3600)
+
+	// stagedRolloutProgressDeadline is how long, in addition to the
+	// verification period, the canary replicas have to become ready
+	// before the operator gives up on the rollout.
+	stagedRolloutProgressDeadline = 10 * time.Minute
+
+	// stagedRolloutPollInterval is how often the operator checks on a
+	// staged rollout that is being verified.
+	stagedRolloutPollInterval = 15 * time.Second
+
+	// stagedRolloutCanaryTerminationGracePeriodSeconds is the termination
+	// grace period of canary pods.  Canary pods do not receive traffic
+	// through the router's services, so they have no connections to
+	// drain.
+	stagedRolloutCanaryTerminationGracePeriodSeconds = int64(30)
+)
+
+// stagedRolloutFailureReasons is the set of container waiting reasons that
+// fail a staged rollout immediately.
+var stagedRolloutFailureReasons = map[string]struct{}{
+	"CrashLoopBackOff":           {},
+	"CreateContainerConfigError": {},
+	"CreateContainerError":       {},
+	"ErrImagePull":               {},
+	"ImagePullBackOff":           {},
+	"InvalidImageName":           {},
+}
+
+// stagedRollout holds the parameters that an ingresscontroller can specify
+// using the stagedRollout unsupported config override.
+type stagedRollout struct {
+	// Enabled specifies that changes to the router deployment's pod
+	// template are verified on canary replicas before they are rolled out
+	// to the router deployment.
+	Enabled bool `json:"enabled"`
+	// CanaryReplicas is the number of canary replicas.  The default is 1.
+	CanaryReplicas int32 `json:"canaryReplicas"`
+	// VerificationSeconds is the number of seconds for which every canary
+	// replica must be ready, without restarting, before the canary
+	// replicas' health is checked and the change is promoted.  The default
+	// is 120.
+	VerificationSeconds int32 `json:"verificationSeconds"`
+}
+
+// stagedRolloutForIngressController returns the staged rollout parameters for
+// the given ingresscontroller, or nil if it does not enable staged rollouts.
+// An error is returned if the parameters cannot be parsed or are invalid.
+func stagedRolloutForIngressController(ic *operatorv1.IngressController) (*stagedRollout, error) {
+	if len(ic.Spec.UnsupportedConfigOverrides.Raw) == 0 {
+		return nil, nil
+	}
+	var unsupportedConfigOverrides struct {
+		StagedRollout *stagedRollout `json:"stagedRollout"`
+	}
+	if err := json.Unmarshal(ic.Spec.UnsupportedConfigOverrides.Raw, &unsupportedConfigOverrides); err != nil {
+		return nil, fmt.Errorf("ingresscontroller %q has invalid spec.unsupportedConfigOverrides: %w", ic.Name, err)
+	}
+	staged := unsupportedConfigOverrides.StagedRollout
+	if staged == nil || !staged.Enabled {
+		return nil, nil
+	}
+
+	if staged.CanaryReplicas == 0 {
+		staged.CanaryReplicas = defaultStagedRolloutCanaryReplicas
+	}
+	if staged.CanaryReplicas < 1 || staged.CanaryReplicas > maxStagedRolloutCanaryReplicas {
+		return nil, fmt.Errorf("spec.unsupportedConfigOverrides.stagedRollout.canaryReplicas must be between 1 and %d, got %d", maxStagedRolloutCanaryReplicas, staged.CanaryReplicas)
+	}
+	if staged.VerificationSeconds == 0 {
+		staged.VerificationSeconds = defaultStagedRolloutVerificationSeconds
+	}
+	if staged.VerificationSeconds < minStagedRolloutVerificationSeconds || staged.VerificationSeconds > maxStagedRolloutVerificationSeconds {
+		return nil, fmt.Errorf("spec.unsupportedConfigOverrides.stagedRollout.verificationSeconds must be between %d and %d, got %d", minStagedRolloutVerificationSeconds, maxStagedRolloutVerificationSeconds, staged.VerificationSeconds)
+	}
+	// Canary replicas would contend with the router replicas for the
+	// same host ports.
+	if eps := ic.Status.EndpointPublishingStrategy; eps != nil && eps.Type == operatorv1.HostNetworkStrategyType {
+		return nil, fmt.Errorf("spec.unsupportedConfigOverrides.stagedRollout is not supported with the %s endpoint publishing strategy", operatorv1.HostNetworkStrategyType)
+	}
+
+	return staged, nil
+}
+
+// ensureStagedRollout updates the given current router deployment to the
+// given desired one, verifying any change to the pod template on canary
+// replicas first.  While a change is being verified, the current pod template
+// is kept and a retryable error is returned.  If verification fails, the
+// current pod template is kept and the rejected template is recorded on the
+// router deployment so that it is not retried.
+func (r *reconciler) ensureStagedRollout(ci *operatorv1.IngressController, staged *stagedRollout, current, desired *appsv1.Deployment) (bool, *appsv1.Deployment, error) {
+	currentHash := current.Spec.Template.Labels[controller.ControllerDeploymentHashLabel]
+	desiredHash := desired.Spec.Template.Labels[controller.ControllerDeploymentHashLabel]
+
+	// Changes outside the pod template, such as to the replica count, do
+	// not roll out new pods and so are applied without verification.
+	held := desired.DeepCopy()
+	held.Spec.Template = *current.Spec.Template.DeepCopy()
+
+	switch {
+	case currentHash == desiredHash:
+		// Nothing to verify.  Abandon any rollout that is in progress,
+		// for example because the change was reverted.
+		if err := r.abandonStagedRollout(ci, current); err != nil {
+			return true, current, err
+		}
+		return r.applyRouterDeployment(ci, current, desired, nil)
+	case current.Annotations[stagedRolloutRejectedHashAnnotation] == desiredHash:
+		// The change already failed verification.
+		return r.applyRouterDeployment(ci, current, held, nil)
+	case current.Annotations[stagedRolloutVerifiedHashAnnotation] == desiredHash:
+		// The change passed verification.
+		return r.promoteStagedRollout(ci, current, held, desired)
+	}
+
+	haveCanary, canary, err := r.currentStagedRolloutDeployment(ci)
+	if err != nil {
+		return true, current, err
+	}
+	if !haveCanary || canary.Spec.Template.Labels[controller.ControllerDeploymentHashLabel] != desiredHash {
+		if haveCanary {
+			if err := r.deleteStagedRolloutDeployment(canary); err != nil {
+				return true, current, err
+			}
+		}
+		if err := r.setStagedRolloutAnnotations(current, desiredHash, "", "", ""); err != nil {
+			return true, current, err
+		}
+		canary = desiredStagedRolloutDeployment(ci, staged, desired, current)
+		if err := r.client.Create(context.TODO(), canary); err != nil {
+			return true, current, fmt.Errorf("failed to create staged rollout deployment %s/%s: %w", canary.Namespace, canary.Name, err)
+		}
+		log.Info("created staged rollout deployment", "namespace", canary.Namespace, "name", canary.Name, "hash", desiredHash)
+		r.recorder.Eventf(ci, "Normal", "StagedRolloutStarted", "Verifying router pod template %s on %d canary replicas", desiredHash, staged.CanaryReplicas)
+		return r.applyRouterDeployment(ci, current, held, stagedRolloutInProgressError(desiredHash))
+	}
+
+	podList := &corev1.PodList{}
+	if err := r.client.List(context.TODO(), podList, crclient.InNamespace(canary.Namespace), crclient.MatchingLabels(canary.Spec.Selector.MatchLabels)); err != nil {
+		return true, current, fmt.Errorf("failed to list pods for staged rollout deployment %s/%s: %w", canary.Namespace, canary.Name, err)
+	}
+	checkHealth := func(pod *corev1.Pod) (string, error) {
+		if failure, err := r.checkRouterPodHealth(ci, pod); len(failure) != 0 || err != nil {
+			return failure, err
+		}
+		// A pod template change can leave the router healthy but
+		// unable to route, so also send a request through the canary
+		// pod before promoting the change.
+		return r.probeRouterPod(ci, pod)
+	}
+	verified, failure := verifyStagedRollout(staged, canary, podList.Items, checkHealth, clock.Now())
+	switch {
+	case len(failure) != 0:
+		if err := r.deleteStagedRolloutDeployment(canary); err != nil {
+			return true, current, err
+		}
+		if err := r.setStagedRolloutAnnotations(current, "", desiredHash, failure, ""); err != nil {
+			return true, current, err
+		}
+		log.Info("staged rollout failed verification", "namespace", current.Namespace, "name", current.Name, "hash", desiredHash, "reason", failure)
+		r.recorder.Eventf(ci, "Warning", "StagedRolloutFailed", "Router pod template %s failed verification and was not rolled out: %s", desiredHash, failure)
+		return r.applyRouterDeployment(ci, current, held, nil)
+	case verified:
+		if err := r.setStagedRolloutAnnotations(current, "", "", "", desiredHash); err != nil {
+			return true, current, err
+		}
+		log.Info("staged rollout passed verification", "namespace", current.Namespace, "name", current.Name, "hash", desiredHash)
+		return r.promoteStagedRollout(ci, current, held, desired)
+	}
+	return r.applyRouterDeployment(ci, current, held, stagedRolloutInProgressError(desiredHash))
+}
+
+// promoteStagedRollout deletes the canary deployment of a staged rollout that
+// passed verification and, once the canary pods have terminated, updates the
+// given current router deployment to the given desired one.  Until then, the
+// given held deployment, which has the current pod template, is applied and a
+// retryable error is returned.  Canary pods have the pod template hash of the
+// desired router pods, so the router pods' anti-affinity rules would keep new
+// router pods off the canary pods' nodes.
+func (r *reconciler) promoteStagedRollout(ci *operatorv1.IngressController, current, held, desired *appsv1.Deployment) (bool, *appsv1.Deployment, error) {
+	desiredHash := desired.Spec.Template.Labels[controller.ControllerDeploymentHashLabel]
+	haveCanary, canary, err := r.currentStagedRolloutDeployment(ci)
+	if err != nil {
+		return true, current, err
+	}
+	if haveCanary {
+		if err := r.deleteStagedRolloutDeployment(canary); err != nil {
+			return true, current, err
+		}
+	}
+	podList := &corev1.PodList{}
+	if err := r.client.List(context.TODO(), podList, crclient.InNamespace(current.Namespace), crclient.MatchingLabels{stagedRolloutCanaryLabel: ci.Name}); err != nil {
+		return true, current, fmt.Errorf("failed to list staged rollout pods: %w", err)
+	}
+	if len(podList.Items) != 0 {
+		return r.applyRouterDeployment(ci, current, held, retryable.New(fmt.Errorf("waiting for %d staged rollout pods to terminate before promoting router pod template %s", len(podList.Items), desiredHash), stagedRolloutPollInterval))
+	}
+
+	promoted := current.DeepCopy()
+	delete(promoted.Annotations, stagedRolloutCandidateHashAnnotation)
+	delete(promoted.Annotations, stagedRolloutRejectedHashAnnotation)
+	delete(promoted.Annotations, stagedRolloutFailureMessageAnnotation)
+	delete(promoted.Annotations, stagedRolloutVerifiedHashAnnotation)
+	haveDepl, promoted, err := r.applyRouterDeployment(ci, promoted, desired, nil)
+	if err != nil {
+		return haveDepl, promoted, err
+	}
+	log.Info("promoted staged rollout", "namespace", current.Namespace, "name", current.Name, "hash", desiredHash)
+	r.recorder.Eventf(ci, "Normal", "StagedRolloutPromoted", "Router pod template %s passed verification and is rolling out", desiredHash)
+	return haveDepl, promoted, nil
+}
+
+// stagedRolloutInProgressError returns a retryable error that causes the
+// ingresscontroller to be reconciled again while the given pod template hash
+// is being verified.
+func stagedRolloutInProgressError(hash string) error {
+	return retryable.New(fmt.Errorf("staged rollout of router pod template %s is being verified", hash), stagedRolloutPollInterval)
+}
+
+// applyRouterDeployment updates the given current router deployment to the
+// given desired one and returns the resulting deployment along with the given
+// error.
+func (r *reconciler) applyRouterDeployment(ci *operatorv1.IngressController, current, desired *appsv1.Deployment, result error) (bool, *appsv1.Deployment, error) {
+	if updated, err := r.updateRouterDeployment(current, desired); err != nil {
+		return true, current, err
+	} else if updated {
+		haveDepl, current, err := r.currentRouterDeployment(ci)
+		if err != nil {
+			return haveDepl, current, err
+		}
+		return haveDepl, current, result
+	}
+	return true, current, result
+}
+
+// abandonStagedRollout deletes the canary deployment of a staged rollout that
+// the given router deployment records as being in progress, and clears the
+// records of any staged rollout from the router deployment.
+func (r *reconciler) abandonStagedRollout(ci *operatorv1.IngressController, current *appsv1.Deployment) error {
+	if len(current.Annotations[stagedRolloutCandidateHashAnnotation]) != 0 {
+		haveCanary, canary, err := r.currentStagedRolloutDeployment(ci)
+		if err != nil {
+			return err
+		}
+		if haveCanary {
+			if err := r.deleteStagedRolloutDeployment(canary); err != nil {
+				return err
+			}
+		}
+	}
+	return r.setStagedRolloutAnnotations(current, "", "", "", "")
+}
+
+// setStagedRolloutAnnotations sets the annotations that record the state of a
+// staged rollout on the given router deployment, removing any annotation with
+// an empty value, and updates the deployment if they changed.
+func (r *reconciler) setStagedRolloutAnnotations(deployment *appsv1.Deployment, candidateHash, rejectedHash, failureMessage, verifiedHash string) error {
+	values := map[string]string{
+		stagedRolloutCandidateHashAnnotation:  candidateHash,
+		stagedRolloutRejectedHashAnnotation:   rejectedHash,
+		stagedRolloutFailureMessageAnnotation: failureMessage,
+		stagedRolloutVerifiedHashAnnotation:   verifiedHash,
+	}
+	changed := false
+	for key, value := range values {
+		if deployment.Annotations[key] == value {
+			continue
+		}
+		changed = true
+		if len(value) == 0 {
+			delete(deployment.Annotations, key)
+			continue
+		}
+		if deployment.Annotations == nil {
+			deployment.Annotations = map[string]string{}
+		}
+		deployment.Annotations[key] = value
+	}
+	if !changed {
+		return nil
+	}
+	if err := r.client.Update(context.TODO(), deployment); err != nil {
+		return fmt.Errorf("failed to update router deployment %s/%s: %w", deployment.Namespace, deployment.Name, err)
+	}
+	return nil
+}
+
+// desiredStagedRolloutDeployment returns the canary deployment for verifying
+// the given desired router deployment.  The canary pods have the staged
+// rollout canary label in place of the router pods' deployment label, so they
+// do not receive traffic through the router's services and do not count
+// toward the router's pod disruption budget or horizontal pod autoscaler.  The
+// canary pods keep away from each other but have no affinity rules relative to
+// the router pods.
+func desiredStagedRolloutDeployment(ci *operatorv1.IngressController, staged *stagedRollout, desired, current *appsv1.Deployment) *appsv1.Deployment {
+	canary := desired.DeepCopy()
+	name := controller.RouterStagedRolloutDeploymentName(ci, desired.Namespace)
+	canary.Name = name.Name
+	canary.Namespace = name.Namespace
+	canary.Annotations = nil
+	replicas := staged.CanaryReplicas
+	canary.Spec.Replicas = &replicas
+	labels := map[string]string{
+		stagedRolloutCanaryLabel:                 ci.Name,
+		controller.ControllerDeploymentHashLabel: desired.Spec.Template.Labels[controller.ControllerDeploymentHashLabel],
+	}
+	canary.Spec.Selector = &metav1.LabelSelector{MatchLabels: labels}
+	canary.Spec.Template.Labels = map[string]string{}
+	for k, v := range labels {
+		canary.Spec.Template.Labels[k] = v
+	}
+	canary.Spec.Template.Spec.Affinity = &corev1.Affinity{
+		PodAntiAffinity: &corev1.PodAntiAffinity{
+			PreferredDuringSchedulingIgnoredDuringExecution: []corev1.WeightedPodAffinityTerm{{
+				Weight: int32(100),
+				PodAffinityTerm: corev1.PodAffinityTerm{
+					TopologyKey:   "kubernetes.io/hostname",
+					LabelSelector: &metav1.LabelSelector{MatchLabels: labels},
+				},
+			}},
+		},
+	}
+	canary.Spec.Template.Spec.TopologySpreadConstraints = nil
+	gracePeriod := stagedRolloutCanaryTerminationGracePeriodSeconds
+	canary.Spec.Template.Spec.TerminationGracePeriodSeconds = &gracePeriod
+	trueVar := true
+	canary.OwnerReferences = []metav1.OwnerReference{{
+		APIVersion: "apps/v1",
+		Kind:       "Deployment",
+		Name:       current.Name,
+		UID:        current.UID,
+		Controller: &trueVar,
+	}}
+	return canary
+}
+
+// verifyStagedRollout checks the given canary deployment and its pods and
+// returns a Boolean value indicating whether the staged rollout has passed
+// verification, or a message describing why it failed.  Verification passes
+// once every canary replica has passed the router's readiness probe
+// continuously for the verification period and then passes the given health
+// check, which queries the router's health and metrics endpoints and sends a
+// request for the canary route through the canary replica.
+// Verification fails if a canary container restarts or cannot start, if the
+// health check reports a failure, or if the canary replicas do not pass
+// verification within the progress deadline.
+func verifyStagedRollout(staged *stagedRollout, canary *appsv1.Deployment, pods []corev1.Pod, checkHealth func(*corev1.Pod) (string, error), now time.Time) (bool, string) {
+	created := canary.CreationTimestamp.Time
+	verification := time.Duration(staged.VerificationSeconds) * time.Second
+	deadline := created.Add(verification + stagedRolloutProgressDeadline)
+	var readySince time.Time
+	var readyPods []*corev1.Pod
+	for i := range pods {
+		pod := &pods[i]
+		if pod.DeletionTimestamp != nil {
+			continue
+		}
+		for _, status := range pod.Status.ContainerStatuses {
+			if status.RestartCount > 0 {
+				return false, fmt.Sprintf("Container %s in pod %s restarted %d times.", status.Name, pod.Name, status.RestartCount)
+			}
+			if waiting := status.State.Waiting; waiting != nil {
+				if _, ok := stagedRolloutFailureReasons[waiting.Reason]; ok {
+					return false, fmt.Sprintf("Container %s in pod %s is waiting: %s: %s", status.Name, pod.Name, waiting.Reason, strings.TrimSpace(waiting.Message))
+				}
+			}
+		}
+		for _, cond := range pod.Status.Conditions {
+			if cond.Type != corev1.PodReady || cond.Status != corev1.ConditionTrue {
+				continue
+			}
+			readyPods = append(readyPods, pod)
+			if cond.LastTransitionTime.Time.After(readySince) {
+				readySince = cond.LastTransitionTime.Time
+			}
+		}
+	}
+	ready := int32(len(readyPods))
+	if ready < staged.CanaryReplicas || now.Before(readySince.Add(verification)) {
+		if now.After(deadline) {
+			return false, fmt.Sprintf("%d of %d canary replicas did not become ready and stay ready for %s within %s.", staged.CanaryReplicas-ready, staged.CanaryReplicas, verification, verification+stagedRolloutProgressDeadline)
+		}
+		return false, ""
+	}
+
+	for _, pod := range readyPods {
+		failure, err := checkHealth(pod)
+		switch {
+		case len(failure) != 0:
+			return false, failure
+		case err != nil && now.After(deadline):
+			return false, fmt.Sprintf("Failed to check the health of canary pod %s within %s: %v", pod.Name, verification+stagedRolloutProgressDeadline, err)
+		case err != nil:
+			log.Info("failed to check the health of staged rollout pod", "namespace", pod.Namespace, "name", pod.Name, "error", err)
+			return false, ""
+		}
+	}
+	return true, ""
+}
+
+// currentStagedRolloutDeployment returns the current staged rollout canary
+// deployment.
+func (r *reconciler) currentStagedRolloutDeployment(ci *operatorv1.IngressController) (bool, *appsv1.Deployment, error) {
+	deployment := &appsv1.Deployment{}
+	if err := r.client.Get(context.TODO(), controller.RouterStagedRolloutDeploymentName(ci, r.config.OperandNamespace), deployment); err != nil {
+		if errors.IsNotFound(err) {
+			return false, nil, nil
+		}
+		return false, nil, fmt.Errorf("failed to get staged rollout deployment: %w", err)
+	}
+	return true, deployment, nil
+}
+
+// deleteStagedRolloutDeployment deletes the given staged rollout canary
+// deployment.
+func (r *reconciler) deleteStagedRolloutDeployment(deployment *appsv1.Deployment) error {
+	if err := r.client.Delete(context.TODO(), deployment); err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete staged rollout deployment %s/%s: %w", deployment.Namespace, deployment.Name, err)
+	}
+	log.Info("deleted staged rollout deployment", "namespace", deployment.Namespace, "name", deployment.Name)
+	return nil
+}
+
+// computeStagedRolloutCondition computes the ingress controller's current
+// StagedRolloutVerified condition from the state that the operator records on
+// the router deployment.
+func computeStagedRolloutCondition(ic *operatorv1.IngressController, deployment *appsv1.Deployment) operatorv1.OperatorCondition {
+	staged, err := stagedRolloutForIngressController(ic)
+	switch {
+	case err != nil:
+		return operatorv1.OperatorCondition{
+			Type:    IngressControllerStagedRolloutVerifiedConditionType,
+			Status:  operatorv1.ConditionFalse,
+			Reason:  "InvalidConfiguration",
+			Message: fmt.Sprintf("The staged rollout configuration is invalid: %v", err),
+		}
+	case staged == nil:
+		return operatorv1.OperatorCondition{
+			Type:    IngressControllerStagedRolloutVerifiedConditionType,
+			Status:  operatorv1.ConditionTrue,
+			Reason:  "NotEnabled",
+			Message: "Staged rollout is not enabled.",
+		}
+	}
+	if hash := deployment.Annotations[stagedRolloutCandidateHashAnnotation]; len(hash) != 0 {
+		return operatorv1.OperatorCondition{
+			Type:    IngressControllerStagedRolloutVerifiedConditionType,
+			Status:  operatorv1.ConditionUnknown,
+			Reason:  "Verifying",
+			Message: fmt.Sprintf("Router pod template %s is being verified on %d canary replicas.", hash, staged.CanaryReplicas),
+		}
+	}
+	if hash := deployment.Annotations[stagedRolloutVerifiedHashAnnotation]; len(hash) != 0 {
+		return operatorv1.OperatorCondition{
+			Type:    IngressControllerStagedRolloutVerifiedConditionType,
+			Status:  operatorv1.ConditionTrue,
+			Reason:  "Promoting",
+			Message: fmt.Sprintf("Router pod template %s passed verification and will roll out once the canary pods have terminated.", hash),
+		}
+	}
+	if hash := deployment.Annotations[stagedRolloutRejectedHashAnnotation]; len(hash) != 0 {
+		return operatorv1.OperatorCondition{
+			Type:    IngressControllerStagedRolloutVerifiedConditionType,
+			Status:  operatorv1.ConditionFalse,
+			Reason:  "VerificationFailed",
+			Message: fmt.Sprintf("Router pod template %s failed verification and was not rolled out: %s", hash, deployment.Annotations[stagedRolloutFailureMessageAnnotation]),
+		}
+	}
+	return operatorv1.OperatorCondition{
+		Type:    IngressControllerStagedRolloutVerifiedConditionType,
+		Status:  operatorv1.ConditionTrue,
+		Reason:  "Verified",
+		Message: "The router pod template has passed verification.",
+	}
+}
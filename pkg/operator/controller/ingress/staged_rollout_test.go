@@ -0,0 +1,448 @@
+package ingress
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	routev1 "github.com/openshift/api/route/v1"
+
+	"github.com/openshift/cluster-ingress-operator/pkg/operator/controller"
+	retryable "github.com/openshift/cluster-ingress-operator/pkg/util/retryableerror"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"k8s.io/client-go/tools/record"
+
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// TestStagedRolloutForIngressController verifies that
+// stagedRolloutForIngressController parses and defaults valid staged rollout
+// parameters and rejects invalid ones.
+func TestStagedRolloutForIngressController(t *testing.T) {
+	testCases := []struct {
+		description string
+		overrides   string
+		strategy    operatorv1.EndpointPublishingStrategyType
+		expect      *stagedRollout
+		expectError bool
+	}{
+		{
+			description: "no overrides",
+		},
+		{
+			description: "disabled",
+			overrides:   `{"stagedRollout":{"enabled":false,"canaryReplicas":100}}`,
+		},
+		{
+			description: "defaults",
+			overrides:   `{"stagedRollout":{"enabled":true}}`,
+			expect:      &stagedRollout{Enabled: true, CanaryReplicas: 1, VerificationSeconds: 120},
+		},
+		{
+			description: "custom parameters",
+			overrides:   `{"stagedRollout":{"enabled":true,"canaryReplicas":2,"verificationSeconds":600}}`,
+			expect:      &stagedRollout{Enabled: true, CanaryReplicas: 2, VerificationSeconds: 600},
+		},
+		{
+			description: "too many canary replicas",
+			overrides:   `{"stagedRollout":{"enabled":true,"canaryReplicas":11}}`,
+			expectError: true,
+		},
+		{
+			description: "negative canary replicas",
+			overrides:   `{"stagedRollout":{"enabled":true,"canaryReplicas":-1}}`,
+			expectError: true,
+		},
+		{
+			description: "verification period too short",
+			overrides:   `{"stagedRollout":{"enabled":true,"verificationSeconds":5}}`,
+			expectError: true,
+		},
+		{
+			description: "host network",
+			overrides:   `{"stagedRollout":{"enabled":true}}`,
+			strategy:    operatorv1.HostNetworkStrategyType,
+			expectError: true,
+		},
+		{
+			description: "malformed overrides",
+			overrides:   `{"stagedRollout":{"enabled":"yes"}}`,
+			expectError: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			ic := &operatorv1.IngressController{
+				ObjectMeta: metav1.ObjectMeta{Name: "default"},
+			}
+			if len(tc.overrides) != 0 {
+				ic.Spec.UnsupportedConfigOverrides = runtime.RawExtension{Raw: []byte(tc.overrides)}
+			}
+			if len(tc.strategy) != 0 {
+				ic.Status.EndpointPublishingStrategy = &operatorv1.EndpointPublishingStrategy{Type: tc.strategy}
+			}
+			actual, err := stagedRolloutForIngressController(ic)
+			switch {
+			case tc.expectError && err == nil:
+				t.Fatalf("expected error, got %+v", actual)
+			case !tc.expectError && err != nil:
+				t.Fatalf("unexpected error: %v", err)
+			case !tc.expectError && !reflect.DeepEqual(tc.expect, actual):
+				t.Errorf("expected %+v, got %+v", tc.expect, actual)
+			}
+		})
+	}
+}
+
+// TestVerifyStagedRollout verifies that verifyStagedRollout passes a canary
+// whose replicas have been ready for the verification period and are healthy
+// and fails one whose containers restart or cannot start, whose replicas fail
+// the health check, or that exceeds the progress deadline.
+func TestVerifyStagedRollout(t *testing.T) {
+	now := time.Now()
+	staged := &stagedRollout{Enabled: true, CanaryReplicas: 2, VerificationSeconds: 120}
+	readyPod := func(name string, readyFor time.Duration) corev1.Pod {
+		return corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Status: corev1.PodStatus{
+				Conditions: []corev1.PodCondition{{
+					Type:               corev1.PodReady,
+					Status:             corev1.ConditionTrue,
+					LastTransitionTime: metav1.NewTime(now.Add(-readyFor)),
+				}},
+				ContainerStatuses: []corev1.ContainerStatus{{Name: "router"}},
+			},
+		}
+	}
+	restartedPod := readyPod("restarted", 5*time.Minute)
+	restartedPod.Status.ContainerStatuses[0].RestartCount = 1
+	crashingPod := corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "crashing"},
+		Status: corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{{
+				Name: "router",
+				State: corev1.ContainerState{
+					Waiting: &corev1.ContainerStateWaiting{Reason: "ImagePullBackOff"},
+				},
+			}},
+		},
+	}
+	testCases := []struct {
+		description    string
+		created        time.Duration
+		healthFailure  string
+		healthError    error
+		pods           []corev1.Pod
+		expectVerified bool
+		expectFailure  bool
+	}{
+		{
+			description: "no pods yet",
+			created:     time.Minute,
+		},
+		{
+			description: "not ready for long enough",
+			created:     5 * time.Minute,
+			pods:        []corev1.Pod{readyPod("a", 5*time.Minute), readyPod("b", time.Minute)},
+		},
+		{
+			description:    "ready for the verification period",
+			created:        5 * time.Minute,
+			pods:           []corev1.Pod{readyPod("a", 5*time.Minute), readyPod("b", 3*time.Minute)},
+			expectVerified: true,
+		},
+		{
+			description:   "container restarted",
+			created:       5 * time.Minute,
+			pods:          []corev1.Pod{readyPod("a", 5*time.Minute), restartedPod},
+			expectFailure: true,
+		},
+		{
+			description:   "image pull failure",
+			created:       time.Minute,
+			pods:          []corev1.Pod{crashingPod},
+			expectFailure: true,
+		},
+		{
+			description:   "health check failed",
+			created:       5 * time.Minute,
+			healthFailure: "Canary pod a reported that HAProxy failed to reload.",
+			pods:          []corev1.Pod{readyPod("a", 5*time.Minute), readyPod("b", 3*time.Minute)},
+			expectFailure: true,
+		},
+		{
+			description: "health check error",
+			created:     5 * time.Minute,
+			healthError: fmt.Errorf("connection refused"),
+			pods:        []corev1.Pod{readyPod("a", 5*time.Minute), readyPod("b", 3*time.Minute)},
+		},
+		{
+			description:   "health check error after the progress deadline",
+			created:       time.Hour,
+			healthError:   fmt.Errorf("connection refused"),
+			pods:          []corev1.Pod{readyPod("a", 5*time.Minute), readyPod("b", 3*time.Minute)},
+			expectFailure: true,
+		},
+		{
+			description:   "progress deadline exceeded",
+			created:       time.Hour,
+			pods:          []corev1.Pod{readyPod("a", 5*time.Minute)},
+			expectFailure: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			canary := &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.NewTime(now.Add(-tc.created))},
+			}
+			checkHealth := func(*corev1.Pod) (string, error) {
+				return tc.healthFailure, tc.healthError
+			}
+			verified, failure := verifyStagedRollout(staged, canary, tc.pods, checkHealth, now)
+			if verified != tc.expectVerified {
+				t.Errorf("expected verified %t, got %t", tc.expectVerified, verified)
+			}
+			if tc.expectFailure != (len(failure) != 0) {
+				t.Errorf("expected failure %t, got %q", tc.expectFailure, failure)
+			}
+		})
+	}
+}
+
+// TestEnsureStagedRollout verifies that ensureStagedRollout holds a pod
+// template change until canary replicas verify it, promotes a verified change
+// once the canary pods have terminated, and rejects a change that fails
+// verification.
+func TestEnsureStagedRollout(t *testing.T) {
+	ic, ingressConfig, infraConfig, apiConfig, networkConfig, proxyNeeded := getRouterDeploymentComponents(t)
+	ic.Spec.UnsupportedConfigOverrides = runtime.RawExtension{Raw: []byte(`{"stagedRollout":{"enabled":true,"verificationSeconds":60}}`)}
+	staged, err := stagedRolloutForIngressController(ic)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	current, err := desiredRouterDeployment(ic, "openshift-ingress", ingressControllerImage, "", "", ingressConfig, infraConfig, apiConfig, networkConfig, proxyNeeded, false, nil)
+	if err != nil {
+		t.Fatalf("invalid router Deployment: %v", err)
+	}
+
+	// unroutable is the set of canary pod addresses that fail the canary
+	// route probe.
+	unroutable := map[string]bool{}
+	realProbeCanaryRoute := probeCanaryRoute
+	probeCanaryRoute = func(route *routev1.Route, address string) (string, error) {
+		if unroutable[address] {
+			return "The canary route responded with HTTP status 503.", nil
+		}
+		return "", nil
+	}
+	defer func() {
+		probeCanaryRoute = realProbeCanaryRoute
+	}()
+	canaryRoute := &routev1.Route{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "openshift-ingress-canary", Name: "canary"},
+		Spec:       routev1.RouteSpec{Host: "canary-openshift-ingress-canary.apps.example.com"},
+		Status: routev1.RouteStatus{
+			Ingress: []routev1.RouteIngress{{
+				RouterName: ic.Name,
+				Conditions: []routev1.RouteIngressCondition{{Type: routev1.RouteAdmitted, Status: corev1.ConditionTrue}},
+			}},
+		},
+	}
+
+	scheme := runtime.NewScheme()
+	appsv1.AddToScheme(scheme)
+	corev1.AddToScheme(scheme)
+	operatorv1.AddToScheme(scheme)
+	routev1.AddToScheme(scheme)
+	cl := fake.NewClientBuilder().WithScheme(scheme).WithObjects(current, canaryRoute).Build()
+	r := &reconciler{
+		config:   Config{OperandNamespace: "openshift-ingress", CanaryNamespace: "openshift-ingress-canary"},
+		client:   cl,
+		recorder: record.NewFakeRecorder(10),
+		checkRouterPodHealth: func(*operatorv1.IngressController, *corev1.Pod) (string, error) {
+			return "", nil
+		},
+	}
+
+	newDesired := func(image string) *appsv1.Deployment {
+		t.Helper()
+		desired, err := desiredRouterDeployment(ic, "openshift-ingress", image, "", "", ingressConfig, infraConfig, apiConfig, networkConfig, proxyNeeded, false, nil)
+		if err != nil {
+			t.Fatalf("invalid router Deployment: %v", err)
+		}
+		return desired
+	}
+	ensure := func(desired *appsv1.Deployment, expectInProgress bool) *appsv1.Deployment {
+		t.Helper()
+		_, current, err := r.currentRouterDeployment(ic)
+		if err != nil {
+			t.Fatalf("failed to get router deployment: %v", err)
+		}
+		_, updated, err := r.ensureStagedRollout(ic, staged, current, desired)
+		if _, ok := err.(retryable.Error); err != nil && !ok {
+			t.Fatalf("unexpected error: %v", err)
+		} else if expectInProgress != ok {
+			t.Fatalf("expected in progress %t, got error %v", expectInProgress, err)
+		}
+		return updated
+	}
+	getCanary := func() *appsv1.Deployment {
+		t.Helper()
+		canary := &appsv1.Deployment{}
+		if err := cl.Get(context.TODO(), controller.RouterStagedRolloutDeploymentName(ic, "openshift-ingress"), canary); err != nil {
+			if errors.IsNotFound(err) {
+				return nil
+			}
+			t.Fatalf("failed to get staged rollout deployment: %v", err)
+		}
+		return canary
+	}
+	addCanaryPod := func(name string, canary *appsv1.Deployment, status corev1.PodStatus) {
+		t.Helper()
+		// The fake client does not set the creation timestamp.
+		canary.CreationTimestamp = metav1.NewTime(time.Now().Add(-5 * time.Minute))
+		if err := cl.Update(context.TODO(), canary); err != nil {
+			t.Fatalf("failed to update staged rollout deployment: %v", err)
+		}
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: canary.Namespace,
+				Labels:    canary.Spec.Template.Labels,
+			},
+			Status: status,
+		}
+		if err := cl.Create(context.TODO(), pod); err != nil {
+			t.Fatalf("failed to create pod: %v", err)
+		}
+	}
+	originalImage := current.Spec.Template.Spec.Containers[0].Image
+
+	// A pod template change is held while canary replicas verify it.
+	good := newDesired("quay.io/openshift/router:good")
+	updated := ensure(good, true)
+	if image := updated.Spec.Template.Spec.Containers[0].Image; image != originalImage {
+		t.Fatalf("expected router deployment to keep image %s, got %s", originalImage, image)
+	}
+	canary := getCanary()
+	if canary == nil {
+		t.Fatal("expected staged rollout deployment")
+	}
+	if image := canary.Spec.Template.Spec.Containers[0].Image; image != "quay.io/openshift/router:good" {
+		t.Fatalf("expected staged rollout deployment to have the new image, got %s", image)
+	}
+	if canary.Spec.Selector.MatchLabels[stagedRolloutCanaryLabel] != ic.Name || *canary.Spec.Replicas != 1 {
+		t.Fatalf("unexpected staged rollout deployment spec: %+v", canary.Spec)
+	}
+	// Canary pods must not match the router deployment's selector, which
+	// the router's services and pod disruption budget also use.
+	routerSelector, err := metav1.LabelSelectorAsSelector(current.Spec.Selector)
+	if err != nil {
+		t.Fatalf("invalid router deployment selector: %v", err)
+	}
+	if routerSelector.Matches(labels.Set(canary.Spec.Template.Labels)) {
+		t.Fatalf("expected router deployment selector %s not to match staged rollout pod labels %v", routerSelector, canary.Spec.Template.Labels)
+	}
+	if cond := computeStagedRolloutCondition(ic, updated); cond.Status != operatorv1.ConditionUnknown {
+		t.Errorf("expected condition status %s, got %+v", operatorv1.ConditionUnknown, cond)
+	}
+
+	// Once the canary replicas are verified, the canary deployment is
+	// deleted, and the change is held until the canary pods terminate.
+	addCanaryPod("good", canary, corev1.PodStatus{
+		PodIP: "10.128.0.8",
+		Conditions: []corev1.PodCondition{{
+			Type:               corev1.PodReady,
+			Status:             corev1.ConditionTrue,
+			LastTransitionTime: metav1.NewTime(time.Now().Add(-2 * time.Minute)),
+		}},
+	})
+	updated = ensure(good, true)
+	if image := updated.Spec.Template.Spec.Containers[0].Image; image != originalImage {
+		t.Fatalf("expected router deployment to keep image %s, got %s", originalImage, image)
+	}
+	if getCanary() != nil {
+		t.Fatal("expected staged rollout deployment to be deleted")
+	}
+	if cond := computeStagedRolloutCondition(ic, updated); cond.Status != operatorv1.ConditionTrue || cond.Reason != "Promoting" {
+		t.Errorf("expected condition status %s with reason Promoting, got %+v", operatorv1.ConditionTrue, cond)
+	}
+
+	// Once the canary pods have terminated, the change is promoted.  The
+	// fake client does not garbage-collect the canary pods.
+	if err := cl.Delete(context.TODO(), &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: canary.Namespace, Name: "good"}}); err != nil {
+		t.Fatalf("failed to delete pod: %v", err)
+	}
+	updated = ensure(good, false)
+	if image := updated.Spec.Template.Spec.Containers[0].Image; image != "quay.io/openshift/router:good" {
+		t.Fatalf("expected router deployment to have the new image, got %s", image)
+	}
+	if getCanary() != nil {
+		t.Fatal("expected staged rollout deployment to be deleted")
+	}
+	if cond := computeStagedRolloutCondition(ic, updated); cond.Status != operatorv1.ConditionTrue {
+		t.Errorf("expected condition status %s, got %+v", operatorv1.ConditionTrue, cond)
+	}
+
+	// A change that fails verification is rejected and not retried.
+	bad := newDesired("quay.io/openshift/router:bad")
+	ensure(bad, true)
+	addCanaryPod("bad", getCanary(), corev1.PodStatus{
+		ContainerStatuses: []corev1.ContainerStatus{{
+			Name:  "router",
+			State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ErrImagePull"}},
+		}},
+	})
+	updated = ensure(bad, false)
+	if image := updated.Spec.Template.Spec.Containers[0].Image; image != "quay.io/openshift/router:good" {
+		t.Fatalf("expected router deployment to keep image quay.io/openshift/router:good, got %s", image)
+	}
+	if getCanary() != nil {
+		t.Fatal("expected staged rollout deployment to be deleted")
+	}
+	if cond := computeStagedRolloutCondition(ic, updated); cond.Status != operatorv1.ConditionFalse || cond.Reason != "VerificationFailed" {
+		t.Errorf("expected condition status %s with reason VerificationFailed, got %+v", operatorv1.ConditionFalse, cond)
+	}
+	ensure(bad, false)
+	if getCanary() != nil {
+		t.Fatal("expected rejected change not to be retried")
+	}
+
+	// A change with which the router is healthy but does not route the
+	// canary route is rejected.
+	unroutable["10.128.0.9:443"] = true
+	unroutableChange := newDesired("quay.io/openshift/router:unroutable")
+	ensure(unroutableChange, true)
+	addCanaryPod("unroutable", getCanary(), corev1.PodStatus{
+		PodIP: "10.128.0.9",
+		Conditions: []corev1.PodCondition{{
+			Type:               corev1.PodReady,
+			Status:             corev1.ConditionTrue,
+			LastTransitionTime: metav1.NewTime(time.Now().Add(-2 * time.Minute)),
+		}},
+	})
+	updated = ensure(unroutableChange, false)
+	if image := updated.Spec.Template.Spec.Containers[0].Image; image != "quay.io/openshift/router:good" {
+		t.Fatalf("expected router deployment to keep image quay.io/openshift/router:good, got %s", image)
+	}
+	if cond := computeStagedRolloutCondition(ic, updated); cond.Status != operatorv1.ConditionFalse || cond.Reason != "VerificationFailed" {
+		t.Errorf("expected condition status %s with reason VerificationFailed, got %+v", operatorv1.ConditionFalse, cond)
+	}
+
+	// Reverting the change clears the failure.
+	updated = ensure(good, false)
+	if cond := computeStagedRolloutCondition(ic, updated); cond.Status != operatorv1.ConditionTrue {
+		t.Errorf("expected condition status %s, got %+v", operatorv1.ConditionTrue, cond)
+	}
+}
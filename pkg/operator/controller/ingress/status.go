@@ -14,6 +14,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 
+	"github.com/openshift/cluster-ingress-operator/pkg/dns"
 	"github.com/openshift/cluster-ingress-operator/pkg/manifests"
 	"github.com/openshift/cluster-ingress-operator/pkg/operator/controller"
 	"github.com/openshift/cluster-ingress-operator/pkg/util/retryableerror"
@@ -32,6 +33,7 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
 	utilclock "k8s.io/utils/clock"
 )
 
@@ -51,12 +53,8 @@ type expectedCondition struct {
 
 // syncIngressControllerStatus computes the current status of ic and
 // updates status upon any changes since last sync.
-func (r *reconciler) syncIngressControllerStatus(ic *operatorv1.IngressController, deployment *appsv1.Deployment, deploymentRef metav1.OwnerReference, pods []corev1.Pod, service *corev1.Service, operandEvents []corev1.Event, wildcardRecord *iov1.DNSRecord, dnsConfig *configv1.DNS, platformStatus *configv1.PlatformStatus) (error, bool) {
+func (r *reconciler) syncIngressControllerStatus(ic *operatorv1.IngressController, deployment *appsv1.Deployment, deploymentRef metav1.OwnerReference, pods []corev1.Pod, nodes []corev1.Node, service *corev1.Service, operandEvents []corev1.Event, wildcardRecord *iov1.DNSRecord, dnsConfig *configv1.DNS, platformStatus *configv1.PlatformStatus, errorPagesConfigmap *corev1.ConfigMap) (error, bool) {
 	updatedIc := false
-	selector, err := metav1.LabelSelectorAsSelector(deployment.Spec.Selector)
-	if err != nil {
-		return fmt.Errorf("deployment has invalid spec.selector: %v", err), updatedIc
-	}
 
 	secret := &corev1.Secret{}
 	secretName := controller.RouterEffectiveDefaultCertificateSecretName(ic, deployment.Namespace)
@@ -64,7 +62,42 @@ func (r *reconciler) syncIngressControllerStatus(ic *operatorv1.IngressControlle
 		return fmt.Errorf("failed to get the default certificate secret %s for ingresscontroller %s/%s: %w", secretName, ic.Namespace, ic.Name, err), updatedIc
 	}
 
-	var errs []error
+	updated, err := ComputeIngressControllerStatus(ic, deployment, deploymentRef, pods, nodes, service, operandEvents, wildcardRecord, dnsConfig, platformStatus, secret, errorPagesConfigmap, r.config.ConfigNamespace)
+	if updated == nil {
+		return err, updatedIc
+	}
+
+	errs := []error{err}
+	if !IngressStatusesEqual(updated.Status, ic.Status) {
+		if err := r.client.Status().Update(context.TODO(), updated); err != nil {
+			errs = append(errs, fmt.Errorf("failed to update ingresscontroller status: %v", err))
+		} else {
+			updatedIc = true
+			SetIngressControllerConditionsMetric(updated)
+		}
+	}
+
+	return retryableerror.NewMaybeRetryableAggregate(errs), updatedIc
+}
+
+// ComputeIngressControllerStatus returns a copy of ic with its status computed
+// from the given router deployment and related objects.  The service, wildcard
+// record, default certificate secret, and error-page configmap may be nil if
+// they do not exist.  configNamespace is the namespace in which the
+// error-page configmap is expected.
+// ComputeIngressControllerStatus neither reads nor writes any objects, so it
+// can compute the status of an ingresscontroller offline from a snapshot of
+// these objects.  It returns a nil ingresscontroller if it cannot compute the
+// status, and an error that may be retryable, which the status controller uses
+// to requeue the ingresscontroller when a condition's grace period expires.
+func ComputeIngressControllerStatus(ic *operatorv1.IngressController, deployment *appsv1.Deployment, deploymentRef metav1.OwnerReference, pods []corev1.Pod, nodes []corev1.Node, service *corev1.Service, operandEvents []corev1.Event, wildcardRecord *iov1.DNSRecord, dnsConfig *configv1.DNS, platformStatus *configv1.PlatformStatus, secret *corev1.Secret, errorPagesConfigmap *corev1.ConfigMap, configNamespace string) (*operatorv1.IngressController, error) {
+	selector, err := metav1.LabelSelectorAsSelector(deployment.Spec.Selector)
+	if err != nil {
+		return nil, fmt.Errorf("deployment has invalid spec.selector: %v", err)
+	}
+	if secret == nil {
+		secret = &corev1.Secret{}
+	}
 
 	updated := ic.DeepCopy()
 	updated.Status.AvailableReplicas = deployment.Status.AvailableReplicas
@@ -80,12 +113,15 @@ func (r *reconciler) syncIngressControllerStatus(ic *operatorv1.IngressControlle
 	updated.Status.Conditions = MergeConditions(updated.Status.Conditions, computeDeploymentReplicasMinAvailableCondition(deployment))
 	updated.Status.Conditions = MergeConditions(updated.Status.Conditions, computeDeploymentReplicasAllAvailableCondition(deployment))
 	updated.Status.Conditions = MergeConditions(updated.Status.Conditions, computeDeploymentRollingOutCondition(deployment))
+	updated.Status.Conditions = MergeConditions(updated.Status.Conditions, computeReplicasSpreadAcrossZonesCondition(deployment, pods, nodes))
+	updated.Status.Conditions = MergeConditions(updated.Status.Conditions, computeStagedRolloutCondition(ic, deployment))
+	updated.Status.Conditions = MergeConditions(updated.Status.Conditions, computeRateLimitingCondition(ic))
+	updated.Status.Conditions = MergeConditions(updated.Status.Conditions, computeHttpErrorCodePagesValidCondition(ic, errorPagesConfigmap, configNamespace)...)
 	updated.Status.Conditions = MergeConditions(updated.Status.Conditions, computeLoadBalancerStatus(ic, service, operandEvents)...)
 	updated.Status.Conditions = MergeConditions(updated.Status.Conditions, computeLoadBalancerProgressingStatus(ic, service, platformStatus))
 	updated.Status.Conditions = MergeConditions(updated.Status.Conditions, computeDNSStatus(ic, wildcardRecord, platformStatus, dnsConfig)...)
 	updated.Status.Conditions = MergeConditions(updated.Status.Conditions, computeIngressAvailableCondition(updated.Status.Conditions))
 	degradedCondition, err := computeIngressDegradedCondition(updated.Status.Conditions, updated.Name)
-	errs = append(errs, err)
 	updated.Status.Conditions = MergeConditions(updated.Status.Conditions, computeIngressProgressingCondition(updated.Status.Conditions))
 	updated.Status.Conditions = MergeConditions(updated.Status.Conditions, degradedCondition)
 	updated.Status.Conditions = MergeConditions(updated.Status.Conditions, computeIngressUpgradeableCondition(ic, deploymentRef, service, platformStatus, secret))
@@ -93,16 +129,7 @@ func (r *reconciler) syncIngressControllerStatus(ic *operatorv1.IngressControlle
 
 	updated.Status.Conditions = PruneConditions(updated.Status.Conditions)
 
-	if !IngressStatusesEqual(updated.Status, ic.Status) {
-		if err := r.client.Status().Update(context.TODO(), updated); err != nil {
-			errs = append(errs, fmt.Errorf("failed to update ingresscontroller status: %v", err))
-		} else {
-			updatedIc = true
-			SetIngressControllerConditionsMetric(updated)
-		}
-	}
-
-	return retryableerror.NewMaybeRetryableAggregate(errs), updatedIc
+	return updated, err
 }
 
 // syncIngressControllerSelectorStatus syncs the routeSelector and namespaceSelector
@@ -268,6 +295,98 @@ func computeDeploymentPodsScheduledCondition(deployment *appsv1.Deployment, pods
 	}
 }
 
+// computeReplicasSpreadAcrossZonesCondition computes the ingress controller's
+// current ReplicasSpreadAcrossZones status condition state by comparing the
+// zones of the nodes to which the deployment's pods are scheduled with the
+// zones of the nodes to which they could be scheduled.  The condition is only
+// a warning; it does not affect the Available or Degraded conditions.  A nil
+// nodes slice means that the nodes are not known, in which case the condition
+// is Unknown.
+func computeReplicasSpreadAcrossZonesCondition(deployment *appsv1.Deployment, pods []corev1.Pod, nodes []corev1.Node) operatorv1.OperatorCondition {
+	if nodes == nil {
+		return operatorv1.OperatorCondition{
+			Type:    IngressControllerReplicasSpreadAcrossZonesConditionType,
+			Status:  operatorv1.ConditionUnknown,
+			Reason:  "NodesUnknown",
+			Message: "The operator was unable to list the cluster's nodes.",
+		}
+	}
+	clusterZones := nodeZones(nodes)
+	if clusterZones.Len() < 2 {
+		return operatorv1.OperatorCondition{
+			Type:    IngressControllerReplicasSpreadAcrossZonesConditionType,
+			Status:  operatorv1.ConditionTrue,
+			Reason:  "SingleZone",
+			Message: "The cluster does not have nodes in multiple zones.",
+		}
+	}
+
+	eligibleZones := nodeZones(eligibleNodes(nodes, &deployment.Spec.Template.Spec))
+	if eligibleZones.Len() < 2 {
+		return operatorv1.OperatorCondition{
+			Type:    IngressControllerReplicasSpreadAcrossZonesConditionType,
+			Status:  operatorv1.ConditionFalse,
+			Reason:  "NodePlacementSingleZone",
+			Message: fmt.Sprintf("Router pods can be scheduled to nodes in %d of the cluster's %d zones (%s). Adjust spec.nodePlacement to allow spreading replicas across zones.", eligibleZones.Len(), clusterZones.Len(), strings.Join(clusterZones.List(), ", ")),
+		}
+	}
+
+	replicas := int32(1)
+	if deployment.Spec.Replicas != nil {
+		replicas = *deployment.Spec.Replicas
+	}
+	if replicas < 2 {
+		return operatorv1.OperatorCondition{
+			Type:    IngressControllerReplicasSpreadAcrossZonesConditionType,
+			Status:  operatorv1.ConditionFalse,
+			Reason:  "InsufficientReplicas",
+			Message: fmt.Sprintf("The deployment has %d replica, so it cannot be spread across the %d zones in which router pods can be scheduled.", replicas, eligibleZones.Len()),
+		}
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(deployment.Spec.Selector)
+	if err != nil || selector.Empty() {
+		return operatorv1.OperatorCondition{
+			Type:    IngressControllerReplicasSpreadAcrossZonesConditionType,
+			Status:  operatorv1.ConditionUnknown,
+			Reason:  "InvalidLabelSelector",
+			Message: "Deployment has an invalid label selector.",
+		}
+	}
+	nodeZone := make(map[string]string, len(nodes))
+	for _, node := range nodes {
+		nodeZone[node.Name] = node.Labels[corev1.LabelTopologyZone]
+	}
+	podZones := sets.NewString()
+	for _, pod := range pods {
+		if !selector.Matches(labels.Set(pod.Labels)) || pod.DeletionTimestamp != nil {
+			continue
+		}
+		if zone := nodeZone[pod.Spec.NodeName]; len(zone) != 0 {
+			podZones.Insert(zone)
+		}
+	}
+
+	expectedZones := eligibleZones.Len()
+	if int(replicas) < expectedZones {
+		expectedZones = int(replicas)
+	}
+	if podZones.Len() < expectedZones {
+		return operatorv1.OperatorCondition{
+			Type:    IngressControllerReplicasSpreadAcrossZonesConditionType,
+			Status:  operatorv1.ConditionFalse,
+			Reason:  "ReplicasNotSpread",
+			Message: fmt.Sprintf("Router pods are scheduled in %d zones (%s), but %d replicas could be spread across %d zones.", podZones.Len(), strings.Join(podZones.List(), ", "), replicas, expectedZones),
+		}
+	}
+	return operatorv1.OperatorCondition{
+		Type:    IngressControllerReplicasSpreadAcrossZonesConditionType,
+		Status:  operatorv1.ConditionTrue,
+		Reason:  "ReplicasSpread",
+		Message: fmt.Sprintf("Router pods are scheduled in %d zones (%s).", podZones.Len(), strings.Join(podZones.List(), ", ")),
+	}
+}
+
 // computeIngressAvailableCondition computes the ingress controller's current Available status state
 // by inspecting the following:
 // 1) the Available condition of Deployment,
@@ -1019,6 +1138,7 @@ func computeDNSStatus(ic *operatorv1.IngressController, wildcardRecord *iov1.DNS
 		})
 	case len(wildcardRecord.Status.Zones) > 0:
 		var failedZones []configv1.DNSZone
+		var failedZoneMessages []string
 		var unknownZones []configv1.DNSZone
 		for _, zone := range wildcardRecord.Status.Zones {
 			for _, cond := range zone.Conditions {
@@ -1033,18 +1153,18 @@ func computeDNSStatus(ic *operatorv1.IngressController, wildcardRecord *iov1.DNS
 					// check to see if the zone is in the dnsConfig.Spec
 					// fix:BZ1942657 - relates to status changes when updating DNS PrivateZone config
 					failedZones = append(failedZones, zone.DNSZone)
+					failedZoneMessages = append(failedZoneMessages, failedZoneMessage(zone.DNSZone, cond))
 				case string(operatorv1.ConditionUnknown):
 					unknownZones = append(unknownZones, zone.DNSZone)
 				}
 			}
 		}
 		if len(failedZones) != 0 {
-			// TODO: Add failed condition reasons
 			conditions = append(conditions, operatorv1.OperatorCondition{
 				Type:    operatorv1.DNSReadyIngressConditionType,
 				Status:  operatorv1.ConditionFalse,
 				Reason:  "FailedZones",
-				Message: fmt.Sprintf("The record failed to provision in some zones: %v", failedZones),
+				Message: fmt.Sprintf("The record failed to provision in some zones: %v\n%s", failedZones, strings.Join(failedZoneMessages, "\n")),
 			})
 		} else if len(unknownZones) != 0 {
 			// This condition is an edge case where DNSManaged=True but
@@ -1068,6 +1188,28 @@ func computeDNSStatus(ic *operatorv1.IngressController, wildcardRecord *iov1.DNS
 	return conditions
 }
 
+// failedZoneMessage returns a message describing why the wildcard record failed
+// to be published to the given zone and what the administrator can do about
+// it, based on the reason of the zone's failed Published condition.
+func failedZoneMessage(zone configv1.DNSZone, cond iov1.DNSZoneCondition) string {
+	var hint string
+	switch dns.ErrorReason(cond.Reason) {
+	case dns.ErrorReasonAuthFailure:
+		hint = "The DNS provider rejected the operator's credentials. Verify that the cloud-credentials secret in the operator's namespace is valid and grants permission to manage records in the zone."
+	case dns.ErrorReasonZoneNotFound:
+		hint = "The DNS provider could not find the zone. Verify the zone's ID or tags in the cluster DNS config (dnses.config.openshift.io/cluster)."
+	case dns.ErrorReasonThrottled:
+		hint = "The DNS provider is throttling requests. The operator will retry with exponential backoff; check for other clients exhausting the provider's API rate limits."
+	case dns.ErrorReasonInvalidTarget:
+		hint = "The DNS provider rejected the record's target. Verify that the ingresscontroller's load balancer has been provisioned and that its hostname or IP address is valid."
+	case dns.ErrorReasonTransient:
+		hint = "A transient error occurred. The operator will retry with exponential backoff."
+	default:
+		hint = "The operator will retry with exponential backoff."
+	}
+	return fmt.Sprintf("Zone %v: %s: %s %s", zone, cond.Reason, cond.Message, hint)
+}
+
 // checkZoneInConfig - private utility to check for a zone in the current config
 func checkZoneInConfig(dnsConfig *configv1.DNS, zone configv1.DNSZone) bool {
 	// check PrivateZone settings only
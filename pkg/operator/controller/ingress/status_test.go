@@ -246,6 +246,109 @@ func TestComputePodsScheduledCondition(t *testing.T) {
 	}
 }
 
+func TestComputeReplicasSpreadAcrossZonesCondition(t *testing.T) {
+	deploymentWithReplicas := func(replicas int32, nodeSelector map[string]string) *appsv1.Deployment {
+		return &appsv1.Deployment{
+			Spec: appsv1.DeploymentSpec{
+				Replicas: &replicas,
+				Selector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{
+						"ingresscontroller.operator.openshift.io/deployment-ingresscontroller": "default",
+					},
+				},
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{NodeSelector: nodeSelector},
+				},
+			},
+		}
+	}
+	workers := map[string]string{"node-role.kubernetes.io/worker": ""}
+	podOn := func(name, node string) corev1.Pod {
+		return corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+				Labels: map[string]string{
+					"ingresscontroller.operator.openshift.io/deployment-ingresscontroller": "default",
+				},
+			},
+			Spec: corev1.PodSpec{NodeName: node},
+		}
+	}
+	infra := testNode("infra", "us-east-1a")
+	infra.Labels["node-role.kubernetes.io/infra"] = ""
+	threeZones := []corev1.Node{testNode("a", "us-east-1a"), testNode("b", "us-east-1b"), testNode("c", "us-east-1c"), infra}
+	tests := []struct {
+		name         string
+		deployment   *appsv1.Deployment
+		pods         []corev1.Pod
+		nodes        []corev1.Node
+		expect       operatorv1.ConditionStatus
+		expectReason string
+	}{
+		{
+			name:         "nodes unknown",
+			deployment:   deploymentWithReplicas(2, workers),
+			pods:         []corev1.Pod{podOn("p1", "a"), podOn("p2", "b")},
+			expect:       operatorv1.ConditionUnknown,
+			expectReason: "NodesUnknown",
+		},
+		{
+			name:         "single-zone cluster",
+			deployment:   deploymentWithReplicas(2, workers),
+			pods:         []corev1.Pod{podOn("p1", "a"), podOn("p2", "b")},
+			nodes:        []corev1.Node{testNode("a", "us-east-1a"), testNode("b", "us-east-1a")},
+			expect:       operatorv1.ConditionTrue,
+			expectReason: "SingleZone",
+		},
+		{
+			name:         "node placement allows a single zone",
+			deployment:   deploymentWithReplicas(2, map[string]string{"node-role.kubernetes.io/infra": ""}),
+			pods:         []corev1.Pod{podOn("p1", "infra")},
+			nodes:        threeZones,
+			expect:       operatorv1.ConditionFalse,
+			expectReason: "NodePlacementSingleZone",
+		},
+		{
+			name:         "single replica",
+			deployment:   deploymentWithReplicas(1, workers),
+			pods:         []corev1.Pod{podOn("p1", "a")},
+			nodes:        threeZones,
+			expect:       operatorv1.ConditionFalse,
+			expectReason: "InsufficientReplicas",
+		},
+		{
+			name:         "replicas in one zone",
+			deployment:   deploymentWithReplicas(2, workers),
+			pods:         []corev1.Pod{podOn("p1", "a"), podOn("p2", "infra")},
+			nodes:        threeZones,
+			expect:       operatorv1.ConditionFalse,
+			expectReason: "ReplicasNotSpread",
+		},
+		{
+			name:         "two replicas in two zones",
+			deployment:   deploymentWithReplicas(2, workers),
+			pods:         []corev1.Pod{podOn("p1", "a"), podOn("p2", "b")},
+			nodes:        threeZones,
+			expect:       operatorv1.ConditionTrue,
+			expectReason: "ReplicasSpread",
+		},
+		{
+			name:         "three replicas in two of three zones",
+			deployment:   deploymentWithReplicas(3, workers),
+			pods:         []corev1.Pod{podOn("p1", "a"), podOn("p2", "b"), podOn("p3", "b")},
+			nodes:        threeZones,
+			expect:       operatorv1.ConditionFalse,
+			expectReason: "ReplicasNotSpread",
+		},
+	}
+	for _, test := range tests {
+		actual := computeReplicasSpreadAcrossZonesCondition(test.deployment, test.pods, test.nodes)
+		if actual.Status != test.expect || actual.Reason != test.expectReason {
+			t.Errorf("%q: expected %v/%s, got %v/%s: %s", test.name, test.expect, test.expectReason, actual.Status, actual.Reason, actual.Message)
+		}
+	}
+}
+
 func TestComputeIngressDegradedCondition(t *testing.T) {
 	// Inject a fake clock and don't forget to reset it
 	fakeClock := utilclocktesting.NewFakeClock(time.Time{})
@@ -2296,7 +2399,7 @@ func TestComputeIngressUpgradeableCondition(t *testing.T) {
 					},
 				},
 			}
-			wantSvc, service, err := desiredLoadBalancerService(ic, deploymentRef, platformStatus)
+			wantSvc, service, err := desiredLoadBalancerService(ic, "openshift-ingress", deploymentRef, platformStatus)
 			if err != nil {
 				t.Errorf("%q: unexpected error from desiredLoadBalancerService: %v", tc.description, err)
 				return
@@ -2406,7 +2509,7 @@ func TestComputeIngressEvaluationConditionsDetectedCondition(t *testing.T) {
 				},
 			}
 
-			wantSvc, service, err := desiredLoadBalancerService(ic, deploymentRef, platformStatus)
+			wantSvc, service, err := desiredLoadBalancerService(ic, "openshift-ingress", deploymentRef, platformStatus)
 			if err != nil {
 				t.Fatalf("unexpected error from desiredLoadBalancerService: %v", err)
 			}
@@ -2502,3 +2605,37 @@ func Test_computeAllowedSourceRanges(t *testing.T) {
 		}
 	}
 }
+
+// TestFailedZoneMessage verifies that failedZoneMessage produces an actionable
+// message for each classification of DNS provider errors.
+func TestFailedZoneMessage(t *testing.T) {
+	zone := configv1.DNSZone{ID: "zone1"}
+	tests := []struct {
+		reason         string
+		expectContains string
+	}{
+		{reason: "AuthFailure", expectContains: "cloud-credentials"},
+		{reason: "ZoneNotFound", expectContains: "dnses.config.openshift.io/cluster"},
+		{reason: "Throttled", expectContains: "rate limits"},
+		{reason: "InvalidTarget", expectContains: "load balancer"},
+		{reason: "TransientError", expectContains: "transient"},
+		{reason: "ProviderError", expectContains: "retry"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.reason, func(t *testing.T) {
+			cond := iov1.DNSZoneCondition{
+				Type:    iov1.DNSRecordPublishedConditionType,
+				Status:  string(operatorv1.ConditionFalse),
+				Reason:  tc.reason,
+				Message: "The DNS provider failed to ensure the record: oops",
+			}
+			msg := failedZoneMessage(zone, cond)
+			if !strings.Contains(msg, tc.expectContains) {
+				t.Errorf("expected message to contain %q, got %q", tc.expectContains, msg)
+			}
+			if !strings.Contains(msg, cond.Message) {
+				t.Errorf("expected message to contain the provider error, got %q", msg)
+			}
+		})
+	}
+}
@@ -0,0 +1,522 @@
+package ingress
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	"github.com/openshift/cluster-ingress-operator/pkg/operator/controller"
+	routertracing "github.com/openshift/cluster-ingress-operator/pkg/tracing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	// w3cTracePropagation is the trace propagation mode in which the
+	// router adds its span to the W3C trace context of each request and
+	// passes the trace context through to backends, generating a trace
+	// context for requests that do not have one.
+	w3cTracePropagation = "W3C"
+	// noTracePropagation is the trace propagation mode in which the router
+	// removes W3C trace context headers from requests before forwarding
+	// them to backends, so that clients cannot join backends' spans to
+	// their traces.  The router's spans, if any, start new traces.
+	noTracePropagation = "None"
+
+	// routerTracingContainerName is the name of the sidecar container
+	// that receives the router's log records and exports spans.
+	routerTracingContainerName = "router-tracing"
+	// routerTracingSyslogAddress is the address on which the tracing
+	// sidecar receives the router's log records using syslog over UDP.
+	// The containers share the pod's network namespace.
+	routerTracingSyslogAddress = "127.0.0.1:1515"
+	// routerTracingUniqueIDHeaderName is the name of the request header
+	// in which the router sends the unique ID that records each request's
+	// trace context; see routertracing.UniqueIDFormat.  The router
+	// requires a header name in order to generate unique IDs.
+	routerTracingUniqueIDHeaderName = "X-Router-Trace-Context"
+	// routerTracingConfigKey is the key in the tracing configmap of the
+	// sidecar's configuration file.
+	routerTracingConfigKey = "router-tracing.json"
+	// routerTracingConfigMountPath is the path at which the tracing
+	// configmap is mounted in the sidecar container.
+	routerTracingConfigMountPath = "/etc/router-tracing"
+	// routerTracingCredentialsMountPath is the path at which the tracing
+	// credentials secret is mounted in the sidecar container.
+	routerTracingCredentialsMountPath = "/etc/router-tracing/credentials"
+
+	// tracingCredentialsAuthorizationKey is the key in the tracing
+	// credentials secret of the Authorization header value.
+	tracingCredentialsAuthorizationKey = "authorization"
+	// tracingCredentialsCABundleKey is the key in the tracing credentials
+	// secret of the PEM-encoded CA certificates with which to verify the
+	// OTLP endpoint.
+	tracingCredentialsCABundleKey = "ca-bundle.crt"
+)
+
+// routerTracing holds the tracing parameters that an ingresscontroller can
+// specify using the tracing unsupported config override.
+//
+// Without an OTLP endpoint, the router emits no spans, and the propagation
+// mode only determines whether the router passes trace context headers through
+// to backends unchanged or removes them.  With an OTLP endpoint, the router
+// records a span for each request, and the tracing sidecar exports the spans
+// of sampled traces to the endpoint.
+type routerTracing struct {
+	// Propagation is w3cTracePropagation or noTracePropagation.  The
+	// default is w3cTracePropagation.
+	Propagation string `json:"propagation"`
+	// SamplingRatio is the fraction of the traces that the router starts
+	// that are sampled, between 0 and 1.  The router follows the sampling
+	// decision of requests with a trace context.  The default is 1.
+	SamplingRatio *float64 `json:"samplingRatio"`
+	// OTLP is the OpenTelemetry endpoint to which the sidecar exports
+	// spans.  Only otlpHTTPProtocol is supported, and it is the default.
+	OTLP *otlpDestination `json:"otlp"`
+	// CredentialsSecret is the name of a secret in the config namespace
+	// with the optional keys tracingCredentialsAuthorizationKey and
+	// tracingCredentialsCABundleKey.  The operator copies the secret into
+	// the operand namespace for the sidecar.
+	CredentialsSecret string `json:"credentialsSecret"`
+}
+
+// routerTracingForIngressController returns the tracing configuration for the
+// given ingresscontroller, with defaults applied, or nil if it does not specify
+// one.  An error is returned if the configuration cannot be parsed or is
+// invalid.  Unknown fields are rejected so that misspelled parameters are not
+// silently ignored.
+func routerTracingForIngressController(ic *operatorv1.IngressController) (*routerTracing, error) {
+	if len(ic.Spec.UnsupportedConfigOverrides.Raw) == 0 {
+		return nil, nil
+	}
+	var unsupportedConfigOverrides struct {
+		Tracing json.RawMessage `json:"tracing"`
+	}
+	if err := json.Unmarshal(ic.Spec.UnsupportedConfigOverrides.Raw, &unsupportedConfigOverrides); err != nil {
+		return nil, fmt.Errorf("ingresscontroller %q has invalid spec.unsupportedConfigOverrides: %w", ic.Name, err)
+	}
+	if len(unsupportedConfigOverrides.Tracing) == 0 || string(unsupportedConfigOverrides.Tracing) == "null" {
+		return nil, nil
+	}
+	tracing := &routerTracing{}
+	decoder := json.NewDecoder(bytes.NewReader(unsupportedConfigOverrides.Tracing))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(tracing); err != nil {
+		return nil, fmt.Errorf("spec.unsupportedConfigOverrides.tracing is invalid: %w", err)
+	}
+
+	switch tracing.Propagation {
+	case "":
+		tracing.Propagation = w3cTracePropagation
+	case w3cTracePropagation, noTracePropagation:
+	default:
+		return nil, fmt.Errorf("spec.unsupportedConfigOverrides.tracing.propagation must be %q or %q, got %q", w3cTracePropagation, noTracePropagation, tracing.Propagation)
+	}
+
+	if tracing.OTLP == nil {
+		if tracing.SamplingRatio != nil || len(tracing.CredentialsSecret) != 0 {
+			return nil, fmt.Errorf("spec.unsupportedConfigOverrides.tracing.samplingRatio and spec.unsupportedConfigOverrides.tracing.credentialsSecret require spec.unsupportedConfigOverrides.tracing.otlp")
+		}
+		return tracing, nil
+	}
+
+	if tracing.SamplingRatio == nil {
+		ratio := 1.0
+		tracing.SamplingRatio = &ratio
+	} else if *tracing.SamplingRatio < 0 || *tracing.SamplingRatio > 1 {
+		return nil, fmt.Errorf("spec.unsupportedConfigOverrides.tracing.samplingRatio must be between 0 and 1, got %v", *tracing.SamplingRatio)
+	}
+	if len(tracing.OTLP.Protocol) == 0 {
+		tracing.OTLP.Protocol = otlpHTTPProtocol
+	}
+	if tracing.OTLP.Protocol != otlpHTTPProtocol {
+		return nil, fmt.Errorf("spec.unsupportedConfigOverrides.tracing.otlp.protocol must be %q, got %q", otlpHTTPProtocol, tracing.OTLP.Protocol)
+	}
+	if err := validateOTLPDestination("spec.unsupportedConfigOverrides.tracing.otlp", tracing.OTLP); err != nil {
+		return nil, err
+	}
+	if len(tracing.CredentialsSecret) != 0 {
+		if errs := validation.IsDNS1123Subdomain(tracing.CredentialsSecret); len(errs) != 0 {
+			return nil, fmt.Errorf("spec.unsupportedConfigOverrides.tracing.credentialsSecret %q is not a valid secret name: %v", tracing.CredentialsSecret, errs)
+		}
+	}
+	// The router records each request's trace context in its unique ID
+	// and sends the records from which the sidecar creates spans to its
+	// only syslog destination.
+	if ic.Spec.HTTPHeaders != nil && len(ic.Spec.HTTPHeaders.UniqueId.Name) != 0 {
+		return nil, fmt.Errorf("spec.unsupportedConfigOverrides.tracing.otlp may not be used with spec.httpHeaders.uniqueId")
+	}
+	if accessLoggingForIngressController(ic) != nil {
+		return nil, fmt.Errorf("spec.unsupportedConfigOverrides.tracing.otlp may not be used with spec.logging.access")
+	}
+
+	return tracing, nil
+}
+
+// tracingRequestHeaderActions returns the request header actions that
+// implement the given tracing configuration's propagation mode.  If the router
+// does not emit spans, it forwards trace context headers unchanged with
+// w3cTracePropagation.  If it does, it replaces the traceparent header with one
+// that names the router's span as the parent.
+func tracingRequestHeaderActions(tracing *routerTracing, emitSpans bool) []httpHeaderAction {
+	switch {
+	case tracing == nil:
+		return nil
+	case tracing.Propagation == noTracePropagation:
+		return []httpHeaderAction{
+			{Name: routertracing.TraceparentHeaderName, Action: deleteHTTPHeaderAction},
+			{Name: routertracing.TracestateHeaderName, Action: deleteHTTPHeaderAction},
+		}
+	case emitSpans:
+		return []httpHeaderAction{
+			{Name: routertracing.TraceparentHeaderName, Action: setHTTPHeaderAction, Value: routertracing.TraceparentFormat},
+		}
+	}
+	return nil
+}
+
+// tracingEnv returns the router environment variables with which the router
+// records the trace context of each request and sends the records from which
+// the tracing sidecar creates spans.
+func tracingEnv(tracing *routerTracing) []corev1.EnvVar {
+	uniqueIDFormat := routertracing.UniqueIDFormat(tracing.Propagation == w3cTracePropagation, *tracing.SamplingRatio)
+	return []corev1.EnvVar{
+		{Name: RouterUniqueHeaderName, Value: routerTracingUniqueIDHeaderName},
+		{Name: RouterUniqueHeaderFormat, Value: fmt.Sprintf("%q", uniqueIDFormat)},
+		{Name: RouterSyslogAddressEnvName, Value: routerTracingSyslogAddress},
+		{Name: RouterSyslogFormatEnvName, Value: fmt.Sprintf("%q", routertracing.LogFormat)},
+		{Name: RouterLogLevelEnvName, Value: "info"},
+	}
+}
+
+// desiredRouterTracingContainer returns the tracing sidecar container and its
+// volumes for the given ingresscontroller.
+func desiredRouterTracingContainer(ic *operatorv1.IngressController, tracing *routerTracing, operandNamespace, operatorImage string) (corev1.Container, []corev1.Volume) {
+	configVolume := corev1.Volume{
+		Name: "router-tracing-config",
+		VolumeSource: corev1.VolumeSource{
+			ConfigMap: &corev1.ConfigMapVolumeSource{
+				LocalObjectReference: corev1.LocalObjectReference{
+					Name: controller.RouterTracingConfigMapName(ic, operandNamespace).Name,
+				},
+			},
+		},
+	}
+	container := corev1.Container{
+		Name:    routerTracingContainerName,
+		Image:   operatorImage,
+		Command: []string{"ingress-operator", routertracing.ServeCommand},
+		Args: []string{
+			"--listen-address=" + routerTracingSyslogAddress,
+			"--config=" + filepath.Join(routerTracingConfigMountPath, routerTracingConfigKey),
+		},
+		ImagePullPolicy: corev1.PullIfNotPresent,
+		VolumeMounts: []corev1.VolumeMount{{
+			Name:      configVolume.Name,
+			MountPath: routerTracingConfigMountPath,
+		}},
+		Resources: corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("10m"),
+				corev1.ResourceMemory: resource.MustParse("32Mi"),
+			},
+		},
+	}
+	volumes := []corev1.Volume{configVolume}
+
+	if len(tracing.CredentialsSecret) != 0 {
+		// The secret is optional so that the sidecar can start before
+		// the operator has copied it.  The sidecar ignores the keys
+		// that the secret does not have.
+		optional := true
+		credentialsVolume := corev1.Volume{
+			Name: "router-tracing-credentials",
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: controller.RouterTracingSecretName(ic, operandNamespace).Name,
+					Optional:   &optional,
+				},
+			},
+		}
+		container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+			Name:      credentialsVolume.Name,
+			MountPath: routerTracingCredentialsMountPath,
+			ReadOnly:  true,
+		})
+		volumes = append(volumes, credentialsVolume)
+	}
+
+	return container, volumes
+}
+
+// desiredRouterTracingConfig returns the configuration file for the tracing
+// sidecar, which exports spans to the OTLP/HTTP traces URL of the given tracing
+// configuration's endpoint.
+func desiredRouterTracingConfig(ic *operatorv1.IngressController, tracing *routerTracing) (string, error) {
+	config := routertracing.Config{
+		Endpoint:    strings.TrimSuffix(tracing.OTLP.Endpoint, "/") + "/v1/traces",
+		ServiceName: "router-" + ic.Name,
+		ResourceAttributes: map[string]string{
+			"openshift.ingresscontroller.name": ic.Name,
+		},
+	}
+	if len(tracing.CredentialsSecret) != 0 {
+		config.AuthorizationFile = filepath.Join(routerTracingCredentialsMountPath, tracingCredentialsAuthorizationKey)
+		config.CABundleFile = filepath.Join(routerTracingCredentialsMountPath, tracingCredentialsCABundleKey)
+	}
+	data, err := json.MarshalIndent(config, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// ensureRouterTracing ensures the tracing credentials secret and sidecar
+// configmap exist for the given ingresscontroller if it exports spans, and
+// deletes them otherwise.
+func (r *reconciler) ensureRouterTracing(ic *operatorv1.IngressController, deploymentRef metav1.OwnerReference) error {
+	tracing, _ := routerTracingForIngressController(ic)
+	if tracing != nil && (tracing.OTLP == nil || len(r.config.OperatorImage) == 0) {
+		tracing = nil
+	}
+	if _, _, err := r.ensureRouterTracingSecret(ic, tracing, deploymentRef); err != nil {
+		return err
+	}
+	if _, _, err := r.ensureRouterTracingConfigMap(ic, tracing, deploymentRef); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ensureRouterTracingSecret ensures that the operator's copy of the tracing
+// credentials secret exists and matches the secret in the config namespace if
+// the given tracing configuration specifies one.  Returns a Boolean indicating
+// whether the secret exists, the secret if it does exist, and an error value.
+func (r *reconciler) ensureRouterTracingSecret(ic *operatorv1.IngressController, tracing *routerTracing, deploymentRef metav1.OwnerReference) (bool, *corev1.Secret, error) {
+	var source *corev1.Secret
+	if tracing != nil && len(tracing.CredentialsSecret) != 0 {
+		name := types.NamespacedName{
+			Namespace: r.config.ConfigNamespace,
+			Name:      tracing.CredentialsSecret,
+		}
+		secret := &corev1.Secret{}
+		if err := r.cache.Get(context.TODO(), name, secret); err != nil {
+			if !errors.IsNotFound(err) {
+				return false, nil, fmt.Errorf("failed to get tracing credentials secret %s: %w", name, err)
+			}
+		} else {
+			source = secret
+		}
+	}
+	want, desired := desiredRouterTracingSecret(ic, source, r.config.OperandNamespace, deploymentRef)
+
+	have, current, err := r.currentRouterTracingSecret(ic)
+	if err != nil {
+		return false, nil, err
+	}
+
+	switch {
+	case !want && !have:
+		return false, nil, nil
+	case !want && have:
+		if err := r.client.Delete(context.TODO(), current); err != nil {
+			if !errors.IsNotFound(err) {
+				return true, current, fmt.Errorf("failed to delete secret: %w", err)
+			}
+		} else {
+			log.Info("deleted secret", "namespace", current.Namespace, "name", current.Name)
+		}
+		return false, nil, nil
+	case want && !have:
+		if err := r.client.Create(context.TODO(), desired); err != nil {
+			return false, nil, fmt.Errorf("failed to create secret: %w", err)
+		}
+		log.Info("created secret", "namespace", desired.Namespace, "name", desired.Name)
+		return r.currentRouterTracingSecret(ic)
+	case want && have:
+		if !reflect.DeepEqual(current.Data, desired.Data) {
+			updated := current.DeepCopy()
+			updated.Data = desired.Data
+			if err := r.client.Update(context.TODO(), updated); err != nil {
+				return true, current, fmt.Errorf("failed to update secret: %w", err)
+			}
+			// Secret data must not be logged, so log only the name.
+			log.Info("updated secret", "namespace", updated.Namespace, "name", updated.Name)
+			return r.currentRouterTracingSecret(ic)
+		}
+	}
+
+	return true, current, nil
+}
+
+// desiredRouterTracingSecret returns the desired copy of the given tracing
+// credentials secret.  Returns a Boolean indicating whether a secret is
+// desired, as well as the secret if one is desired.  Only the keys that the
+// sidecar uses are copied.
+func desiredRouterTracingSecret(ic *operatorv1.IngressController, source *corev1.Secret, operandNamespace string, deploymentRef metav1.OwnerReference) (bool, *corev1.Secret) {
+	if source == nil {
+		return false, nil
+	}
+	data := map[string][]byte{}
+	for _, key := range []string{tracingCredentialsAuthorizationKey, tracingCredentialsCABundleKey} {
+		if value, ok := source.Data[key]; ok {
+			data[key] = value
+		}
+	}
+	name := controller.RouterTracingSecretName(ic, operandNamespace)
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name.Name,
+			Namespace: name.Namespace,
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: data,
+	}
+	secret.SetOwnerReferences([]metav1.OwnerReference{deploymentRef})
+	return true, secret
+}
+
+// currentRouterTracingSecret returns the operator's current copy of the
+// tracing credentials secret.  Returns a Boolean indicating whether the secret
+// existed, the secret if it did exist, and an error value.
+func (r *reconciler) currentRouterTracingSecret(ic *operatorv1.IngressController) (bool, *corev1.Secret, error) {
+	secret := &corev1.Secret{}
+	if err := r.client.Get(context.TODO(), controller.RouterTracingSecretName(ic, r.config.OperandNamespace), secret); err != nil {
+		if errors.IsNotFound(err) {
+			return false, nil, nil
+		}
+		return false, nil, err
+	}
+	return true, secret, nil
+}
+
+// ensureRouterTracingConfigMap ensures the tracing sidecar configmap exists
+// for the given ingresscontroller if it exports spans.  Returns a Boolean
+// indicating whether the configmap exists, the configmap if it does exist, and
+// an error value.
+func (r *reconciler) ensureRouterTracingConfigMap(ic *operatorv1.IngressController, tracing *routerTracing, deploymentRef metav1.OwnerReference) (bool, *corev1.ConfigMap, error) {
+	want, desired, err := desiredRouterTracingConfigMap(ic, tracing, r.config.OperandNamespace, deploymentRef)
+	if err != nil {
+		return false, nil, fmt.Errorf("failed to build configmap: %w", err)
+	}
+
+	have, current, err := r.currentRouterTracingConfigMap(ic)
+	if err != nil {
+		return false, nil, err
+	}
+
+	switch {
+	case !want && !have:
+		return false, nil, nil
+	case !want && have:
+		if err := r.client.Delete(context.TODO(), current); err != nil {
+			if !errors.IsNotFound(err) {
+				return true, current, fmt.Errorf("failed to delete configmap: %w", err)
+			}
+		} else {
+			log.Info("deleted configmap", "configmap", current)
+		}
+		return false, nil, nil
+	case want && !have:
+		if err := r.client.Create(context.TODO(), desired); err != nil {
+			return false, nil, fmt.Errorf("failed to create configmap: %w", err)
+		}
+		log.Info("created configmap", "configmap", desired)
+		return r.currentRouterTracingConfigMap(ic)
+	case want && have:
+		if !reflect.DeepEqual(current.Data, desired.Data) {
+			updated := current.DeepCopy()
+			updated.Data = desired.Data
+			// Diff before updating because the client may mutate the object.
+			diff := cmp.Diff(current, updated, cmpopts.EquateEmpty())
+			if err := r.client.Update(context.TODO(), updated); err != nil {
+				return true, current, fmt.Errorf("failed to update configmap: %w", err)
+			}
+			log.Info("updated configmap", "namespace", updated.Namespace, "name", updated.Name, "diff", diff)
+			return r.currentRouterTracingConfigMap(ic)
+		}
+	}
+
+	return true, current, nil
+}
+
+// desiredRouterTracingConfigMap returns the desired tracing sidecar configmap.
+// Returns a Boolean indicating whether a configmap is desired, as well as the
+// configmap if one is desired.
+func desiredRouterTracingConfigMap(ic *operatorv1.IngressController, tracing *routerTracing, operandNamespace string, deploymentRef metav1.OwnerReference) (bool, *corev1.ConfigMap, error) {
+	if tracing == nil || tracing.OTLP == nil {
+		return false, nil, nil
+	}
+	config, err := desiredRouterTracingConfig(ic, tracing)
+	if err != nil {
+		return false, nil, err
+	}
+	name := controller.RouterTracingConfigMapName(ic, operandNamespace)
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name.Name,
+			Namespace: name.Namespace,
+		},
+		Data: map[string]string{
+			routerTracingConfigKey: config,
+		},
+	}
+	cm.SetOwnerReferences([]metav1.OwnerReference{deploymentRef})
+	return true, cm, nil
+}
+
+// currentRouterTracingConfigMap returns the current tracing sidecar configmap.
+// Returns a Boolean indicating whether the configmap existed, the configmap if
+// it did exist, and an error value.
+func (r *reconciler) currentRouterTracingConfigMap(ic *operatorv1.IngressController) (bool, *corev1.ConfigMap, error) {
+	cm := &corev1.ConfigMap{}
+	if err := r.client.Get(context.TODO(), controller.RouterTracingConfigMapName(ic, r.config.OperandNamespace), cm); err != nil {
+		if errors.IsNotFound(err) {
+			return false, nil, nil
+		}
+		return false, nil, err
+	}
+	return true, cm, nil
+}
+
+// tracingSecretToIngressController maps a secret in the config namespace to
+// the ingresscontrollers that use it as their tracing credentials secret.
+func (r *reconciler) tracingSecretToIngressController(o client.Object) []reconcile.Request {
+	var requests []reconcile.Request
+	controllers := &operatorv1.IngressControllerList{}
+	if err := r.cache.List(context.Background(), controllers, client.InNamespace(r.config.Namespace)); err != nil {
+		log.Error(err, "failed to list ingresscontrollers for secret", "namespace", o.GetNamespace(), "name", o.GetName())
+		return requests
+	}
+	for i := range controllers.Items {
+		ic := &controllers.Items[i]
+		tracing, _ := routerTracingForIngressController(ic)
+		if tracing == nil || tracing.CredentialsSecret != o.GetName() {
+			continue
+		}
+		log.Info("queueing ingresscontroller", "name", ic.Name, "namespace", o.GetNamespace(), "secret", o.GetName())
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Namespace: ic.Namespace,
+				Name:      ic.Name,
+			},
+		})
+	}
+	return requests
+}
@@ -0,0 +1,345 @@
+package ingress
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"reflect"
+	"testing"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	routertracing "github.com/openshift/cluster-ingress-operator/pkg/tracing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// TestRouterTracingForIngressController verifies that
+// routerTracingForIngressController applies defaults and rejects invalid
+// tracing configurations.
+func TestRouterTracingForIngressController(t *testing.T) {
+	ratio := func(v float64) *float64 { return &v }
+	testCases := []struct {
+		description   string
+		overrides     string
+		uniqueId      string
+		accessLogging bool
+		expect        *routerTracing
+		expectError   bool
+	}{
+		{
+			description: "no overrides",
+		},
+		{
+			description: "overrides without tracing",
+			overrides:   `{"httpHeaderActions":{"request":[{"name":"X-Client","action":"Delete"}]}}`,
+		},
+		{
+			description: "default propagation",
+			overrides:   `{"tracing":{}}`,
+			expect:      &routerTracing{Propagation: w3cTracePropagation},
+		},
+		{
+			description: "no propagation",
+			overrides:   `{"tracing":{"propagation":"None"}}`,
+			expect:      &routerTracing{Propagation: noTracePropagation},
+		},
+		{
+			description: "unsupported propagation",
+			overrides:   `{"tracing":{"propagation":"B3"}}`,
+			expectError: true,
+		},
+		{
+			description: "span export with defaults",
+			overrides:   `{"tracing":{"otlp":{"endpoint":"https://collector.example.com:4318"}}}`,
+			expect: &routerTracing{
+				Propagation:   w3cTracePropagation,
+				SamplingRatio: ratio(1),
+				OTLP:          &otlpDestination{Endpoint: "https://collector.example.com:4318", Protocol: otlpHTTPProtocol},
+			},
+		},
+		{
+			description: "span export with sampling and credentials",
+			overrides:   `{"tracing":{"propagation":"None","samplingRatio":0.25,"otlp":{"endpoint":"http://collector.example.com:4318","protocol":"http"},"credentialsSecret":"tracing-credentials"}}`,
+			expect: &routerTracing{
+				Propagation:       noTracePropagation,
+				SamplingRatio:     ratio(0.25),
+				OTLP:              &otlpDestination{Endpoint: "http://collector.example.com:4318", Protocol: otlpHTTPProtocol},
+				CredentialsSecret: "tracing-credentials",
+			},
+		},
+		{
+			description: "gRPC is not supported",
+			overrides:   `{"tracing":{"otlp":{"endpoint":"collector.example.com:4317","protocol":"grpc"}}}`,
+			expectError: true,
+		},
+		{
+			description: "endpoint is not a URL",
+			overrides:   `{"tracing":{"otlp":{"endpoint":"collector.example.com:4318"}}}`,
+			expectError: true,
+		},
+		{
+			description: "sampling ratio out of range",
+			overrides:   `{"tracing":{"samplingRatio":1.5,"otlp":{"endpoint":"http://collector.example.com:4318"}}}`,
+			expectError: true,
+		},
+		{
+			description: "sampling ratio without span export",
+			overrides:   `{"tracing":{"samplingRatio":0.5}}`,
+			expectError: true,
+		},
+		{
+			description: "invalid credentials secret",
+			overrides:   `{"tracing":{"otlp":{"endpoint":"http://collector.example.com:4318"},"credentialsSecret":"Tracing_Credentials"}}`,
+			expectError: true,
+		},
+		{
+			description: "span export with unique ID",
+			overrides:   `{"tracing":{"otlp":{"endpoint":"http://collector.example.com:4318"}}}`,
+			uniqueId:    "X-Request-Id",
+			expectError: true,
+		},
+		{
+			description:   "span export with access logging",
+			overrides:     `{"tracing":{"otlp":{"endpoint":"http://collector.example.com:4318"}}}`,
+			accessLogging: true,
+			expectError:   true,
+		},
+		{
+			description: "propagation with unique ID",
+			overrides:   `{"tracing":{"propagation":"None"}}`,
+			uniqueId:    "X-Request-Id",
+			expect:      &routerTracing{Propagation: noTracePropagation},
+		},
+		{
+			description: "unknown field",
+			overrides:   `{"tracing":{"generateTraceContext":true}}`,
+			expectError: true,
+		},
+		{
+			description: "tracing is not an object",
+			overrides:   `{"tracing":"W3C"}`,
+			expectError: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			ic := &operatorv1.IngressController{
+				ObjectMeta: metav1.ObjectMeta{Name: "default"},
+			}
+			if len(tc.overrides) != 0 {
+				ic.Spec.UnsupportedConfigOverrides = runtime.RawExtension{Raw: []byte(tc.overrides)}
+			}
+			if len(tc.uniqueId) != 0 {
+				ic.Spec.HTTPHeaders = &operatorv1.IngressControllerHTTPHeaders{
+					UniqueId: operatorv1.IngressControllerHTTPUniqueIdHeaderPolicy{Name: tc.uniqueId},
+				}
+			}
+			if tc.accessLogging {
+				ic.Spec.Logging = &operatorv1.IngressControllerLogging{
+					Access: &operatorv1.AccessLogging{
+						Destination: operatorv1.LoggingDestination{Type: operatorv1.ContainerLoggingDestinationType},
+					},
+				}
+			}
+			actual, err := routerTracingForIngressController(ic)
+			switch {
+			case tc.expectError && err == nil:
+				t.Fatalf("expected error, got %+v", actual)
+			case !tc.expectError && err != nil:
+				t.Fatalf("unexpected error: %v", err)
+			case !tc.expectError && !reflect.DeepEqual(tc.expect, actual):
+				t.Errorf("expected %+v, got %+v", tc.expect, actual)
+			}
+		})
+	}
+}
+
+// TestDesiredRouterTracingConfig verifies that the tracing sidecar exports to
+// the traces URL of the endpoint and reads the credentials from the copied
+// secret.
+func TestDesiredRouterTracingConfig(t *testing.T) {
+	ic := &operatorv1.IngressController{
+		ObjectMeta: metav1.ObjectMeta{Name: "default"},
+	}
+	testCases := []struct {
+		description string
+		tracing     *routerTracing
+		expect      routertracing.Config
+	}{
+		{
+			description: "without credentials",
+			tracing: &routerTracing{
+				OTLP: &otlpDestination{Endpoint: "http://collector.example.com:4318/", Protocol: otlpHTTPProtocol},
+			},
+			expect: routertracing.Config{
+				Endpoint:           "http://collector.example.com:4318/v1/traces",
+				ServiceName:        "router-default",
+				ResourceAttributes: map[string]string{"openshift.ingresscontroller.name": "default"},
+			},
+		},
+		{
+			description: "with credentials",
+			tracing: &routerTracing{
+				OTLP:              &otlpDestination{Endpoint: "https://collector.example.com", Protocol: otlpHTTPProtocol},
+				CredentialsSecret: "tracing-credentials",
+			},
+			expect: routertracing.Config{
+				Endpoint:           "https://collector.example.com/v1/traces",
+				ServiceName:        "router-default",
+				ResourceAttributes: map[string]string{"openshift.ingresscontroller.name": "default"},
+				AuthorizationFile:  "/etc/router-tracing/credentials/authorization",
+				CABundleFile:       "/etc/router-tracing/credentials/ca-bundle.crt",
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			data, err := desiredRouterTracingConfig(ic, tc.tracing)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			var actual routertracing.Config
+			if err := json.Unmarshal([]byte(data), &actual); err != nil {
+				t.Fatalf("failed to parse configuration: %v\n%s", err, data)
+			}
+			if !reflect.DeepEqual(tc.expect, actual) {
+				t.Errorf("expected %+v, got %+v", tc.expect, actual)
+			}
+		})
+	}
+}
+
+// TestDesiredRouterTracingSecret verifies that the operator copies only the
+// keys of the tracing credentials secret that the sidecar uses.
+func TestDesiredRouterTracingSecret(t *testing.T) {
+	ic := &operatorv1.IngressController{
+		ObjectMeta: metav1.ObjectMeta{Name: "default"},
+	}
+	deploymentRef := metav1.OwnerReference{Name: "router-default"}
+	if want, _ := desiredRouterTracingSecret(ic, nil, "openshift-ingress", deploymentRef); want {
+		t.Error("expected no secret without a source secret")
+	}
+	source := &corev1.Secret{
+		Data: map[string][]byte{
+			tracingCredentialsAuthorizationKey: []byte("Bearer token"),
+			"unrelated":                        []byte("value"),
+		},
+	}
+	want, secret := desiredRouterTracingSecret(ic, source, "openshift-ingress", deploymentRef)
+	if !want {
+		t.Fatal("expected secret")
+	}
+	if secret.Namespace != "openshift-ingress" || secret.Name != "router-tracing-default" {
+		t.Errorf("unexpected secret name %s/%s", secret.Namespace, secret.Name)
+	}
+	expected := map[string][]byte{
+		tracingCredentialsAuthorizationKey: []byte("Bearer token"),
+	}
+	if !reflect.DeepEqual(secret.Data, expected) {
+		t.Errorf("expected data %v, got %v", expected, secret.Data)
+	}
+}
+
+// TestDesiredRouterDeploymentTracing verifies that desiredRouterDeployment
+// configures the router to record and propagate trace context and adds the
+// tracing sidecar when the ingresscontroller exports spans, and only strips
+// trace context headers otherwise.
+func TestDesiredRouterDeploymentTracing(t *testing.T) {
+	const operatorImage = "quay.io/openshift/origin-cluster-ingress-operator:latest"
+	setTraceparent := "traceparent:" + url.QueryEscape(routertracing.TraceparentFormat) + ":Set"
+	testCases := []struct {
+		description   string
+		overrides     string
+		operatorImage string
+		expectEnv     []envData
+		expectSidecar bool
+	}{
+		{
+			description:   "W3C propagation without span export",
+			overrides:     `{"tracing":{"propagation":"W3C"}}`,
+			operatorImage: operatorImage,
+			expectEnv: []envData{
+				{RouterUniqueHeaderName, false, ""},
+				{RouterSyslogAddressEnvName, false, ""},
+				{RouterHTTPRequestHeaders, false, ""},
+			},
+		},
+		{
+			description:   "no propagation without span export",
+			overrides:     `{"tracing":{"propagation":"None"},"httpHeaderActions":{"request":[{"name":"X-Client","action":"Delete"}]}}`,
+			operatorImage: operatorImage,
+			expectEnv: []envData{
+				{RouterUniqueHeaderName, false, ""},
+				{RouterHTTPRequestHeaders, true, "X-Client:Delete,traceparent:Delete,tracestate:Delete"},
+			},
+		},
+		{
+			description:   "W3C propagation with span export",
+			overrides:     `{"tracing":{"samplingRatio":0.5,"otlp":{"endpoint":"http://collector.example.com:4318"}}}`,
+			operatorImage: operatorImage,
+			expectEnv: []envData{
+				{RouterUniqueHeaderName, true, routerTracingUniqueIDHeaderName},
+				{RouterUniqueHeaderFormat, true, fmt.Sprintf("%q", routertracing.UniqueIDFormat(true, 0.5))},
+				{RouterSyslogAddressEnvName, true, routerTracingSyslogAddress},
+				{RouterSyslogFormatEnvName, true, fmt.Sprintf("%q", routertracing.LogFormat)},
+				{RouterLogLevelEnvName, true, "info"},
+				{RouterHTTPRequestHeaders, true, setTraceparent},
+			},
+			expectSidecar: true,
+		},
+		{
+			description:   "no propagation with span export",
+			overrides:     `{"tracing":{"propagation":"None","otlp":{"endpoint":"http://collector.example.com:4318"},"credentialsSecret":"tracing-credentials"}}`,
+			operatorImage: operatorImage,
+			expectEnv: []envData{
+				{RouterUniqueHeaderFormat, true, fmt.Sprintf("%q", routertracing.UniqueIDFormat(false, 1))},
+				{RouterHTTPRequestHeaders, true, "traceparent:Delete,tracestate:Delete"},
+			},
+			expectSidecar: true,
+		},
+		{
+			description: "no operator image",
+			overrides:   `{"tracing":{"otlp":{"endpoint":"http://collector.example.com:4318"}}}`,
+			expectEnv: []envData{
+				{RouterUniqueHeaderName, false, ""},
+				{RouterHTTPRequestHeaders, false, ""},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			ic, ingressConfig, infraConfig, apiConfig, networkConfig, proxyNeeded := getRouterDeploymentComponents(t)
+			ic.Spec.UnsupportedConfigOverrides = runtime.RawExtension{Raw: []byte(tc.overrides)}
+			deployment, err := desiredRouterDeployment(ic, "openshift-ingress", ingressControllerImage, "", tc.operatorImage, ingressConfig, infraConfig, apiConfig, networkConfig, proxyNeeded, false, nil)
+			if err != nil {
+				t.Fatalf("invalid router Deployment: %v", err)
+			}
+			if err := checkDeploymentEnvironment(t, deployment, tc.expectEnv); err != nil {
+				t.Error(err)
+			}
+			var sidecar *corev1.Container
+			for i, container := range deployment.Spec.Template.Spec.Containers {
+				if container.Name == routerTracingContainerName {
+					sidecar = &deployment.Spec.Template.Spec.Containers[i]
+				}
+			}
+			switch {
+			case tc.expectSidecar && sidecar == nil:
+				t.Fatal("expected tracing sidecar container")
+			case !tc.expectSidecar && sidecar != nil:
+				t.Fatal("unexpected tracing sidecar container")
+			case sidecar != nil:
+				if sidecar.Image != tc.operatorImage {
+					t.Errorf("expected sidecar image %s, got %s", tc.operatorImage, sidecar.Image)
+				}
+				expectArgs := []string{"--listen-address=" + routerTracingSyslogAddress, "--config=/etc/router-tracing/" + routerTracingConfigKey}
+				if !reflect.DeepEqual(sidecar.Args, expectArgs) {
+					t.Errorf("expected sidecar args %v, got %v", expectArgs, sidecar.Args)
+				}
+			}
+			checkDeploymentHasEnvSorted(t, deployment)
+		})
+	}
+}
@@ -35,7 +35,6 @@ const (
 
 	DefaultOperatorNamespace = "openshift-ingress-operator"
 	DefaultOperandNamespace  = "openshift-ingress"
-	SourceConfigMapNamespace = "openshift-config"
 
 	// DefaultCanaryNamespace is the default namespace for
 	// the ingress canary check resources.
@@ -54,6 +53,16 @@ func IngressClusterOperatorName() types.NamespacedName {
 	}
 }
 
+// IngressControllerConditionHistoryConfigMapName returns the namespaced name
+// of the configmap in which the operator records the history of
+// ingresscontrollers' status condition transitions.
+func IngressControllerConditionHistoryConfigMapName(operatorNamespace string) types.NamespacedName {
+	return types.NamespacedName{
+		Namespace: operatorNamespace,
+		Name:      "ingresscontroller-condition-history",
+	}
+}
+
 // IngressClusterConfigName returns the namespaced name of the ingress.config.openshift.io
 // resource for the operator.
 func IngressClusterConfigName() types.NamespacedName {
@@ -71,13 +80,24 @@ func InfrastructureClusterConfigName() types.NamespacedName {
 }
 
 // RouterDeploymentName returns the namespaced name for the router deployment.
-func RouterDeploymentName(ci *operatorv1.IngressController) types.NamespacedName {
+func RouterDeploymentName(ci *operatorv1.IngressController, namespace string) types.NamespacedName {
 	return types.NamespacedName{
-		Namespace: DefaultOperandNamespace,
+		Namespace: namespace,
 		Name:      "router-" + ci.Name,
 	}
 }
 
+// RouterStagedRolloutDeploymentName returns the namespaced name for the
+// deployment that runs the canary replicas of a staged router rollout.  The
+// name does not begin with "router-" so that it cannot collide with the router
+// deployment of another ingresscontroller.
+func RouterStagedRolloutDeploymentName(ci *operatorv1.IngressController, namespace string) types.NamespacedName {
+	return types.NamespacedName{
+		Namespace: namespace,
+		Name:      "staged-router-" + ci.Name,
+	}
+}
+
 // RouterCASecretName returns the namespaced name for the router CA secret.
 // This secret holds the CA certificate that the operator will use to create
 // default certificates for ingresscontrollers.
@@ -88,6 +108,43 @@ func RouterCASecretName(operatorNamespace string) types.NamespacedName {
 	}
 }
 
+// WebhookServingCertSecretName returns the namespaced name for the secret with
+// the certificate and key that the operator's admission webhook serves.  The
+// certificate is signed by the router CA.
+func WebhookServingCertSecretName(operatorNamespace string) types.NamespacedName {
+	return types.NamespacedName{
+		Namespace: operatorNamespace,
+		Name:      "webhook-serving-cert",
+	}
+}
+
+// WebhookServiceName returns the namespaced name for the service through which
+// the API server reaches the operator's admission webhook.
+func WebhookServiceName(operatorNamespace string) types.NamespacedName {
+	return types.NamespacedName{
+		Namespace: operatorNamespace,
+		Name:      "webhook",
+	}
+}
+
+// ValidatingWebhookConfigurationName returns the name for the operator's
+// validatingwebhookconfiguration.  The configuration is cluster-scoped, so its
+// name is the operator namespace in order to be unique to the operator.
+func ValidatingWebhookConfigurationName(operatorNamespace string) types.NamespacedName {
+	return types.NamespacedName{
+		Name: operatorNamespace,
+	}
+}
+
+// MutatingWebhookConfigurationName returns the name for the operator's
+// mutatingwebhookconfiguration.  The configuration is cluster-scoped, so its
+// name is the operator namespace in order to be unique to the operator.
+func MutatingWebhookConfigurationName(operatorNamespace string) types.NamespacedName {
+	return types.NamespacedName{
+		Name: operatorNamespace,
+	}
+}
+
 // DefaultIngressCertConfigMapName returns the namespaced name for the default ingress cert configmap.
 // The operator uses this configmap to publish the public key that golang clients can use to trust
 // the default ingress wildcard serving cert.
@@ -121,46 +178,73 @@ func RouterOperatorGeneratedDefaultCertificateSecretName(ci *operatorv1.IngressC
 // ClientCAConfigMapName returns the namespaced name for the operator-managed
 // client CA configmap, which is a copy of the user-managed configmap from the
 // openshift-config namespace.
-func ClientCAConfigMapName(ic *operatorv1.IngressController) types.NamespacedName {
+func ClientCAConfigMapName(ic *operatorv1.IngressController, namespace string) types.NamespacedName {
 	return types.NamespacedName{
-		Namespace: "openshift-ingress",
+		Namespace: namespace,
 		Name:      "router-client-ca-" + ic.Name,
 	}
 }
 
 // CRLConfigMapName returns the namespaced name for the CRL configmap.
-func CRLConfigMapName(ic *operatorv1.IngressController) types.NamespacedName {
+func CRLConfigMapName(ic *operatorv1.IngressController, namespace string) types.NamespacedName {
 	return types.NamespacedName{
-		Namespace: "openshift-ingress",
+		Namespace: namespace,
 		Name:      "router-client-ca-crl-" + ic.Name,
 	}
 }
 
 // RsyslogConfigMapName returns the namespaced name for the rsyslog configmap.
-func RsyslogConfigMapName(ic *operatorv1.IngressController) types.NamespacedName {
+func RsyslogConfigMapName(ic *operatorv1.IngressController, namespace string) types.NamespacedName {
 	return types.NamespacedName{
-		Namespace: DefaultOperandNamespace,
+		Namespace: namespace,
 		Name:      "rsyslog-conf-" + ic.Name,
 	}
 }
 
 // HttpErrorCodePageConfigMapName returns the namespaced name for the errorpage configmap.
-func HttpErrorCodePageConfigMapName(ic *operatorv1.IngressController) types.NamespacedName {
+func HttpErrorCodePageConfigMapName(ic *operatorv1.IngressController, namespace string) types.NamespacedName {
 	return types.NamespacedName{
-		Namespace: DefaultOperandNamespace,
+		Namespace: namespace,
 		Name:      ic.Name + "-errorpages",
 	}
 }
 
 // RouterPodDisruptionBudgetName returns the namespaced name for the router
 // deployment's pod disruption budget.
-func RouterPodDisruptionBudgetName(ic *operatorv1.IngressController) types.NamespacedName {
+func RouterPodDisruptionBudgetName(ic *operatorv1.IngressController, namespace string) types.NamespacedName {
+	return types.NamespacedName{
+		Namespace: namespace,
+		Name:      "router-" + ic.Name,
+	}
+}
+
+// RouterHorizontalPodAutoscalerName returns the namespaced name for the router
+// deployment's horizontal pod autoscaler.
+func RouterHorizontalPodAutoscalerName(ic *operatorv1.IngressController, namespace string) types.NamespacedName {
 	return types.NamespacedName{
-		Namespace: DefaultOperandNamespace,
+		Namespace: namespace,
 		Name:      "router-" + ic.Name,
 	}
 }
 
+// RouterTracingConfigMapName returns the namespaced name for the configmap
+// with the router tracing sidecar's configuration.
+func RouterTracingConfigMapName(ic *operatorv1.IngressController, namespace string) types.NamespacedName {
+	return types.NamespacedName{
+		Namespace: namespace,
+		Name:      "router-tracing-" + ic.Name,
+	}
+}
+
+// RouterTracingSecretName returns the namespaced name for the operator's copy
+// of the secret with the router tracing sidecar's credentials.
+func RouterTracingSecretName(ic *operatorv1.IngressController, namespace string) types.NamespacedName {
+	return types.NamespacedName{
+		Namespace: namespace,
+		Name:      "router-tracing-" + ic.Name,
+	}
+}
+
 // RouterEffectiveDefaultCertificateSecretName returns the namespaced name for
 // the in-use router default certificate secret.
 func RouterEffectiveDefaultCertificateSecretName(ci *operatorv1.IngressController, namespace string) types.NamespacedName {
@@ -172,9 +256,9 @@ func RouterEffectiveDefaultCertificateSecretName(ci *operatorv1.IngressControlle
 
 // ServiceCAConfigMapName returns the namespaced name for the
 // configmap with the service CA bundle.
-func ServiceCAConfigMapName() types.NamespacedName {
+func ServiceCAConfigMapName(namespace string) types.NamespacedName {
 	return types.NamespacedName{
-		Namespace: DefaultOperandNamespace,
+		Namespace: namespace,
 		Name:      "service-ca-bundle",
 	}
 }
@@ -191,24 +275,30 @@ func IngressControllerDeploymentPodSelector(ic *operatorv1.IngressController) *m
 	}
 }
 
-func InternalIngressControllerServiceName(ic *operatorv1.IngressController) types.NamespacedName {
-	// TODO: remove hard-coded namespace
-	return types.NamespacedName{Namespace: DefaultOperandNamespace, Name: "router-internal-" + ic.Name}
+func InternalIngressControllerServiceName(ic *operatorv1.IngressController, namespace string) types.NamespacedName {
+	return types.NamespacedName{Namespace: namespace, Name: "router-internal-" + ic.Name}
 }
 
-func IngressControllerServiceMonitorName(ic *operatorv1.IngressController) types.NamespacedName {
+func IngressControllerServiceMonitorName(ic *operatorv1.IngressController, namespace string) types.NamespacedName {
 	return types.NamespacedName{
-		Namespace: DefaultOperandNamespace,
+		Namespace: namespace,
 		Name:      "router-" + ic.Name,
 	}
 }
 
-func LoadBalancerServiceName(ic *operatorv1.IngressController) types.NamespacedName {
-	return types.NamespacedName{Namespace: DefaultOperandNamespace, Name: "router-" + ic.Name}
+func LoadBalancerServiceName(ic *operatorv1.IngressController, namespace string) types.NamespacedName {
+	return types.NamespacedName{Namespace: namespace, Name: "router-" + ic.Name}
+}
+
+// MigrationLoadBalancerServiceName returns the name of the temporary
+// LoadBalancer-type service that the operator uses while migrating an
+// ingresscontroller's load balancer to a new scope or type.
+func MigrationLoadBalancerServiceName(ic *operatorv1.IngressController, namespace string) types.NamespacedName {
+	return types.NamespacedName{Namespace: namespace, Name: "router-migration-" + ic.Name}
 }
 
-func NodePortServiceName(ic *operatorv1.IngressController) types.NamespacedName {
-	return types.NamespacedName{Namespace: DefaultOperandNamespace, Name: "router-nodeport-" + ic.Name}
+func NodePortServiceName(ic *operatorv1.IngressController, namespace string) types.NamespacedName {
+	return types.NamespacedName{Namespace: namespace, Name: "router-nodeport-" + ic.Name}
 }
 
 func WildcardDNSRecordName(ic *operatorv1.IngressController) types.NamespacedName {
@@ -218,9 +308,9 @@ func WildcardDNSRecordName(ic *operatorv1.IngressController) types.NamespacedNam
 	}
 }
 
-func CanaryDaemonSetName() types.NamespacedName {
+func CanaryDaemonSetName(namespace string) types.NamespacedName {
 	return types.NamespacedName{
-		Namespace: DefaultCanaryNamespace,
+		Namespace: namespace,
 		Name:      "ingress-canary",
 	}
 }
@@ -233,16 +323,16 @@ func CanaryDaemonSetPodSelector(canaryControllerName string) *metav1.LabelSelect
 	}
 }
 
-func CanaryServiceName() types.NamespacedName {
+func CanaryServiceName(namespace string) types.NamespacedName {
 	return types.NamespacedName{
-		Namespace: DefaultCanaryNamespace,
+		Namespace: namespace,
 		Name:      "ingress-canary",
 	}
 }
 
-func CanaryRouteName() types.NamespacedName {
+func CanaryRouteName(namespace string) types.NamespacedName {
 	return types.NamespacedName{
-		Namespace: DefaultCanaryNamespace,
+		Namespace: namespace,
 		Name:      "canary",
 	}
 }
@@ -58,7 +58,10 @@ var clock utilclock.Clock = utilclock.RealClock{}
 // The controller watches IngressController resources in the manager namespace
 // and uses them to compute the operator status.  It also watches the
 // clusteroperators resource so that it reconciles the ingress clusteroperator
-// in case something else updates or deletes it.
+// in case something else updates or deletes it.  Besides the aggregated
+// conditions, the controller publishes a per-ingresscontroller breakdown of
+// conditions in the clusteroperator's status extension and records condition
+// transitions in a configmap in the operator's namespace.
 func New(mgr manager.Manager, config Config) (controller.Controller, error) {
 	reconciler := &reconciler{
 		config: config,
@@ -104,6 +107,8 @@ type Config struct {
 	CanaryImage            string
 	OperatorReleaseVersion string
 	Namespace              string
+	OperandNamespace       string
+	CanaryNamespace        string
 }
 
 // reconciler handles the actual status reconciliation logic in response to
@@ -120,9 +125,6 @@ type reconciler struct {
 func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
 	log.Info("Reconciling", "request", request)
 
-	ingressNamespace := manifests.RouterNamespace().Name
-	canaryNamespace := manifests.CanaryNamespace().Name
-
 	co := &configv1.ClusterOperator{ObjectMeta: metav1.ObjectMeta{Name: operatorcontroller.IngressClusterOperatorName().Name}}
 	if err := r.client.Get(ctx, operatorcontroller.IngressClusterOperatorName(), co); err != nil {
 		if errors.IsNotFound(err) {
@@ -137,7 +139,7 @@ func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	}
 	oldStatus := co.Status.DeepCopy()
 
-	state, err := r.getOperatorState(ingressNamespace, canaryNamespace)
+	state, err := r.getOperatorState(r.config.OperandNamespace, r.config.CanaryNamespace)
 	if err != nil {
 		return reconcile.Result{}, fmt.Errorf("failed to get operator state: %v", err)
 	}
@@ -171,8 +173,24 @@ func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		})
 	}
 
+	historyName := operatorcontroller.IngressControllerConditionHistoryConfigMapName(r.config.Namespace)
+	related = append(related, configv1.ObjectReference{
+		Resource:  "configmaps",
+		Namespace: historyName.Namespace,
+		Name:      historyName.Name,
+	})
+	for i := range state.IngressControllers {
+		related = append(related, ingressControllerRelatedObject(&state.IngressControllers[i]))
+	}
+
 	co.Status.RelatedObjects = related
 
+	extension, err := computeOperatorStatusExtension(state.IngressControllers)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to compute status extension for clusteroperator %s: %v", co.Name, err)
+	}
+	co.Status.Extension = extension
+
 	allIngressesAvailable := checkAllIngressesAvailable(state.IngressControllers)
 
 	co.Status.Versions = r.computeOperatorStatusVersions(oldStatus.Versions, allIngressesAvailable)
@@ -199,6 +217,10 @@ func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		}
 	}
 
+	if err := r.ensureConditionHistory(ctx, state.IngressControllers); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to ensure ingresscontroller condition history: %v", err)
+	}
+
 	return reconcile.Result{}, nil
 }
 
@@ -604,12 +626,27 @@ func operatorStatusesEqual(a, b configv1.ClusterOperatorStatus) bool {
 
 	relatedCmpOpts := []cmp.Option{
 		cmpopts.EquateEmpty(),
-		cmpopts.SortSlices(func(a, b configv1.ObjectReference) bool { return a.Name < b.Name }),
+		cmpopts.SortSlices(func(a, b configv1.ObjectReference) bool {
+			if a.Group != b.Group {
+				return a.Group < b.Group
+			}
+			if a.Resource != b.Resource {
+				return a.Resource < b.Resource
+			}
+			if a.Namespace != b.Namespace {
+				return a.Namespace < b.Namespace
+			}
+			return a.Name < b.Name
+		}),
 	}
 	if !cmp.Equal(a.RelatedObjects, b.RelatedObjects, relatedCmpOpts...) {
 		return false
 	}
 
+	if !operatorStatusExtensionsEqual(a.Extension, b.Extension) {
+		return false
+	}
+
 	versionsCmpOpts := []cmp.Option{
 		cmpopts.EquateEmpty(),
 		cmpopts.SortSlices(func(a, b configv1.OperandVersion) bool { return a.Name < b.Name }),
@@ -1,6 +1,7 @@
 package status
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -10,6 +11,7 @@ import (
 	operatorv1 "github.com/openshift/api/operator/v1"
 	"github.com/openshift/cluster-ingress-operator/pkg/operator/controller/ingress"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
 func TestComputeOperatorProgressingCondition(t *testing.T) {
@@ -390,6 +392,49 @@ func TestOperatorStatusesEqual(t *testing.T) {
 				},
 			},
 		},
+		{
+			description: "related objects that differ only in namespace should be compared in any order",
+			expected:    true,
+			a: configv1.ClusterOperatorStatus{
+				RelatedObjects: []configv1.ObjectReference{
+					{Group: "operator.openshift.io", Resource: "ingresscontrollers", Namespace: "a", Name: "default"},
+					{Group: "operator.openshift.io", Resource: "ingresscontrollers", Namespace: "b", Name: "default"},
+				},
+			},
+			b: configv1.ClusterOperatorStatus{
+				RelatedObjects: []configv1.ObjectReference{
+					{Group: "operator.openshift.io", Resource: "ingresscontrollers", Namespace: "b", Name: "default"},
+					{Group: "operator.openshift.io", Resource: "ingresscontrollers", Namespace: "a", Name: "default"},
+				},
+			},
+		},
+		{
+			description: "extensions that differ only in formatting should be equal",
+			expected:    true,
+			a: configv1.ClusterOperatorStatus{
+				Extension: runtime.RawExtension{Raw: []byte(`{"ingressControllers":[{"relatedObject":{"group":"operator.openshift.io","resource":"ingresscontrollers","namespace":"openshift-ingress-operator","name":"default"}}]}`)},
+			},
+			b: configv1.ClusterOperatorStatus{
+				Extension: runtime.RawExtension{Raw: []byte(`{"ingressControllers": [{"relatedObject": {"name": "default", "namespace": "openshift-ingress-operator", "resource": "ingresscontrollers", "group": "operator.openshift.io"}, "conditions": []}]}`)},
+			},
+		},
+		{
+			description: "check extension change",
+			expected:    false,
+			a: configv1.ClusterOperatorStatus{
+				Extension: runtime.RawExtension{Raw: []byte(`{"ingressControllers":[{"relatedObject":{"name":"default"},"conditions":[{"type":"Degraded","status":"False"}]}]}`)},
+			},
+			b: configv1.ClusterOperatorStatus{
+				Extension: runtime.RawExtension{Raw: []byte(`{"ingressControllers":[{"relatedObject":{"name":"default"},"conditions":[{"type":"Degraded","status":"True"}]}]}`)},
+			},
+		},
+		{
+			description: "check extension added",
+			expected:    false,
+			b: configv1.ClusterOperatorStatus{
+				Extension: runtime.RawExtension{Raw: []byte(`{"ingressControllers":[]}`)},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -654,3 +699,60 @@ func Test_computeOperatorEvaluationConditionsDetectedCondition(t *testing.T) {
 		}
 	}
 }
+
+// TestComputeOperatorStatusExtension verifies that
+// computeOperatorStatusExtension summarizes each ingresscontroller's
+// Available, Progressing, Degraded, and Upgradeable conditions in that order,
+// sorted by ingresscontroller name.
+func TestComputeOperatorStatusExtension(t *testing.T) {
+	ingresscontrollers := []operatorv1.IngressController{
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "openshift-ingress-operator", Name: "sharded"},
+			Status: operatorv1.IngressControllerStatus{
+				Conditions: []operatorv1.OperatorCondition{
+					{Type: "Degraded", Status: operatorv1.ConditionTrue, Reason: "DeploymentUnavailable"},
+					{Type: "Admitted", Status: operatorv1.ConditionTrue},
+					{Type: "Available", Status: operatorv1.ConditionFalse},
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "openshift-ingress-operator", Name: "default"},
+		},
+	}
+	extension, err := computeOperatorStatusExtension(ingresscontrollers)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var actual operatorStatusExtension
+	if err := json.Unmarshal(extension.Raw, &actual); err != nil {
+		t.Fatal(err)
+	}
+	expected := operatorStatusExtension{
+		IngressControllers: []ingressControllerStatusSummary{
+			{
+				RelatedObject: configv1.ObjectReference{
+					Group:     "operator.openshift.io",
+					Resource:  "ingresscontrollers",
+					Namespace: "openshift-ingress-operator",
+					Name:      "default",
+				},
+			},
+			{
+				RelatedObject: configv1.ObjectReference{
+					Group:     "operator.openshift.io",
+					Resource:  "ingresscontrollers",
+					Namespace: "openshift-ingress-operator",
+					Name:      "sharded",
+				},
+				Conditions: []operatorv1.OperatorCondition{
+					{Type: "Available", Status: operatorv1.ConditionFalse},
+					{Type: "Degraded", Status: operatorv1.ConditionTrue, Reason: "DeploymentUnavailable"},
+				},
+			},
+		},
+	}
+	if diff := cmp.Diff(expected, actual, cmpopts.EquateEmpty()); len(diff) != 0 {
+		t.Errorf("unexpected extension (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,220 @@
+package status
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	operatorcontroller "github.com/openshift/cluster-ingress-operator/pkg/operator/controller"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// maxConditionHistoryEntries is the maximum number of transitions that
+	// the condition history retains for each ingresscontroller.
+	maxConditionHistoryEntries = 20
+	// maxConditionHistoryMessageLength is the maximum length of a
+	// condition message in the condition history.  Longer messages are
+	// truncated so that the configmap stays well within size limits.
+	maxConditionHistoryMessageLength = 256
+)
+
+// conditionTransition is an entry in an ingresscontroller's condition history.
+type conditionTransition struct {
+	// Time is the condition's lastTransitionTime.
+	Time metav1.Time `json:"time"`
+	// Type is the condition's type.
+	Type string `json:"type"`
+	// Status is the condition's status after the transition.
+	Status operatorv1.ConditionStatus `json:"status"`
+	// Reason is the condition's reason after the transition.
+	Reason string `json:"reason,omitempty"`
+	// Message is the condition's message after the transition, possibly
+	// truncated.
+	Message string `json:"message,omitempty"`
+}
+
+// ensureConditionHistory records transitions of the ingresscontrollers'
+// Available, Progressing, Degraded, and Upgradeable status conditions in the
+// condition history configmap and updates the related metrics.  The configmap
+// has a key for each ingresscontroller, the value of which is a JSON array of
+// the most recent transitions, oldest first.
+func (r *reconciler) ensureConditionHistory(ctx context.Context, ingresscontrollers []operatorv1.IngressController) error {
+	name := operatorcontroller.IngressControllerConditionHistoryConfigMapName(r.config.Namespace)
+	current := &corev1.ConfigMap{}
+	haveCurrent := true
+	if err := r.client.Get(ctx, name, current); err != nil {
+		if !errors.IsNotFound(err) {
+			return fmt.Errorf("failed to get configmap %s: %w", name, err)
+		}
+		haveCurrent = false
+	}
+
+	var currentData map[string]string
+	if haveCurrent {
+		currentData = current.Data
+	}
+	desiredData, transitions := computeConditionHistory(currentData, ingresscontrollers)
+
+	switch {
+	case !haveCurrent:
+		desired := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name.Name,
+				Namespace: name.Namespace,
+			},
+			Data: desiredData,
+		}
+		if err := r.client.Create(ctx, desired); err != nil {
+			return fmt.Errorf("failed to create configmap %s: %w", name, err)
+		}
+		log.Info("created configmap", "namespace", desired.Namespace, "name", desired.Name)
+	case conditionHistoryChanged(current.Data, desiredData):
+		updated := current.DeepCopy()
+		updated.Data = desiredData
+		if err := r.client.Update(ctx, updated); err != nil {
+			return fmt.Errorf("failed to update configmap %s: %w", name, err)
+		}
+		log.Info("updated configmap", "namespace", updated.Namespace, "name", updated.Name)
+	}
+
+	names := make(map[string]struct{}, len(ingresscontrollers))
+	for i := range ingresscontrollers {
+		ic := &ingresscontrollers[i]
+		names[ic.Name] = struct{}{}
+		for _, transition := range transitions[ic.Name] {
+			recordConditionTransition(ic.Name, transition.Type, transition.Status)
+		}
+		for _, cond := range ic.Status.Conditions {
+			if cond.Type == operatorv1.OperatorStatusTypeDegraded {
+				degradedDuration.observe(ic.Name, cond.Status == operatorv1.ConditionTrue, cond.LastTransitionTime.Time)
+			}
+		}
+	}
+	deleteStaleIngressControllerMetrics(names)
+
+	return nil
+}
+
+// computeConditionHistory returns the condition history configmap data for the
+// given ingresscontrollers, given the current data, as well as the transitions
+// that were observed since the current data were recorded, keyed by
+// ingresscontroller name.  The first observation of a condition is recorded in
+// the history but is not returned as a transition.  Keys for ingresscontrollers
+// that no longer exist are dropped, and keys with values that cannot be
+// decoded are reset.
+func computeConditionHistory(current map[string]string, ingresscontrollers []operatorv1.IngressController) (map[string]string, map[string][]conditionTransition) {
+	data := make(map[string]string, len(ingresscontrollers))
+	transitions := map[string][]conditionTransition{}
+	for i := range ingresscontrollers {
+		ic := &ingresscontrollers[i]
+		var history []conditionTransition
+		if value, ok := current[ic.Name]; ok {
+			if err := json.Unmarshal([]byte(value), &history); err != nil {
+				log.Error(err, "resetting malformed condition history", "ingresscontroller", ic.Name)
+				history = nil
+			}
+		}
+		changed := false
+		for _, conditionType := range summarizedConditions {
+			for _, cond := range ic.Status.Conditions {
+				if cond.Type != conditionType {
+					continue
+				}
+				last := lastConditionTransition(history, conditionType)
+				if last != nil && last.Status == cond.Status {
+					break
+				}
+				transition := conditionTransition{
+					Time:    cond.LastTransitionTime,
+					Type:    cond.Type,
+					Status:  cond.Status,
+					Reason:  cond.Reason,
+					Message: truncateMessage(cond.Message, maxConditionHistoryMessageLength),
+				}
+				history = append(history, transition)
+				changed = true
+				if last != nil {
+					transitions[ic.Name] = append(transitions[ic.Name], transition)
+				}
+				break
+			}
+		}
+		if !changed {
+			if value, ok := current[ic.Name]; ok {
+				data[ic.Name] = value
+				continue
+			}
+		}
+		history = boundConditionHistory(history, maxConditionHistoryEntries)
+		value, err := json.Marshal(history)
+		if err != nil {
+			log.Error(err, "failed to encode condition history", "ingresscontroller", ic.Name)
+			continue
+		}
+		data[ic.Name] = string(value)
+	}
+	return data, transitions
+}
+
+// conditionHistoryChanged returns a Boolean indicating whether the condition
+// history configmap data need to be updated from current to expected.
+func conditionHistoryChanged(current, expected map[string]string) bool {
+	if len(current) == 0 && len(expected) == 0 {
+		return false
+	}
+	return !reflect.DeepEqual(current, expected)
+}
+
+// boundConditionHistory drops the oldest entries from the given history until
+// it has at most max entries.  The most recent entry for each condition type
+// is always retained so that a condition that rarely transitions is not
+// mistaken for a newly observed one.
+func boundConditionHistory(history []conditionTransition, max int) []conditionTransition {
+	excess := len(history) - max
+	if excess <= 0 {
+		return history
+	}
+	bounded := make([]conditionTransition, 0, max)
+	for i := range history {
+		if excess > 0 && lastConditionTransition(history[i+1:], history[i].Type) != nil {
+			excess--
+			continue
+		}
+		bounded = append(bounded, history[i])
+	}
+	return bounded
+}
+
+// lastConditionTransition returns the most recent entry in the given history
+// for the given condition type, or nil if the history has no such entry.
+func lastConditionTransition(history []conditionTransition, conditionType string) *conditionTransition {
+	for i := len(history) - 1; i >= 0; i-- {
+		if history[i].Type == conditionType {
+			return &history[i]
+		}
+	}
+	return nil
+}
+
+// truncateMessage truncates the given message to at most max bytes without
+// splitting a multi-byte character, appending an ellipsis if the message is
+// truncated.
+func truncateMessage(message string, max int) string {
+	const ellipsis = "..."
+	if len(message) <= max {
+		return message
+	}
+	i := max - len(ellipsis)
+	for i > 0 && (message[i]&0xC0) == 0x80 {
+		i--
+	}
+	return message[:i] + ellipsis
+}
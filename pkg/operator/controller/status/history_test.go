@@ -0,0 +1,205 @@
+package status
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+// TestComputeConditionHistory verifies that computeConditionHistory records
+// the first observation of each condition and subsequent status changes, only
+// reports the latter as transitions, and drops ingresscontrollers that no
+// longer exist.
+func TestComputeConditionHistory(t *testing.T) {
+	t1 := metav1.NewTime(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC))
+	t2 := metav1.NewTime(t1.Add(time.Minute))
+	ic := func(name string, conditions ...operatorv1.OperatorCondition) operatorv1.IngressController {
+		return operatorv1.IngressController{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Status:     operatorv1.IngressControllerStatus{Conditions: conditions},
+		}
+	}
+	cond := func(conditionType string, status operatorv1.ConditionStatus, time metav1.Time) operatorv1.OperatorCondition {
+		return operatorv1.OperatorCondition{Type: conditionType, Status: status, LastTransitionTime: time, Reason: "Reason", Message: "message"}
+	}
+	encode := func(history ...conditionTransition) string {
+		data, err := json.Marshal(history)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(data)
+	}
+	transition := func(conditionType string, status operatorv1.ConditionStatus, time metav1.Time) conditionTransition {
+		return conditionTransition{Time: time, Type: conditionType, Status: status, Reason: "Reason", Message: "message"}
+	}
+
+	testCases := []struct {
+		name                string
+		current             map[string]string
+		ingresscontrollers  []operatorv1.IngressController
+		expectedData        map[string]string
+		expectedTransitions map[string][]conditionTransition
+	}{
+		{
+			name: "first observation",
+			ingresscontrollers: []operatorv1.IngressController{
+				ic("default",
+					cond("Admitted", operatorv1.ConditionTrue, t1),
+					cond("Degraded", operatorv1.ConditionFalse, t1),
+					cond("Available", operatorv1.ConditionTrue, t1),
+				),
+			},
+			expectedData: map[string]string{
+				"default": encode(
+					transition("Available", operatorv1.ConditionTrue, t1),
+					transition("Degraded", operatorv1.ConditionFalse, t1),
+				),
+			},
+			expectedTransitions: map[string][]conditionTransition{},
+		},
+		{
+			name: "no change",
+			current: map[string]string{
+				"default": encode(transition("Degraded", operatorv1.ConditionFalse, t1)),
+			},
+			ingresscontrollers: []operatorv1.IngressController{
+				ic("default", cond("Degraded", operatorv1.ConditionFalse, t1)),
+			},
+			expectedData: map[string]string{
+				"default": encode(transition("Degraded", operatorv1.ConditionFalse, t1)),
+			},
+			expectedTransitions: map[string][]conditionTransition{},
+		},
+		{
+			name: "transition",
+			current: map[string]string{
+				"default": encode(transition("Degraded", operatorv1.ConditionFalse, t1)),
+			},
+			ingresscontrollers: []operatorv1.IngressController{
+				ic("default", cond("Degraded", operatorv1.ConditionTrue, t2)),
+			},
+			expectedData: map[string]string{
+				"default": encode(
+					transition("Degraded", operatorv1.ConditionFalse, t1),
+					transition("Degraded", operatorv1.ConditionTrue, t2),
+				),
+			},
+			expectedTransitions: map[string][]conditionTransition{
+				"default": {transition("Degraded", operatorv1.ConditionTrue, t2)},
+			},
+		},
+		{
+			name: "deleted and malformed",
+			current: map[string]string{
+				"default": "bogus",
+				"deleted": encode(transition("Degraded", operatorv1.ConditionFalse, t1)),
+			},
+			ingresscontrollers: []operatorv1.IngressController{
+				ic("default", cond("Degraded", operatorv1.ConditionTrue, t2)),
+			},
+			expectedData: map[string]string{
+				"default": encode(transition("Degraded", operatorv1.ConditionTrue, t2)),
+			},
+			expectedTransitions: map[string][]conditionTransition{},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			data, transitions := computeConditionHistory(tc.current, tc.ingresscontrollers)
+			if diff := cmp.Diff(tc.expectedData, data); len(diff) != 0 {
+				t.Errorf("unexpected data (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.expectedTransitions, transitions); len(diff) != 0 {
+				t.Errorf("unexpected transitions (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestBoundConditionHistory verifies that boundConditionHistory drops the
+// oldest entries but retains the most recent entry for each condition type.
+func TestBoundConditionHistory(t *testing.T) {
+	history := []conditionTransition{
+		{Type: "Upgradeable", Status: operatorv1.ConditionTrue},
+		{Type: "Degraded", Status: operatorv1.ConditionFalse},
+		{Type: "Degraded", Status: operatorv1.ConditionTrue},
+		{Type: "Degraded", Status: operatorv1.ConditionFalse},
+		{Type: "Degraded", Status: operatorv1.ConditionTrue},
+	}
+	expected := []conditionTransition{
+		{Type: "Upgradeable", Status: operatorv1.ConditionTrue},
+		{Type: "Degraded", Status: operatorv1.ConditionFalse},
+		{Type: "Degraded", Status: operatorv1.ConditionTrue},
+	}
+	if diff := cmp.Diff(expected, boundConditionHistory(history, 3)); len(diff) != 0 {
+		t.Errorf("unexpected history (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(history, boundConditionHistory(history, maxConditionHistoryEntries)); len(diff) != 0 {
+		t.Errorf("unexpected history (-want +got):\n%s", diff)
+	}
+}
+
+// TestTruncateMessage verifies that truncateMessage bounds the length of a
+// message without splitting a multi-byte character.
+func TestTruncateMessage(t *testing.T) {
+	if actual := truncateMessage("short", 10); actual != "short" {
+		t.Errorf("expected %q, got %q", "short", actual)
+	}
+	if actual := truncateMessage("0123456789abc", 10); actual != "0123456..." {
+		t.Errorf("expected %q, got %q", "0123456...", actual)
+	}
+	actual := truncateMessage(strings.Repeat("é", 10), 10)
+	if len(actual) > 10 || actual != "ééé..." {
+		t.Errorf("expected %q, got %q", "ééé...", actual)
+	}
+}
+
+// TestDegradedDurationCollector verifies that the
+// ingress_controller_degraded_seconds_total metric accumulates the time that an
+// ingresscontroller spends Degraded, including the current period, and stops
+// being reported once the ingresscontroller is forgotten.
+func TestDegradedDurationCollector(t *testing.T) {
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	fakeClock := clocktesting.NewFakeClock(start)
+	oldClock := clock
+	clock = fakeClock
+	defer func() { clock = oldClock }()
+
+	c := newDegradedDurationCollector()
+	expectValue := func(expected float64) {
+		t.Helper()
+		if actual := testutil.ToFloat64(c); actual != expected {
+			t.Errorf("expected %v, got %v", expected, actual)
+		}
+	}
+
+	c.observe("default", false, start)
+	expectValue(0)
+
+	fakeClock.SetTime(start.Add(time.Minute))
+	c.observe("default", true, start.Add(10*time.Second))
+	expectValue(50)
+
+	fakeClock.SetTime(start.Add(2 * time.Minute))
+	c.observe("default", true, start.Add(10*time.Second))
+	expectValue(110)
+
+	c.observe("default", false, start.Add(70*time.Second))
+	fakeClock.SetTime(start.Add(time.Hour))
+	expectValue(60)
+
+	c.forget(map[string]struct{}{})
+	if n := testutil.CollectAndCount(c); n != 0 {
+		t.Errorf("expected no metrics, got %d", n)
+	}
+}
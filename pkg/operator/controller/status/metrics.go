@@ -0,0 +1,174 @@
+package status
+
+import (
+	"sync"
+	"time"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	// conditionTransitions counts the transitions of each
+	// ingresscontroller's status conditions that the status controller
+	// records in the condition history.
+	conditionTransitions = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "ingress_controller_condition_transitions_total",
+		Help: "Report the number of transitions of the status conditions of ingress controllers.",
+	}, []string{"name", "condition", "status"})
+
+	// degradedDuration reports the time that each ingresscontroller has
+	// spent Degraded.
+	degradedDuration = newDegradedDurationCollector()
+
+	// metricsList is a list of metrics for this package.
+	metricsList = []prometheus.Collector{
+		conditionTransitions,
+		degradedDuration,
+	}
+
+	// transitionsMetricNames is the set of ingresscontroller names for which
+	// the conditionTransitions metric has values.
+	transitionsMetricNames = map[string]struct{}{}
+	// transitionsMetricNamesLock guards transitionsMetricNames.
+	transitionsMetricNamesLock sync.Mutex
+)
+
+// recordConditionTransition increments the
+// ingress_controller_condition_transitions_total metric for the given
+// ingresscontroller, condition type, and status.
+func recordConditionTransition(name, conditionType string, status operatorv1.ConditionStatus) {
+	transitionsMetricNamesLock.Lock()
+	defer transitionsMetricNamesLock.Unlock()
+
+	transitionsMetricNames[name] = struct{}{}
+	conditionTransitions.WithLabelValues(name, conditionType, string(status)).Inc()
+}
+
+// deleteStaleIngressControllerMetrics deletes the metrics for ingresscontrollers
+// other than the given ones.
+func deleteStaleIngressControllerMetrics(names map[string]struct{}) {
+	transitionsMetricNamesLock.Lock()
+	defer transitionsMetricNamesLock.Unlock()
+
+	for name := range transitionsMetricNames {
+		if _, ok := names[name]; ok {
+			continue
+		}
+		for _, conditionType := range summarizedConditions {
+			for _, status := range []operatorv1.ConditionStatus{operatorv1.ConditionTrue, operatorv1.ConditionFalse, operatorv1.ConditionUnknown} {
+				conditionTransitions.DeleteLabelValues(name, conditionType, string(status))
+			}
+		}
+		delete(transitionsMetricNames, name)
+	}
+	degradedDuration.forget(names)
+}
+
+// degradedDurationCollector is a prometheus collector for the
+// ingress_controller_degraded_seconds_total metric.  The value of the metric
+// keeps increasing while an ingresscontroller is Degraded, so the collector
+// computes it when it is scraped rather than when the status controller
+// observes the ingresscontroller.
+type degradedDurationCollector struct {
+	desc *prometheus.Desc
+
+	lock sync.Mutex
+	// ingresscontrollers maps each ingresscontroller's name to the time it
+	// has spent Degraded.
+	ingresscontrollers map[string]*degradedTime
+}
+
+// degradedTime is the time that an ingresscontroller has spent Degraded.
+type degradedTime struct {
+	// accumulated is the time that the ingresscontroller spent Degraded
+	// before it most recently became not Degraded.
+	accumulated time.Duration
+	// since is the time at which the ingresscontroller most recently
+	// became Degraded, or the zero value if it is not Degraded.
+	since time.Time
+}
+
+func newDegradedDurationCollector() *degradedDurationCollector {
+	return &degradedDurationCollector{
+		desc: prometheus.NewDesc(
+			"ingress_controller_degraded_seconds_total",
+			"Report the number of seconds that ingress controllers have spent Degraded.",
+			[]string{"name"},
+			nil,
+		),
+		ingresscontrollers: map[string]*degradedTime{},
+	}
+}
+
+// observe records whether the given ingresscontroller is Degraded, and the
+// time at which its Degraded status condition last transitioned.
+func (c *degradedDurationCollector) observe(name string, degraded bool, lastTransitionTime time.Time) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	t, ok := c.ingresscontrollers[name]
+	if !ok {
+		t = &degradedTime{}
+		c.ingresscontrollers[name] = t
+	}
+	switch {
+	case degraded && t.since.IsZero():
+		t.since = lastTransitionTime
+		if t.since.IsZero() {
+			t.since = clock.Now()
+		}
+	case !degraded && !t.since.IsZero():
+		end := lastTransitionTime
+		if end.Before(t.since) {
+			end = clock.Now()
+		}
+		t.accumulated += end.Sub(t.since)
+		t.since = time.Time{}
+	}
+}
+
+// forget stops reporting the metric for ingresscontrollers other than the
+// given ones.
+func (c *degradedDurationCollector) forget(names map[string]struct{}) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	for name := range c.ingresscontrollers {
+		if _, ok := names[name]; !ok {
+			delete(c.ingresscontrollers, name)
+		}
+	}
+}
+
+// Describe implements prometheus.Collector.
+func (c *degradedDurationCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+}
+
+// Collect implements prometheus.Collector.
+func (c *degradedDurationCollector) Collect(ch chan<- prometheus.Metric) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	now := clock.Now()
+	for name, t := range c.ingresscontrollers {
+		d := t.accumulated
+		if !t.since.IsZero() && now.After(t.since) {
+			d += now.Sub(t.since)
+		}
+		ch <- prometheus.MustNewConstMetric(c.desc, prometheus.CounterValue, d.Seconds(), name)
+	}
+}
+
+// RegisterMetrics calls prometheus.Register on each metric in metricsList, and
+// returns on errors.
+func RegisterMetrics() error {
+	for _, metric := range metricsList {
+		if err := prometheus.Register(metric); err != nil {
+			return err
+		}
+	}
+	return nil
+}
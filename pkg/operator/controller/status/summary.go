@@ -0,0 +1,100 @@
+package status
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	configv1 "github.com/openshift/api/config/v1"
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// summarizedConditions are the ingresscontroller status conditions that the
+// controller summarizes in the clusteroperator's status extension and records
+// in the condition history, in the order in which they are reported.
+var summarizedConditions = []string{
+	operatorv1.OperatorStatusTypeAvailable,
+	operatorv1.OperatorStatusTypeProgressing,
+	operatorv1.OperatorStatusTypeDegraded,
+	operatorv1.OperatorStatusTypeUpgradeable,
+}
+
+// operatorStatusExtension is the operator-specific status that the controller
+// publishes in the clusteroperator's status.extension field.
+type operatorStatusExtension struct {
+	// IngressControllers summarizes the status conditions of each
+	// ingresscontroller, sorted by name.
+	IngressControllers []ingressControllerStatusSummary `json:"ingressControllers"`
+}
+
+// ingressControllerStatusSummary summarizes an ingresscontroller's status
+// conditions.
+type ingressControllerStatusSummary struct {
+	// RelatedObject is the ingresscontroller's entry in the
+	// clusteroperator's status.relatedObjects field.
+	RelatedObject configv1.ObjectReference `json:"relatedObject"`
+	// Conditions are the ingresscontroller's Available, Progressing,
+	// Degraded, and Upgradeable status conditions, if it reports them.
+	Conditions []operatorv1.OperatorCondition `json:"conditions,omitempty"`
+}
+
+// ingressControllerRelatedObject returns the clusteroperator's relatedObjects
+// entry for the given ingresscontroller.
+func ingressControllerRelatedObject(ic *operatorv1.IngressController) configv1.ObjectReference {
+	return configv1.ObjectReference{
+		Group:     operatorv1.GroupName,
+		Resource:  "ingresscontrollers",
+		Namespace: ic.Namespace,
+		Name:      ic.Name,
+	}
+}
+
+// computeOperatorStatusExtension returns the clusteroperator's status
+// extension, which breaks down the operator's conditions by ingresscontroller.
+func computeOperatorStatusExtension(ingresscontrollers []operatorv1.IngressController) (runtime.RawExtension, error) {
+	extension := operatorStatusExtension{
+		IngressControllers: make([]ingressControllerStatusSummary, 0, len(ingresscontrollers)),
+	}
+	for i := range ingresscontrollers {
+		ic := &ingresscontrollers[i]
+		summary := ingressControllerStatusSummary{RelatedObject: ingressControllerRelatedObject(ic)}
+		for _, conditionType := range summarizedConditions {
+			for _, cond := range ic.Status.Conditions {
+				if cond.Type == conditionType {
+					summary.Conditions = append(summary.Conditions, cond)
+					break
+				}
+			}
+		}
+		extension.IngressControllers = append(extension.IngressControllers, summary)
+	}
+	sort.Slice(extension.IngressControllers, func(i, j int) bool {
+		return extension.IngressControllers[i].RelatedObject.Name < extension.IngressControllers[j].RelatedObject.Name
+	})
+	data, err := json.Marshal(extension)
+	if err != nil {
+		return runtime.RawExtension{}, err
+	}
+	return runtime.RawExtension{Raw: data}, nil
+}
+
+// operatorStatusExtensionsEqual compares two clusteroperator status extensions.
+// The API server may serialize an extension differently from the way that the
+// controller does, so the extensions are compared after decoding them.
+func operatorStatusExtensionsEqual(a, b runtime.RawExtension) bool {
+	if len(a.Raw) == 0 || len(b.Raw) == 0 {
+		return len(a.Raw) == len(b.Raw)
+	}
+	var x, y operatorStatusExtension
+	if err := json.Unmarshal(a.Raw, &x); err != nil {
+		return false
+	}
+	if err := json.Unmarshal(b.Raw, &y); err != nil {
+		return false
+	}
+	return cmp.Equal(x, y, cmpopts.EquateEmpty())
+}
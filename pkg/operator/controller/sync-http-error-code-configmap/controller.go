@@ -9,6 +9,7 @@ import (
 	operatorv1 "github.com/openshift/api/operator/v1"
 	logf "github.com/openshift/cluster-ingress-operator/pkg/log"
 	"github.com/openshift/cluster-ingress-operator/pkg/operator/controller"
+	ingresscontroller "github.com/openshift/cluster-ingress-operator/pkg/operator/controller/ingress"
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -218,7 +219,7 @@ func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{}, fmt.Errorf("failed to get ingresscontroller: %w", err)
 	}
 	deployment := &appsv1.Deployment{}
-	if err := r.client.Get(ctx, controller.RouterDeploymentName(ingress), deployment); err != nil {
+	if err := r.client.Get(ctx, controller.RouterDeploymentName(ingress, r.config.OperandNamespace), deployment); err != nil {
 		if errors.IsNotFound(err) {
 			log.Info("deployment not found; will retry configmap sync", "ingresscontroller", ingress.Name)
 			return reconcile.Result{RequeueAfter: 5 * time.Second}, nil
@@ -237,7 +238,21 @@ func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	if err := r.cache.List(ctx, controllers, client.InNamespace(r.config.OperatorNamespace)); err != nil {
 		return reconcile.Result{}, fmt.Errorf("failed to list ingresscontrollers: %w", err)
 	}
-	if _, _, err := r.ensureHttpErrorCodeConfigMap(ingress, deploymentRef); err != nil {
+	sourceName := types.NamespacedName{
+		Namespace: r.config.ConfigNamespace,
+		Name:      ingress.Spec.HttpErrorCodePages.Name,
+	}
+	haveSource, source, err := r.currentHttpErrorCodeConfigMap(sourceName)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to get errorpage configmap %s: %w", sourceName, err)
+	}
+	// Sync only the valid error pages so that an invalid page cannot
+	// break the router.  The ingress controller reports the invalid pages
+	// in the ingresscontroller's status.
+	if haveSource {
+		source, _ = ingresscontroller.ValidateHttpErrorCodePages(ingress, source)
+	}
+	if _, _, err := r.ensureHttpErrorCodeConfigMap(ingress, haveSource, source, deploymentRef); err != nil {
 		return reconcile.Result{}, fmt.Errorf("failed to ensure errorpage configmap for ingresscontroller %q: %w", ingress.Name, err)
 	}
 	return reconcile.Result{}, nil
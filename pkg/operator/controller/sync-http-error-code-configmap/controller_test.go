@@ -4,6 +4,10 @@ import (
 	"reflect"
 	"testing"
 
+	ingresscontroller "github.com/openshift/cluster-ingress-operator/pkg/operator/controller/ingress"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 
@@ -90,10 +94,10 @@ func TestDesiredHttpErrorCodeConfigMap(t *testing.T) {
 						Name:      "my-custom-error-code-pages",
 						Namespace: "openshift-ingress",
 					},
-					Data: map[string]string{
+					Data: withDefaultErrorPages(map[string]string{
 						"error-page-404.http": errorpage404,
 						"error-page-503.http": errorpage503,
-					},
+					}),
 				},
 			},
 		},
@@ -108,10 +112,9 @@ func TestDesiredHttpErrorCodeConfigMap(t *testing.T) {
 						Name:      "my-custom-error-code-pages",
 						Namespace: "openshift-ingress",
 					},
-					Data: map[string]string{
+					Data: withDefaultErrorPages(map[string]string{
 						"error-page-404.http": errorpage404,
-						"error-page-503.http": DEFAULT_503_ERROR_PAGE,
-					},
+					}),
 				},
 			},
 		},
@@ -126,10 +129,9 @@ func TestDesiredHttpErrorCodeConfigMap(t *testing.T) {
 						Name:      "my-custom-error-code-pages",
 						Namespace: "openshift-ingress",
 					},
-					Data: map[string]string{
-						"error-page-404.http": DEFAULT_404_ERROR_PAGE,
+					Data: withDefaultErrorPages(map[string]string{
 						"error-page-503.http": errorpage503,
-					},
+					}),
 				},
 			},
 		},
@@ -159,3 +161,60 @@ func TestDesiredHttpErrorCodeConfigMap(t *testing.T) {
 		}
 	}
 }
+
+// withDefaultErrorPages returns the given error pages along with the default
+// error page for every other supported status code.
+func withDefaultErrorPages(pages map[string]string) map[string]string {
+	data := map[string]string{}
+	for _, code := range ingresscontroller.SupportedHttpErrorCodes {
+		key := ingresscontroller.HttpErrorCodePageKey(code)
+		if page, ok := pages[key]; ok {
+			data[key] = page
+		} else {
+			data[key] = defaultHttpErrorCodePage(code)
+		}
+	}
+	return data
+}
+
+// TestDesiredHttpErrorCodeConfigMapUnsupportedCodes verifies that
+// desiredHttpErrorCodeConfigMap copies error pages for every status code that
+// the router serves and not for other status codes.
+func TestDesiredHttpErrorCodeConfigMapUnsupportedCodes(t *testing.T) {
+	const (
+		page500 = "HTTP/1.1 500 Internal Server Error\r\n\r\n"
+		page401 = "HTTP/1.1 401 Unauthorized\r\n\r\n"
+		page418 = "HTTP/1.1 418 I'm a teapot\r\n\r\n"
+	)
+	source := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-custom-error-code-pages", Namespace: "openshift-config"},
+		Data: map[string]string{
+			"error-page-401.http": page401,
+			"error-page-418.http": page418,
+			"error-page-500.http": page500,
+		},
+	}
+	name := types.NamespacedName{Name: "default-errorpages", Namespace: "openshift-ingress"}
+	_, actual, err := desiredHttpErrorCodeConfigMap(true, source, name, metav1.OwnerReference{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := withDefaultErrorPages(map[string]string{"error-page-500.http": page500})
+	if !reflect.DeepEqual(expected, actual.Data) {
+		t.Errorf("expected keys %v, got %v", expected, actual.Data)
+	}
+}
+
+// TestDefaultHttpErrorCodePage verifies that the default error page for every
+// supported status code is a valid error page for that code.
+func TestDefaultHttpErrorCodePage(t *testing.T) {
+	ic := &operatorv1.IngressController{}
+	source := &corev1.ConfigMap{Data: withDefaultErrorPages(nil)}
+	valid, err := ingresscontroller.ValidateHttpErrorCodePages(ic, source)
+	if err != nil {
+		t.Fatalf("expected the default error pages to be valid, got %v", err)
+	}
+	if len(valid.Data) != len(ingresscontroller.SupportedHttpErrorCodes) {
+		t.Errorf("expected %d valid error pages, got %d", len(ingresscontroller.SupportedHttpErrorCodes), len(valid.Data))
+	}
+}
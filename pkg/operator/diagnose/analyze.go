@@ -0,0 +1,390 @@
+package diagnose
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	configv1 "github.com/openshift/api/config/v1"
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	ingresscontroller "github.com/openshift/cluster-ingress-operator/pkg/operator/controller/ingress"
+
+	corev1 "k8s.io/api/core/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// maxEventFindings is the maximum number of warning events that
+	// Analyze reports for each ingresscontroller.
+	maxEventFindings = 5
+)
+
+// Finding is a likely root cause of a problem with an ingresscontroller.
+type Finding struct {
+	// IngressController is the name of the ingresscontroller to which the
+	// finding applies, or empty if the finding applies to all
+	// ingresscontrollers.
+	IngressController string
+	// Source is what the finding is based on, such as a condition type, a
+	// pod, or an event.
+	Source string
+	// Message describes the problem.
+	Message string
+	// Hint suggests what to check or do about the problem.
+	Hint string
+}
+
+// Analysis is the result of analyzing a snapshot.
+type Analysis struct {
+	// ComputedStatus maps the names of the ingresscontrollers in the
+	// snapshot to the status that the operator would compute for them
+	// from the objects in the snapshot.
+	ComputedStatus map[string]operatorv1.IngressControllerStatus
+	// Findings are the likely root causes of problems, ordered by
+	// ingresscontroller.
+	Findings []Finding
+}
+
+// problemCondition describes a status condition that indicates a problem, and
+// what to check when the condition has the problem status.
+type problemCondition struct {
+	conditionType string
+	status        operatorv1.ConditionStatus
+	// reason, if not empty, is the reason that the condition must have
+	// to indicate a problem.
+	reason string
+	hint   string
+}
+
+// problemConditions are the ingresscontroller status conditions that indicate
+// problems.  The aggregate Available, Progressing, and Degraded conditions are
+// omitted because they only summarize these conditions.
+var problemConditions = []problemCondition{{
+	conditionType: ingresscontroller.IngressControllerAdmittedConditionType,
+	status:        operatorv1.ConditionFalse,
+	hint:          "The ingresscontroller's spec is invalid; correct the spec as the message describes.",
+}, {
+	conditionType: ingresscontroller.IngressControllerPodsScheduledConditionType,
+	status:        operatorv1.ConditionFalse,
+	hint:          "Check the ingresscontroller's node placement, the nodes' taints and capacity, and the pods' scheduling events.",
+}, {
+	conditionType: ingresscontroller.IngressControllerDeploymentAvailableConditionType,
+	status:        operatorv1.ConditionFalse,
+	hint:          "Check the router pods' status, events, and logs.",
+}, {
+	conditionType: ingresscontroller.IngressControllerDeploymentReplicasMinAvailableConditionType,
+	status:        operatorv1.ConditionFalse,
+	hint:          "Check the router pods' status, events, and logs, and whether enough nodes match the node placement.",
+}, {
+	conditionType: ingresscontroller.IngressControllerDeploymentReplicasAllAvailableConditionType,
+	status:        operatorv1.ConditionFalse,
+	hint:          "Check the router pods' status, events, and logs.",
+}, {
+	conditionType: ingresscontroller.IngressControllerStagedRolloutVerifiedConditionType,
+	status:        operatorv1.ConditionFalse,
+	hint:          "The canary pods of a staged rollout failed verification; check the canary router pods' logs before continuing the rollout.",
+}, {
+	conditionType: ingresscontroller.IngressControllerRateLimitingConditionType,
+	status:        operatorv1.ConditionFalse,
+	reason:        "InvalidConfiguration",
+	hint:          "Correct the rate limiting configuration as the message describes.",
+}, {
+	conditionType: operatorv1.LoadBalancerReadyIngressConditionType,
+	status:        operatorv1.ConditionFalse,
+	hint:          "Check the load balancer service's events and the cloud provider's quotas and permissions.",
+}, {
+	conditionType: ingresscontroller.IngressControllerLoadBalancerProgressingConditionType,
+	status:        operatorv1.ConditionTrue,
+	hint:          "The load balancer needs manual intervention to apply a change; follow the instructions in the message.",
+}, {
+	conditionType: operatorv1.DNSReadyIngressConditionType,
+	status:        operatorv1.ConditionFalse,
+	hint:          "Check the wildcard dnsrecord's status, the cluster DNS configuration's zones, and the cloud credentials.",
+}, {
+	conditionType: ingresscontroller.IngressControllerCanaryCheckSuccessConditionType,
+	status:        operatorv1.ConditionFalse,
+	hint:          "Requests to the canary route through this ingresscontroller are failing; check the router logs, the load balancer, and DNS resolution of the canary route's host.",
+}, {
+	conditionType: ingresscontroller.IngressControllerHTTPErrorCodePagesValidConditionType,
+	status:        operatorv1.ConditionFalse,
+	hint:          "Correct the custom error code pages configmap as the message describes.",
+}}
+
+// problemContainerReasons maps reasons for which a container may be waiting or
+// may have terminated to hints about what to check.
+var problemContainerReasons = map[string]string{
+	"CrashLoopBackOff":           "The container keeps exiting; check its log and previous log.",
+	"ImagePullBackOff":           "The image cannot be pulled; check the image pullspec, the pull secret, and the registry.",
+	"ErrImagePull":               "The image cannot be pulled; check the image pullspec, the pull secret, and the registry.",
+	"CreateContainerConfigError": "The container cannot be created; check that the secrets and configmaps that it references exist.",
+	"OOMKilled":                  "The container ran out of memory; check the number of routes and connections and the container's memory limit.",
+}
+
+// Analyze computes the status of each ingresscontroller in the snapshot and
+// returns the likely root causes of problems.
+func Analyze(s *Snapshot) *Analysis {
+	analysis := &Analysis{ComputedStatus: map[string]operatorv1.IngressControllerStatus{}}
+
+	for i := range s.IngressControllers {
+		ics := &s.IngressControllers[i]
+		findings, status := analyzeIngressController(s, ics)
+		analysis.Findings = append(analysis.Findings, findings...)
+		if status != nil {
+			analysis.ComputedStatus[ics.IngressController.Name] = *status
+		}
+	}
+
+	if ds := s.Canary.DaemonSet; ds != nil && ds.Status.NumberAvailable < ds.Status.DesiredNumberScheduled {
+		analysis.Findings = append(analysis.Findings, Finding{
+			Source:  "DaemonSet " + ds.Name,
+			Message: fmt.Sprintf("%d of %d canary pods are available.", ds.Status.NumberAvailable, ds.Status.DesiredNumberScheduled),
+			Hint:    "Canary checks may fail on nodes without a canary pod; check the canary pods' status and events.",
+		})
+	}
+	for i := range s.Canary.Pods {
+		analysis.Findings = append(analysis.Findings, podFindings("", &s.Canary.Pods[i])...)
+	}
+
+	return analysis
+}
+
+// analyzeIngressController returns findings for the given ingresscontroller
+// and its computed status, or nil if its status cannot be computed.
+func analyzeIngressController(s *Snapshot, ics *IngressControllerSnapshot) ([]Finding, *operatorv1.IngressControllerStatus) {
+	ic := ics.IngressController
+	var findings []Finding
+	add := func(source, message, hint string) {
+		findings = append(findings, Finding{IngressController: ic.Name, Source: source, Message: message, Hint: hint})
+	}
+
+	if ics.Deployment == nil {
+		hint := "Check that the operator is running and the operator's logs."
+		if cond := findCondition(ic.Status.Conditions, ingresscontroller.IngressControllerAdmittedConditionType); cond != nil && cond.Status == operatorv1.ConditionFalse {
+			hint = "The ingresscontroller is not admitted: " + cond.Message
+		}
+		add("Deployment", "The router deployment does not exist.", hint)
+		return findings, nil
+	}
+
+	// The operator computes status only after admission sets the endpoint
+	// publishing strategy in status, and the computation depends on it.
+	if ic.Status.EndpointPublishingStrategy == nil {
+		add("Status", "The ingresscontroller's status.endpointPublishingStrategy is not set.", "The operator may not have admitted the ingresscontroller yet; check the operator's logs.")
+		return findings, nil
+	}
+
+	platformStatus := &configv1.PlatformStatus{}
+	if s.Infrastructure != nil && s.Infrastructure.Status.PlatformStatus != nil {
+		platformStatus = s.Infrastructure.Status.PlatformStatus
+	}
+	dnsConfig := &configv1.DNS{}
+	if s.DNSConfig != nil {
+		dnsConfig = s.DNSConfig
+	}
+	trueVar := true
+	deploymentRef := metav1.OwnerReference{
+		APIVersion: "apps/v1",
+		Kind:       "Deployment",
+		Name:       ics.Deployment.Name,
+		UID:        ics.Deployment.UID,
+		Controller: &trueVar,
+	}
+	computed, err := ingresscontroller.ComputeIngressControllerStatus(ic, ics.Deployment, deploymentRef, ics.Pods, s.Nodes, ics.LoadBalancerService, s.OperandEvents, ics.WildcardRecord, dnsConfig, platformStatus, ics.DefaultCertificate, ics.ErrorPagesConfigMap, s.ConfigNamespace)
+	if computed == nil {
+		add("Status", fmt.Sprintf("Failed to compute status: %v", err), "Check the router deployment's spec.")
+		return findings, nil
+	}
+
+	for _, conditionType := range []string{operatorv1.OperatorStatusTypeAvailable, operatorv1.OperatorStatusTypeProgressing, operatorv1.OperatorStatusTypeDegraded} {
+		stored, current := conditionStatus(ic.Status.Conditions, conditionType), conditionStatus(computed.Status.Conditions, conditionType)
+		if stored != current {
+			add("Status", fmt.Sprintf("The stored %s condition is %s, but the objects in the cluster indicate %s.", conditionType, stored, current), "The operator may not be running or may be failing to reconcile the ingresscontroller; check the operator's logs.")
+		}
+	}
+
+	for _, pc := range problemConditions {
+		cond := findCondition(computed.Status.Conditions, pc.conditionType)
+		if cond == nil || cond.Status != pc.status || (len(pc.reason) != 0 && cond.Reason != pc.reason) {
+			continue
+		}
+		add(pc.conditionType, fmt.Sprintf("%s=%s (%s): %s", cond.Type, cond.Status, cond.Reason, cond.Message), pc.hint)
+	}
+
+	for i := range ics.Pods {
+		findings = append(findings, podFindings(ic.Name, &ics.Pods[i])...)
+	}
+
+	findings = append(findings, eventFindings(ic.Name, ics, s.OperandEvents)...)
+
+	return findings, &computed.Status
+}
+
+// podFindings returns findings for the given pod's containers that are waiting
+// or have terminated for a reason that indicates a problem.
+func podFindings(icName string, pod *corev1.Pod) []Finding {
+	var findings []Finding
+	for _, status := range pod.Status.ContainerStatuses {
+		reason := ""
+		switch {
+		case status.State.Waiting != nil:
+			reason = status.State.Waiting.Reason
+		case status.LastTerminationState.Terminated != nil:
+			reason = status.LastTerminationState.Terminated.Reason
+		}
+		hint, ok := problemContainerReasons[reason]
+		if !ok {
+			continue
+		}
+		findings = append(findings, Finding{
+			IngressController: icName,
+			Source:            "Pod " + pod.Name,
+			Message:           fmt.Sprintf("Container %q is %s and has restarted %d times.", status.Name, reason, status.RestartCount),
+			Hint:              hint,
+		})
+	}
+	return findings
+}
+
+// eventFindings returns findings for the most recent warning events about the
+// given ingresscontroller's deployment, pods, and services.
+func eventFindings(icName string, ics *IngressControllerSnapshot, events []corev1.Event) []Finding {
+	involved := map[string]bool{ics.Deployment.Name: true}
+	for _, pod := range ics.Pods {
+		involved[pod.Name] = true
+	}
+	for _, service := range []*corev1.Service{ics.LoadBalancerService, ics.InternalService, ics.NodePortService} {
+		if service != nil {
+			involved[service.Name] = true
+		}
+	}
+
+	var warnings []corev1.Event
+	for _, event := range events {
+		if event.Type == corev1.EventTypeWarning && involved[event.InvolvedObject.Name] {
+			warnings = append(warnings, event)
+		}
+	}
+	sort.Slice(warnings, func(i, j int) bool {
+		return eventTime(&warnings[i]).After(eventTime(&warnings[j]).Time)
+	})
+	if len(warnings) > maxEventFindings {
+		warnings = warnings[:maxEventFindings]
+	}
+
+	var findings []Finding
+	for _, event := range warnings {
+		findings = append(findings, Finding{
+			IngressController: icName,
+			Source:            fmt.Sprintf("Event %s on %s %s", event.Reason, event.InvolvedObject.Kind, event.InvolvedObject.Name),
+			Message:           fmt.Sprintf("%s (count %d, last seen %s)", strings.TrimSpace(event.Message), event.Count, eventTime(&event).UTC().Format("2006-01-02T15:04:05Z")),
+		})
+	}
+	return findings
+}
+
+// eventTime returns the time at which the given event was last observed.
+func eventTime(event *corev1.Event) metav1.Time {
+	if !event.LastTimestamp.IsZero() {
+		return event.LastTimestamp
+	}
+	if !event.EventTime.IsZero() {
+		return metav1.Time{Time: event.EventTime.Time}
+	}
+	return event.CreationTimestamp
+}
+
+// findCondition returns the condition of the given type, or nil if there is
+// none.
+func findCondition(conditions []operatorv1.OperatorCondition, conditionType string) *operatorv1.OperatorCondition {
+	for i := range conditions {
+		if conditions[i].Type == conditionType {
+			return &conditions[i]
+		}
+	}
+	return nil
+}
+
+// conditionStatus returns the status of the condition of the given type, or
+// "Missing" if there is none.
+func conditionStatus(conditions []operatorv1.OperatorCondition, conditionType string) string {
+	if cond := findCondition(conditions, conditionType); cond != nil {
+		return string(cond.Status)
+	}
+	return "Missing"
+}
+
+// WriteSummary writes a human-readable summary of the snapshot and analysis.
+func WriteSummary(w io.Writer, s *Snapshot, a *Analysis) error {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "Ingress diagnostics collected at %s\n", s.CollectedAt.UTC().Format("2006-01-02T15:04:05Z"))
+	if co := s.ClusterOperator; co != nil {
+		fmt.Fprintf(&b, "\nClusterOperator %s:", co.Name)
+		for _, conditionType := range []configv1.ClusterStatusConditionType{configv1.OperatorAvailable, configv1.OperatorProgressing, configv1.OperatorDegraded, configv1.OperatorUpgradeable} {
+			for _, cond := range co.Status.Conditions {
+				if cond.Type == conditionType {
+					fmt.Fprintf(&b, " %s=%s", cond.Type, cond.Status)
+				}
+			}
+		}
+		fmt.Fprintln(&b)
+	}
+
+	for _, ics := range s.IngressControllers {
+		name := ics.IngressController.Name
+		fmt.Fprintf(&b, "\nIngressController %s:\n", name)
+		fmt.Fprintf(&b, "  Stored status:   %s\n", aggregateConditions(ics.IngressController.Status.Conditions))
+		if status, ok := a.ComputedStatus[name]; ok {
+			fmt.Fprintf(&b, "  Computed status: %s\n", aggregateConditions(status.Conditions))
+		} else {
+			fmt.Fprintf(&b, "  Computed status: unavailable\n")
+		}
+		writeFindings(&b, a.Findings, name)
+	}
+
+	fmt.Fprintf(&b, "\nCanary:\n")
+	writeFindings(&b, a.Findings, "")
+
+	if len(s.Errors) != 0 {
+		fmt.Fprintf(&b, "\nCollection errors:\n")
+		for _, err := range s.Errors {
+			fmt.Fprintf(&b, "  - %s\n", err)
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// writeFindings writes the findings for the named ingresscontroller.
+func writeFindings(b *strings.Builder, findings []Finding, icName string) {
+	found := false
+	for _, f := range findings {
+		if f.IngressController != icName {
+			continue
+		}
+		if !found {
+			fmt.Fprintf(b, "  Likely root causes:\n")
+			found = true
+		}
+		fmt.Fprintf(b, "  - [%s] %s\n", f.Source, f.Message)
+		if len(f.Hint) != 0 {
+			fmt.Fprintf(b, "    Hint: %s\n", f.Hint)
+		}
+	}
+	if !found {
+		fmt.Fprintf(b, "  No problems found.\n")
+	}
+}
+
+// aggregateConditions formats the statuses of the Available, Progressing, and
+// Degraded conditions.
+func aggregateConditions(conditions []operatorv1.OperatorCondition) string {
+	return fmt.Sprintf("Available=%s Progressing=%s Degraded=%s",
+		conditionStatus(conditions, operatorv1.OperatorStatusTypeAvailable),
+		conditionStatus(conditions, operatorv1.OperatorStatusTypeProgressing),
+		conditionStatus(conditions, operatorv1.OperatorStatusTypeDegraded))
+}
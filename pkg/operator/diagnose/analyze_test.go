@@ -0,0 +1,148 @@
+package diagnose
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	configv1 "github.com/openshift/api/config/v1"
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	operatorcontroller "github.com/openshift/cluster-ingress-operator/pkg/operator/controller"
+	ingresscontroller "github.com/openshift/cluster-ingress-operator/pkg/operator/controller/ingress"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TestAnalyze verifies that Analyze computes the status of an ingresscontroller
+// from a snapshot and reports unschedulable and crashing router pods, and a
+// stored status that does not match the computed status.
+func TestAnalyze(t *testing.T) {
+	ic := &operatorv1.IngressController{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "openshift-ingress-operator", Name: "default"},
+		Status: operatorv1.IngressControllerStatus{
+			EndpointPublishingStrategy: &operatorv1.EndpointPublishingStrategy{Type: operatorv1.HostNetworkStrategyType},
+			Conditions: []operatorv1.OperatorCondition{{
+				Type:   operatorv1.OperatorStatusTypeAvailable,
+				Status: operatorv1.ConditionTrue,
+			}},
+		},
+	}
+	podLabels := operatorcontroller.IngressControllerDeploymentPodSelector(ic).MatchLabels
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "openshift-ingress", Name: "router-default"},
+		Spec: appsv1.DeploymentSpec{
+			Selector: operatorcontroller.IngressControllerDeploymentPodSelector(ic),
+		},
+		Status: appsv1.DeploymentStatus{
+			Conditions: []appsv1.DeploymentCondition{{
+				Type:    appsv1.DeploymentAvailable,
+				Status:  corev1.ConditionFalse,
+				Reason:  "MinimumReplicasUnavailable",
+				Message: "Deployment does not have minimum availability.",
+			}},
+		},
+	}
+	pods := []corev1.Pod{{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "openshift-ingress", Name: "router-default-a", Labels: podLabels},
+		Status: corev1.PodStatus{
+			Conditions: []corev1.PodCondition{{
+				Type:    corev1.PodScheduled,
+				Status:  corev1.ConditionFalse,
+				Reason:  corev1.PodReasonUnschedulable,
+				Message: "0/3 nodes are available: 3 node(s) didn't match Pod's node affinity/selector.",
+			}},
+		},
+	}, {
+		ObjectMeta: metav1.ObjectMeta{Namespace: "openshift-ingress", Name: "router-default-b", Labels: podLabels},
+		Status: corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{{
+				Name:         "router",
+				RestartCount: 7,
+				State: corev1.ContainerState{
+					Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"},
+				},
+			}},
+		},
+	}}
+	snapshot := &Snapshot{
+		Infrastructure: &configv1.Infrastructure{
+			Status: configv1.InfrastructureStatus{
+				PlatformStatus: &configv1.PlatformStatus{Type: configv1.NonePlatformType},
+			},
+		},
+		IngressControllers: []IngressControllerSnapshot{{
+			IngressController: ic,
+			Deployment:        deployment,
+			Pods:              pods,
+		}},
+	}
+
+	analysis := Analyze(snapshot)
+
+	status, ok := analysis.ComputedStatus["default"]
+	if !ok {
+		t.Fatalf("expected computed status for ingresscontroller default")
+	}
+	if actual := conditionStatus(status.Conditions, operatorv1.OperatorStatusTypeAvailable); actual != string(operatorv1.ConditionFalse) {
+		t.Errorf("expected computed Available=False, got %s", actual)
+	}
+
+	expectedSources := []string{
+		"Status",
+		ingresscontroller.IngressControllerPodsScheduledConditionType,
+		"Pod router-default-b",
+	}
+	for _, source := range expectedSources {
+		found := false
+		for _, f := range analysis.Findings {
+			if f.IngressController == "default" && f.Source == source {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("expected a finding with source %q, got %+v", source, analysis.Findings)
+		}
+	}
+
+	var summary bytes.Buffer
+	if err := WriteSummary(&summary, snapshot, analysis); err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []string{"IngressController default:", "Stored status:   Available=True", "Computed status: Available=False", "CrashLoopBackOff"} {
+		if !strings.Contains(summary.String(), expected) {
+			t.Errorf("expected summary to contain %q, got:\n%s", expected, summary.String())
+		}
+	}
+}
+
+// TestAnalyzeMissingDeployment verifies that Analyze reports an
+// ingresscontroller that has no router deployment.
+func TestAnalyzeMissingDeployment(t *testing.T) {
+	snapshot := &Snapshot{
+		IngressControllers: []IngressControllerSnapshot{{
+			IngressController: &operatorv1.IngressController{
+				ObjectMeta: metav1.ObjectMeta{Name: "custom"},
+				Status: operatorv1.IngressControllerStatus{
+					Conditions: []operatorv1.OperatorCondition{{
+						Type:    ingresscontroller.IngressControllerAdmittedConditionType,
+						Status:  operatorv1.ConditionFalse,
+						Message: "domain is already in use",
+					}},
+				},
+			},
+		}},
+	}
+
+	analysis := Analyze(snapshot)
+
+	if _, ok := analysis.ComputedStatus["custom"]; ok {
+		t.Errorf("expected no computed status without a deployment")
+	}
+	if len(analysis.Findings) != 1 || analysis.Findings[0].Source != "Deployment" || !strings.Contains(analysis.Findings[0].Hint, "domain is already in use") {
+		t.Errorf("expected one finding about the missing deployment, got %+v", analysis.Findings)
+	}
+}
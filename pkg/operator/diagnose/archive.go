@@ -0,0 +1,227 @@
+package diagnose
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"path"
+	"reflect"
+	"sort"
+	"strings"
+
+	operatorclient "github.com/openshift/cluster-ingress-operator/pkg/operator/client"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/yaml"
+)
+
+// objectFile is an object and the name of the file to which to write it.
+type objectFile struct {
+	name string
+	obj  client.Object
+}
+
+// archive writes files to a gzipped tarball under a top-level directory.
+type archive struct {
+	dir string
+	tw  *tar.Writer
+	s   *Snapshot
+}
+
+// WriteArchive writes a gzipped tarball that contains the summary of the
+// snapshot and analysis, the redacted objects in the snapshot, and the redacted
+// router logs.  The files in the tarball are under a directory with the given
+// name.
+func WriteArchive(w io.Writer, dir string, s *Snapshot, a *Analysis) error {
+	gw := gzip.NewWriter(w)
+	ar := &archive{dir: dir, tw: tar.NewWriter(gw), s: s}
+
+	if err := ar.write(s, a); err != nil {
+		return err
+	}
+	if err := ar.tw.Close(); err != nil {
+		return err
+	}
+	return gw.Close()
+}
+
+// write writes all the files of the archive.
+func (ar *archive) write(s *Snapshot, a *Analysis) error {
+	var summary bytes.Buffer
+	if err := WriteSummary(&summary, s, a); err != nil {
+		return err
+	}
+	if err := ar.writeFile("summary.txt", summary.Bytes()); err != nil {
+		return err
+	}
+
+	for _, f := range []objectFile{
+		{"cluster/clusteroperator.yaml", s.ClusterOperator},
+		{"cluster/dns.yaml", s.DNSConfig},
+		{"cluster/infrastructure.yaml", s.Infrastructure},
+		{"cluster/ingress.yaml", s.IngressConfig},
+		{"operand/namespace.yaml", s.OperandNamespace},
+		{"operand/serviceaccount-router.yaml", s.RouterServiceAccount},
+		{"operand/clusterrole-router.yaml", s.RouterClusterRole},
+		{"operand/clusterrolebinding-router.yaml", s.RouterClusterRoleBinding},
+		{"operand/configmap-service-ca.yaml", s.ServiceCAConfigMap},
+		{"canary/daemonset.yaml", s.Canary.DaemonSet},
+		{"canary/route.yaml", s.Canary.Route},
+	} {
+		if err := ar.writeObject(f.name, f.obj); err != nil {
+			return err
+		}
+	}
+	if err := ar.writeObjects("cluster/nodes.yaml", nodeObjects(s.Nodes)); err != nil {
+		return err
+	}
+	if err := ar.writeObjects("operator/events.yaml", eventObjects(s.OperatorEvents)); err != nil {
+		return err
+	}
+	if err := ar.writeObjects("operand/events.yaml", eventObjects(s.OperandEvents)); err != nil {
+		return err
+	}
+	if err := ar.writeObjects("canary/pods.yaml", podObjects(s.Canary.Pods)); err != nil {
+		return err
+	}
+
+	for _, ics := range s.IngressControllers {
+		dir := path.Join("ingresscontrollers", ics.IngressController.Name)
+		for _, f := range []objectFile{
+			{"ingresscontroller.yaml", ics.IngressController},
+			{"deployment.yaml", ics.Deployment},
+			{"deployment-staged-rollout.yaml", ics.StagedRolloutDeployment},
+			{"service-loadbalancer.yaml", ics.LoadBalancerService},
+			{"service-loadbalancer-migration.yaml", ics.MigrationLoadBalancerService},
+			{"service-internal.yaml", ics.InternalService},
+			{"service-nodeport.yaml", ics.NodePortService},
+			{"servicemonitor.yaml", ics.ServiceMonitor},
+			{"poddisruptionbudget.yaml", ics.PodDisruptionBudget},
+			{"horizontalpodautoscaler.yaml", ics.HorizontalPodAutoscaler},
+			{"dnsrecord-wildcard.yaml", ics.WildcardRecord},
+			{"secret-default-certificate.yaml", ics.DefaultCertificate},
+			{"configmap-rsyslog.yaml", ics.RsyslogConfigMap},
+			{"configmap-error-pages.yaml", ics.ErrorPagesConfigMap},
+			{"configmap-error-pages-operand.yaml", ics.OperandErrorPagesConfigMap},
+			{"configmap-client-ca.yaml", ics.ClientCAConfigMap},
+			{"configmap-client-ca-crl.yaml", ics.CRLConfigMap},
+		} {
+			if err := ar.writeObject(path.Join(dir, f.name), f.obj); err != nil {
+				return err
+			}
+		}
+		if err := ar.writeObjects(path.Join(dir, "pods.yaml"), podObjects(ics.Pods)); err != nil {
+			return err
+		}
+		if status, ok := a.ComputedStatus[ics.IngressController.Name]; ok {
+			data, err := yaml.Marshal(status)
+			if err != nil {
+				return fmt.Errorf("failed to marshal computed status of ingresscontroller %s: %w", ics.IngressController.Name, err)
+			}
+			if err := ar.writeFile(path.Join(dir, "computed-status.yaml"), data); err != nil {
+				return err
+			}
+		}
+		pods := make([]string, 0, len(ics.RouterLogs))
+		for pod := range ics.RouterLogs {
+			pods = append(pods, pod)
+		}
+		sort.Strings(pods)
+		for _, pod := range pods {
+			if err := ar.writeFile(path.Join(dir, "logs", pod+".log"), []byte(redactLog(ics.RouterLogs[pod]))); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(s.Errors) != 0 {
+		if err := ar.writeFile("errors.txt", []byte(strings.Join(s.Errors, "\n")+"\n")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeObject writes a redacted copy of the given object as YAML, or nothing
+// if the object is a nil pointer, which the snapshot uses for objects that do
+// not exist.
+func (ar *archive) writeObject(name string, obj client.Object) error {
+	if obj == nil || reflect.ValueOf(obj).IsNil() {
+		return nil
+	}
+	data, err := yaml.Marshal(prepareObject(obj))
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s: %w", name, err)
+	}
+	return ar.writeFile(name, data)
+}
+
+// writeObjects writes redacted copies of the given objects as a YAML list.
+func (ar *archive) writeObjects(name string, objs []client.Object) error {
+	items := make([]client.Object, 0, len(objs))
+	for _, obj := range objs {
+		items = append(items, prepareObject(obj))
+	}
+	data, err := yaml.Marshal(map[string]interface{}{"items": items})
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s: %w", name, err)
+	}
+	return ar.writeFile(name, data)
+}
+
+// writeFile writes a file with the given name and content.
+func (ar *archive) writeFile(name string, data []byte) error {
+	header := &tar.Header{
+		Name:    path.Join(ar.dir, name),
+		Mode:    0644,
+		Size:    int64(len(data)),
+		ModTime: ar.s.CollectedAt,
+	}
+	if err := ar.tw.WriteHeader(header); err != nil {
+		return fmt.Errorf("failed to write header for %s: %w", name, err)
+	}
+	if _, err := ar.tw.Write(data); err != nil {
+		return fmt.Errorf("failed to write %s: %w", name, err)
+	}
+	return nil
+}
+
+// prepareObject returns a redacted copy of the given object with its API
+// version and kind set, which the client leaves empty for typed objects.
+func prepareObject(obj client.Object) client.Object {
+	copied := obj.DeepCopyObject().(client.Object)
+	if gvk, err := apiutil.GVKForObject(copied, operatorclient.GetScheme()); err == nil {
+		copied.GetObjectKind().SetGroupVersionKind(gvk)
+	}
+	redactObject(copied)
+	return copied
+}
+
+func nodeObjects(items []corev1.Node) []client.Object {
+	objs := make([]client.Object, 0, len(items))
+	for i := range items {
+		objs = append(objs, &items[i])
+	}
+	return objs
+}
+
+func eventObjects(items []corev1.Event) []client.Object {
+	objs := make([]client.Object, 0, len(items))
+	for i := range items {
+		objs = append(objs, &items[i])
+	}
+	return objs
+}
+
+func podObjects(items []corev1.Pod) []client.Object {
+	objs := make([]client.Object, 0, len(items))
+	for i := range items {
+		objs = append(objs, &items[i])
+	}
+	return objs
+}
@@ -0,0 +1,399 @@
+// Package diagnose collects the objects that the ingress operator manages for
+// a set of ingresscontrollers, computes the ingresscontrollers' status from
+// them offline, and writes the objects and a summary of likely root causes of
+// problems to a support bundle.
+package diagnose
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	configv1 "github.com/openshift/api/config/v1"
+	operatorv1 "github.com/openshift/api/operator/v1"
+	iov1 "github.com/openshift/api/operatoringress/v1"
+	routev1 "github.com/openshift/api/route/v1"
+
+	"github.com/openshift/cluster-ingress-operator/pkg/manifests"
+	operatorcontroller "github.com/openshift/cluster-ingress-operator/pkg/operator/controller"
+
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	"k8s.io/client-go/kubernetes"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Options configures what Collect collects.
+type Options struct {
+	// OperatorNamespace is the namespace in which the operator watches
+	// ingresscontrollers.
+	OperatorNamespace string
+	// OperandNamespace is the namespace of the router deployments.
+	OperandNamespace string
+	// CanaryNamespace is the namespace of the canary resources.
+	CanaryNamespace string
+	// ConfigNamespace is the namespace of the configmaps that
+	// ingresscontrollers reference.
+	ConfigNamespace string
+	// IngressControllerName is the name of the ingresscontroller to
+	// collect.  If empty, Collect collects all ingresscontrollers.
+	IngressControllerName string
+	// LogLines is the number of lines of each router container's log to
+	// collect.  If zero, Collect does not collect logs.
+	LogLines int64
+}
+
+// Snapshot is a point-in-time copy of the objects that the operator manages
+// for, or that affect the status of, a set of ingresscontrollers.  Objects that
+// do not exist are nil.
+type Snapshot struct {
+	// CollectedAt is the time at which collection started.
+	CollectedAt time.Time
+	// ConfigNamespace is the namespace in which Collect looked for the
+	// configmaps that the ingresscontrollers reference.
+	ConfigNamespace string
+
+	ClusterOperator *configv1.ClusterOperator
+	DNSConfig       *configv1.DNS
+	Infrastructure  *configv1.Infrastructure
+	IngressConfig   *configv1.Ingress
+	Nodes           []corev1.Node
+
+	// OperatorEvents are the events in the operator namespace.
+	OperatorEvents []corev1.Event
+	// OperandEvents are the events in the operand namespace.
+	OperandEvents []corev1.Event
+
+	// OperandNamespace, RouterServiceAccount, RouterClusterRole,
+	// RouterClusterRoleBinding, and ServiceCAConfigMap are the objects
+	// that the operator manages for all ingresscontrollers.
+	OperandNamespace         *corev1.Namespace
+	RouterServiceAccount     *corev1.ServiceAccount
+	RouterClusterRole        *rbacv1.ClusterRole
+	RouterClusterRoleBinding *rbacv1.ClusterRoleBinding
+	ServiceCAConfigMap       *corev1.ConfigMap
+
+	Canary CanarySnapshot
+
+	IngressControllers []IngressControllerSnapshot
+
+	// Errors are errors that Collect encountered.  Collect records errors
+	// and keeps collecting so that a partial snapshot is still useful.
+	Errors []string
+}
+
+// CanarySnapshot is a copy of the ingress canary check resources.
+type CanarySnapshot struct {
+	DaemonSet *appsv1.DaemonSet
+	Route     *routev1.Route
+	Pods      []corev1.Pod
+}
+
+// IngressControllerSnapshot is a copy of an ingresscontroller and the objects
+// that the operator manages for it.
+type IngressControllerSnapshot struct {
+	IngressController *operatorv1.IngressController
+	Deployment        *appsv1.Deployment
+	// StagedRolloutDeployment is the deployment that runs the canary
+	// replicas of a staged rollout of the router deployment.
+	StagedRolloutDeployment *appsv1.Deployment
+	Pods                    []corev1.Pod
+	LoadBalancerService     *corev1.Service
+	// MigrationLoadBalancerService is the temporary service that the
+	// operator uses while it migrates the load balancer to a new scope or
+	// type.
+	MigrationLoadBalancerService *corev1.Service
+	InternalService              *corev1.Service
+	NodePortService              *corev1.Service
+	ServiceMonitor               *unstructured.Unstructured
+	PodDisruptionBudget          *policyv1.PodDisruptionBudget
+	HorizontalPodAutoscaler      *autoscalingv2.HorizontalPodAutoscaler
+	WildcardRecord               *iov1.DNSRecord
+	DefaultCertificate           *corev1.Secret
+	RsyslogConfigMap             *corev1.ConfigMap
+	// ErrorPagesConfigMap is the configmap in the config namespace that
+	// the ingresscontroller's spec.httpErrorCodePages references.
+	ErrorPagesConfigMap *corev1.ConfigMap
+	// OperandErrorPagesConfigMap is the operator's copy of the valid error
+	// pages from ErrorPagesConfigMap in the operand namespace.
+	OperandErrorPagesConfigMap *corev1.ConfigMap
+	// ClientCAConfigMap and CRLConfigMap are the operator's copy of the
+	// client CA configmap that the ingresscontroller's spec.clientTLS
+	// references, and the certificate revocation lists of the client CA
+	// certificates, in the operand namespace.
+	ClientCAConfigMap *corev1.ConfigMap
+	CRLConfigMap      *corev1.ConfigMap
+	// RouterLogs maps the names of the router pods to the tails of their
+	// router containers' logs.
+	RouterLogs map[string]string
+}
+
+// collector collects a snapshot using a client for objects and a clientset for
+// logs.
+type collector struct {
+	client     client.Client
+	kubeClient kubernetes.Interface
+	options    Options
+	snapshot   *Snapshot
+}
+
+// Collect collects a snapshot of the ingresscontrollers that opts specifies and
+// of the objects that the operator manages for them.  Collect returns an error
+// only if it cannot get the ingresscontrollers; other errors are recorded in
+// the snapshot.
+func Collect(ctx context.Context, cl client.Client, kubeClient kubernetes.Interface, opts Options) (*Snapshot, error) {
+	c := &collector{
+		client:     cl,
+		kubeClient: kubeClient,
+		options:    opts,
+		snapshot:   &Snapshot{CollectedAt: time.Now(), ConfigNamespace: opts.ConfigNamespace},
+	}
+
+	ingressControllers := []operatorv1.IngressController{}
+	if len(opts.IngressControllerName) != 0 {
+		ic := &operatorv1.IngressController{}
+		name := types.NamespacedName{Namespace: opts.OperatorNamespace, Name: opts.IngressControllerName}
+		if err := cl.Get(ctx, name, ic); err != nil {
+			return nil, fmt.Errorf("failed to get ingresscontroller %s: %w", name, err)
+		}
+		ingressControllers = append(ingressControllers, *ic)
+	} else {
+		list := &operatorv1.IngressControllerList{}
+		if err := cl.List(ctx, list, client.InNamespace(opts.OperatorNamespace)); err != nil {
+			return nil, fmt.Errorf("failed to list ingresscontrollers in namespace %s: %w", opts.OperatorNamespace, err)
+		}
+		ingressControllers = list.Items
+	}
+
+	s := c.snapshot
+	s.ClusterOperator = &configv1.ClusterOperator{}
+	if !c.get(ctx, operatorcontroller.IngressClusterOperatorName(), s.ClusterOperator) {
+		s.ClusterOperator = nil
+	}
+	s.DNSConfig = &configv1.DNS{}
+	if !c.get(ctx, types.NamespacedName{Name: "cluster"}, s.DNSConfig) {
+		s.DNSConfig = nil
+	}
+	s.Infrastructure = &configv1.Infrastructure{}
+	if !c.get(ctx, types.NamespacedName{Name: "cluster"}, s.Infrastructure) {
+		s.Infrastructure = nil
+	}
+	s.IngressConfig = &configv1.Ingress{}
+	if !c.get(ctx, types.NamespacedName{Name: "cluster"}, s.IngressConfig) {
+		s.IngressConfig = nil
+	}
+	nodes := &corev1.NodeList{}
+	if c.list(ctx, nodes) {
+		s.Nodes = nodes.Items
+	}
+	operatorEvents := &corev1.EventList{}
+	if c.list(ctx, operatorEvents, client.InNamespace(opts.OperatorNamespace)) {
+		s.OperatorEvents = operatorEvents.Items
+	}
+	operandEvents := &corev1.EventList{}
+	if c.list(ctx, operandEvents, client.InNamespace(opts.OperandNamespace)) {
+		s.OperandEvents = operandEvents.Items
+	}
+
+	s.OperandNamespace = &corev1.Namespace{}
+	if !c.get(ctx, types.NamespacedName{Name: opts.OperandNamespace}, s.OperandNamespace) {
+		s.OperandNamespace = nil
+	}
+	s.RouterServiceAccount = &corev1.ServiceAccount{}
+	if !c.get(ctx, client.ObjectKeyFromObject(manifests.RouterServiceAccount(opts.OperandNamespace)), s.RouterServiceAccount) {
+		s.RouterServiceAccount = nil
+	}
+	s.RouterClusterRole = &rbacv1.ClusterRole{}
+	if !c.get(ctx, client.ObjectKeyFromObject(manifests.RouterClusterRole()), s.RouterClusterRole) {
+		s.RouterClusterRole = nil
+	}
+	s.RouterClusterRoleBinding = &rbacv1.ClusterRoleBinding{}
+	if !c.get(ctx, client.ObjectKeyFromObject(manifests.RouterClusterRoleBinding(opts.OperandNamespace)), s.RouterClusterRoleBinding) {
+		s.RouterClusterRoleBinding = nil
+	}
+	s.ServiceCAConfigMap = &corev1.ConfigMap{}
+	if !c.get(ctx, operatorcontroller.ServiceCAConfigMapName(opts.OperandNamespace), s.ServiceCAConfigMap) {
+		s.ServiceCAConfigMap = nil
+	}
+
+	s.Canary.DaemonSet = &appsv1.DaemonSet{}
+	if !c.get(ctx, operatorcontroller.CanaryDaemonSetName(opts.CanaryNamespace), s.Canary.DaemonSet) {
+		s.Canary.DaemonSet = nil
+	}
+	s.Canary.Route = &routev1.Route{}
+	if !c.get(ctx, operatorcontroller.CanaryRouteName(opts.CanaryNamespace), s.Canary.Route) {
+		s.Canary.Route = nil
+	}
+	canaryPods := &corev1.PodList{}
+	if c.list(ctx, canaryPods, client.InNamespace(opts.CanaryNamespace)) {
+		s.Canary.Pods = canaryPods.Items
+	}
+
+	for i := range ingressControllers {
+		s.IngressControllers = append(s.IngressControllers, c.collectIngressController(ctx, &ingressControllers[i]))
+	}
+
+	return s, nil
+}
+
+// collectIngressController collects the objects that the operator manages for
+// the given ingresscontroller, which include the objects that
+// ingresscontroller.RenderIngressController renders for it.
+func (c *collector) collectIngressController(ctx context.Context, ic *operatorv1.IngressController) IngressControllerSnapshot {
+	snapshot := IngressControllerSnapshot{IngressController: ic}
+	operandNamespace := c.options.OperandNamespace
+
+	snapshot.Deployment = &appsv1.Deployment{}
+	if !c.get(ctx, operatorcontroller.RouterDeploymentName(ic, operandNamespace), snapshot.Deployment) {
+		snapshot.Deployment = nil
+	}
+	snapshot.StagedRolloutDeployment = &appsv1.Deployment{}
+	if !c.get(ctx, operatorcontroller.RouterStagedRolloutDeploymentName(ic, operandNamespace), snapshot.StagedRolloutDeployment) {
+		snapshot.StagedRolloutDeployment = nil
+	}
+	snapshot.LoadBalancerService = &corev1.Service{}
+	if !c.get(ctx, operatorcontroller.LoadBalancerServiceName(ic, operandNamespace), snapshot.LoadBalancerService) {
+		snapshot.LoadBalancerService = nil
+	}
+	snapshot.MigrationLoadBalancerService = &corev1.Service{}
+	if !c.get(ctx, operatorcontroller.MigrationLoadBalancerServiceName(ic, operandNamespace), snapshot.MigrationLoadBalancerService) {
+		snapshot.MigrationLoadBalancerService = nil
+	}
+	snapshot.InternalService = &corev1.Service{}
+	if !c.get(ctx, operatorcontroller.InternalIngressControllerServiceName(ic, operandNamespace), snapshot.InternalService) {
+		snapshot.InternalService = nil
+	}
+	snapshot.NodePortService = &corev1.Service{}
+	if !c.get(ctx, operatorcontroller.NodePortServiceName(ic, operandNamespace), snapshot.NodePortService) {
+		snapshot.NodePortService = nil
+	}
+	snapshot.ServiceMonitor = &unstructured.Unstructured{}
+	snapshot.ServiceMonitor.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "monitoring.coreos.com",
+		Kind:    "ServiceMonitor",
+		Version: "v1",
+	})
+	if !c.get(ctx, operatorcontroller.IngressControllerServiceMonitorName(ic, operandNamespace), snapshot.ServiceMonitor) {
+		snapshot.ServiceMonitor = nil
+	}
+	snapshot.PodDisruptionBudget = &policyv1.PodDisruptionBudget{}
+	if !c.get(ctx, operatorcontroller.RouterPodDisruptionBudgetName(ic, operandNamespace), snapshot.PodDisruptionBudget) {
+		snapshot.PodDisruptionBudget = nil
+	}
+	snapshot.HorizontalPodAutoscaler = &autoscalingv2.HorizontalPodAutoscaler{}
+	if !c.get(ctx, operatorcontroller.RouterHorizontalPodAutoscalerName(ic, operandNamespace), snapshot.HorizontalPodAutoscaler) {
+		snapshot.HorizontalPodAutoscaler = nil
+	}
+	snapshot.WildcardRecord = &iov1.DNSRecord{}
+	if !c.get(ctx, operatorcontroller.WildcardDNSRecordName(ic), snapshot.WildcardRecord) {
+		snapshot.WildcardRecord = nil
+	}
+	snapshot.DefaultCertificate = &corev1.Secret{}
+	if !c.get(ctx, operatorcontroller.RouterEffectiveDefaultCertificateSecretName(ic, operandNamespace), snapshot.DefaultCertificate) {
+		snapshot.DefaultCertificate = nil
+	}
+	snapshot.RsyslogConfigMap = &corev1.ConfigMap{}
+	if !c.get(ctx, operatorcontroller.RsyslogConfigMapName(ic, operandNamespace), snapshot.RsyslogConfigMap) {
+		snapshot.RsyslogConfigMap = nil
+	}
+	snapshot.OperandErrorPagesConfigMap = &corev1.ConfigMap{}
+	if !c.get(ctx, operatorcontroller.HttpErrorCodePageConfigMapName(ic, operandNamespace), snapshot.OperandErrorPagesConfigMap) {
+		snapshot.OperandErrorPagesConfigMap = nil
+	}
+	snapshot.ClientCAConfigMap = &corev1.ConfigMap{}
+	if !c.get(ctx, operatorcontroller.ClientCAConfigMapName(ic, operandNamespace), snapshot.ClientCAConfigMap) {
+		snapshot.ClientCAConfigMap = nil
+	}
+	snapshot.CRLConfigMap = &corev1.ConfigMap{}
+	if !c.get(ctx, operatorcontroller.CRLConfigMapName(ic, operandNamespace), snapshot.CRLConfigMap) {
+		snapshot.CRLConfigMap = nil
+	}
+	if len(ic.Spec.HttpErrorCodePages.Name) != 0 {
+		snapshot.ErrorPagesConfigMap = &corev1.ConfigMap{}
+		if !c.get(ctx, types.NamespacedName{Namespace: c.options.ConfigNamespace, Name: ic.Spec.HttpErrorCodePages.Name}, snapshot.ErrorPagesConfigMap) {
+			snapshot.ErrorPagesConfigMap = nil
+		}
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(operatorcontroller.IngressControllerDeploymentPodSelector(ic))
+	if err != nil {
+		c.recordError(fmt.Errorf("failed to build pod selector for ingresscontroller %s: %w", ic.Name, err))
+		return snapshot
+	}
+	pods := &corev1.PodList{}
+	if c.list(ctx, pods, client.InNamespace(operandNamespace), client.MatchingLabelsSelector{Selector: selector}) {
+		snapshot.Pods = pods.Items
+	}
+
+	if c.options.LogLines > 0 {
+		snapshot.RouterLogs = map[string]string{}
+		for _, pod := range snapshot.Pods {
+			if log, err := c.routerLog(ctx, &pod); err != nil {
+				c.recordError(fmt.Errorf("failed to get log of pod %s/%s: %w", pod.Namespace, pod.Name, err))
+			} else {
+				snapshot.RouterLogs[pod.Name] = log
+			}
+		}
+	}
+
+	return snapshot
+}
+
+// routerLog returns the tail of the given pod's router container's log.
+func (c *collector) routerLog(ctx context.Context, pod *corev1.Pod) (string, error) {
+	lines := c.options.LogLines
+	request := c.kubeClient.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, &corev1.PodLogOptions{
+		Container: "router",
+		TailLines: &lines,
+	})
+	stream, err := request.Stream(ctx)
+	if err != nil {
+		return "", err
+	}
+	defer stream.Close()
+	var buf bytes.Buffer
+	if _, err := io.Copy(&buf, stream); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// get gets the named object and returns a Boolean indicating whether it
+// exists.  get records errors other than NotFound.
+func (c *collector) get(ctx context.Context, name types.NamespacedName, obj client.Object) bool {
+	if err := c.client.Get(ctx, name, obj); err != nil {
+		if !errors.IsNotFound(err) {
+			c.recordError(fmt.Errorf("failed to get %T %s: %w", obj, name, err))
+		}
+		return false
+	}
+	return true
+}
+
+// list lists objects and returns a Boolean indicating whether it succeeded.
+// list records errors.
+func (c *collector) list(ctx context.Context, list client.ObjectList, opts ...client.ListOption) bool {
+	if err := c.client.List(ctx, list, opts...); err != nil {
+		c.recordError(fmt.Errorf("failed to list %T: %w", list, err))
+		return false
+	}
+	return true
+}
+
+// recordError records an error in the snapshot.
+func (c *collector) recordError(err error) {
+	c.snapshot.Errors = append(c.snapshot.Errors, err.Error())
+}
@@ -0,0 +1,115 @@
+package diagnose
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	configv1 "github.com/openshift/api/config/v1"
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	operatorclient "github.com/openshift/cluster-ingress-operator/pkg/operator/client"
+	ingresscontroller "github.com/openshift/cluster-ingress-operator/pkg/operator/controller/ingress"
+
+	corev1 "k8s.io/api/core/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// TestCollectRenderedObjects verifies that Collect collects every object that
+// RenderIngressController renders for an ingresscontroller.
+func TestCollectRenderedObjects(t *testing.T) {
+	ic := &operatorv1.IngressController{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "openshift-ingress-operator", Name: "default"},
+		Spec: operatorv1.IngressControllerSpec{
+			Logging: &operatorv1.IngressControllerLogging{
+				Access: &operatorv1.AccessLogging{
+					Destination: operatorv1.LoggingDestination{
+						Type:      operatorv1.ContainerLoggingDestinationType,
+						Container: &operatorv1.ContainerLoggingDestinationParameters{},
+					},
+				},
+			},
+		},
+	}
+	admitted, objects, err := ingresscontroller.RenderIngressController(ic, ingresscontroller.RenderConfig{
+		OperatorNamespace:      "openshift-ingress-operator",
+		OperandNamespace:       "openshift-ingress",
+		IngressControllerImage: "quay.io/openshift/router:latest",
+		DNSConfig:              &configv1.DNS{Spec: configv1.DNSSpec{BaseDomain: "example.com"}},
+		InfraConfig: &configv1.Infrastructure{
+			Status: configv1.InfrastructureStatus{
+				PlatformStatus:         &configv1.PlatformStatus{Type: configv1.AWSPlatformType},
+				InfrastructureTopology: configv1.HighlyAvailableTopologyMode,
+				ControlPlaneTopology:   configv1.HighlyAvailableTopologyMode,
+			},
+		},
+		IngressConfig:       &configv1.Ingress{Spec: configv1.IngressSpec{Domain: "apps.example.com"}},
+		LoadBalancerIngress: []corev1.LoadBalancerIngress{{Hostname: "lb.example.com"}},
+	})
+	if err != nil {
+		t.Fatalf("failed to render ingresscontroller: %v", err)
+	}
+
+	cl := fake.NewClientBuilder().WithScheme(operatorclient.GetScheme()).WithObjects(admitted).WithObjects(objects...).Build()
+
+	snapshot, err := Collect(context.TODO(), cl, nil, Options{
+		OperatorNamespace: "openshift-ingress-operator",
+		OperandNamespace:  "openshift-ingress",
+		CanaryNamespace:   "openshift-ingress-canary",
+		ConfigNamespace:   "openshift-config",
+	})
+	if err != nil {
+		t.Fatalf("failed to collect snapshot: %v", err)
+	}
+	if len(snapshot.IngressControllers) != 1 {
+		t.Fatalf("expected 1 ingresscontroller, got %d", len(snapshot.IngressControllers))
+	}
+
+	ics := snapshot.IngressControllers[0]
+	collected := map[string]bool{}
+	for _, obj := range []client.Object{
+		snapshot.OperandNamespace,
+		snapshot.RouterServiceAccount,
+		snapshot.RouterClusterRole,
+		snapshot.RouterClusterRoleBinding,
+		snapshot.ServiceCAConfigMap,
+		ics.Deployment,
+		ics.StagedRolloutDeployment,
+		ics.LoadBalancerService,
+		ics.MigrationLoadBalancerService,
+		ics.InternalService,
+		ics.NodePortService,
+		ics.ServiceMonitor,
+		ics.PodDisruptionBudget,
+		ics.HorizontalPodAutoscaler,
+		ics.WildcardRecord,
+		ics.RsyslogConfigMap,
+		ics.OperandErrorPagesConfigMap,
+		ics.ClientCAConfigMap,
+		ics.CRLConfigMap,
+	} {
+		if obj == nil || reflect.ValueOf(obj).IsNil() {
+			continue
+		}
+		collected[objectKey(obj)] = true
+	}
+	for _, obj := range objects {
+		if !collected[objectKey(obj)] {
+			t.Errorf("expected Collect to collect rendered %s", objectKey(obj))
+		}
+	}
+	if len(snapshot.Errors) != 0 {
+		t.Errorf("unexpected errors: %v", snapshot.Errors)
+	}
+}
+
+// objectKey returns a string that identifies the given object by its Go type
+// and namespaced name.
+func objectKey(obj client.Object) string {
+	return fmt.Sprintf("%T %s/%s", obj, obj.GetNamespace(), obj.GetName())
+}
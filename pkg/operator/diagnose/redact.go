@@ -0,0 +1,101 @@
+package diagnose
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+
+	routev1 "github.com/openshift/api/route/v1"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// redacted replaces sensitive values.
+	redacted = "REDACTED"
+
+	// lastAppliedConfigAnnotation is the annotation in which "oc apply"
+	// stores the last applied configuration, which may include sensitive
+	// values.
+	lastAppliedConfigAnnotation = "kubectl.kubernetes.io/last-applied-configuration"
+)
+
+var (
+	// sensitiveNameRegexp matches names of environment variables whose
+	// values are likely to be sensitive.
+	sensitiveNameRegexp = regexp.MustCompile(`(?i)(password|passwd|secret|token|credential|private_?key|api_?key)`)
+
+	// sensitiveLogRegexp matches key-value pairs and authorization headers
+	// in log lines whose values are likely to be sensitive.
+	sensitiveLogRegexp = regexp.MustCompile(`(?i)((?:password|passwd|secret|token|api_?key)\s*[=:]\s*|authorization:\s*\w+\s+)\S+`)
+)
+
+// redactObject removes sensitive values from the given object in place.  It
+// removes managed fields and the last applied configuration annotation from
+// every object; replaces the data of secrets with the size of each value;
+// replaces the values of environment variables that have sensitive names in
+// pods and pod templates; and removes private keys from routes.
+func redactObject(obj client.Object) {
+	obj.SetManagedFields(nil)
+	if annotations := obj.GetAnnotations(); annotations != nil {
+		if _, ok := annotations[lastAppliedConfigAnnotation]; ok {
+			delete(annotations, lastAppliedConfigAnnotation)
+			obj.SetAnnotations(annotations)
+		}
+	}
+
+	switch o := obj.(type) {
+	case *corev1.Secret:
+		keys := make([]string, 0, len(o.Data)+len(o.StringData))
+		sizes := map[string]int{}
+		for k, v := range o.Data {
+			keys = append(keys, k)
+			sizes[k] = len(v)
+		}
+		for k, v := range o.StringData {
+			if _, ok := sizes[k]; !ok {
+				keys = append(keys, k)
+			}
+			sizes[k] = len(v)
+		}
+		sort.Strings(keys)
+		o.Data = nil
+		o.StringData = map[string]string{}
+		for _, k := range keys {
+			o.StringData[k] = fmt.Sprintf("%s (%d bytes)", redacted, sizes[k])
+		}
+	case *corev1.Pod:
+		redactPodSpec(&o.Spec)
+	case *appsv1.Deployment:
+		redactPodSpec(&o.Spec.Template.Spec)
+	case *appsv1.DaemonSet:
+		redactPodSpec(&o.Spec.Template.Spec)
+	case *routev1.Route:
+		if o.Spec.TLS != nil && len(o.Spec.TLS.Key) != 0 {
+			o.Spec.TLS.Key = redacted
+		}
+	}
+}
+
+// redactPodSpec replaces the values of environment variables that have
+// sensitive names in the given pod spec.
+func redactPodSpec(spec *corev1.PodSpec) {
+	for _, containers := range [][]corev1.Container{spec.InitContainers, spec.Containers} {
+		for i := range containers {
+			for j := range containers[i].Env {
+				env := &containers[i].Env[j]
+				if len(env.Value) != 0 && sensitiveNameRegexp.MatchString(env.Name) {
+					env.Value = redacted
+				}
+			}
+		}
+	}
+}
+
+// redactLog replaces values in the given log that are likely to be sensitive.
+func redactLog(log string) string {
+	return sensitiveLogRegexp.ReplaceAllString(log, "${1}"+redacted)
+}
@@ -0,0 +1,111 @@
+package diagnose
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TestWriteArchiveRedacts verifies that WriteArchive does not write secret
+// data, sensitive environment variable values, or sensitive values in logs.
+func TestWriteArchiveRedacts(t *testing.T) {
+	ic := &operatorv1.IngressController{ObjectMeta: metav1.ObjectMeta{Name: "default"}}
+	snapshot := &Snapshot{
+		CollectedAt: time.Now(),
+		IngressControllers: []IngressControllerSnapshot{{
+			IngressController: ic,
+			Deployment: &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "router-default",
+					Annotations: map[string]string{
+						lastAppliedConfigAnnotation: "s3cr3t-applied",
+					},
+				},
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Containers: []corev1.Container{{
+								Name: "router",
+								Env: []corev1.EnvVar{
+									{Name: "STATS_PASSWORD", Value: "s3cr3t-env"},
+									{Name: "ROUTER_THREADS", Value: "4"},
+								},
+							}},
+						},
+					},
+				},
+			},
+			DefaultCertificate: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "router-certs-default"},
+				Data: map[string][]byte{
+					"tls.key": []byte("s3cr3t-key"),
+				},
+			},
+			RouterLogs: map[string]string{
+				"router-default-a": "I0101 connecting with token=s3cr3t-log\n",
+			},
+		}},
+	}
+
+	var buf bytes.Buffer
+	if err := WriteArchive(&buf, "bundle", snapshot, Analyze(snapshot)); err != nil {
+		t.Fatalf("failed to write archive: %v", err)
+	}
+
+	gr, err := gzip.NewReader(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tr := tar.NewReader(gr)
+	files := map[string]string{}
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := io.ReadAll(tr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		files[header.Name] = string(data)
+	}
+
+	for _, name := range []string{
+		"bundle/summary.txt",
+		"bundle/ingresscontrollers/default/deployment.yaml",
+		"bundle/ingresscontrollers/default/secret-default-certificate.yaml",
+		"bundle/ingresscontrollers/default/logs/router-default-a.log",
+	} {
+		if _, ok := files[name]; !ok {
+			t.Errorf("expected archive to contain %s", name)
+		}
+	}
+	for name, content := range files {
+		if strings.Contains(content, "s3cr3t") {
+			t.Errorf("expected %s to be redacted, got:\n%s", name, content)
+		}
+	}
+	if deployment := files["bundle/ingresscontrollers/default/deployment.yaml"]; !strings.Contains(deployment, "ROUTER_THREADS") || !strings.Contains(deployment, `"4"`) {
+		t.Errorf("expected non-sensitive environment variables to be kept, got:\n%s", deployment)
+	}
+	if secret := files["bundle/ingresscontrollers/default/secret-default-certificate.yaml"]; !strings.Contains(secret, "tls.key: REDACTED (10 bytes)") {
+		t.Errorf("expected secret keys and sizes to be kept, got:\n%s", secret)
+	}
+	if _, ok := files["bundle/ingresscontrollers/default/service-loadbalancer.yaml"]; ok {
+		t.Errorf("expected no file for a missing service")
+	}
+}
@@ -0,0 +1,72 @@
+package operator
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	canarycontroller "github.com/openshift/cluster-ingress-operator/pkg/operator/controller/canary"
+
+	"sigs.k8s.io/controller-runtime/pkg/healthz"
+)
+
+// cacheSyncCheckTimeout is how long the informer sync readiness check waits for
+// the manager's caches to sync.
+const cacheSyncCheckTimeout = 1 * time.Second
+
+// HealthChecks returns the operator's liveness and readiness checks, keyed by
+// name.  The liveness checks fail if the operator is running but stuck, and the
+// readiness checks fail if the operator has not finished starting up.  Failures
+// to reach the DNS provider are reported in the dns_provider_initialized metric
+// and in dnsrecords' status instead, because they affect every replica alike.
+func (o *Operator) HealthChecks() (healthzChecks, readyzChecks map[string]healthz.Checker) {
+	healthzChecks = map[string]healthz.Checker{
+		"ping":          healthz.Ping,
+		"canary-poller": canarycontroller.PollerHealthzCheck,
+	}
+	readyzChecks = map[string]healthz.Checker{
+		"informer-sync": o.informerSyncCheck,
+	}
+	return healthzChecks, readyzChecks
+}
+
+// informerSyncCheck is a healthz.Checker that fails if the manager's caches or
+// the operator's other informers have not synced.
+func (o *Operator) informerSyncCheck(req *http.Request) error {
+	ctx, cancel := context.WithTimeout(req.Context(), cacheSyncCheckTimeout)
+	defer cancel()
+	if !o.manager.GetCache().WaitForCacheSync(ctx) {
+		return errors.New("manager caches have not synced")
+	}
+	if !o.informersSynced() {
+		return errors.New("pod informer has not synced")
+	}
+	return nil
+}
+
+// StartHealthListener serves the given liveness checks at /healthz and the
+// given readiness checks at /readyz on addr until signal is done.  Unlike the
+// metrics listener, which only kube-rbac-proxy reaches, the health listener
+// must listen on an address that the kubelet can reach.
+func StartHealthListener(addr string, signal context.Context, healthzChecks, readyzChecks map[string]healthz.Checker) {
+	log.Info("starting health listener", "addr", addr)
+	mux := http.NewServeMux()
+	healthzHandler := &healthz.Handler{Checks: healthzChecks}
+	mux.Handle("/healthz", http.StripPrefix("/healthz", healthzHandler))
+	mux.Handle("/healthz/", http.StripPrefix("/healthz", healthzHandler))
+	readyzHandler := &healthz.Handler{Checks: readyzChecks}
+	mux.Handle("/readyz", http.StripPrefix("/readyz", readyzHandler))
+	mux.Handle("/readyz/", http.StripPrefix("/readyz", readyzHandler))
+	s := http.Server{Addr: addr, Handler: mux}
+
+	go func() {
+		if err := s.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Error(err, "health listener exited")
+		}
+	}()
+	<-signal.Done()
+	if err := s.Shutdown(context.Background()); err != nil {
+		log.Error(err, "error stopping health listener")
+	}
+}
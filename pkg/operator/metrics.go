@@ -3,14 +3,64 @@ package operator
 import (
 	"context"
 	"net/http"
+	"net/http/pprof"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	ctrlruntimemetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 )
 
+var (
+	// isLeader reports whether this replica of the operator is the leader.
+	// Only the leader runs controllers, so only the leader's controller
+	// metrics are meaningful.
+	isLeader = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "ingress_operator_leader",
+		Help: "Reports whether this replica of the operator is the leader (1) or not (0).",
+	})
+
+	// trustedCABundleReloadsTotal counts reloads of the trusted CA bundle,
+	// by result.
+	trustedCABundleReloadsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "ingress_operator_trusted_ca_bundle_reloads_total",
+		Help: "Report the number of times that the operator has reloaded its trusted CA bundle after the bundle changed, by result (success or failure).",
+	}, []string{"result"})
+
+	// trustedCABundleLastReloadSuccess reports when the operator last
+	// loaded its trusted CA bundle successfully.
+	trustedCABundleLastReloadSuccess = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "ingress_operator_trusted_ca_bundle_last_reload_success_timestamp_seconds",
+		Help: "Report the Unix time at which the operator last loaded its trusted CA bundle successfully.",
+	})
+
+	// metricsList is a list of the operator's custom metrics.
+	metricsList = []prometheus.Collector{
+		isLeader,
+		trustedCABundleReloadsTotal,
+		trustedCABundleLastReloadSuccess,
+	}
+)
+
+// RegisterMetrics calls prometheus.Register on each metric in metricsList, and
+// returns on errors.
+func RegisterMetrics() error {
+	for _, metric := range metricsList {
+		if err := prometheus.Register(metric); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ListenerConfig configures the endpoints, besides /metrics, that the metrics
+// listener serves.
+type ListenerConfig struct {
+	// EnableProfiling enables the /debug/pprof endpoints.
+	EnableProfiling bool
+}
+
 // StartMetricsListener starts the metrics listener on addr.
-func StartMetricsListener(addr string, signal context.Context) {
+func StartMetricsListener(addr string, signal context.Context, config ListenerConfig) {
 	// These metrics get registered in controller-runtime's registry via an init in the internal/controller/metrics package.
 	// Unregister the controller-runtime metrics, so that we can combine the controller-runtime metric's registry
 	// with that of the ingress-operator. This shouldn't have any side effects, as long as no 2 metrics across
@@ -29,6 +79,14 @@ func StartMetricsListener(addr string, signal context.Context) {
 	log.Info("starting metrics listener", "addr", addr)
 	mux := http.NewServeMux()
 	mux.Handle("/metrics", handler)
+	if config.EnableProfiling {
+		log.Info("enabling profiling endpoints", "path", "/debug/pprof/")
+		mux.HandleFunc("/debug/pprof/", pprof.Index)
+		mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+		mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+		mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+		mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	}
 	s := http.Server{Addr: addr, Handler: mux}
 
 	go func() {
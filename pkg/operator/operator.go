@@ -10,12 +10,17 @@ import (
 	routemetricscontroller "github.com/openshift/cluster-ingress-operator/pkg/operator/controller/route-metrics"
 	errorpageconfigmapcontroller "github.com/openshift/cluster-ingress-operator/pkg/operator/controller/sync-http-error-code-configmap"
 	"github.com/openshift/library-go/pkg/operator/onepodpernodeccontroller"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
 
 	configv1 "github.com/openshift/api/config/v1"
 	operatorv1 "github.com/openshift/api/operator/v1"
+	iov1 "github.com/openshift/api/operatoringress/v1"
+	routev1 "github.com/openshift/api/route/v1"
 	logf "github.com/openshift/cluster-ingress-operator/pkg/log"
 	"github.com/openshift/cluster-ingress-operator/pkg/manifests"
 	operatorclient "github.com/openshift/cluster-ingress-operator/pkg/operator/client"
@@ -32,11 +37,14 @@ import (
 	ingresscontroller "github.com/openshift/cluster-ingress-operator/pkg/operator/controller/ingress"
 	ingressclasscontroller "github.com/openshift/cluster-ingress-operator/pkg/operator/controller/ingressclass"
 	statuscontroller "github.com/openshift/cluster-ingress-operator/pkg/operator/controller/status"
+	"github.com/openshift/cluster-ingress-operator/pkg/operator/webhook"
+	"github.com/openshift/cluster-ingress-operator/pkg/util/trustedca"
 	"github.com/openshift/library-go/pkg/operator/events"
 
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/util/retry"
@@ -46,6 +54,12 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 )
 
+const (
+	// leaderElectionID is the name of the lease that replicas of the
+	// operator use for leader election.
+	leaderElectionID = "ingress-operator-lock"
+)
+
 var (
 	log = logf.Logger.WithName("init")
 )
@@ -65,32 +79,78 @@ type Operator struct {
 	manager manager.Manager
 
 	namespace string
+
+	// informersSynced reports whether the informers that the operator
+	// starts outside of the manager have synced.
+	informersSynced func() bool
+
+	// trustedCABundle is the operator's trusted CA bundle, or nil if the
+	// operator uses the system certificate pool.
+	trustedCABundle *trustedca.Bundle
+
+	// recorder records events on the operator's deployment.
+	recorder events.Recorder
 }
 
 // New creates (but does not start) a new operator from configuration.
 func New(config operatorconfig.Config, kubeConfig *rest.Config) (*Operator, error) {
+	if err := validateNamespaces(config); err != nil {
+		return nil, err
+	}
 	scheme := operatorclient.GetScheme()
+	cachedNamespaces := sets.NewString(
+		config.Namespace,
+		config.ConfigNamespace,
+		config.OperandNamespace,
+		config.CanaryNamespace,
+		operatorcontroller.GlobalMachineSpecifiedConfigNamespace,
+	).List()
 	// Set up an operator manager for the operator namespace.
 	mgr, err := manager.New(kubeConfig, manager.Options{
 		Namespace: config.Namespace,
 		Scheme:    scheme,
-		NewCache: cache.MultiNamespacedCacheBuilder([]string{
-			config.Namespace,
-			operatorcontroller.GlobalUserSpecifiedConfigNamespace,
-			operatorcontroller.DefaultOperandNamespace,
-			operatorcontroller.DefaultCanaryNamespace,
-			operatorcontroller.GlobalMachineSpecifiedConfigNamespace,
-			operatorcontroller.SourceConfigMapNamespace,
-		}),
-		// Use a non-caching client everywhere. The default split client does not
-		// promise to invalidate the cache during writes (nor does it promise
-		// sequential create/get coherence), and we have code which (probably
-		// incorrectly) assumes a get immediately following a create/update will
-		// return the updated resource. All client consumers will need audited to
-		// ensure they are tolerant of stale data (or we need a cache or client that
-		// makes stronger coherence guarantees).
-		NewClient: func(_ cache.Cache, config *rest.Config, options client.Options, uncachedObjects ...client.Object) (client.Client, error) {
-			return client.New(config, options)
+		// With leader election, only the leader runs controllers.  The
+		// manager starts the caches of every replica regardless so
+		// that a new leader can take over without waiting for them to
+		// sync.  If the leader loses its lease, the manager stops, and
+		// the operator exits.
+		LeaderElection:                config.LeaderElection.Enabled,
+		LeaderElectionID:              leaderElectionID,
+		LeaderElectionNamespace:       config.Namespace,
+		LeaderElectionReleaseOnCancel: true,
+		LeaseDuration:                 &config.LeaderElection.LeaseDuration,
+		RenewDeadline:                 &config.LeaderElection.RenewDeadline,
+		RetryPeriod:                   &config.LeaderElection.RetryPeriod,
+		NewCache:                      cache.MultiNamespacedCacheBuilder(cachedNamespaces),
+		// The default split client does not promise sequential
+		// create/get coherence, and we have code that assumes a get
+		// immediately following a create/update will return the updated
+		// resource.  Use a client that reads from the cache only the
+		// kinds of objects that controllers already watch, and that
+		// waits for the cache to observe the client's own writes before
+		// it serves reads of them.
+		NewClient: func(c cache.Cache, config *rest.Config, options client.Options, uncachedObjects ...client.Object) (client.Client, error) {
+			apiClient, err := client.New(config, options)
+			if err != nil {
+				return nil, err
+			}
+			return operatorclient.NewCoherentClient(apiClient, c, cachedNamespaces,
+				&appsv1.Deployment{},
+				&corev1.ConfigMap{},
+				&corev1.Pod{},
+				&corev1.Secret{},
+				&corev1.Service{},
+				&configv1.ClusterOperator{},
+				&configv1.DNS{},
+				&configv1.Infrastructure{},
+				&configv1.Ingress{},
+				&iov1.DNSRecord{},
+				&networkingv1.IngressClass{},
+				&operatorv1.IngressController{},
+				&rbacv1.Role{},
+				&rbacv1.RoleBinding{},
+				&routev1.Route{},
+			)
 		},
 	})
 	if err != nil {
@@ -100,7 +160,12 @@ func New(config operatorconfig.Config, kubeConfig *rest.Config) (*Operator, erro
 	// Create and register the ingress controller with the operator manager.
 	if _, err := ingresscontroller.New(mgr, ingresscontroller.Config{
 		Namespace:              config.Namespace,
+		OperandNamespace:       config.OperandNamespace,
+		ConfigNamespace:        config.ConfigNamespace,
+		CanaryNamespace:        config.CanaryNamespace,
 		IngressControllerImage: config.IngressControllerImage,
+		OTelCollectorImage:     config.OTelCollectorImage,
+		OperatorImage:          config.CanaryImage,
 	}); err != nil {
 		return nil, fmt.Errorf("failed to create ingress controller: %v", err)
 	}
@@ -109,32 +174,41 @@ func New(config operatorconfig.Config, kubeConfig *rest.Config) (*Operator, erro
 	if err != nil {
 		return nil, fmt.Errorf("failed to create kube client: %w", err)
 	}
-	namespaceInformers := informers.NewSharedInformerFactoryWithOptions(kubeClient, 24*time.Hour, informers.WithNamespace(operatorcontroller.DefaultOperandNamespace))
+	recorder := events.NewKubeRecorder(kubeClient.CoreV1().Events(config.Namespace), "cluster-ingress-operator", &corev1.ObjectReference{
+		APIVersion: "apps/v1",
+		Kind:       "Deployment",
+		Namespace:  config.Namespace,
+		Name:       "ingress-operator",
+	})
+	namespaceInformers := informers.NewSharedInformerFactoryWithOptions(kubeClient, 24*time.Hour, informers.WithNamespace(config.OperandNamespace))
 	// this only handles the case for the default router which is used for oauth-server, console, and other
 	// platform services.  The scheduler bug will need to be fixed to correct the rest.
 	// Once https://bugzilla.redhat.com/show_bug.cgi?id=2062459 is fixed, this controller can be removed.
 	forcePodSpread := onepodpernodeccontroller.NewOnePodPerNodeController(
 		"spread-default-router-pods",
-		operatorcontroller.DefaultOperandNamespace,
+		config.OperandNamespace,
 		operatorcontroller.IngressControllerDeploymentPodSelector(&operatorv1.IngressController{ObjectMeta: metav1.ObjectMeta{Name: "default"}}),
 		30, // minReadySeconds from deployments.apps/router-default
-		events.NewKubeRecorder(kubeClient.CoreV1().Events(config.Namespace), "cluster-ingress-operator", &corev1.ObjectReference{
-			APIVersion: "apps/v1",
-			Kind:       "Deployment",
-			Namespace:  config.Namespace,
-			Name:       "ingress-operator",
-		}),
+		recorder,
 		// ingress operator appears to be wired to a namespaced resource
 		v1helpers.NewFakeOperatorClient(&operatorv1.OperatorSpec{ManagementState: operatorv1.Managed}, &operatorv1.OperatorStatus{}, nil),
 		kubeClient,
 		namespaceInformers.Core().V1().Pods(),
 	)
-	go forcePodSpread.Run(context.TODO(), 1)
+	// The pod spread controller evicts pods, so only the leader may run
+	// it.  The informers that it uses are started on every replica so
+	// that their caches are warm.
+	if err := mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+		forcePodSpread.Run(ctx, 1)
+		return nil
+	})); err != nil {
+		return nil, fmt.Errorf("failed to add pod spread controller: %w", err)
+	}
 	go namespaceInformers.Start(config.Stop)
 
 	// Create and register the configurable route controller with the operator manager.
 	if _, err := configurableroutecontroller.New(mgr, configurableroutecontroller.Config{
-		SecretNamespace: operatorcontroller.GlobalUserSpecifiedConfigNamespace,
+		SecretNamespace: config.ConfigNamespace,
 	}, events.NewLoggingEventRecorder(configurableroutecontroller.ControllerName)); err != nil {
 		return nil, fmt.Errorf("failed to create configurable-route controller: %v", err)
 	}
@@ -145,40 +219,49 @@ func New(config operatorconfig.Config, kubeConfig *rest.Config) (*Operator, erro
 		IngressControllerImage: config.IngressControllerImage,
 		CanaryImage:            config.CanaryImage,
 		OperatorReleaseVersion: config.OperatorReleaseVersion,
+		OperandNamespace:       config.OperandNamespace,
+		CanaryNamespace:        config.CanaryNamespace,
 	}); err != nil {
 		return nil, fmt.Errorf("failed to create status controller: %v", err)
 	}
 
 	// Set up the certificate controller
-	if _, err := certcontroller.New(mgr, config.Namespace); err != nil {
+	if _, err := certcontroller.New(mgr, certcontroller.Config{
+		OperatorNamespace: config.Namespace,
+		OperandNamespace:  config.OperandNamespace,
+		WebhookEnabled:    len(config.WebhookListenAddr) != 0,
+	}); err != nil {
 		return nil, fmt.Errorf("failed to create cacert controller: %v", err)
 	}
 
 	// Set up the error-page configmap controller.
 	if _, err := errorpageconfigmapcontroller.New(mgr, errorpageconfigmapcontroller.Config{
 		OperatorNamespace: config.Namespace,
-		ConfigNamespace:   operatorcontroller.GlobalUserSpecifiedConfigNamespace,
-		OperandNamespace:  operatorcontroller.DefaultOperandNamespace,
+		ConfigNamespace:   config.ConfigNamespace,
+		OperandNamespace:  config.OperandNamespace,
 	}); err != nil {
 		return nil, fmt.Errorf("failed to create error-page configmap controller: %w", err)
 	}
 
 	// Set up the certificate-publisher controller
-	if _, err := certpublishercontroller.New(mgr, config.Namespace, operatorcontroller.DefaultOperandNamespace); err != nil {
+	if _, err := certpublishercontroller.New(mgr, config.Namespace, config.OperandNamespace); err != nil {
 		return nil, fmt.Errorf("failed to create certificate-publisher controller: %v", err)
 	}
 
 	// Set up the client CA configmap controller
 	if _, err := clientcacontroller.New(mgr, clientcacontroller.Config{
 		OperatorNamespace: config.Namespace,
-		SourceNamespace:   operatorcontroller.GlobalUserSpecifiedConfigNamespace,
-		TargetNamespace:   operatorcontroller.DefaultOperandNamespace,
+		SourceNamespace:   config.ConfigNamespace,
+		TargetNamespace:   config.OperandNamespace,
 	}); err != nil {
 		return nil, fmt.Errorf("failed to create client CA configmap controller: %w", err)
 	}
 
 	// Set up the crl controller
-	if _, err := crlcontroller.New(mgr); err != nil {
+	if _, err := crlcontroller.New(mgr, crlcontroller.Config{
+		OperandNamespace: config.OperandNamespace,
+		TrustedCABundle:  config.TrustedCABundle,
+	}); err != nil {
 		return nil, fmt.Errorf("failed to create crl controller: %v", err)
 	}
 
@@ -186,6 +269,7 @@ func New(config operatorconfig.Config, kubeConfig *rest.Config) (*Operator, erro
 	if _, err := dnscontroller.New(mgr, dnscontroller.Config{
 		Namespace:              config.Namespace,
 		OperatorReleaseVersion: config.OperatorReleaseVersion,
+		TrustedCABundle:        config.TrustedCABundle,
 	}); err != nil {
 		return nil, fmt.Errorf("failed to create dns controller: %v", err)
 	}
@@ -201,30 +285,74 @@ func New(config operatorconfig.Config, kubeConfig *rest.Config) (*Operator, erro
 	// Canary can be disabled when running the operator locally.
 	if len(config.CanaryImage) != 0 {
 		if _, err := canarycontroller.New(mgr, canarycontroller.Config{
-			Namespace:   config.Namespace,
-			CanaryImage: config.CanaryImage,
-			Stop:        config.Stop,
+			Namespace:       config.Namespace,
+			CanaryNamespace: config.CanaryNamespace,
+			CanaryImage:     config.CanaryImage,
+			Stop:            config.Stop,
 		}); err != nil {
 			return nil, fmt.Errorf("failed to create canary controller: %v", err)
 		}
 	}
 
+	// Set up the admission webhook.
+	if len(config.WebhookListenAddr) != 0 {
+		if err := webhook.New(mgr, webhook.Config{
+			ListenAddr:         config.WebhookListenAddr,
+			Namespace:          config.Namespace,
+			OperandNamespace:   config.OperandNamespace,
+			ConfigNamespace:    config.ConfigNamespace,
+			OTelCollectorImage: config.OTelCollectorImage,
+			OperatorImage:      config.CanaryImage,
+		}); err != nil {
+			return nil, fmt.Errorf("failed to create webhook: %w", err)
+		}
+	}
+
 	// Set up the route metrics controller.
 	if _, err := routemetricscontroller.New(mgr, config.Namespace); err != nil {
 		return nil, fmt.Errorf("failed to create route metrics controller: %w", err)
 	}
 
+	if config.TrustedCABundle != nil {
+		trustedCABundleLastReloadSuccess.SetToCurrentTime()
+	}
+
 	return &Operator{
 		manager: mgr,
 		// TODO: These are only needed for the default ingress controller stuff, which
 		// should be refactored away.
-		client:    mgr.GetClient(),
-		namespace: config.Namespace,
+		client:          mgr.GetClient(),
+		namespace:       config.Namespace,
+		informersSynced: namespaceInformers.Core().V1().Pods().Informer().HasSynced,
+		trustedCABundle: config.TrustedCABundle,
+		recorder:        recorder,
 	}, nil
 }
 
-// Start creates the default IngressController and then starts the operator
-// synchronously until a message is received on the stop channel.
+// validateNamespaces returns an error if the given configuration does not
+// specify the operator's namespaces or specifies the same namespace for
+// operands and for the canary.
+func validateNamespaces(config operatorconfig.Config) error {
+	for name, ns := range map[string]string{
+		"operator": config.Namespace,
+		"operand":  config.OperandNamespace,
+		"canary":   config.CanaryNamespace,
+		"config":   config.ConfigNamespace,
+	} {
+		if len(ns) == 0 {
+			return fmt.Errorf("%s namespace must be specified", name)
+		}
+	}
+	if config.OperandNamespace == config.CanaryNamespace {
+		return fmt.Errorf("operand and canary namespaces must differ, both are %q", config.OperandNamespace)
+	}
+	return nil
+}
+
+// Start starts the operator and, once the operator is elected leader (or
+// immediately, if leader election is disabled), creates the default
+// IngressController.  Start runs synchronously until the context is canceled or
+// the operator manager exits.
 // TODO: Move the default IngressController logic elsewhere.
 func (o *Operator) Start(ctx context.Context) error {
 
@@ -233,11 +361,32 @@ func (o *Operator) Start(ctx context.Context) error {
 		return fmt.Errorf("failed fetching infrastructure config: %w", err)
 	}
 
-	if infraConfig.Status.ControlPlaneTopology == configv1.ExternalTopologyMode {
-		log.Info("skipping default ingress controller creation")
-	} else {
+	errChan := make(chan error)
+	go func() {
+		errChan <- o.manager.Start(ctx)
+	}()
+
+	// The rest of the startup logic mutates cluster state, so only the
+	// leader may run it.
+	go func() {
+		select {
+		case <-ctx.Done():
+			return
+		case <-o.manager.Elected():
+		}
+		log.Info("acquired leadership")
+		isLeader.Set(1)
+
+		if err := o.handleSingleNode4Dot11Upgrade(); err != nil {
+			log.Error(err, "failed to handle single node 4.11 upgrade logic")
+		}
+
+		if infraConfig.Status.ControlPlaneTopology == configv1.ExternalTopologyMode {
+			log.Info("skipping default ingress controller creation")
+			return
+		}
 		// Periodicaly ensure the default controller exists.
-		go wait.Until(func() {
+		wait.Until(func() {
 			if !o.manager.GetCache().WaitForCacheSync(ctx) {
 				log.Error(nil, "failed to sync cache before ensuring default ingresscontroller")
 				return
@@ -253,16 +402,6 @@ func (o *Operator) Start(ctx context.Context) error {
 				log.Error(err, "failed to ensure default ingresscontroller")
 			}
 		}, 1*time.Minute, ctx.Done())
-
-	}
-
-	if err := o.handleSingleNode4Dot11Upgrade(); err != nil {
-		log.Error(err, "failed to handle single node 4.11 upgrade logic")
-	}
-
-	errChan := make(chan error)
-	go func() {
-		errChan <- o.manager.Start(ctx)
 	}()
 
 	// Wait for the manager to exit or an explicit stop.
@@ -0,0 +1,34 @@
+package operator
+
+import (
+	"fmt"
+)
+
+// ReloadTrustedCABundle reloads the operator's trusted CA bundle from its file.
+// If the bundle changed, the operator's controllers recreate their HTTP clients
+// and DNS providers with the new bundle.  ReloadTrustedCABundle reports the
+// result using metrics and an event on the operator's deployment, and returns
+// an error if the bundle could not be reloaded, in which case the operator
+// keeps using the previous bundle.
+func (o *Operator) ReloadTrustedCABundle() error {
+	if o.trustedCABundle == nil {
+		return fmt.Errorf("operator has no trusted CA bundle to reload")
+	}
+
+	changed, err := o.trustedCABundle.Reload()
+	if err != nil {
+		trustedCABundleReloadsTotal.WithLabelValues("failure").Inc()
+		o.recorder.Warningf("TrustedCABundleReloadFailed", "Failed to reload trusted CA bundle %s: %v", o.trustedCABundle.Path(), err)
+		return err
+	}
+	if !changed {
+		log.Info("trusted CA bundle is unchanged", "filename", o.trustedCABundle.Path())
+		return nil
+	}
+
+	trustedCABundleReloadsTotal.WithLabelValues("success").Inc()
+	trustedCABundleLastReloadSuccess.SetToCurrentTime()
+	o.recorder.Eventf("TrustedCABundleReloaded", "Reloaded trusted CA bundle %s", o.trustedCABundle.Path())
+	log.Info("reloaded trusted CA bundle", "filename", o.trustedCABundle.Path(), "generation", o.trustedCABundle.Generation())
+	return nil
+}
@@ -0,0 +1,52 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+
+	iov1 "github.com/openshift/api/operatoringress/v1"
+
+	dnscontroller "github.com/openshift/cluster-ingress-operator/pkg/operator/controller/dns"
+
+	admissionv1 "k8s.io/api/admission/v1"
+
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// dnsRecordValidator rejects dnsrecords that the DNS providers cannot publish.
+type dnsRecordValidator struct {
+	decoder *admission.Decoder
+}
+
+// Handle implements admission.Handler.
+func (v *dnsRecordValidator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	record := &iov1.DNSRecord{}
+	if err := v.decoder.Decode(req, record); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+	if record.DeletionTimestamp != nil {
+		return admission.Allowed("")
+	}
+	if req.Operation == admissionv1.Update {
+		old := &iov1.DNSRecord{}
+		if err := v.decoder.DecodeRaw(req.OldObject, old); err != nil {
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+		// Updates that do not change the spec, such as updates to
+		// finalizers or annotations, are always allowed so that the
+		// operator can clean up records that predate the webhook.
+		if reflect.DeepEqual(old.Spec, record.Spec) {
+			return admission.Allowed("")
+		}
+	}
+
+	errs, warnings := dnscontroller.ValidateDNSRecord(record)
+	if err := utilerrors.NewAggregate(errs); err != nil {
+		return admission.Denied(fmt.Sprintf("dnsrecord %s is invalid: %v", record.Name, err)).WithWarnings(warnings...)
+	}
+	return admission.Allowed("").WithWarnings(warnings...)
+}
@@ -0,0 +1,184 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+
+	configv1 "github.com/openshift/api/config/v1"
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	ingresscontroller "github.com/openshift/cluster-ingress-operator/pkg/operator/controller/ingress"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// ingressControllerAdmitter runs the operator's admission and defaulting logic
+// against ingresscontrollers for the ingresscontroller webhooks.
+type ingressControllerAdmitter struct {
+	client             client.Reader
+	decoder            *admission.Decoder
+	namespace          string
+	operandNamespace   string
+	configNamespace    string
+	otelCollectorImage string
+	operatorImage      string
+}
+
+// ingressControllerValidator rejects ingresscontrollers that admission would
+// reject, and warns about settings that the operator would ignore or change.
+type ingressControllerValidator struct {
+	ingressControllerAdmitter
+}
+
+// Handle implements admission.Handler.
+func (v *ingressControllerValidator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	ic := &operatorv1.IngressController{}
+	if err := v.decoder.Decode(req, ic); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+	if ic.DeletionTimestamp != nil {
+		return admission.Allowed("")
+	}
+	if req.Operation == admissionv1.Update {
+		old := &operatorv1.IngressController{}
+		if err := v.decoder.DecodeRaw(req.OldObject, old); err != nil {
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+		// Updates that do not change the spec, such as the
+		// operator's updates to finalizers, are always allowed so
+		// that the operator can finalize ingresscontrollers that
+		// admission rejected.
+		if reflect.DeepEqual(old.Spec, ic.Spec) {
+			return admission.Allowed("")
+		}
+	}
+
+	result, err := v.lint(ctx, ic)
+	if err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+	if len(result.Errors) != 0 {
+		return admission.Denied(fmt.Sprintf("ingresscontroller %s is invalid: %s", ic.Name, strings.Join(result.Errors, "; "))).WithWarnings(result.Warnings...)
+	}
+	return admission.Allowed("").WithWarnings(result.Warnings...)
+}
+
+// ingressControllerDefaulter sets the domain that admission would give a new
+// ingresscontroller in the ingresscontroller's spec, so that the domain persists
+// even if the cluster config changes later.  The endpoint publishing strategy is
+// not persisted: the operator derives it from the cluster config and from
+// upgrade migrations, and a copy in the spec would stop those from applying.
+// A domain that the ingresscontroller already specifies is never changed, and
+// ingresscontrollers that admission would reject are left to the validator.
+type ingressControllerDefaulter struct {
+	ingressControllerAdmitter
+}
+
+// Handle implements admission.Handler.
+func (d *ingressControllerDefaulter) Handle(ctx context.Context, req admission.Request) admission.Response {
+	// Defaults are only set on creation; changing the spec of an
+	// existing ingresscontroller could change how it is published.
+	if req.Operation != admissionv1.Create {
+		return admission.Allowed("")
+	}
+	ic := &operatorv1.IngressController{}
+	if err := d.decoder.Decode(req, ic); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+	if len(ic.Spec.Domain) != 0 {
+		return admission.Allowed("")
+	}
+
+	result, err := d.lint(ctx, ic)
+	if err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+	if len(result.Errors) != 0 {
+		return admission.Allowed("")
+	}
+
+	defaulted := ic.DeepCopy()
+	defaulted.Spec.Domain = result.IngressController.Status.Domain
+	marshaled, err := json.Marshal(defaulted)
+	if err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+	return admission.PatchResponseFromRaw(req.Object.Raw, marshaled)
+}
+
+// lint runs admission and defaulting logic against the given ingresscontroller
+// with the current cluster config and the existing ingresscontrollers.
+func (a *ingressControllerAdmitter) lint(ctx context.Context, ic *operatorv1.IngressController) (*ingresscontroller.LintResult, error) {
+	config, err := a.renderConfig(ctx, ic)
+	if err != nil {
+		return nil, err
+	}
+	existing := &operatorv1.IngressControllerList{}
+	if err := a.client.List(ctx, existing, client.InNamespace(a.namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list ingresscontrollers: %w", err)
+	}
+	return ingresscontroller.LintIngressController(ic, existing.Items, config), nil
+}
+
+// renderConfig returns the cluster config with which to admit the given
+// ingresscontroller.
+func (a *ingressControllerAdmitter) renderConfig(ctx context.Context, ic *operatorv1.IngressController) (ingresscontroller.RenderConfig, error) {
+	config := ingresscontroller.RenderConfig{
+		OperatorNamespace:  a.namespace,
+		OperandNamespace:   a.operandNamespace,
+		OTelCollectorImage: a.otelCollectorImage,
+		OperatorImage:      a.operatorImage,
+		APIConfig:          &configv1.APIServer{},
+		DNSConfig:          &configv1.DNS{},
+		InfraConfig:        &configv1.Infrastructure{},
+		IngressConfig:      &configv1.Ingress{},
+		NetworkConfig:      &configv1.Network{},
+	}
+	if err := a.client.Get(ctx, types.NamespacedName{Name: "cluster"}, config.APIConfig); err != nil {
+		return config, fmt.Errorf("failed to get apiserver 'cluster': %w", err)
+	}
+	if err := a.client.Get(ctx, types.NamespacedName{Name: "cluster"}, config.DNSConfig); err != nil {
+		return config, fmt.Errorf("failed to get dns 'cluster': %w", err)
+	}
+	if err := a.client.Get(ctx, types.NamespacedName{Name: "cluster"}, config.InfraConfig); err != nil {
+		return config, fmt.Errorf("failed to get infrastructure 'cluster': %w", err)
+	}
+	if err := a.client.Get(ctx, types.NamespacedName{Name: "cluster"}, config.IngressConfig); err != nil {
+		return config, fmt.Errorf("failed to get ingress 'cluster': %w", err)
+	}
+	if err := a.client.Get(ctx, types.NamespacedName{Name: "cluster"}, config.NetworkConfig); err != nil {
+		return config, fmt.Errorf("failed to get network 'cluster': %w", err)
+	}
+	// A missing platform status is a problem with the cluster, not with
+	// the ingresscontroller, so it must not cause the ingresscontroller to
+	// be rejected.
+	if config.InfraConfig.Status.PlatformStatus == nil {
+		return config, fmt.Errorf("failed to determine infrastructure platform status: PlatformStatus is nil")
+	}
+
+	// The client CA configmap may legitimately be created after the
+	// ingresscontroller, so a missing configmap only produces a warning.
+	if name := ic.Spec.ClientTLS.ClientCA.Name; len(name) != 0 {
+		cm := &corev1.ConfigMap{}
+		if err := a.client.Get(ctx, types.NamespacedName{Namespace: a.configNamespace, Name: name}, cm); err != nil {
+			if !errors.IsNotFound(err) {
+				return config, fmt.Errorf("failed to get configmap %s/%s: %w", a.configNamespace, name, err)
+			}
+		} else {
+			config.ClientCAConfigMap = cm
+		}
+	}
+
+	return config, nil
+}
@@ -0,0 +1,258 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	configv1 "github.com/openshift/api/config/v1"
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	operatorclient "github.com/openshift/cluster-ingress-operator/pkg/operator/client"
+
+	admissionv1 "k8s.io/api/admission/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// TestIngressControllerValidator verifies that the ingresscontroller webhook
+// rejects ingresscontrollers that admission would reject and allows updates
+// that do not change the spec.
+func TestIngressControllerValidator(t *testing.T) {
+	ic := func(name, domain, overrides string) *operatorv1.IngressController {
+		ic := &operatorv1.IngressController{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "openshift-ingress-operator", Name: name, UID: types.UID("uid-" + name)},
+			Spec:       operatorv1.IngressControllerSpec{Domain: domain},
+		}
+		if len(overrides) != 0 {
+			ic.Spec.UnsupportedConfigOverrides = runtime.RawExtension{Raw: []byte(overrides)}
+		}
+		return ic
+	}
+	admittedDefault := ic("default", "", "")
+	admittedDefault.Status.Domain = "apps.example.com"
+	admittedDefault.Status.Conditions = []operatorv1.OperatorCondition{{
+		Type:   "Admitted",
+		Status: operatorv1.ConditionTrue,
+	}}
+
+	testCases := []struct {
+		name           string
+		objects        []client.Object
+		ic             *operatorv1.IngressController
+		old            *operatorv1.IngressController
+		expectAllowed  bool
+		expectCode     int32
+		expectInReason string
+	}{
+		{
+			name:          "valid ingresscontroller",
+			objects:       clusterConfig(&configv1.PlatformStatus{Type: configv1.AWSPlatformType}),
+			ic:            ic("default", "", ""),
+			expectAllowed: true,
+		},
+		{
+			name:           "duplicate domain",
+			objects:        append(clusterConfig(&configv1.PlatformStatus{Type: configv1.AWSPlatformType}), admittedDefault),
+			ic:             ic("sharded", "apps.example.com", ""),
+			expectInReason: "conflicts with: default",
+		},
+		{
+			name:           "malformed unsupportedConfigOverrides",
+			objects:        clusterConfig(&configv1.PlatformStatus{Type: configv1.AWSPlatformType}),
+			ic:             ic("default", "", `{"replicaPolicy":{"defaultReplicas":"Bogus"}}`),
+			expectInReason: "spec.unsupportedConfigOverrides.replicaPolicy.defaultReplicas",
+		},
+		{
+			name:          "update that does not change the spec of an invalid ingresscontroller",
+			objects:       clusterConfig(&configv1.PlatformStatus{Type: configv1.AWSPlatformType}),
+			ic:            ic("default", "", `{"replicaPolicy":{"defaultReplicas":"Bogus"}}`),
+			old:           ic("default", "", `{"replicaPolicy":{"defaultReplicas":"Bogus"}}`),
+			expectAllowed: true,
+		},
+		{
+			name:    "update that does not conflict with itself",
+			objects: append(clusterConfig(&configv1.PlatformStatus{Type: configv1.AWSPlatformType}), admittedDefault),
+			ic: func() *operatorv1.IngressController {
+				ic := admittedDefault.DeepCopy()
+				ic.Spec.Replicas = new(int32)
+				return ic
+			}(),
+			old:           admittedDefault,
+			expectAllowed: true,
+		},
+		{
+			name:       "no platform status",
+			objects:    clusterConfig(nil),
+			ic:         ic("default", "", ""),
+			expectCode: http.StatusInternalServerError,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			scheme := operatorclient.GetScheme()
+			decoder, err := admission.NewDecoder(scheme)
+			if err != nil {
+				t.Fatal(err)
+			}
+			v := &ingressControllerValidator{ingressControllerAdmitter{
+				client:           fake.NewClientBuilder().WithScheme(scheme).WithObjects(tc.objects...).Build(),
+				decoder:          decoder,
+				namespace:        "openshift-ingress-operator",
+				operandNamespace: "openshift-ingress",
+				configNamespace:  "openshift-config",
+			}}
+			req := admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+				Operation: admissionv1.Create,
+				Object:    runtime.RawExtension{Raw: mustMarshal(t, tc.ic)},
+			}}
+			if tc.old != nil {
+				req.Operation = admissionv1.Update
+				req.OldObject = runtime.RawExtension{Raw: mustMarshal(t, tc.old)}
+			}
+			resp := v.Handle(context.Background(), req)
+			if tc.expectCode != 0 {
+				if resp.Result == nil || resp.Result.Code != tc.expectCode {
+					t.Fatalf("expected code %d, got %+v", tc.expectCode, resp.Result)
+				}
+				return
+			}
+			if resp.Allowed != tc.expectAllowed {
+				t.Fatalf("expected allowed=%t, got %+v", tc.expectAllowed, resp.Result)
+			}
+			if len(tc.expectInReason) != 0 && !strings.Contains(string(resp.Result.Reason), tc.expectInReason) {
+				t.Errorf("expected reason to contain %q, got %q", tc.expectInReason, resp.Result.Reason)
+			}
+		})
+	}
+}
+
+// TestIngressControllerDefaulter verifies that the ingresscontroller webhook
+// sets the domain that admission would set in the spec of a new
+// ingresscontroller that does not specify one, never sets the endpoint
+// publishing strategy, and leaves other ingresscontrollers unchanged.
+func TestIngressControllerDefaulter(t *testing.T) {
+	ic := func(domain string, eps *operatorv1.EndpointPublishingStrategy, overrides string) *operatorv1.IngressController {
+		ic := &operatorv1.IngressController{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "openshift-ingress-operator", Name: "default"},
+			Spec: operatorv1.IngressControllerSpec{
+				Domain:                     domain,
+				EndpointPublishingStrategy: eps,
+			},
+		}
+		if len(overrides) != 0 {
+			ic.Spec.UnsupportedConfigOverrides = runtime.RawExtension{Raw: []byte(overrides)}
+		}
+		return ic
+	}
+	// expectDomain is the domain that the webhook is expected to patch
+	// into the spec, if any.
+	testCases := []struct {
+		name         string
+		ic           *operatorv1.IngressController
+		operation    admissionv1.Operation
+		expectDomain string
+	}{
+		{
+			name:         "new ingresscontroller without defaults",
+			ic:           ic("", nil, ""),
+			operation:    admissionv1.Create,
+			expectDomain: "apps.example.com",
+		},
+		{
+			name:         "new ingresscontroller with a strategy",
+			ic:           ic("", &operatorv1.EndpointPublishingStrategy{Type: operatorv1.PrivateStrategyType}, ""),
+			operation:    admissionv1.Create,
+			expectDomain: "apps.example.com",
+		},
+		{
+			name:      "new ingresscontroller with a domain",
+			ic:        ic("apps.example.org", nil, ""),
+			operation: admissionv1.Create,
+		},
+		{
+			name:      "new invalid ingresscontroller",
+			ic:        ic("", nil, `{"replicaPolicy":{"defaultReplicas":"Bogus"}}`),
+			operation: admissionv1.Create,
+		},
+		{
+			name:      "updated ingresscontroller",
+			ic:        ic("", nil, ""),
+			operation: admissionv1.Update,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			scheme := operatorclient.GetScheme()
+			decoder, err := admission.NewDecoder(scheme)
+			if err != nil {
+				t.Fatal(err)
+			}
+			d := &ingressControllerDefaulter{ingressControllerAdmitter{
+				client:           fake.NewClientBuilder().WithScheme(scheme).WithObjects(clusterConfig(&configv1.PlatformStatus{Type: configv1.GCPPlatformType})...).Build(),
+				decoder:          decoder,
+				namespace:        "openshift-ingress-operator",
+				operandNamespace: "openshift-ingress",
+				configNamespace:  "openshift-config",
+			}}
+			raw := mustMarshal(t, tc.ic)
+			resp := d.Handle(context.Background(), admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+				Operation: tc.operation,
+				Object:    runtime.RawExtension{Raw: raw},
+			}})
+			if !resp.Allowed {
+				t.Fatalf("expected the ingresscontroller to be allowed, got %+v", resp.Result)
+			}
+			var domain string
+			for _, patch := range resp.Patches {
+				switch patch.Path {
+				case "/spec/domain":
+					domain = patch.Value.(string)
+				default:
+					t.Errorf("unexpected patch: %+v", patch)
+				}
+			}
+			if domain != tc.expectDomain {
+				t.Errorf("expected domain patch %q, got %q", tc.expectDomain, domain)
+			}
+		})
+	}
+}
+
+// clusterConfig returns the cluster config objects that the ingresscontroller
+// webhooks read, with the given platform status.
+func clusterConfig(platformStatus *configv1.PlatformStatus) []client.Object {
+	return []client.Object{
+		&configv1.APIServer{ObjectMeta: metav1.ObjectMeta{Name: "cluster"}},
+		&configv1.DNS{
+			ObjectMeta: metav1.ObjectMeta{Name: "cluster"},
+			Spec:       configv1.DNSSpec{BaseDomain: "example.com"},
+		},
+		&configv1.Infrastructure{
+			ObjectMeta: metav1.ObjectMeta{Name: "cluster"},
+			Status:     configv1.InfrastructureStatus{PlatformStatus: platformStatus},
+		},
+		&configv1.Ingress{
+			ObjectMeta: metav1.ObjectMeta{Name: "cluster"},
+			Spec:       configv1.IngressSpec{Domain: "apps.example.com"},
+		},
+		&configv1.Network{ObjectMeta: metav1.ObjectMeta{Name: "cluster"}},
+	}
+}
+
+func mustMarshal(t *testing.T, obj interface{}) []byte {
+	t.Helper()
+	data, err := json.Marshal(obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
@@ -0,0 +1,192 @@
+// The webhook package implements the operator's admission webhooks.
+//
+// The validating webhook rejects ingresscontrollers and dnsrecords that the operator would
+// reject or fail to publish when they are created or updated, instead of
+// accepting them and reporting the problem asynchronously in their status.  The
+// webhook runs the same admission and defaulting logic that the ingress
+// controller runs, so an ingresscontroller is validated with the defaults that
+// admission would set.  Admission remains authoritative; the webhook's
+// configuration ignores failures to call the webhook.
+//
+// The mutating webhook sets the domain that admission would give a new
+// ingresscontroller in the ingresscontroller's spec if the spec does not
+// specify one, so that the domain persists.
+//
+// The certificate controller manages the webhook's serving certificate and its
+// validatingwebhookconfiguration and mutatingwebhookconfiguration.
+package webhook
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"sync"
+
+	logf "github.com/openshift/cluster-ingress-operator/pkg/log"
+	operatorcontroller "github.com/openshift/cluster-ingress-operator/pkg/operator/controller"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+const (
+	// IngressControllerPath is the path on which the webhook validates
+	// ingresscontrollers.
+	IngressControllerPath = "/validate-ingresscontroller"
+	// IngressControllerDefaultingPath is the path on which the webhook
+	// defaults ingresscontrollers.
+	IngressControllerDefaultingPath = "/default-ingresscontroller"
+	// DNSRecordPath is the path on which the webhook validates dnsrecords.
+	DNSRecordPath = "/validate-dnsrecord"
+)
+
+var log = logf.Logger.WithName("webhook")
+
+// Config holds all the things necessary for the webhook to run.
+type Config struct {
+	// ListenAddr is the address on which the webhook listens.
+	ListenAddr string
+	// Namespace is the operator namespace.
+	Namespace string
+	// OperandNamespace is the namespace in which the operator manages
+	// the router deployments.
+	OperandNamespace string
+	// ConfigNamespace is the namespace in which ingresscontrollers'
+	// client CA configmaps are expected.
+	ConfigNamespace string
+	// OTelCollectorImage is the OpenTelemetry collector image with which
+	// the operator is configured.
+	OTelCollectorImage string
+	// OperatorImage is the ingress operator image with which the operator
+	// is configured.
+	OperatorImage string
+}
+
+// New creates the webhook server and adds it to the given manager.  The
+// server runs on every replica of the operator, whether or not it is the
+// leader.
+func New(mgr manager.Manager, config Config) error {
+	decoder, err := admission.NewDecoder(mgr.GetScheme())
+	if err != nil {
+		return fmt.Errorf("failed to create decoder: %w", err)
+	}
+	admitter := ingressControllerAdmitter{
+		client:             mgr.GetClient(),
+		decoder:            decoder,
+		namespace:          config.Namespace,
+		operandNamespace:   config.OperandNamespace,
+		configNamespace:    config.ConfigNamespace,
+		otelCollectorImage: config.OTelCollectorImage,
+		operatorImage:      config.OperatorImage,
+	}
+	s := &server{
+		config: config,
+		client: mgr.GetClient(),
+		scheme: mgr.GetScheme(),
+		handlers: map[string]admission.Handler{
+			IngressControllerPath:           &ingressControllerValidator{admitter},
+			IngressControllerDefaultingPath: &ingressControllerDefaulter{admitter},
+			DNSRecordPath: &dnsRecordValidator{
+				decoder: decoder,
+			},
+		},
+	}
+	return mgr.Add(s)
+}
+
+// server serves the webhook's handlers over TLS.
+type server struct {
+	config   Config
+	client   client.Reader
+	scheme   *runtime.Scheme
+	handlers map[string]admission.Handler
+
+	// lock protects cert and certResourceVersion.
+	lock sync.Mutex
+	// cert is the parsed serving certificate.
+	cert *tls.Certificate
+	// certResourceVersion is the resource version of the secret from
+	// which cert was parsed.
+	certResourceVersion string
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable.  The API server
+// may call the webhook on any replica.
+func (s *server) NeedLeaderElection() bool {
+	return false
+}
+
+// Start implements manager.Runnable.  Start serves the webhook until the given
+// context is canceled.
+func (s *server) Start(ctx context.Context) error {
+	mux := http.NewServeMux()
+	for path, handler := range s.handlers {
+		hook, err := admission.StandaloneWebhook(&admission.Webhook{Handler: handler}, admission.StandaloneOptions{
+			Scheme:      s.scheme,
+			Logger:      log.WithValues("path", path),
+			MetricsPath: path,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create webhook for %s: %w", path, err)
+		}
+		mux.Handle(path, hook)
+	}
+	srv := &http.Server{
+		Addr:    s.config.ListenAddr,
+		Handler: mux,
+		TLSConfig: &tls.Config{
+			MinVersion:     tls.VersionTLS12,
+			GetCertificate: s.getCertificate,
+		},
+	}
+
+	log.Info("starting webhook listener", "addr", s.config.ListenAddr)
+	errChan := make(chan error, 1)
+	go func() {
+		// The certificate comes from the TLS config.
+		errChan <- srv.ListenAndServeTLS("", "")
+	}()
+	select {
+	case <-ctx.Done():
+		if err := srv.Shutdown(context.Background()); err != nil {
+			log.Error(err, "error stopping webhook listener")
+		}
+		return nil
+	case err := <-errChan:
+		if err != http.ErrServerClosed {
+			return fmt.Errorf("webhook listener exited: %w", err)
+		}
+		return nil
+	}
+}
+
+// getCertificate returns the serving certificate from the webhook's serving
+// certificate secret.  The certificate is read from the secret rather than from
+// a mounted file so that the operator can start before the certificate
+// controller has created the secret, and so that the server picks up a renewed
+// certificate without restarting.
+func (s *server) getCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	name := operatorcontroller.WebhookServingCertSecretName(s.config.Namespace)
+	secret := &corev1.Secret{}
+	if err := s.client.Get(context.TODO(), name, secret); err != nil {
+		return nil, fmt.Errorf("failed to get secret %s: %w", name, err)
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.cert != nil && s.certResourceVersion == secret.ResourceVersion {
+		return s.cert, nil
+	}
+	cert, err := tls.X509KeyPair(secret.Data[corev1.TLSCertKey], secret.Data[corev1.TLSPrivateKeyKey])
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse certificate from secret %s: %w", name, err)
+	}
+	s.cert, s.certResourceVersion = &cert, secret.ResourceVersion
+	return s.cert, nil
+}
@@ -0,0 +1,166 @@
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"time"
+)
+
+// exportTimeout is the time limit for exporting a batch of spans.
+const exportTimeout = 10 * time.Second
+
+// Config is the configuration of the router tracing sidecar, which the
+// operator writes to the router tracing configmap.  The sidecar reads the
+// configuration before each export so that changes take effect without
+// restarting the router pod.
+type Config struct {
+	// Endpoint is the OTLP/HTTP URL to which the sidecar posts spans.
+	Endpoint string `json:"endpoint"`
+	// ServiceName is the service.name resource attribute of the spans.
+	ServiceName string `json:"serviceName"`
+	// ResourceAttributes are additional resource attributes of the spans.
+	ResourceAttributes map[string]string `json:"resourceAttributes,omitempty"`
+	// AuthorizationFile is the path of a file with the value of the
+	// Authorization header to send to the endpoint.  If the path is empty
+	// or the file does not exist, no Authorization header is sent.
+	AuthorizationFile string `json:"authorizationFile,omitempty"`
+	// CABundleFile is the path of a file with the PEM-encoded CA
+	// certificates with which to verify the endpoint.  If the path is empty
+	// or the file does not exist, the system certificate pool is used.
+	CABundleFile string `json:"caBundleFile,omitempty"`
+}
+
+// LoadConfig reads the router tracing sidecar's configuration from the given
+// file.
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	config := &Config{}
+	if err := json.Unmarshal(data, config); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	if len(config.Endpoint) == 0 {
+		return nil, fmt.Errorf("%s does not specify an endpoint", path)
+	}
+	return config, nil
+}
+
+// readOptionalFile returns the contents of the file at the given path, or nil
+// if the path is empty or the file does not exist.
+func readOptionalFile(path string) ([]byte, error) {
+	if len(path) == 0 {
+		return nil, nil
+	}
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	return data, err
+}
+
+// exporter exports spans to the endpoint that the sidecar's configuration
+// file specifies.
+type exporter struct {
+	configPath string
+
+	// client is the HTTP client for the CA bundle in caBundle.  The client
+	// is recreated when the CA bundle changes.
+	client   *http.Client
+	caBundle []byte
+}
+
+// httpClient returns an HTTP client that verifies the endpoint using the given
+// CA bundle, or the system certificate pool if the bundle is empty.
+func (e *exporter) httpClient(caBundle []byte) (*http.Client, error) {
+	if e.client != nil && bytes.Equal(e.caBundle, caBundle) {
+		return e.client, nil
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if len(caBundle) != 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caBundle) {
+			return nil, fmt.Errorf("CA bundle has no valid certificates")
+		}
+		transport.TLSClientConfig = &tls.Config{RootCAs: pool}
+	}
+	e.client = &http.Client{Transport: transport, Timeout: exportTimeout}
+	e.caBundle = caBundle
+	return e.client, nil
+}
+
+// export posts the given spans to the endpoint using OTLP/JSON.
+func (e *exporter) export(ctx context.Context, spans []span) error {
+	config, err := LoadConfig(e.configPath)
+	if err != nil {
+		return err
+	}
+	caBundle, err := readOptionalFile(config.CABundleFile)
+	if err != nil {
+		return fmt.Errorf("failed to read CA bundle: %w", err)
+	}
+	authorization, err := readOptionalFile(config.AuthorizationFile)
+	if err != nil {
+		return fmt.Errorf("failed to read authorization: %w", err)
+	}
+	client, err := e.httpClient(caBundle)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(exportRequest(config, spans))
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, config.Endpoint, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if value := strings.TrimSpace(string(authorization)); len(value) != 0 {
+		req.Header.Set("Authorization", value)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("endpoint %s responded with status %s: %s", config.Endpoint, resp.Status, body)
+	}
+	return nil
+}
+
+// exportRequest returns the OTLP export request for the given spans with the
+// resource attributes from the given configuration.
+func exportRequest(config *Config, spans []span) *exportTraceServiceRequest {
+	attributes := []keyValue{stringAttribute("service.name", config.ServiceName)}
+	keys := make([]string, 0, len(config.ResourceAttributes))
+	for key := range config.ResourceAttributes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		attributes = append(attributes, stringAttribute(key, config.ResourceAttributes[key]))
+	}
+	return &exportTraceServiceRequest{
+		ResourceSpans: []resourceSpans{{
+			Resource: resource{Attributes: attributes},
+			ScopeSpans: []scopeSpans{{
+				Scope: instrumentationScope{Name: routerTracingScopeName},
+				Spans: spans,
+			}},
+		}},
+	}
+}
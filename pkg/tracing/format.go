@@ -0,0 +1,98 @@
+// Package tracing implements the router tracing sidecar, which receives the
+// router's per-request log records using syslog, turns them into OpenTelemetry
+// spans, and exports the spans to an OTLP/HTTP endpoint.  It also provides the
+// HAProxy formats with which the operator configures the router to generate and
+// propagate W3C trace context and to log the records that the sidecar reads.
+package tracing
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+const (
+	// TraceparentHeaderName is the name of the W3C trace context header
+	// that identifies the trace and parent span of a request.
+	TraceparentHeaderName = "traceparent"
+	// TracestateHeaderName is the name of the W3C trace context header that
+	// carries vendor-specific trace state.
+	TracestateHeaderName = "tracestate"
+
+	// samplingScale is the number of distinct values of the random number
+	// with which the router makes sampling decisions, which determines the
+	// resolution of the sampling ratio.
+	samplingScale = 1000000
+
+	// spanIDFormat generates a random 16-digit span ID.  A version 4 UUID
+	// has 12 random hexadecimal digits in its fifth field, 8 in its first
+	// and 4 in its second, so IDs are assembled from the fields of several
+	// UUIDs.
+	spanIDFormat = "%[uuid(4),field(5,-)]%[uuid(4),field(2,-)]"
+	// traceIDFormat generates a random 32-digit trace ID.
+	traceIDFormat = "%[uuid(4),field(5,-)]%[uuid(4),field(5,-)]%[uuid(4),field(1,-)]"
+	// incomingTraceIDFormat, incomingParentIDFormat and incomingFlagsFormat
+	// are the trace ID, parent ID and trace flags of the request's
+	// traceparent header, or empty if the request has none.
+	incomingTraceIDFormat  = "%[req.hdr(traceparent),field(2,-)]"
+	incomingParentIDFormat = "%[req.hdr(traceparent),field(3,-)]"
+	incomingFlagsFormat    = "%[req.hdr(traceparent),field(4,-)]"
+
+	// traceIDLength, spanIDLength and flagsLength are the lengths of the
+	// hexadecimal fields of a traceparent header.
+	traceIDLength = 32
+	spanIDLength  = 16
+	flagsLength   = 2
+
+	// TraceparentFormat is the HAProxy log format of the traceparent
+	// header that the router sends to backends when it propagates trace
+	// context.  It names the router's span as the parent span and takes the
+	// trace ID and flags from the trace context; see UniqueIDFormat.
+	TraceparentFormat = "00-%[unique-id,bytes(16,32)]-%[unique-id,bytes(0,16)]-%[unique-id,bytes(48,2)]"
+)
+
+// UniqueIDFormat returns the HAProxy unique ID format with which the router
+// records the trace context of each request.  The unique ID is the router's
+// span ID, followed by the trace ID and flags of the request's traceparent
+// header, a generated trace ID, and generated flags, which mark the trace as
+// sampled with the given probability.  If the request has no traceparent header
+// or the header is not propagated, the generated trace ID and flags take the
+// place of the request's, so the span ID, trace ID, and flags are always the
+// first 16, next 32, and next 2 characters of the unique ID.  If the header is
+// propagated, the unique ID ends with its parent ID so that the router's span
+// becomes the child of the client's span.
+func UniqueIDFormat(propagate bool, samplingRatio float64) string {
+	sampledFlagsFormat := fmt.Sprintf("%%[rand(%d),lt(%d),iif(01,00)]", samplingScale, int64(math.Round(samplingRatio*samplingScale)))
+	if !propagate {
+		return spanIDFormat + traceIDFormat + sampledFlagsFormat
+	}
+	return spanIDFormat + incomingTraceIDFormat + incomingFlagsFormat + traceIDFormat + sampledFlagsFormat + incomingParentIDFormat
+}
+
+// logFields are the fields of the JSON object that the router logs for each
+// request, using HAProxy log format variables.  String values that come from
+// the request are escaped using the json converter; numeric values are logged
+// as JSON numbers.  The accept date's milliseconds are zero-padded, so they
+// are logged as a string.
+var logFields = []string{
+	`"traceContext":"%ID"`,
+	`"acceptTime":%Ts`,
+	`"acceptMilliseconds":"%ms"`,
+	`"handshakeTime":%Th`,
+	`"idleTime":%Ti`,
+	`"activeTime":%Ta`,
+	`"method":"%[capture.req.method,json(utf8s)]"`,
+	`"uri":"%[capture.req.uri,json(utf8s)]"`,
+	`"httpVersion":"%[capture.req.ver,json(utf8s)]"`,
+	`"statusCode":%ST`,
+	`"clientIP":"%ci"`,
+	`"frontend":"%ft"`,
+	`"backend":"%b"`,
+	`"server":"%s"`,
+	`"bytesRead":%B`,
+	`"terminationState":"%tsc"`,
+}
+
+// LogFormat is the HAProxy log format of the records from which the router
+// tracing sidecar creates spans.
+var LogFormat = "{" + strings.Join(logFields, ",") + "}"
@@ -0,0 +1,125 @@
+package tracing
+
+import (
+	"context"
+	"net"
+	"time"
+
+	logf "github.com/openshift/cluster-ingress-operator/pkg/log"
+)
+
+const (
+	// ServeCommand is the ingress operator command that runs the router
+	// tracing sidecar.
+	ServeCommand = "serve-router-tracing"
+
+	// exportInterval is the maximum time for which the sidecar holds a
+	// span before exporting it.
+	exportInterval = 5 * time.Second
+	// maxExportBatchSize is the maximum number of spans in an export.
+	maxExportBatchSize = 512
+	// maxQueuedSpans is the maximum number of spans that may wait to be
+	// exported.  Spans are dropped while the queue is full so that a slow
+	// endpoint does not make the router's log messages back up.
+	maxQueuedSpans = 4096
+	// maxLogMessageSize is the maximum size of a syslog message from the
+	// router.
+	maxLogMessageSize = 65536
+)
+
+var log = logf.Logger.WithName("router-tracing")
+
+// Serve receives the router's log records using syslog over UDP on the given
+// address and exports a span for each request in a sampled trace, using the
+// configuration in the given file, until the given context is done.
+func Serve(ctx context.Context, listenAddress, configPath string) error {
+	conn, err := net.ListenPacket("udp", listenAddress)
+	if err != nil {
+		return err
+	}
+	log.Info("receiving router log records", "address", conn.LocalAddr().String())
+
+	go func() {
+		<-ctx.Done()
+		conn.Close()
+	}()
+
+	spans := make(chan span, maxQueuedSpans)
+	go receiveSpans(ctx, conn, spans)
+
+	exportSpans(ctx, &exporter{configPath: configPath}, spans)
+	return nil
+}
+
+// receiveSpans reads syslog messages from the given connection and sends the
+// spans for sampled requests to the given channel until the given context is
+// done, and then closes the channel.
+func receiveSpans(ctx context.Context, conn net.PacketConn, spans chan<- span) {
+	defer close(spans)
+	buf := make([]byte, maxLogMessageSize)
+	dropped := 0
+	for {
+		n, _, err := conn.ReadFrom(buf)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			log.Error(err, "failed to read log message")
+			continue
+		}
+		s, err := spanFromLogMessage(buf[:n])
+		if err != nil {
+			log.Error(err, "failed to create span from log message")
+			continue
+		}
+		if s == nil {
+			continue
+		}
+		select {
+		case spans <- *s:
+			if dropped != 0 {
+				log.Info("dropped spans because the export queue was full", "count", dropped)
+				dropped = 0
+			}
+		default:
+			dropped++
+		}
+	}
+}
+
+// exportSpans exports the spans from the given channel in batches until the
+// channel is closed.
+func exportSpans(ctx context.Context, e *exporter, spans <-chan span) {
+	ticker := time.NewTicker(exportInterval)
+	defer ticker.Stop()
+
+	batch := make([]span, 0, maxExportBatchSize)
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+		// Export with a fresh context so that the spans that are
+		// pending when the sidecar is stopped are not lost.
+		exportCtx, cancel := context.WithTimeout(context.Background(), exportTimeout)
+		defer cancel()
+		if err := e.export(exportCtx, batch); err != nil {
+			log.Error(err, "failed to export spans", "count", len(batch))
+		}
+		batch = batch[:0]
+	}
+	for {
+		select {
+		case s, ok := <-spans:
+			if !ok {
+				flush()
+				return
+			}
+			batch = append(batch, s)
+			if len(batch) == maxExportBatchSize {
+				flush()
+			}
+		case <-ticker.C:
+			flush()
+		}
+	}
+}
@@ -0,0 +1,174 @@
+package tracing
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// spanKindServer is the OTLP span kind of the router's spans.
+	spanKindServer = 2
+	// statusCodeError is the OTLP status code of spans for failed
+	// requests.
+	statusCodeError = 2
+
+	// routerTracingScopeName is the name of the instrumentation scope of
+	// the router's spans.
+	routerTracingScopeName = "openshift-router"
+)
+
+// logRecord is a record that the router logs for a request using LogFormat.
+type logRecord struct {
+	TraceContext       string `json:"traceContext"`
+	AcceptTime         int64  `json:"acceptTime"`
+	AcceptMilliseconds string `json:"acceptMilliseconds"`
+	HandshakeTime      int64  `json:"handshakeTime"`
+	IdleTime           int64  `json:"idleTime"`
+	ActiveTime         int64  `json:"activeTime"`
+	Method             string `json:"method"`
+	URI                string `json:"uri"`
+	HTTPVersion        string `json:"httpVersion"`
+	StatusCode         int64  `json:"statusCode"`
+	ClientIP           string `json:"clientIP"`
+	Frontend           string `json:"frontend"`
+	Backend            string `json:"backend"`
+	Server             string `json:"server"`
+	BytesRead          int64  `json:"bytesRead"`
+	TerminationState   string `json:"terminationState"`
+}
+
+// traceContext is the trace context of a request that the router handled.
+type traceContext struct {
+	traceID      string
+	spanID       string
+	parentSpanID string
+	sampled      bool
+}
+
+// parseTraceContext parses the unique ID that the router generates using
+// UniqueIDFormat.
+func parseTraceContext(uniqueID string) (*traceContext, error) {
+	var tc traceContext
+	switch len(uniqueID) {
+	case spanIDLength + traceIDLength + flagsLength:
+	case 2 * (spanIDLength + traceIDLength + flagsLength):
+		tc.parentSpanID = uniqueID[len(uniqueID)-spanIDLength:]
+	default:
+		return nil, fmt.Errorf("trace context %q has unexpected length %d", uniqueID, len(uniqueID))
+	}
+	tc.spanID = uniqueID[:spanIDLength]
+	tc.traceID = uniqueID[spanIDLength : spanIDLength+traceIDLength]
+	flags := uniqueID[spanIDLength+traceIDLength : spanIDLength+traceIDLength+flagsLength]
+
+	for _, id := range []string{tc.spanID, tc.traceID, flags, tc.parentSpanID} {
+		if !isLowerHex(id) {
+			return nil, fmt.Errorf("trace context %q has a field that is not lower-case hexadecimal: %q", uniqueID, id)
+		}
+	}
+	if strings.Trim(tc.traceID, "0") == "" || strings.Trim(tc.spanID, "0") == "" {
+		return nil, fmt.Errorf("trace context %q has an all-zero trace or span ID", uniqueID)
+	}
+	flagsValue, _ := strconv.ParseUint(flags, 16, 8)
+	tc.sampled = flagsValue&1 == 1
+	return &tc, nil
+}
+
+// isLowerHex returns a Boolean value indicating whether the given string
+// consists only of lower-case hexadecimal digits.
+func isLowerHex(s string) bool {
+	for _, c := range s {
+		if (c < '0' || c > '9') && (c < 'a' || c > 'f') {
+			return false
+		}
+	}
+	return true
+}
+
+// parseLogMessage parses the log record in the given syslog message.  The
+// record is the JSON object that follows the syslog header.
+func parseLogMessage(message []byte) (*logRecord, error) {
+	start := bytes.IndexByte(message, '{')
+	if start == -1 {
+		return nil, fmt.Errorf("message has no log record: %q", message)
+	}
+	record := &logRecord{}
+	if err := json.Unmarshal(message[start:], record); err != nil {
+		return nil, fmt.Errorf("failed to parse log record: %w", err)
+	}
+	return record, nil
+}
+
+// spanFromLogMessage returns the span for the request in the given syslog
+// message, or nil if the request's trace is not sampled.
+func spanFromLogMessage(message []byte) (*span, error) {
+	record, err := parseLogMessage(message)
+	if err != nil {
+		return nil, err
+	}
+	tc, err := parseTraceContext(record.TraceContext)
+	if err != nil {
+		return nil, err
+	}
+	if !tc.sampled {
+		return nil, nil
+	}
+
+	// The request starts after the TLS handshake and the idle time that
+	// precedes the first byte of the request, and lasts for the active
+	// time.  HAProxy logs -1 for timers that did not run.
+	milliseconds, err := strconv.ParseInt(record.AcceptMilliseconds, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("log record has invalid acceptMilliseconds %q: %w", record.AcceptMilliseconds, err)
+	}
+	start := time.Unix(record.AcceptTime, 0).Add(time.Duration(milliseconds+nonNegative(record.HandshakeTime)+nonNegative(record.IdleTime)) * time.Millisecond)
+	end := start.Add(time.Duration(nonNegative(record.ActiveTime)) * time.Millisecond)
+
+	path, query := record.URI, ""
+	if i := strings.IndexByte(path, '?'); i != -1 {
+		path, query = path[:i], path[i+1:]
+	}
+	attributes := []keyValue{
+		stringAttribute("http.request.method", record.Method),
+		stringAttribute("url.path", path),
+		intAttribute("http.response.status_code", record.StatusCode),
+		stringAttribute("network.protocol.version", strings.TrimPrefix(record.HTTPVersion, "HTTP/")),
+		stringAttribute("client.address", record.ClientIP),
+		intAttribute("openshift.router.bytes_read", record.BytesRead),
+		stringAttribute("openshift.router.frontend", record.Frontend),
+		stringAttribute("openshift.router.backend", record.Backend),
+		stringAttribute("openshift.router.server", record.Server),
+		stringAttribute("openshift.router.termination_state", record.TerminationState),
+	}
+	if len(query) != 0 {
+		attributes = append(attributes, stringAttribute("url.query", query))
+	}
+
+	s := &span{
+		TraceID:           tc.traceID,
+		SpanID:            tc.spanID,
+		ParentSpanID:      tc.parentSpanID,
+		Name:              record.Method,
+		Kind:              spanKindServer,
+		StartTimeUnixNano: strconv.FormatInt(start.UnixNano(), 10),
+		EndTimeUnixNano:   strconv.FormatInt(end.UnixNano(), 10),
+		Attributes:        attributes,
+	}
+	// A server span is failed if the router could not get a response or
+	// returned a server error.
+	if record.StatusCode < 0 || record.StatusCode >= 500 {
+		s.Status.Code = statusCodeError
+	}
+	return s, nil
+}
+
+// nonNegative returns the given timer value, or 0 if the timer did not run.
+func nonNegative(v int64) int64 {
+	if v < 0 {
+		return 0
+	}
+	return v
+}
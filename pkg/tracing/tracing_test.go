@@ -0,0 +1,257 @@
+package tracing
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+const (
+	testSpanID   = "00f067aa0ba902b7"
+	testTraceID  = "4bf92f3577b34da6a3ce929d0e0e4736"
+	testParentID = "b7ad6b7169203331"
+	otherTraceID = "0af7651916cd43dd8448eb211c80319c"
+)
+
+// TestParseTraceContext verifies that parseTraceContext extracts the router's
+// span ID, the trace ID, the parent span ID and the sampling decision from the
+// unique IDs that the router generates using UniqueIDFormat.
+func TestParseTraceContext(t *testing.T) {
+	testCases := []struct {
+		description string
+		uniqueID    string
+		expect      *traceContext
+		expectError bool
+	}{
+		{
+			description: "generated trace context, sampled",
+			uniqueID:    testSpanID + testTraceID + "01",
+			expect:      &traceContext{traceID: testTraceID, spanID: testSpanID, sampled: true},
+		},
+		{
+			description: "generated trace context, not sampled",
+			uniqueID:    testSpanID + testTraceID + "00",
+			expect:      &traceContext{traceID: testTraceID, spanID: testSpanID},
+		},
+		{
+			description: "propagated trace context",
+			uniqueID:    testSpanID + testTraceID + "01" + otherTraceID + "00" + testParentID,
+			expect:      &traceContext{traceID: testTraceID, spanID: testSpanID, parentSpanID: testParentID, sampled: true},
+		},
+		{
+			description: "propagated trace context, not sampled by the client",
+			uniqueID:    testSpanID + testTraceID + "00" + otherTraceID + "01" + testParentID,
+			expect:      &traceContext{traceID: testTraceID, spanID: testSpanID, parentSpanID: testParentID},
+		},
+		{
+			description: "unexpected length",
+			uniqueID:    testSpanID + testTraceID,
+			expectError: true,
+		},
+		{
+			description: "upper-case trace ID",
+			uniqueID:    testSpanID + "4BF92F3577B34DA6A3CE929D0E0E4736" + "01",
+			expectError: true,
+		},
+		{
+			description: "malformed parent ID",
+			uniqueID:    testSpanID + testTraceID + "01" + otherTraceID + "00" + "b7ad6b716920333-",
+			expectError: true,
+		},
+		{
+			description: "all-zero trace ID",
+			uniqueID:    testSpanID + "00000000000000000000000000000000" + "01",
+			expectError: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			actual, err := parseTraceContext(tc.uniqueID)
+			switch {
+			case tc.expectError && err == nil:
+				t.Fatalf("expected error, got %+v", actual)
+			case !tc.expectError && err != nil:
+				t.Fatalf("unexpected error: %v", err)
+			case !tc.expectError && !reflect.DeepEqual(tc.expect, actual):
+				t.Errorf("expected %+v, got %+v", tc.expect, actual)
+			}
+		})
+	}
+}
+
+// TestUniqueIDFormat verifies that the sampling ratio is rendered into the
+// unique ID format and that propagation appends the incoming trace context.
+func TestUniqueIDFormat(t *testing.T) {
+	testCases := []struct {
+		propagate     bool
+		samplingRatio float64
+		expect        string
+	}{
+		{false, 1, spanIDFormat + traceIDFormat + "%[rand(1000000),lt(1000000),iif(01,00)]"},
+		{false, 0, spanIDFormat + traceIDFormat + "%[rand(1000000),lt(0),iif(01,00)]"},
+		{true, 0.25, spanIDFormat + incomingTraceIDFormat + incomingFlagsFormat + traceIDFormat + "%[rand(1000000),lt(250000),iif(01,00)]" + incomingParentIDFormat},
+	}
+	for _, tc := range testCases {
+		if actual := UniqueIDFormat(tc.propagate, tc.samplingRatio); actual != tc.expect {
+			t.Errorf("UniqueIDFormat(%t, %v): expected %q, got %q", tc.propagate, tc.samplingRatio, tc.expect, actual)
+		}
+	}
+}
+
+// testLogMessage returns a syslog message with a log record for the given
+// trace context.
+func testLogMessage(traceContext string, statusCode int) []byte {
+	return []byte(fmt.Sprintf(`<134>Oct 19 10:00:00 haproxy[42]: {"traceContext":%q,"acceptTime":1700000000,"acceptMilliseconds":"007","handshakeTime":3,"idleTime":-1,"activeTime":20,"method":"GET","uri":"/path?query=1","httpVersion":"HTTP/1.1","statusCode":%d,"clientIP":"10.0.0.1","frontend":"fe_sni","backend":"be_secure:ns:route","server":"pod:svc:http:10.128.0.5:8080","bytesRead":512,"terminationState":"--"}`, traceContext, statusCode))
+}
+
+// TestSpanFromLogMessage verifies that spanFromLogMessage converts the router's
+// log records into server spans and ignores unsampled requests.
+func TestSpanFromLogMessage(t *testing.T) {
+	s, err := spanFromLogMessage(testLogMessage(testSpanID+testTraceID+"01"+otherTraceID+"00"+testParentID, 200))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if s == nil {
+		t.Fatal("expected span for sampled request")
+	}
+	expected := span{
+		TraceID:      testTraceID,
+		SpanID:       testSpanID,
+		ParentSpanID: testParentID,
+		Name:         "GET",
+		Kind:         spanKindServer,
+		// The request starts 7ms after the accept date plus a 3ms
+		// handshake; the idle timer did not run.
+		StartTimeUnixNano: "1700000000010000000",
+		EndTimeUnixNano:   "1700000000030000000",
+		Attributes: []keyValue{
+			stringAttribute("http.request.method", "GET"),
+			stringAttribute("url.path", "/path"),
+			intAttribute("http.response.status_code", 200),
+			stringAttribute("network.protocol.version", "1.1"),
+			stringAttribute("client.address", "10.0.0.1"),
+			intAttribute("openshift.router.bytes_read", 512),
+			stringAttribute("openshift.router.frontend", "fe_sni"),
+			stringAttribute("openshift.router.backend", "be_secure:ns:route"),
+			stringAttribute("openshift.router.server", "pod:svc:http:10.128.0.5:8080"),
+			stringAttribute("openshift.router.termination_state", "--"),
+			stringAttribute("url.query", "query=1"),
+		},
+	}
+	if !reflect.DeepEqual(expected, *s) {
+		t.Errorf("expected span %+v, got %+v", expected, *s)
+	}
+
+	s, err = spanFromLogMessage(testLogMessage(testSpanID+testTraceID+"01", 503))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if s.Status.Code != statusCodeError {
+		t.Errorf("expected error status for a 503 response, got %d", s.Status.Code)
+	}
+	if len(s.ParentSpanID) != 0 {
+		t.Errorf("expected root span, got parent %q", s.ParentSpanID)
+	}
+
+	s, err = spanFromLogMessage(testLogMessage(testSpanID+testTraceID+"00", 200))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if s != nil {
+		t.Errorf("expected no span for unsampled request, got %+v", *s)
+	}
+
+	if _, err := spanFromLogMessage([]byte("<134>Oct 19 10:00:00 haproxy[42]: Proxy fe_sni started.")); err == nil {
+		t.Error("expected error for message without a log record")
+	}
+}
+
+// TestExport verifies that the exporter posts spans using OTLP/JSON to the
+// configured endpoint with the configured resource attributes and
+// authorization.
+func TestExport(t *testing.T) {
+	var (
+		path, authorization, contentType string
+		request                          exportTraceServiceRequest
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		authorization = r.Header.Get("Authorization")
+		contentType = r.Header.Get("Content-Type")
+		body, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(body, &request); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	authorizationPath := filepath.Join(dir, "authorization")
+	if err := os.WriteFile(authorizationPath, []byte("Bearer token\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	config := Config{
+		Endpoint:           server.URL + "/v1/traces",
+		ServiceName:        "router-default",
+		ResourceAttributes: map[string]string{"openshift.ingresscontroller.name": "default"},
+		AuthorizationFile:  authorizationPath,
+		CABundleFile:       filepath.Join(dir, "missing-ca-bundle.crt"),
+	}
+	data, err := json.Marshal(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	configPath := filepath.Join(dir, "router-tracing.json")
+	if err := os.WriteFile(configPath, data, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	spans := []span{{TraceID: testTraceID, SpanID: testSpanID, Name: "GET", Kind: spanKindServer, StartTimeUnixNano: "1", EndTimeUnixNano: "2"}}
+	e := &exporter{configPath: configPath}
+	if err := e.export(context.Background(), spans); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if path != "/v1/traces" {
+		t.Errorf("expected request to /v1/traces, got %s", path)
+	}
+	if authorization != "Bearer token" {
+		t.Errorf("expected authorization %q, got %q", "Bearer token", authorization)
+	}
+	if contentType != "application/json" {
+		t.Errorf("expected content type application/json, got %q", contentType)
+	}
+	expected := exportTraceServiceRequest{
+		ResourceSpans: []resourceSpans{{
+			Resource: resource{Attributes: []keyValue{
+				stringAttribute("service.name", "router-default"),
+				stringAttribute("openshift.ingresscontroller.name", "default"),
+			}},
+			ScopeSpans: []scopeSpans{{
+				Scope: instrumentationScope{Name: routerTracingScopeName},
+				Spans: spans,
+			}},
+		}},
+	}
+	if !reflect.DeepEqual(expected, request) {
+		t.Errorf("expected request %+v, got %+v", expected, request)
+	}
+
+	config.Endpoint = server.URL + "/missing"
+	server.Config.Handler = http.NotFoundHandler()
+	data, _ = json.Marshal(config)
+	if err := os.WriteFile(configPath, data, 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := e.export(context.Background(), spans); err == nil {
+		t.Error("expected error for an endpoint that rejects the request")
+	}
+}
@@ -0,0 +1,148 @@
+// Package trustedca provides a trusted CA bundle that is loaded from a file and
+// that can be reloaded when the file changes, so that clients can trust new
+// certificate authorities without restarting the process.
+package trustedca
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+)
+
+// Bundle is a trusted CA bundle that is loaded from a PEM-encoded file.
+//
+// The standard library loads the system certificate pool once per process, so
+// clients that use the system pool never observe changes to the trusted CA
+// bundle.  Clients that need to observe changes should instead get the
+// certificate pool or a transport from the bundle each time they are created,
+// and subscribe to the bundle to learn when they need to be recreated.
+//
+// A nil *Bundle is valid and represents the system certificate pool.
+type Bundle struct {
+	path string
+
+	// lock protects the fields below.
+	lock sync.RWMutex
+	// pem is the content of the file as of the last successful load.
+	pem []byte
+	// pool is the certificate pool that was parsed from pem.
+	pool *x509.CertPool
+	// generation is incremented each time a load changes pem.
+	generation int64
+	// subscribers are called after each load that changes pem.
+	subscribers []func()
+}
+
+// Load reads the trusted CA bundle from the file at the given path and returns
+// a Bundle that can later be reloaded from the same path.
+func Load(path string) (*Bundle, error) {
+	b := &Bundle{path: path}
+	if _, err := b.Reload(); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// Reload reads the trusted CA bundle from the file again.  Reload returns a
+// Boolean indicating whether the content of the file changed, and an error
+// value.  If the file cannot be read or does not contain any certificates,
+// Reload returns an error and the bundle keeps its current content.  If the
+// content changed, Reload calls each subscriber after it updates the bundle.
+func (b *Bundle) Reload() (bool, error) {
+	data, err := os.ReadFile(b.path)
+	if err != nil {
+		return false, fmt.Errorf("failed to read trusted CA bundle %q: %w", b.path, err)
+	}
+
+	b.lock.Lock()
+	if b.pool != nil && bytes.Equal(data, b.pem) {
+		b.lock.Unlock()
+		return false, nil
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(data) {
+		b.lock.Unlock()
+		return false, fmt.Errorf("failed to parse trusted CA bundle %q: no certificates found", b.path)
+	}
+	b.pem, b.pool = data, pool
+	b.generation++
+	subscribers := make([]func(), len(b.subscribers))
+	copy(subscribers, b.subscribers)
+	b.lock.Unlock()
+
+	for _, f := range subscribers {
+		f()
+	}
+	return true, nil
+}
+
+// Path returns the path of the file from which the bundle is loaded.
+func (b *Bundle) Path() string {
+	if b == nil {
+		return ""
+	}
+	return b.path
+}
+
+// PEM returns the PEM-encoded content of the bundle, or nil if b is nil.
+func (b *Bundle) PEM() []byte {
+	if b == nil {
+		return nil
+	}
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+	return b.pem
+}
+
+// CertPool returns the certificate pool of the bundle, or nil, which
+// represents the system certificate pool, if b is nil.
+func (b *Bundle) CertPool() *x509.CertPool {
+	if b == nil {
+		return nil
+	}
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+	return b.pool
+}
+
+// Generation returns a number that increases each time the content of the
+// bundle changes.  Callers can compare generations to determine whether a
+// client that they created from the bundle is stale.
+func (b *Bundle) Generation() int64 {
+	if b == nil {
+		return 0
+	}
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+	return b.generation
+}
+
+// Subscribe registers a function that the bundle calls after each reload that
+// changes its content.  The function must not block.
+func (b *Bundle) Subscribe(f func()) {
+	if b == nil {
+		return
+	}
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.subscribers = append(b.subscribers, f)
+}
+
+// Transport returns a new HTTP transport that has the same settings as
+// http.DefaultTransport, including using the proxy from the environment, and
+// that trusts the current content of the bundle.  Callers should create a new
+// transport after the bundle changes.
+func (b *Bundle) Transport() *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if pool := b.CertPool(); pool != nil {
+		if transport.TLSClientConfig == nil {
+			transport.TLSClientConfig = &tls.Config{}
+		}
+		transport.TLSClientConfig.RootCAs = pool
+	}
+	return transport
+}
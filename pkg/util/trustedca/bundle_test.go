@@ -0,0 +1,111 @@
+package trustedca
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// newCACertPEM returns a new self-signed PEM-encoded CA certificate with the
+// given common name.
+func newCACertPEM(t *testing.T, commonName string) []byte {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+// TestBundleReload verifies that Bundle.Reload picks up changes to the file,
+// notifies subscribers only when the content changes, and keeps the current
+// content when the file is invalid.
+func TestBundleReload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tls-ca-bundle.pem")
+	first := newCACertPEM(t, "first")
+	if err := os.WriteFile(path, first, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	bundle, err := Load(path)
+	if err != nil {
+		t.Fatalf("failed to load bundle: %v", err)
+	}
+	if bundle.Generation() != 1 {
+		t.Errorf("expected generation 1 after load, got %d", bundle.Generation())
+	}
+	notifications := 0
+	bundle.Subscribe(func() { notifications++ })
+
+	if changed, err := bundle.Reload(); err != nil || changed {
+		t.Errorf("expected unchanged reload to return (false, nil), got (%t, %v)", changed, err)
+	}
+	if notifications != 0 {
+		t.Errorf("expected no notifications for unchanged reload, got %d", notifications)
+	}
+
+	second := newCACertPEM(t, "second")
+	if err := os.WriteFile(path, second, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if changed, err := bundle.Reload(); err != nil || !changed {
+		t.Errorf("expected changed reload to return (true, nil), got (%t, %v)", changed, err)
+	}
+	if notifications != 1 {
+		t.Errorf("expected 1 notification for changed reload, got %d", notifications)
+	}
+	if bundle.Generation() != 2 {
+		t.Errorf("expected generation 2 after changed reload, got %d", bundle.Generation())
+	}
+	if string(bundle.PEM()) != string(second) {
+		t.Errorf("expected bundle to have the new content")
+	}
+	if bundle.Transport().TLSClientConfig.RootCAs != bundle.CertPool() {
+		t.Errorf("expected transport to use the bundle's certificate pool")
+	}
+
+	if err := os.WriteFile(path, []byte("not a certificate"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := bundle.Reload(); err == nil {
+		t.Errorf("expected reload of invalid bundle to return an error")
+	}
+	if string(bundle.PEM()) != string(second) || bundle.Generation() != 2 || notifications != 1 {
+		t.Errorf("expected failed reload to keep the current content")
+	}
+}
+
+// TestNilBundle verifies that a nil Bundle represents the system certificate
+// pool.
+func TestNilBundle(t *testing.T) {
+	var bundle *Bundle
+	if bundle.CertPool() != nil || bundle.PEM() != nil || bundle.Generation() != 0 {
+		t.Errorf("expected nil bundle to have no content")
+	}
+	bundle.Subscribe(func() {})
+	if transport := bundle.Transport(); transport.TLSClientConfig != nil && transport.TLSClientConfig.RootCAs != nil {
+		t.Errorf("expected nil bundle's transport to use the system certificate pool")
+	}
+}
@@ -34,6 +34,8 @@ func TestAll(t *testing.T) {
 		t.Run("TestHAProxyTimeouts", TestHAProxyTimeouts)
 		t.Run("TestHAProxyTimeoutsRejection", TestHAProxyTimeoutsRejection)
 		t.Run("TestHTTPCookieCapture", TestHTTPCookieCapture)
+		t.Run("TestHTTPHeaderActions", TestHTTPHeaderActions)
+		t.Run("TestHTTPHeaderActionsRejection", TestHTTPHeaderActionsRejection)
 		t.Run("TestHTTPHeaderBufferSize", TestHTTPHeaderBufferSize)
 		t.Run("TestHTTPHeaderCapture", TestHTTPHeaderCapture)
 		t.Run("TestHeaderNameCaseAdjustment", TestHeaderNameCaseAdjustment)
@@ -43,16 +45,22 @@ func TestAll(t *testing.T) {
 		t.Run("TestIngressControllerServiceNameCollision", TestIngressControllerServiceNameCollision)
 		t.Run("TestInternalLoadBalancer", TestInternalLoadBalancer)
 		t.Run("TestInternalLoadBalancerGlobalAccessGCP", TestInternalLoadBalancerGlobalAccessGCP)
+		t.Run("TestLeaderElection", TestLeaderElection)
 		t.Run("TestLoadBalancingAlgorithmUnsupportedConfigOverride", TestLoadBalancingAlgorithmUnsupportedConfigOverride)
 		t.Run("TestLocalWithFallbackOverrideForNodePortService", TestLocalWithFallbackOverrideForNodePortService)
 		t.Run("TestNetworkLoadBalancer", TestNetworkLoadBalancer)
 		t.Run("TestNodePortServiceEndpointPublishingStrategy", TestNodePortServiceEndpointPublishingStrategy)
 		t.Run("TestProxyProtocolAPI", TestProxyProtocolAPI)
+		t.Run("TestRateLimiting", TestRateLimiting)
+		t.Run("TestRolloutPolicy", TestRolloutPolicy)
 		t.Run("TestRouteAdmissionPolicy", TestRouteAdmissionPolicy)
 		t.Run("TestRouterCompressionParsing", TestRouterCompressionParsing)
+		t.Run("TestRouterResources", TestRouterResources)
 		t.Run("TestScopeChange", TestScopeChange)
+		t.Run("TestStagedRollout", TestStagedRollout)
 		t.Run("TestSyslogLogging", TestSyslogLogging)
 		t.Run("TestTLSSecurityProfile", TestTLSSecurityProfile)
+		t.Run("TestTracing", TestTracing)
 		t.Run("TestTunableMaxConnectionsInvalidValues", TestTunableMaxConnectionsInvalidValues)
 		t.Run("TestTunableMaxConnectionsValidValues", TestTunableMaxConnectionsValidValues)
 		t.Run("TestTunableRouterKubeletProbesForCustomIngressController", TestTunableRouterKubeletProbesForCustomIngressController)
@@ -74,7 +74,7 @@ func TestAllowedSourceRanges(t *testing.T) {
 	// Poll for a minute and verify that LoadBalancerSourceRanges field is updated as expected.
 	lbService := &corev1.Service{}
 	err := wait.PollImmediate(10*time.Second, 1*time.Minute, func() (bool, error) {
-		if err := kclient.Get(context.TODO(), controller.LoadBalancerServiceName(ic), lbService); err != nil {
+		if err := kclient.Get(context.TODO(), controller.LoadBalancerServiceName(ic, operandNamespace), lbService); err != nil {
 			return false, fmt.Errorf("failed to get service: %w", err)
 		}
 
@@ -101,7 +101,7 @@ func TestAllowedSourceRanges(t *testing.T) {
 	}
 
 	err = wait.PollImmediate(10*time.Second, 1*time.Minute, func() (bool, error) {
-		if err := kclient.Get(context.TODO(), controller.LoadBalancerServiceName(ic), lbService); err != nil {
+		if err := kclient.Get(context.TODO(), controller.LoadBalancerServiceName(ic, operandNamespace), lbService); err != nil {
 			return false, fmt.Errorf("failed to get service: %w", err)
 		}
 
@@ -158,7 +158,7 @@ func TestAllowedSourceRangesStatus(t *testing.T) {
 	}
 
 	lbService := &corev1.Service{}
-	if err := kclient.Get(context.TODO(), controller.LoadBalancerServiceName(ic), lbService); err != nil {
+	if err := kclient.Get(context.TODO(), controller.LoadBalancerServiceName(ic, operandNamespace), lbService); err != nil {
 		t.Fatalf("failed to get service: %v", err)
 	}
 
@@ -198,7 +198,7 @@ func TestAllowedSourceRangesStatus(t *testing.T) {
 	}
 
 	// Set .Spec.LoadBalancerSourceRanges and see if it will be reflected in AllowedSourceRanges in IngressController's status
-	if err := kclient.Get(context.TODO(), controller.LoadBalancerServiceName(ic), lbService); err != nil {
+	if err := kclient.Get(context.TODO(), controller.LoadBalancerServiceName(ic, operandNamespace), lbService); err != nil {
 		t.Fatalf("failed to get service: %v", err)
 	}
 	lbService.Spec.LoadBalancerSourceRanges = []string{"0.0.0.0/0"}
@@ -277,7 +277,7 @@ func TestSourceRangesProgressingAndEvaluationConditionsDetectedStatuses(t *testi
 	}
 
 	lbService := &corev1.Service{}
-	if err := kclient.Get(context.TODO(), controller.LoadBalancerServiceName(ic), lbService); err != nil {
+	if err := kclient.Get(context.TODO(), controller.LoadBalancerServiceName(ic, operandNamespace), lbService); err != nil {
 		t.Fatalf("failed to get service: %v", err)
 	}
 
@@ -53,13 +53,13 @@ func TestCanaryRoute(t *testing.T) {
 
 	// Get default ingress controller deployment
 	deployment := &appsv1.Deployment{}
-	if err := kclient.Get(context.TODO(), controller.RouterDeploymentName(def), deployment); err != nil {
+	if err := kclient.Get(context.TODO(), controller.RouterDeploymentName(def, operandNamespace), deployment); err != nil {
 		t.Fatalf("failed to get ingresscontroller deployment: %v", err)
 	}
 
 	// Get canary route
 	canaryRoute := &routev1.Route{}
-	name := controller.CanaryRouteName()
+	name := controller.CanaryRouteName(controller.DefaultCanaryNamespace)
 	err = wait.PollImmediate(1*time.Second, 1*time.Minute, func() (bool, error) {
 		if err := kclient.Get(context.TODO(), name, canaryRoute); err != nil {
 			t.Logf("failed to get canary route %s: %v", name, err)
@@ -42,7 +42,7 @@ func TestHealthCheckIntervalIngressController(t *testing.T) {
 		t.Fatalf("failed to get ingresscontroller %s: %v", name, err)
 	}
 
-	routerDeployment, err := getDeployment(t, kclient, controller.RouterDeploymentName(ic), 1*time.Minute)
+	routerDeployment, err := getDeployment(t, kclient, controller.RouterDeploymentName(ic, operandNamespace), 1*time.Minute)
 	if err != nil {
 		t.Fatalf("failed to get router deployment: %v", err)
 	}
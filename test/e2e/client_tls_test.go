@@ -127,7 +127,7 @@ func TestClientTLS(t *testing.T) {
 	// We need some route to which to send requests.  The canary route
 	// should always exist and be responsive, so use that.
 	route := &routev1.Route{}
-	routeName := controller.CanaryRouteName()
+	routeName := controller.CanaryRouteName(controller.DefaultCanaryNamespace)
 	err = wait.PollImmediate(1*time.Second, 1*time.Minute, func() (bool, error) {
 		if err := kclient.Get(context.TODO(), routeName, route); err != nil {
 			t.Logf("failed to get route %q: %v", routeName, err)
@@ -143,7 +143,7 @@ func TestClientTLS(t *testing.T) {
 	// runs inside the cluster, so we can use the custom router's internal
 	// service address.
 	service := &corev1.Service{}
-	serviceName := controller.InternalIngressControllerServiceName(ic)
+	serviceName := controller.InternalIngressControllerServiceName(ic, operandNamespace)
 	if err := kclient.Get(context.TODO(), serviceName, service); err != nil {
 		t.Fatalf("failed to get service %q: %v", serviceName, err)
 	}
@@ -151,7 +151,7 @@ func TestClientTLS(t *testing.T) {
 	// We need an image that we can use for test clients.  The router image
 	// has Curl, so we can use that image.
 	deployment := &appsv1.Deployment{}
-	deploymentName := controller.RouterDeploymentName(ic)
+	deploymentName := controller.RouterDeploymentName(ic, operandNamespace)
 	if err := kclient.Get(context.TODO(), deploymentName, deployment); err != nil {
 		t.Fatalf("failed to get deployment %q: %v", deploymentName, err)
 	}
@@ -38,6 +38,20 @@ var testPodCount int
 func testRouteHeaders(t *testing.T, image string, route *routev1.Route, address string, headers []string, expectedResponse string, expectedMatches int) {
 	t.Helper()
 
+	var extraCurlArgs []string
+	for _, header := range headers {
+		extraCurlArgs = append(extraCurlArgs, "-H", header)
+	}
+	testRouteCurlOutput(t, image, route, address, extraCurlArgs, expectedResponse, expectedMatches)
+}
+
+// testRouteCurlOutput runs curl with the given extra arguments against the
+// specified route using the provided address and verifies that the output has
+// the expected number of matches of the expected string.  Case is ignored when
+// comparing the expected response and the actual output.
+func testRouteCurlOutput(t *testing.T, image string, route *routev1.Route, address string, extraCurlArgs []string, expectedResponse string, expectedMatches int) {
+	t.Helper()
+
 	kubeConfig, err := config.GetConfig()
 	if err != nil {
 		t.Fatalf("failed to get kube config: %v", err)
@@ -47,10 +61,6 @@ func testRouteHeaders(t *testing.T, image string, route *routev1.Route, address
 		t.Fatalf("failed to create kube client: %v", err)
 	}
 
-	var extraCurlArgs []string
-	for _, header := range headers {
-		extraCurlArgs = append(extraCurlArgs, "-H", header)
-	}
 	extraCurlArgs = append(extraCurlArgs, "--resolve", route.Spec.Host+":80:"+address)
 	testPodCount++
 	name := fmt.Sprintf("%s%d", route.Name, testPodCount)
@@ -140,11 +150,11 @@ func TestForwardedHeaderPolicyAppend(t *testing.T) {
 	}
 
 	deployment := &appsv1.Deployment{}
-	if err := kclient.Get(context.TODO(), controller.RouterDeploymentName(ic), deployment); err != nil {
+	if err := kclient.Get(context.TODO(), controller.RouterDeploymentName(ic, operandNamespace), deployment); err != nil {
 		t.Fatalf("failed to get ingresscontroller deployment: %v", err)
 	}
 	service := &corev1.Service{}
-	if err := kclient.Get(context.TODO(), controller.InternalIngressControllerServiceName(ic), service); err != nil {
+	if err := kclient.Get(context.TODO(), controller.InternalIngressControllerServiceName(ic, operandNamespace), service); err != nil {
 		t.Fatalf("failed to get ingresscontroller service: %v", err)
 	}
 
@@ -238,11 +248,11 @@ func TestForwardedHeaderPolicyReplace(t *testing.T) {
 	}
 
 	deployment := &appsv1.Deployment{}
-	if err := kclient.Get(context.TODO(), controller.RouterDeploymentName(ic), deployment); err != nil {
+	if err := kclient.Get(context.TODO(), controller.RouterDeploymentName(ic, operandNamespace), deployment); err != nil {
 		t.Fatalf("failed to get ingresscontroller deployment: %v", err)
 	}
 	service := &corev1.Service{}
-	if err := kclient.Get(context.TODO(), controller.InternalIngressControllerServiceName(ic), service); err != nil {
+	if err := kclient.Get(context.TODO(), controller.InternalIngressControllerServiceName(ic, operandNamespace), service); err != nil {
 		t.Fatalf("failed to get ingresscontroller service: %v", err)
 	}
 
@@ -308,11 +318,11 @@ func TestForwardedHeaderPolicyNever(t *testing.T) {
 	}
 
 	deployment := &appsv1.Deployment{}
-	if err := kclient.Get(context.TODO(), controller.RouterDeploymentName(ic), deployment); err != nil {
+	if err := kclient.Get(context.TODO(), controller.RouterDeploymentName(ic, operandNamespace), deployment); err != nil {
 		t.Fatalf("failed to get ingresscontroller deployment: %v", err)
 	}
 	service := &corev1.Service{}
-	if err := kclient.Get(context.TODO(), controller.InternalIngressControllerServiceName(ic), service); err != nil {
+	if err := kclient.Get(context.TODO(), controller.InternalIngressControllerServiceName(ic, operandNamespace), service); err != nil {
 		t.Fatalf("failed to get ingresscontroller service: %v", err)
 	}
 
@@ -379,11 +389,11 @@ func TestForwardedHeaderPolicyIfNone(t *testing.T) {
 	}
 
 	deployment := &appsv1.Deployment{}
-	if err := kclient.Get(context.TODO(), controller.RouterDeploymentName(ic), deployment); err != nil {
+	if err := kclient.Get(context.TODO(), controller.RouterDeploymentName(ic, operandNamespace), deployment); err != nil {
 		t.Fatalf("failed to get ingresscontroller deployment: %v", err)
 	}
 	service := &corev1.Service{}
-	if err := kclient.Get(context.TODO(), controller.InternalIngressControllerServiceName(ic), service); err != nil {
+	if err := kclient.Get(context.TODO(), controller.InternalIngressControllerServiceName(ic, operandNamespace), service); err != nil {
 		t.Fatalf("failed to get ingresscontroller service: %v", err)
 	}
 
@@ -66,7 +66,7 @@ func TestHAProxyTimeouts(t *testing.T) {
 	}
 
 	deployment := &appsv1.Deployment{}
-	if err := kclient.Get(context.TODO(), controller.RouterDeploymentName(ic), deployment); err != nil {
+	if err := kclient.Get(context.TODO(), controller.RouterDeploymentName(ic, operandNamespace), deployment); err != nil {
 		t.Fatalf("failed to get ingresscontroller deployment: %v", err)
 	}
 	for _, envVar := range deployment.Spec.Template.Spec.Containers[0].Env {
@@ -221,7 +221,7 @@ func TestHAProxyTimeoutsRejection(t *testing.T) {
 	}
 
 	deployment := &appsv1.Deployment{}
-	if err := kclient.Get(context.TODO(), controller.RouterDeploymentName(ic), deployment); err != nil {
+	if err := kclient.Get(context.TODO(), controller.RouterDeploymentName(ic, operandNamespace), deployment); err != nil {
 		t.Fatalf("failed to get ingresscontroller deployment: %v", err)
 	}
 	for _, envVar := range deployment.Spec.Template.Spec.Containers[0].Env {
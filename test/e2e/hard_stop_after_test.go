@@ -392,8 +392,8 @@ func hardStopAfterGetTestObjects(t *testing.T, client client.Client, timeout tim
 
 	deployment := appsv1.Deployment{}
 	if err := wait.PollImmediate(1*time.Second, timeout, func() (bool, error) {
-		if err := client.Get(context.TODO(), controller.RouterDeploymentName(&ic), &deployment); err != nil {
-			t.Logf("Get %q failed: %v, retrying...", controller.RouterDeploymentName(&ic), err)
+		if err := client.Get(context.TODO(), controller.RouterDeploymentName(&ic, operandNamespace), &deployment); err != nil {
+			t.Logf("Get %q failed: %v, retrying...", controller.RouterDeploymentName(&ic, operandNamespace), err)
 			return false, nil
 		}
 		return true, nil
@@ -193,8 +193,8 @@ func http2GetIngressConfig(t *testing.T, client client.Client, timeout time.Dura
 func waitForRouterDeploymentHTTP2Enabled(t *testing.T, client client.Client, timeout time.Duration, c *operatorv1.IngressController, enabledState bool) error {
 	return wait.PollImmediate(1*time.Second, timeout, func() (bool, error) {
 		deployment := &appsv1.Deployment{}
-		if err := client.Get(context.TODO(), controller.RouterDeploymentName(c), deployment); err != nil {
-			t.Logf("Get %q failed: %v, retrying...", controller.RouterDeploymentName(c), err)
+		if err := client.Get(context.TODO(), controller.RouterDeploymentName(c, operandNamespace), deployment); err != nil {
+			t.Logf("Get %q failed: %v, retrying...", controller.RouterDeploymentName(c, operandNamespace), err)
 			return false, nil
 		}
 		return !http2IsDisabledInEnv(deployment.Spec.Template.Spec.Containers[0].Env) == enabledState, nil
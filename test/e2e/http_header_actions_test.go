@@ -0,0 +1,133 @@
+//go:build e2e
+// +build e2e
+
+package e2e
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	"github.com/openshift/cluster-ingress-operator/pkg/operator/controller"
+	ingresscontroller "github.com/openshift/cluster-ingress-operator/pkg/operator/controller/ingress"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// TestHTTPHeaderActions verifies that the ingress controller sets and deletes
+// request and response headers as specified by the httpHeaderActions
+// unsupported config override.
+func TestHTTPHeaderActions(t *testing.T) {
+	t.Parallel()
+	icName := types.NamespacedName{Namespace: operatorNamespace, Name: "http-header-actions"}
+	domain := icName.Name + "." + dnsConfig.Spec.BaseDomain
+	ic := newPrivateController(icName, domain)
+	ic.Spec.UnsupportedConfigOverrides = runtime.RawExtension{
+		Raw: []byte(`{"httpHeaderActions":{"request":[{"name":"X-Router-Request","action":"Set","value":"router"},{"name":"X-Remove-Me","action":"Delete"}],"response":[{"name":"X-Frame-Options","action":"Set","value":"DENY"}]}}`),
+	}
+	if err := kclient.Create(context.TODO(), ic); err != nil {
+		t.Fatalf("failed to create ingresscontroller %s: %v", icName, err)
+	}
+	defer assertIngressControllerDeleted(t, kclient, ic)
+	if err := waitForIngressControllerCondition(t, kclient, 5*time.Minute, icName, availableConditionsForPrivateIngressController...); err != nil {
+		t.Fatalf("failed to observe expected conditions: %v", err)
+	}
+
+	deployment := &appsv1.Deployment{}
+	if err := kclient.Get(context.TODO(), controller.RouterDeploymentName(ic, operandNamespace), deployment); err != nil {
+		t.Fatalf("failed to get ingresscontroller deployment: %v", err)
+	}
+	if err := waitForDeploymentEnvVar(t, kclient, deployment, 1*time.Minute, "ROUTER_HTTP_RESPONSE_HEADERS", "X-Frame-Options:DENY:Set"); err != nil {
+		t.Fatalf("failed to observe ROUTER_HTTP_RESPONSE_HEADERS: %v", err)
+	}
+	service := &corev1.Service{}
+	if err := kclient.Get(context.TODO(), controller.InternalIngressControllerServiceName(ic, operandNamespace), service); err != nil {
+		t.Fatalf("failed to get ingresscontroller service: %v", err)
+	}
+
+	// Create a pod and route that echoes back the request.
+	echoPod := buildEchoPod("http-header-actions-echo", deployment.Namespace)
+	if err := kclient.Create(context.TODO(), echoPod); err != nil {
+		t.Fatalf("failed to create pod %s/%s: %v", echoPod.Namespace, echoPod.Name, err)
+	}
+	defer func() {
+		if err := kclient.Delete(context.TODO(), echoPod); err != nil {
+			t.Fatalf("failed to delete pod %s/%s: %v", echoPod.Namespace, echoPod.Name, err)
+		}
+	}()
+
+	echoService := buildEchoService(echoPod.Name, echoPod.Namespace, echoPod.ObjectMeta.Labels)
+	if err := kclient.Create(context.TODO(), echoService); err != nil {
+		t.Fatalf("failed to create service %s/%s: %v", echoService.Namespace, echoService.Name, err)
+	}
+	defer func() {
+		if err := kclient.Delete(context.TODO(), echoService); err != nil {
+			t.Fatalf("failed to delete service %s/%s: %v", echoService.Namespace, echoService.Name, err)
+		}
+	}()
+
+	echoRoute := buildRoute(echoPod.Name, echoPod.Namespace, echoService.Name)
+	if err := kclient.Create(context.TODO(), echoRoute); err != nil {
+		t.Fatalf("failed to create route %s/%s: %v", echoRoute.Namespace, echoRoute.Name, err)
+	}
+	defer func() {
+		if err := kclient.Delete(context.TODO(), echoRoute); err != nil {
+			t.Fatalf("failed to delete route %s/%s: %v", echoRoute.Namespace, echoRoute.Name, err)
+		}
+	}()
+
+	clientPodImage := deployment.Spec.Template.Spec.Containers[0].Image
+
+	// The echo server writes the request headers that it receives in the
+	// response body.  The router should add X-Router-Request, replacing
+	// any value that the client specifies, and remove X-Remove-Me.
+	testRouteHeaders(t, clientPodImage, echoRoute, service.Spec.ClusterIP, nil, "x-router-request: router", 1)
+	testRouteHeaders(t, clientPodImage, echoRoute, service.Spec.ClusterIP, []string{"x-router-request:client", "x-router-request:other"}, "x-router-request:", 1)
+	testRouteHeaders(t, clientPodImage, echoRoute, service.Spec.ClusterIP, []string{"x-remove-me:foo"}, "x-remove-me:", 0)
+
+	// The router should add X-Frame-Options to the response headers.
+	testRouteCurlOutput(t, clientPodImage, echoRoute, service.Spec.ClusterIP, []string{"-i"}, "x-frame-options: deny", 1)
+
+	// Removing the override should remove the header actions.
+	if err := updateIngressControllerSpecWithRetryOnConflict(t, icName, timeout, func(spec *operatorv1.IngressControllerSpec) {
+		spec.UnsupportedConfigOverrides = runtime.RawExtension{}
+	}); err != nil {
+		t.Fatalf("failed to update ingresscontroller %s: %v", icName, err)
+	}
+	if err := waitForDeploymentEnvVar(t, kclient, deployment, 1*time.Minute, "ROUTER_HTTP_RESPONSE_HEADERS", ""); err != nil {
+		t.Fatalf("failed to observe removal of ROUTER_HTTP_RESPONSE_HEADERS: %v", err)
+	}
+	if err := waitForDeploymentComplete(t, kclient, deployment, 3*time.Minute); err != nil {
+		t.Fatalf("failed to observe expected conditions: %v", err)
+	}
+	testRouteCurlOutput(t, clientPodImage, echoRoute, service.Spec.ClusterIP, []string{"-i"}, "x-frame-options:", 0)
+	testRouteHeaders(t, clientPodImage, echoRoute, service.Spec.ClusterIP, []string{"x-remove-me:foo"}, "x-remove-me:", 1)
+}
+
+// TestHTTPHeaderActionsRejection verifies that the operator rejects an
+// ingresscontroller that specifies invalid header actions.
+func TestHTTPHeaderActionsRejection(t *testing.T) {
+	t.Parallel()
+	icName := types.NamespacedName{Namespace: operatorNamespace, Name: "http-header-actions-rejection"}
+	domain := icName.Name + "." + dnsConfig.Spec.BaseDomain
+	ic := newPrivateController(icName, domain)
+	ic.Spec.UnsupportedConfigOverrides = runtime.RawExtension{
+		Raw: []byte(`{"httpHeaderActions":{"request":[{"name":"Host","action":"Set","value":"example.com"}]}}`),
+	}
+	if err := kclient.Create(context.TODO(), ic); err != nil {
+		t.Fatalf("failed to create ingresscontroller %s: %v", icName, err)
+	}
+	defer assertIngressControllerDeleted(t, kclient, ic)
+	conditions := []operatorv1.OperatorCondition{
+		{Type: ingresscontroller.IngressControllerAdmittedConditionType, Status: operatorv1.ConditionFalse},
+	}
+	if err := waitForIngressControllerCondition(t, kclient, 5*time.Minute, icName, conditions...); err != nil {
+		t.Fatalf("failed to observe expected conditions: %v", err)
+	}
+}
@@ -54,12 +54,12 @@ func TestHTTPHeaderBufferSize(t *testing.T) {
 	}
 
 	deployment := &appsv1.Deployment{}
-	if err := kclient.Get(context.TODO(), controller.RouterDeploymentName(ic), deployment); err != nil {
+	if err := kclient.Get(context.TODO(), controller.RouterDeploymentName(ic, operandNamespace), deployment); err != nil {
 		t.Fatalf("failed to get ingresscontroller deployment: %v", err)
 	}
 
 	service := &corev1.Service{}
-	if err := kclient.Get(context.TODO(), controller.InternalIngressControllerServiceName(ic), service); err != nil {
+	if err := kclient.Get(context.TODO(), controller.InternalIngressControllerServiceName(ic, operandNamespace), service); err != nil {
 		t.Fatalf("failed to get ingresscontroller service: %v", err)
 	}
 
@@ -0,0 +1,96 @@
+//go:build e2e
+// +build e2e
+
+package e2e
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"k8s.io/client-go/kubernetes"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/config"
+)
+
+// TestLeaderElection verifies that the operator runs more than one replica,
+// holds the leader election lease, and that the lease holder is one of the
+// operator's running pods.
+func TestLeaderElection(t *testing.T) {
+	t.Parallel()
+	kubeConfig, err := config.GetConfig()
+	if err != nil {
+		t.Fatalf("failed to get kube config: %v", err)
+	}
+	kubeClient, err := kubernetes.NewForConfig(kubeConfig)
+	if err != nil {
+		t.Fatalf("failed to create kube client: %v", err)
+	}
+
+	deployment := &appsv1.Deployment{}
+	deploymentName := types.NamespacedName{Namespace: operatorNamespace, Name: "ingress-operator"}
+	if err := kclient.Get(context.TODO(), deploymentName, deployment); err != nil {
+		t.Fatalf("failed to get deployment %s: %v", deploymentName, err)
+	}
+	leaderElectionEnabled := false
+	for _, arg := range deployment.Spec.Template.Spec.Containers[0].Command {
+		if arg == "--leader-elect" || arg == "--leader-elect=true" {
+			leaderElectionEnabled = true
+		}
+	}
+	if !leaderElectionEnabled {
+		t.Skipf("deployment %s does not enable leader election", deploymentName)
+	}
+
+	// The operator runs a standby replica that can take over the lease.
+	if err := wait.PollImmediate(2*time.Second, 5*time.Minute, func() (bool, error) {
+		if err := kclient.Get(context.TODO(), deploymentName, deployment); err != nil {
+			t.Logf("failed to get deployment %s: %v", deploymentName, err)
+			return false, nil
+		}
+		if deployment.Status.AvailableReplicas < 2 {
+			t.Logf("deployment %s has %d available replicas, expected at least 2", deploymentName, deployment.Status.AvailableReplicas)
+			return false, nil
+		}
+		return true, nil
+	}); err != nil {
+		t.Fatalf("failed to observe available operator replicas: %v", err)
+	}
+
+	if err := wait.PollImmediate(2*time.Second, 1*time.Minute, func() (bool, error) {
+		lease, err := kubeClient.CoordinationV1().Leases(operatorNamespace).Get(context.TODO(), "ingress-operator-lock", metav1.GetOptions{})
+		if err != nil {
+			t.Logf("failed to get leader election lease: %v", err)
+			return false, nil
+		}
+		if lease.Spec.HolderIdentity == nil {
+			t.Log("leader election lease has no holder")
+			return false, nil
+		}
+		podList := &corev1.PodList{}
+		if err := kclient.List(context.TODO(), podList, client.InNamespace(operatorNamespace), client.MatchingLabels(deployment.Spec.Selector.MatchLabels)); err != nil {
+			t.Logf("failed to list pods for deployment %s: %v", deploymentName, err)
+			return false, nil
+		}
+		// The lease holder identity is the pod's host name followed by
+		// a unique suffix.
+		for _, pod := range podList.Items {
+			if pod.Status.Phase == corev1.PodRunning && strings.HasPrefix(*lease.Spec.HolderIdentity, pod.Name+"_") {
+				return true, nil
+			}
+		}
+		t.Logf("leader election lease holder %q is not a running operator pod", *lease.Spec.HolderIdentity)
+		return false, nil
+	}); err != nil {
+		t.Fatalf("failed to observe expected leader election lease: %v", err)
+	}
+}
@@ -34,7 +34,7 @@ func TestRouteNbthreadIngressController(t *testing.T) {
 		t.Fatalf("failed to get ingress controller: %v", err)
 	}
 
-	routerDeployment, err := getDeployment(t, kclient, controller.RouterDeploymentName(ic), 1*time.Minute)
+	routerDeployment, err := getDeployment(t, kclient, controller.RouterDeploymentName(ic, operandNamespace), 1*time.Minute)
 	if err != nil {
 		t.Fatalf("failed to get router deployment: %v", err)
 	}
@@ -179,6 +179,17 @@ func TestClusterOperatorStatusRelatedObjects(t *testing.T) {
 			Resource: "namespaces",
 			Name:     "openshift-ingress-canary",
 		},
+		{
+			Resource:  "configmaps",
+			Namespace: operatorNamespace,
+			Name:      controller.IngressControllerConditionHistoryConfigMapName(operatorNamespace).Name,
+		},
+		{
+			Group:     operatorv1.GroupName,
+			Resource:  "ingresscontrollers",
+			Namespace: operatorNamespace,
+			Name:      manifests.DefaultIngressControllerName,
+		},
 	}
 
 	coName := controller.IngressClusterOperatorName()
@@ -189,7 +200,23 @@ func TestClusterOperatorStatusRelatedObjects(t *testing.T) {
 			return false, nil
 		}
 
-		return reflect.DeepEqual(expected, co.Status.RelatedObjects), nil
+		// Other tests may create ingresscontrollers concurrently, each
+		// of which has its own entry, so only check that the expected
+		// entries are present.
+		for _, ref := range expected {
+			found := false
+			for _, actual := range co.Status.RelatedObjects {
+				if reflect.DeepEqual(ref, actual) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				t.Logf("ingress cluster operator %s does not have related object %+v", coName, ref)
+				return false, nil
+			}
+		}
+		return true, nil
 	})
 	if err != nil {
 		t.Errorf("did not get expected status related objects: %v", err)
@@ -407,7 +434,7 @@ func TestProxyProtocolOnAWS(t *testing.T) {
 	}
 
 	deployment := &appsv1.Deployment{}
-	if err := kclient.Get(context.TODO(), controller.RouterDeploymentName(ic), deployment); err != nil {
+	if err := kclient.Get(context.TODO(), controller.RouterDeploymentName(ic, operandNamespace), deployment); err != nil {
 		t.Fatalf("failed to get default ingresscontroller deployment: %v", err)
 	}
 
@@ -443,7 +470,7 @@ func TestProxyProtocolAPI(t *testing.T) {
 	}
 
 	deployment := &appsv1.Deployment{}
-	if err := kclient.Get(context.TODO(), controller.RouterDeploymentName(ic), deployment); err != nil {
+	if err := kclient.Get(context.TODO(), controller.RouterDeploymentName(ic, operandNamespace), deployment); err != nil {
 		t.Fatalf("failed to get ingresscontroller deployment: %v", err)
 	}
 	if err := waitForDeploymentEnvVar(t, kclient, deployment, 1*time.Minute, "ROUTER_USE_PROXY_PROTOCOL", ""); err != nil {
@@ -521,7 +548,7 @@ func TestUpdateDefaultIngressControllerSecret(t *testing.T) {
 	// ingress controller, or the default if none is set, and store the
 	// secret name (if any) so we can reset it at the end of the test.
 	deployment := &appsv1.Deployment{}
-	if err := kclient.Get(context.TODO(), controller.RouterDeploymentName(ic), deployment); err != nil {
+	if err := kclient.Get(context.TODO(), controller.RouterDeploymentName(ic, operandNamespace), deployment); err != nil {
 		t.Fatalf("failed to get default deployment: %v", err)
 	}
 	originalSecret := ic.Spec.DefaultCertificate.DeepCopy()
@@ -591,8 +618,8 @@ func TestUpdateDefaultIngressControllerSecret(t *testing.T) {
 
 	// Verify that the deployment uses the new certificate.
 	err = wait.PollImmediate(1*time.Second, timeout, func() (bool, error) {
-		if err := kclient.Get(context.TODO(), controller.RouterDeploymentName(ic), deployment); err != nil {
-			t.Logf("failed to get deployment %s: %v", controller.RouterDeploymentName(ic), err)
+		if err := kclient.Get(context.TODO(), controller.RouterDeploymentName(ic, operandNamespace), deployment); err != nil {
+			t.Logf("failed to get deployment %s: %v", controller.RouterDeploymentName(ic, operandNamespace), err)
 			return false, nil
 		}
 		if deployment.Spec.Template.Spec.Volumes[0].Secret.SecretName != secret.Name {
@@ -644,7 +671,7 @@ func TestUpdateDefaultIngressControllerSecret(t *testing.T) {
 	// Verify that the default router deployment gets restored to the
 	// original secret reference.
 	err = wait.PollImmediate(1*time.Second, timeout, func() (bool, error) {
-		if err := kclient.Get(context.TODO(), controller.RouterDeploymentName(ic), deployment); err != nil {
+		if err := kclient.Get(context.TODO(), controller.RouterDeploymentName(ic, operandNamespace), deployment); err != nil {
 			t.Logf("failed to get default router deployment: %v", err)
 			return false, nil
 		}
@@ -716,7 +743,7 @@ func TestIngressControllerScale(t *testing.T) {
 
 	// Get the ingresscontroller's deployment's selector and replicaset.
 	deployment := &appsv1.Deployment{}
-	if err := kclient.Get(context.TODO(), controller.RouterDeploymentName(ic), deployment); err != nil {
+	if err := kclient.Get(context.TODO(), controller.RouterDeploymentName(ic, operandNamespace), deployment); err != nil {
 		t.Fatalf("failed to get deployment for ingresscontroller %s: %v", name, err)
 	}
 
@@ -926,7 +953,7 @@ func TestPodDisruptionBudgetExists(t *testing.T) {
 	}
 
 	pdb := &policyv1.PodDisruptionBudget{}
-	if err := kclient.Get(context.TODO(), controller.RouterPodDisruptionBudgetName(ic), pdb); err != nil {
+	if err := kclient.Get(context.TODO(), controller.RouterPodDisruptionBudgetName(ic, operandNamespace), pdb); err != nil {
 		t.Fatalf("failed to get default ingresscontroller poddisruptionbudget: %v", err)
 	}
 }
@@ -1097,7 +1124,7 @@ func TestInternalLoadBalancer(t *testing.T) {
 	}
 
 	lbService := &corev1.Service{}
-	if err := kclient.Get(context.TODO(), controller.LoadBalancerServiceName(ic), lbService); err != nil {
+	if err := kclient.Get(context.TODO(), controller.LoadBalancerServiceName(ic, operandNamespace), lbService); err != nil {
 		t.Fatalf("failed to get LoadBalancer service: %v", err)
 	}
 
@@ -1117,7 +1144,7 @@ func TestInternalLoadBalancer(t *testing.T) {
 		const annotation = "service.beta.kubernetes.io/aws-load-balancer-internal"
 
 		// Set the annotation value to "0.0.0.0/0".
-		if err := kclient.Get(context.TODO(), controller.LoadBalancerServiceName(ic), lbService); err != nil {
+		if err := kclient.Get(context.TODO(), controller.LoadBalancerServiceName(ic, operandNamespace), lbService); err != nil {
 			t.Fatalf("failed to get LoadBalancer service: %v", err)
 		}
 		lbService.Annotations[annotation] = "0.0.0.0/0"
@@ -1128,7 +1155,7 @@ func TestInternalLoadBalancer(t *testing.T) {
 		// Verify that the operator reverts the annotation value to
 		// "true".
 		err := wait.PollImmediate(1*time.Second, 1*time.Minute, func() (bool, error) {
-			if err := kclient.Get(context.TODO(), controller.LoadBalancerServiceName(ic), lbService); err != nil {
+			if err := kclient.Get(context.TODO(), controller.LoadBalancerServiceName(ic, operandNamespace), lbService); err != nil {
 				t.Logf("failed to get LoadBalancer service: %v", err)
 				return false, nil
 			}
@@ -1142,7 +1169,7 @@ func TestInternalLoadBalancer(t *testing.T) {
 			return true, nil
 		})
 		if err != nil {
-			t.Errorf("failed to observe expected annotation on load balancer service %s: %v", controller.LoadBalancerServiceName(ic), err)
+			t.Errorf("failed to observe expected annotation on load balancer service %s: %v", controller.LoadBalancerServiceName(ic, operandNamespace), err)
 		}
 	}
 }
@@ -1186,7 +1213,7 @@ func TestInternalLoadBalancerGlobalAccessGCP(t *testing.T) {
 	}
 
 	lbService := &corev1.Service{}
-	if err := kclient.Get(context.TODO(), controller.LoadBalancerServiceName(ic), lbService); err != nil {
+	if err := kclient.Get(context.TODO(), controller.LoadBalancerServiceName(ic, operandNamespace), lbService); err != nil {
 		t.Fatalf("failed to get LoadBalancer service: %v", err)
 	}
 
@@ -1221,7 +1248,7 @@ func TestInternalLoadBalancerGlobalAccessGCP(t *testing.T) {
 	// Use a polling loop since the operator might not switch out the annotation
 	// immediately.
 	err := wait.PollImmediate(1*time.Second, 3*time.Minute, func() (bool, error) {
-		if err := kclient.Get(context.TODO(), controller.LoadBalancerServiceName(ic), lbService); err != nil {
+		if err := kclient.Get(context.TODO(), controller.LoadBalancerServiceName(ic, operandNamespace), lbService); err != nil {
 			t.Logf("failed to get LoadBalancer service: %v", err)
 			return false, nil
 		}
@@ -1235,7 +1262,7 @@ func TestInternalLoadBalancerGlobalAccessGCP(t *testing.T) {
 		return true, nil
 	})
 	if err != nil {
-		t.Errorf("failed to observe expected annotations on load balancer service %s: %v", controller.LoadBalancerServiceName(ic), err)
+		t.Errorf("failed to observe expected annotations on load balancer service %s: %v", controller.LoadBalancerServiceName(ic, operandNamespace), err)
 	}
 }
 
@@ -1262,7 +1289,7 @@ func TestAWSLBTypeChange(t *testing.T) {
 	}
 
 	lbService := &corev1.Service{}
-	if err := kclient.Get(context.TODO(), controller.LoadBalancerServiceName(ic), lbService); err != nil {
+	if err := kclient.Get(context.TODO(), controller.LoadBalancerServiceName(ic, operandNamespace), lbService); err != nil {
 		t.Fatalf("failed to get LoadBalancer service: %v", err)
 	}
 	if v := lbService.Annotations[ingresscontroller.AWSLBTypeAnnotation]; len(v) != 0 {
@@ -1292,8 +1319,8 @@ func TestAWSLBTypeChange(t *testing.T) {
 
 	err := wait.PollImmediate(5*time.Second, 5*time.Minute, func() (bool, error) {
 		service := &corev1.Service{}
-		if err := kclient.Get(context.TODO(), controller.LoadBalancerServiceName(ic), service); err != nil {
-			t.Logf("failed to get service %s: %v", controller.LoadBalancerServiceName(ic), err)
+		if err := kclient.Get(context.TODO(), controller.LoadBalancerServiceName(ic, operandNamespace), service); err != nil {
+			t.Logf("failed to get service %s: %v", controller.LoadBalancerServiceName(ic, operandNamespace), err)
 			return false, nil
 		}
 		if actual, ok := service.Annotations[ingresscontroller.AWSLBTypeAnnotation]; !ok {
@@ -1335,7 +1362,7 @@ func TestAWSLBTypeDefaulting(t *testing.T) {
 	}
 
 	t.Logf("verifying that ingresscontroller %s's LoadBalancer service doesn't specify NLB", clbName)
-	assertServiceAnnotation(t, controller.LoadBalancerServiceName(clbIC), ingresscontroller.AWSLBTypeAnnotation, "", false)
+	assertServiceAnnotation(t, controller.LoadBalancerServiceName(clbIC, operandNamespace), ingresscontroller.AWSLBTypeAnnotation, "", false)
 
 	t.Log("changing the default LB type to NLB")
 	if err := updateIngressConfigSpecWithRetryOnConflict(t, clusterConfigName, timeout, func(spec *configv1.IngressSpec) {
@@ -1384,13 +1411,13 @@ func TestAWSLBTypeDefaulting(t *testing.T) {
 	}
 
 	t.Logf("verifying that ingresscontroller %s's LoadBalancer service specifies NLB", nlbName)
-	assertServiceAnnotation(t, controller.LoadBalancerServiceName(nlbIC), ingresscontroller.AWSLBTypeAnnotation, ingresscontroller.AWSNLBAnnotation, true)
+	assertServiceAnnotation(t, controller.LoadBalancerServiceName(nlbIC, operandNamespace), ingresscontroller.AWSLBTypeAnnotation, ingresscontroller.AWSNLBAnnotation, true)
 
 	t.Logf("verifying that ingresscontroller %s's LoadBalancer service still doesn't specify NLB", clbName)
 	// This is step is to verify that updating the cluster ingress config
 	// doesn't impact ingresscontrollers that have previously taken the
 	// default setting.
-	assertServiceAnnotation(t, controller.LoadBalancerServiceName(clbIC), ingresscontroller.AWSLBTypeAnnotation, "", false)
+	assertServiceAnnotation(t, controller.LoadBalancerServiceName(clbIC, operandNamespace), ingresscontroller.AWSLBTypeAnnotation, "", false)
 
 	t.Log("resetting the default LB type to Classic")
 	if err := updateIngressConfigSpecWithRetryOnConflict(t, clusterConfigName, timeout, func(spec *configv1.IngressSpec) {
@@ -1403,9 +1430,9 @@ func TestAWSLBTypeDefaulting(t *testing.T) {
 	}
 
 	t.Logf("verifying that ingresscontroller %s's LoadBalancer service still specifies NLB", nlbName)
-	assertServiceAnnotation(t, controller.LoadBalancerServiceName(clbIC), ingresscontroller.AWSLBTypeAnnotation, "", false)
+	assertServiceAnnotation(t, controller.LoadBalancerServiceName(clbIC, operandNamespace), ingresscontroller.AWSLBTypeAnnotation, "", false)
 	t.Logf("verifying that ingresscontroller %s's LoadBalancer service still doesn't specify NLB", clbName)
-	assertServiceAnnotation(t, controller.LoadBalancerServiceName(nlbIC), ingresscontroller.AWSLBTypeAnnotation, ingresscontroller.AWSNLBAnnotation, true)
+	assertServiceAnnotation(t, controller.LoadBalancerServiceName(nlbIC, operandNamespace), ingresscontroller.AWSLBTypeAnnotation, ingresscontroller.AWSNLBAnnotation, true)
 }
 
 // TestScopeChange creates an ingresscontroller with the "LoadBalancerService"
@@ -1456,7 +1483,7 @@ func TestScopeChange(t *testing.T) {
 	}
 
 	lbService := &corev1.Service{}
-	if err := kclient.Get(context.TODO(), controller.LoadBalancerServiceName(ic), lbService); err != nil {
+	if err := kclient.Get(context.TODO(), controller.LoadBalancerServiceName(ic, operandNamespace), lbService); err != nil {
 		t.Fatalf("failed to get LoadBalancer service: %v", err)
 	}
 
@@ -1487,11 +1514,11 @@ func TestScopeChange(t *testing.T) {
 	case configv1.AzurePlatformType, configv1.GCPPlatformType:
 		err := wait.PollImmediate(5*time.Second, 5*time.Minute, func() (bool, error) {
 			service := &corev1.Service{}
-			if err := kclient.Get(context.TODO(), controller.LoadBalancerServiceName(ic), service); err != nil {
+			if err := kclient.Get(context.TODO(), controller.LoadBalancerServiceName(ic, operandNamespace), service); err != nil {
 				if apierrors.IsNotFound(err) {
 					return false, nil
 				}
-				t.Logf("failed to get service %s: %v", controller.LoadBalancerServiceName(ic), err)
+				t.Logf("failed to get service %s: %v", controller.LoadBalancerServiceName(ic, operandNamespace), err)
 				return false, nil
 			}
 			if ingresscontroller.IsServiceInternal(service) {
@@ -1528,7 +1555,7 @@ func TestScopeChange(t *testing.T) {
 	case configv1.AzurePlatformType, configv1.GCPPlatformType:
 		err := wait.PollImmediate(5*time.Second, 5*time.Minute, func() (bool, error) {
 			service := &corev1.Service{}
-			if err := kclient.Get(context.TODO(), controller.LoadBalancerServiceName(ic), service); err != nil {
+			if err := kclient.Get(context.TODO(), controller.LoadBalancerServiceName(ic, operandNamespace), service); err != nil {
 				if apierrors.IsNotFound(err) {
 					return false, nil
 				}
@@ -1568,11 +1595,11 @@ func TestScopeChange(t *testing.T) {
 
 	err := wait.PollImmediate(5*time.Second, 5*time.Minute, func() (bool, error) {
 		service := &corev1.Service{}
-		if err := kclient.Get(context.TODO(), controller.LoadBalancerServiceName(ic), service); err != nil {
+		if err := kclient.Get(context.TODO(), controller.LoadBalancerServiceName(ic, operandNamespace), service); err != nil {
 			if apierrors.IsNotFound(err) {
 				return false, nil
 			}
-			t.Logf("failed to get service %s: %v", controller.LoadBalancerServiceName(ic), err)
+			t.Logf("failed to get service %s: %v", controller.LoadBalancerServiceName(ic, operandNamespace), err)
 			return false, nil
 		}
 		if ingresscontroller.IsServiceInternal(service) {
@@ -1616,7 +1643,7 @@ func TestNodePortServiceEndpointPublishingStrategy(t *testing.T) {
 
 	// Make sure the ingresscontroller has a nodeport service
 	// with the expected ports.
-	svcName := controller.NodePortServiceName(ing)
+	svcName := controller.NodePortServiceName(ing, operandNamespace)
 	service := &corev1.Service{}
 	err = wait.PollImmediate(1*time.Second, 1*time.Minute, func() (bool, error) {
 		if err := kclient.Get(context.TODO(), svcName, service); err != nil {
@@ -1846,8 +1873,8 @@ func TestRouteAdmissionPolicy(t *testing.T) {
 	// has finished rolling out before checking the route.
 	deployment := &appsv1.Deployment{}
 	err := wait.PollImmediate(1*time.Second, 1*time.Minute, func() (bool, error) {
-		if err := kclient.Get(context.TODO(), controller.RouterDeploymentName(ic), deployment); err != nil {
-			t.Logf("failed to get deployment %s: %v", controller.RouterDeploymentName(ic), err)
+		if err := kclient.Get(context.TODO(), controller.RouterDeploymentName(ic, operandNamespace), deployment); err != nil {
+			t.Logf("failed to get deployment %s: %v", controller.RouterDeploymentName(ic, operandNamespace), err)
 			return false, nil
 		}
 		for _, v := range deployment.Spec.Template.Spec.Containers[0].Env {
@@ -1909,8 +1936,8 @@ func TestRouteAdmissionPolicy(t *testing.T) {
 		t.Fatalf("failed to update ingresscontroller: %v", err)
 	}
 	err = wait.PollImmediate(1*time.Second, 1*time.Minute, func() (bool, error) {
-		if err := kclient.Get(context.TODO(), controller.RouterDeploymentName(ic), deployment); err != nil {
-			t.Logf("failed to get deployment %s: %v", controller.RouterDeploymentName(ic), err)
+		if err := kclient.Get(context.TODO(), controller.RouterDeploymentName(ic, operandNamespace), deployment); err != nil {
+			t.Logf("failed to get deployment %s: %v", controller.RouterDeploymentName(ic, operandNamespace), err)
 			return false, nil
 		}
 		for _, v := range deployment.Spec.Template.Spec.Containers[0].Env {
@@ -1951,7 +1978,7 @@ func TestSyslogLogging(t *testing.T) {
 		t.Fatalf("failed to get default ingresscontroller: %v", err)
 	}
 	deployment := &appsv1.Deployment{}
-	if err := kclient.Get(context.TODO(), controller.RouterDeploymentName(ic), deployment); err != nil {
+	if err := kclient.Get(context.TODO(), controller.RouterDeploymentName(ic, operandNamespace), deployment); err != nil {
 		t.Fatalf("failed to get default ingresscontroller's deployment: %v", err)
 	}
 	var (
@@ -2263,7 +2290,7 @@ func TestHTTPHeaderCapture(t *testing.T) {
 	// Get the deployment's pods.  We will use these to curl a route and to
 	// scan access logs.
 	deployment := &appsv1.Deployment{}
-	if err := kclient.Get(context.TODO(), controller.RouterDeploymentName(ic), deployment); err != nil {
+	if err := kclient.Get(context.TODO(), controller.RouterDeploymentName(ic, operandNamespace), deployment); err != nil {
 		t.Fatalf("failed to get ingresscontroller deployment: %v", err)
 	}
 	selector, err := metav1.LabelSelectorAsSelector(deployment.Spec.Selector)
@@ -2407,7 +2434,7 @@ func TestHTTPCookieCapture(t *testing.T) {
 	// Get the deployment's pods.  We will use these to curl a route and to
 	// scan access logs.
 	deployment := &appsv1.Deployment{}
-	if err := kclient.Get(context.TODO(), controller.RouterDeploymentName(ic), deployment); err != nil {
+	if err := kclient.Get(context.TODO(), controller.RouterDeploymentName(ic, operandNamespace), deployment); err != nil {
 		t.Fatalf("failed to get ingresscontroller deployment: %v", err)
 	}
 	selector, err := metav1.LabelSelectorAsSelector(deployment.Spec.Selector)
@@ -2544,7 +2571,7 @@ func TestNetworkLoadBalancer(t *testing.T) {
 	}
 
 	lbService := &corev1.Service{}
-	if err := kclient.Get(context.TODO(), controller.LoadBalancerServiceName(ic), lbService); err != nil {
+	if err := kclient.Get(context.TODO(), controller.LoadBalancerServiceName(ic, operandNamespace), lbService); err != nil {
 		t.Fatalf("failed to get LoadBalancer service: %v", err)
 	}
 
@@ -2629,7 +2656,7 @@ func TestAWSELBConnectionIdleTimeout(t *testing.T) {
 
 	// Verify that the ingresscontroller has the expected annotation.
 	lbService := &corev1.Service{}
-	if err := kclient.Get(context.TODO(), controller.LoadBalancerServiceName(ic), lbService); err != nil {
+	if err := kclient.Get(context.TODO(), controller.LoadBalancerServiceName(ic, operandNamespace), lbService); err != nil {
 		t.Fatalf("failed to get LoadBalancer service: %v", err)
 	}
 
@@ -2716,7 +2743,7 @@ func TestAWSELBConnectionIdleTimeout(t *testing.T) {
 
 	// Verify that the ingresscontroller has the updated annotation.
 	if err := wait.PollImmediate(1*time.Second, 1*time.Minute, func() (bool, error) {
-		if err := kclient.Get(context.TODO(), controller.LoadBalancerServiceName(ic), lbService); err != nil {
+		if err := kclient.Get(context.TODO(), controller.LoadBalancerServiceName(ic, operandNamespace), lbService); err != nil {
 			t.Logf("failed to get LoadBalancer service: %v", err)
 
 			return false, nil
@@ -2798,12 +2825,12 @@ func TestUniqueIdHeader(t *testing.T) {
 	}
 
 	deployment := &appsv1.Deployment{}
-	if err := kclient.Get(context.TODO(), controller.RouterDeploymentName(ic), deployment); err != nil {
+	if err := kclient.Get(context.TODO(), controller.RouterDeploymentName(ic, operandNamespace), deployment); err != nil {
 		t.Fatalf("failed to get ingresscontroller deployment: %v", err)
 	}
 
 	service := &corev1.Service{}
-	if err := kclient.Get(context.TODO(), controller.InternalIngressControllerServiceName(ic), service); err != nil {
+	if err := kclient.Get(context.TODO(), controller.InternalIngressControllerServiceName(ic, operandNamespace), service); err != nil {
 		t.Fatalf("failed to get ingresscontroller service: %v", err)
 	}
 
@@ -2926,7 +2953,7 @@ func TestLoadBalancingAlgorithmUnsupportedConfigOverride(t *testing.T) {
 	}
 
 	deployment := &appsv1.Deployment{}
-	if err := kclient.Get(context.TODO(), controller.RouterDeploymentName(ic), deployment); err != nil {
+	if err := kclient.Get(context.TODO(), controller.RouterDeploymentName(ic, operandNamespace), deployment); err != nil {
 		t.Fatalf("failed to get ingresscontroller deployment: %v", err)
 	}
 	expectedAlgorithm := "random"
@@ -2973,7 +3000,7 @@ func TestDynamicConfigManagerUnsupportedConfigOverride(t *testing.T) {
 	}
 
 	deployment := &appsv1.Deployment{}
-	if err := kclient.Get(context.TODO(), controller.RouterDeploymentName(ic), deployment); err != nil {
+	if err := kclient.Get(context.TODO(), controller.RouterDeploymentName(ic, operandNamespace), deployment); err != nil {
 		t.Fatalf("failed to get ingresscontroller deployment: %v", err)
 	}
 	if err := waitForDeploymentEnvVar(t, kclient, deployment, 30*time.Second, "ROUTER_HAPROXY_CONFIG_MANAGER", ""); err != nil {
@@ -3024,7 +3051,7 @@ func TestLocalWithFallbackOverrideForLoadBalancerService(t *testing.T) {
 	}
 
 	service := &corev1.Service{}
-	serviceName := controller.LoadBalancerServiceName(ic)
+	serviceName := controller.LoadBalancerServiceName(ic, operandNamespace)
 	if err := kclient.Get(context.TODO(), serviceName, service); err != nil {
 		t.Fatalf("failed to get service %q: %v", serviceName, err)
 	}
@@ -3083,7 +3110,7 @@ func TestLocalWithFallbackOverrideForNodePortService(t *testing.T) {
 	}
 
 	service := &corev1.Service{}
-	serviceName := controller.NodePortServiceName(ic)
+	serviceName := controller.NodePortServiceName(ic, operandNamespace)
 	if err := kclient.Get(context.TODO(), serviceName, service); err != nil {
 		t.Fatalf("failed to get service %q: %v", serviceName, err)
 	}
@@ -3164,7 +3191,7 @@ func TestCustomErrorpages(t *testing.T) {
 	}
 
 	// The controller should create a configmap in "openshift-ingress".
-	cmName := controller.HttpErrorCodePageConfigMapName(ic)
+	cmName := controller.HttpErrorCodePageConfigMapName(ic, operandNamespace)
 	cm := &corev1.ConfigMap{}
 	err := wait.PollImmediate(1*time.Second, 5*time.Minute, func() (bool, error) {
 		if err := kclient.Get(context.TODO(), cmName, cm); err != nil {
@@ -3181,7 +3208,7 @@ func TestCustomErrorpages(t *testing.T) {
 	}
 
 	// The deployment should use the custom error-page configmap.
-	deploymentName := controller.RouterDeploymentName(ic)
+	deploymentName := controller.RouterDeploymentName(ic, operandNamespace)
 	deployment := &appsv1.Deployment{}
 	if err := kclient.Get(context.TODO(), deploymentName, deployment); err != nil {
 		t.Fatalf("failed to get deployment %q: %v", deploymentName, err)
@@ -3189,6 +3216,9 @@ func TestCustomErrorpages(t *testing.T) {
 	if err := waitForDeploymentEnvVar(t, kclient, deployment, 1*time.Minute, "ROUTER_ERRORFILE_503", "/var/lib/haproxy/conf/error_code_pages/error-page-503.http"); err != nil {
 		t.Fatalf("expected deployment %q to use the custom error-page file: %v", deploymentName, err)
 	}
+	if err := waitForDeploymentEnvVar(t, kclient, deployment, 1*time.Minute, "ROUTER_ERRORFILE_500", "/var/lib/haproxy/conf/error_code_pages/error-page-500.http"); err != nil {
+		t.Fatalf("expected deployment %q to use an error-page file for status code 500: %v", deploymentName, err)
+	}
 
 	// The controller should recreate the configmap if it is deleted.
 	if err := kclient.Delete(context.TODO(), cm); err != nil {
@@ -3246,7 +3276,7 @@ func TestTunableRouterKubeletProbesForCustomIngressController(t *testing.T) {
 		t.Fatalf("failed to observe expected conditions: %v", err)
 	}
 
-	deploymentName := controller.RouterDeploymentName(ic)
+	deploymentName := controller.RouterDeploymentName(ic, operandNamespace)
 	deployment := &appsv1.Deployment{}
 	if err := kclient.Get(context.TODO(), deploymentName, deployment); err != nil {
 		t.Fatalf("failed to get default deployment: %v", err)
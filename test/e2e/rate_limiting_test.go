@@ -0,0 +1,102 @@
+//go:build e2e
+// +build e2e
+
+package e2e
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	"github.com/openshift/cluster-ingress-operator/pkg/operator/controller"
+	ingresscontroller "github.com/openshift/cluster-ingress-operator/pkg/operator/controller/ingress"
+
+	appsv1 "k8s.io/api/apps/v1"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// TestRateLimiting verifies that the operator renders the rate limits that the
+// rateLimiting unsupported config override specifies into the router's
+// configuration, reports the effective limits using the RateLimiting status
+// condition, and removes them when the override is removed.
+func TestRateLimiting(t *testing.T) {
+	t.Parallel()
+	icName := types.NamespacedName{Namespace: operatorNamespace, Name: "rate-limiting"}
+	domain := icName.Name + "." + dnsConfig.Spec.BaseDomain
+	ic := newPrivateController(icName, domain)
+	ic.Spec.TuningOptions.MaxConnections = 2000
+	ic.Spec.UnsupportedConfigOverrides = runtime.RawExtension{
+		Raw: []byte(`{"rateLimiting":{"requestsPerSecond":100,"concurrentConnections":5000,"allowlist":["10.0.0.0/8"]}}`),
+	}
+	if err := kclient.Create(context.TODO(), ic); err != nil {
+		t.Fatalf("failed to create ingresscontroller %s: %v", icName, err)
+	}
+	defer assertIngressControllerDeleted(t, kclient, ic)
+	if err := waitForIngressControllerCondition(t, kclient, 5*time.Minute, icName, availableConditionsForPrivateIngressController...); err != nil {
+		t.Fatalf("failed to observe expected conditions: %v", err)
+	}
+
+	deployment := &appsv1.Deployment{}
+	deploymentName := controller.RouterDeploymentName(ic, operandNamespace)
+	if err := kclient.Get(context.TODO(), deploymentName, deployment); err != nil {
+		t.Fatalf("failed to get router deployment %s: %v", deploymentName, err)
+	}
+	expectedEnv := map[string]string{
+		ingresscontroller.RouterRateLimitHTTPRequestsPerSecondEnvName: "100",
+		ingresscontroller.RouterRateLimitConcurrentConnectionsEnvName: "2000",
+		ingresscontroller.RouterRateLimitAllowlistEnvName:             "10.0.0.0/8",
+	}
+	for name, value := range expectedEnv {
+		if err := waitForDeploymentEnvVar(t, kclient, deployment, 1*time.Minute, name, value); err != nil {
+			t.Fatalf("expected router deployment to have %s=%s: %v", name, value, err)
+		}
+	}
+	if err := waitForDeploymentEnvVar(t, kclient, deployment, 1*time.Minute, ingresscontroller.RouterMetricsHAProxyExportedEnvName, "2,4,5,7,8,9,10,13,14,17,21,24,33,35,40,43,60"); err != nil {
+		t.Fatalf("expected router deployment to export the denied requests metric: %v", err)
+	}
+
+	conditions := []operatorv1.OperatorCondition{
+		{Type: ingresscontroller.IngressControllerRateLimitingConditionType, Status: operatorv1.ConditionTrue},
+	}
+	if err := waitForIngressControllerCondition(t, kclient, 1*time.Minute, icName, conditions...); err != nil {
+		t.Fatalf("failed to observe expected conditions: %v", err)
+	}
+	expectedMessage := "Each source IP address is limited to 100 requests per second and 2000 concurrent connections. Exempt sources: 10.0.0.0/8."
+	if err := wait.PollImmediate(1*time.Second, 1*time.Minute, func() (bool, error) {
+		if err := kclient.Get(context.TODO(), icName, ic); err != nil {
+			t.Logf("failed to get ingresscontroller %s: %v", icName, err)
+			return false, nil
+		}
+		for _, cond := range ic.Status.Conditions {
+			if cond.Type == ingresscontroller.IngressControllerRateLimitingConditionType {
+				return cond.Message == expectedMessage, nil
+			}
+		}
+		return false, nil
+	}); err != nil {
+		t.Fatalf("failed to observe condition message %q: %v", expectedMessage, err)
+	}
+
+	// Removing the override should remove the rate limits.
+	if err := updateIngressControllerSpecWithRetryOnConflict(t, icName, timeout, func(spec *operatorv1.IngressControllerSpec) {
+		spec.UnsupportedConfigOverrides = runtime.RawExtension{}
+	}); err != nil {
+		t.Fatalf("failed to update ingresscontroller %s: %v", icName, err)
+	}
+	for name := range expectedEnv {
+		if err := waitForDeploymentEnvVar(t, kclient, deployment, 1*time.Minute, name, ""); err != nil {
+			t.Fatalf("expected router deployment not to have %s: %v", name, err)
+		}
+	}
+	conditions = []operatorv1.OperatorCondition{
+		{Type: ingresscontroller.IngressControllerRateLimitingConditionType, Status: operatorv1.ConditionFalse},
+	}
+	if err := waitForIngressControllerCondition(t, kclient, 1*time.Minute, icName, conditions...); err != nil {
+		t.Fatalf("failed to observe expected conditions: %v", err)
+	}
+}
@@ -46,7 +46,7 @@ func TestReloadInterval(t *testing.T) {
 	}
 
 	deployment := &appsv1.Deployment{}
-	if err := kclient.Get(context.TODO(), controller.RouterDeploymentName(ic), deployment); err != nil {
+	if err := kclient.Get(context.TODO(), controller.RouterDeploymentName(ic, operandNamespace), deployment); err != nil {
 		t.Fatalf("failed to get ingresscontroller router deployment: %v", err)
 	}
 
@@ -108,20 +108,20 @@ func TestReloadInterval(t *testing.T) {
 		// Ensure the deployment has been recreated.
 		err := wait.PollImmediate(1*time.Second, 2*time.Minute, func() (bool, error) {
 			deployment := &appsv1.Deployment{}
-			if err := kclient.Get(context.TODO(), controller.RouterDeploymentName(ic), deployment); err != nil {
-				t.Logf("Get %q failed: %v, retrying ...", controller.RouterDeploymentName(ic), err)
+			if err := kclient.Get(context.TODO(), controller.RouterDeploymentName(ic, operandNamespace), deployment); err != nil {
+				t.Logf("Get %q failed: %v, retrying ...", controller.RouterDeploymentName(ic, operandNamespace), err)
 				return false, nil
 			}
 			// Ensure the UID of the router deployment changed.
 			if deployment.UID == oldUID {
-				t.Logf("Waiting for deployment %q to be deleted", controller.RouterDeploymentName(ic))
+				t.Logf("Waiting for deployment %q to be deleted", controller.RouterDeploymentName(ic, operandNamespace))
 				return false, nil
 			}
 			return true, nil
 		})
 
 		if err != nil {
-			t.Fatalf("failed to delete and recreate the %q deployment", controller.RouterDeploymentName(ic))
+			t.Fatalf("failed to delete and recreate the %q deployment", controller.RouterDeploymentName(ic, operandNamespace))
 		}
 
 		if err := waitForDeploymentEnvVar(t, kclient, deployment, 1*time.Minute, ingresscontroller.RouterReloadIntervalEnvName, testCase.expectedEnvVar); err != nil {
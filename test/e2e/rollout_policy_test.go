@@ -0,0 +1,78 @@
+//go:build e2e
+// +build e2e
+
+package e2e
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/openshift/cluster-ingress-operator/pkg/operator/controller"
+
+	appsv1 "k8s.io/api/apps/v1"
+	policyv1 "k8s.io/api/policy/v1"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// TestRolloutPolicy verifies that the operator applies the rollout parameters
+// that the rollout unsupported config override specifies to the router
+// deployment and keeps the pod disruption budget consistent with them.
+func TestRolloutPolicy(t *testing.T) {
+	t.Parallel()
+	icName := types.NamespacedName{Namespace: operatorNamespace, Name: "rollout-policy"}
+	domain := icName.Name + "." + dnsConfig.Spec.BaseDomain
+	ic := newPrivateController(icName, domain)
+	replicas := int32(2)
+	ic.Spec.Replicas = &replicas
+	ic.Spec.UnsupportedConfigOverrides = runtime.RawExtension{
+		Raw: []byte(`{"rollout":{"maxUnavailable":1,"maxSurge":1,"terminationGracePeriodSeconds":600,"minReadySeconds":5}}`),
+	}
+	if err := kclient.Create(context.TODO(), ic); err != nil {
+		t.Fatalf("failed to create ingresscontroller %s: %v", icName, err)
+	}
+	defer assertIngressControllerDeleted(t, kclient, ic)
+	if err := waitForIngressControllerCondition(t, kclient, 5*time.Minute, icName, availableConditionsForPrivateIngressController...); err != nil {
+		t.Fatalf("failed to observe expected conditions: %v", err)
+	}
+
+	expectedMaxUnavailable := intstr.FromInt(1)
+	expectedMaxSurge := intstr.FromInt(1)
+	deployment := &appsv1.Deployment{}
+	pdb := &policyv1.PodDisruptionBudget{}
+	if err := wait.PollImmediate(2*time.Second, 1*time.Minute, func() (bool, error) {
+		if err := kclient.Get(context.TODO(), controller.RouterDeploymentName(ic, operandNamespace), deployment); err != nil {
+			t.Logf("failed to get deployment: %v", err)
+			return false, nil
+		}
+		if err := kclient.Get(context.TODO(), controller.RouterPodDisruptionBudgetName(ic, operandNamespace), pdb); err != nil {
+			t.Logf("failed to get pod disruption budget: %v", err)
+			return false, nil
+		}
+		var errs []error
+		if rollingUpdate := deployment.Spec.Strategy.RollingUpdate; rollingUpdate == nil || !reflect.DeepEqual(rollingUpdate.MaxUnavailable, &expectedMaxUnavailable) || !reflect.DeepEqual(rollingUpdate.MaxSurge, &expectedMaxSurge) {
+			errs = append(errs, fmt.Errorf("unexpected rolling update parameters: %+v", rollingUpdate))
+		}
+		if gracePeriod := deployment.Spec.Template.Spec.TerminationGracePeriodSeconds; gracePeriod == nil || *gracePeriod != 600 {
+			errs = append(errs, fmt.Errorf("unexpected termination grace period: %v", gracePeriod))
+		}
+		if deployment.Spec.MinReadySeconds != 5 {
+			errs = append(errs, fmt.Errorf("unexpected min ready seconds: %d", deployment.Spec.MinReadySeconds))
+		}
+		if !reflect.DeepEqual(pdb.Spec.MaxUnavailable, &expectedMaxUnavailable) {
+			errs = append(errs, fmt.Errorf("unexpected pod disruption budget max unavailable: %v", pdb.Spec.MaxUnavailable))
+		}
+		for _, err := range errs {
+			t.Log(err)
+		}
+		return len(errs) == 0, nil
+	}); err != nil {
+		t.Fatalf("failed to observe expected rollout parameters: %v", err)
+	}
+}
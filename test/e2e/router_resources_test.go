@@ -0,0 +1,110 @@
+//go:build e2e
+// +build e2e
+
+package e2e
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	"github.com/openshift/cluster-ingress-operator/pkg/operator/controller"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// TestRouterResources verifies that the operator applies the container
+// resources that the resources unsupported config override specifies, that
+// router pods have the Guaranteed QoS class in Guaranteed QoS mode, and that a
+// change to the resources rolls out.
+func TestRouterResources(t *testing.T) {
+	t.Parallel()
+	icName := types.NamespacedName{Namespace: operatorNamespace, Name: "router-resources"}
+	domain := icName.Name + "." + dnsConfig.Spec.BaseDomain
+	ic := newPrivateController(icName, domain)
+	ic.Spec.UnsupportedConfigOverrides = runtime.RawExtension{
+		Raw: []byte(`{"resources":{"guaranteedQoS":true,"router":{"limits":{"cpu":"200m","memory":"512Mi"}}}}`),
+	}
+	if err := kclient.Create(context.TODO(), ic); err != nil {
+		t.Fatalf("failed to create ingresscontroller %s: %v", icName, err)
+	}
+	defer assertIngressControllerDeleted(t, kclient, ic)
+	if err := waitForIngressControllerCondition(t, kclient, 5*time.Minute, icName, availableConditionsForPrivateIngressController...); err != nil {
+		t.Fatalf("failed to observe expected conditions: %v", err)
+	}
+
+	deployment := &appsv1.Deployment{}
+	if err := kclient.Get(context.TODO(), controller.RouterDeploymentName(ic, operandNamespace), deployment); err != nil {
+		t.Fatalf("failed to get ingresscontroller deployment: %v", err)
+	}
+	if err := waitForDeploymentComplete(t, kclient, deployment, 3*time.Minute); err != nil {
+		t.Fatalf("failed to observe expected conditions: %v", err)
+	}
+	waitForRouterPodQOSClass(t, icName, deployment.Namespace, corev1.PodQOSGuaranteed)
+
+	// Disabling Guaranteed QoS mode should roll out pods with the
+	// Burstable QoS class.
+	if err := updateIngressControllerSpecWithRetryOnConflict(t, icName, timeout, func(spec *operatorv1.IngressControllerSpec) {
+		spec.UnsupportedConfigOverrides = runtime.RawExtension{
+			Raw: []byte(`{"resources":{"router":{"requests":{"cpu":"200m"},"limits":{"memory":"512Mi"}}}}`),
+		}
+	}); err != nil {
+		t.Fatalf("failed to update ingresscontroller %s: %v", icName, err)
+	}
+	if err := wait.PollImmediate(2*time.Second, 1*time.Minute, func() (bool, error) {
+		if err := kclient.Get(context.TODO(), controller.RouterDeploymentName(ic, operandNamespace), deployment); err != nil {
+			t.Logf("failed to get deployment: %v", err)
+			return false, nil
+		}
+		resources := deployment.Spec.Template.Spec.Containers[0].Resources
+		_, haveCPULimit := resources.Limits[corev1.ResourceCPU]
+		return !haveCPULimit && resources.Limits.Memory().Cmp(resource.MustParse("512Mi")) == 0, nil
+	}); err != nil {
+		t.Fatalf("failed to observe updated resources: %v", err)
+	}
+	if err := waitForDeploymentComplete(t, kclient, deployment, 3*time.Minute); err != nil {
+		t.Fatalf("failed to observe expected conditions: %v", err)
+	}
+	waitForRouterPodQOSClass(t, icName, deployment.Namespace, corev1.PodQOSBurstable)
+}
+
+// waitForRouterPodQOSClass waits for every router pod of the given
+// ingresscontroller to have the given QoS class.
+func waitForRouterPodQOSClass(t *testing.T, icName types.NamespacedName, namespace string, qosClass corev1.PodQOSClass) {
+	t.Helper()
+	labels := map[string]string{
+		controller.ControllerDeploymentLabel: icName.Name,
+	}
+	if err := wait.PollImmediate(2*time.Second, 3*time.Minute, func() (bool, error) {
+		podList := &corev1.PodList{}
+		if err := kclient.List(context.TODO(), podList, client.InNamespace(namespace), client.MatchingLabels(labels)); err != nil {
+			t.Logf("failed to list pods for ingresscontroller %s: %v", icName.Name, err)
+			return false, nil
+		}
+		if len(podList.Items) == 0 {
+			return false, nil
+		}
+		for _, pod := range podList.Items {
+			if pod.DeletionTimestamp != nil {
+				return false, nil
+			}
+			if pod.Status.QOSClass != qosClass {
+				t.Logf("pod %s/%s has QoS class %q, expected %q", pod.Namespace, pod.Name, pod.Status.QOSClass, qosClass)
+				return false, nil
+			}
+		}
+		return true, nil
+	}); err != nil {
+		t.Fatalf("failed to observe router pods with QoS class %q: %v", qosClass, err)
+	}
+}
@@ -0,0 +1,94 @@
+//go:build e2e
+// +build e2e
+
+package e2e
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	"github.com/openshift/cluster-ingress-operator/pkg/operator/controller"
+	ingresscontroller "github.com/openshift/cluster-ingress-operator/pkg/operator/controller/ingress"
+
+	appsv1 "k8s.io/api/apps/v1"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// TestStagedRollout verifies that, with the stagedRollout unsupported config
+// override, the operator verifies a change to the router deployment's pod
+// template on a canary deployment before it promotes the change to the router
+// deployment.
+func TestStagedRollout(t *testing.T) {
+	t.Parallel()
+	icName := types.NamespacedName{Namespace: operatorNamespace, Name: "staged-rollout"}
+	domain := icName.Name + "." + dnsConfig.Spec.BaseDomain
+	ic := newPrivateController(icName, domain)
+	ic.Spec.UnsupportedConfigOverrides = runtime.RawExtension{
+		Raw: []byte(`{"stagedRollout":{"enabled":true,"verificationSeconds":30}}`),
+	}
+	if err := kclient.Create(context.TODO(), ic); err != nil {
+		t.Fatalf("failed to create ingresscontroller %s: %v", icName, err)
+	}
+	defer assertIngressControllerDeleted(t, kclient, ic)
+	conditions := append([]operatorv1.OperatorCondition{
+		{Type: ingresscontroller.IngressControllerStagedRolloutVerifiedConditionType, Status: operatorv1.ConditionTrue},
+	}, availableConditionsForPrivateIngressController...)
+	if err := waitForIngressControllerCondition(t, kclient, 5*time.Minute, icName, conditions...); err != nil {
+		t.Fatalf("failed to observe expected conditions: %v", err)
+	}
+
+	// Change the client timeout, which changes the pod template.
+	if err := updateIngressControllerSpecWithRetryOnConflict(t, icName, timeout, func(spec *operatorv1.IngressControllerSpec) {
+		spec.TuningOptions.ClientTimeout = &metav1.Duration{Duration: 45 * time.Second}
+	}); err != nil {
+		t.Fatalf("failed to update ingresscontroller %s: %v", icName, err)
+	}
+
+	// The operator should verify the change on a canary deployment
+	// first.
+	canary := &appsv1.Deployment{}
+	if err := wait.PollImmediate(2*time.Second, 1*time.Minute, func() (bool, error) {
+		if err := kclient.Get(context.TODO(), controller.RouterStagedRolloutDeploymentName(ic, operandNamespace), canary); err != nil {
+			t.Logf("failed to get staged rollout deployment: %v", err)
+			return false, nil
+		}
+		return true, nil
+	}); err != nil {
+		t.Fatalf("failed to observe staged rollout deployment: %v", err)
+	}
+	if err := waitForDeploymentEnvVar(t, kclient, canary, 1*time.Minute, "ROUTER_DEFAULT_CLIENT_TIMEOUT", "45s"); err != nil {
+		t.Fatalf("expected staged rollout deployment to have the new client timeout: %v", err)
+	}
+
+	// Once the canary replicas pass verification, the operator should
+	// promote the change and delete the canary deployment.
+	deployment := &appsv1.Deployment{}
+	if err := kclient.Get(context.TODO(), controller.RouterDeploymentName(ic, operandNamespace), deployment); err != nil {
+		t.Fatalf("failed to get ingresscontroller deployment: %v", err)
+	}
+	if err := waitForDeploymentEnvVar(t, kclient, deployment, 5*time.Minute, "ROUTER_DEFAULT_CLIENT_TIMEOUT", "45s"); err != nil {
+		t.Fatalf("expected router deployment to have the new client timeout: %v", err)
+	}
+	if err := wait.PollImmediate(2*time.Second, 1*time.Minute, func() (bool, error) {
+		if err := kclient.Get(context.TODO(), controller.RouterStagedRolloutDeploymentName(ic, operandNamespace), canary); err != nil {
+			if errors.IsNotFound(err) {
+				return true, nil
+			}
+			t.Logf("failed to get staged rollout deployment: %v", err)
+		}
+		return false, nil
+	}); err != nil {
+		t.Fatalf("failed to observe deletion of staged rollout deployment: %v", err)
+	}
+	if err := waitForIngressControllerCondition(t, kclient, 5*time.Minute, icName, conditions...); err != nil {
+		t.Fatalf("failed to observe expected conditions: %v", err)
+	}
+}
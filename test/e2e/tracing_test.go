@@ -0,0 +1,206 @@
+//go:build e2e
+// +build e2e
+
+package e2e
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	"github.com/openshift/cluster-ingress-operator/pkg/operator/controller"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"k8s.io/client-go/kubernetes"
+
+	"sigs.k8s.io/controller-runtime/pkg/client/config"
+)
+
+// buildTracingCollectorPod returns a pod definition for a stand-in OTLP
+// collector that accepts any HTTP request, writes the request line to its
+// standard error, and responds with an empty 200 response.
+func buildTracingCollectorPod(name, namespace string) *corev1.Pod {
+	pod := buildEchoPod(name, namespace)
+	pod.Spec.Containers[0].Name = "collector"
+	pod.Spec.Containers[0].Args = []string{
+		"TCP4-LISTEN:8080,reuseaddr,fork",
+		`EXEC:'/bin/bash -c \"sed -n -e \\\"1w /dev/stderr\\\" -e \\\"/^\r/q\\\"; printf \\\"HTTP/1.0 200 OK\r\nContent-Length: 0\r\n\r\n\\\"\"'`,
+	}
+	return pod
+}
+
+// TestTracing verifies that an ingresscontroller with the tracing unsupported
+// config override generates and propagates W3C trace context headers, exports
+// spans to the configured OTLP endpoint through the router tracing sidecar,
+// and removes trace context headers when propagation is disabled.  The test
+// requires the operator to be configured with its own image, which the
+// tracing sidecar runs.
+func TestTracing(t *testing.T) {
+	t.Parallel()
+
+	operatorDeployment := &appsv1.Deployment{}
+	operatorDeploymentName := types.NamespacedName{Namespace: operatorNamespace, Name: "ingress-operator"}
+	if err := kclient.Get(context.TODO(), operatorDeploymentName, operatorDeployment); err != nil {
+		t.Fatalf("failed to get operator deployment: %v", err)
+	}
+	haveOperatorImage := false
+	for _, container := range operatorDeployment.Spec.Template.Spec.Containers {
+		for _, env := range container.Env {
+			if env.Name == "CANARY_IMAGE" && len(env.Value) != 0 {
+				haveOperatorImage = true
+			}
+		}
+	}
+	if !haveOperatorImage {
+		t.Skip("operator is not configured with the ingress operator image")
+	}
+
+	// Create the stand-in collector.
+	collectorPod := buildTracingCollectorPod("tracing-collector", operandNamespace)
+	if err := kclient.Create(context.TODO(), collectorPod); err != nil {
+		t.Fatalf("failed to create pod %s/%s: %v", collectorPod.Namespace, collectorPod.Name, err)
+	}
+	defer func() {
+		if err := kclient.Delete(context.TODO(), collectorPod); err != nil {
+			t.Fatalf("failed to delete pod %s/%s: %v", collectorPod.Namespace, collectorPod.Name, err)
+		}
+	}()
+	collectorService := buildEchoService(collectorPod.Name, collectorPod.Namespace, collectorPod.ObjectMeta.Labels)
+	if err := kclient.Create(context.TODO(), collectorService); err != nil {
+		t.Fatalf("failed to create service %s/%s: %v", collectorService.Namespace, collectorService.Name, err)
+	}
+	defer func() {
+		if err := kclient.Delete(context.TODO(), collectorService); err != nil {
+			t.Fatalf("failed to delete service %s/%s: %v", collectorService.Namespace, collectorService.Name, err)
+		}
+	}()
+
+	icName := types.NamespacedName{Namespace: operatorNamespace, Name: "tracing"}
+	domain := icName.Name + "." + dnsConfig.Spec.BaseDomain
+	ic := newPrivateController(icName, domain)
+	collectorEndpoint := fmt.Sprintf("http://%s.%s.svc", collectorService.Name, collectorService.Namespace)
+	ic.Spec.UnsupportedConfigOverrides = runtime.RawExtension{
+		Raw: []byte(fmt.Sprintf(`{"tracing":{"propagation":"W3C","samplingRatio":1,"otlp":{"endpoint":%q,"protocol":"http"}}}`, collectorEndpoint)),
+	}
+	if err := kclient.Create(context.TODO(), ic); err != nil {
+		t.Fatalf("failed to create ingresscontroller %s: %v", icName, err)
+	}
+	defer assertIngressControllerDeleted(t, kclient, ic)
+	if err := waitForIngressControllerCondition(t, kclient, 5*time.Minute, icName, availableConditionsForPrivateIngressController...); err != nil {
+		t.Fatalf("failed to observe expected conditions: %v", err)
+	}
+
+	deployment := &appsv1.Deployment{}
+	if err := kclient.Get(context.TODO(), controller.RouterDeploymentName(ic, operandNamespace), deployment); err != nil {
+		t.Fatalf("failed to get ingresscontroller deployment: %v", err)
+	}
+	if err := waitForDeploymentEnvVar(t, kclient, deployment, 1*time.Minute, "ROUTER_UNIQUE_ID_HEADER_NAME", "X-Router-Trace-Context"); err != nil {
+		t.Fatalf("failed to observe ROUTER_UNIQUE_ID_HEADER_NAME: %v", err)
+	}
+	if err := waitForDeploymentComplete(t, kclient, deployment, 3*time.Minute); err != nil {
+		t.Fatalf("failed to observe expected conditions: %v", err)
+	}
+	service := &corev1.Service{}
+	if err := kclient.Get(context.TODO(), controller.InternalIngressControllerServiceName(ic, operandNamespace), service); err != nil {
+		t.Fatalf("failed to get ingresscontroller service: %v", err)
+	}
+
+	// Create a pod and route that echoes back the request.
+	echoPod := buildEchoPod("tracing-echo", deployment.Namespace)
+	if err := kclient.Create(context.TODO(), echoPod); err != nil {
+		t.Fatalf("failed to create pod %s/%s: %v", echoPod.Namespace, echoPod.Name, err)
+	}
+	defer func() {
+		if err := kclient.Delete(context.TODO(), echoPod); err != nil {
+			t.Fatalf("failed to delete pod %s/%s: %v", echoPod.Namespace, echoPod.Name, err)
+		}
+	}()
+
+	echoService := buildEchoService(echoPod.Name, echoPod.Namespace, echoPod.ObjectMeta.Labels)
+	if err := kclient.Create(context.TODO(), echoService); err != nil {
+		t.Fatalf("failed to create service %s/%s: %v", echoService.Namespace, echoService.Name, err)
+	}
+	defer func() {
+		if err := kclient.Delete(context.TODO(), echoService); err != nil {
+			t.Fatalf("failed to delete service %s/%s: %v", echoService.Namespace, echoService.Name, err)
+		}
+	}()
+
+	echoRoute := buildRoute(echoPod.Name, echoPod.Namespace, echoService.Name)
+	if err := kclient.Create(context.TODO(), echoRoute); err != nil {
+		t.Fatalf("failed to create route %s/%s: %v", echoRoute.Namespace, echoRoute.Name, err)
+	}
+	defer func() {
+		if err := kclient.Delete(context.TODO(), echoRoute); err != nil {
+			t.Fatalf("failed to delete route %s/%s: %v", echoRoute.Namespace, echoRoute.Name, err)
+		}
+	}()
+
+	clientPodImage := deployment.Spec.Template.Spec.Containers[0].Image
+
+	// The router should generate a traceparent header for a request that
+	// does not have one.
+	testRouteHeaders(t, clientPodImage, echoRoute, service.Spec.ClusterIP, nil, "traceparent: 00-", 1)
+
+	// The router should preserve the trace ID of a request that has a
+	// traceparent header and name its own span as the parent.
+	const traceID = "0af7651916cd43dd8448eb211c80319c"
+	const traceparent = "traceparent: 00-" + traceID + "-b7ad6b7169203331-01"
+	testRouteHeaders(t, clientPodImage, echoRoute, service.Spec.ClusterIP, []string{traceparent}, "traceparent: 00-"+traceID+"-", 1)
+
+	// The tracing sidecar should export the spans to the stand-in
+	// collector.
+	kubeConfig, err := config.GetConfig()
+	if err != nil {
+		t.Fatalf("failed to get kube config: %v", err)
+	}
+	kubeClient, err := kubernetes.NewForConfig(kubeConfig)
+	if err != nil {
+		t.Fatalf("failed to create kube client: %v", err)
+	}
+	if err := wait.PollImmediate(5*time.Second, 2*time.Minute, func() (bool, error) {
+		readCloser, err := kubeClient.CoreV1().Pods(collectorPod.Namespace).GetLogs(collectorPod.Name, &corev1.PodLogOptions{
+			Container: "collector",
+		}).Stream(context.TODO())
+		if err != nil {
+			t.Logf("failed to read logs from pod %s/%s: %v", collectorPod.Namespace, collectorPod.Name, err)
+			return false, nil
+		}
+		defer readCloser.Close()
+		logs, err := io.ReadAll(readCloser)
+		if err != nil {
+			t.Logf("failed to read logs from pod %s/%s: %v", collectorPod.Namespace, collectorPod.Name, err)
+			return false, nil
+		}
+		return bytes.Contains(logs, []byte("POST /v1/traces")), nil
+	}); err != nil {
+		t.Fatalf("failed to observe exported spans: %v", err)
+	}
+
+	// With propagation disabled and span export removed, the router should
+	// remove the traceparent header.
+	if err := updateIngressControllerSpecWithRetryOnConflict(t, icName, 1*time.Minute, func(spec *operatorv1.IngressControllerSpec) {
+		spec.UnsupportedConfigOverrides = runtime.RawExtension{
+			Raw: []byte(`{"tracing":{"propagation":"None"}}`),
+		}
+	}); err != nil {
+		t.Fatalf("failed to update ingresscontroller %s: %v", icName, err)
+	}
+	if err := waitForDeploymentEnvVar(t, kclient, deployment, 1*time.Minute, "ROUTER_HTTP_REQUEST_HEADERS", "traceparent:Delete,tracestate:Delete"); err != nil {
+		t.Fatalf("failed to observe ROUTER_HTTP_REQUEST_HEADERS: %v", err)
+	}
+	if err := waitForDeploymentComplete(t, kclient, deployment, 3*time.Minute); err != nil {
+		t.Fatalf("failed to observe expected conditions: %v", err)
+	}
+	testRouteHeaders(t, clientPodImage, echoRoute, service.Spec.ClusterIP, []string{traceparent}, "traceparent:", 0)
+}
@@ -50,7 +50,7 @@ func TestUnmanagedDNSToManagedDNSIngressController(t *testing.T) {
 	}
 
 	lbService := &corev1.Service{}
-	if err := kclient.Get(context.TODO(), controller.LoadBalancerServiceName(ic), lbService); err != nil {
+	if err := kclient.Get(context.TODO(), controller.LoadBalancerServiceName(ic, operandNamespace), lbService); err != nil {
 		t.Fatalf("failed to get LoadBalancer service: %v", err)
 	}
 
@@ -124,7 +124,7 @@ func TestManagedDNSToUnmanagedDNSIngressController(t *testing.T) {
 	}
 
 	lbService := &corev1.Service{}
-	if err := kclient.Get(context.TODO(), controller.LoadBalancerServiceName(ic), lbService); err != nil {
+	if err := kclient.Get(context.TODO(), controller.LoadBalancerServiceName(ic, operandNamespace), lbService); err != nil {
 		t.Fatalf("failed to get LoadBalancer service: %v", err)
 	}
 
@@ -226,7 +226,7 @@ func TestUnmanagedDNSToManagedDNSInternalIngressController(t *testing.T) {
 	}
 
 	lbService := &corev1.Service{}
-	if err := kclient.Get(context.TODO(), controller.LoadBalancerServiceName(ic), lbService); err != nil {
+	if err := kclient.Get(context.TODO(), controller.LoadBalancerServiceName(ic, operandNamespace), lbService); err != nil {
 		t.Fatalf("failed to get LoadBalancer service: %v", err)
 	}
 
@@ -247,7 +247,7 @@ func TestUnmanagedDNSToManagedDNSInternalIngressController(t *testing.T) {
 	verifyUnmanagedDNSRecordStatus(t, wildcardRecord)
 
 	routerDeployment := &appsv1.Deployment{}
-	if err := kclient.Get(context.TODO(), controller.RouterDeploymentName(ic), routerDeployment); err != nil {
+	if err := kclient.Get(context.TODO(), controller.RouterDeploymentName(ic, operandNamespace), routerDeployment); err != nil {
 		t.Fatalf("failed to get router deployment: %v", err)
 	}
 
@@ -277,12 +277,12 @@ func TestUnmanagedDNSToManagedDNSInternalIngressController(t *testing.T) {
 	// Ensure the service's load-balancer status changes.
 	err := wait.PollImmediate(10*time.Second, 5*time.Minute, func() (bool, error) {
 		lbService := &corev1.Service{}
-		if err := kclient.Get(context.TODO(), controller.LoadBalancerServiceName(ic), lbService); err != nil {
-			t.Logf("Get %q failed: %v, retrying ...", controller.LoadBalancerServiceName(ic), err)
+		if err := kclient.Get(context.TODO(), controller.LoadBalancerServiceName(ic, operandNamespace), lbService); err != nil {
+			t.Logf("Get %q failed: %v, retrying ...", controller.LoadBalancerServiceName(ic, operandNamespace), err)
 			return false, nil
 		}
 		if reflect.DeepEqual(lbService.Status.LoadBalancer, oldLoadBalancerStatus) {
-			t.Logf("Waiting for service %q to be updated", controller.LoadBalancerServiceName(ic))
+			t.Logf("Waiting for service %q to be updated", controller.LoadBalancerServiceName(ic, operandNamespace))
 			return false, nil
 		} else if ingresscontroller.IsServiceInternal(lbService) {
 			// The service got updated, but is not external.
@@ -291,7 +291,7 @@ func TestUnmanagedDNSToManagedDNSInternalIngressController(t *testing.T) {
 		return true, nil
 	})
 	if err != nil {
-		t.Fatalf("error updating the %q service: %v", controller.LoadBalancerServiceName(ic), err)
+		t.Fatalf("error updating the %q service: %v", controller.LoadBalancerServiceName(ic, operandNamespace), err)
 	}
 
 	t.Logf("Waiting for stable conditions on ingresscontroller %s after dnsManagementPolicy=Managed", ic.Name)